@@ -0,0 +1,57 @@
+// Command plans lints story plans. Without arguments, it checks the built-in plans. Otherwise, every argument is the
+// path to a YAML plan; files sharing the same slug are also checked to be translations of one another.
+//
+//	go run ./cmd/plans
+//	go run ./cmd/plans path/to/plan.en.yaml path/to/plan.fr.yaml
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/goccy/go-yaml"
+
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func loadPlan(path string) (*storyplanmodel.Plan, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	plan := new(storyplanmodel.Plan)
+
+	err = yaml.Unmarshal(data, plan)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal plan: %w", err)
+	}
+
+	return plan, nil
+}
+
+func main() {
+	plans := storyplanmodel.DefaultPlans
+
+	if len(os.Args) > 1 {
+		plans = make([]*storyplanmodel.Plan, 0, len(os.Args)-1)
+
+		for _, path := range os.Args[1:] {
+			plan, err := loadPlan(path)
+			if err != nil {
+				log.Fatalf("load plan %s: %v", path, err)
+			}
+
+			plans = append(plans, plan)
+		}
+	}
+
+	err := storyplanmodel.LintPlans(plans)
+	if err != nil {
+		log.Fatalf("lint plans:\n%v", err)
+	}
+
+	log.Printf("%d plans checked, no issue found", len(plans))
+}
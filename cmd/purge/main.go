@@ -0,0 +1,34 @@
+// Command purge permanently deletes the loglines and beats sheets that stayed in the trash longer than the configured
+// retention (TRASH_RETENTION, defaults to 30 days). It is meant to run periodically, as a cron job.
+//
+//	go run ./cmd/purge
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func main() {
+	ctx, err := postgres.NewContext(context.Background(), config.PostgresPresetDefault)
+	if err != nil {
+		log.Fatalf("failed to create context: %v", err)
+	}
+
+	service := services.NewPurgeTrashService(dao.NewPurgeTrashRepository())
+
+	purged, err := service.PurgeTrash(ctx, services.PurgeTrashRequest{
+		Retention: config.TrashPresetDefault.Retention,
+	})
+	if err != nil {
+		log.Fatalf("failed to purge trash: %v", err)
+	}
+
+	log.Printf("%d loglines and %d beats sheets purged", purged.Loglines, purged.BeatsSheets)
+}
@@ -34,22 +34,66 @@ var ErrInternalServerError = &apimodels.UnexpectedErrorStatusCode{
 type API struct {
 	apimodels.UnimplementedHandler
 
+	ConvertBeatsSheetService ConvertBeatsSheetService
+
 	CreateBeatsSheetService CreateBeatsSheetService
 	CreateLoglineService    CreateLoglineService
+	CreateProjectService    CreateProjectService
+	CreateStoryPlanService  CreateStoryPlanService
+
+	CritiqueLoglineService CritiqueLoglineService
+
+	DeleteBeatsSheetService DeleteBeatsSheetService
+	DeleteLoglineService    DeleteLoglineService
+	DeleteProjectService    DeleteProjectService
+
+	DetectLangService DetectLangService
+
+	DiffBeatsSheetsService      DiffBeatsSheetsService
+	DiffLoglineRevisionsService DiffLoglineRevisionsService
 
 	ExpandBeatService    ExpandBeatService
 	ExpandLoglineService ExpandLoglineService
 
-	GenerateBeatsSheetService GenerateBeatsSheetService
-	GenerateLoglinesService   GenerateLoglinesService
+	ForkStoryPlanService ForkStoryPlanService
+
+	GenerateBeatsSheetService        GenerateBeatsSheetService
+	GenerateLoglineVariationsService GenerateLoglineVariationsService
+	GenerateLoglinesService          GenerateLoglinesService
 
-	ListBeatsSheetsService ListBeatsSheetsService
-	ListLoglinesService    ListLoglinesService
+	ListBeatsSheetsService      ListBeatsSheetsService
+	ListLoglineRevisionsService ListLoglineRevisionsService
+	ListLoglineTagsService      ListLoglineTagsService
+	ListLoglinesService         ListLoglinesService
+	ListProjectsService         ListProjectsService
+	ListStoryPlansService       ListStoryPlansService
+
+	ListTrashedBeatsSheetsService ListTrashedBeatsSheetsService
+	ListTrashedLoglinesService    ListTrashedLoglinesService
 
 	RegenerateBeatsService RegenerateBeatsService
 
-	SelectBeatsSheetService SelectBeatsSheetService
-	SelectLoglineService    SelectLoglineService
+	RestoreBeatsSheetService      RestoreBeatsSheetService
+	RestoreLoglineService         RestoreLoglineService
+	RestoreLoglineRevisionService RestoreLoglineRevisionService
+
+	SearchService SearchService
+
+	SelectBeatsSheetService      SelectBeatsSheetService
+	SelectLoglineService         SelectLoglineService
+	SelectLoglineRevisionService SelectLoglineRevisionService
+	SelectProjectService         SelectProjectService
+	SelectStoryPlanService       SelectStoryPlanService
+
+	TranslateBeatsSheetService TranslateBeatsSheetService
+	TranslateLoglineService    TranslateLoglineService
+
+	UpdateBeatService      UpdateBeatService
+	UpdateLoglineService   UpdateLoglineService
+	UpdateProjectService   UpdateProjectService
+	UpdateStoryPlanService UpdateStoryPlanService
+
+	UpgradeBeatsSheetsService UpgradeBeatsSheetsService
 
 	JKClient     *jkApiModels.Client
 	OpenAIClient *config.OpenAI
@@ -0,0 +1,187 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestConvertBeatsSheet(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type convertBeatsSheetData struct {
+		resp *models.BeatsSheet
+		err  error
+	}
+
+	form := &apimodels.ConvertBeatsSheetForm{
+		BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		StoryPlanID:  apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.ConvertBeatsSheetForm
+
+		convertBeatsSheetData *convertBeatsSheetData
+
+		expect    apimodels.ConvertBeatsSheetRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: form,
+
+			convertBeatsSheetData: &convertBeatsSheetData{
+				resp: &models.BeatsSheet{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					SourceID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Content: []models.Beat{
+						{Key: "test-beat", Title: "Test Beat", Content: "Test Beat Content"},
+					},
+					Lang: models.LangEN,
+					Acts: []models.BeatsSheetAct{
+						{Key: "act-1", Name: "Act 1", Beats: []string{"test-beat"}},
+					},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.BeatsSheet{
+				ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				StoryPlanID: apimodels.NewOptStoryPlanID(
+					apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+				),
+				SourceID: apimodels.NewOptBeatsSheetID(
+					apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				),
+				Content: []apimodels.Beat{
+					{Key: "test-beat", Title: "Test Beat", Content: "Test Beat Content"},
+				},
+				Lang: apimodels.LangEn,
+				Acts: []apimodels.BeatsSheetAct{
+					{Key: "act-1", Name: "Act 1", Beats: []string{"test-beat"}},
+				},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "BeatsSheetNotFound",
+
+			form: form,
+
+			convertBeatsSheetData: &convertBeatsSheetData{err: dao.ErrBeatsSheetNotFound},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrBeatsSheetNotFound.Error()},
+		},
+		{
+			name: "LoglineNotFound",
+
+			form: form,
+
+			convertBeatsSheetData: &convertBeatsSheetData{err: dao.ErrLoglineNotFound},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "StoryPlanNotFound",
+
+			form: form,
+
+			convertBeatsSheetData: &convertBeatsSheetData{err: dao.ErrStoryPlanNotFound},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrStoryPlanNotFound.Error()},
+		},
+		{
+			name: "LangMismatch",
+
+			form: form,
+
+			convertBeatsSheetData: &convertBeatsSheetData{err: services.ErrStoryPlanLangMismatch},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrStoryPlanLangMismatch.Error()},
+		},
+		{
+			name: "InvalidPlan",
+
+			form: form,
+
+			convertBeatsSheetData: &convertBeatsSheetData{err: storyplanmodel.ErrInvalidPlan},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrInvalidPlan.Error()},
+		},
+		{
+			name: "InvalidBeatSheet",
+
+			form: form,
+
+			convertBeatsSheetData: &convertBeatsSheetData{err: daoai.ErrInvalidBeatSheet},
+
+			expect: &apimodels.UnprocessableEntityError{Error: daoai.ErrInvalidBeatSheet.Error()},
+		},
+		{
+			name: "Error",
+
+			form: form,
+
+			convertBeatsSheetData: &convertBeatsSheetData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockConvertBeatsSheetService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.convertBeatsSheetData != nil {
+				source.EXPECT().
+					ConvertBeatsSheet(mock.Anything, services.ConvertBeatsSheetRequest{
+						BeatsSheetID: uuid.UUID(testCase.form.GetBeatsSheetID()),
+						UserID:       uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						StoryPlanID:  uuid.UUID(testCase.form.GetStoryPlanID()),
+					}).
+					Return(testCase.convertBeatsSheetData.resp, testCase.convertBeatsSheetData.err)
+			}
+
+			handler := api.API{ConvertBeatsSheetService: source}
+
+			res, err := handler.ConvertBeatsSheet(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -36,6 +36,9 @@ func (api *API) CreateBeatsSheet(
 	beatsSheet, err := api.CreateBeatsSheetService.CreateBeatsSheet(ctx, services.CreateBeatsSheetRequest{
 		LoglineID: uuid.UUID(req.GetLoglineID()),
 		UserID:    userID,
+		StoryPlanID: lo.Ternary(
+			req.GetStoryPlanID().IsSet(), lo.ToPtr(uuid.UUID(req.GetStoryPlanID().Value)), nil,
+		),
 		Content: lo.Map(req.GetContent(), func(item apimodels.Beat, _ int) models.Beat {
 			return models.Beat{
 				Key:     item.GetKey(),
@@ -47,11 +50,14 @@ func (api *API) CreateBeatsSheet(
 	})
 
 	switch {
-	case errors.Is(err, dao.ErrLoglineNotFound), errors.Is(err, services.ErrStoryPlanNotFound):
+	case errors.Is(err, dao.ErrLoglineNotFound), errors.Is(err, dao.ErrStoryPlanNotFound):
 		_ = otel.ReportError(span, err)
 
 		return &apimodels.NotFoundError{Error: err.Error()}, nil
-	case errors.Is(err, storyplanmodel.ErrInvalidPlan):
+	case errors.Is(err, storyplanmodel.ErrInvalidPlan),
+		errors.Is(err, services.ErrStoryPlanLangMismatch),
+		errors.Is(err, storyplanmodel.ErrUnsupportedLang),
+		errors.Is(err, services.ErrContentLangMismatch):
 		_ = otel.ReportError(span, err)
 
 		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
@@ -64,6 +70,11 @@ func (api *API) CreateBeatsSheet(
 	return otel.ReportSuccess(span, &apimodels.BeatsSheet{
 		ID:        apimodels.BeatsSheetID(beatsSheet.ID),
 		LoglineID: apimodels.LoglineID(beatsSheet.LoglineID),
+		StoryPlanID: lo.Ternary(
+			beatsSheet.StoryPlanID != uuid.Nil,
+			apimodels.NewOptStoryPlanID(apimodels.StoryPlanID(beatsSheet.StoryPlanID)),
+			apimodels.OptStoryPlanID{},
+		),
 		Content: lo.Map(beatsSheet.Content, func(item models.Beat, _ int) apimodels.Beat {
 			return apimodels.Beat{
 				Key:     item.Key,
@@ -72,6 +83,7 @@ func (api *API) CreateBeatsSheet(
 			}
 		}),
 		Lang:      apimodels.Lang(beatsSheet.Lang),
+		Acts:      beatsSheetActsToAPI(beatsSheet.Acts),
 		CreatedAt: beatsSheet.CreatedAt,
 	}), nil
 }
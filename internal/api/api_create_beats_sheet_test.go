@@ -150,10 +150,10 @@ func TestCreateBeatsSheet(t *testing.T) {
 			},
 
 			createBeatsSheetData: &createBeatsSheetData{
-				err: services.ErrStoryPlanNotFound,
+				err: dao.ErrStoryPlanNotFound,
 			},
 
-			expect: &apimodels.NotFoundError{Error: services.ErrStoryPlanNotFound.Error()},
+			expect: &apimodels.NotFoundError{Error: dao.ErrStoryPlanNotFound.Error()},
 		},
 		{
 			name: "Error/InvalidStoryPlan",
@@ -207,6 +207,103 @@ func TestCreateBeatsSheet(t *testing.T) {
 
 			expectErr: errFoo,
 		},
+		{
+			name: "Success/StoryPlanID",
+
+			form: &apimodels.CreateBeatsSheetForm{
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				StoryPlanID: apimodels.NewOptStoryPlanID(
+					apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+				),
+				Content: []apimodels.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Beat 1",
+						Content: "Beat 1 content",
+					},
+				},
+				Lang: apimodels.LangEn,
+			},
+
+			createBeatsSheetData: &createBeatsSheetData{
+				resp: &models.BeatsSheet{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Beat 1",
+							Content: "Beat 1 content",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.BeatsSheet{
+				ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				StoryPlanID: apimodels.NewOptStoryPlanID(
+					apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+				),
+				Content: []apimodels.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Beat 1",
+						Content: "Beat 1 content",
+					},
+				},
+				Lang:      apimodels.LangEn,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "StoryPlanLangMismatch",
+
+			form: &apimodels.CreateBeatsSheetForm{
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				StoryPlanID: apimodels.NewOptStoryPlanID(
+					apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+				),
+				Content: []apimodels.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Beat 1",
+						Content: "Beat 1 content",
+					},
+				},
+				Lang: apimodels.LangEn,
+			},
+
+			createBeatsSheetData: &createBeatsSheetData{
+				err: services.ErrStoryPlanLangMismatch,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrStoryPlanLangMismatch.Error()},
+		},
+		{
+			name: "ContentLangMismatch",
+
+			form: &apimodels.CreateBeatsSheetForm{
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				Content: []apimodels.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Temps fort 1",
+						Content: "Contenu du temps fort 1",
+					},
+				},
+				Lang: apimodels.LangEn,
+			},
+
+			createBeatsSheetData: &createBeatsSheetData{
+				err: services.ErrContentLangMismatch,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrContentLangMismatch.Error()},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -224,7 +321,12 @@ func TestCreateBeatsSheet(t *testing.T) {
 					CreateBeatsSheet(mock.Anything, services.CreateBeatsSheetRequest{
 						LoglineID: uuid.UUID(testCase.form.GetLoglineID()),
 						UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
-						Lang:      models.Lang(testCase.form.GetLang()),
+						StoryPlanID: lo.Ternary(
+							testCase.form.GetStoryPlanID().IsSet(),
+							lo.ToPtr(uuid.UUID(testCase.form.GetStoryPlanID().Value)),
+							nil,
+						),
+						Lang: models.Lang(testCase.form.GetLang()),
 						Content: lo.Map(testCase.form.GetContent(), func(item apimodels.Beat, _ int) models.Beat {
 							return models.Beat{
 								Key:     item.GetKey(),
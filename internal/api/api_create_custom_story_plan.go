@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func (api *API) CreateCustomStoryPlan(
+	ctx context.Context, req *apimodels.CreateStoryPlanForm,
+) (apimodels.CreateCustomStoryPlanRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.CreateCustomStoryPlan")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	storyPlan, err := api.CreateStoryPlanService.CreateStoryPlan(ctx, services.CreateStoryPlanRequest{
+		UserID: &userID,
+		Slug:   models.Slug(req.GetSlug()),
+		Name:   req.GetName(),
+		Lang:   models.Lang(req.GetLang()),
+		Acts:   storyPlanActsFromAPI(req.GetActs()),
+		Beats:  storyPlanBeatsFromAPI(req.GetBeats()),
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrStoryPlanAlreadyExists):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.ConflictError{Error: err.Error()}, nil
+	case errors.Is(err, storyplanmodel.ErrInvalidPlan), errors.Is(err, storyplanmodel.ErrUnsupportedLang):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("create custom story plan: %w", err)
+	}
+
+	return otel.ReportSuccess(span, storyPlanToAPI(storyPlan)), nil
+}
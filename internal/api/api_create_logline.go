@@ -2,14 +2,19 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
+
 	"github.com/a-novel/golib/otel"
 	authpkg "github.com/a-novel/service-authentication/pkg"
 
+	"github.com/a-novel/service-story-schematics/internal/dao"
 	"github.com/a-novel/service-story-schematics/internal/services"
 	"github.com/a-novel/service-story-schematics/models"
 	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 type CreateLoglineService interface {
@@ -28,23 +33,32 @@ func (api *API) CreateLogline(
 	}
 
 	logline, err := api.CreateLoglineService.CreateLogline(ctx, services.CreateLoglineRequest{
-		UserID:  userID,
-		Slug:    models.Slug(req.GetSlug()),
-		Name:    req.GetName(),
-		Content: req.GetContent(),
-		Lang:    models.Lang(req.GetLang()),
+		UserID:    userID,
+		Slug:      models.Slug(req.GetSlug()),
+		Name:      req.GetName(),
+		Content:   req.GetContent(),
+		Lang:      models.Lang(req.GetLang()),
+		Genre:     models.Genre(req.GetGenre().Value),
+		Tags:      req.GetTags(),
+		ProjectID: uuid.UUID(req.GetProjectID().Value),
 	})
-	if err != nil {
-		return nil, otel.ReportError(span, fmt.Errorf("create logline: %w", err))
+	switch {
+	case errors.Is(err, dao.ErrProjectNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case errors.Is(err, storyplanmodel.ErrUnsupportedLang),
+		errors.Is(err, models.ErrUnsupportedGenre),
+		errors.Is(err, models.ErrInvalidTags),
+		errors.Is(err, services.ErrContentLangMismatch):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("create logline: %w", err)
 	}
 
-	return otel.ReportSuccess(span, &apimodels.Logline{
-		ID:        apimodels.LoglineID(logline.ID),
-		UserID:    apimodels.UserID(logline.UserID),
-		Slug:      apimodels.Slug(logline.Slug),
-		Name:      logline.Name,
-		Content:   logline.Content,
-		Lang:      apimodels.Lang(logline.Lang),
-		CreatedAt: logline.CreatedAt,
-	}), nil
+	return otel.ReportSuccess(span, loglineToAPI(logline)), nil
 }
@@ -16,9 +16,11 @@ import (
 
 	"github.com/a-novel/service-story-schematics/internal/api"
 	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
 	"github.com/a-novel/service-story-schematics/internal/services"
 	"github.com/a-novel/service-story-schematics/models"
 	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 func TestCreateLogline(t *testing.T) {
@@ -73,6 +75,145 @@ func TestCreateLogline(t *testing.T) {
 				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
 		},
+		{
+			name: "Success/GenreAndTags",
+
+			form: &apimodels.CreateLoglineForm{
+				Slug:    "slug",
+				Name:    "name",
+				Content: "content",
+				Lang:    apimodels.LangEn,
+				Genre:   apimodels.NewOptGenre(apimodels.GenreFantasy),
+				Tags:    apimodels.Tags{"Dragons", "quest"},
+			},
+
+			createLoglineData: &createLoglineData{
+				resp: &models.Logline{
+					ID:        uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "slug",
+					Name:      "name",
+					Content:   "content",
+					Lang:      models.LangEN,
+					Genre:     models.GenreFantasy,
+					Tags:      []string{"dragons", "quest"},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Logline{
+				ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				UserID:    apimodels.UserID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Slug:      "slug",
+				Name:      "name",
+				Content:   "content",
+				Lang:      apimodels.LangEn,
+				Genre:     apimodels.NewOptGenre(apimodels.GenreFantasy),
+				Tags:      apimodels.Tags{"dragons", "quest"},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/Project",
+
+			form: &apimodels.CreateLoglineForm{
+				Slug:      "slug",
+				Name:      "name",
+				Content:   "content",
+				Lang:      apimodels.LangEn,
+				ProjectID: apimodels.NewOptProjectID(apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-2000-000000000001"))),
+			},
+
+			createLoglineData: &createLoglineData{
+				resp: &models.Logline{
+					ID:        uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ProjectID: uuid.MustParse("00000000-0000-0000-2000-000000000001"),
+					Slug:      "slug",
+					Name:      "name",
+					Content:   "content",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Logline{
+				ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				UserID:    apimodels.UserID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				ProjectID: apimodels.NewOptProjectID(apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-2000-000000000001"))),
+				Slug:      "slug",
+				Name:      "name",
+				Content:   "content",
+				Lang:      apimodels.LangEn,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "ProjectNotFound",
+
+			form: &apimodels.CreateLoglineForm{
+				Slug:      "slug",
+				Name:      "name",
+				Content:   "content",
+				Lang:      apimodels.LangEn,
+				ProjectID: apimodels.NewOptProjectID(apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-2000-000000000001"))),
+			},
+
+			createLoglineData: &createLoglineData{
+				err: dao.ErrProjectNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrProjectNotFound.Error()},
+		},
+		{
+			name: "UnsupportedLang",
+
+			form: &apimodels.CreateLoglineForm{
+				Slug:    "slug",
+				Name:    "name",
+				Content: "content",
+				Lang:    apimodels.LangEn,
+			},
+
+			createLoglineData: &createLoglineData{
+				err: storyplanmodel.ErrUnsupportedLang,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrUnsupportedLang.Error()},
+		},
+		{
+			name: "InvalidTags",
+
+			form: &apimodels.CreateLoglineForm{
+				Slug:    "slug",
+				Name:    "name",
+				Content: "content",
+				Lang:    apimodels.LangEn,
+				Tags:    apimodels.Tags{"a very long tag that goes way past the limit"},
+			},
+
+			createLoglineData: &createLoglineData{
+				err: models.ErrInvalidTags,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: models.ErrInvalidTags.Error()},
+		},
+		{
+			name: "ContentLangMismatch",
+
+			form: &apimodels.CreateLoglineForm{
+				Slug:    "slug",
+				Name:    "nom",
+				Content: "contenu",
+				Lang:    apimodels.LangEn,
+			},
+
+			createLoglineData: &createLoglineData{
+				err: services.ErrContentLangMismatch,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrContentLangMismatch.Error()},
+		},
 		{
 			name: "Error",
 
@@ -104,11 +245,14 @@ func TestCreateLogline(t *testing.T) {
 			if testCase.createLoglineData != nil {
 				source.EXPECT().
 					CreateLogline(mock.Anything, services.CreateLoglineRequest{
-						UserID:  uuid.MustParse("00000000-1000-0000-0000-000000000001"),
-						Slug:    models.Slug(testCase.form.GetSlug()),
-						Name:    testCase.form.GetName(),
-						Content: testCase.form.GetContent(),
-						Lang:    models.Lang(testCase.form.GetLang()),
+						UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Slug:      models.Slug(testCase.form.GetSlug()),
+						Name:      testCase.form.GetName(),
+						Content:   testCase.form.GetContent(),
+						Lang:      models.Lang(testCase.form.GetLang()),
+						Genre:     models.Genre(testCase.form.GetGenre().Value),
+						Tags:      testCase.form.GetTags(),
+						ProjectID: uuid.UUID(testCase.form.GetProjectID().Value),
 					}).
 					Return(testCase.createLoglineData.resp, testCase.createLoglineData.err)
 			}
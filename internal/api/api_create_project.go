@@ -0,0 +1,40 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type CreateProjectService interface {
+	CreateProject(ctx context.Context, request services.CreateProjectRequest) (*models.Project, error)
+}
+
+func (api *API) CreateProject(
+	ctx context.Context, req *apimodels.CreateProjectForm,
+) (apimodels.CreateProjectRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.CreateProject")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	project, err := api.CreateProjectService.CreateProject(ctx, services.CreateProjectRequest{
+		UserID:      userID,
+		Name:        req.GetName(),
+		Description: req.GetDescription().Value,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("create project: %w", err))
+	}
+
+	return otel.ReportSuccess(span, projectToAPI(project)), nil
+}
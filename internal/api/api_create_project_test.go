@@ -0,0 +1,112 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestCreateProject(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type createProjectData struct {
+		resp *models.Project
+		err  error
+	}
+
+	form := &apimodels.CreateProjectForm{
+		Name:        "The Sea Trilogy",
+		Description: apimodels.NewOptString("Three novels about the sea."),
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.CreateProjectForm
+
+		createProjectData *createProjectData
+
+		expect    apimodels.CreateProjectRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: form,
+
+			createProjectData: &createProjectData{
+				resp: &models.Project{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:      uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Name:        "The Sea Trilogy",
+					Description: "Three novels about the sea.",
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Project{
+				ID:          apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				Name:        "The Sea Trilogy",
+				Description: "Three novels about the sea.",
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error",
+
+			form: form,
+
+			createProjectData: &createProjectData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockCreateProjectService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.createProjectData != nil {
+				source.EXPECT().
+					CreateProject(mock.Anything, services.CreateProjectRequest{
+						UserID:      uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Name:        testCase.form.GetName(),
+						Description: testCase.form.GetDescription().Value,
+					}).
+					Return(testCase.createProjectData.resp, testCase.createProjectData.err)
+			}
+
+			handler := api.API{CreateProjectService: source}
+
+			res, err := handler.CreateProject(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type CreateStoryPlanService interface {
+	CreateStoryPlan(ctx context.Context, request services.CreateStoryPlanRequest) (*storyplanmodel.Plan, error)
+}
+
+func (api *API) CreateStoryPlan(
+	ctx context.Context, req *apimodels.CreateStoryPlanForm,
+) (apimodels.CreateStoryPlanRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.CreateStoryPlan")
+	defer span.End()
+
+	storyPlan, err := api.CreateStoryPlanService.CreateStoryPlan(ctx, services.CreateStoryPlanRequest{
+		Slug:  models.Slug(req.GetSlug()),
+		Name:  req.GetName(),
+		Lang:  models.Lang(req.GetLang()),
+		Acts:  storyPlanActsFromAPI(req.GetActs()),
+		Beats: storyPlanBeatsFromAPI(req.GetBeats()),
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrStoryPlanAlreadyExists):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.ConflictError{Error: err.Error()}, nil
+	case errors.Is(err, storyplanmodel.ErrInvalidPlan), errors.Is(err, storyplanmodel.ErrUnsupportedLang):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("create story plan: %w", err)
+	}
+
+	return otel.ReportSuccess(span, storyPlanToAPI(storyPlan)), nil
+}
@@ -0,0 +1,275 @@
+package api_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestCreateStoryPlan(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type createStoryPlanData struct {
+		resp *storyplanmodel.Plan
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.CreateStoryPlanForm
+
+		createStoryPlanData *createStoryPlanData
+
+		expect    apimodels.CreateStoryPlanRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: &apimodels.CreateStoryPlanForm{
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: apimodels.LangEn,
+				Acts: []apimodels.StoryPlanAct{
+					{Name: "Test Act", Key: "test-act", Purpose: "Test Purpose"},
+				},
+				Beats: []apimodels.StoryPlanBeat{
+					{
+						Name:      "Test Beat 1",
+						Key:       "test-beat-1",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+						Act:       apimodels.NewOptString("test-act"),
+					},
+					{
+						Name:      "Test Beat 2",
+						Key:       "test-beat-2",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Min: apimodels.NewOptInt(2), Max: apimodels.NewOptInt(4)},
+						Act:       apimodels.NewOptString("test-act"),
+						Optional:  apimodels.NewOptBool(true),
+						Repeat: apimodels.NewOptStoryPlanRepeat(apimodels.StoryPlanRepeat{
+							Max: apimodels.NewOptInt(3),
+						}),
+					},
+				},
+			},
+
+			createStoryPlanData: &createStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Slug:      "test-slug",
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					Acts: []storyplanmodel.Act{
+						{Name: "Test Act", Key: "test-act", Purpose: "Test Purpose"},
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat 1",
+							Key:       "test-beat-1",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+							Scenes:    storyplanmodel.Scenes{Exact: lo.ToPtr(1)},
+							Act:       "test-act",
+						},
+						{
+							Name:      "Test Beat 2",
+							Key:       "test-beat-2",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+							Scenes:    storyplanmodel.Scenes{Min: lo.ToPtr(2), Max: lo.ToPtr(4)},
+							Act:       "test-act",
+							Optional:  true,
+							Repeat:    &storyplanmodel.Repeat{Max: lo.ToPtr(3)},
+						},
+					},
+				},
+			},
+
+			expect: &apimodels.StoryPlan{
+				ID:   apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: apimodels.LangEn,
+				Acts: []apimodels.StoryPlanAct{
+					{Name: "Test Act", Key: "test-act", Purpose: "Test Purpose"},
+				},
+				Beats: []apimodels.StoryPlanBeat{
+					{
+						Name:      "Test Beat 1",
+						Key:       "test-beat-1",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+						Act:       apimodels.NewOptString("test-act"),
+					},
+					{
+						Name:      "Test Beat 2",
+						Key:       "test-beat-2",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Min: apimodels.NewOptInt(2), Max: apimodels.NewOptInt(4)},
+						Act:       apimodels.NewOptString("test-act"),
+						Optional:  apimodels.NewOptBool(true),
+						Repeat: apimodels.NewOptStoryPlanRepeat(apimodels.StoryPlanRepeat{
+							Max: apimodels.NewOptInt(3),
+						}),
+					},
+				},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "AlreadyExists",
+
+			form: &apimodels.CreateStoryPlanForm{
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: apimodels.LangEn,
+				Beats: []apimodels.StoryPlanBeat{
+					{
+						Name:      "Test Beat 1",
+						Key:       "test-beat-1",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+					},
+				},
+			},
+
+			createStoryPlanData: &createStoryPlanData{
+				err: dao.ErrStoryPlanAlreadyExists,
+			},
+
+			expect: &apimodels.ConflictError{Error: dao.ErrStoryPlanAlreadyExists.Error()},
+		},
+		{
+			name: "InvalidPlan",
+
+			form: &apimodels.CreateStoryPlanForm{
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: apimodels.LangEn,
+				Beats: []apimodels.StoryPlanBeat{
+					{
+						Name:      "Test Beat 1",
+						Key:       "test-beat-1",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+						Act:       apimodels.NewOptString("test-act"),
+					},
+				},
+			},
+
+			createStoryPlanData: &createStoryPlanData{
+				err: storyplanmodel.ErrUnknownAct,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrUnknownAct.Error()},
+		},
+		{
+			name: "Error",
+
+			form: &apimodels.CreateStoryPlanForm{
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: apimodels.LangEn,
+				Beats: []apimodels.StoryPlanBeat{
+					{
+						Name:      "Test Beat 1",
+						Key:       "test-beat-1",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+					},
+				},
+			},
+
+			createStoryPlanData: &createStoryPlanData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockCreateStoryPlanService(t)
+
+			ctx := t.Context()
+
+			if testCase.createStoryPlanData != nil {
+				var acts []storyplanmodel.Act
+
+				for _, item := range testCase.form.GetActs() {
+					acts = append(acts, storyplanmodel.Act{Name: item.Name, Key: item.Key, Purpose: item.Purpose})
+				}
+
+				source.EXPECT().
+					CreateStoryPlan(mock.Anything, services.CreateStoryPlanRequest{
+						Slug: models.Slug(testCase.form.GetSlug()),
+						Name: testCase.form.GetName(),
+						Lang: models.Lang(testCase.form.GetLang()),
+						Acts: acts,
+						Beats: lo.Map(
+							testCase.form.GetBeats(),
+							func(item apimodels.StoryPlanBeat, _ int) storyplanmodel.Beat {
+								return storyplanmodel.Beat{
+									Name:      item.Name,
+									Key:       item.Key,
+									KeyPoints: item.KeyPoints,
+									Purpose:   item.Purpose,
+									Scenes: storyplanmodel.Scenes{
+										Exact: lo.Ternary(item.Scenes.Exact.IsSet(), &item.Scenes.Exact.Value, nil),
+										Min:   lo.Ternary(item.Scenes.Min.IsSet(), &item.Scenes.Min.Value, nil),
+										Max:   lo.Ternary(item.Scenes.Max.IsSet(), &item.Scenes.Max.Value, nil),
+									},
+									Act:      item.Act.Or(""),
+									Optional: item.Optional.Or(false),
+									Repeat: lo.Ternary(item.Repeat.IsSet(), &storyplanmodel.Repeat{
+										Min: lo.Ternary(item.Repeat.Value.Min.IsSet(), &item.Repeat.Value.Min.Value, nil),
+										Max: lo.Ternary(item.Repeat.Value.Max.IsSet(), &item.Repeat.Value.Max.Value, nil),
+									}, nil),
+								}
+							},
+						),
+					}).
+					Return(testCase.createStoryPlanData.resp, testCase.createStoryPlanData.err)
+			}
+
+			handler := api.API{CreateStoryPlanService: source}
+
+			res, err := handler.CreateStoryPlan(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
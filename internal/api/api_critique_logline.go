@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type CritiqueLoglineService interface {
+	CritiqueLogline(ctx context.Context, request services.CritiqueLoglineRequest) (*models.LoglineCritique, error)
+}
+
+func (api *API) CritiqueLogline(
+	ctx context.Context, req *apimodels.CritiqueLoglineForm,
+) (apimodels.CritiqueLoglineRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.CritiqueLogline")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	var logline *models.LoglineIdea
+
+	if idea, ok := req.GetLogline().Get(); ok {
+		logline = &models.LoglineIdea{
+			Name:    idea.Name,
+			Content: idea.Content,
+			Lang:    models.Lang(idea.Lang),
+		}
+	}
+
+	critique, err := api.CritiqueLoglineService.CritiqueLogline(ctx, services.CritiqueLoglineRequest{
+		UserID:    userID,
+		LoglineID: lo.Ternary(req.GetLoglineID().IsSet(), lo.ToPtr(uuid.UUID(req.GetLoglineID().Value)), nil),
+		Logline:   logline,
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrLoglineNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case errors.Is(err, services.ErrInvalidCritiqueSource),
+		errors.Is(err, storyplanmodel.ErrUnsupportedLang):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("critique logline: %w", err)
+	}
+
+	return otel.ReportSuccess(span, &apimodels.LoglineCritique{
+		Protagonist:  loglineCritiqueScoreToAPI(critique.Protagonist),
+		Goal:         loglineCritiqueScoreToAPI(critique.Goal),
+		Stakes:       loglineCritiqueScoreToAPI(critique.Stakes),
+		Hook:         loglineCritiqueScoreToAPI(critique.Hook),
+		GenreClarity: loglineCritiqueScoreToAPI(critique.GenreClarity),
+		Lang:         apimodels.Lang(critique.Lang),
+	}), nil
+}
+
+func loglineCritiqueScoreToAPI(score models.LoglineCritiqueScore) apimodels.LoglineCritiqueScore {
+	return apimodels.LoglineCritiqueScore{
+		Score:         score.Score,
+		Justification: score.Justification,
+		Suggestion:    score.Suggestion,
+	}
+}
@@ -0,0 +1,178 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestCritiqueLogline(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type critiqueLoglineData struct {
+		request services.CritiqueLoglineRequest
+		resp    *models.LoglineCritique
+		err     error
+	}
+
+	ideaForm := &apimodels.CritiqueLoglineForm{
+		Logline: apimodels.NewOptLoglineIdea(apimodels.LoglineIdea{
+			Name:    "test title",
+			Content: "test content",
+			Lang:    apimodels.LangEn,
+		}),
+	}
+
+	ideaRequest := services.CritiqueLoglineRequest{
+		UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+		Logline: &models.LoglineIdea{
+			Name:    "test title",
+			Content: "test content",
+			Lang:    models.LangEN,
+		},
+	}
+
+	idForm := &apimodels.CritiqueLoglineForm{
+		LoglineID: apimodels.NewOptLoglineID(
+			apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		),
+	}
+
+	idRequest := services.CritiqueLoglineRequest{
+		UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+		LoglineID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+	}
+
+	critique := &models.LoglineCritique{
+		Protagonist:  models.LoglineCritiqueScore{Score: 3, Justification: "Generic.", Suggestion: "Name them."},
+		Goal:         models.LoglineCritiqueScore{Score: 5, Justification: "Vague.", Suggestion: "Be concrete."},
+		Stakes:       models.LoglineCritiqueScore{Score: 2, Justification: "Missing.", Suggestion: "Add a threat."},
+		Hook:         models.LoglineCritiqueScore{Score: 4, Justification: "Flat.", Suggestion: "Add irony."},
+		GenreClarity: models.LoglineCritiqueScore{Score: 7, Justification: "Clear.", Suggestion: "Keep it."},
+		Lang:         models.LangEN,
+	}
+
+	apiCritique := &apimodels.LoglineCritique{
+		Protagonist:  apimodels.LoglineCritiqueScore{Score: 3, Justification: "Generic.", Suggestion: "Name them."},
+		Goal:         apimodels.LoglineCritiqueScore{Score: 5, Justification: "Vague.", Suggestion: "Be concrete."},
+		Stakes:       apimodels.LoglineCritiqueScore{Score: 2, Justification: "Missing.", Suggestion: "Add a threat."},
+		Hook:         apimodels.LoglineCritiqueScore{Score: 4, Justification: "Flat.", Suggestion: "Add irony."},
+		GenreClarity: apimodels.LoglineCritiqueScore{Score: 7, Justification: "Clear.", Suggestion: "Keep it."},
+		Lang:         apimodels.LangEn,
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.CritiqueLoglineForm
+
+		critiqueLoglineData *critiqueLoglineData
+
+		expect    apimodels.CritiqueLoglineRes
+		expectErr error
+	}{
+		{
+			name: "Success/Idea",
+
+			form: ideaForm,
+
+			critiqueLoglineData: &critiqueLoglineData{request: ideaRequest, resp: critique},
+
+			expect: apiCritique,
+		},
+		{
+			name: "Success/StoredLogline",
+
+			form: idForm,
+
+			critiqueLoglineData: &critiqueLoglineData{request: idRequest, resp: critique},
+
+			expect: apiCritique,
+		},
+		{
+			name: "LoglineNotFound",
+
+			form: idForm,
+
+			critiqueLoglineData: &critiqueLoglineData{request: idRequest, err: dao.ErrLoglineNotFound},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "InvalidSource",
+
+			form: &apimodels.CritiqueLoglineForm{},
+
+			critiqueLoglineData: &critiqueLoglineData{
+				request: services.CritiqueLoglineRequest{
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+				},
+				err: services.ErrInvalidCritiqueSource,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrInvalidCritiqueSource.Error()},
+		},
+		{
+			name: "UnsupportedLang",
+
+			form: ideaForm,
+
+			critiqueLoglineData: &critiqueLoglineData{request: ideaRequest, err: storyplanmodel.ErrUnsupportedLang},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrUnsupportedLang.Error()},
+		},
+		{
+			name: "Error",
+
+			form: ideaForm,
+
+			critiqueLoglineData: &critiqueLoglineData{request: ideaRequest, err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockCritiqueLoglineService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.critiqueLoglineData != nil {
+				source.EXPECT().
+					CritiqueLogline(mock.Anything, testCase.critiqueLoglineData.request).
+					Return(testCase.critiqueLoglineData.resp, testCase.critiqueLoglineData.err)
+			}
+
+			handler := api.API{CritiqueLoglineService: source}
+
+			res, err := handler.CritiqueLogline(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
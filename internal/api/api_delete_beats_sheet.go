@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type DeleteBeatsSheetService interface {
+	DeleteBeatsSheet(ctx context.Context, request services.DeleteBeatsSheetRequest) (*models.TrashedBeatsSheet, error)
+}
+
+func (api *API) DeleteBeatsSheet(
+	ctx context.Context, params apimodels.DeleteBeatsSheetParams,
+) (apimodels.DeleteBeatsSheetRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.DeleteBeatsSheet")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	beatsSheet, err := api.DeleteBeatsSheetService.DeleteBeatsSheet(ctx, services.DeleteBeatsSheetRequest{
+		BeatsSheetID: uuid.UUID(params.BeatsSheetID),
+		UserID:       userID,
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrBeatsSheetNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("delete beats sheet: %w", err)
+	}
+
+	return otel.ReportSuccess(span, trashedBeatsSheetToAPI(beatsSheet)), nil
+}
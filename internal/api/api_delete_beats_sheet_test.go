@@ -0,0 +1,126 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestDeleteBeatsSheet(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type deleteBeatsSheetData struct {
+		resp *models.TrashedBeatsSheet
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		params apimodels.DeleteBeatsSheetParams
+
+		deleteBeatsSheetData *deleteBeatsSheetData
+
+		expect    apimodels.DeleteBeatsSheetRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			params: apimodels.DeleteBeatsSheetParams{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			deleteBeatsSheetData: &deleteBeatsSheetData{
+				resp: &models.TrashedBeatsSheet{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.TrashedBeatsSheet{
+				ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				Lang:      apimodels.LangEn,
+				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				DeletedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "BeatsSheetNotFound",
+
+			params: apimodels.DeleteBeatsSheetParams{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			deleteBeatsSheetData: &deleteBeatsSheetData{
+				err: dao.ErrBeatsSheetNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrBeatsSheetNotFound.Error()},
+		},
+		{
+			name: "Error",
+
+			params: apimodels.DeleteBeatsSheetParams{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			deleteBeatsSheetData: &deleteBeatsSheetData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockDeleteBeatsSheetService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.deleteBeatsSheetData != nil {
+				source.EXPECT().
+					DeleteBeatsSheet(mock.Anything, services.DeleteBeatsSheetRequest{
+						BeatsSheetID: uuid.UUID(testCase.params.BeatsSheetID),
+						UserID:       uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					}).
+					Return(testCase.deleteBeatsSheetData.resp, testCase.deleteBeatsSheetData.err)
+			}
+
+			handler := api.API{DeleteBeatsSheetService: source}
+
+			res, err := handler.DeleteBeatsSheet(ctx, testCase.params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type DeleteLoglineService interface {
+	DeleteLogline(ctx context.Context, request services.DeleteLoglineRequest) (*models.Logline, error)
+}
+
+func (api *API) DeleteLogline(
+	ctx context.Context, params apimodels.DeleteLoglineParams,
+) (apimodels.DeleteLoglineRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.DeleteLogline")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	logline, err := api.DeleteLoglineService.DeleteLogline(ctx, services.DeleteLoglineRequest{
+		ID:     uuid.UUID(params.LoglineID),
+		UserID: userID,
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrLoglineNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("delete logline: %w", err)
+	}
+
+	return otel.ReportSuccess(span, loglineToAPI(logline)), nil
+}
@@ -0,0 +1,132 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestDeleteLogline(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type deleteLoglineData struct {
+		resp *models.Logline
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		params apimodels.DeleteLoglineParams
+
+		deleteLoglineData *deleteLoglineData
+
+		expect    apimodels.DeleteLoglineRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			params: apimodels.DeleteLoglineParams{
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			deleteLoglineData: &deleteLoglineData{
+				resp: &models.Logline{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Logline{
+				ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				Slug:      "test-slug",
+				Name:      "Test Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      apimodels.LangEn,
+				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				DeletedAt: apimodels.NewOptDateTime(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "LoglineNotFound",
+
+			params: apimodels.DeleteLoglineParams{
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			deleteLoglineData: &deleteLoglineData{
+				err: dao.ErrLoglineNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "Error",
+
+			params: apimodels.DeleteLoglineParams{
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			deleteLoglineData: &deleteLoglineData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockDeleteLoglineService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.deleteLoglineData != nil {
+				source.EXPECT().
+					DeleteLogline(mock.Anything, services.DeleteLoglineRequest{
+						ID:     uuid.UUID(testCase.params.LoglineID),
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					}).
+					Return(testCase.deleteLoglineData.resp, testCase.deleteLoglineData.err)
+			}
+
+			handler := api.API{DeleteLoglineService: source}
+
+			res, err := handler.DeleteLogline(ctx, testCase.params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type DeleteProjectService interface {
+	DeleteProject(ctx context.Context, request services.DeleteProjectRequest) (*models.Project, error)
+}
+
+func (api *API) DeleteProject(
+	ctx context.Context, params apimodels.DeleteProjectParams,
+) (apimodels.DeleteProjectRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.DeleteProject")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	project, err := api.DeleteProjectService.DeleteProject(ctx, services.DeleteProjectRequest{
+		ID:     uuid.UUID(params.ProjectID),
+		UserID: userID,
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrProjectNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("delete project: %w", err)
+	}
+
+	return otel.ReportSuccess(span, projectToAPI(project)), nil
+}
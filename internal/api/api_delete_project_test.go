@@ -0,0 +1,120 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestDeleteProject(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type deleteProjectData struct {
+		resp *models.Project
+		err  error
+	}
+
+	params := apimodels.DeleteProjectParams{
+		ProjectID: apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+	}
+
+	testCases := []struct {
+		name string
+
+		params apimodels.DeleteProjectParams
+
+		deleteProjectData *deleteProjectData
+
+		expect    apimodels.DeleteProjectRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			params: params,
+
+			deleteProjectData: &deleteProjectData{
+				resp: &models.Project{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:      uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Name:        "The Sea Trilogy",
+					Description: "Three novels about the sea.",
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Project{
+				ID:          apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				Name:        "The Sea Trilogy",
+				Description: "Three novels about the sea.",
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "ProjectNotFound",
+
+			params: params,
+
+			deleteProjectData: &deleteProjectData{err: dao.ErrProjectNotFound},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrProjectNotFound.Error()},
+		},
+		{
+			name: "Error",
+
+			params: params,
+
+			deleteProjectData: &deleteProjectData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockDeleteProjectService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.deleteProjectData != nil {
+				source.EXPECT().
+					DeleteProject(mock.Anything, services.DeleteProjectRequest{
+						ID:     uuid.UUID(testCase.params.ProjectID),
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					}).
+					Return(testCase.deleteProjectData.resp, testCase.deleteProjectData.err)
+			}
+
+			handler := api.API{DeleteProjectService: source}
+
+			res, err := handler.DeleteProject(ctx, testCase.params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type DetectLangService interface {
+	DetectLang(ctx context.Context, request services.DetectLangRequest) (*models.LangDetection, error)
+}
+
+func (api *API) DetectLang(ctx context.Context, req *apimodels.DetectLangForm) (apimodels.DetectLangRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.DetectLang")
+	defer span.End()
+
+	detection, err := api.DetectLangService.DetectLang(ctx, services.DetectLangRequest{
+		Text: req.GetText(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("detect lang: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &apimodels.LangDetection{
+		Lang: lo.Ternary(
+			detection.Lang != "",
+			apimodels.NewOptLang(apimodels.Lang(detection.Lang)),
+			apimodels.OptLang{},
+		),
+		Confidence: detection.Confidence,
+	}), nil
+}
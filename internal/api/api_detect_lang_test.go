@@ -0,0 +1,94 @@
+package api_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestDetectLang(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type detectLangData struct {
+		resp *models.LangDetection
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.DetectLangForm
+
+		detectLangData *detectLangData
+
+		expect    apimodels.DetectLangRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: &apimodels.DetectLangForm{Text: "Il était une fois"},
+
+			detectLangData: &detectLangData{
+				resp: &models.LangDetection{Lang: models.LangFR, Confidence: 0.8},
+			},
+
+			expect: &apimodels.LangDetection{
+				Lang:       apimodels.NewOptLang(apimodels.LangFr),
+				Confidence: 0.8,
+			},
+		},
+		{
+			name: "Success/Undetermined",
+
+			form: &apimodels.DetectLangForm{Text: "1984"},
+
+			detectLangData: &detectLangData{
+				resp: &models.LangDetection{},
+			},
+
+			expect: &apimodels.LangDetection{},
+		},
+		{
+			name: "Error",
+
+			form: &apimodels.DetectLangForm{Text: "Il était une fois"},
+
+			detectLangData: &detectLangData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockDetectLangService(t)
+
+			if testCase.detectLangData != nil {
+				source.EXPECT().
+					DetectLang(mock.Anything, services.DetectLangRequest{Text: testCase.form.GetText()}).
+					Return(testCase.detectLangData.resp, testCase.detectLangData.err)
+			}
+
+			handler := api.API{DetectLangService: source}
+
+			res, err := handler.DetectLang(t.Context(), testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type DiffBeatsSheetsService interface {
+	DiffBeatsSheets(ctx context.Context, request services.DiffBeatsSheetsRequest) (*models.BeatsSheetDiff, error)
+}
+
+func (api *API) GetBeatsSheetDiff(
+	ctx context.Context, params apimodels.GetBeatsSheetDiffParams,
+) (apimodels.GetBeatsSheetDiffRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.GetBeatsSheetDiff")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	diff, err := api.DiffBeatsSheetsService.DiffBeatsSheets(ctx, services.DiffBeatsSheetsRequest{
+		FromID: uuid.UUID(params.From),
+		ToID:   uuid.UUID(params.To),
+		UserID: userID,
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrBeatsSheetNotFound),
+		errors.Is(err, dao.ErrLoglineNotFound),
+		errors.Is(err, dao.ErrStoryPlanNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("diff beats sheets: %w", err)
+	}
+
+	return otel.ReportSuccess(span, &apimodels.BeatsSheetDiff{
+		From: *beatsSheetToAPI(diff.From),
+		To:   *beatsSheetToAPI(diff.To),
+		Beats: lo.Map(diff.Beats, func(item models.BeatDiff, _ int) apimodels.BeatDiff {
+			return apimodels.BeatDiff{
+				Key:    item.Key,
+				Status: apimodels.BeatDiffStatus(item.Status),
+				FromIndex: lo.Ternary(
+					item.FromIndex != nil, apimodels.NewOptInt(lo.FromPtr(item.FromIndex)), apimodels.OptInt{},
+				),
+				ToIndex: lo.Ternary(
+					item.ToIndex != nil, apimodels.NewOptInt(lo.FromPtr(item.ToIndex)), apimodels.OptInt{},
+				),
+				Moved:    item.Moved,
+				Modified: item.Modified,
+				Title:    diffChunksToAPI(item.Title),
+				Content:  diffChunksToAPI(item.Content),
+			}
+		}),
+	}), nil
+}
@@ -0,0 +1,213 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestDiffBeatsSheets(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type diffBeatsSheetsData struct {
+		resp *models.BeatsSheetDiff
+		err  error
+	}
+
+	params := apimodels.GetBeatsSheetDiffParams{
+		From: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		To:   apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+	}
+
+	testCases := []struct {
+		name string
+
+		diffBeatsSheetsData *diffBeatsSheetsData
+
+		expect    apimodels.GetBeatsSheetDiffRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			diffBeatsSheetsData: &diffBeatsSheetsData{
+				resp: &models.BeatsSheetDiff{
+					From: &models.BeatsSheet{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+						Content: []models.Beat{
+							{Key: "opening", Title: "Opening", Content: "A young hero sleeps."},
+							{Key: "finale", Title: "Finale", Content: "The hero wins."},
+						},
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					To: &models.BeatsSheet{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+						Content: []models.Beat{
+							{Key: "opening", Title: "Opening", Content: "A reluctant hero sleeps."},
+						},
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+					Beats: []models.BeatDiff{
+						{
+							Key:       "opening",
+							Status:    models.BeatDiffStatusKept,
+							FromIndex: lo.ToPtr(0),
+							ToIndex:   lo.ToPtr(0),
+							Modified:  true,
+							Title: []models.DiffChunk{
+								{Op: models.DiffOpEqual, Text: "Opening"},
+							},
+							Content: []models.DiffChunk{
+								{Op: models.DiffOpEqual, Text: "A "},
+								{Op: models.DiffOpDelete, Text: "young"},
+								{Op: models.DiffOpInsert, Text: "reluctant"},
+								{Op: models.DiffOpEqual, Text: " hero sleeps."},
+							},
+						},
+						{
+							Key:       "finale",
+							Status:    models.BeatDiffStatusRemoved,
+							FromIndex: lo.ToPtr(1),
+							Title: []models.DiffChunk{
+								{Op: models.DiffOpDelete, Text: "Finale"},
+							},
+							Content: []models.DiffChunk{
+								{Op: models.DiffOpDelete, Text: "The hero wins."},
+							},
+						},
+					},
+				},
+			},
+
+			expect: &apimodels.BeatsSheetDiff{
+				From: apimodels.BeatsSheet{
+					ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+					Content: []apimodels.Beat{
+						{Key: "opening", Title: "Opening", Content: "A young hero sleeps."},
+						{Key: "finale", Title: "Finale", Content: "The hero wins."},
+					},
+					Lang:      apimodels.LangEn,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				To: apimodels.BeatsSheet{
+					ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+					Content: []apimodels.Beat{
+						{Key: "opening", Title: "Opening", Content: "A reluctant hero sleeps."},
+					},
+					Lang:      apimodels.LangEn,
+					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				Beats: []apimodels.BeatDiff{
+					{
+						Key:       "opening",
+						Status:    apimodels.BeatDiffStatusKept,
+						FromIndex: apimodels.NewOptInt(0),
+						ToIndex:   apimodels.NewOptInt(0),
+						Modified:  true,
+						Title: []apimodels.DiffChunk{
+							{Op: apimodels.DiffOpEqual, Text: "Opening"},
+						},
+						Content: []apimodels.DiffChunk{
+							{Op: apimodels.DiffOpEqual, Text: "A "},
+							{Op: apimodels.DiffOpDelete, Text: "young"},
+							{Op: apimodels.DiffOpInsert, Text: "reluctant"},
+							{Op: apimodels.DiffOpEqual, Text: " hero sleeps."},
+						},
+					},
+					{
+						Key:       "finale",
+						Status:    apimodels.BeatDiffStatusRemoved,
+						FromIndex: apimodels.NewOptInt(1),
+						Title: []apimodels.DiffChunk{
+							{Op: apimodels.DiffOpDelete, Text: "Finale"},
+						},
+						Content: []apimodels.DiffChunk{
+							{Op: apimodels.DiffOpDelete, Text: "The hero wins."},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BeatsSheetNotFound",
+
+			diffBeatsSheetsData: &diffBeatsSheetsData{
+				err: dao.ErrBeatsSheetNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrBeatsSheetNotFound.Error()},
+		},
+		{
+			name: "LoglineNotFound",
+
+			diffBeatsSheetsData: &diffBeatsSheetsData{
+				err: dao.ErrLoglineNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "Error",
+
+			diffBeatsSheetsData: &diffBeatsSheetsData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockDiffBeatsSheetsService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.diffBeatsSheetsData != nil {
+				source.EXPECT().
+					DiffBeatsSheets(mock.Anything, services.DiffBeatsSheetsRequest{
+						FromID: uuid.UUID(params.From),
+						ToID:   uuid.UUID(params.To),
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					}).
+					Return(testCase.diffBeatsSheetsData.resp, testCase.diffBeatsSheetsData.err)
+			}
+
+			handler := api.API{DiffBeatsSheetsService: source}
+
+			res, err := handler.GetBeatsSheetDiff(ctx, params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
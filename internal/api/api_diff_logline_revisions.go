@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type DiffLoglineRevisionsService interface {
+	DiffLoglineRevisions(
+		ctx context.Context, request services.DiffLoglineRevisionsRequest,
+	) (*models.LoglineRevisionDiff, error)
+}
+
+func (api *API) GetLoglineRevisionDiff(
+	ctx context.Context, params apimodels.GetLoglineRevisionDiffParams,
+) (apimodels.GetLoglineRevisionDiffRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.GetLoglineRevisionDiff")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	diff, err := api.DiffLoglineRevisionsService.DiffLoglineRevisions(ctx, services.DiffLoglineRevisionsRequest{
+		FromID: uuid.UUID(params.From),
+		ToID:   uuid.UUID(params.To),
+		UserID: userID,
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrLoglineRevisionNotFound), errors.Is(err, dao.ErrLoglineNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("diff logline revisions: %w", err)
+	}
+
+	return otel.ReportSuccess(span, &apimodels.LoglineRevisionDiff{
+		From:    *loglineRevisionToAPI(diff.From),
+		To:      *loglineRevisionToAPI(diff.To),
+		Name:    diffChunksToAPI(diff.Name),
+		Content: diffChunksToAPI(diff.Content),
+	}), nil
+}
@@ -0,0 +1,158 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestDiffLoglineRevisions(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type diffLoglineRevisionsData struct {
+		resp *models.LoglineRevisionDiff
+		err  error
+	}
+
+	params := apimodels.GetLoglineRevisionDiffParams{
+		From: apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		To:   apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+	}
+
+	testCases := []struct {
+		name string
+
+		diffLoglineRevisionsData *diffLoglineRevisionsData
+
+		expect    apimodels.GetLoglineRevisionDiffRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			diffLoglineRevisionsData: &diffLoglineRevisionsData{
+				resp: &models.LoglineRevisionDiff{
+					From: &models.LoglineRevision{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+						Name:      "Test Name",
+						Content:   "A young hero leaves home.",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					To: &models.LoglineRevision{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+						Name:      "Test Name",
+						Content:   "A reluctant hero leaves home.",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+					Name: []models.DiffChunk{
+						{Op: models.DiffOpEqual, Text: "Test Name"},
+					},
+					Content: []models.DiffChunk{
+						{Op: models.DiffOpEqual, Text: "A "},
+						{Op: models.DiffOpDelete, Text: "young"},
+						{Op: models.DiffOpInsert, Text: "reluctant"},
+						{Op: models.DiffOpEqual, Text: " hero leaves home."},
+					},
+				},
+			},
+
+			expect: &apimodels.LoglineRevisionDiff{
+				From: apimodels.LoglineRevision{
+					ID:        apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+					Name:      "Test Name",
+					Content:   "A young hero leaves home.",
+					Lang:      apimodels.LangEn,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				To: apimodels.LoglineRevision{
+					ID:        apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+					Name:      "Test Name",
+					Content:   "A reluctant hero leaves home.",
+					Lang:      apimodels.LangEn,
+					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				Name: []apimodels.DiffChunk{
+					{Op: apimodels.DiffOpEqual, Text: "Test Name"},
+				},
+				Content: []apimodels.DiffChunk{
+					{Op: apimodels.DiffOpEqual, Text: "A "},
+					{Op: apimodels.DiffOpDelete, Text: "young"},
+					{Op: apimodels.DiffOpInsert, Text: "reluctant"},
+					{Op: apimodels.DiffOpEqual, Text: " hero leaves home."},
+				},
+			},
+		},
+		{
+			name: "RevisionNotFound",
+
+			diffLoglineRevisionsData: &diffLoglineRevisionsData{
+				err: dao.ErrLoglineRevisionNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineRevisionNotFound.Error()},
+		},
+		{
+			name: "Error",
+
+			diffLoglineRevisionsData: &diffLoglineRevisionsData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockDiffLoglineRevisionsService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.diffLoglineRevisionsData != nil {
+				source.EXPECT().
+					DiffLoglineRevisions(mock.Anything, services.DiffLoglineRevisionsRequest{
+						FromID: uuid.UUID(params.From),
+						ToID:   uuid.UUID(params.To),
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					}).
+					Return(testCase.diffLoglineRevisionsData.resp, testCase.diffLoglineRevisionsData.err)
+			}
+
+			handler := api.API{DiffLoglineRevisionsService: source}
+
+			res, err := handler.GetLoglineRevisionDiff(ctx, params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
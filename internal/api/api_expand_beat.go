@@ -15,10 +15,11 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	"github.com/a-novel/service-story-schematics/models"
 	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 type ExpandBeatService interface {
-	ExpandBeat(ctx context.Context, request services.ExpandBeatRequest) (*models.Beat, error)
+	ExpandBeat(ctx context.Context, request services.ExpandBeatRequest) (*models.ExpandedBeat, error)
 }
 
 func (api *API) ExpandBeat(ctx context.Context, req *apimodels.ExpandBeatForm) (apimodels.ExpandBeatRes, error) {
@@ -30,18 +31,22 @@ func (api *API) ExpandBeat(ctx context.Context, req *apimodels.ExpandBeatForm) (
 		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
 	}
 
-	beat, err := api.ExpandBeatService.ExpandBeat(ctx, services.ExpandBeatRequest{
+	expanded, err := api.ExpandBeatService.ExpandBeat(ctx, services.ExpandBeatRequest{
 		BeatsSheetID: uuid.UUID(req.GetBeatsSheetID()),
 		TargetKey:    req.GetTargetKey(),
+		Occurrence:   req.GetOccurrence().Or(0),
 		UserID:       userID,
+		Apply:        req.GetApply().Or(false),
 	})
 
 	switch {
-	case errors.Is(err, dao.ErrBeatsSheetNotFound), errors.Is(err, services.ErrStoryPlanNotFound):
+	case errors.Is(err, dao.ErrBeatsSheetNotFound), errors.Is(err, dao.ErrStoryPlanNotFound):
 		_ = otel.ReportError(span, err)
 
 		return &apimodels.NotFoundError{Error: err.Error()}, nil
-	case errors.Is(err, daoai.ErrUnknownTargetKey):
+	case errors.Is(err, daoai.ErrUnknownTargetKey),
+		errors.Is(err, services.ErrBeatNotFound),
+		errors.Is(err, storyplanmodel.ErrInvalidPlan):
 		_ = otel.ReportError(span, err)
 
 		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
@@ -51,9 +56,14 @@ func (api *API) ExpandBeat(ctx context.Context, req *apimodels.ExpandBeatForm) (
 		return nil, fmt.Errorf("expand beat: %w", err)
 	}
 
+	// Applied beats are returned as the new revision of the sheet.
+	if expanded.BeatsSheet != nil {
+		return otel.ReportSuccess(span, beatsSheetToAPI(expanded.BeatsSheet)), nil
+	}
+
 	return otel.ReportSuccess(span, &apimodels.Beat{
-		Key:     beat.Key,
-		Title:   beat.Title,
-		Content: beat.Content,
+		Key:     expanded.Beat.Key,
+		Title:   expanded.Beat.Title,
+		Content: expanded.Beat.Content,
 	}), nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -20,6 +21,7 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	"github.com/a-novel/service-story-schematics/models"
 	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 func TestExpandBeat(t *testing.T) {
@@ -28,7 +30,7 @@ func TestExpandBeat(t *testing.T) {
 	errFoo := errors.New("foo")
 
 	type expandBeatData struct {
-		resp *models.Beat
+		resp *models.ExpandedBeat
 		err  error
 	}
 
@@ -51,10 +53,12 @@ func TestExpandBeat(t *testing.T) {
 			},
 
 			expandBeatData: &expandBeatData{
-				resp: &models.Beat{
-					Key:     "beat-1",
-					Title:   "Beat 1 expanded",
-					Content: "Beat 1 content expanded",
+				resp: &models.ExpandedBeat{
+					Beat: &models.Beat{
+						Key:     "beat-1",
+						Title:   "Beat 1 expanded",
+						Content: "Beat 1 content expanded",
+					},
 				},
 			},
 
@@ -64,6 +68,67 @@ func TestExpandBeat(t *testing.T) {
 				Content: "Beat 1 content expanded",
 			},
 		},
+		{
+			name: "Apply",
+
+			form: &apimodels.ExpandBeatForm{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				TargetKey:    "beat-1",
+				Occurrence:   apimodels.NewOptInt(0),
+				Apply:        apimodels.NewOptBool(true),
+			},
+
+			expandBeatData: &expandBeatData{
+				resp: &models.ExpandedBeat{
+					Beat: &models.Beat{
+						Key:     "beat-1",
+						Title:   "Beat 1 expanded",
+						Content: "Beat 1 content expanded",
+					},
+					BeatsSheet: &models.BeatsSheet{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+						ParentID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Content: []models.Beat{
+							{
+								Key:     "beat-1",
+								Title:   "Beat 1 expanded",
+								Content: "Beat 1 content expanded",
+							},
+							{
+								Key:     "beat-2",
+								Title:   "Beat 2",
+								Content: "Beat 2 content",
+							},
+						},
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: &apimodels.BeatsSheet{
+				ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-1000-0000-000000000001")),
+				ParentID: apimodels.NewOptBeatsSheetID(
+					apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				),
+				Content: []apimodels.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Beat 1 expanded",
+						Content: "Beat 1 content expanded",
+					},
+					{
+						Key:     "beat-2",
+						Title:   "Beat 2",
+						Content: "Beat 2 content",
+					},
+				},
+				Lang:      apimodels.LangEn,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
 		{
 			name: "BeatsSheetNotFound",
 
@@ -87,10 +152,10 @@ func TestExpandBeat(t *testing.T) {
 			},
 
 			expandBeatData: &expandBeatData{
-				err: services.ErrStoryPlanNotFound,
+				err: dao.ErrStoryPlanNotFound,
 			},
 
-			expect: &apimodels.NotFoundError{Error: services.ErrStoryPlanNotFound.Error()},
+			expect: &apimodels.NotFoundError{Error: dao.ErrStoryPlanNotFound.Error()},
 		},
 		{
 			name: "UnknownTargetKey",
@@ -106,6 +171,37 @@ func TestExpandBeat(t *testing.T) {
 
 			expect: &apimodels.UnprocessableEntityError{Error: daoai.ErrUnknownTargetKey.Error()},
 		},
+		{
+			name: "BeatNotFound",
+
+			form: &apimodels.ExpandBeatForm{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				TargetKey:    "beat-1",
+				Occurrence:   apimodels.NewOptInt(2),
+				Apply:        apimodels.NewOptBool(true),
+			},
+
+			expandBeatData: &expandBeatData{
+				err: services.ErrBeatNotFound,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrBeatNotFound.Error()},
+		},
+		{
+			name: "InvalidPlan",
+
+			form: &apimodels.ExpandBeatForm{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				TargetKey:    "beat-1",
+				Apply:        apimodels.NewOptBool(true),
+			},
+
+			expandBeatData: &expandBeatData{
+				err: storyplanmodel.ErrInvalidPlan,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrInvalidPlan.Error()},
+		},
 		{
 			name: "Error",
 
@@ -137,7 +233,9 @@ func TestExpandBeat(t *testing.T) {
 					ExpandBeat(mock.Anything, services.ExpandBeatRequest{
 						BeatsSheetID: uuid.UUID(testCase.form.GetBeatsSheetID()),
 						TargetKey:    testCase.form.GetTargetKey(),
+						Occurrence:   testCase.form.GetOccurrence().Or(0),
 						UserID:       uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Apply:        testCase.form.GetApply().Or(false),
 					}).
 					Return(testCase.expandBeatData.resp, testCase.expandBeatData.err)
 			}
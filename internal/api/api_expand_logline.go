@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/a-novel/golib/otel"
@@ -10,6 +11,7 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	"github.com/a-novel/service-story-schematics/models"
 	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 type ExpandLoglineService interface {
@@ -33,8 +35,15 @@ func (api *API) ExpandLogline(ctx context.Context, req *apimodels.LoglineIdea) (
 		},
 		UserID: userID,
 	})
-	if err != nil {
-		return nil, otel.ReportError(span, fmt.Errorf("expand logline: %w", err))
+	switch {
+	case errors.Is(err, storyplanmodel.ErrUnsupportedLang):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("expand logline: %w", err)
 	}
 
 	return otel.ReportSuccess(span, &apimodels.LoglineIdea{
@@ -18,6 +18,7 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	"github.com/a-novel/service-story-schematics/models"
 	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 func TestExpandLogline(t *testing.T) {
@@ -63,6 +64,21 @@ func TestExpandLogline(t *testing.T) {
 				Lang:    apimodels.LangEn,
 			},
 		},
+		{
+			name: "UnsupportedLang",
+
+			form: &apimodels.LoglineIdea{
+				Name:    "Logline 1",
+				Content: "Logline 1 content",
+				Lang:    apimodels.LangEn,
+			},
+
+			expandLoglineData: &expandLoglineData{
+				err: storyplanmodel.ErrUnsupportedLang,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrUnsupportedLang.Error()},
+		},
 		{
 			name: "Error",
 
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type ForkStoryPlanService interface {
+	ForkStoryPlan(ctx context.Context, request services.ForkStoryPlanRequest) (*storyplanmodel.Plan, error)
+}
+
+func (api *API) ForkStoryPlan(
+	ctx context.Context, req *apimodels.ForkStoryPlanForm,
+) (apimodels.ForkStoryPlanRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.ForkStoryPlan")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	storyPlan, err := api.ForkStoryPlanService.ForkStoryPlan(ctx, services.ForkStoryPlanRequest{
+		ID:     uuid.UUID(req.GetID()),
+		UserID: userID,
+		Slug:   lo.Ternary(req.Slug.IsSet(), lo.ToPtr(models.Slug(req.Slug.Value)), nil),
+		Name:   lo.Ternary(req.Name.IsSet(), lo.ToPtr(req.Name.Value), nil),
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrStoryPlanNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case errors.Is(err, dao.ErrStoryPlanAlreadyExists):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.ConflictError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("fork story plan: %w", err)
+	}
+
+	return otel.ReportSuccess(span, storyPlanToAPI(storyPlan)), nil
+}
@@ -0,0 +1,223 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestForkStoryPlan(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type forkStoryPlanData struct {
+		request services.ForkStoryPlanRequest
+
+		resp *storyplanmodel.Plan
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.ForkStoryPlanForm
+
+		forkStoryPlanData *forkStoryPlanData
+
+		expect    apimodels.ForkStoryPlanRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: &apimodels.ForkStoryPlanForm{
+				ID: apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			forkStoryPlanData: &forkStoryPlanData{
+				request: services.ForkStoryPlanRequest{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+				},
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						UserID:    lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+						Slug:      "test-slug",
+						Version:   1,
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+							Scenes:    storyplanmodel.Scenes{Exact: lo.ToPtr(1)},
+						},
+					},
+				},
+			},
+
+			expect: &apimodels.StoryPlan{
+				ID:      apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				Slug:    "test-slug",
+				Version: 1,
+				UserID: apimodels.NewOptUserID(
+					apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				),
+				Name: "Test Name",
+				Lang: apimodels.LangEn,
+				Beats: []apimodels.StoryPlanBeat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+					},
+				},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/Rename",
+
+			form: &apimodels.ForkStoryPlanForm{
+				ID:   apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Slug: apimodels.NewOptSlug("my-slug"),
+				Name: apimodels.NewOptString("My Name"),
+			},
+
+			forkStoryPlanData: &forkStoryPlanData{
+				request: services.ForkStoryPlanRequest{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Slug:   lo.ToPtr(models.Slug("my-slug")),
+					Name:   lo.ToPtr("My Name"),
+				},
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						UserID:    lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+						Slug:      "my-slug",
+						Version:   1,
+						Name:      "My Name",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: &apimodels.StoryPlan{
+				ID:      apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				Slug:    "my-slug",
+				Version: 1,
+				UserID: apimodels.NewOptUserID(
+					apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				),
+				Name:      "My Name",
+				Lang:      apimodels.LangEn,
+				Beats:     []apimodels.StoryPlanBeat{},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "NotFound",
+
+			form: &apimodels.ForkStoryPlanForm{
+				ID: apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			forkStoryPlanData: &forkStoryPlanData{
+				request: services.ForkStoryPlanRequest{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+				},
+				err: dao.ErrStoryPlanNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrStoryPlanNotFound.Error()},
+		},
+		{
+			name: "AlreadyExists",
+
+			form: &apimodels.ForkStoryPlanForm{
+				ID: apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			forkStoryPlanData: &forkStoryPlanData{
+				request: services.ForkStoryPlanRequest{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+				},
+				err: dao.ErrStoryPlanAlreadyExists,
+			},
+
+			expect: &apimodels.ConflictError{Error: dao.ErrStoryPlanAlreadyExists.Error()},
+		},
+		{
+			name: "Error",
+
+			form: &apimodels.ForkStoryPlanForm{
+				ID: apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			forkStoryPlanData: &forkStoryPlanData{
+				request: services.ForkStoryPlanRequest{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+				},
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockForkStoryPlanService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.forkStoryPlanData != nil {
+				source.EXPECT().
+					ForkStoryPlan(mock.Anything, testCase.forkStoryPlanData.request).
+					Return(testCase.forkStoryPlanData.resp, testCase.forkStoryPlanData.err)
+			}
+
+			handler := api.API{ForkStoryPlanService: source}
+
+			res, err := handler.ForkStoryPlan(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
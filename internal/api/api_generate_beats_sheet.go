@@ -15,6 +15,7 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	"github.com/a-novel/service-story-schematics/models"
 	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 type GenerateBeatsSheetService interface {
@@ -37,15 +38,22 @@ func (api *API) GenerateBeatsSheet(
 		services.GenerateBeatsSheetRequest{
 			LoglineID: uuid.UUID(req.GetLoglineID()),
 			UserID:    userID,
-			Lang:      models.Lang(req.GetLang()),
+			StoryPlanID: lo.Ternary(
+				req.GetStoryPlanID().IsSet(), lo.ToPtr(uuid.UUID(req.GetStoryPlanID().Value)), nil,
+			),
+			Lang: models.Lang(req.GetLang()),
 		},
 	)
 
 	switch {
-	case errors.Is(err, dao.ErrLoglineNotFound), errors.Is(err, services.ErrStoryPlanNotFound):
+	case errors.Is(err, dao.ErrLoglineNotFound), errors.Is(err, dao.ErrStoryPlanNotFound):
 		_ = otel.ReportError(span, err)
 
 		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case errors.Is(err, services.ErrStoryPlanLangMismatch), errors.Is(err, storyplanmodel.ErrUnsupportedLang):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
 	case err != nil:
 		_ = otel.ReportError(span, err)
 
@@ -80,6 +80,55 @@ func TestGenerateBeatsSheet(t *testing.T) {
 				Lang: apimodels.LangEn,
 			},
 		},
+		{
+			name: "Success/StoryPlanID",
+
+			form: &apimodels.GenerateBeatsSheetForm{
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				StoryPlanID: apimodels.NewOptStoryPlanID(
+					apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+				),
+				Lang: apimodels.LangEn,
+			},
+
+			generateBeatsSheetData: &generateBeatsSheetData{
+				resp: []models.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Beat 1",
+						Content: "Beat 1 content",
+					},
+				},
+			},
+
+			expect: &apimodels.BeatsSheetIdea{
+				Content: []apimodels.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Beat 1",
+						Content: "Beat 1 content",
+					},
+				},
+				Lang: apimodels.LangEn,
+			},
+		},
+		{
+			name: "StoryPlanLangMismatch",
+
+			form: &apimodels.GenerateBeatsSheetForm{
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				StoryPlanID: apimodels.NewOptStoryPlanID(
+					apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+				),
+				Lang: apimodels.LangEn,
+			},
+
+			generateBeatsSheetData: &generateBeatsSheetData{
+				err: services.ErrStoryPlanLangMismatch,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrStoryPlanLangMismatch.Error()},
+		},
 		{
 			name: "LoglineNotFound",
 
@@ -103,10 +152,10 @@ func TestGenerateBeatsSheet(t *testing.T) {
 			},
 
 			generateBeatsSheetData: &generateBeatsSheetData{
-				err: services.ErrStoryPlanNotFound,
+				err: dao.ErrStoryPlanNotFound,
 			},
 
-			expect: &apimodels.NotFoundError{Error: services.ErrStoryPlanNotFound.Error()},
+			expect: &apimodels.NotFoundError{Error: dao.ErrStoryPlanNotFound.Error()},
 		},
 		{
 			name: "Error",
@@ -139,7 +188,12 @@ func TestGenerateBeatsSheet(t *testing.T) {
 					GenerateBeatsSheet(mock.Anything, services.GenerateBeatsSheetRequest{
 						LoglineID: uuid.UUID(testCase.form.GetLoglineID()),
 						UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
-						Lang:      models.Lang(testCase.form.GetLang()),
+						StoryPlanID: lo.Ternary(
+							testCase.form.GetStoryPlanID().IsSet(),
+							lo.ToPtr(uuid.UUID(testCase.form.GetStoryPlanID().Value)),
+							nil,
+						),
+						Lang: models.Lang(testCase.form.GetLang()),
 					}).
 					Return(testCase.generateBeatsSheetData.resp, testCase.generateBeatsSheetData.err)
 			}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type GenerateLoglineVariationsService interface {
+	GenerateLoglineVariations(
+		ctx context.Context, request services.GenerateLoglineVariationsRequest,
+	) ([]models.LoglineVariation, error)
+}
+
+func (api *API) GenerateLoglineVariations(
+	ctx context.Context, req *apimodels.GenerateLoglineVariationsForm,
+) (apimodels.GenerateLoglineVariationsRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.GenerateLoglineVariations")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	var logline *models.LoglineIdea
+
+	if idea, ok := req.GetLogline().Get(); ok {
+		logline = &models.LoglineIdea{
+			Name:    idea.Name,
+			Content: idea.Content,
+			Lang:    models.Lang(idea.Lang),
+		}
+	}
+
+	variations, err := api.GenerateLoglineVariationsService.GenerateLoglineVariations(
+		ctx,
+		services.GenerateLoglineVariationsRequest{
+			UserID:    userID,
+			LoglineID: lo.Ternary(req.GetLoglineID().IsSet(), lo.ToPtr(uuid.UUID(req.GetLoglineID().Value)), nil),
+			Logline:   logline,
+			Count:     req.GetCount(),
+			Dimensions: lo.Map(req.GetDimensions(), func(item apimodels.LoglineDimension, _ int) models.LoglineDimension {
+				return models.LoglineDimension(item)
+			}),
+		},
+	)
+
+	switch {
+	case errors.Is(err, dao.ErrLoglineNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case errors.Is(err, services.ErrInvalidVariationSource),
+		errors.Is(err, services.ErrMissingVariations),
+		errors.Is(err, models.ErrUnsupportedLoglineDimension),
+		errors.Is(err, storyplanmodel.ErrUnsupportedLang):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("generate logline variations: %w", err)
+	}
+
+	res := apimodels.GenerateLoglineVariationsOKApplicationJSON(
+		lo.Map(variations, func(item models.LoglineVariation, _ int) apimodels.LoglineVariation {
+			return apimodels.LoglineVariation{
+				Name:      item.Name,
+				Content:   item.Content,
+				Lang:      apimodels.Lang(item.Lang),
+				Dimension: apimodels.LoglineDimension(item.Dimension),
+			}
+		}),
+	)
+
+	return otel.ReportSuccess(span, &res), nil
+}
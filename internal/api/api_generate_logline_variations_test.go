@@ -0,0 +1,221 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestGenerateLoglineVariations(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type generateLoglineVariationsData struct {
+		request services.GenerateLoglineVariationsRequest
+		resp    []models.LoglineVariation
+		err     error
+	}
+
+	ideaForm := &apimodels.GenerateLoglineVariationsForm{
+		Logline: apimodels.NewOptLoglineIdea(apimodels.LoglineIdea{
+			Name:    "test title",
+			Content: "test content",
+			Lang:    apimodels.LangEn,
+		}),
+		Count:      2,
+		Dimensions: []apimodels.LoglineDimension{apimodels.LoglineDimensionTone, apimodels.LoglineDimensionEnding},
+	}
+
+	ideaRequest := services.GenerateLoglineVariationsRequest{
+		UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+		Logline: &models.LoglineIdea{
+			Name:    "test title",
+			Content: "test content",
+			Lang:    models.LangEN,
+		},
+		Count:      2,
+		Dimensions: []models.LoglineDimension{models.LoglineDimensionTone, models.LoglineDimensionEnding},
+	}
+
+	idForm := &apimodels.GenerateLoglineVariationsForm{
+		LoglineID: apimodels.NewOptLoglineID(
+			apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		),
+		Count: 2,
+	}
+
+	idRequest := services.GenerateLoglineVariationsRequest{
+		UserID:     uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+		LoglineID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Count:      2,
+		Dimensions: []models.LoglineDimension{},
+	}
+
+	variations := []models.LoglineVariation{
+		{
+			LoglineIdea: models.LoglineIdea{Name: "variation 1", Content: "content 1", Lang: models.LangEN},
+			Dimension:   models.LoglineDimensionTone,
+		},
+		{
+			LoglineIdea: models.LoglineIdea{Name: "variation 2", Content: "content 2", Lang: models.LangEN},
+			Dimension:   models.LoglineDimensionEnding,
+		},
+	}
+
+	apiVariations := &apimodels.GenerateLoglineVariationsOKApplicationJSON{
+		{
+			Name:      "variation 1",
+			Content:   "content 1",
+			Lang:      apimodels.LangEn,
+			Dimension: apimodels.LoglineDimensionTone,
+		},
+		{
+			Name:      "variation 2",
+			Content:   "content 2",
+			Lang:      apimodels.LangEn,
+			Dimension: apimodels.LoglineDimensionEnding,
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.GenerateLoglineVariationsForm
+
+		generateLoglineVariationsData *generateLoglineVariationsData
+
+		expect    apimodels.GenerateLoglineVariationsRes
+		expectErr error
+	}{
+		{
+			name: "Success/Idea",
+
+			form: ideaForm,
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{request: ideaRequest, resp: variations},
+
+			expect: apiVariations,
+		},
+		{
+			name: "Success/StoredLogline",
+
+			form: idForm,
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{request: idRequest, resp: variations},
+
+			expect: apiVariations,
+		},
+		{
+			name: "LoglineNotFound",
+
+			form: idForm,
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{
+				request: idRequest,
+				err:     dao.ErrLoglineNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "InvalidSource",
+
+			form: idForm,
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{
+				request: idRequest,
+				err:     services.ErrInvalidVariationSource,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrInvalidVariationSource.Error()},
+		},
+		{
+			name: "UnsupportedDimension",
+
+			form: ideaForm,
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{
+				request: ideaRequest,
+				err:     models.ErrUnsupportedLoglineDimension,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: models.ErrUnsupportedLoglineDimension.Error()},
+		},
+		{
+			name: "UnsupportedLang",
+
+			form: ideaForm,
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{
+				request: ideaRequest,
+				err:     storyplanmodel.ErrUnsupportedLang,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrUnsupportedLang.Error()},
+		},
+		{
+			name: "MissingVariations",
+
+			form: ideaForm,
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{
+				request: ideaRequest,
+				err:     services.ErrMissingVariations,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrMissingVariations.Error()},
+		},
+		{
+			name: "Error",
+
+			form: ideaForm,
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{request: ideaRequest, err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockGenerateLoglineVariationsService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.generateLoglineVariationsData != nil {
+				source.EXPECT().
+					GenerateLoglineVariations(mock.Anything, testCase.generateLoglineVariationsData.request).
+					Return(testCase.generateLoglineVariationsData.resp, testCase.generateLoglineVariationsData.err)
+			}
+
+			handler := api.API{GenerateLoglineVariationsService: source}
+
+			res, err := handler.GenerateLoglineVariations(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
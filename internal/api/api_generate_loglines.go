@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/samber/lo"
@@ -12,6 +13,7 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	"github.com/a-novel/service-story-schematics/models"
 	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 type GenerateLoglinesService interface {
@@ -35,8 +37,15 @@ func (api *API) GenerateLoglines(
 		UserID: userID,
 		Lang:   models.Lang(req.GetLang()),
 	})
-	if err != nil {
-		return nil, otel.ReportError(span, fmt.Errorf("generate loglines: %w", err))
+	switch {
+	case errors.Is(err, storyplanmodel.ErrUnsupportedLang):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("generate loglines: %w", err)
 	}
 
 	res := apimodels.GenerateLoglinesOKApplicationJSON(
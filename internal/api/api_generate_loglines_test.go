@@ -18,6 +18,7 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	"github.com/a-novel/service-story-schematics/models"
 	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 func TestGenerateLoglines(t *testing.T) {
@@ -77,6 +78,21 @@ func TestGenerateLoglines(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "UnsupportedLang",
+
+			form: &apimodels.GenerateLoglinesForm{
+				Count: 10,
+				Theme: "theme",
+				Lang:  apimodels.LangEn,
+			},
+
+			generateLoglinesData: &generateLoglinesData{
+				err: storyplanmodel.ErrUnsupportedLang,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrUnsupportedLang.Error()},
+		},
 		{
 			name: "Error",
 
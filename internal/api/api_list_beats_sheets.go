@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -16,7 +17,9 @@ import (
 )
 
 type ListBeatsSheetsService interface {
-	ListBeatsSheets(ctx context.Context, request services.ListBeatsSheetsRequest) ([]*models.BeatsSheetPreview, error)
+	ListBeatsSheets(
+		ctx context.Context, request services.ListBeatsSheetsRequest,
+	) (*models.Page[*models.BeatsSheetPreview], error)
 }
 
 func (api *API) GetBeatsSheets(
@@ -33,22 +36,30 @@ func (api *API) GetBeatsSheets(
 	beatsSheets, err := api.ListBeatsSheetsService.ListBeatsSheets(ctx, services.ListBeatsSheetsRequest{
 		UserID:    userID,
 		LoglineID: uuid.UUID(params.LoglineID),
+		Sort:      models.ListSort(params.Sort.Value),
+		Cursor:    params.Cursor.Value,
 		Limit:     params.Limit.Value,
-		Offset:    params.Offset.Value,
 	})
-	if err != nil {
+
+	switch {
+	case errors.Is(err, models.ErrInvalidCursor), errors.Is(err, models.ErrUnsupportedSort):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
 		return nil, otel.ReportError(span, fmt.Errorf("list beats sheets: %w", err))
 	}
 
-	res := apimodels.GetBeatsSheetsOKApplicationJSON(
-		lo.Map(beatsSheets, func(item *models.BeatsSheetPreview, _ int) apimodels.BeatsSheetPreview {
+	return otel.ReportSuccess(span, &apimodels.BeatsSheetsPage{
+		Items: lo.Map(beatsSheets.Items, func(item *models.BeatsSheetPreview, _ int) apimodels.BeatsSheetPreview {
 			return apimodels.BeatsSheetPreview{
 				ID:        apimodels.BeatsSheetID(item.ID),
 				Lang:      apimodels.Lang(item.Lang),
 				CreatedAt: item.CreatedAt,
 			}
 		}),
-	)
-
-	return otel.ReportSuccess(span, &res), nil
+		NextCursor: lo.Ternary(
+			beatsSheets.NextCursor != "", apimodels.NewOptString(beatsSheets.NextCursor), apimodels.OptString{},
+		),
+	}), nil
 }
@@ -27,10 +27,17 @@ func TestListBeatsSheets(t *testing.T) {
 	errFoo := errors.New("foo")
 
 	type listBeatsSheetsData struct {
-		resp []*models.BeatsSheetPreview
+		resp *models.Page[*models.BeatsSheetPreview]
 		err  error
 	}
 
+	params := apimodels.GetBeatsSheetsParams{
+		LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+		Sort:      apimodels.NewOptBeatsSheetsSort(apimodels.BeatsSheetsSortOldest),
+		Cursor:    apimodels.NewOptString("cursor"),
+		Limit:     apimodels.OptInt{Value: 10, Set: true},
+	}
+
 	testCases := []struct {
 		name string
 
@@ -44,49 +51,85 @@ func TestListBeatsSheets(t *testing.T) {
 		{
 			name: "Success",
 
-			params: apimodels.GetBeatsSheetsParams{
-				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
-				Limit:     apimodels.OptInt{Value: 10, Set: true},
-				Offset:    apimodels.OptInt{Value: 2, Set: true},
-			},
+			params: params,
 
 			listBeatsSheetsData: &listBeatsSheetsData{
-				resp: []*models.BeatsSheetPreview{
+				resp: &models.Page[*models.BeatsSheetPreview]{
+					Items: []*models.BeatsSheetPreview{
+						{
+							ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Lang:      models.LangEN,
+							CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+						{
+							ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+							Lang:      models.LangEN,
+							CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+						},
+					},
+					NextCursor: "next-cursor",
+				},
+			},
+
+			expect: &apimodels.BeatsSheetsPage{
+				Items: []apimodels.BeatsSheetPreview{
 					{
-						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-						Lang:      models.LangEN,
+						ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						Lang:      apimodels.LangEn,
 						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 					},
 					{
-						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-						Lang:      models.LangEN,
+						ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+						Lang:      apimodels.LangEn,
 						CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 					},
 				},
+				NextCursor: apimodels.NewOptString("next-cursor"),
 			},
+		},
+		{
+			name: "LastPage",
+
+			params: params,
 
-			expect: &apimodels.GetBeatsSheetsOKApplicationJSON{
-				{
-					ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
-					Lang:      apimodels.LangEn,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			listBeatsSheetsData: &listBeatsSheetsData{
+				resp: &models.Page[*models.BeatsSheetPreview]{
+					Items: []*models.BeatsSheetPreview{
+						{
+							ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Lang:      models.LangEN,
+							CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+					},
 				},
-				{
-					ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
-					Lang:      apimodels.LangEn,
-					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &apimodels.BeatsSheetsPage{
+				Items: []apimodels.BeatsSheetPreview{
+					{
+						ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						Lang:      apimodels.LangEn,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
 				},
 			},
 		},
 		{
-			name: "Error",
+			name: "InvalidCursor",
+
+			params: params,
 
-			params: apimodels.GetBeatsSheetsParams{
-				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
-				Limit:     apimodels.OptInt{Value: 10, Set: true},
-				Offset:    apimodels.OptInt{Value: 2, Set: true},
+			listBeatsSheetsData: &listBeatsSheetsData{
+				err: models.ErrInvalidCursor,
 			},
 
+			expect: &apimodels.UnprocessableEntityError{Error: models.ErrInvalidCursor.Error()},
+		},
+		{
+			name: "Error",
+
+			params: params,
+
 			listBeatsSheetsData: &listBeatsSheetsData{
 				err: errFoo,
 			},
@@ -110,8 +153,9 @@ func TestListBeatsSheets(t *testing.T) {
 					ListBeatsSheets(mock.Anything, services.ListBeatsSheetsRequest{
 						UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
 						LoglineID: uuid.UUID(testCase.params.LoglineID),
+						Sort:      models.ListSort(testCase.params.Sort.Value),
+						Cursor:    testCase.params.Cursor.Value,
 						Limit:     testCase.params.Limit.Value,
-						Offset:    testCase.params.Offset.Value,
 					}).
 					Return(testCase.listBeatsSheetsData.resp, testCase.listBeatsSheetsData.err)
 			}
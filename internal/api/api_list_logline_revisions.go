@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type ListLoglineRevisionsService interface {
+	ListLoglineRevisions(
+		ctx context.Context, request services.ListLoglineRevisionsRequest,
+	) ([]*models.LoglineRevision, error)
+}
+
+func (api *API) GetLoglineRevisions(
+	ctx context.Context, params apimodels.GetLoglineRevisionsParams,
+) (apimodels.GetLoglineRevisionsRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.GetLoglineRevisions")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	revisions, err := api.ListLoglineRevisionsService.ListLoglineRevisions(ctx, services.ListLoglineRevisionsRequest{
+		UserID:    userID,
+		LoglineID: uuid.UUID(params.LoglineID),
+		Limit:     params.Limit.Value,
+		Offset:    params.Offset.Value,
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrLoglineNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("list logline revisions: %w", err)
+	}
+
+	res := apimodels.GetLoglineRevisionsOKApplicationJSON(
+		lo.Map(revisions, func(item *models.LoglineRevision, _ int) apimodels.LoglineRevision {
+			return *loglineRevisionToAPI(item)
+		}),
+	)
+
+	return otel.ReportSuccess(span, &res), nil
+}
@@ -0,0 +1,152 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestListLoglineRevisions(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type listLoglineRevisionsData struct {
+		resp []*models.LoglineRevision
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		params apimodels.GetLoglineRevisionsParams
+
+		listLoglineRevisionsData *listLoglineRevisionsData
+
+		expect    apimodels.GetLoglineRevisionsRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			params: apimodels.GetLoglineRevisionsParams{
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				Limit:     apimodels.OptInt{Value: 10, Set: true},
+				Offset:    apimodels.OptInt{Value: 2, Set: true},
+			},
+
+			listLoglineRevisionsData: &listLoglineRevisionsData{
+				resp: []*models.LoglineRevision{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+						Name:      "Test Name",
+						Content:   "Lorem ipsum dolor sit amet, consectetur adipiscing elit",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+						Name:      "Test Name",
+						Content:   "Lorem ipsum dolor sit amet",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: &apimodels.GetLoglineRevisionsOKApplicationJSON{
+				{
+					ID:        apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet, consectetur adipiscing elit",
+					Lang:      apimodels.LangEn,
+					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      apimodels.LangEn,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "LoglineNotFound",
+
+			params: apimodels.GetLoglineRevisionsParams{
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+			},
+
+			listLoglineRevisionsData: &listLoglineRevisionsData{
+				err: dao.ErrLoglineNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "Error",
+
+			params: apimodels.GetLoglineRevisionsParams{
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+			},
+
+			listLoglineRevisionsData: &listLoglineRevisionsData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockListLoglineRevisionsService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.listLoglineRevisionsData != nil {
+				source.EXPECT().
+					ListLoglineRevisions(mock.Anything, services.ListLoglineRevisionsRequest{
+						UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						LoglineID: uuid.UUID(testCase.params.LoglineID),
+						Limit:     testCase.params.Limit.Value,
+						Offset:    testCase.params.Offset.Value,
+					}).
+					Return(testCase.listLoglineRevisionsData.resp, testCase.listLoglineRevisionsData.err)
+			}
+
+			handler := api.API{ListLoglineRevisionsService: source}
+
+			res, err := handler.GetLoglineRevisions(ctx, testCase.params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type ListLoglineTagsService interface {
+	ListLoglineTags(ctx context.Context, request services.ListLoglineTagsRequest) ([]*models.LoglineTag, error)
+}
+
+func (api *API) GetLoglineTags(ctx context.Context) (apimodels.GetLoglineTagsRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.GetLoglineTags")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	tags, err := api.ListLoglineTagsService.ListLoglineTags(ctx, services.ListLoglineTagsRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list logline tags: %w", err))
+	}
+
+	res := apimodels.GetLoglineTagsOKApplicationJSON(
+		lo.Map(tags, func(item *models.LoglineTag, _ int) apimodels.LoglineTag {
+			return apimodels.LoglineTag{
+				Tag:   item.Tag,
+				Count: item.Count,
+			}
+		}),
+	)
+
+	return otel.ReportSuccess(span, &res), nil
+}
@@ -0,0 +1,94 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestListLoglineTags(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type listLoglineTagsData struct {
+		resp []*models.LoglineTag
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		listLoglineTagsData *listLoglineTagsData
+
+		expect    apimodels.GetLoglineTagsRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			listLoglineTagsData: &listLoglineTagsData{
+				resp: []*models.LoglineTag{
+					{Tag: "quest", Count: 3},
+					{Tag: "dragons", Count: 1},
+				},
+			},
+
+			expect: &apimodels.GetLoglineTagsOKApplicationJSON{
+				{Tag: "quest", Count: 3},
+				{Tag: "dragons", Count: 1},
+			},
+		},
+		{
+			name: "Error",
+
+			listLoglineTagsData: &listLoglineTagsData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockListLoglineTagsService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.listLoglineTagsData != nil {
+				source.EXPECT().
+					ListLoglineTags(mock.Anything, services.ListLoglineTagsRequest{
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					}).
+					Return(testCase.listLoglineTagsData.resp, testCase.listLoglineTagsData.err)
+			}
+
+			handler := api.API{ListLoglineTagsService: source}
+
+			res, err := handler.GetLoglineTags(ctx)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -2,8 +2,10 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/samber/lo"
 
 	"github.com/a-novel/golib/otel"
@@ -15,7 +17,9 @@ import (
 )
 
 type ListLoglinesService interface {
-	ListLoglines(ctx context.Context, request services.ListLoglinesRequest) ([]*models.LoglinePreview, error)
+	ListLoglines(
+		ctx context.Context, request services.ListLoglinesRequest,
+	) (*models.Page[*models.LoglinePreview], error)
 }
 
 func (api *API) GetLoglines(ctx context.Context, params apimodels.GetLoglinesParams) (apimodels.GetLoglinesRes, error) {
@@ -28,25 +32,43 @@ func (api *API) GetLoglines(ctx context.Context, params apimodels.GetLoglinesPar
 	}
 
 	loglines, err := api.ListLoglinesService.ListLoglines(ctx, services.ListLoglinesRequest{
-		UserID: userID,
-		Limit:  params.Limit.Value,
-		Offset: params.Offset.Value,
+		UserID:    userID,
+		Sort:      models.ListSort(params.Sort.Value),
+		Cursor:    params.Cursor.Value,
+		Limit:     params.Limit.Value,
+		Genre:     models.Genre(params.Genre.Value),
+		Lang:      models.Lang(params.Lang.Value),
+		Tags:      params.Tag,
+		ProjectID: uuid.UUID(params.ProjectID.Value),
 	})
-	if err != nil {
+
+	switch {
+	case errors.Is(err, models.ErrInvalidCursor),
+		errors.Is(err, models.ErrUnsupportedSort),
+		errors.Is(err, models.ErrUnsupportedGenre),
+		errors.Is(err, models.ErrInvalidTags):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
 		return nil, otel.ReportError(span, fmt.Errorf("list loglines: %w", err))
 	}
 
-	res := apimodels.GetLoglinesOKApplicationJSON(
-		lo.Map(loglines, func(item *models.LoglinePreview, _ int) apimodels.LoglinePreview {
+	return otel.ReportSuccess(span, &apimodels.LoglinesPage{
+		Items: lo.Map(loglines.Items, func(item *models.LoglinePreview, _ int) apimodels.LoglinePreview {
 			return apimodels.LoglinePreview{
 				Slug:      apimodels.Slug(item.Slug),
+				ProjectID: projectIDToAPI(item.ProjectID),
 				Name:      item.Name,
 				Content:   item.Content,
 				Lang:      apimodels.Lang(item.Lang),
+				Genre:     genreToAPI(item.Genre),
+				Tags:      item.Tags,
 				CreatedAt: item.CreatedAt,
 			}
 		}),
-	)
-
-	return otel.ReportSuccess(span, &res), nil
+		NextCursor: lo.Ternary(
+			loglines.NextCursor != "", apimodels.NewOptString(loglines.NextCursor), apimodels.OptString{},
+		),
+	}), nil
 }
@@ -27,10 +27,16 @@ func TestListLoglines(t *testing.T) {
 	errFoo := errors.New("foo")
 
 	type listLoglinesData struct {
-		resp []*models.LoglinePreview
+		resp *models.Page[*models.LoglinePreview]
 		err  error
 	}
 
+	params := apimodels.GetLoglinesParams{
+		Sort:   apimodels.NewOptLoglinesSort(apimodels.LoglinesSortName),
+		Cursor: apimodels.NewOptString("cursor"),
+		Limit:  apimodels.OptInt{Value: 10, Set: true},
+	}
+
 	testCases := []struct {
 		name string
 
@@ -44,55 +50,152 @@ func TestListLoglines(t *testing.T) {
 		{
 			name: "Success",
 
-			params: apimodels.GetLoglinesParams{
-				Limit:  apimodels.OptInt{Value: 10, Set: true},
-				Offset: apimodels.OptInt{Value: 2, Set: true},
-			},
+			params: params,
 
 			listLoglinesData: &listLoglinesData{
-				resp: []*models.LoglinePreview{
+				resp: &models.Page[*models.LoglinePreview]{
+					Items: []*models.LoglinePreview{
+						{
+							Slug:      "slug-1",
+							Name:      "Logline 1",
+							Content:   "Logline 1 content",
+							Lang:      models.LangEN,
+							CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+						{
+							Slug:      "slug-2",
+							Name:      "Logline 2",
+							Content:   "Logline 2 content",
+							Lang:      models.LangEN,
+							CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+						},
+					},
+					NextCursor: "next-cursor",
+				},
+			},
+
+			expect: &apimodels.LoglinesPage{
+				Items: []apimodels.LoglinePreview{
 					{
 						Slug:      "slug-1",
 						Name:      "Logline 1",
 						Content:   "Logline 1 content",
-						Lang:      models.LangEN,
+						Lang:      apimodels.LangEn,
 						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 					},
 					{
 						Slug:      "slug-2",
 						Name:      "Logline 2",
 						Content:   "Logline 2 content",
-						Lang:      models.LangEN,
+						Lang:      apimodels.LangEn,
 						CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 					},
 				},
+				NextCursor: apimodels.NewOptString("next-cursor"),
 			},
+		},
+		{
+			name: "LastPage",
+
+			params: params,
 
-			expect: &apimodels.GetLoglinesOKApplicationJSON{
-				{
-					Slug:      "slug-1",
-					Name:      "Logline 1",
-					Content:   "Logline 1 content",
-					Lang:      apimodels.LangEn,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			listLoglinesData: &listLoglinesData{
+				resp: &models.Page[*models.LoglinePreview]{
+					Items: []*models.LoglinePreview{
+						{
+							Slug:      "slug-1",
+							Name:      "Logline 1",
+							Content:   "Logline 1 content",
+							Lang:      models.LangEN,
+							CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+					},
 				},
-				{
-					Slug:      "slug-2",
-					Name:      "Logline 2",
-					Content:   "Logline 2 content",
-					Lang:      apimodels.LangEn,
-					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &apimodels.LoglinesPage{
+				Items: []apimodels.LoglinePreview{
+					{
+						Slug:      "slug-1",
+						Name:      "Logline 1",
+						Content:   "Logline 1 content",
+						Lang:      apimodels.LangEn,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
 				},
 			},
 		},
 		{
-			name: "Error",
+			name: "Filters",
+
+			params: apimodels.GetLoglinesParams{
+				Genre:     apimodels.NewOptGenre(apimodels.GenreFantasy),
+				Lang:      apimodels.NewOptLang(apimodels.LangFr),
+				Tag:       []string{"quest", "dragons"},
+				ProjectID: apimodels.NewOptProjectID(apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-2000-000000000001"))),
+			},
+
+			listLoglinesData: &listLoglinesData{
+				resp: &models.Page[*models.LoglinePreview]{
+					Items: []*models.LoglinePreview{
+						{
+							Slug:      "slug-1",
+							ProjectID: uuid.MustParse("00000000-0000-0000-2000-000000000001"),
+							Name:      "Logline 1",
+							Content:   "Logline 1 content",
+							Lang:      models.LangFR,
+							Genre:     models.GenreFantasy,
+							Tags:      []string{"dragons", "quest"},
+							CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+					},
+				},
+			},
+
+			expect: &apimodels.LoglinesPage{
+				Items: []apimodels.LoglinePreview{
+					{
+						Slug:      "slug-1",
+						ProjectID: apimodels.NewOptProjectID(apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-2000-000000000001"))),
+						Name:      "Logline 1",
+						Content:   "Logline 1 content",
+						Lang:      apimodels.LangFr,
+						Genre:     apimodels.NewOptGenre(apimodels.GenreFantasy),
+						Tags:      apimodels.Tags{"dragons", "quest"},
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+		},
+		{
+			name: "InvalidTags",
 
 			params: apimodels.GetLoglinesParams{
-				Limit:  apimodels.OptInt{Value: 10, Set: true},
-				Offset: apimodels.OptInt{Value: 2, Set: true},
+				Tag: []string{"a very long tag that goes way past the limit"},
+			},
+
+			listLoglinesData: &listLoglinesData{
+				err: models.ErrInvalidTags,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: models.ErrInvalidTags.Error()},
+		},
+		{
+			name: "InvalidCursor",
+
+			params: params,
+
+			listLoglinesData: &listLoglinesData{
+				err: models.ErrInvalidCursor,
 			},
 
+			expect: &apimodels.UnprocessableEntityError{Error: models.ErrInvalidCursor.Error()},
+		},
+		{
+			name: "Error",
+
+			params: params,
+
 			listLoglinesData: &listLoglinesData{
 				err: errFoo,
 			},
@@ -114,9 +217,14 @@ func TestListLoglines(t *testing.T) {
 			if testCase.listLoglinesData != nil {
 				source.EXPECT().
 					ListLoglines(mock.Anything, services.ListLoglinesRequest{
-						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
-						Limit:  testCase.params.Limit.Value,
-						Offset: testCase.params.Offset.Value,
+						UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Sort:      models.ListSort(testCase.params.Sort.Value),
+						Cursor:    testCase.params.Cursor.Value,
+						Limit:     testCase.params.Limit.Value,
+						Genre:     models.Genre(testCase.params.Genre.Value),
+						Lang:      models.Lang(testCase.params.Lang.Value),
+						Tags:      testCase.params.Tag,
+						ProjectID: uuid.UUID(testCase.params.ProjectID.Value),
 					}).
 					Return(testCase.listLoglinesData.resp, testCase.listLoglinesData.err)
 			}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type ListProjectsService interface {
+	ListProjects(ctx context.Context, request services.ListProjectsRequest) (*models.Page[*models.Project], error)
+}
+
+func (api *API) GetProjects(ctx context.Context, params apimodels.GetProjectsParams) (apimodels.GetProjectsRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.GetProjects")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	projects, err := api.ListProjectsService.ListProjects(ctx, services.ListProjectsRequest{
+		UserID: userID,
+		Cursor: params.Cursor.Value,
+		Limit:  params.Limit.Value,
+	})
+
+	switch {
+	case errors.Is(err, models.ErrInvalidCursor):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		return nil, otel.ReportError(span, fmt.Errorf("list projects: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &apimodels.ProjectsPage{
+		Items: lo.Map(projects.Items, func(item *models.Project, _ int) apimodels.Project {
+			return *projectToAPI(item)
+		}),
+		NextCursor: lo.Ternary(
+			projects.NextCursor != "", apimodels.NewOptString(projects.NextCursor), apimodels.OptString{},
+		),
+	}), nil
+}
@@ -0,0 +1,145 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestGetProjects(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type listProjectsData struct {
+		resp *models.Page[*models.Project]
+		err  error
+	}
+
+	params := apimodels.GetProjectsParams{
+		Cursor: apimodels.NewOptString("test-cursor"),
+		Limit:  apimodels.NewOptInt(2),
+	}
+
+	testCases := []struct {
+		name string
+
+		params apimodels.GetProjectsParams
+
+		listProjectsData *listProjectsData
+
+		expect    apimodels.GetProjectsRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			params: params,
+
+			listProjectsData: &listProjectsData{
+				resp: &models.Page[*models.Project]{
+					Items: []*models.Project{
+						{
+							ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+							UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+							Name:      "A Mountain Saga",
+							CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+						},
+						{
+							ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							UserID:      uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+							Name:        "The Sea Trilogy",
+							Description: "Three novels about the sea.",
+							CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+							UpdatedAt:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+						},
+					},
+					NextCursor: "next-cursor",
+				},
+			},
+
+			expect: &apimodels.ProjectsPage{
+				Items: []apimodels.Project{
+					{
+						ID:        apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+						UserID:    apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+						Name:      "A Mountain Saga",
+						CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:          apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						UserID:      apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+						Name:        "The Sea Trilogy",
+						Description: "Three novels about the sea.",
+						CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:   apimodels.NewOptDateTime(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+					},
+				},
+				NextCursor: apimodels.NewOptString("next-cursor"),
+			},
+		},
+		{
+			name: "InvalidCursor",
+
+			params: params,
+
+			listProjectsData: &listProjectsData{err: models.ErrInvalidCursor},
+
+			expect: &apimodels.UnprocessableEntityError{Error: models.ErrInvalidCursor.Error()},
+		},
+		{
+			name: "Error",
+
+			params: params,
+
+			listProjectsData: &listProjectsData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockListProjectsService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.listProjectsData != nil {
+				source.EXPECT().
+					ListProjects(mock.Anything, services.ListProjectsRequest{
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Cursor: testCase.params.Cursor.Value,
+						Limit:  testCase.params.Limit.Value,
+					}).
+					Return(testCase.listProjectsData.resp, testCase.listProjectsData.err)
+			}
+
+			handler := api.API{ListProjectsService: source}
+
+			res, err := handler.GetProjects(ctx, testCase.params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
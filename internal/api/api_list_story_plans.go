@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type ListStoryPlansService interface {
+	ListStoryPlans(ctx context.Context, request services.ListStoryPlansRequest) ([]*storyplanmodel.Metadata, error)
+}
+
+func (api *API) GetStoryPlans(
+	ctx context.Context, params apimodels.GetStoryPlansParams,
+) (apimodels.GetStoryPlansRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.GetStoryPlans")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	storyPlans, err := api.ListStoryPlansService.ListStoryPlans(ctx, services.ListStoryPlansRequest{
+		UserID: userID,
+		Limit:  params.Limit.Value,
+		Offset: params.Offset.Value,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list story plans: %w", err))
+	}
+
+	res := apimodels.GetStoryPlansOKApplicationJSON(
+		lo.Map(storyPlans, func(item *storyplanmodel.Metadata, _ int) apimodels.StoryPlanPreview {
+			return apimodels.StoryPlanPreview{
+				ID:        apimodels.StoryPlanID(item.ID),
+				Slug:      apimodels.Slug(item.Slug),
+				Version:   item.Version,
+				UserID:    userIDToAPI(item.UserID),
+				Name:      item.Name,
+				Lang:      apimodels.Lang(item.Lang),
+				CreatedAt: item.CreatedAt,
+			}
+		}),
+	)
+
+	return otel.ReportSuccess(span, &res), nil
+}
@@ -0,0 +1,142 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestListStoryPlans(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type listStoryPlansData struct {
+		resp []*storyplanmodel.Metadata
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		params apimodels.GetStoryPlansParams
+
+		listStoryPlansData *listStoryPlansData
+
+		expect    apimodels.GetStoryPlansRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			params: apimodels.GetStoryPlansParams{
+				Limit:  apimodels.NewOptInt(10),
+				Offset: apimodels.NewOptInt(2),
+			},
+
+			listStoryPlansData: &listStoryPlansData{
+				resp: []*storyplanmodel.Metadata{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Slug:      "test-slug",
+						Version:   1,
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						UserID:    lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+						Slug:      "test-slug",
+						Version:   1,
+						Name:      "Nom de Test",
+						Lang:      models.LangFR,
+						CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: &apimodels.GetStoryPlansOKApplicationJSON{
+				{
+					ID:        apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Slug:      "test-slug",
+					Version:   1,
+					Name:      "Test Name",
+					Lang:      apimodels.LangEn,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:      apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					Slug:    "test-slug",
+					Version: 1,
+					UserID: apimodels.NewOptUserID(
+						apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+					),
+					Name:      "Nom de Test",
+					Lang:      apimodels.LangFr,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Error",
+
+			params: apimodels.GetStoryPlansParams{
+				Limit:  apimodels.NewOptInt(10),
+				Offset: apimodels.NewOptInt(2),
+			},
+
+			listStoryPlansData: &listStoryPlansData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockListStoryPlansService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.listStoryPlansData != nil {
+				source.EXPECT().
+					ListStoryPlans(mock.Anything, services.ListStoryPlansRequest{
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Limit:  testCase.params.Limit.Value,
+						Offset: testCase.params.Offset.Value,
+					}).
+					Return(testCase.listStoryPlansData.resp, testCase.listStoryPlansData.err)
+			}
+
+			handler := api.API{ListStoryPlansService: source}
+
+			res, err := handler.GetStoryPlans(ctx, testCase.params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
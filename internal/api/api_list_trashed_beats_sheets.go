@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type ListTrashedBeatsSheetsService interface {
+	ListTrashedBeatsSheets(
+		ctx context.Context, request services.ListTrashedBeatsSheetsRequest,
+	) ([]*models.TrashedBeatsSheet, error)
+}
+
+func (api *API) GetTrashedBeatsSheets(
+	ctx context.Context, params apimodels.GetTrashedBeatsSheetsParams,
+) (apimodels.GetTrashedBeatsSheetsRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.GetTrashedBeatsSheets")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	beatsSheets, err := api.ListTrashedBeatsSheetsService.ListTrashedBeatsSheets(
+		ctx, services.ListTrashedBeatsSheetsRequest{
+			UserID: userID,
+			Limit:  params.Limit.Value,
+			Offset: params.Offset.Value,
+		},
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list trashed beats sheets: %w", err))
+	}
+
+	res := apimodels.GetTrashedBeatsSheetsOKApplicationJSON(
+		lo.Map(beatsSheets, func(item *models.TrashedBeatsSheet, _ int) apimodels.TrashedBeatsSheet {
+			return *trashedBeatsSheetToAPI(item)
+		}),
+	)
+
+	return otel.ReportSuccess(span, &res), nil
+}
@@ -0,0 +1,133 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestListTrashedBeatsSheets(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type listTrashedBeatsSheetsData struct {
+		resp []*models.TrashedBeatsSheet
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		params apimodels.GetTrashedBeatsSheetsParams
+
+		listTrashedBeatsSheetsData *listTrashedBeatsSheetsData
+
+		expect    apimodels.GetTrashedBeatsSheetsRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			params: apimodels.GetTrashedBeatsSheetsParams{
+				Limit:  apimodels.OptInt{Value: 10, Set: true},
+				Offset: apimodels.OptInt{Value: 2, Set: true},
+			},
+
+			listTrashedBeatsSheetsData: &listTrashedBeatsSheetsData{
+				resp: []*models.TrashedBeatsSheet{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						DeletedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+						Lang:      models.LangFR,
+						CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+						DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: &apimodels.GetTrashedBeatsSheetsOKApplicationJSON{
+				{
+					ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+					Lang:      apimodels.LangEn,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+					Lang:      apimodels.LangFr,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Error",
+
+			params: apimodels.GetTrashedBeatsSheetsParams{
+				Limit:  apimodels.OptInt{Value: 10, Set: true},
+				Offset: apimodels.OptInt{Value: 2, Set: true},
+			},
+
+			listTrashedBeatsSheetsData: &listTrashedBeatsSheetsData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockListTrashedBeatsSheetsService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.listTrashedBeatsSheetsData != nil {
+				source.EXPECT().
+					ListTrashedBeatsSheets(mock.Anything, services.ListTrashedBeatsSheetsRequest{
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Limit:  testCase.params.Limit.Value,
+						Offset: testCase.params.Offset.Value,
+					}).
+					Return(testCase.listTrashedBeatsSheetsData.resp, testCase.listTrashedBeatsSheetsData.err)
+			}
+
+			handler := api.API{ListTrashedBeatsSheetsService: source}
+
+			res, err := handler.GetTrashedBeatsSheets(ctx, testCase.params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type ListTrashedLoglinesService interface {
+	ListTrashedLoglines(ctx context.Context, request services.ListTrashedLoglinesRequest) ([]*models.Logline, error)
+}
+
+func (api *API) GetTrashedLoglines(
+	ctx context.Context, params apimodels.GetTrashedLoglinesParams,
+) (apimodels.GetTrashedLoglinesRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.GetTrashedLoglines")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	loglines, err := api.ListTrashedLoglinesService.ListTrashedLoglines(ctx, services.ListTrashedLoglinesRequest{
+		UserID: userID,
+		Limit:  params.Limit.Value,
+		Offset: params.Offset.Value,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list trashed loglines: %w", err))
+	}
+
+	res := apimodels.GetTrashedLoglinesOKApplicationJSON(
+		lo.Map(loglines, func(item *models.Logline, _ int) apimodels.Logline {
+			return *loglineToAPI(item)
+		}),
+	)
+
+	return otel.ReportSuccess(span, &res), nil
+}
@@ -0,0 +1,145 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestListTrashedLoglines(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type listTrashedLoglinesData struct {
+		resp []*models.Logline
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		params apimodels.GetTrashedLoglinesParams
+
+		listTrashedLoglinesData *listTrashedLoglinesData
+
+		expect    apimodels.GetTrashedLoglinesRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			params: apimodels.GetTrashedLoglinesParams{
+				Limit:  apimodels.OptInt{Value: 10, Set: true},
+				Offset: apimodels.OptInt{Value: 2, Set: true},
+			},
+
+			listTrashedLoglinesData: &listTrashedLoglinesData{
+				resp: []*models.Logline{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Slug:      "slug-1",
+						Name:      "Logline 1",
+						Content:   "Logline 1 content",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						DeletedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Slug:      "slug-2",
+						Name:      "Logline 2",
+						Content:   "Logline 2 content",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+						DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: &apimodels.GetTrashedLoglinesOKApplicationJSON{
+				{
+					ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					UserID:    apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+					Slug:      "slug-1",
+					Name:      "Logline 1",
+					Content:   "Logline 1 content",
+					Lang:      apimodels.LangEn,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: apimodels.NewOptDateTime(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+				},
+				{
+					ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					UserID:    apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+					Slug:      "slug-2",
+					Name:      "Logline 2",
+					Content:   "Logline 2 content",
+					Lang:      apimodels.LangEn,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					DeletedAt: apimodels.NewOptDateTime(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name: "Error",
+
+			params: apimodels.GetTrashedLoglinesParams{
+				Limit:  apimodels.OptInt{Value: 10, Set: true},
+				Offset: apimodels.OptInt{Value: 2, Set: true},
+			},
+
+			listTrashedLoglinesData: &listTrashedLoglinesData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockListTrashedLoglinesService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.listTrashedLoglinesData != nil {
+				source.EXPECT().
+					ListTrashedLoglines(mock.Anything, services.ListTrashedLoglinesRequest{
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Limit:  testCase.params.Limit.Value,
+						Offset: testCase.params.Offset.Value,
+					}).
+					Return(testCase.listTrashedLoglinesData.resp, testCase.listTrashedLoglinesData.err)
+			}
+
+			handler := api.API{ListTrashedLoglinesService: source}
+
+			res, err := handler.GetTrashedLoglines(ctx, testCase.params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
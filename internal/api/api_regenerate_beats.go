@@ -15,10 +15,11 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	"github.com/a-novel/service-story-schematics/models"
 	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 type RegenerateBeatsService interface {
-	RegenerateBeats(ctx context.Context, request services.RegenerateBeatsRequest) ([]models.Beat, error)
+	RegenerateBeats(ctx context.Context, request services.RegenerateBeatsRequest) (*models.RegeneratedBeats, error)
 }
 
 func (api *API) RegenerateBeats(
@@ -32,26 +33,36 @@ func (api *API) RegenerateBeats(
 		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
 	}
 
-	beats, err := api.RegenerateBeatsService.RegenerateBeats(ctx, services.RegenerateBeatsRequest{
+	regenerated, err := api.RegenerateBeatsService.RegenerateBeats(ctx, services.RegenerateBeatsRequest{
 		BeatsSheetID:   uuid.UUID(req.GetBeatsSheetID()),
 		UserID:         userID,
 		RegenerateKeys: req.GetRegenerateKeys(),
+		Apply:          req.GetApply().Or(false),
 	})
 
 	switch {
 	case errors.Is(err, dao.ErrBeatsSheetNotFound),
 		errors.Is(err, dao.ErrLoglineNotFound),
-		errors.Is(err, services.ErrStoryPlanNotFound):
+		errors.Is(err, dao.ErrStoryPlanNotFound):
 		_ = otel.ReportError(span, err)
 
 		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case errors.Is(err, storyplanmodel.ErrInvalidPlan):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
 	case err != nil:
 		_ = otel.ReportError(span, err)
 
 		return nil, fmt.Errorf("regenerate beats: %w", err)
 	}
 
-	var res apimodels.Beats = lo.Map(beats, func(item models.Beat, _ int) apimodels.Beat {
+	// Applied beats are returned as the new revision of the sheet.
+	if regenerated.BeatsSheet != nil {
+		return otel.ReportSuccess(span, beatsSheetToAPI(regenerated.BeatsSheet)), nil
+	}
+
+	var res apimodels.Beats = lo.Map(regenerated.Beats, func(item models.Beat, _ int) apimodels.Beat {
 		return apimodels.Beat{
 			Key:     item.Key,
 			Title:   item.Title,
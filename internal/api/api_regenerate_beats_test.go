@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -19,6 +20,7 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	"github.com/a-novel/service-story-schematics/models"
 	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 func TestRegenerateBeats(t *testing.T) {
@@ -27,7 +29,7 @@ func TestRegenerateBeats(t *testing.T) {
 	errFoo := errors.New("foo")
 
 	type regenerateBeatsData struct {
-		resp []models.Beat
+		resp *models.RegeneratedBeats
 		err  error
 	}
 
@@ -53,16 +55,18 @@ func TestRegenerateBeats(t *testing.T) {
 			},
 
 			regenerateBeatsData: &regenerateBeatsData{
-				resp: []models.Beat{
-					{
-						Key:     "beat-1",
-						Title:   "Regenerated Beat 1",
-						Content: "Regenerated Content 1",
-					},
-					{
-						Key:     "beat-2",
-						Title:   "Regenerated Beat 2",
-						Content: "Regenerated Content 2",
+				resp: &models.RegeneratedBeats{
+					Beats: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Regenerated Beat 1",
+							Content: "Regenerated Content 1",
+						},
+						{
+							Key:     "beat-2",
+							Title:   "Regenerated Beat 2",
+							Content: "Regenerated Content 2",
+						},
 					},
 				},
 			},
@@ -80,6 +84,70 @@ func TestRegenerateBeats(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Apply",
+
+			form: &apimodels.RegenerateBeatsForm{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				RegenerateKeys: []string{
+					"beat-1",
+				},
+				Apply: apimodels.NewOptBool(true),
+			},
+
+			regenerateBeatsData: &regenerateBeatsData{
+				resp: &models.RegeneratedBeats{
+					Beats: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Regenerated Beat 1",
+							Content: "Regenerated Content 1",
+						},
+					},
+					BeatsSheet: &models.BeatsSheet{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+						ParentID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Content: []models.Beat{
+							{
+								Key:     "beat-1",
+								Title:   "Regenerated Beat 1",
+								Content: "Regenerated Content 1",
+							},
+							{
+								Key:     "beat-2",
+								Title:   "Beat 2",
+								Content: "Content 2",
+							},
+						},
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: &apimodels.BeatsSheet{
+				ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-1000-0000-000000000001")),
+				ParentID: apimodels.NewOptBeatsSheetID(
+					apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				),
+				Content: []apimodels.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Regenerated Beat 1",
+						Content: "Regenerated Content 1",
+					},
+					{
+						Key:     "beat-2",
+						Title:   "Beat 2",
+						Content: "Content 2",
+					},
+				},
+				Lang:      apimodels.LangEn,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
 		{
 			name: "BeatsSheetNotFound",
 
@@ -126,10 +194,27 @@ func TestRegenerateBeats(t *testing.T) {
 			},
 
 			regenerateBeatsData: &regenerateBeatsData{
-				err: services.ErrStoryPlanNotFound,
+				err: dao.ErrStoryPlanNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrStoryPlanNotFound.Error()},
+		},
+		{
+			name: "InvalidPlan",
+
+			form: &apimodels.RegenerateBeatsForm{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				RegenerateKeys: []string{
+					"beat-1",
+				},
+				Apply: apimodels.NewOptBool(true),
+			},
+
+			regenerateBeatsData: &regenerateBeatsData{
+				err: storyplanmodel.ErrInvalidPlan,
 			},
 
-			expect: &apimodels.NotFoundError{Error: services.ErrStoryPlanNotFound.Error()},
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrInvalidPlan.Error()},
 		},
 		{
 			name: "Error",
@@ -166,6 +251,7 @@ func TestRegenerateBeats(t *testing.T) {
 						BeatsSheetID:   uuid.UUID(testCase.form.GetBeatsSheetID()),
 						UserID:         uuid.MustParse("00000000-1000-0000-0000-000000000001"),
 						RegenerateKeys: testCase.form.GetRegenerateKeys(),
+						Apply:          testCase.form.GetApply().Or(false),
 					}).
 					Return(testCase.regenerateBeatsData.resp, testCase.regenerateBeatsData.err)
 			}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type RestoreBeatsSheetService interface {
+	RestoreBeatsSheet(ctx context.Context, request services.RestoreBeatsSheetRequest) (*models.BeatsSheet, error)
+}
+
+func (api *API) RestoreBeatsSheet(
+	ctx context.Context, req *apimodels.RestoreBeatsSheetForm,
+) (apimodels.RestoreBeatsSheetRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.RestoreBeatsSheet")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	beatsSheet, err := api.RestoreBeatsSheetService.RestoreBeatsSheet(ctx, services.RestoreBeatsSheetRequest{
+		BeatsSheetID: uuid.UUID(req.GetID()),
+		UserID:       userID,
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrBeatsSheetNotFound), errors.Is(err, dao.ErrLoglineNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("restore beats sheet: %w", err)
+	}
+
+	return otel.ReportSuccess(span, beatsSheetToAPI(beatsSheet)), nil
+}
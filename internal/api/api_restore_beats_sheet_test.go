@@ -0,0 +1,167 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestRestoreBeatsSheet(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type restoreBeatsSheetData struct {
+		resp *models.BeatsSheet
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.RestoreBeatsSheetForm
+
+		restoreBeatsSheetData *restoreBeatsSheetData
+
+		expect    apimodels.RestoreBeatsSheetRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: &apimodels.RestoreBeatsSheetForm{
+				ID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			restoreBeatsSheetData: &restoreBeatsSheetData{
+				resp: &models.BeatsSheet{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "test-beat",
+							Title:   "Test Beat",
+							Content: "Test Beat Content",
+						},
+						{
+							Key:     "test-beat-2",
+							Title:   "Test Beat 2",
+							Content: "Test Beat Content 2",
+						},
+					},
+					Lang: models.LangEN,
+					Acts: []models.BeatsSheetAct{
+						{Key: "act-1", Name: "Act 1", Beats: []string{"test-beat", "test-beat-2"}},
+					},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.BeatsSheet{
+				ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				Content: []apimodels.Beat{
+					{
+						Key:     "test-beat",
+						Title:   "Test Beat",
+						Content: "Test Beat Content",
+					},
+					{
+						Key:     "test-beat-2",
+						Title:   "Test Beat 2",
+						Content: "Test Beat Content 2",
+					},
+				},
+				Lang: apimodels.LangEn,
+				Acts: []apimodels.BeatsSheetAct{
+					{Key: "act-1", Name: "Act 1", Beats: []string{"test-beat", "test-beat-2"}},
+				},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "BeatsSheetNotFound",
+
+			form: &apimodels.RestoreBeatsSheetForm{
+				ID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			restoreBeatsSheetData: &restoreBeatsSheetData{
+				err: dao.ErrBeatsSheetNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrBeatsSheetNotFound.Error()},
+		},
+		{
+			name: "LoglineNotFound",
+
+			form: &apimodels.RestoreBeatsSheetForm{
+				ID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			restoreBeatsSheetData: &restoreBeatsSheetData{
+				err: dao.ErrLoglineNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "Error",
+
+			form: &apimodels.RestoreBeatsSheetForm{
+				ID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			restoreBeatsSheetData: &restoreBeatsSheetData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockRestoreBeatsSheetService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.restoreBeatsSheetData != nil {
+				source.EXPECT().
+					RestoreBeatsSheet(mock.Anything, services.RestoreBeatsSheetRequest{
+						BeatsSheetID: uuid.UUID(testCase.form.ID),
+						UserID:       uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					}).
+					Return(testCase.restoreBeatsSheetData.resp, testCase.restoreBeatsSheetData.err)
+			}
+
+			handler := api.API{RestoreBeatsSheetService: source}
+
+			res, err := handler.RestoreBeatsSheet(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type RestoreLoglineService interface {
+	RestoreLogline(ctx context.Context, request services.RestoreLoglineRequest) (*models.Logline, error)
+}
+
+func (api *API) RestoreLogline(
+	ctx context.Context, req *apimodels.RestoreLoglineForm,
+) (apimodels.RestoreLoglineRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.RestoreLogline")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	logline, err := api.RestoreLoglineService.RestoreLogline(ctx, services.RestoreLoglineRequest{
+		ID:     uuid.UUID(req.GetID()),
+		UserID: userID,
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrLoglineNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("restore logline: %w", err)
+	}
+
+	return otel.ReportSuccess(span, loglineToAPI(logline)), nil
+}
@@ -0,0 +1,143 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestRestoreLoglineRevision(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type restoreLoglineRevisionData struct {
+		resp *models.Logline
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.RestoreLoglineRevisionForm
+
+		restoreLoglineRevisionData *restoreLoglineRevisionData
+
+		expect    apimodels.RestoreLoglineRevisionRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: &apimodels.RestoreLoglineRevisionForm{
+				ID: apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+			},
+
+			restoreLoglineRevisionData: &restoreLoglineRevisionData{
+				resp: &models.Logline{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Logline{
+				ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				Slug:      "test-slug",
+				Name:      "Test Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      apimodels.LangEn,
+				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "RevisionNotFound",
+
+			form: &apimodels.RestoreLoglineRevisionForm{
+				ID: apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+			},
+
+			restoreLoglineRevisionData: &restoreLoglineRevisionData{
+				err: dao.ErrLoglineRevisionNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineRevisionNotFound.Error()},
+		},
+		{
+			name: "LoglineNotFound",
+
+			form: &apimodels.RestoreLoglineRevisionForm{
+				ID: apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+			},
+
+			restoreLoglineRevisionData: &restoreLoglineRevisionData{
+				err: dao.ErrLoglineNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "Error",
+
+			form: &apimodels.RestoreLoglineRevisionForm{
+				ID: apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+			},
+
+			restoreLoglineRevisionData: &restoreLoglineRevisionData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockRestoreLoglineRevisionService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.restoreLoglineRevisionData != nil {
+				source.EXPECT().
+					RestoreLoglineRevision(mock.Anything, services.RestoreLoglineRevisionRequest{
+						ID:     uuid.UUID(testCase.form.ID),
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					}).
+					Return(testCase.restoreLoglineRevisionData.resp, testCase.restoreLoglineRevisionData.err)
+			}
+
+			handler := api.API{RestoreLoglineRevisionService: source}
+
+			res, err := handler.RestoreLoglineRevision(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
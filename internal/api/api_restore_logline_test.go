@@ -0,0 +1,130 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestRestoreLogline(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type restoreLoglineData struct {
+		resp *models.Logline
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.RestoreLoglineForm
+
+		restoreLoglineData *restoreLoglineData
+
+		expect    apimodels.RestoreLoglineRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: &apimodels.RestoreLoglineForm{
+				ID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			restoreLoglineData: &restoreLoglineData{
+				resp: &models.Logline{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Logline{
+				ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				Slug:      "test-slug",
+				Name:      "Test Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      apimodels.LangEn,
+				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "LoglineNotFound",
+
+			form: &apimodels.RestoreLoglineForm{
+				ID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			restoreLoglineData: &restoreLoglineData{
+				err: dao.ErrLoglineNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "Error",
+
+			form: &apimodels.RestoreLoglineForm{
+				ID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			restoreLoglineData: &restoreLoglineData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockRestoreLoglineService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.restoreLoglineData != nil {
+				source.EXPECT().
+					RestoreLogline(mock.Anything, services.RestoreLoglineRequest{
+						ID:     uuid.UUID(testCase.form.ID),
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					}).
+					Return(testCase.restoreLoglineData.resp, testCase.restoreLoglineData.err)
+			}
+
+			handler := api.API{RestoreLoglineService: source}
+
+			res, err := handler.RestoreLogline(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, request services.SearchRequest) ([]*models.SearchHit, error)
+}
+
+func (api *API) Search(ctx context.Context, params apimodels.SearchParams) (apimodels.SearchRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.Search")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	hits, err := api.SearchService.Search(ctx, services.SearchRequest{
+		UserID: userID,
+		Query:  params.Query,
+		Lang:   models.Lang(params.Lang.Value),
+		Limit:  params.Limit.Value,
+		Offset: params.Offset.Value,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("search: %w", err))
+	}
+
+	res := apimodels.SearchOKApplicationJSON(
+		lo.Map(hits, func(item *models.SearchHit, _ int) apimodels.SearchHit {
+			return apimodels.SearchHit{
+				Kind:      apimodels.SearchHitKind(item.Kind),
+				ID:        item.ID,
+				LoglineID: apimodels.LoglineID(item.LoglineID),
+				Slug:      apimodels.Slug(item.Slug),
+				Name:      item.Name,
+				Lang:      apimodels.Lang(item.Lang),
+				Rank:      item.Rank,
+				Snippet:   item.Snippet,
+				CreatedAt: item.CreatedAt,
+			}
+		}),
+	)
+
+	return otel.ReportSuccess(span, &res), nil
+}
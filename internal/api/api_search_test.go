@@ -0,0 +1,152 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type searchData struct {
+		resp []*models.SearchHit
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		params apimodels.SearchParams
+
+		searchData *searchData
+
+		expect    apimodels.SearchRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			params: apimodels.SearchParams{
+				Query:  "lighthouse",
+				Lang:   apimodels.NewOptLang(apimodels.LangEn),
+				Limit:  apimodels.OptInt{Value: 10, Set: true},
+				Offset: apimodels.OptInt{Value: 2, Set: true},
+			},
+
+			searchData: &searchData{
+				resp: []*models.SearchHit{
+					{
+						Kind:      models.SearchHitKindLogline,
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Slug:      "slug-1",
+						Name:      "Logline 1",
+						Lang:      models.LangEN,
+						Rank:      0.6,
+						Snippet:   "A keeper of the <mark>lighthouse</mark>",
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						Kind:      models.SearchHitKindBeatsSheet,
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Slug:      "slug-1",
+						Name:      "Logline 1",
+						Lang:      models.LangEN,
+						Rank:      0.1,
+						Snippet:   "The <mark>lighthouse</mark> goes dark",
+						CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: &apimodels.SearchOKApplicationJSON{
+				{
+					Kind:      apimodels.SearchHitKindLogline,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Slug:      "slug-1",
+					Name:      "Logline 1",
+					Lang:      apimodels.LangEn,
+					Rank:      0.6,
+					Snippet:   "A keeper of the <mark>lighthouse</mark>",
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Kind:      apimodels.SearchHitKindBeatsSheet,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Slug:      "slug-1",
+					Name:      "Logline 1",
+					Lang:      apimodels.LangEn,
+					Rank:      0.1,
+					Snippet:   "The <mark>lighthouse</mark> goes dark",
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Error",
+
+			params: apimodels.SearchParams{
+				Query: "lighthouse",
+			},
+
+			searchData: &searchData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockSearchService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.searchData != nil {
+				source.EXPECT().
+					Search(mock.Anything, services.SearchRequest{
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Query:  testCase.params.Query,
+						Lang:   models.Lang(testCase.params.Lang.Value),
+						Limit:  testCase.params.Limit.Value,
+						Offset: testCase.params.Offset.Value,
+					}).
+					Return(testCase.searchData.resp, testCase.searchData.err)
+			}
+
+			handler := api.API{SearchService: source}
+
+			res, err := handler.Search(ctx, testCase.params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
-	"github.com/samber/lo"
 
 	"github.com/a-novel/golib/otel"
 	authpkg "github.com/a-novel/service-authentication/pkg"
@@ -48,17 +47,5 @@ func (api *API) GetBeatsSheet(
 		return nil, fmt.Errorf("get beats sheet: %w", err)
 	}
 
-	return otel.ReportSuccess(span, &apimodels.BeatsSheet{
-		ID:        apimodels.BeatsSheetID(beatsSheet.ID),
-		LoglineID: apimodels.LoglineID(beatsSheet.LoglineID),
-		Content: lo.Map(beatsSheet.Content, func(item models.Beat, _ int) apimodels.Beat {
-			return apimodels.Beat{
-				Key:     item.Key,
-				Title:   item.Title,
-				Content: item.Content,
-			}
-		}),
-		Lang:      apimodels.Lang(beatsSheet.Lang),
-		CreatedAt: beatsSheet.CreatedAt,
-	}), nil
+	return otel.ReportSuccess(span, beatsSheetToAPI(beatsSheet)), nil
 }
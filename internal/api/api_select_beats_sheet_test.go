@@ -65,7 +65,10 @@ func TestSelectBeatsSheet(t *testing.T) {
 							Content: "Test Beat Content 2",
 						},
 					},
-					Lang:      models.LangEN,
+					Lang: models.LangEN,
+					Acts: []models.BeatsSheetAct{
+						{Key: "act-1", Name: "Act 1", Beats: []string{"test-beat", "test-beat-2"}},
+					},
 					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
 			},
@@ -85,7 +88,10 @@ func TestSelectBeatsSheet(t *testing.T) {
 						Content: "Test Beat Content 2",
 					},
 				},
-				Lang:      apimodels.LangEn,
+				Lang: apimodels.LangEn,
+				Acts: []apimodels.BeatsSheetAct{
+					{Key: "act-1", Name: "Act 1", Beats: []string{"test-beat", "test-beat-2"}},
+				},
 				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
 		},
@@ -47,13 +47,5 @@ func (api *API) GetLogline(ctx context.Context, params apimodels.GetLoglineParam
 		return nil, fmt.Errorf("get logline: %w", err)
 	}
 
-	return otel.ReportSuccess(span, &apimodels.Logline{
-		ID:        apimodels.LoglineID(logline.ID),
-		UserID:    apimodels.UserID(logline.UserID),
-		Slug:      apimodels.Slug(logline.Slug),
-		Name:      logline.Name,
-		Content:   logline.Content,
-		Lang:      apimodels.Lang(logline.Lang),
-		CreatedAt: logline.CreatedAt,
-	}), nil
+	return otel.ReportSuccess(span, loglineToAPI(logline)), nil
 }
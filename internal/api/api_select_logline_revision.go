@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type SelectLoglineRevisionService interface {
+	SelectLoglineRevision(
+		ctx context.Context, request services.SelectLoglineRevisionRequest,
+	) (*models.LoglineRevision, error)
+}
+
+func (api *API) GetLoglineRevision(
+	ctx context.Context, params apimodels.GetLoglineRevisionParams,
+) (apimodels.GetLoglineRevisionRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.GetLoglineRevision")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	revision, err := api.SelectLoglineRevisionService.SelectLoglineRevision(ctx, services.SelectLoglineRevisionRequest{
+		ID:     uuid.UUID(params.RevisionID),
+		UserID: userID,
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrLoglineRevisionNotFound), errors.Is(err, dao.ErrLoglineNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("get logline revision: %w", err)
+	}
+
+	return otel.ReportSuccess(span, loglineRevisionToAPI(revision)), nil
+}
@@ -0,0 +1,141 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestSelectLoglineRevision(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectLoglineRevisionData struct {
+		resp *models.LoglineRevision
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		params apimodels.GetLoglineRevisionParams
+
+		selectLoglineRevisionData *selectLoglineRevisionData
+
+		expect    apimodels.GetLoglineRevisionRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			params: apimodels.GetLoglineRevisionParams{
+				RevisionID: apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			selectLoglineRevisionData: &selectLoglineRevisionData{
+				resp: &models.LoglineRevision{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.LoglineRevision{
+				ID:        apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				Name:      "Test Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      apimodels.LangEn,
+				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "RevisionNotFound",
+
+			params: apimodels.GetLoglineRevisionParams{
+				RevisionID: apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			selectLoglineRevisionData: &selectLoglineRevisionData{
+				err: dao.ErrLoglineRevisionNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineRevisionNotFound.Error()},
+		},
+		{
+			name: "LoglineNotFound",
+
+			params: apimodels.GetLoglineRevisionParams{
+				RevisionID: apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			selectLoglineRevisionData: &selectLoglineRevisionData{
+				err: dao.ErrLoglineNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "Error",
+
+			params: apimodels.GetLoglineRevisionParams{
+				RevisionID: apimodels.LoglineRevisionID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			selectLoglineRevisionData: &selectLoglineRevisionData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockSelectLoglineRevisionService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.selectLoglineRevisionData != nil {
+				source.EXPECT().
+					SelectLoglineRevision(mock.Anything, services.SelectLoglineRevisionRequest{
+						ID:     uuid.UUID(testCase.params.RevisionID),
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					}).
+					Return(testCase.selectLoglineRevisionData.resp, testCase.selectLoglineRevisionData.err)
+			}
+
+			handler := api.API{SelectLoglineRevisionService: source}
+
+			res, err := handler.GetLoglineRevision(ctx, testCase.params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
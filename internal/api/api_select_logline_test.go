@@ -91,21 +91,27 @@ func TestSelectLogline(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
 					Slug:      "test-slug",
+					SourceID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Name:      "Test Name 2",
 					Content:   "Lorem ipsum dolor sit amet 2",
 					Lang:      models.LangEN,
 					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
 				},
 			},
 
 			expect: &apimodels.Logline{
-				ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
-				UserID:    apimodels.UserID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
-				Slug:      "test-slug",
+				ID:     apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				UserID: apimodels.UserID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				Slug:   "test-slug",
+				SourceID: apimodels.NewOptLoglineID(
+					apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				),
 				Name:      "Test Name 2",
 				Content:   "Lorem ipsum dolor sit amet 2",
 				Lang:      apimodels.LangEn,
 				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: apimodels.NewOptDateTime(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)),
 			},
 		},
 		{
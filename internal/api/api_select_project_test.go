@@ -0,0 +1,120 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestGetProject(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectProjectData struct {
+		resp *models.Project
+		err  error
+	}
+
+	params := apimodels.GetProjectParams{
+		ProjectID: apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+	}
+
+	testCases := []struct {
+		name string
+
+		params apimodels.GetProjectParams
+
+		selectProjectData *selectProjectData
+
+		expect    apimodels.GetProjectRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			params: params,
+
+			selectProjectData: &selectProjectData{
+				resp: &models.Project{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:      uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Name:        "The Sea Trilogy",
+					Description: "Three novels about the sea.",
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Project{
+				ID:          apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				Name:        "The Sea Trilogy",
+				Description: "Three novels about the sea.",
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "ProjectNotFound",
+
+			params: params,
+
+			selectProjectData: &selectProjectData{err: dao.ErrProjectNotFound},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrProjectNotFound.Error()},
+		},
+		{
+			name: "Error",
+
+			params: params,
+
+			selectProjectData: &selectProjectData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockSelectProjectService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.selectProjectData != nil {
+				source.EXPECT().
+					SelectProject(mock.Anything, services.SelectProjectRequest{
+						ID:     uuid.UUID(testCase.params.ProjectID),
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					}).
+					Return(testCase.selectProjectData.resp, testCase.selectProjectData.err)
+			}
+
+			handler := api.API{SelectProjectService: source}
+
+			res, err := handler.GetProject(ctx, testCase.params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
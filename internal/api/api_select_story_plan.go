@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type SelectStoryPlanService interface {
+	SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)
+}
+
+func (api *API) GetStoryPlan(
+	ctx context.Context, params apimodels.GetStoryPlanParams,
+) (apimodels.GetStoryPlanRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.GetStoryPlan")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	storyPlan, err := api.SelectStoryPlanService.SelectStoryPlan(ctx, services.SelectStoryPlanRequest{
+		UserID: userID,
+		ID:     lo.Ternary(params.ID.IsSet(), lo.ToPtr(uuid.UUID(params.ID.Value)), nil),
+		Slug:   lo.Ternary(params.Slug.IsSet(), lo.ToPtr(models.Slug(params.Slug.Value)), nil),
+		Lang:   models.Lang(params.Lang.Or(apimodels.LangEn)),
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrStoryPlanNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case errors.Is(err, storyplanmodel.ErrUnsupportedLang):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("get story plan: %w", err)
+	}
+
+	return otel.ReportSuccess(span, storyPlanToAPI(storyPlan)), nil
+}
@@ -0,0 +1,259 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestSelectStoryPlan(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectStoryPlanData struct {
+		request services.SelectStoryPlanRequest
+
+		resp *storyplanmodel.Plan
+		err  error
+	}
+
+	plan := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Slug:      "test-slug",
+			Version:   1,
+			Name:      "Nom de Test",
+			Lang:      models.LangFR,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		Beats: []storyplanmodel.Beat{
+			{
+				Name:      "Battement de Test",
+				Key:       "test-beat",
+				KeyPoints: []string{"Point Clé de Test"},
+				Purpose:   "Objectif de Test",
+				Scenes:    storyplanmodel.Scenes{Min: lo.ToPtr(1), Max: lo.ToPtr(3)},
+			},
+		},
+	}
+
+	apiPlan := &apimodels.StoryPlan{
+		ID:      apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Slug:    "test-slug",
+		Version: 1,
+		Name:    "Nom de Test",
+		Lang:    apimodels.LangFr,
+		Beats: []apimodels.StoryPlanBeat{
+			{
+				Name:      "Battement de Test",
+				Key:       "test-beat",
+				KeyPoints: []string{"Point Clé de Test"},
+				Purpose:   "Objectif de Test",
+				Scenes:    apimodels.StoryPlanScenes{Min: apimodels.NewOptInt(1), Max: apimodels.NewOptInt(3)},
+			},
+		},
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		params apimodels.GetStoryPlanParams
+
+		selectStoryPlanData *selectStoryPlanData
+
+		expect    apimodels.GetStoryPlanRes
+		expectErr error
+	}{
+		{
+			name: "Success/ID",
+
+			params: apimodels.GetStoryPlanParams{
+				ID: apimodels.NewOptStoryPlanID(
+					apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				),
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				request: services.SelectStoryPlanRequest{
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Lang:   models.LangEN,
+				},
+				resp: plan,
+			},
+
+			expect: apiPlan,
+		},
+		{
+			name: "Success/Slug",
+
+			params: apimodels.GetStoryPlanParams{
+				Slug: apimodels.NewOptSlug("test-slug"),
+				Lang: apimodels.NewOptLang(apimodels.LangFr),
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				request: services.SelectStoryPlanRequest{
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Slug:   lo.ToPtr(models.Slug("test-slug")),
+					Lang:   models.LangFR,
+				},
+				resp: plan,
+			},
+
+			expect: apiPlan,
+		},
+		{
+			name: "Success/Default",
+
+			params: apimodels.GetStoryPlanParams{
+				Lang: apimodels.NewOptLang(apimodels.LangFr),
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				request: services.SelectStoryPlanRequest{
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Lang:   models.LangFR,
+				},
+				resp: plan,
+			},
+
+			expect: apiPlan,
+		},
+		{
+			name: "Success/Custom",
+
+			params: apimodels.GetStoryPlanParams{
+				ID: apimodels.NewOptStoryPlanID(
+					apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				),
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				request: services.SelectStoryPlanRequest{
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					Lang:   models.LangEN,
+				},
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						UserID:    lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+						Slug:      "test-slug",
+						Version:   1,
+						Name:      "Nom de Test",
+						Lang:      models.LangFR,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					Beats: plan.Beats,
+				},
+			},
+
+			expect: &apimodels.StoryPlan{
+				ID:        apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				Slug:      "test-slug",
+				Version:   1,
+				UserID:    apimodels.NewOptUserID(apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001"))),
+				Name:      "Nom de Test",
+				Lang:      apimodels.LangFr,
+				Beats:     apiPlan.Beats,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "NotFound",
+
+			params: apimodels.GetStoryPlanParams{
+				Slug: apimodels.NewOptSlug("test-slug"),
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				request: services.SelectStoryPlanRequest{
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Slug:   lo.ToPtr(models.Slug("test-slug")),
+					Lang:   models.LangEN,
+				},
+				err: dao.ErrStoryPlanNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrStoryPlanNotFound.Error()},
+		},
+		{
+			name: "UnsupportedLang",
+
+			params: apimodels.GetStoryPlanParams{
+				Lang: apimodels.NewOptLang(apimodels.LangDe),
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				request: services.SelectStoryPlanRequest{
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Lang:   models.LangDE,
+				},
+				err: storyplanmodel.ErrUnsupportedLang,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrUnsupportedLang.Error()},
+		},
+		{
+			name: "Error",
+
+			params: apimodels.GetStoryPlanParams{},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				request: services.SelectStoryPlanRequest{
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Lang:   models.LangEN,
+				},
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockSelectStoryPlanService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.selectStoryPlanData != nil {
+				source.EXPECT().
+					SelectStoryPlan(mock.Anything, testCase.selectStoryPlanData.request).
+					Return(testCase.selectStoryPlanData.resp, testCase.selectStoryPlanData.err)
+			}
+
+			handler := api.API{SelectStoryPlanService: source}
+
+			res, err := handler.GetStoryPlan(ctx, testCase.params)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
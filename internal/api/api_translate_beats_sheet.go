@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type TranslateBeatsSheetService interface {
+	TranslateBeatsSheet(ctx context.Context, request services.TranslateBeatsSheetRequest) (*models.BeatsSheet, error)
+}
+
+func (api *API) TranslateBeatsSheet(
+	ctx context.Context, req *apimodels.TranslateBeatsSheetForm,
+) (apimodels.TranslateBeatsSheetRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.TranslateBeatsSheet")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	beatsSheet, err := api.TranslateBeatsSheetService.TranslateBeatsSheet(ctx, services.TranslateBeatsSheetRequest{
+		BeatsSheetID: uuid.UUID(req.GetBeatsSheetID()),
+		UserID:       userID,
+		Lang:         models.Lang(req.GetLang()),
+		LoglineID: lo.Ternary(
+			req.GetLoglineID().IsSet(), lo.ToPtr(uuid.UUID(req.GetLoglineID().Value)), nil,
+		),
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrBeatsSheetNotFound),
+		errors.Is(err, dao.ErrLoglineNotFound),
+		errors.Is(err, dao.ErrStoryPlanNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case errors.Is(err, storyplanmodel.ErrInvalidPlan),
+		errors.Is(err, storyplanmodel.ErrUnsupportedLang),
+		errors.Is(err, services.ErrTranslationSameLang),
+		errors.Is(err, services.ErrTranslationLangMismatch),
+		errors.Is(err, daoai.ErrInvalidBeatSheet):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("translate beats sheet: %w", err)
+	}
+
+	return otel.ReportSuccess(span, &apimodels.BeatsSheet{
+		ID:        apimodels.BeatsSheetID(beatsSheet.ID),
+		LoglineID: apimodels.LoglineID(beatsSheet.LoglineID),
+		StoryPlanID: lo.Ternary(
+			beatsSheet.StoryPlanID != uuid.Nil,
+			apimodels.NewOptStoryPlanID(apimodels.StoryPlanID(beatsSheet.StoryPlanID)),
+			apimodels.OptStoryPlanID{},
+		),
+		SourceID: lo.Ternary(
+			beatsSheet.SourceID != uuid.Nil,
+			apimodels.NewOptBeatsSheetID(apimodels.BeatsSheetID(beatsSheet.SourceID)),
+			apimodels.OptBeatsSheetID{},
+		),
+		Content: lo.Map(beatsSheet.Content, func(item models.Beat, _ int) apimodels.Beat {
+			return apimodels.Beat{
+				Key:     item.Key,
+				Title:   item.Title,
+				Content: item.Content,
+			}
+		}),
+		Lang:      apimodels.Lang(beatsSheet.Lang),
+		Acts:      beatsSheetActsToAPI(beatsSheet.Acts),
+		CreatedAt: beatsSheet.CreatedAt,
+	}), nil
+}
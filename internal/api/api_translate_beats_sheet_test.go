@@ -0,0 +1,257 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestTranslateBeatsSheet(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type translateBeatsSheetData struct {
+		resp *models.BeatsSheet
+		err  error
+	}
+
+	form := &apimodels.TranslateBeatsSheetForm{
+		BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Lang:         apimodels.LangFr,
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.TranslateBeatsSheetForm
+
+		translateBeatsSheetData *translateBeatsSheetData
+
+		expect    apimodels.TranslateBeatsSheetRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: form,
+
+			translateBeatsSheetData: &translateBeatsSheetData{
+				resp: &models.BeatsSheet{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					SourceID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Content: []models.Beat{
+						{Key: "test-beat", Title: "Test Beat", Content: "Test Beat Content"},
+					},
+					Lang: models.LangFR,
+					Acts: []models.BeatsSheetAct{
+						{Key: "act-1", Name: "Act 1", Beats: []string{"test-beat"}},
+					},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.BeatsSheet{
+				ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				StoryPlanID: apimodels.NewOptStoryPlanID(
+					apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+				),
+				SourceID: apimodels.NewOptBeatsSheetID(
+					apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				),
+				Content: []apimodels.Beat{
+					{Key: "test-beat", Title: "Test Beat", Content: "Test Beat Content"},
+				},
+				Lang: apimodels.LangFr,
+				Acts: []apimodels.BeatsSheetAct{
+					{Key: "act-1", Name: "Act 1", Beats: []string{"test-beat"}},
+				},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/Logline",
+
+			form: &apimodels.TranslateBeatsSheetForm{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Lang:         apimodels.LangFr,
+				LoglineID: apimodels.NewOptLoglineID(
+					apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000002")),
+				),
+			},
+
+			translateBeatsSheetData: &translateBeatsSheetData{
+				resp: &models.BeatsSheet{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					SourceID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Content: []models.Beat{
+						{Key: "test-beat", Title: "Temps fort", Content: "Contenu du temps fort"},
+					},
+					Lang: models.LangFR,
+					Acts: []models.BeatsSheetAct{
+						{Key: "act-1", Name: "Acte 1", Beats: []string{"test-beat"}},
+					},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.BeatsSheet{
+				ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000002")),
+				StoryPlanID: apimodels.NewOptStoryPlanID(
+					apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+				),
+				SourceID: apimodels.NewOptBeatsSheetID(
+					apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				),
+				Content: []apimodels.Beat{
+					{Key: "test-beat", Title: "Temps fort", Content: "Contenu du temps fort"},
+				},
+				Lang: apimodels.LangFr,
+				Acts: []apimodels.BeatsSheetAct{
+					{Key: "act-1", Name: "Acte 1", Beats: []string{"test-beat"}},
+				},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "BeatsSheetNotFound",
+
+			form: form,
+
+			translateBeatsSheetData: &translateBeatsSheetData{err: dao.ErrBeatsSheetNotFound},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrBeatsSheetNotFound.Error()},
+		},
+		{
+			name: "LoglineNotFound",
+
+			form: form,
+
+			translateBeatsSheetData: &translateBeatsSheetData{err: dao.ErrLoglineNotFound},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "StoryPlanNotFound",
+
+			form: form,
+
+			translateBeatsSheetData: &translateBeatsSheetData{err: dao.ErrStoryPlanNotFound},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrStoryPlanNotFound.Error()},
+		},
+		{
+			name: "UnsupportedLang",
+
+			form: form,
+
+			translateBeatsSheetData: &translateBeatsSheetData{err: storyplanmodel.ErrUnsupportedLang},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrUnsupportedLang.Error()},
+		},
+		{
+			name: "SameLang",
+
+			form: form,
+
+			translateBeatsSheetData: &translateBeatsSheetData{err: services.ErrTranslationSameLang},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrTranslationSameLang.Error()},
+		},
+		{
+			name: "LangMismatch",
+
+			form: form,
+
+			translateBeatsSheetData: &translateBeatsSheetData{err: services.ErrTranslationLangMismatch},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrTranslationLangMismatch.Error()},
+		},
+		{
+			name: "InvalidPlan",
+
+			form: form,
+
+			translateBeatsSheetData: &translateBeatsSheetData{err: storyplanmodel.ErrInvalidPlan},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrInvalidPlan.Error()},
+		},
+		{
+			name: "InvalidBeatSheet",
+
+			form: form,
+
+			translateBeatsSheetData: &translateBeatsSheetData{err: daoai.ErrInvalidBeatSheet},
+
+			expect: &apimodels.UnprocessableEntityError{Error: daoai.ErrInvalidBeatSheet.Error()},
+		},
+		{
+			name: "Error",
+
+			form: form,
+
+			translateBeatsSheetData: &translateBeatsSheetData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockTranslateBeatsSheetService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.translateBeatsSheetData != nil {
+				source.EXPECT().
+					TranslateBeatsSheet(mock.Anything, services.TranslateBeatsSheetRequest{
+						BeatsSheetID: uuid.UUID(testCase.form.GetBeatsSheetID()),
+						UserID:       uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Lang:         models.Lang(testCase.form.GetLang()),
+						LoglineID: lo.Ternary(
+							testCase.form.GetLoglineID().IsSet(),
+							lo.ToPtr(uuid.UUID(testCase.form.GetLoglineID().Value)),
+							nil,
+						),
+					}).
+					Return(testCase.translateBeatsSheetData.resp, testCase.translateBeatsSheetData.err)
+			}
+
+			handler := api.API{TranslateBeatsSheetService: source}
+
+			res, err := handler.TranslateBeatsSheet(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
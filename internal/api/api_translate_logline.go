@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type TranslateLoglineService interface {
+	TranslateLogline(ctx context.Context, request services.TranslateLoglineRequest) (*models.Logline, error)
+}
+
+func (api *API) TranslateLogline(
+	ctx context.Context, req *apimodels.TranslateLoglineForm,
+) (apimodels.TranslateLoglineRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.TranslateLogline")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	logline, err := api.TranslateLoglineService.TranslateLogline(ctx, services.TranslateLoglineRequest{
+		LoglineID: uuid.UUID(req.GetLoglineID()),
+		UserID:    userID,
+		Lang:      models.Lang(req.GetLang()),
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrLoglineNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case errors.Is(err, storyplanmodel.ErrUnsupportedLang),
+		errors.Is(err, services.ErrTranslationSameLang):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("translate logline: %w", err)
+	}
+
+	return otel.ReportSuccess(span, loglineToAPI(logline)), nil
+}
@@ -0,0 +1,149 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestTranslateLogline(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type translateLoglineData struct {
+		resp *models.Logline
+		err  error
+	}
+
+	form := &apimodels.TranslateLoglineForm{
+		LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Lang:      apimodels.LangFr,
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.TranslateLoglineForm
+
+		translateLoglineData *translateLoglineData
+
+		expect    apimodels.TranslateLoglineRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: form,
+
+			translateLoglineData: &translateLoglineData{
+				resp: &models.Logline{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Slug:      "test-slug-fr",
+					SourceID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Name:      "Nom de test",
+					Content:   "Il était une fois",
+					Lang:      models.LangFR,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Logline{
+				ID:     apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				UserID: apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				Slug:   "test-slug-fr",
+				SourceID: apimodels.NewOptLoglineID(
+					apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				),
+				Name:      "Nom de test",
+				Content:   "Il était une fois",
+				Lang:      apimodels.LangFr,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "LoglineNotFound",
+
+			form: form,
+
+			translateLoglineData: &translateLoglineData{err: dao.ErrLoglineNotFound},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "UnsupportedLang",
+
+			form: form,
+
+			translateLoglineData: &translateLoglineData{err: storyplanmodel.ErrUnsupportedLang},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrUnsupportedLang.Error()},
+		},
+		{
+			name: "SameLang",
+
+			form: form,
+
+			translateLoglineData: &translateLoglineData{err: services.ErrTranslationSameLang},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrTranslationSameLang.Error()},
+		},
+		{
+			name: "Error",
+
+			form: form,
+
+			translateLoglineData: &translateLoglineData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockTranslateLoglineService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.translateLoglineData != nil {
+				source.EXPECT().
+					TranslateLogline(mock.Anything, services.TranslateLoglineRequest{
+						LoglineID: uuid.UUID(testCase.form.GetLoglineID()),
+						UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Lang:      models.Lang(testCase.form.GetLang()),
+					}).
+					Return(testCase.translateLoglineData.resp, testCase.translateLoglineData.err)
+			}
+
+			handler := api.API{TranslateLoglineService: source}
+
+			res, err := handler.TranslateLogline(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type UpdateBeatService interface {
+	UpdateBeat(ctx context.Context, request services.UpdateBeatRequest) (*models.BeatsSheet, error)
+}
+
+func (api *API) UpdateBeat(ctx context.Context, req *apimodels.UpdateBeatForm) (apimodels.UpdateBeatRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.UpdateBeat")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	beatsSheet, err := api.UpdateBeatService.UpdateBeat(ctx, services.UpdateBeatRequest{
+		BeatsSheetID: uuid.UUID(req.GetBeatsSheetID()),
+		UserID:       userID,
+		Key:          req.GetKey(),
+		Occurrence:   req.GetOccurrence().Or(0),
+		Title:        lo.Ternary(req.GetTitle().IsSet(), lo.ToPtr(req.GetTitle().Value), nil),
+		Content:      lo.Ternary(req.GetContent().IsSet(), lo.ToPtr(req.GetContent().Value), nil),
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrBeatsSheetNotFound),
+		errors.Is(err, dao.ErrLoglineNotFound),
+		errors.Is(err, dao.ErrStoryPlanNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case errors.Is(err, services.ErrBeatNotFound), errors.Is(err, storyplanmodel.ErrInvalidPlan):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("update beat: %w", err)
+	}
+
+	return otel.ReportSuccess(span, beatsSheetToAPI(beatsSheet)), nil
+}
@@ -0,0 +1,203 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestUpdateBeat(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type updateBeatData struct {
+		resp *models.BeatsSheet
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.UpdateBeatForm
+
+		updateBeatData *updateBeatData
+
+		expect    apimodels.UpdateBeatRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: &apimodels.UpdateBeatForm{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Key:          "beat-2",
+				Occurrence:   apimodels.NewOptInt(1),
+				Content:      apimodels.NewOptString("New content"),
+			},
+
+			updateBeatData: &updateBeatData{
+				resp: &models.BeatsSheet{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					ParentID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Content: []models.Beat{
+						{Key: "beat-1", Title: "Beat 1", Content: "Content 1"},
+						{Key: "beat-2", Title: "Beat 2", Content: "Content 2"},
+						{Key: "beat-2", Title: "Beat 2", Content: "New content"},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.BeatsSheet{
+				ID:        apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				LoglineID: apimodels.LoglineID(uuid.MustParse("00000000-0000-1000-0000-000000000001")),
+				StoryPlanID: apimodels.NewOptStoryPlanID(
+					apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				),
+				ParentID: apimodels.NewOptBeatsSheetID(
+					apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				),
+				Content: []apimodels.Beat{
+					{Key: "beat-1", Title: "Beat 1", Content: "Content 1"},
+					{Key: "beat-2", Title: "Beat 2", Content: "Content 2"},
+					{Key: "beat-2", Title: "Beat 2", Content: "New content"},
+				},
+				Lang:      apimodels.LangEn,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "BeatsSheetNotFound",
+
+			form: &apimodels.UpdateBeatForm{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Key:          "beat-1",
+				Title:        apimodels.NewOptString("New title"),
+			},
+
+			updateBeatData: &updateBeatData{
+				err: dao.ErrBeatsSheetNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrBeatsSheetNotFound.Error()},
+		},
+		{
+			name: "LoglineNotFound",
+
+			form: &apimodels.UpdateBeatForm{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Key:          "beat-1",
+				Title:        apimodels.NewOptString("New title"),
+			},
+
+			updateBeatData: &updateBeatData{
+				err: dao.ErrLoglineNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "BeatNotFound",
+
+			form: &apimodels.UpdateBeatForm{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Key:          "beat-1",
+				Title:        apimodels.NewOptString("New title"),
+			},
+
+			updateBeatData: &updateBeatData{
+				err: services.ErrBeatNotFound,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrBeatNotFound.Error()},
+		},
+		{
+			name: "InvalidPlan",
+
+			form: &apimodels.UpdateBeatForm{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Key:          "beat-1",
+				Title:        apimodels.NewOptString("New title"),
+			},
+
+			updateBeatData: &updateBeatData{
+				err: storyplanmodel.ErrInvalidPlan,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrInvalidPlan.Error()},
+		},
+		{
+			name: "Error",
+
+			form: &apimodels.UpdateBeatForm{
+				BeatsSheetID: apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Key:          "beat-1",
+				Title:        apimodels.NewOptString("New title"),
+			},
+
+			updateBeatData: &updateBeatData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockUpdateBeatService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.updateBeatData != nil {
+				source.EXPECT().
+					UpdateBeat(mock.Anything, services.UpdateBeatRequest{
+						BeatsSheetID: uuid.UUID(testCase.form.GetBeatsSheetID()),
+						UserID:       uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Key:          testCase.form.GetKey(),
+						Occurrence:   testCase.form.GetOccurrence().Or(0),
+						Title: lo.Ternary(
+							testCase.form.GetTitle().IsSet(), lo.ToPtr(testCase.form.GetTitle().Value), nil,
+						),
+						Content: lo.Ternary(
+							testCase.form.GetContent().IsSet(), lo.ToPtr(testCase.form.GetContent().Value), nil,
+						),
+					}).
+					Return(testCase.updateBeatData.resp, testCase.updateBeatData.err)
+			}
+
+			handler := api.API{UpdateBeatService: source}
+
+			res, err := handler.UpdateBeat(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
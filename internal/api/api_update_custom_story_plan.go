@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func (api *API) UpdateCustomStoryPlan(
+	ctx context.Context, req *apimodels.UpdateStoryPlanForm,
+) (apimodels.UpdateCustomStoryPlanRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.UpdateCustomStoryPlan")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	storyPlan, err := api.UpdateStoryPlanService.UpdateStoryPlan(ctx, services.UpdateStoryPlanRequest{
+		ID:     uuid.UUID(req.GetID()),
+		UserID: &userID,
+		Name:   req.GetName(),
+		Acts:   storyPlanActsFromAPI(req.GetActs()),
+		Beats:  storyPlanBeatsFromAPI(req.GetBeats()),
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrStoryPlanNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case errors.Is(err, dao.ErrStoryPlanAlreadyExists):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.ConflictError{Error: err.Error()}, nil
+	case errors.Is(err, storyplanmodel.ErrInvalidPlan):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("update custom story plan: %w", err)
+	}
+
+	return otel.ReportSuccess(span, storyPlanToAPI(storyPlan)), nil
+}
@@ -0,0 +1,181 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestUpdateCustomStoryPlan(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type updateStoryPlanData struct {
+		resp *storyplanmodel.Plan
+		err  error
+	}
+
+	form := &apimodels.UpdateStoryPlanForm{
+		ID:   apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Name: "Test Name",
+		Beats: []apimodels.StoryPlanBeat{
+			{
+				Name:      "Test Beat",
+				Key:       "test-beat",
+				KeyPoints: []string{"Test Key Point"},
+				Purpose:   "Test Purpose",
+				Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		updateStoryPlanData *updateStoryPlanData
+
+		expect    apimodels.UpdateCustomStoryPlanRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			updateStoryPlanData: &updateStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						UserID:    lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+						Slug:      "test-slug",
+						Version:   2,
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+							Scenes:    storyplanmodel.Scenes{Exact: lo.ToPtr(1)},
+						},
+					},
+				},
+			},
+
+			expect: &apimodels.StoryPlan{
+				ID:      apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				Slug:    "test-slug",
+				Version: 2,
+				UserID: apimodels.NewOptUserID(
+					apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				),
+				Name: "Test Name",
+				Lang: apimodels.LangEn,
+				Beats: []apimodels.StoryPlanBeat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+					},
+				},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "NotFound",
+
+			updateStoryPlanData: &updateStoryPlanData{
+				err: dao.ErrStoryPlanNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrStoryPlanNotFound.Error()},
+		},
+		{
+			name: "AlreadyExists",
+
+			updateStoryPlanData: &updateStoryPlanData{
+				err: dao.ErrStoryPlanAlreadyExists,
+			},
+
+			expect: &apimodels.ConflictError{Error: dao.ErrStoryPlanAlreadyExists.Error()},
+		},
+		{
+			name: "InvalidPlan",
+
+			updateStoryPlanData: &updateStoryPlanData{
+				err: storyplanmodel.ErrUnknownAct,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrUnknownAct.Error()},
+		},
+		{
+			name: "Error",
+
+			updateStoryPlanData: &updateStoryPlanData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockUpdateStoryPlanService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.updateStoryPlanData != nil {
+				source.EXPECT().
+					UpdateStoryPlan(mock.Anything, services.UpdateStoryPlanRequest{
+						ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+						Name:   "Test Name",
+						Beats: []storyplanmodel.Beat{
+							{
+								Name:      "Test Beat",
+								Key:       "test-beat",
+								KeyPoints: []string{"Test Key Point"},
+								Purpose:   "Test Purpose",
+								Scenes:    storyplanmodel.Scenes{Exact: lo.ToPtr(1)},
+							},
+						},
+					}).
+					Return(testCase.updateStoryPlanData.resp, testCase.updateStoryPlanData.err)
+			}
+
+			handler := api.API{UpdateStoryPlanService: source}
+
+			res, err := handler.UpdateCustomStoryPlan(ctx, form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
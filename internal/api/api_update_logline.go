@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type UpdateLoglineService interface {
+	UpdateLogline(ctx context.Context, request services.UpdateLoglineRequest) (*models.Logline, error)
+}
+
+func (api *API) UpdateLogline(
+	ctx context.Context, req *apimodels.UpdateLoglineForm,
+) (apimodels.UpdateLoglineRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.UpdateLogline")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	logline, err := api.UpdateLoglineService.UpdateLogline(ctx, services.UpdateLoglineRequest{
+		ID:      uuid.UUID(req.GetID()),
+		UserID:  userID,
+		Slug:    lo.Ternary(req.GetSlug().IsSet(), lo.ToPtr(models.Slug(req.GetSlug().Value)), nil),
+		Name:    lo.Ternary(req.GetName().IsSet(), lo.ToPtr(req.GetName().Value), nil),
+		Content: lo.Ternary(req.GetContent().IsSet(), lo.ToPtr(req.GetContent().Value), nil),
+		Lang:    lo.Ternary(req.GetLang().IsSet(), lo.ToPtr(models.Lang(req.GetLang().Value)), nil),
+		// A null genre is set to an empty value, which removes the genre of the logline.
+		Genre: lo.Ternary(req.GetGenre().IsSet(), lo.ToPtr(models.Genre(req.GetGenre().Value)), nil),
+		Tags:  req.GetTags(),
+		// A null project is set to a nil ID, which takes the logline out of its project.
+		ProjectID: lo.Ternary(req.GetProjectID().IsSet(), lo.ToPtr(uuid.UUID(req.GetProjectID().Value)), nil),
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrLoglineNotFound),
+		errors.Is(err, dao.ErrProjectNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case errors.Is(err, dao.ErrLoglineAlreadyExists):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.ConflictError{Error: err.Error()}, nil
+	case errors.Is(err, storyplanmodel.ErrUnsupportedLang),
+		errors.Is(err, models.ErrUnsupportedGenre),
+		errors.Is(err, models.ErrInvalidTags),
+		errors.Is(err, services.ErrContentLangMismatch):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.UnprocessableEntityError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("update logline: %w", err)
+	}
+
+	return otel.ReportSuccess(span, loglineToAPI(logline)), nil
+}
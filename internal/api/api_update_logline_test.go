@@ -0,0 +1,338 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestUpdateLogline(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type updateLoglineData struct {
+		request services.UpdateLoglineRequest
+		resp    *models.Logline
+		err     error
+	}
+
+	projectID := uuid.MustParse("00000000-0000-0000-2000-000000000001")
+
+	form := &apimodels.UpdateLoglineForm{
+		ID:   apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Slug: apimodels.NewOptSlug("new-slug"),
+		Name: apimodels.NewOptString("New Name"),
+	}
+
+	request := services.UpdateLoglineRequest{
+		ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+		Slug:   lo.ToPtr(models.Slug("new-slug")),
+		Name:   lo.ToPtr("New Name"),
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.UpdateLoglineForm
+
+		updateLoglineData *updateLoglineData
+
+		expect    apimodels.UpdateLoglineRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: form,
+
+			updateLoglineData: &updateLoglineData{
+				request: request,
+				resp: &models.Logline{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Slug:      "new-slug",
+					Name:      "New Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Logline{
+				ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				Slug:      "new-slug",
+				Name:      "New Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      apimodels.LangEn,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: apimodels.NewOptDateTime(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "Success/ContentAndLang",
+
+			form: &apimodels.UpdateLoglineForm{
+				ID:      apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Content: apimodels.NewOptString("Il était une fois"),
+				Lang:    apimodels.NewOptLang(apimodels.LangFr),
+			},
+
+			updateLoglineData: &updateLoglineData{
+				request: services.UpdateLoglineRequest{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:  uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Content: lo.ToPtr("Il était une fois"),
+					Lang:    lo.ToPtr(models.LangFR),
+				},
+				resp: &models.Logline{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Il était une fois",
+					Lang:      models.LangFR,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Logline{
+				ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				Slug:      "test-slug",
+				Name:      "Test Name",
+				Content:   "Il était une fois",
+				Lang:      apimodels.LangFr,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: apimodels.NewOptDateTime(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "Success/GenreAndTags",
+
+			form: &apimodels.UpdateLoglineForm{
+				ID:    apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Genre: apimodels.OptNilGenre{Set: true, Null: true},
+				Tags:  apimodels.Tags{"sea"},
+			},
+
+			updateLoglineData: &updateLoglineData{
+				request: services.UpdateLoglineRequest{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Genre:  lo.ToPtr(models.Genre("")),
+					Tags:   []string{"sea"},
+				},
+				resp: &models.Logline{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					Tags:      []string{"sea"},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Logline{
+				ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				Slug:      "test-slug",
+				Name:      "Test Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      apimodels.LangEn,
+				Tags:      apimodels.Tags{"sea"},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: apimodels.NewOptDateTime(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "Success/MoveToProject",
+
+			form: &apimodels.UpdateLoglineForm{
+				ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				ProjectID: apimodels.NewOptNilProjectID(apimodels.ProjectID(projectID)),
+			},
+
+			updateLoglineData: &updateLoglineData{
+				request: services.UpdateLoglineRequest{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					ProjectID: lo.ToPtr(projectID),
+				},
+				resp: &models.Logline{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					ProjectID: projectID,
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Logline{
+				ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				ProjectID: apimodels.NewOptProjectID(apimodels.ProjectID(projectID)),
+				Slug:      "test-slug",
+				Name:      "Test Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      apimodels.LangEn,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: apimodels.NewOptDateTime(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "Success/RemoveFromProject",
+
+			form: &apimodels.UpdateLoglineForm{
+				ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				ProjectID: apimodels.OptNilProjectID{Set: true, Null: true},
+			},
+
+			updateLoglineData: &updateLoglineData{
+				request: services.UpdateLoglineRequest{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					ProjectID: lo.ToPtr(uuid.Nil),
+				},
+				resp: &models.Logline{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Logline{
+				ID:        apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				Slug:      "test-slug",
+				Name:      "Test Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      apimodels.LangEn,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: apimodels.NewOptDateTime(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "LoglineNotFound",
+
+			form: form,
+
+			updateLoglineData: &updateLoglineData{request: request, err: dao.ErrLoglineNotFound},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrLoglineNotFound.Error()},
+		},
+		{
+			name: "ProjectNotFound",
+
+			form: form,
+
+			updateLoglineData: &updateLoglineData{request: request, err: dao.ErrProjectNotFound},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrProjectNotFound.Error()},
+		},
+		{
+			name: "SlugTaken",
+
+			form: form,
+
+			updateLoglineData: &updateLoglineData{request: request, err: dao.ErrLoglineAlreadyExists},
+
+			expect: &apimodels.ConflictError{Error: dao.ErrLoglineAlreadyExists.Error()},
+		},
+		{
+			name: "UnsupportedLang",
+
+			form: form,
+
+			updateLoglineData: &updateLoglineData{request: request, err: storyplanmodel.ErrUnsupportedLang},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrUnsupportedLang.Error()},
+		},
+		{
+			name: "UnsupportedGenre",
+
+			form: form,
+
+			updateLoglineData: &updateLoglineData{request: request, err: models.ErrUnsupportedGenre},
+
+			expect: &apimodels.UnprocessableEntityError{Error: models.ErrUnsupportedGenre.Error()},
+		},
+		{
+			name: "ContentLangMismatch",
+
+			form: form,
+
+			updateLoglineData: &updateLoglineData{request: request, err: services.ErrContentLangMismatch},
+
+			expect: &apimodels.UnprocessableEntityError{Error: services.ErrContentLangMismatch.Error()},
+		},
+		{
+			name: "Error",
+
+			form: form,
+
+			updateLoglineData: &updateLoglineData{request: request, err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockUpdateLoglineService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.updateLoglineData != nil {
+				source.EXPECT().
+					UpdateLogline(mock.Anything, testCase.updateLoglineData.request).
+					Return(testCase.updateLoglineData.resp, testCase.updateLoglineData.err)
+			}
+
+			handler := api.API{UpdateLoglineService: source}
+
+			res, err := handler.UpdateLogline(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
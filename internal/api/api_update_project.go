@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type UpdateProjectService interface {
+	UpdateProject(ctx context.Context, request services.UpdateProjectRequest) (*models.Project, error)
+}
+
+func (api *API) UpdateProject(
+	ctx context.Context, req *apimodels.UpdateProjectForm,
+) (apimodels.UpdateProjectRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.UpdateProject")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	project, err := api.UpdateProjectService.UpdateProject(ctx, services.UpdateProjectRequest{
+		ID:          uuid.UUID(req.GetID()),
+		UserID:      userID,
+		Name:        lo.Ternary(req.GetName().IsSet(), lo.ToPtr(req.GetName().Value), nil),
+		Description: lo.Ternary(req.GetDescription().IsSet(), lo.ToPtr(req.GetDescription().Value), nil),
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrProjectNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("update project: %w", err)
+	}
+
+	return otel.ReportSuccess(span, projectToAPI(project)), nil
+}
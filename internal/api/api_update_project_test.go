@@ -0,0 +1,124 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestUpdateProject(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type updateProjectData struct {
+		resp *models.Project
+		err  error
+	}
+
+	form := &apimodels.UpdateProjectForm{
+		ID:   apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Name: apimodels.NewOptString("The Ocean Trilogy"),
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.UpdateProjectForm
+
+		updateProjectData *updateProjectData
+
+		expect    apimodels.UpdateProjectRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: form,
+
+			updateProjectData: &updateProjectData{
+				resp: &models.Project{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:      uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					Name:        "The Ocean Trilogy",
+					Description: "Three novels about the sea.",
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &apimodels.Project{
+				ID:          apimodels.ProjectID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      apimodels.UserID(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+				Name:        "The Ocean Trilogy",
+				Description: "Three novels about the sea.",
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   apimodels.NewOptDateTime(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "ProjectNotFound",
+
+			form: form,
+
+			updateProjectData: &updateProjectData{err: dao.ErrProjectNotFound},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrProjectNotFound.Error()},
+		},
+		{
+			name: "Error",
+
+			form: form,
+
+			updateProjectData: &updateProjectData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockUpdateProjectService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.updateProjectData != nil {
+				source.EXPECT().
+					UpdateProject(mock.Anything, services.UpdateProjectRequest{
+						ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						UserID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+						Name:   lo.ToPtr("The Ocean Trilogy"),
+					}).
+					Return(testCase.updateProjectData.resp, testCase.updateProjectData.err)
+			}
+
+			handler := api.API{UpdateProjectService: source}
+
+			res, err := handler.UpdateProject(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package api_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestUpdateStoryPlan(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type updateStoryPlanData struct {
+		resp *storyplanmodel.Plan
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.UpdateStoryPlanForm
+
+		updateStoryPlanData *updateStoryPlanData
+
+		expect    apimodels.UpdateStoryPlanRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: &apimodels.UpdateStoryPlanForm{
+				ID:   apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Name: "Test Name Updated",
+				Beats: []apimodels.StoryPlanBeat{
+					{
+						Name:      "Test Beat 1",
+						Key:       "test-beat-1",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+					},
+				},
+			},
+
+			updateStoryPlanData: &updateStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Slug:      "test-slug",
+						Version:   2,
+						Name:      "Test Name Updated",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat 1",
+							Key:       "test-beat-1",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+							Scenes:    storyplanmodel.Scenes{Exact: lo.ToPtr(1)},
+						},
+					},
+				},
+			},
+
+			expect: &apimodels.StoryPlan{
+				ID:      apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				Slug:    "test-slug",
+				Version: 2,
+				Name:    "Test Name Updated",
+				Lang:    apimodels.LangEn,
+				Beats: []apimodels.StoryPlanBeat{
+					{
+						Name:      "Test Beat 1",
+						Key:       "test-beat-1",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+					},
+				},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "NotFound",
+
+			form: &apimodels.UpdateStoryPlanForm{
+				ID:   apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Name: "Test Name Updated",
+				Beats: []apimodels.StoryPlanBeat{
+					{
+						Name:      "Test Beat 1",
+						Key:       "test-beat-1",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+					},
+				},
+			},
+
+			updateStoryPlanData: &updateStoryPlanData{
+				err: dao.ErrStoryPlanNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrStoryPlanNotFound.Error()},
+		},
+		{
+			name: "Conflict",
+
+			form: &apimodels.UpdateStoryPlanForm{
+				ID:   apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Name: "Test Name Updated",
+				Beats: []apimodels.StoryPlanBeat{
+					{
+						Name:      "Test Beat 1",
+						Key:       "test-beat-1",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+					},
+				},
+			},
+
+			updateStoryPlanData: &updateStoryPlanData{
+				err: dao.ErrStoryPlanAlreadyExists,
+			},
+
+			expect: &apimodels.ConflictError{Error: dao.ErrStoryPlanAlreadyExists.Error()},
+		},
+		{
+			name: "InvalidPlan",
+
+			form: &apimodels.UpdateStoryPlanForm{
+				ID:   apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Name: "Test Name Updated",
+				Beats: []apimodels.StoryPlanBeat{
+					{
+						Name:      "Test Beat 1",
+						Key:       "test-beat-1",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+					},
+				},
+			},
+
+			updateStoryPlanData: &updateStoryPlanData{
+				err: storyplanmodel.ErrMisplacedAct,
+			},
+
+			expect: &apimodels.UnprocessableEntityError{Error: storyplanmodel.ErrMisplacedAct.Error()},
+		},
+		{
+			name: "Error",
+
+			form: &apimodels.UpdateStoryPlanForm{
+				ID:   apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Name: "Test Name Updated",
+				Beats: []apimodels.StoryPlanBeat{
+					{
+						Name:      "Test Beat 1",
+						Key:       "test-beat-1",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+					},
+				},
+			},
+
+			updateStoryPlanData: &updateStoryPlanData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockUpdateStoryPlanService(t)
+
+			ctx := t.Context()
+
+			if testCase.updateStoryPlanData != nil {
+				source.EXPECT().
+					UpdateStoryPlan(mock.Anything, services.UpdateStoryPlanRequest{
+						ID:   uuid.UUID(testCase.form.GetID()),
+						Name: testCase.form.GetName(),
+						Beats: []storyplanmodel.Beat{
+							{
+								Name:      "Test Beat 1",
+								Key:       "test-beat-1",
+								KeyPoints: []string{"Test Key Point"},
+								Purpose:   "Test Purpose",
+								Scenes:    storyplanmodel.Scenes{Exact: lo.ToPtr(1)},
+							},
+						},
+					}).
+					Return(testCase.updateStoryPlanData.resp, testCase.updateStoryPlanData.err)
+			}
+
+			handler := api.API{UpdateStoryPlanService: source}
+
+			res, err := handler.UpdateStoryPlan(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+type UpgradeBeatsSheetsService interface {
+	UpgradeBeatsSheets(
+		ctx context.Context, request services.UpgradeBeatsSheetsRequest,
+	) ([]*models.BeatsSheetUpgrade, error)
+}
+
+func (api *API) UpgradeBeatsSheets(
+	ctx context.Context, req *apimodels.UpgradeBeatsSheetsForm,
+) (apimodels.UpgradeBeatsSheetsRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.UpgradeBeatsSheets")
+	defer span.End()
+
+	upgrades, err := api.UpgradeBeatsSheetsService.UpgradeBeatsSheets(ctx, services.UpgradeBeatsSheetsRequest{
+		StoryPlanID: uuid.UUID(req.GetID()),
+		DryRun:      req.GetDryRun().Value,
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrStoryPlanNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("upgrade beats sheets: %w", err)
+	}
+
+	res := apimodels.UpgradeBeatsSheetsOKApplicationJSON(beatsSheetUpgradesToAPI(upgrades))
+
+	return otel.ReportSuccess(span, &res), nil
+}
+
+func beatsSheetUpgradesToAPI(upgrades []*models.BeatsSheetUpgrade) []apimodels.BeatsSheetUpgrade {
+	return lo.Map(upgrades, func(item *models.BeatsSheetUpgrade, _ int) apimodels.BeatsSheetUpgrade {
+		return apimodels.BeatsSheetUpgrade{
+			BeatsSheetID:    apimodels.BeatsSheetID(item.BeatsSheetID),
+			LoglineID:       apimodels.LoglineID(item.LoglineID),
+			FromStoryPlanID: apimodels.StoryPlanID(item.FromStoryPlanID),
+			ToStoryPlanID:   apimodels.StoryPlanID(item.ToStoryPlanID),
+			Compatible:      item.Compatible,
+			Upgraded:        item.Upgraded,
+			Reason:          lo.Ternary(item.Reason != "", apimodels.NewOptString(item.Reason), apimodels.OptString{}),
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/golib/otel"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func (api *API) UpgradeCustomBeatsSheets(
+	ctx context.Context, req *apimodels.UpgradeBeatsSheetsForm,
+) (apimodels.UpgradeCustomBeatsSheetsRes, error) {
+	ctx, span := otel.Tracer().Start(ctx, "api.UpgradeCustomBeatsSheets")
+	defer span.End()
+
+	userID, err := authpkg.RequireUserID(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get user ID: %w", err))
+	}
+
+	upgrades, err := api.UpgradeBeatsSheetsService.UpgradeBeatsSheets(ctx, services.UpgradeBeatsSheetsRequest{
+		StoryPlanID: uuid.UUID(req.GetID()),
+		UserID:      &userID,
+		DryRun:      req.GetDryRun().Value,
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrStoryPlanNotFound):
+		_ = otel.ReportError(span, err)
+
+		return &apimodels.NotFoundError{Error: err.Error()}, nil
+	case err != nil:
+		_ = otel.ReportError(span, err)
+
+		return nil, fmt.Errorf("upgrade custom beats sheets: %w", err)
+	}
+
+	res := apimodels.UpgradeCustomBeatsSheetsOKApplicationJSON(beatsSheetUpgradesToAPI(upgrades))
+
+	return otel.ReportSuccess(span, &res), nil
+}
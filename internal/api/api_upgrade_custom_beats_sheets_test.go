@@ -0,0 +1,160 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/a-novel/service-authentication/models"
+	authpkg "github.com/a-novel/service-authentication/pkg"
+
+	"github.com/a-novel/service-story-schematics/internal/api"
+	apimocks "github.com/a-novel/service-story-schematics/internal/api/mocks"
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func TestUpgradeCustomBeatsSheets(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type upgradeBeatsSheetsData struct {
+		resp []*models.BeatsSheetUpgrade
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		form *apimodels.UpgradeBeatsSheetsForm
+
+		upgradeBeatsSheetsData *upgradeBeatsSheetsData
+
+		expect    apimodels.UpgradeCustomBeatsSheetsRes
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			form: &apimodels.UpgradeBeatsSheetsForm{
+				ID: apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000002")),
+			},
+
+			upgradeBeatsSheetsData: &upgradeBeatsSheetsData{
+				resp: []*models.BeatsSheetUpgrade{
+					{
+						BeatsSheetID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						LoglineID:       uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+						FromStoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+						ToStoryPlanID:   uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+						Compatible:      true,
+						Upgraded:        true,
+					},
+					{
+						BeatsSheetID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						LoglineID:       uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+						FromStoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+						ToStoryPlanID:   uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+						Reason:          "invalid plan: missing beat: beat-2 at index 1",
+					},
+				},
+			},
+
+			expect: &apimodels.UpgradeCustomBeatsSheetsOKApplicationJSON{
+				{
+					BeatsSheetID:    apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					LoglineID:       apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+					FromStoryPlanID: apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+					ToStoryPlanID:   apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000002")),
+					Compatible:      true,
+					Upgraded:        true,
+				},
+				{
+					BeatsSheetID:    apimodels.BeatsSheetID(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					LoglineID:       apimodels.LoglineID(uuid.MustParse("00000000-0000-0000-1000-000000000002")),
+					FromStoryPlanID: apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+					ToStoryPlanID:   apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000002")),
+					Reason:          apimodels.NewOptString("invalid plan: missing beat: beat-2 at index 1"),
+				},
+			},
+		},
+		{
+			name: "DryRun",
+
+			form: &apimodels.UpgradeBeatsSheetsForm{
+				ID:     apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000002")),
+				DryRun: apimodels.NewOptBool(true),
+			},
+
+			upgradeBeatsSheetsData: &upgradeBeatsSheetsData{
+				resp: []*models.BeatsSheetUpgrade{},
+			},
+
+			expect: &apimodels.UpgradeCustomBeatsSheetsOKApplicationJSON{},
+		},
+		{
+			name: "NotFound",
+
+			form: &apimodels.UpgradeBeatsSheetsForm{
+				ID: apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000002")),
+			},
+
+			upgradeBeatsSheetsData: &upgradeBeatsSheetsData{
+				err: dao.ErrStoryPlanNotFound,
+			},
+
+			expect: &apimodels.NotFoundError{Error: dao.ErrStoryPlanNotFound.Error()},
+		},
+		{
+			name: "Error",
+
+			form: &apimodels.UpgradeBeatsSheetsForm{
+				ID: apimodels.StoryPlanID(uuid.MustParse("00000000-0000-0000-0000-100000000002")),
+			},
+
+			upgradeBeatsSheetsData: &upgradeBeatsSheetsData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := apimocks.NewMockUpgradeBeatsSheetsService(t)
+
+			ctx := context.WithValue(t.Context(), authpkg.ClaimsContextKey{}, &authmodels.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+			})
+
+			if testCase.upgradeBeatsSheetsData != nil {
+				source.EXPECT().
+					UpgradeBeatsSheets(mock.Anything, services.UpgradeBeatsSheetsRequest{
+						StoryPlanID: uuid.UUID(testCase.form.GetID()),
+						UserID:      lo.ToPtr(uuid.MustParse("00000000-1000-0000-0000-000000000001")),
+						DryRun:      testCase.form.GetDryRun().Value,
+					}).
+					Return(testCase.upgradeBeatsSheetsData.resp, testCase.upgradeBeatsSheetsData.err)
+			}
+
+			handler := api.API{UpgradeBeatsSheetsService: source}
+
+			res, err := handler.UpgradeCustomBeatsSheets(ctx, testCase.form)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func beatsSheetToAPI(beatsSheet *models.BeatsSheet) *apimodels.BeatsSheet {
+	return &apimodels.BeatsSheet{
+		ID:        apimodels.BeatsSheetID(beatsSheet.ID),
+		LoglineID: apimodels.LoglineID(beatsSheet.LoglineID),
+		StoryPlanID: lo.Ternary(
+			beatsSheet.StoryPlanID != uuid.Nil,
+			apimodels.NewOptStoryPlanID(apimodels.StoryPlanID(beatsSheet.StoryPlanID)),
+			apimodels.OptStoryPlanID{},
+		),
+		SourceID: lo.Ternary(
+			beatsSheet.SourceID != uuid.Nil,
+			apimodels.NewOptBeatsSheetID(apimodels.BeatsSheetID(beatsSheet.SourceID)),
+			apimodels.OptBeatsSheetID{},
+		),
+		ParentID: lo.Ternary(
+			beatsSheet.ParentID != uuid.Nil,
+			apimodels.NewOptBeatsSheetID(apimodels.BeatsSheetID(beatsSheet.ParentID)),
+			apimodels.OptBeatsSheetID{},
+		),
+		Content: lo.Map(beatsSheet.Content, func(item models.Beat, _ int) apimodels.Beat {
+			return apimodels.Beat{
+				Key:     item.Key,
+				Title:   item.Title,
+				Content: item.Content,
+			}
+		}),
+		Lang:      apimodels.Lang(beatsSheet.Lang),
+		Acts:      beatsSheetActsToAPI(beatsSheet.Acts),
+		CreatedAt: beatsSheet.CreatedAt,
+	}
+}
+
+func trashedBeatsSheetToAPI(beatsSheet *models.TrashedBeatsSheet) *apimodels.TrashedBeatsSheet {
+	return &apimodels.TrashedBeatsSheet{
+		ID:        apimodels.BeatsSheetID(beatsSheet.ID),
+		LoglineID: apimodels.LoglineID(beatsSheet.LoglineID),
+		Lang:      apimodels.Lang(beatsSheet.Lang),
+		CreatedAt: beatsSheet.CreatedAt,
+		DeletedAt: beatsSheet.DeletedAt,
+	}
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func timeToOptDateTime(value time.Time) apimodels.OptDateTime {
+	return lo.Ternary(!value.IsZero(), apimodels.NewOptDateTime(value), apimodels.OptDateTime{})
+}
+
+func genreToAPI(genre models.Genre) apimodels.OptGenre {
+	return lo.Ternary(genre != "", apimodels.NewOptGenre(apimodels.Genre(genre)), apimodels.OptGenre{})
+}
+
+func loglineToAPI(logline *models.Logline) *apimodels.Logline {
+	return &apimodels.Logline{
+		ID:     apimodels.LoglineID(logline.ID),
+		UserID: apimodels.UserID(logline.UserID),
+		Slug:   apimodels.Slug(logline.Slug),
+		SourceID: lo.Ternary(
+			logline.SourceID != uuid.Nil,
+			apimodels.NewOptLoglineID(apimodels.LoglineID(logline.SourceID)),
+			apimodels.OptLoglineID{},
+		),
+		ProjectID: projectIDToAPI(logline.ProjectID),
+		Name:      logline.Name,
+		Content:   logline.Content,
+		Lang:      apimodels.Lang(logline.Lang),
+		Genre:     genreToAPI(logline.Genre),
+		Tags:      logline.Tags,
+		CreatedAt: logline.CreatedAt,
+		UpdatedAt: timeToOptDateTime(logline.UpdatedAt),
+		DeletedAt: timeToOptDateTime(logline.DeletedAt),
+	}
+}
+
+func loglineRevisionToAPI(revision *models.LoglineRevision) *apimodels.LoglineRevision {
+	return &apimodels.LoglineRevision{
+		ID:        apimodels.LoglineRevisionID(revision.ID),
+		LoglineID: apimodels.LoglineID(revision.LoglineID),
+		Name:      revision.Name,
+		Content:   revision.Content,
+		Lang:      apimodels.Lang(revision.Lang),
+		CreatedAt: revision.CreatedAt,
+	}
+}
+
+func diffChunksToAPI(chunks []models.DiffChunk) []apimodels.DiffChunk {
+	return lo.Map(chunks, func(item models.DiffChunk, _ int) apimodels.DiffChunk {
+		return apimodels.DiffChunk{
+			Op:   apimodels.DiffOp(item.Op),
+			Text: item.Text,
+		}
+	})
+}
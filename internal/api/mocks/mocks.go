@@ -9,9 +9,105 @@ import (
 
 	"github.com/a-novel/service-story-schematics/internal/services"
 	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/story_plan"
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockConvertBeatsSheetService creates a new instance of MockConvertBeatsSheetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConvertBeatsSheetService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConvertBeatsSheetService {
+	mock := &MockConvertBeatsSheetService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConvertBeatsSheetService is an autogenerated mock type for the ConvertBeatsSheetService type
+type MockConvertBeatsSheetService struct {
+	mock.Mock
+}
+
+type MockConvertBeatsSheetService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConvertBeatsSheetService) EXPECT() *MockConvertBeatsSheetService_Expecter {
+	return &MockConvertBeatsSheetService_Expecter{mock: &_m.Mock}
+}
+
+// ConvertBeatsSheet provides a mock function for the type MockConvertBeatsSheetService
+func (_mock *MockConvertBeatsSheetService) ConvertBeatsSheet(ctx context.Context, request services.ConvertBeatsSheetRequest) (*models.BeatsSheet, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertBeatsSheet")
+	}
+
+	var r0 *models.BeatsSheet
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ConvertBeatsSheetRequest) (*models.BeatsSheet, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ConvertBeatsSheetRequest) *models.BeatsSheet); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BeatsSheet)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ConvertBeatsSheetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConvertBeatsSheetService_ConvertBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertBeatsSheet'
+type MockConvertBeatsSheetService_ConvertBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// ConvertBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ConvertBeatsSheetRequest
+func (_e *MockConvertBeatsSheetService_Expecter) ConvertBeatsSheet(ctx interface{}, request interface{}) *MockConvertBeatsSheetService_ConvertBeatsSheet_Call {
+	return &MockConvertBeatsSheetService_ConvertBeatsSheet_Call{Call: _e.mock.On("ConvertBeatsSheet", ctx, request)}
+}
+
+func (_c *MockConvertBeatsSheetService_ConvertBeatsSheet_Call) Run(run func(ctx context.Context, request services.ConvertBeatsSheetRequest)) *MockConvertBeatsSheetService_ConvertBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.ConvertBeatsSheetRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.ConvertBeatsSheetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConvertBeatsSheetService_ConvertBeatsSheet_Call) Return(beatsSheet *models.BeatsSheet, err error) *MockConvertBeatsSheetService_ConvertBeatsSheet_Call {
+	_c.Call.Return(beatsSheet, err)
+	return _c
+}
+
+func (_c *MockConvertBeatsSheetService_ConvertBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request services.ConvertBeatsSheetRequest) (*models.BeatsSheet, error)) *MockConvertBeatsSheetService_ConvertBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCreateBeatsSheetService creates a new instance of MockCreateBeatsSheetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCreateBeatsSheetService(t interface {
@@ -202,13 +298,13 @@ func (_c *MockCreateLoglineService_CreateLogline_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
-// NewMockExpandBeatService creates a new instance of MockExpandBeatService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCreateProjectService creates a new instance of MockCreateProjectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockExpandBeatService(t interface {
+func NewMockCreateProjectService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockExpandBeatService {
-	mock := &MockExpandBeatService{}
+}) *MockCreateProjectService {
+	mock := &MockCreateProjectService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -216,40 +312,40 @@ func NewMockExpandBeatService(t interface {
 	return mock
 }
 
-// MockExpandBeatService is an autogenerated mock type for the ExpandBeatService type
-type MockExpandBeatService struct {
+// MockCreateProjectService is an autogenerated mock type for the CreateProjectService type
+type MockCreateProjectService struct {
 	mock.Mock
 }
 
-type MockExpandBeatService_Expecter struct {
+type MockCreateProjectService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockExpandBeatService) EXPECT() *MockExpandBeatService_Expecter {
-	return &MockExpandBeatService_Expecter{mock: &_m.Mock}
+func (_m *MockCreateProjectService) EXPECT() *MockCreateProjectService_Expecter {
+	return &MockCreateProjectService_Expecter{mock: &_m.Mock}
 }
 
-// ExpandBeat provides a mock function for the type MockExpandBeatService
-func (_mock *MockExpandBeatService) ExpandBeat(ctx context.Context, request services.ExpandBeatRequest) (*models.Beat, error) {
+// CreateProject provides a mock function for the type MockCreateProjectService
+func (_mock *MockCreateProjectService) CreateProject(ctx context.Context, request services.CreateProjectRequest) (*models.Project, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExpandBeat")
+		panic("no return value specified for CreateProject")
 	}
 
-	var r0 *models.Beat
+	var r0 *models.Project
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ExpandBeatRequest) (*models.Beat, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.CreateProjectRequest) (*models.Project, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ExpandBeatRequest) *models.Beat); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.CreateProjectRequest) *models.Project); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Beat)
+			r0 = ret.Get(0).(*models.Project)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ExpandBeatRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.CreateProjectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -257,27 +353,27 @@ func (_mock *MockExpandBeatService) ExpandBeat(ctx context.Context, request serv
 	return r0, r1
 }
 
-// MockExpandBeatService_ExpandBeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandBeat'
-type MockExpandBeatService_ExpandBeat_Call struct {
+// MockCreateProjectService_CreateProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProject'
+type MockCreateProjectService_CreateProject_Call struct {
 	*mock.Call
 }
 
-// ExpandBeat is a helper method to define mock.On call
+// CreateProject is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request services.ExpandBeatRequest
-func (_e *MockExpandBeatService_Expecter) ExpandBeat(ctx interface{}, request interface{}) *MockExpandBeatService_ExpandBeat_Call {
-	return &MockExpandBeatService_ExpandBeat_Call{Call: _e.mock.On("ExpandBeat", ctx, request)}
+//   - request services.CreateProjectRequest
+func (_e *MockCreateProjectService_Expecter) CreateProject(ctx interface{}, request interface{}) *MockCreateProjectService_CreateProject_Call {
+	return &MockCreateProjectService_CreateProject_Call{Call: _e.mock.On("CreateProject", ctx, request)}
 }
 
-func (_c *MockExpandBeatService_ExpandBeat_Call) Run(run func(ctx context.Context, request services.ExpandBeatRequest)) *MockExpandBeatService_ExpandBeat_Call {
+func (_c *MockCreateProjectService_CreateProject_Call) Run(run func(ctx context.Context, request services.CreateProjectRequest)) *MockCreateProjectService_CreateProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 services.ExpandBeatRequest
+		var arg1 services.CreateProjectRequest
 		if args[1] != nil {
-			arg1 = args[1].(services.ExpandBeatRequest)
+			arg1 = args[1].(services.CreateProjectRequest)
 		}
 		run(
 			arg0,
@@ -287,23 +383,23 @@ func (_c *MockExpandBeatService_ExpandBeat_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockExpandBeatService_ExpandBeat_Call) Return(beat *models.Beat, err error) *MockExpandBeatService_ExpandBeat_Call {
-	_c.Call.Return(beat, err)
+func (_c *MockCreateProjectService_CreateProject_Call) Return(project *models.Project, err error) *MockCreateProjectService_CreateProject_Call {
+	_c.Call.Return(project, err)
 	return _c
 }
 
-func (_c *MockExpandBeatService_ExpandBeat_Call) RunAndReturn(run func(ctx context.Context, request services.ExpandBeatRequest) (*models.Beat, error)) *MockExpandBeatService_ExpandBeat_Call {
+func (_c *MockCreateProjectService_CreateProject_Call) RunAndReturn(run func(ctx context.Context, request services.CreateProjectRequest) (*models.Project, error)) *MockCreateProjectService_CreateProject_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockExpandLoglineService creates a new instance of MockExpandLoglineService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCreateStoryPlanService creates a new instance of MockCreateStoryPlanService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockExpandLoglineService(t interface {
+func NewMockCreateStoryPlanService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockExpandLoglineService {
-	mock := &MockExpandLoglineService{}
+}) *MockCreateStoryPlanService {
+	mock := &MockCreateStoryPlanService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -311,40 +407,40 @@ func NewMockExpandLoglineService(t interface {
 	return mock
 }
 
-// MockExpandLoglineService is an autogenerated mock type for the ExpandLoglineService type
-type MockExpandLoglineService struct {
+// MockCreateStoryPlanService is an autogenerated mock type for the CreateStoryPlanService type
+type MockCreateStoryPlanService struct {
 	mock.Mock
 }
 
-type MockExpandLoglineService_Expecter struct {
+type MockCreateStoryPlanService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockExpandLoglineService) EXPECT() *MockExpandLoglineService_Expecter {
-	return &MockExpandLoglineService_Expecter{mock: &_m.Mock}
+func (_m *MockCreateStoryPlanService) EXPECT() *MockCreateStoryPlanService_Expecter {
+	return &MockCreateStoryPlanService_Expecter{mock: &_m.Mock}
 }
 
-// ExpandLogline provides a mock function for the type MockExpandLoglineService
-func (_mock *MockExpandLoglineService) ExpandLogline(ctx context.Context, request services.ExpandLoglineRequest) (*models.LoglineIdea, error) {
+// CreateStoryPlan provides a mock function for the type MockCreateStoryPlanService
+func (_mock *MockCreateStoryPlanService) CreateStoryPlan(ctx context.Context, request services.CreateStoryPlanRequest) (*storyplanmodel.Plan, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExpandLogline")
+		panic("no return value specified for CreateStoryPlan")
 	}
 
-	var r0 *models.LoglineIdea
+	var r0 *storyplanmodel.Plan
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ExpandLoglineRequest) (*models.LoglineIdea, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.CreateStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ExpandLoglineRequest) *models.LoglineIdea); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.CreateStoryPlanRequest) *storyplanmodel.Plan); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.LoglineIdea)
+			r0 = ret.Get(0).(*storyplanmodel.Plan)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ExpandLoglineRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.CreateStoryPlanRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -352,27 +448,27 @@ func (_mock *MockExpandLoglineService) ExpandLogline(ctx context.Context, reques
 	return r0, r1
 }
 
-// MockExpandLoglineService_ExpandLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandLogline'
-type MockExpandLoglineService_ExpandLogline_Call struct {
+// MockCreateStoryPlanService_CreateStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStoryPlan'
+type MockCreateStoryPlanService_CreateStoryPlan_Call struct {
 	*mock.Call
 }
 
-// ExpandLogline is a helper method to define mock.On call
+// CreateStoryPlan is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request services.ExpandLoglineRequest
-func (_e *MockExpandLoglineService_Expecter) ExpandLogline(ctx interface{}, request interface{}) *MockExpandLoglineService_ExpandLogline_Call {
-	return &MockExpandLoglineService_ExpandLogline_Call{Call: _e.mock.On("ExpandLogline", ctx, request)}
+//   - request services.CreateStoryPlanRequest
+func (_e *MockCreateStoryPlanService_Expecter) CreateStoryPlan(ctx interface{}, request interface{}) *MockCreateStoryPlanService_CreateStoryPlan_Call {
+	return &MockCreateStoryPlanService_CreateStoryPlan_Call{Call: _e.mock.On("CreateStoryPlan", ctx, request)}
 }
 
-func (_c *MockExpandLoglineService_ExpandLogline_Call) Run(run func(ctx context.Context, request services.ExpandLoglineRequest)) *MockExpandLoglineService_ExpandLogline_Call {
+func (_c *MockCreateStoryPlanService_CreateStoryPlan_Call) Run(run func(ctx context.Context, request services.CreateStoryPlanRequest)) *MockCreateStoryPlanService_CreateStoryPlan_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 services.ExpandLoglineRequest
+		var arg1 services.CreateStoryPlanRequest
 		if args[1] != nil {
-			arg1 = args[1].(services.ExpandLoglineRequest)
+			arg1 = args[1].(services.CreateStoryPlanRequest)
 		}
 		run(
 			arg0,
@@ -382,23 +478,23 @@ func (_c *MockExpandLoglineService_ExpandLogline_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *MockExpandLoglineService_ExpandLogline_Call) Return(loglineIdea *models.LoglineIdea, err error) *MockExpandLoglineService_ExpandLogline_Call {
-	_c.Call.Return(loglineIdea, err)
+func (_c *MockCreateStoryPlanService_CreateStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockCreateStoryPlanService_CreateStoryPlan_Call {
+	_c.Call.Return(plan, err)
 	return _c
 }
 
-func (_c *MockExpandLoglineService_ExpandLogline_Call) RunAndReturn(run func(ctx context.Context, request services.ExpandLoglineRequest) (*models.LoglineIdea, error)) *MockExpandLoglineService_ExpandLogline_Call {
+func (_c *MockCreateStoryPlanService_CreateStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.CreateStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockCreateStoryPlanService_CreateStoryPlan_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockGenerateBeatsSheetService creates a new instance of MockGenerateBeatsSheetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCritiqueLoglineService creates a new instance of MockCritiqueLoglineService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockGenerateBeatsSheetService(t interface {
+func NewMockCritiqueLoglineService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockGenerateBeatsSheetService {
-	mock := &MockGenerateBeatsSheetService{}
+}) *MockCritiqueLoglineService {
+	mock := &MockCritiqueLoglineService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -406,40 +502,40 @@ func NewMockGenerateBeatsSheetService(t interface {
 	return mock
 }
 
-// MockGenerateBeatsSheetService is an autogenerated mock type for the GenerateBeatsSheetService type
-type MockGenerateBeatsSheetService struct {
+// MockCritiqueLoglineService is an autogenerated mock type for the CritiqueLoglineService type
+type MockCritiqueLoglineService struct {
 	mock.Mock
 }
 
-type MockGenerateBeatsSheetService_Expecter struct {
+type MockCritiqueLoglineService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockGenerateBeatsSheetService) EXPECT() *MockGenerateBeatsSheetService_Expecter {
-	return &MockGenerateBeatsSheetService_Expecter{mock: &_m.Mock}
+func (_m *MockCritiqueLoglineService) EXPECT() *MockCritiqueLoglineService_Expecter {
+	return &MockCritiqueLoglineService_Expecter{mock: &_m.Mock}
 }
 
-// GenerateBeatsSheet provides a mock function for the type MockGenerateBeatsSheetService
-func (_mock *MockGenerateBeatsSheetService) GenerateBeatsSheet(ctx context.Context, request services.GenerateBeatsSheetRequest) ([]models.Beat, error) {
+// CritiqueLogline provides a mock function for the type MockCritiqueLoglineService
+func (_mock *MockCritiqueLoglineService) CritiqueLogline(ctx context.Context, request services.CritiqueLoglineRequest) (*models.LoglineCritique, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenerateBeatsSheet")
+		panic("no return value specified for CritiqueLogline")
 	}
 
-	var r0 []models.Beat
+	var r0 *models.LoglineCritique
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.GenerateBeatsSheetRequest) ([]models.Beat, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.CritiqueLoglineRequest) (*models.LoglineCritique, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.GenerateBeatsSheetRequest) []models.Beat); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.CritiqueLoglineRequest) *models.LoglineCritique); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Beat)
+			r0 = ret.Get(0).(*models.LoglineCritique)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, services.GenerateBeatsSheetRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.CritiqueLoglineRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -447,27 +543,27 @@ func (_mock *MockGenerateBeatsSheetService) GenerateBeatsSheet(ctx context.Conte
 	return r0, r1
 }
 
-// MockGenerateBeatsSheetService_GenerateBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateBeatsSheet'
-type MockGenerateBeatsSheetService_GenerateBeatsSheet_Call struct {
+// MockCritiqueLoglineService_CritiqueLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CritiqueLogline'
+type MockCritiqueLoglineService_CritiqueLogline_Call struct {
 	*mock.Call
 }
 
-// GenerateBeatsSheet is a helper method to define mock.On call
+// CritiqueLogline is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request services.GenerateBeatsSheetRequest
-func (_e *MockGenerateBeatsSheetService_Expecter) GenerateBeatsSheet(ctx interface{}, request interface{}) *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call {
-	return &MockGenerateBeatsSheetService_GenerateBeatsSheet_Call{Call: _e.mock.On("GenerateBeatsSheet", ctx, request)}
+//   - request services.CritiqueLoglineRequest
+func (_e *MockCritiqueLoglineService_Expecter) CritiqueLogline(ctx interface{}, request interface{}) *MockCritiqueLoglineService_CritiqueLogline_Call {
+	return &MockCritiqueLoglineService_CritiqueLogline_Call{Call: _e.mock.On("CritiqueLogline", ctx, request)}
 }
 
-func (_c *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call) Run(run func(ctx context.Context, request services.GenerateBeatsSheetRequest)) *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call {
+func (_c *MockCritiqueLoglineService_CritiqueLogline_Call) Run(run func(ctx context.Context, request services.CritiqueLoglineRequest)) *MockCritiqueLoglineService_CritiqueLogline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 services.GenerateBeatsSheetRequest
+		var arg1 services.CritiqueLoglineRequest
 		if args[1] != nil {
-			arg1 = args[1].(services.GenerateBeatsSheetRequest)
+			arg1 = args[1].(services.CritiqueLoglineRequest)
 		}
 		run(
 			arg0,
@@ -477,23 +573,23 @@ func (_c *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call) Run(run func(ct
 	return _c
 }
 
-func (_c *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call) Return(beats []models.Beat, err error) *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call {
-	_c.Call.Return(beats, err)
+func (_c *MockCritiqueLoglineService_CritiqueLogline_Call) Return(loglineCritique *models.LoglineCritique, err error) *MockCritiqueLoglineService_CritiqueLogline_Call {
+	_c.Call.Return(loglineCritique, err)
 	return _c
 }
 
-func (_c *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request services.GenerateBeatsSheetRequest) ([]models.Beat, error)) *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call {
+func (_c *MockCritiqueLoglineService_CritiqueLogline_Call) RunAndReturn(run func(ctx context.Context, request services.CritiqueLoglineRequest) (*models.LoglineCritique, error)) *MockCritiqueLoglineService_CritiqueLogline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockGenerateLoglinesService creates a new instance of MockGenerateLoglinesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockDeleteBeatsSheetService creates a new instance of MockDeleteBeatsSheetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockGenerateLoglinesService(t interface {
+func NewMockDeleteBeatsSheetService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockGenerateLoglinesService {
-	mock := &MockGenerateLoglinesService{}
+}) *MockDeleteBeatsSheetService {
+	mock := &MockDeleteBeatsSheetService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -501,40 +597,40 @@ func NewMockGenerateLoglinesService(t interface {
 	return mock
 }
 
-// MockGenerateLoglinesService is an autogenerated mock type for the GenerateLoglinesService type
-type MockGenerateLoglinesService struct {
+// MockDeleteBeatsSheetService is an autogenerated mock type for the DeleteBeatsSheetService type
+type MockDeleteBeatsSheetService struct {
 	mock.Mock
 }
 
-type MockGenerateLoglinesService_Expecter struct {
+type MockDeleteBeatsSheetService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockGenerateLoglinesService) EXPECT() *MockGenerateLoglinesService_Expecter {
-	return &MockGenerateLoglinesService_Expecter{mock: &_m.Mock}
+func (_m *MockDeleteBeatsSheetService) EXPECT() *MockDeleteBeatsSheetService_Expecter {
+	return &MockDeleteBeatsSheetService_Expecter{mock: &_m.Mock}
 }
 
-// GenerateLoglines provides a mock function for the type MockGenerateLoglinesService
-func (_mock *MockGenerateLoglinesService) GenerateLoglines(ctx context.Context, request services.GenerateLoglinesRequest) ([]models.LoglineIdea, error) {
+// DeleteBeatsSheet provides a mock function for the type MockDeleteBeatsSheetService
+func (_mock *MockDeleteBeatsSheetService) DeleteBeatsSheet(ctx context.Context, request services.DeleteBeatsSheetRequest) (*models.TrashedBeatsSheet, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenerateLoglines")
+		panic("no return value specified for DeleteBeatsSheet")
 	}
 
-	var r0 []models.LoglineIdea
+	var r0 *models.TrashedBeatsSheet
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.GenerateLoglinesRequest) ([]models.LoglineIdea, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.DeleteBeatsSheetRequest) (*models.TrashedBeatsSheet, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.GenerateLoglinesRequest) []models.LoglineIdea); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.DeleteBeatsSheetRequest) *models.TrashedBeatsSheet); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.LoglineIdea)
+			r0 = ret.Get(0).(*models.TrashedBeatsSheet)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, services.GenerateLoglinesRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.DeleteBeatsSheetRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -542,27 +638,27 @@ func (_mock *MockGenerateLoglinesService) GenerateLoglines(ctx context.Context,
 	return r0, r1
 }
 
-// MockGenerateLoglinesService_GenerateLoglines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateLoglines'
-type MockGenerateLoglinesService_GenerateLoglines_Call struct {
+// MockDeleteBeatsSheetService_DeleteBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBeatsSheet'
+type MockDeleteBeatsSheetService_DeleteBeatsSheet_Call struct {
 	*mock.Call
 }
 
-// GenerateLoglines is a helper method to define mock.On call
+// DeleteBeatsSheet is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request services.GenerateLoglinesRequest
-func (_e *MockGenerateLoglinesService_Expecter) GenerateLoglines(ctx interface{}, request interface{}) *MockGenerateLoglinesService_GenerateLoglines_Call {
-	return &MockGenerateLoglinesService_GenerateLoglines_Call{Call: _e.mock.On("GenerateLoglines", ctx, request)}
+//   - request services.DeleteBeatsSheetRequest
+func (_e *MockDeleteBeatsSheetService_Expecter) DeleteBeatsSheet(ctx interface{}, request interface{}) *MockDeleteBeatsSheetService_DeleteBeatsSheet_Call {
+	return &MockDeleteBeatsSheetService_DeleteBeatsSheet_Call{Call: _e.mock.On("DeleteBeatsSheet", ctx, request)}
 }
 
-func (_c *MockGenerateLoglinesService_GenerateLoglines_Call) Run(run func(ctx context.Context, request services.GenerateLoglinesRequest)) *MockGenerateLoglinesService_GenerateLoglines_Call {
+func (_c *MockDeleteBeatsSheetService_DeleteBeatsSheet_Call) Run(run func(ctx context.Context, request services.DeleteBeatsSheetRequest)) *MockDeleteBeatsSheetService_DeleteBeatsSheet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 services.GenerateLoglinesRequest
+		var arg1 services.DeleteBeatsSheetRequest
 		if args[1] != nil {
-			arg1 = args[1].(services.GenerateLoglinesRequest)
+			arg1 = args[1].(services.DeleteBeatsSheetRequest)
 		}
 		run(
 			arg0,
@@ -572,23 +668,23 @@ func (_c *MockGenerateLoglinesService_GenerateLoglines_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *MockGenerateLoglinesService_GenerateLoglines_Call) Return(loglineIdeas []models.LoglineIdea, err error) *MockGenerateLoglinesService_GenerateLoglines_Call {
-	_c.Call.Return(loglineIdeas, err)
+func (_c *MockDeleteBeatsSheetService_DeleteBeatsSheet_Call) Return(trashedBeatsSheet *models.TrashedBeatsSheet, err error) *MockDeleteBeatsSheetService_DeleteBeatsSheet_Call {
+	_c.Call.Return(trashedBeatsSheet, err)
 	return _c
 }
 
-func (_c *MockGenerateLoglinesService_GenerateLoglines_Call) RunAndReturn(run func(ctx context.Context, request services.GenerateLoglinesRequest) ([]models.LoglineIdea, error)) *MockGenerateLoglinesService_GenerateLoglines_Call {
+func (_c *MockDeleteBeatsSheetService_DeleteBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request services.DeleteBeatsSheetRequest) (*models.TrashedBeatsSheet, error)) *MockDeleteBeatsSheetService_DeleteBeatsSheet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockListBeatsSheetsService creates a new instance of MockListBeatsSheetsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockDeleteLoglineService creates a new instance of MockDeleteLoglineService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockListBeatsSheetsService(t interface {
+func NewMockDeleteLoglineService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockListBeatsSheetsService {
-	mock := &MockListBeatsSheetsService{}
+}) *MockDeleteLoglineService {
+	mock := &MockDeleteLoglineService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -596,40 +692,40 @@ func NewMockListBeatsSheetsService(t interface {
 	return mock
 }
 
-// MockListBeatsSheetsService is an autogenerated mock type for the ListBeatsSheetsService type
-type MockListBeatsSheetsService struct {
+// MockDeleteLoglineService is an autogenerated mock type for the DeleteLoglineService type
+type MockDeleteLoglineService struct {
 	mock.Mock
 }
 
-type MockListBeatsSheetsService_Expecter struct {
+type MockDeleteLoglineService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockListBeatsSheetsService) EXPECT() *MockListBeatsSheetsService_Expecter {
-	return &MockListBeatsSheetsService_Expecter{mock: &_m.Mock}
+func (_m *MockDeleteLoglineService) EXPECT() *MockDeleteLoglineService_Expecter {
+	return &MockDeleteLoglineService_Expecter{mock: &_m.Mock}
 }
 
-// ListBeatsSheets provides a mock function for the type MockListBeatsSheetsService
-func (_mock *MockListBeatsSheetsService) ListBeatsSheets(ctx context.Context, request services.ListBeatsSheetsRequest) ([]*models.BeatsSheetPreview, error) {
+// DeleteLogline provides a mock function for the type MockDeleteLoglineService
+func (_mock *MockDeleteLoglineService) DeleteLogline(ctx context.Context, request services.DeleteLoglineRequest) (*models.Logline, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListBeatsSheets")
+		panic("no return value specified for DeleteLogline")
 	}
 
-	var r0 []*models.BeatsSheetPreview
+	var r0 *models.Logline
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListBeatsSheetsRequest) ([]*models.BeatsSheetPreview, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.DeleteLoglineRequest) (*models.Logline, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListBeatsSheetsRequest) []*models.BeatsSheetPreview); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.DeleteLoglineRequest) *models.Logline); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.BeatsSheetPreview)
+			r0 = ret.Get(0).(*models.Logline)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ListBeatsSheetsRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.DeleteLoglineRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -637,27 +733,27 @@ func (_mock *MockListBeatsSheetsService) ListBeatsSheets(ctx context.Context, re
 	return r0, r1
 }
 
-// MockListBeatsSheetsService_ListBeatsSheets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBeatsSheets'
-type MockListBeatsSheetsService_ListBeatsSheets_Call struct {
+// MockDeleteLoglineService_DeleteLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLogline'
+type MockDeleteLoglineService_DeleteLogline_Call struct {
 	*mock.Call
 }
 
-// ListBeatsSheets is a helper method to define mock.On call
+// DeleteLogline is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request services.ListBeatsSheetsRequest
-func (_e *MockListBeatsSheetsService_Expecter) ListBeatsSheets(ctx interface{}, request interface{}) *MockListBeatsSheetsService_ListBeatsSheets_Call {
-	return &MockListBeatsSheetsService_ListBeatsSheets_Call{Call: _e.mock.On("ListBeatsSheets", ctx, request)}
+//   - request services.DeleteLoglineRequest
+func (_e *MockDeleteLoglineService_Expecter) DeleteLogline(ctx interface{}, request interface{}) *MockDeleteLoglineService_DeleteLogline_Call {
+	return &MockDeleteLoglineService_DeleteLogline_Call{Call: _e.mock.On("DeleteLogline", ctx, request)}
 }
 
-func (_c *MockListBeatsSheetsService_ListBeatsSheets_Call) Run(run func(ctx context.Context, request services.ListBeatsSheetsRequest)) *MockListBeatsSheetsService_ListBeatsSheets_Call {
+func (_c *MockDeleteLoglineService_DeleteLogline_Call) Run(run func(ctx context.Context, request services.DeleteLoglineRequest)) *MockDeleteLoglineService_DeleteLogline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 services.ListBeatsSheetsRequest
+		var arg1 services.DeleteLoglineRequest
 		if args[1] != nil {
-			arg1 = args[1].(services.ListBeatsSheetsRequest)
+			arg1 = args[1].(services.DeleteLoglineRequest)
 		}
 		run(
 			arg0,
@@ -667,23 +763,23 @@ func (_c *MockListBeatsSheetsService_ListBeatsSheets_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockListBeatsSheetsService_ListBeatsSheets_Call) Return(beatsSheetPreviews []*models.BeatsSheetPreview, err error) *MockListBeatsSheetsService_ListBeatsSheets_Call {
-	_c.Call.Return(beatsSheetPreviews, err)
+func (_c *MockDeleteLoglineService_DeleteLogline_Call) Return(logline *models.Logline, err error) *MockDeleteLoglineService_DeleteLogline_Call {
+	_c.Call.Return(logline, err)
 	return _c
 }
 
-func (_c *MockListBeatsSheetsService_ListBeatsSheets_Call) RunAndReturn(run func(ctx context.Context, request services.ListBeatsSheetsRequest) ([]*models.BeatsSheetPreview, error)) *MockListBeatsSheetsService_ListBeatsSheets_Call {
+func (_c *MockDeleteLoglineService_DeleteLogline_Call) RunAndReturn(run func(ctx context.Context, request services.DeleteLoglineRequest) (*models.Logline, error)) *MockDeleteLoglineService_DeleteLogline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockListLoglinesService creates a new instance of MockListLoglinesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockDeleteProjectService creates a new instance of MockDeleteProjectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockListLoglinesService(t interface {
+func NewMockDeleteProjectService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockListLoglinesService {
-	mock := &MockListLoglinesService{}
+}) *MockDeleteProjectService {
+	mock := &MockDeleteProjectService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -691,40 +787,40 @@ func NewMockListLoglinesService(t interface {
 	return mock
 }
 
-// MockListLoglinesService is an autogenerated mock type for the ListLoglinesService type
-type MockListLoglinesService struct {
+// MockDeleteProjectService is an autogenerated mock type for the DeleteProjectService type
+type MockDeleteProjectService struct {
 	mock.Mock
 }
 
-type MockListLoglinesService_Expecter struct {
+type MockDeleteProjectService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockListLoglinesService) EXPECT() *MockListLoglinesService_Expecter {
-	return &MockListLoglinesService_Expecter{mock: &_m.Mock}
+func (_m *MockDeleteProjectService) EXPECT() *MockDeleteProjectService_Expecter {
+	return &MockDeleteProjectService_Expecter{mock: &_m.Mock}
 }
 
-// ListLoglines provides a mock function for the type MockListLoglinesService
-func (_mock *MockListLoglinesService) ListLoglines(ctx context.Context, request services.ListLoglinesRequest) ([]*models.LoglinePreview, error) {
+// DeleteProject provides a mock function for the type MockDeleteProjectService
+func (_mock *MockDeleteProjectService) DeleteProject(ctx context.Context, request services.DeleteProjectRequest) (*models.Project, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListLoglines")
+		panic("no return value specified for DeleteProject")
 	}
 
-	var r0 []*models.LoglinePreview
+	var r0 *models.Project
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListLoglinesRequest) ([]*models.LoglinePreview, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.DeleteProjectRequest) (*models.Project, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListLoglinesRequest) []*models.LoglinePreview); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.DeleteProjectRequest) *models.Project); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.LoglinePreview)
+			r0 = ret.Get(0).(*models.Project)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ListLoglinesRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.DeleteProjectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -732,27 +828,27 @@ func (_mock *MockListLoglinesService) ListLoglines(ctx context.Context, request
 	return r0, r1
 }
 
-// MockListLoglinesService_ListLoglines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLoglines'
-type MockListLoglinesService_ListLoglines_Call struct {
+// MockDeleteProjectService_DeleteProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProject'
+type MockDeleteProjectService_DeleteProject_Call struct {
 	*mock.Call
 }
 
-// ListLoglines is a helper method to define mock.On call
+// DeleteProject is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request services.ListLoglinesRequest
-func (_e *MockListLoglinesService_Expecter) ListLoglines(ctx interface{}, request interface{}) *MockListLoglinesService_ListLoglines_Call {
-	return &MockListLoglinesService_ListLoglines_Call{Call: _e.mock.On("ListLoglines", ctx, request)}
+//   - request services.DeleteProjectRequest
+func (_e *MockDeleteProjectService_Expecter) DeleteProject(ctx interface{}, request interface{}) *MockDeleteProjectService_DeleteProject_Call {
+	return &MockDeleteProjectService_DeleteProject_Call{Call: _e.mock.On("DeleteProject", ctx, request)}
 }
 
-func (_c *MockListLoglinesService_ListLoglines_Call) Run(run func(ctx context.Context, request services.ListLoglinesRequest)) *MockListLoglinesService_ListLoglines_Call {
+func (_c *MockDeleteProjectService_DeleteProject_Call) Run(run func(ctx context.Context, request services.DeleteProjectRequest)) *MockDeleteProjectService_DeleteProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 services.ListLoglinesRequest
+		var arg1 services.DeleteProjectRequest
 		if args[1] != nil {
-			arg1 = args[1].(services.ListLoglinesRequest)
+			arg1 = args[1].(services.DeleteProjectRequest)
 		}
 		run(
 			arg0,
@@ -762,23 +858,23 @@ func (_c *MockListLoglinesService_ListLoglines_Call) Run(run func(ctx context.Co
 	return _c
 }
 
-func (_c *MockListLoglinesService_ListLoglines_Call) Return(loglinePreviews []*models.LoglinePreview, err error) *MockListLoglinesService_ListLoglines_Call {
-	_c.Call.Return(loglinePreviews, err)
+func (_c *MockDeleteProjectService_DeleteProject_Call) Return(project *models.Project, err error) *MockDeleteProjectService_DeleteProject_Call {
+	_c.Call.Return(project, err)
 	return _c
 }
 
-func (_c *MockListLoglinesService_ListLoglines_Call) RunAndReturn(run func(ctx context.Context, request services.ListLoglinesRequest) ([]*models.LoglinePreview, error)) *MockListLoglinesService_ListLoglines_Call {
+func (_c *MockDeleteProjectService_DeleteProject_Call) RunAndReturn(run func(ctx context.Context, request services.DeleteProjectRequest) (*models.Project, error)) *MockDeleteProjectService_DeleteProject_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRegenerateBeatsService creates a new instance of MockRegenerateBeatsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockDetectLangService creates a new instance of MockDetectLangService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockRegenerateBeatsService(t interface {
+func NewMockDetectLangService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockRegenerateBeatsService {
-	mock := &MockRegenerateBeatsService{}
+}) *MockDetectLangService {
+	mock := &MockDetectLangService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -786,40 +882,40 @@ func NewMockRegenerateBeatsService(t interface {
 	return mock
 }
 
-// MockRegenerateBeatsService is an autogenerated mock type for the RegenerateBeatsService type
-type MockRegenerateBeatsService struct {
+// MockDetectLangService is an autogenerated mock type for the DetectLangService type
+type MockDetectLangService struct {
 	mock.Mock
 }
 
-type MockRegenerateBeatsService_Expecter struct {
+type MockDetectLangService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockRegenerateBeatsService) EXPECT() *MockRegenerateBeatsService_Expecter {
-	return &MockRegenerateBeatsService_Expecter{mock: &_m.Mock}
+func (_m *MockDetectLangService) EXPECT() *MockDetectLangService_Expecter {
+	return &MockDetectLangService_Expecter{mock: &_m.Mock}
 }
 
-// RegenerateBeats provides a mock function for the type MockRegenerateBeatsService
-func (_mock *MockRegenerateBeatsService) RegenerateBeats(ctx context.Context, request services.RegenerateBeatsRequest) ([]models.Beat, error) {
+// DetectLang provides a mock function for the type MockDetectLangService
+func (_mock *MockDetectLangService) DetectLang(ctx context.Context, request services.DetectLangRequest) (*models.LangDetection, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegenerateBeats")
+		panic("no return value specified for DetectLang")
 	}
 
-	var r0 []models.Beat
+	var r0 *models.LangDetection
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RegenerateBeatsRequest) ([]models.Beat, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.DetectLangRequest) (*models.LangDetection, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RegenerateBeatsRequest) []models.Beat); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.DetectLangRequest) *models.LangDetection); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Beat)
+			r0 = ret.Get(0).(*models.LangDetection)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, services.RegenerateBeatsRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.DetectLangRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -827,27 +923,27 @@ func (_mock *MockRegenerateBeatsService) RegenerateBeats(ctx context.Context, re
 	return r0, r1
 }
 
-// MockRegenerateBeatsService_RegenerateBeats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegenerateBeats'
-type MockRegenerateBeatsService_RegenerateBeats_Call struct {
+// MockDetectLangService_DetectLang_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetectLang'
+type MockDetectLangService_DetectLang_Call struct {
 	*mock.Call
 }
 
-// RegenerateBeats is a helper method to define mock.On call
+// DetectLang is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request services.RegenerateBeatsRequest
-func (_e *MockRegenerateBeatsService_Expecter) RegenerateBeats(ctx interface{}, request interface{}) *MockRegenerateBeatsService_RegenerateBeats_Call {
-	return &MockRegenerateBeatsService_RegenerateBeats_Call{Call: _e.mock.On("RegenerateBeats", ctx, request)}
+//   - request services.DetectLangRequest
+func (_e *MockDetectLangService_Expecter) DetectLang(ctx interface{}, request interface{}) *MockDetectLangService_DetectLang_Call {
+	return &MockDetectLangService_DetectLang_Call{Call: _e.mock.On("DetectLang", ctx, request)}
 }
 
-func (_c *MockRegenerateBeatsService_RegenerateBeats_Call) Run(run func(ctx context.Context, request services.RegenerateBeatsRequest)) *MockRegenerateBeatsService_RegenerateBeats_Call {
+func (_c *MockDetectLangService_DetectLang_Call) Run(run func(ctx context.Context, request services.DetectLangRequest)) *MockDetectLangService_DetectLang_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 services.RegenerateBeatsRequest
+		var arg1 services.DetectLangRequest
 		if args[1] != nil {
-			arg1 = args[1].(services.RegenerateBeatsRequest)
+			arg1 = args[1].(services.DetectLangRequest)
 		}
 		run(
 			arg0,
@@ -857,23 +953,23 @@ func (_c *MockRegenerateBeatsService_RegenerateBeats_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockRegenerateBeatsService_RegenerateBeats_Call) Return(beats []models.Beat, err error) *MockRegenerateBeatsService_RegenerateBeats_Call {
-	_c.Call.Return(beats, err)
+func (_c *MockDetectLangService_DetectLang_Call) Return(langDetection *models.LangDetection, err error) *MockDetectLangService_DetectLang_Call {
+	_c.Call.Return(langDetection, err)
 	return _c
 }
 
-func (_c *MockRegenerateBeatsService_RegenerateBeats_Call) RunAndReturn(run func(ctx context.Context, request services.RegenerateBeatsRequest) ([]models.Beat, error)) *MockRegenerateBeatsService_RegenerateBeats_Call {
+func (_c *MockDetectLangService_DetectLang_Call) RunAndReturn(run func(ctx context.Context, request services.DetectLangRequest) (*models.LangDetection, error)) *MockDetectLangService_DetectLang_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSelectBeatsSheetService creates a new instance of MockSelectBeatsSheetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockDiffBeatsSheetsService creates a new instance of MockDiffBeatsSheetsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSelectBeatsSheetService(t interface {
+func NewMockDiffBeatsSheetsService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSelectBeatsSheetService {
-	mock := &MockSelectBeatsSheetService{}
+}) *MockDiffBeatsSheetsService {
+	mock := &MockDiffBeatsSheetsService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -881,40 +977,2700 @@ func NewMockSelectBeatsSheetService(t interface {
 	return mock
 }
 
-// MockSelectBeatsSheetService is an autogenerated mock type for the SelectBeatsSheetService type
-type MockSelectBeatsSheetService struct {
+// MockDiffBeatsSheetsService is an autogenerated mock type for the DiffBeatsSheetsService type
+type MockDiffBeatsSheetsService struct {
 	mock.Mock
 }
 
-type MockSelectBeatsSheetService_Expecter struct {
+type MockDiffBeatsSheetsService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSelectBeatsSheetService) EXPECT() *MockSelectBeatsSheetService_Expecter {
-	return &MockSelectBeatsSheetService_Expecter{mock: &_m.Mock}
+func (_m *MockDiffBeatsSheetsService) EXPECT() *MockDiffBeatsSheetsService_Expecter {
+	return &MockDiffBeatsSheetsService_Expecter{mock: &_m.Mock}
 }
 
-// SelectBeatsSheet provides a mock function for the type MockSelectBeatsSheetService
+// DiffBeatsSheets provides a mock function for the type MockDiffBeatsSheetsService
+func (_mock *MockDiffBeatsSheetsService) DiffBeatsSheets(ctx context.Context, request services.DiffBeatsSheetsRequest) (*models.BeatsSheetDiff, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffBeatsSheets")
+	}
+
+	var r0 *models.BeatsSheetDiff
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.DiffBeatsSheetsRequest) (*models.BeatsSheetDiff, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.DiffBeatsSheetsRequest) *models.BeatsSheetDiff); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BeatsSheetDiff)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.DiffBeatsSheetsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDiffBeatsSheetsService_DiffBeatsSheets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffBeatsSheets'
+type MockDiffBeatsSheetsService_DiffBeatsSheets_Call struct {
+	*mock.Call
+}
+
+// DiffBeatsSheets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.DiffBeatsSheetsRequest
+func (_e *MockDiffBeatsSheetsService_Expecter) DiffBeatsSheets(ctx interface{}, request interface{}) *MockDiffBeatsSheetsService_DiffBeatsSheets_Call {
+	return &MockDiffBeatsSheetsService_DiffBeatsSheets_Call{Call: _e.mock.On("DiffBeatsSheets", ctx, request)}
+}
+
+func (_c *MockDiffBeatsSheetsService_DiffBeatsSheets_Call) Run(run func(ctx context.Context, request services.DiffBeatsSheetsRequest)) *MockDiffBeatsSheetsService_DiffBeatsSheets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.DiffBeatsSheetsRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.DiffBeatsSheetsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDiffBeatsSheetsService_DiffBeatsSheets_Call) Return(beatsSheetDiff *models.BeatsSheetDiff, err error) *MockDiffBeatsSheetsService_DiffBeatsSheets_Call {
+	_c.Call.Return(beatsSheetDiff, err)
+	return _c
+}
+
+func (_c *MockDiffBeatsSheetsService_DiffBeatsSheets_Call) RunAndReturn(run func(ctx context.Context, request services.DiffBeatsSheetsRequest) (*models.BeatsSheetDiff, error)) *MockDiffBeatsSheetsService_DiffBeatsSheets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDiffLoglineRevisionsService creates a new instance of MockDiffLoglineRevisionsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDiffLoglineRevisionsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDiffLoglineRevisionsService {
+	mock := &MockDiffLoglineRevisionsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDiffLoglineRevisionsService is an autogenerated mock type for the DiffLoglineRevisionsService type
+type MockDiffLoglineRevisionsService struct {
+	mock.Mock
+}
+
+type MockDiffLoglineRevisionsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDiffLoglineRevisionsService) EXPECT() *MockDiffLoglineRevisionsService_Expecter {
+	return &MockDiffLoglineRevisionsService_Expecter{mock: &_m.Mock}
+}
+
+// DiffLoglineRevisions provides a mock function for the type MockDiffLoglineRevisionsService
+func (_mock *MockDiffLoglineRevisionsService) DiffLoglineRevisions(ctx context.Context, request services.DiffLoglineRevisionsRequest) (*models.LoglineRevisionDiff, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffLoglineRevisions")
+	}
+
+	var r0 *models.LoglineRevisionDiff
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.DiffLoglineRevisionsRequest) (*models.LoglineRevisionDiff, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.DiffLoglineRevisionsRequest) *models.LoglineRevisionDiff); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LoglineRevisionDiff)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.DiffLoglineRevisionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDiffLoglineRevisionsService_DiffLoglineRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffLoglineRevisions'
+type MockDiffLoglineRevisionsService_DiffLoglineRevisions_Call struct {
+	*mock.Call
+}
+
+// DiffLoglineRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.DiffLoglineRevisionsRequest
+func (_e *MockDiffLoglineRevisionsService_Expecter) DiffLoglineRevisions(ctx interface{}, request interface{}) *MockDiffLoglineRevisionsService_DiffLoglineRevisions_Call {
+	return &MockDiffLoglineRevisionsService_DiffLoglineRevisions_Call{Call: _e.mock.On("DiffLoglineRevisions", ctx, request)}
+}
+
+func (_c *MockDiffLoglineRevisionsService_DiffLoglineRevisions_Call) Run(run func(ctx context.Context, request services.DiffLoglineRevisionsRequest)) *MockDiffLoglineRevisionsService_DiffLoglineRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.DiffLoglineRevisionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.DiffLoglineRevisionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDiffLoglineRevisionsService_DiffLoglineRevisions_Call) Return(loglineRevisionDiff *models.LoglineRevisionDiff, err error) *MockDiffLoglineRevisionsService_DiffLoglineRevisions_Call {
+	_c.Call.Return(loglineRevisionDiff, err)
+	return _c
+}
+
+func (_c *MockDiffLoglineRevisionsService_DiffLoglineRevisions_Call) RunAndReturn(run func(ctx context.Context, request services.DiffLoglineRevisionsRequest) (*models.LoglineRevisionDiff, error)) *MockDiffLoglineRevisionsService_DiffLoglineRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExpandBeatService creates a new instance of MockExpandBeatService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExpandBeatService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExpandBeatService {
+	mock := &MockExpandBeatService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExpandBeatService is an autogenerated mock type for the ExpandBeatService type
+type MockExpandBeatService struct {
+	mock.Mock
+}
+
+type MockExpandBeatService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExpandBeatService) EXPECT() *MockExpandBeatService_Expecter {
+	return &MockExpandBeatService_Expecter{mock: &_m.Mock}
+}
+
+// ExpandBeat provides a mock function for the type MockExpandBeatService
+func (_mock *MockExpandBeatService) ExpandBeat(ctx context.Context, request services.ExpandBeatRequest) (*models.ExpandedBeat, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandBeat")
+	}
+
+	var r0 *models.ExpandedBeat
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ExpandBeatRequest) (*models.ExpandedBeat, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ExpandBeatRequest) *models.ExpandedBeat); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ExpandedBeat)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ExpandBeatRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpandBeatService_ExpandBeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandBeat'
+type MockExpandBeatService_ExpandBeat_Call struct {
+	*mock.Call
+}
+
+// ExpandBeat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ExpandBeatRequest
+func (_e *MockExpandBeatService_Expecter) ExpandBeat(ctx interface{}, request interface{}) *MockExpandBeatService_ExpandBeat_Call {
+	return &MockExpandBeatService_ExpandBeat_Call{Call: _e.mock.On("ExpandBeat", ctx, request)}
+}
+
+func (_c *MockExpandBeatService_ExpandBeat_Call) Run(run func(ctx context.Context, request services.ExpandBeatRequest)) *MockExpandBeatService_ExpandBeat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.ExpandBeatRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.ExpandBeatRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpandBeatService_ExpandBeat_Call) Return(expandedBeat *models.ExpandedBeat, err error) *MockExpandBeatService_ExpandBeat_Call {
+	_c.Call.Return(expandedBeat, err)
+	return _c
+}
+
+func (_c *MockExpandBeatService_ExpandBeat_Call) RunAndReturn(run func(ctx context.Context, request services.ExpandBeatRequest) (*models.ExpandedBeat, error)) *MockExpandBeatService_ExpandBeat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExpandLoglineService creates a new instance of MockExpandLoglineService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExpandLoglineService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExpandLoglineService {
+	mock := &MockExpandLoglineService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExpandLoglineService is an autogenerated mock type for the ExpandLoglineService type
+type MockExpandLoglineService struct {
+	mock.Mock
+}
+
+type MockExpandLoglineService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExpandLoglineService) EXPECT() *MockExpandLoglineService_Expecter {
+	return &MockExpandLoglineService_Expecter{mock: &_m.Mock}
+}
+
+// ExpandLogline provides a mock function for the type MockExpandLoglineService
+func (_mock *MockExpandLoglineService) ExpandLogline(ctx context.Context, request services.ExpandLoglineRequest) (*models.LoglineIdea, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandLogline")
+	}
+
+	var r0 *models.LoglineIdea
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ExpandLoglineRequest) (*models.LoglineIdea, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ExpandLoglineRequest) *models.LoglineIdea); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LoglineIdea)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ExpandLoglineRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpandLoglineService_ExpandLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandLogline'
+type MockExpandLoglineService_ExpandLogline_Call struct {
+	*mock.Call
+}
+
+// ExpandLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ExpandLoglineRequest
+func (_e *MockExpandLoglineService_Expecter) ExpandLogline(ctx interface{}, request interface{}) *MockExpandLoglineService_ExpandLogline_Call {
+	return &MockExpandLoglineService_ExpandLogline_Call{Call: _e.mock.On("ExpandLogline", ctx, request)}
+}
+
+func (_c *MockExpandLoglineService_ExpandLogline_Call) Run(run func(ctx context.Context, request services.ExpandLoglineRequest)) *MockExpandLoglineService_ExpandLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.ExpandLoglineRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.ExpandLoglineRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpandLoglineService_ExpandLogline_Call) Return(loglineIdea *models.LoglineIdea, err error) *MockExpandLoglineService_ExpandLogline_Call {
+	_c.Call.Return(loglineIdea, err)
+	return _c
+}
+
+func (_c *MockExpandLoglineService_ExpandLogline_Call) RunAndReturn(run func(ctx context.Context, request services.ExpandLoglineRequest) (*models.LoglineIdea, error)) *MockExpandLoglineService_ExpandLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockForkStoryPlanService creates a new instance of MockForkStoryPlanService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockForkStoryPlanService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockForkStoryPlanService {
+	mock := &MockForkStoryPlanService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockForkStoryPlanService is an autogenerated mock type for the ForkStoryPlanService type
+type MockForkStoryPlanService struct {
+	mock.Mock
+}
+
+type MockForkStoryPlanService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockForkStoryPlanService) EXPECT() *MockForkStoryPlanService_Expecter {
+	return &MockForkStoryPlanService_Expecter{mock: &_m.Mock}
+}
+
+// ForkStoryPlan provides a mock function for the type MockForkStoryPlanService
+func (_mock *MockForkStoryPlanService) ForkStoryPlan(ctx context.Context, request services.ForkStoryPlanRequest) (*storyplanmodel.Plan, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForkStoryPlan")
+	}
+
+	var r0 *storyplanmodel.Plan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ForkStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ForkStoryPlanRequest) *storyplanmodel.Plan); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*storyplanmodel.Plan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ForkStoryPlanRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockForkStoryPlanService_ForkStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForkStoryPlan'
+type MockForkStoryPlanService_ForkStoryPlan_Call struct {
+	*mock.Call
+}
+
+// ForkStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ForkStoryPlanRequest
+func (_e *MockForkStoryPlanService_Expecter) ForkStoryPlan(ctx interface{}, request interface{}) *MockForkStoryPlanService_ForkStoryPlan_Call {
+	return &MockForkStoryPlanService_ForkStoryPlan_Call{Call: _e.mock.On("ForkStoryPlan", ctx, request)}
+}
+
+func (_c *MockForkStoryPlanService_ForkStoryPlan_Call) Run(run func(ctx context.Context, request services.ForkStoryPlanRequest)) *MockForkStoryPlanService_ForkStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.ForkStoryPlanRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.ForkStoryPlanRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockForkStoryPlanService_ForkStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockForkStoryPlanService_ForkStoryPlan_Call {
+	_c.Call.Return(plan, err)
+	return _c
+}
+
+func (_c *MockForkStoryPlanService_ForkStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.ForkStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockForkStoryPlanService_ForkStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGenerateBeatsSheetService creates a new instance of MockGenerateBeatsSheetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateBeatsSheetService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateBeatsSheetService {
+	mock := &MockGenerateBeatsSheetService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGenerateBeatsSheetService is an autogenerated mock type for the GenerateBeatsSheetService type
+type MockGenerateBeatsSheetService struct {
+	mock.Mock
+}
+
+type MockGenerateBeatsSheetService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateBeatsSheetService) EXPECT() *MockGenerateBeatsSheetService_Expecter {
+	return &MockGenerateBeatsSheetService_Expecter{mock: &_m.Mock}
+}
+
+// GenerateBeatsSheet provides a mock function for the type MockGenerateBeatsSheetService
+func (_mock *MockGenerateBeatsSheetService) GenerateBeatsSheet(ctx context.Context, request services.GenerateBeatsSheetRequest) ([]models.Beat, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateBeatsSheet")
+	}
+
+	var r0 []models.Beat
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.GenerateBeatsSheetRequest) ([]models.Beat, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.GenerateBeatsSheetRequest) []models.Beat); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Beat)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.GenerateBeatsSheetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGenerateBeatsSheetService_GenerateBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateBeatsSheet'
+type MockGenerateBeatsSheetService_GenerateBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// GenerateBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.GenerateBeatsSheetRequest
+func (_e *MockGenerateBeatsSheetService_Expecter) GenerateBeatsSheet(ctx interface{}, request interface{}) *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call {
+	return &MockGenerateBeatsSheetService_GenerateBeatsSheet_Call{Call: _e.mock.On("GenerateBeatsSheet", ctx, request)}
+}
+
+func (_c *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call) Run(run func(ctx context.Context, request services.GenerateBeatsSheetRequest)) *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.GenerateBeatsSheetRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.GenerateBeatsSheetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call) Return(beats []models.Beat, err error) *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call {
+	_c.Call.Return(beats, err)
+	return _c
+}
+
+func (_c *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request services.GenerateBeatsSheetRequest) ([]models.Beat, error)) *MockGenerateBeatsSheetService_GenerateBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGenerateLoglineVariationsService creates a new instance of MockGenerateLoglineVariationsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateLoglineVariationsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateLoglineVariationsService {
+	mock := &MockGenerateLoglineVariationsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGenerateLoglineVariationsService is an autogenerated mock type for the GenerateLoglineVariationsService type
+type MockGenerateLoglineVariationsService struct {
+	mock.Mock
+}
+
+type MockGenerateLoglineVariationsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateLoglineVariationsService) EXPECT() *MockGenerateLoglineVariationsService_Expecter {
+	return &MockGenerateLoglineVariationsService_Expecter{mock: &_m.Mock}
+}
+
+// GenerateLoglineVariations provides a mock function for the type MockGenerateLoglineVariationsService
+func (_mock *MockGenerateLoglineVariationsService) GenerateLoglineVariations(ctx context.Context, request services.GenerateLoglineVariationsRequest) ([]models.LoglineVariation, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateLoglineVariations")
+	}
+
+	var r0 []models.LoglineVariation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.GenerateLoglineVariationsRequest) ([]models.LoglineVariation, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.GenerateLoglineVariationsRequest) []models.LoglineVariation); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LoglineVariation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.GenerateLoglineVariationsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGenerateLoglineVariationsService_GenerateLoglineVariations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateLoglineVariations'
+type MockGenerateLoglineVariationsService_GenerateLoglineVariations_Call struct {
+	*mock.Call
+}
+
+// GenerateLoglineVariations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.GenerateLoglineVariationsRequest
+func (_e *MockGenerateLoglineVariationsService_Expecter) GenerateLoglineVariations(ctx interface{}, request interface{}) *MockGenerateLoglineVariationsService_GenerateLoglineVariations_Call {
+	return &MockGenerateLoglineVariationsService_GenerateLoglineVariations_Call{Call: _e.mock.On("GenerateLoglineVariations", ctx, request)}
+}
+
+func (_c *MockGenerateLoglineVariationsService_GenerateLoglineVariations_Call) Run(run func(ctx context.Context, request services.GenerateLoglineVariationsRequest)) *MockGenerateLoglineVariationsService_GenerateLoglineVariations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.GenerateLoglineVariationsRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.GenerateLoglineVariationsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenerateLoglineVariationsService_GenerateLoglineVariations_Call) Return(loglineVariations []models.LoglineVariation, err error) *MockGenerateLoglineVariationsService_GenerateLoglineVariations_Call {
+	_c.Call.Return(loglineVariations, err)
+	return _c
+}
+
+func (_c *MockGenerateLoglineVariationsService_GenerateLoglineVariations_Call) RunAndReturn(run func(ctx context.Context, request services.GenerateLoglineVariationsRequest) ([]models.LoglineVariation, error)) *MockGenerateLoglineVariationsService_GenerateLoglineVariations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGenerateLoglinesService creates a new instance of MockGenerateLoglinesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateLoglinesService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateLoglinesService {
+	mock := &MockGenerateLoglinesService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGenerateLoglinesService is an autogenerated mock type for the GenerateLoglinesService type
+type MockGenerateLoglinesService struct {
+	mock.Mock
+}
+
+type MockGenerateLoglinesService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateLoglinesService) EXPECT() *MockGenerateLoglinesService_Expecter {
+	return &MockGenerateLoglinesService_Expecter{mock: &_m.Mock}
+}
+
+// GenerateLoglines provides a mock function for the type MockGenerateLoglinesService
+func (_mock *MockGenerateLoglinesService) GenerateLoglines(ctx context.Context, request services.GenerateLoglinesRequest) ([]models.LoglineIdea, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateLoglines")
+	}
+
+	var r0 []models.LoglineIdea
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.GenerateLoglinesRequest) ([]models.LoglineIdea, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.GenerateLoglinesRequest) []models.LoglineIdea); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LoglineIdea)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.GenerateLoglinesRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGenerateLoglinesService_GenerateLoglines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateLoglines'
+type MockGenerateLoglinesService_GenerateLoglines_Call struct {
+	*mock.Call
+}
+
+// GenerateLoglines is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.GenerateLoglinesRequest
+func (_e *MockGenerateLoglinesService_Expecter) GenerateLoglines(ctx interface{}, request interface{}) *MockGenerateLoglinesService_GenerateLoglines_Call {
+	return &MockGenerateLoglinesService_GenerateLoglines_Call{Call: _e.mock.On("GenerateLoglines", ctx, request)}
+}
+
+func (_c *MockGenerateLoglinesService_GenerateLoglines_Call) Run(run func(ctx context.Context, request services.GenerateLoglinesRequest)) *MockGenerateLoglinesService_GenerateLoglines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.GenerateLoglinesRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.GenerateLoglinesRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenerateLoglinesService_GenerateLoglines_Call) Return(loglineIdeas []models.LoglineIdea, err error) *MockGenerateLoglinesService_GenerateLoglines_Call {
+	_c.Call.Return(loglineIdeas, err)
+	return _c
+}
+
+func (_c *MockGenerateLoglinesService_GenerateLoglines_Call) RunAndReturn(run func(ctx context.Context, request services.GenerateLoglinesRequest) ([]models.LoglineIdea, error)) *MockGenerateLoglinesService_GenerateLoglines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListBeatsSheetsService creates a new instance of MockListBeatsSheetsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListBeatsSheetsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListBeatsSheetsService {
+	mock := &MockListBeatsSheetsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListBeatsSheetsService is an autogenerated mock type for the ListBeatsSheetsService type
+type MockListBeatsSheetsService struct {
+	mock.Mock
+}
+
+type MockListBeatsSheetsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListBeatsSheetsService) EXPECT() *MockListBeatsSheetsService_Expecter {
+	return &MockListBeatsSheetsService_Expecter{mock: &_m.Mock}
+}
+
+// ListBeatsSheets provides a mock function for the type MockListBeatsSheetsService
+func (_mock *MockListBeatsSheetsService) ListBeatsSheets(ctx context.Context, request services.ListBeatsSheetsRequest) (*models.Page[*models.BeatsSheetPreview], error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBeatsSheets")
+	}
+
+	var r0 *models.Page[*models.BeatsSheetPreview]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListBeatsSheetsRequest) (*models.Page[*models.BeatsSheetPreview], error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListBeatsSheetsRequest) *models.Page[*models.BeatsSheetPreview]); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Page[*models.BeatsSheetPreview])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ListBeatsSheetsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListBeatsSheetsService_ListBeatsSheets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBeatsSheets'
+type MockListBeatsSheetsService_ListBeatsSheets_Call struct {
+	*mock.Call
+}
+
+// ListBeatsSheets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ListBeatsSheetsRequest
+func (_e *MockListBeatsSheetsService_Expecter) ListBeatsSheets(ctx interface{}, request interface{}) *MockListBeatsSheetsService_ListBeatsSheets_Call {
+	return &MockListBeatsSheetsService_ListBeatsSheets_Call{Call: _e.mock.On("ListBeatsSheets", ctx, request)}
+}
+
+func (_c *MockListBeatsSheetsService_ListBeatsSheets_Call) Run(run func(ctx context.Context, request services.ListBeatsSheetsRequest)) *MockListBeatsSheetsService_ListBeatsSheets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.ListBeatsSheetsRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.ListBeatsSheetsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListBeatsSheetsService_ListBeatsSheets_Call) Return(page *models.Page[*models.BeatsSheetPreview], err error) *MockListBeatsSheetsService_ListBeatsSheets_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *MockListBeatsSheetsService_ListBeatsSheets_Call) RunAndReturn(run func(ctx context.Context, request services.ListBeatsSheetsRequest) (*models.Page[*models.BeatsSheetPreview], error)) *MockListBeatsSheetsService_ListBeatsSheets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListLoglineRevisionsService creates a new instance of MockListLoglineRevisionsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListLoglineRevisionsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListLoglineRevisionsService {
+	mock := &MockListLoglineRevisionsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListLoglineRevisionsService is an autogenerated mock type for the ListLoglineRevisionsService type
+type MockListLoglineRevisionsService struct {
+	mock.Mock
+}
+
+type MockListLoglineRevisionsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListLoglineRevisionsService) EXPECT() *MockListLoglineRevisionsService_Expecter {
+	return &MockListLoglineRevisionsService_Expecter{mock: &_m.Mock}
+}
+
+// ListLoglineRevisions provides a mock function for the type MockListLoglineRevisionsService
+func (_mock *MockListLoglineRevisionsService) ListLoglineRevisions(ctx context.Context, request services.ListLoglineRevisionsRequest) ([]*models.LoglineRevision, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLoglineRevisions")
+	}
+
+	var r0 []*models.LoglineRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListLoglineRevisionsRequest) ([]*models.LoglineRevision, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListLoglineRevisionsRequest) []*models.LoglineRevision); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.LoglineRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ListLoglineRevisionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListLoglineRevisionsService_ListLoglineRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLoglineRevisions'
+type MockListLoglineRevisionsService_ListLoglineRevisions_Call struct {
+	*mock.Call
+}
+
+// ListLoglineRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ListLoglineRevisionsRequest
+func (_e *MockListLoglineRevisionsService_Expecter) ListLoglineRevisions(ctx interface{}, request interface{}) *MockListLoglineRevisionsService_ListLoglineRevisions_Call {
+	return &MockListLoglineRevisionsService_ListLoglineRevisions_Call{Call: _e.mock.On("ListLoglineRevisions", ctx, request)}
+}
+
+func (_c *MockListLoglineRevisionsService_ListLoglineRevisions_Call) Run(run func(ctx context.Context, request services.ListLoglineRevisionsRequest)) *MockListLoglineRevisionsService_ListLoglineRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.ListLoglineRevisionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.ListLoglineRevisionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListLoglineRevisionsService_ListLoglineRevisions_Call) Return(loglineRevisions []*models.LoglineRevision, err error) *MockListLoglineRevisionsService_ListLoglineRevisions_Call {
+	_c.Call.Return(loglineRevisions, err)
+	return _c
+}
+
+func (_c *MockListLoglineRevisionsService_ListLoglineRevisions_Call) RunAndReturn(run func(ctx context.Context, request services.ListLoglineRevisionsRequest) ([]*models.LoglineRevision, error)) *MockListLoglineRevisionsService_ListLoglineRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListLoglineTagsService creates a new instance of MockListLoglineTagsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListLoglineTagsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListLoglineTagsService {
+	mock := &MockListLoglineTagsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListLoglineTagsService is an autogenerated mock type for the ListLoglineTagsService type
+type MockListLoglineTagsService struct {
+	mock.Mock
+}
+
+type MockListLoglineTagsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListLoglineTagsService) EXPECT() *MockListLoglineTagsService_Expecter {
+	return &MockListLoglineTagsService_Expecter{mock: &_m.Mock}
+}
+
+// ListLoglineTags provides a mock function for the type MockListLoglineTagsService
+func (_mock *MockListLoglineTagsService) ListLoglineTags(ctx context.Context, request services.ListLoglineTagsRequest) ([]*models.LoglineTag, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLoglineTags")
+	}
+
+	var r0 []*models.LoglineTag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListLoglineTagsRequest) ([]*models.LoglineTag, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListLoglineTagsRequest) []*models.LoglineTag); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.LoglineTag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ListLoglineTagsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListLoglineTagsService_ListLoglineTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLoglineTags'
+type MockListLoglineTagsService_ListLoglineTags_Call struct {
+	*mock.Call
+}
+
+// ListLoglineTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ListLoglineTagsRequest
+func (_e *MockListLoglineTagsService_Expecter) ListLoglineTags(ctx interface{}, request interface{}) *MockListLoglineTagsService_ListLoglineTags_Call {
+	return &MockListLoglineTagsService_ListLoglineTags_Call{Call: _e.mock.On("ListLoglineTags", ctx, request)}
+}
+
+func (_c *MockListLoglineTagsService_ListLoglineTags_Call) Run(run func(ctx context.Context, request services.ListLoglineTagsRequest)) *MockListLoglineTagsService_ListLoglineTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.ListLoglineTagsRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.ListLoglineTagsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListLoglineTagsService_ListLoglineTags_Call) Return(loglineTags []*models.LoglineTag, err error) *MockListLoglineTagsService_ListLoglineTags_Call {
+	_c.Call.Return(loglineTags, err)
+	return _c
+}
+
+func (_c *MockListLoglineTagsService_ListLoglineTags_Call) RunAndReturn(run func(ctx context.Context, request services.ListLoglineTagsRequest) ([]*models.LoglineTag, error)) *MockListLoglineTagsService_ListLoglineTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListLoglinesService creates a new instance of MockListLoglinesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListLoglinesService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListLoglinesService {
+	mock := &MockListLoglinesService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListLoglinesService is an autogenerated mock type for the ListLoglinesService type
+type MockListLoglinesService struct {
+	mock.Mock
+}
+
+type MockListLoglinesService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListLoglinesService) EXPECT() *MockListLoglinesService_Expecter {
+	return &MockListLoglinesService_Expecter{mock: &_m.Mock}
+}
+
+// ListLoglines provides a mock function for the type MockListLoglinesService
+func (_mock *MockListLoglinesService) ListLoglines(ctx context.Context, request services.ListLoglinesRequest) (*models.Page[*models.LoglinePreview], error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLoglines")
+	}
+
+	var r0 *models.Page[*models.LoglinePreview]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListLoglinesRequest) (*models.Page[*models.LoglinePreview], error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListLoglinesRequest) *models.Page[*models.LoglinePreview]); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Page[*models.LoglinePreview])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ListLoglinesRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListLoglinesService_ListLoglines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLoglines'
+type MockListLoglinesService_ListLoglines_Call struct {
+	*mock.Call
+}
+
+// ListLoglines is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ListLoglinesRequest
+func (_e *MockListLoglinesService_Expecter) ListLoglines(ctx interface{}, request interface{}) *MockListLoglinesService_ListLoglines_Call {
+	return &MockListLoglinesService_ListLoglines_Call{Call: _e.mock.On("ListLoglines", ctx, request)}
+}
+
+func (_c *MockListLoglinesService_ListLoglines_Call) Run(run func(ctx context.Context, request services.ListLoglinesRequest)) *MockListLoglinesService_ListLoglines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.ListLoglinesRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.ListLoglinesRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListLoglinesService_ListLoglines_Call) Return(page *models.Page[*models.LoglinePreview], err error) *MockListLoglinesService_ListLoglines_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *MockListLoglinesService_ListLoglines_Call) RunAndReturn(run func(ctx context.Context, request services.ListLoglinesRequest) (*models.Page[*models.LoglinePreview], error)) *MockListLoglinesService_ListLoglines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListProjectsService creates a new instance of MockListProjectsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListProjectsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListProjectsService {
+	mock := &MockListProjectsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListProjectsService is an autogenerated mock type for the ListProjectsService type
+type MockListProjectsService struct {
+	mock.Mock
+}
+
+type MockListProjectsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListProjectsService) EXPECT() *MockListProjectsService_Expecter {
+	return &MockListProjectsService_Expecter{mock: &_m.Mock}
+}
+
+// ListProjects provides a mock function for the type MockListProjectsService
+func (_mock *MockListProjectsService) ListProjects(ctx context.Context, request services.ListProjectsRequest) (*models.Page[*models.Project], error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjects")
+	}
+
+	var r0 *models.Page[*models.Project]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListProjectsRequest) (*models.Page[*models.Project], error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListProjectsRequest) *models.Page[*models.Project]); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Page[*models.Project])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ListProjectsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListProjectsService_ListProjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjects'
+type MockListProjectsService_ListProjects_Call struct {
+	*mock.Call
+}
+
+// ListProjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ListProjectsRequest
+func (_e *MockListProjectsService_Expecter) ListProjects(ctx interface{}, request interface{}) *MockListProjectsService_ListProjects_Call {
+	return &MockListProjectsService_ListProjects_Call{Call: _e.mock.On("ListProjects", ctx, request)}
+}
+
+func (_c *MockListProjectsService_ListProjects_Call) Run(run func(ctx context.Context, request services.ListProjectsRequest)) *MockListProjectsService_ListProjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.ListProjectsRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.ListProjectsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListProjectsService_ListProjects_Call) Return(page *models.Page[*models.Project], err error) *MockListProjectsService_ListProjects_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *MockListProjectsService_ListProjects_Call) RunAndReturn(run func(ctx context.Context, request services.ListProjectsRequest) (*models.Page[*models.Project], error)) *MockListProjectsService_ListProjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListStoryPlansService creates a new instance of MockListStoryPlansService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListStoryPlansService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListStoryPlansService {
+	mock := &MockListStoryPlansService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListStoryPlansService is an autogenerated mock type for the ListStoryPlansService type
+type MockListStoryPlansService struct {
+	mock.Mock
+}
+
+type MockListStoryPlansService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListStoryPlansService) EXPECT() *MockListStoryPlansService_Expecter {
+	return &MockListStoryPlansService_Expecter{mock: &_m.Mock}
+}
+
+// ListStoryPlans provides a mock function for the type MockListStoryPlansService
+func (_mock *MockListStoryPlansService) ListStoryPlans(ctx context.Context, request services.ListStoryPlansRequest) ([]*storyplanmodel.Metadata, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStoryPlans")
+	}
+
+	var r0 []*storyplanmodel.Metadata
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListStoryPlansRequest) ([]*storyplanmodel.Metadata, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListStoryPlansRequest) []*storyplanmodel.Metadata); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*storyplanmodel.Metadata)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ListStoryPlansRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListStoryPlansService_ListStoryPlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStoryPlans'
+type MockListStoryPlansService_ListStoryPlans_Call struct {
+	*mock.Call
+}
+
+// ListStoryPlans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ListStoryPlansRequest
+func (_e *MockListStoryPlansService_Expecter) ListStoryPlans(ctx interface{}, request interface{}) *MockListStoryPlansService_ListStoryPlans_Call {
+	return &MockListStoryPlansService_ListStoryPlans_Call{Call: _e.mock.On("ListStoryPlans", ctx, request)}
+}
+
+func (_c *MockListStoryPlansService_ListStoryPlans_Call) Run(run func(ctx context.Context, request services.ListStoryPlansRequest)) *MockListStoryPlansService_ListStoryPlans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.ListStoryPlansRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.ListStoryPlansRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListStoryPlansService_ListStoryPlans_Call) Return(metadatas []*storyplanmodel.Metadata, err error) *MockListStoryPlansService_ListStoryPlans_Call {
+	_c.Call.Return(metadatas, err)
+	return _c
+}
+
+func (_c *MockListStoryPlansService_ListStoryPlans_Call) RunAndReturn(run func(ctx context.Context, request services.ListStoryPlansRequest) ([]*storyplanmodel.Metadata, error)) *MockListStoryPlansService_ListStoryPlans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListTrashedBeatsSheetsService creates a new instance of MockListTrashedBeatsSheetsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListTrashedBeatsSheetsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListTrashedBeatsSheetsService {
+	mock := &MockListTrashedBeatsSheetsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListTrashedBeatsSheetsService is an autogenerated mock type for the ListTrashedBeatsSheetsService type
+type MockListTrashedBeatsSheetsService struct {
+	mock.Mock
+}
+
+type MockListTrashedBeatsSheetsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListTrashedBeatsSheetsService) EXPECT() *MockListTrashedBeatsSheetsService_Expecter {
+	return &MockListTrashedBeatsSheetsService_Expecter{mock: &_m.Mock}
+}
+
+// ListTrashedBeatsSheets provides a mock function for the type MockListTrashedBeatsSheetsService
+func (_mock *MockListTrashedBeatsSheetsService) ListTrashedBeatsSheets(ctx context.Context, request services.ListTrashedBeatsSheetsRequest) ([]*models.TrashedBeatsSheet, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrashedBeatsSheets")
+	}
+
+	var r0 []*models.TrashedBeatsSheet
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListTrashedBeatsSheetsRequest) ([]*models.TrashedBeatsSheet, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListTrashedBeatsSheetsRequest) []*models.TrashedBeatsSheet); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TrashedBeatsSheet)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ListTrashedBeatsSheetsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListTrashedBeatsSheetsService_ListTrashedBeatsSheets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrashedBeatsSheets'
+type MockListTrashedBeatsSheetsService_ListTrashedBeatsSheets_Call struct {
+	*mock.Call
+}
+
+// ListTrashedBeatsSheets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ListTrashedBeatsSheetsRequest
+func (_e *MockListTrashedBeatsSheetsService_Expecter) ListTrashedBeatsSheets(ctx interface{}, request interface{}) *MockListTrashedBeatsSheetsService_ListTrashedBeatsSheets_Call {
+	return &MockListTrashedBeatsSheetsService_ListTrashedBeatsSheets_Call{Call: _e.mock.On("ListTrashedBeatsSheets", ctx, request)}
+}
+
+func (_c *MockListTrashedBeatsSheetsService_ListTrashedBeatsSheets_Call) Run(run func(ctx context.Context, request services.ListTrashedBeatsSheetsRequest)) *MockListTrashedBeatsSheetsService_ListTrashedBeatsSheets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.ListTrashedBeatsSheetsRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.ListTrashedBeatsSheetsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListTrashedBeatsSheetsService_ListTrashedBeatsSheets_Call) Return(trashedBeatsSheets []*models.TrashedBeatsSheet, err error) *MockListTrashedBeatsSheetsService_ListTrashedBeatsSheets_Call {
+	_c.Call.Return(trashedBeatsSheets, err)
+	return _c
+}
+
+func (_c *MockListTrashedBeatsSheetsService_ListTrashedBeatsSheets_Call) RunAndReturn(run func(ctx context.Context, request services.ListTrashedBeatsSheetsRequest) ([]*models.TrashedBeatsSheet, error)) *MockListTrashedBeatsSheetsService_ListTrashedBeatsSheets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListTrashedLoglinesService creates a new instance of MockListTrashedLoglinesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListTrashedLoglinesService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListTrashedLoglinesService {
+	mock := &MockListTrashedLoglinesService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListTrashedLoglinesService is an autogenerated mock type for the ListTrashedLoglinesService type
+type MockListTrashedLoglinesService struct {
+	mock.Mock
+}
+
+type MockListTrashedLoglinesService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListTrashedLoglinesService) EXPECT() *MockListTrashedLoglinesService_Expecter {
+	return &MockListTrashedLoglinesService_Expecter{mock: &_m.Mock}
+}
+
+// ListTrashedLoglines provides a mock function for the type MockListTrashedLoglinesService
+func (_mock *MockListTrashedLoglinesService) ListTrashedLoglines(ctx context.Context, request services.ListTrashedLoglinesRequest) ([]*models.Logline, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrashedLoglines")
+	}
+
+	var r0 []*models.Logline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListTrashedLoglinesRequest) ([]*models.Logline, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.ListTrashedLoglinesRequest) []*models.Logline); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Logline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.ListTrashedLoglinesRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListTrashedLoglinesService_ListTrashedLoglines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrashedLoglines'
+type MockListTrashedLoglinesService_ListTrashedLoglines_Call struct {
+	*mock.Call
+}
+
+// ListTrashedLoglines is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ListTrashedLoglinesRequest
+func (_e *MockListTrashedLoglinesService_Expecter) ListTrashedLoglines(ctx interface{}, request interface{}) *MockListTrashedLoglinesService_ListTrashedLoglines_Call {
+	return &MockListTrashedLoglinesService_ListTrashedLoglines_Call{Call: _e.mock.On("ListTrashedLoglines", ctx, request)}
+}
+
+func (_c *MockListTrashedLoglinesService_ListTrashedLoglines_Call) Run(run func(ctx context.Context, request services.ListTrashedLoglinesRequest)) *MockListTrashedLoglinesService_ListTrashedLoglines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.ListTrashedLoglinesRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.ListTrashedLoglinesRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListTrashedLoglinesService_ListTrashedLoglines_Call) Return(loglines []*models.Logline, err error) *MockListTrashedLoglinesService_ListTrashedLoglines_Call {
+	_c.Call.Return(loglines, err)
+	return _c
+}
+
+func (_c *MockListTrashedLoglinesService_ListTrashedLoglines_Call) RunAndReturn(run func(ctx context.Context, request services.ListTrashedLoglinesRequest) ([]*models.Logline, error)) *MockListTrashedLoglinesService_ListTrashedLoglines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRegenerateBeatsService creates a new instance of MockRegenerateBeatsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRegenerateBeatsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRegenerateBeatsService {
+	mock := &MockRegenerateBeatsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRegenerateBeatsService is an autogenerated mock type for the RegenerateBeatsService type
+type MockRegenerateBeatsService struct {
+	mock.Mock
+}
+
+type MockRegenerateBeatsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRegenerateBeatsService) EXPECT() *MockRegenerateBeatsService_Expecter {
+	return &MockRegenerateBeatsService_Expecter{mock: &_m.Mock}
+}
+
+// RegenerateBeats provides a mock function for the type MockRegenerateBeatsService
+func (_mock *MockRegenerateBeatsService) RegenerateBeats(ctx context.Context, request services.RegenerateBeatsRequest) (*models.RegeneratedBeats, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegenerateBeats")
+	}
+
+	var r0 *models.RegeneratedBeats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RegenerateBeatsRequest) (*models.RegeneratedBeats, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RegenerateBeatsRequest) *models.RegeneratedBeats); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RegeneratedBeats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.RegenerateBeatsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRegenerateBeatsService_RegenerateBeats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegenerateBeats'
+type MockRegenerateBeatsService_RegenerateBeats_Call struct {
+	*mock.Call
+}
+
+// RegenerateBeats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.RegenerateBeatsRequest
+func (_e *MockRegenerateBeatsService_Expecter) RegenerateBeats(ctx interface{}, request interface{}) *MockRegenerateBeatsService_RegenerateBeats_Call {
+	return &MockRegenerateBeatsService_RegenerateBeats_Call{Call: _e.mock.On("RegenerateBeats", ctx, request)}
+}
+
+func (_c *MockRegenerateBeatsService_RegenerateBeats_Call) Run(run func(ctx context.Context, request services.RegenerateBeatsRequest)) *MockRegenerateBeatsService_RegenerateBeats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.RegenerateBeatsRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.RegenerateBeatsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRegenerateBeatsService_RegenerateBeats_Call) Return(regeneratedBeats *models.RegeneratedBeats, err error) *MockRegenerateBeatsService_RegenerateBeats_Call {
+	_c.Call.Return(regeneratedBeats, err)
+	return _c
+}
+
+func (_c *MockRegenerateBeatsService_RegenerateBeats_Call) RunAndReturn(run func(ctx context.Context, request services.RegenerateBeatsRequest) (*models.RegeneratedBeats, error)) *MockRegenerateBeatsService_RegenerateBeats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestoreBeatsSheetService creates a new instance of MockRestoreBeatsSheetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestoreBeatsSheetService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestoreBeatsSheetService {
+	mock := &MockRestoreBeatsSheetService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRestoreBeatsSheetService is an autogenerated mock type for the RestoreBeatsSheetService type
+type MockRestoreBeatsSheetService struct {
+	mock.Mock
+}
+
+type MockRestoreBeatsSheetService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestoreBeatsSheetService) EXPECT() *MockRestoreBeatsSheetService_Expecter {
+	return &MockRestoreBeatsSheetService_Expecter{mock: &_m.Mock}
+}
+
+// RestoreBeatsSheet provides a mock function for the type MockRestoreBeatsSheetService
+func (_mock *MockRestoreBeatsSheetService) RestoreBeatsSheet(ctx context.Context, request services.RestoreBeatsSheetRequest) (*models.BeatsSheet, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreBeatsSheet")
+	}
+
+	var r0 *models.BeatsSheet
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RestoreBeatsSheetRequest) (*models.BeatsSheet, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RestoreBeatsSheetRequest) *models.BeatsSheet); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BeatsSheet)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.RestoreBeatsSheetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestoreBeatsSheetService_RestoreBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreBeatsSheet'
+type MockRestoreBeatsSheetService_RestoreBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// RestoreBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.RestoreBeatsSheetRequest
+func (_e *MockRestoreBeatsSheetService_Expecter) RestoreBeatsSheet(ctx interface{}, request interface{}) *MockRestoreBeatsSheetService_RestoreBeatsSheet_Call {
+	return &MockRestoreBeatsSheetService_RestoreBeatsSheet_Call{Call: _e.mock.On("RestoreBeatsSheet", ctx, request)}
+}
+
+func (_c *MockRestoreBeatsSheetService_RestoreBeatsSheet_Call) Run(run func(ctx context.Context, request services.RestoreBeatsSheetRequest)) *MockRestoreBeatsSheetService_RestoreBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.RestoreBeatsSheetRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.RestoreBeatsSheetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestoreBeatsSheetService_RestoreBeatsSheet_Call) Return(beatsSheet *models.BeatsSheet, err error) *MockRestoreBeatsSheetService_RestoreBeatsSheet_Call {
+	_c.Call.Return(beatsSheet, err)
+	return _c
+}
+
+func (_c *MockRestoreBeatsSheetService_RestoreBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request services.RestoreBeatsSheetRequest) (*models.BeatsSheet, error)) *MockRestoreBeatsSheetService_RestoreBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestoreLoglineService creates a new instance of MockRestoreLoglineService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestoreLoglineService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestoreLoglineService {
+	mock := &MockRestoreLoglineService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRestoreLoglineService is an autogenerated mock type for the RestoreLoglineService type
+type MockRestoreLoglineService struct {
+	mock.Mock
+}
+
+type MockRestoreLoglineService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestoreLoglineService) EXPECT() *MockRestoreLoglineService_Expecter {
+	return &MockRestoreLoglineService_Expecter{mock: &_m.Mock}
+}
+
+// RestoreLogline provides a mock function for the type MockRestoreLoglineService
+func (_mock *MockRestoreLoglineService) RestoreLogline(ctx context.Context, request services.RestoreLoglineRequest) (*models.Logline, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreLogline")
+	}
+
+	var r0 *models.Logline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RestoreLoglineRequest) (*models.Logline, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RestoreLoglineRequest) *models.Logline); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Logline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.RestoreLoglineRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestoreLoglineService_RestoreLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreLogline'
+type MockRestoreLoglineService_RestoreLogline_Call struct {
+	*mock.Call
+}
+
+// RestoreLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.RestoreLoglineRequest
+func (_e *MockRestoreLoglineService_Expecter) RestoreLogline(ctx interface{}, request interface{}) *MockRestoreLoglineService_RestoreLogline_Call {
+	return &MockRestoreLoglineService_RestoreLogline_Call{Call: _e.mock.On("RestoreLogline", ctx, request)}
+}
+
+func (_c *MockRestoreLoglineService_RestoreLogline_Call) Run(run func(ctx context.Context, request services.RestoreLoglineRequest)) *MockRestoreLoglineService_RestoreLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.RestoreLoglineRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.RestoreLoglineRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestoreLoglineService_RestoreLogline_Call) Return(logline *models.Logline, err error) *MockRestoreLoglineService_RestoreLogline_Call {
+	_c.Call.Return(logline, err)
+	return _c
+}
+
+func (_c *MockRestoreLoglineService_RestoreLogline_Call) RunAndReturn(run func(ctx context.Context, request services.RestoreLoglineRequest) (*models.Logline, error)) *MockRestoreLoglineService_RestoreLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestoreLoglineRevisionService creates a new instance of MockRestoreLoglineRevisionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestoreLoglineRevisionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestoreLoglineRevisionService {
+	mock := &MockRestoreLoglineRevisionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRestoreLoglineRevisionService is an autogenerated mock type for the RestoreLoglineRevisionService type
+type MockRestoreLoglineRevisionService struct {
+	mock.Mock
+}
+
+type MockRestoreLoglineRevisionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestoreLoglineRevisionService) EXPECT() *MockRestoreLoglineRevisionService_Expecter {
+	return &MockRestoreLoglineRevisionService_Expecter{mock: &_m.Mock}
+}
+
+// RestoreLoglineRevision provides a mock function for the type MockRestoreLoglineRevisionService
+func (_mock *MockRestoreLoglineRevisionService) RestoreLoglineRevision(ctx context.Context, request services.RestoreLoglineRevisionRequest) (*models.Logline, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreLoglineRevision")
+	}
+
+	var r0 *models.Logline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RestoreLoglineRevisionRequest) (*models.Logline, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RestoreLoglineRevisionRequest) *models.Logline); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Logline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.RestoreLoglineRevisionRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestoreLoglineRevisionService_RestoreLoglineRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreLoglineRevision'
+type MockRestoreLoglineRevisionService_RestoreLoglineRevision_Call struct {
+	*mock.Call
+}
+
+// RestoreLoglineRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.RestoreLoglineRevisionRequest
+func (_e *MockRestoreLoglineRevisionService_Expecter) RestoreLoglineRevision(ctx interface{}, request interface{}) *MockRestoreLoglineRevisionService_RestoreLoglineRevision_Call {
+	return &MockRestoreLoglineRevisionService_RestoreLoglineRevision_Call{Call: _e.mock.On("RestoreLoglineRevision", ctx, request)}
+}
+
+func (_c *MockRestoreLoglineRevisionService_RestoreLoglineRevision_Call) Run(run func(ctx context.Context, request services.RestoreLoglineRevisionRequest)) *MockRestoreLoglineRevisionService_RestoreLoglineRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.RestoreLoglineRevisionRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.RestoreLoglineRevisionRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestoreLoglineRevisionService_RestoreLoglineRevision_Call) Return(logline *models.Logline, err error) *MockRestoreLoglineRevisionService_RestoreLoglineRevision_Call {
+	_c.Call.Return(logline, err)
+	return _c
+}
+
+func (_c *MockRestoreLoglineRevisionService_RestoreLoglineRevision_Call) RunAndReturn(run func(ctx context.Context, request services.RestoreLoglineRevisionRequest) (*models.Logline, error)) *MockRestoreLoglineRevisionService_RestoreLoglineRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSearchService creates a new instance of MockSearchService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSearchService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSearchService {
+	mock := &MockSearchService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSearchService is an autogenerated mock type for the SearchService type
+type MockSearchService struct {
+	mock.Mock
+}
+
+type MockSearchService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSearchService) EXPECT() *MockSearchService_Expecter {
+	return &MockSearchService_Expecter{mock: &_m.Mock}
+}
+
+// Search provides a mock function for the type MockSearchService
+func (_mock *MockSearchService) Search(ctx context.Context, request services.SearchRequest) ([]*models.SearchHit, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*models.SearchHit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SearchRequest) ([]*models.SearchHit, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SearchRequest) []*models.SearchHit); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SearchHit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSearchService_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockSearchService_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SearchRequest
+func (_e *MockSearchService_Expecter) Search(ctx interface{}, request interface{}) *MockSearchService_Search_Call {
+	return &MockSearchService_Search_Call{Call: _e.mock.On("Search", ctx, request)}
+}
+
+func (_c *MockSearchService_Search_Call) Run(run func(ctx context.Context, request services.SearchRequest)) *MockSearchService_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSearchService_Search_Call) Return(searchHits []*models.SearchHit, err error) *MockSearchService_Search_Call {
+	_c.Call.Return(searchHits, err)
+	return _c
+}
+
+func (_c *MockSearchService_Search_Call) RunAndReturn(run func(ctx context.Context, request services.SearchRequest) ([]*models.SearchHit, error)) *MockSearchService_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSelectBeatsSheetService creates a new instance of MockSelectBeatsSheetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSelectBeatsSheetService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSelectBeatsSheetService {
+	mock := &MockSelectBeatsSheetService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSelectBeatsSheetService is an autogenerated mock type for the SelectBeatsSheetService type
+type MockSelectBeatsSheetService struct {
+	mock.Mock
+}
+
+type MockSelectBeatsSheetService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSelectBeatsSheetService) EXPECT() *MockSelectBeatsSheetService_Expecter {
+	return &MockSelectBeatsSheetService_Expecter{mock: &_m.Mock}
+}
+
+// SelectBeatsSheet provides a mock function for the type MockSelectBeatsSheetService
 func (_mock *MockSelectBeatsSheetService) SelectBeatsSheet(ctx context.Context, request services.SelectBeatsSheetRequest) (*models.BeatsSheet, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectBeatsSheet")
+		panic("no return value specified for SelectBeatsSheet")
+	}
+
+	var r0 *models.BeatsSheet
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectBeatsSheetRequest) (*models.BeatsSheet, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectBeatsSheetRequest) *models.BeatsSheet); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BeatsSheet)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectBeatsSheetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectBeatsSheetService_SelectBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectBeatsSheet'
+type MockSelectBeatsSheetService_SelectBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// SelectBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectBeatsSheetRequest
+func (_e *MockSelectBeatsSheetService_Expecter) SelectBeatsSheet(ctx interface{}, request interface{}) *MockSelectBeatsSheetService_SelectBeatsSheet_Call {
+	return &MockSelectBeatsSheetService_SelectBeatsSheet_Call{Call: _e.mock.On("SelectBeatsSheet", ctx, request)}
+}
+
+func (_c *MockSelectBeatsSheetService_SelectBeatsSheet_Call) Run(run func(ctx context.Context, request services.SelectBeatsSheetRequest)) *MockSelectBeatsSheetService_SelectBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectBeatsSheetRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectBeatsSheetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectBeatsSheetService_SelectBeatsSheet_Call) Return(beatsSheet *models.BeatsSheet, err error) *MockSelectBeatsSheetService_SelectBeatsSheet_Call {
+	_c.Call.Return(beatsSheet, err)
+	return _c
+}
+
+func (_c *MockSelectBeatsSheetService_SelectBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request services.SelectBeatsSheetRequest) (*models.BeatsSheet, error)) *MockSelectBeatsSheetService_SelectBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSelectLoglineService creates a new instance of MockSelectLoglineService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSelectLoglineService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSelectLoglineService {
+	mock := &MockSelectLoglineService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSelectLoglineService is an autogenerated mock type for the SelectLoglineService type
+type MockSelectLoglineService struct {
+	mock.Mock
+}
+
+type MockSelectLoglineService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSelectLoglineService) EXPECT() *MockSelectLoglineService_Expecter {
+	return &MockSelectLoglineService_Expecter{mock: &_m.Mock}
+}
+
+// SelectLogline provides a mock function for the type MockSelectLoglineService
+func (_mock *MockSelectLoglineService) SelectLogline(ctx context.Context, request services.SelectLoglineRequest) (*models.Logline, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLogline")
+	}
+
+	var r0 *models.Logline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectLoglineRequest) (*models.Logline, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectLoglineRequest) *models.Logline); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Logline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectLoglineRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectLoglineService_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockSelectLoglineService_SelectLogline_Call struct {
+	*mock.Call
+}
+
+// SelectLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectLoglineRequest
+func (_e *MockSelectLoglineService_Expecter) SelectLogline(ctx interface{}, request interface{}) *MockSelectLoglineService_SelectLogline_Call {
+	return &MockSelectLoglineService_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, request)}
+}
+
+func (_c *MockSelectLoglineService_SelectLogline_Call) Run(run func(ctx context.Context, request services.SelectLoglineRequest)) *MockSelectLoglineService_SelectLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectLoglineRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectLoglineRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectLoglineService_SelectLogline_Call) Return(logline *models.Logline, err error) *MockSelectLoglineService_SelectLogline_Call {
+	_c.Call.Return(logline, err)
+	return _c
+}
+
+func (_c *MockSelectLoglineService_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, request services.SelectLoglineRequest) (*models.Logline, error)) *MockSelectLoglineService_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSelectLoglineRevisionService creates a new instance of MockSelectLoglineRevisionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSelectLoglineRevisionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSelectLoglineRevisionService {
+	mock := &MockSelectLoglineRevisionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSelectLoglineRevisionService is an autogenerated mock type for the SelectLoglineRevisionService type
+type MockSelectLoglineRevisionService struct {
+	mock.Mock
+}
+
+type MockSelectLoglineRevisionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSelectLoglineRevisionService) EXPECT() *MockSelectLoglineRevisionService_Expecter {
+	return &MockSelectLoglineRevisionService_Expecter{mock: &_m.Mock}
+}
+
+// SelectLoglineRevision provides a mock function for the type MockSelectLoglineRevisionService
+func (_mock *MockSelectLoglineRevisionService) SelectLoglineRevision(ctx context.Context, request services.SelectLoglineRevisionRequest) (*models.LoglineRevision, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLoglineRevision")
+	}
+
+	var r0 *models.LoglineRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectLoglineRevisionRequest) (*models.LoglineRevision, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectLoglineRevisionRequest) *models.LoglineRevision); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LoglineRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectLoglineRevisionRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectLoglineRevisionService_SelectLoglineRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLoglineRevision'
+type MockSelectLoglineRevisionService_SelectLoglineRevision_Call struct {
+	*mock.Call
+}
+
+// SelectLoglineRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectLoglineRevisionRequest
+func (_e *MockSelectLoglineRevisionService_Expecter) SelectLoglineRevision(ctx interface{}, request interface{}) *MockSelectLoglineRevisionService_SelectLoglineRevision_Call {
+	return &MockSelectLoglineRevisionService_SelectLoglineRevision_Call{Call: _e.mock.On("SelectLoglineRevision", ctx, request)}
+}
+
+func (_c *MockSelectLoglineRevisionService_SelectLoglineRevision_Call) Run(run func(ctx context.Context, request services.SelectLoglineRevisionRequest)) *MockSelectLoglineRevisionService_SelectLoglineRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectLoglineRevisionRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectLoglineRevisionRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectLoglineRevisionService_SelectLoglineRevision_Call) Return(loglineRevision *models.LoglineRevision, err error) *MockSelectLoglineRevisionService_SelectLoglineRevision_Call {
+	_c.Call.Return(loglineRevision, err)
+	return _c
+}
+
+func (_c *MockSelectLoglineRevisionService_SelectLoglineRevision_Call) RunAndReturn(run func(ctx context.Context, request services.SelectLoglineRevisionRequest) (*models.LoglineRevision, error)) *MockSelectLoglineRevisionService_SelectLoglineRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSelectProjectService creates a new instance of MockSelectProjectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSelectProjectService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSelectProjectService {
+	mock := &MockSelectProjectService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSelectProjectService is an autogenerated mock type for the SelectProjectService type
+type MockSelectProjectService struct {
+	mock.Mock
+}
+
+type MockSelectProjectService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSelectProjectService) EXPECT() *MockSelectProjectService_Expecter {
+	return &MockSelectProjectService_Expecter{mock: &_m.Mock}
+}
+
+// SelectProject provides a mock function for the type MockSelectProjectService
+func (_mock *MockSelectProjectService) SelectProject(ctx context.Context, request services.SelectProjectRequest) (*models.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectProject")
+	}
+
+	var r0 *models.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectProjectRequest) (*models.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectProjectRequest) *models.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectProjectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectProjectService_SelectProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectProject'
+type MockSelectProjectService_SelectProject_Call struct {
+	*mock.Call
+}
+
+// SelectProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectProjectRequest
+func (_e *MockSelectProjectService_Expecter) SelectProject(ctx interface{}, request interface{}) *MockSelectProjectService_SelectProject_Call {
+	return &MockSelectProjectService_SelectProject_Call{Call: _e.mock.On("SelectProject", ctx, request)}
+}
+
+func (_c *MockSelectProjectService_SelectProject_Call) Run(run func(ctx context.Context, request services.SelectProjectRequest)) *MockSelectProjectService_SelectProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectProjectRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectProjectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectProjectService_SelectProject_Call) Return(project *models.Project, err error) *MockSelectProjectService_SelectProject_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockSelectProjectService_SelectProject_Call) RunAndReturn(run func(ctx context.Context, request services.SelectProjectRequest) (*models.Project, error)) *MockSelectProjectService_SelectProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSelectStoryPlanService creates a new instance of MockSelectStoryPlanService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSelectStoryPlanService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSelectStoryPlanService {
+	mock := &MockSelectStoryPlanService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSelectStoryPlanService is an autogenerated mock type for the SelectStoryPlanService type
+type MockSelectStoryPlanService struct {
+	mock.Mock
+}
+
+type MockSelectStoryPlanService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSelectStoryPlanService) EXPECT() *MockSelectStoryPlanService_Expecter {
+	return &MockSelectStoryPlanService_Expecter{mock: &_m.Mock}
+}
+
+// SelectStoryPlan provides a mock function for the type MockSelectStoryPlanService
+func (_mock *MockSelectStoryPlanService) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectStoryPlan")
+	}
+
+	var r0 *storyplanmodel.Plan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) *storyplanmodel.Plan); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*storyplanmodel.Plan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectStoryPlanRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectStoryPlanService_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
+type MockSelectStoryPlanService_SelectStoryPlan_Call struct {
+	*mock.Call
+}
+
+// SelectStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectStoryPlanRequest
+func (_e *MockSelectStoryPlanService_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockSelectStoryPlanService_SelectStoryPlan_Call {
+	return &MockSelectStoryPlanService_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
+}
+
+func (_c *MockSelectStoryPlanService_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockSelectStoryPlanService_SelectStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectStoryPlanRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectStoryPlanRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectStoryPlanService_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockSelectStoryPlanService_SelectStoryPlan_Call {
+	_c.Call.Return(plan, err)
+	return _c
+}
+
+func (_c *MockSelectStoryPlanService_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockSelectStoryPlanService_SelectStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTranslateBeatsSheetService creates a new instance of MockTranslateBeatsSheetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTranslateBeatsSheetService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTranslateBeatsSheetService {
+	mock := &MockTranslateBeatsSheetService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTranslateBeatsSheetService is an autogenerated mock type for the TranslateBeatsSheetService type
+type MockTranslateBeatsSheetService struct {
+	mock.Mock
+}
+
+type MockTranslateBeatsSheetService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTranslateBeatsSheetService) EXPECT() *MockTranslateBeatsSheetService_Expecter {
+	return &MockTranslateBeatsSheetService_Expecter{mock: &_m.Mock}
+}
+
+// TranslateBeatsSheet provides a mock function for the type MockTranslateBeatsSheetService
+func (_mock *MockTranslateBeatsSheetService) TranslateBeatsSheet(ctx context.Context, request services.TranslateBeatsSheetRequest) (*models.BeatsSheet, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TranslateBeatsSheet")
+	}
+
+	var r0 *models.BeatsSheet
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.TranslateBeatsSheetRequest) (*models.BeatsSheet, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.TranslateBeatsSheetRequest) *models.BeatsSheet); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BeatsSheet)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.TranslateBeatsSheetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTranslateBeatsSheetService_TranslateBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TranslateBeatsSheet'
+type MockTranslateBeatsSheetService_TranslateBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// TranslateBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.TranslateBeatsSheetRequest
+func (_e *MockTranslateBeatsSheetService_Expecter) TranslateBeatsSheet(ctx interface{}, request interface{}) *MockTranslateBeatsSheetService_TranslateBeatsSheet_Call {
+	return &MockTranslateBeatsSheetService_TranslateBeatsSheet_Call{Call: _e.mock.On("TranslateBeatsSheet", ctx, request)}
+}
+
+func (_c *MockTranslateBeatsSheetService_TranslateBeatsSheet_Call) Run(run func(ctx context.Context, request services.TranslateBeatsSheetRequest)) *MockTranslateBeatsSheetService_TranslateBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.TranslateBeatsSheetRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.TranslateBeatsSheetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTranslateBeatsSheetService_TranslateBeatsSheet_Call) Return(beatsSheet *models.BeatsSheet, err error) *MockTranslateBeatsSheetService_TranslateBeatsSheet_Call {
+	_c.Call.Return(beatsSheet, err)
+	return _c
+}
+
+func (_c *MockTranslateBeatsSheetService_TranslateBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request services.TranslateBeatsSheetRequest) (*models.BeatsSheet, error)) *MockTranslateBeatsSheetService_TranslateBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTranslateLoglineService creates a new instance of MockTranslateLoglineService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTranslateLoglineService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTranslateLoglineService {
+	mock := &MockTranslateLoglineService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTranslateLoglineService is an autogenerated mock type for the TranslateLoglineService type
+type MockTranslateLoglineService struct {
+	mock.Mock
+}
+
+type MockTranslateLoglineService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTranslateLoglineService) EXPECT() *MockTranslateLoglineService_Expecter {
+	return &MockTranslateLoglineService_Expecter{mock: &_m.Mock}
+}
+
+// TranslateLogline provides a mock function for the type MockTranslateLoglineService
+func (_mock *MockTranslateLoglineService) TranslateLogline(ctx context.Context, request services.TranslateLoglineRequest) (*models.Logline, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TranslateLogline")
+	}
+
+	var r0 *models.Logline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.TranslateLoglineRequest) (*models.Logline, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.TranslateLoglineRequest) *models.Logline); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Logline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.TranslateLoglineRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTranslateLoglineService_TranslateLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TranslateLogline'
+type MockTranslateLoglineService_TranslateLogline_Call struct {
+	*mock.Call
+}
+
+// TranslateLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.TranslateLoglineRequest
+func (_e *MockTranslateLoglineService_Expecter) TranslateLogline(ctx interface{}, request interface{}) *MockTranslateLoglineService_TranslateLogline_Call {
+	return &MockTranslateLoglineService_TranslateLogline_Call{Call: _e.mock.On("TranslateLogline", ctx, request)}
+}
+
+func (_c *MockTranslateLoglineService_TranslateLogline_Call) Run(run func(ctx context.Context, request services.TranslateLoglineRequest)) *MockTranslateLoglineService_TranslateLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.TranslateLoglineRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.TranslateLoglineRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTranslateLoglineService_TranslateLogline_Call) Return(logline *models.Logline, err error) *MockTranslateLoglineService_TranslateLogline_Call {
+	_c.Call.Return(logline, err)
+	return _c
+}
+
+func (_c *MockTranslateLoglineService_TranslateLogline_Call) RunAndReturn(run func(ctx context.Context, request services.TranslateLoglineRequest) (*models.Logline, error)) *MockTranslateLoglineService_TranslateLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdateBeatService creates a new instance of MockUpdateBeatService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateBeatService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateBeatService {
+	mock := &MockUpdateBeatService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUpdateBeatService is an autogenerated mock type for the UpdateBeatService type
+type MockUpdateBeatService struct {
+	mock.Mock
+}
+
+type MockUpdateBeatService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateBeatService) EXPECT() *MockUpdateBeatService_Expecter {
+	return &MockUpdateBeatService_Expecter{mock: &_m.Mock}
+}
+
+// UpdateBeat provides a mock function for the type MockUpdateBeatService
+func (_mock *MockUpdateBeatService) UpdateBeat(ctx context.Context, request services.UpdateBeatRequest) (*models.BeatsSheet, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBeat")
 	}
 
 	var r0 *models.BeatsSheet
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectBeatsSheetRequest) (*models.BeatsSheet, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.UpdateBeatRequest) (*models.BeatsSheet, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectBeatsSheetRequest) *models.BeatsSheet); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.UpdateBeatRequest) *models.BeatsSheet); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.BeatsSheet)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectBeatsSheetRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.UpdateBeatRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -922,27 +3678,27 @@ func (_mock *MockSelectBeatsSheetService) SelectBeatsSheet(ctx context.Context,
 	return r0, r1
 }
 
-// MockSelectBeatsSheetService_SelectBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectBeatsSheet'
-type MockSelectBeatsSheetService_SelectBeatsSheet_Call struct {
+// MockUpdateBeatService_UpdateBeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBeat'
+type MockUpdateBeatService_UpdateBeat_Call struct {
 	*mock.Call
 }
 
-// SelectBeatsSheet is a helper method to define mock.On call
+// UpdateBeat is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request services.SelectBeatsSheetRequest
-func (_e *MockSelectBeatsSheetService_Expecter) SelectBeatsSheet(ctx interface{}, request interface{}) *MockSelectBeatsSheetService_SelectBeatsSheet_Call {
-	return &MockSelectBeatsSheetService_SelectBeatsSheet_Call{Call: _e.mock.On("SelectBeatsSheet", ctx, request)}
+//   - request services.UpdateBeatRequest
+func (_e *MockUpdateBeatService_Expecter) UpdateBeat(ctx interface{}, request interface{}) *MockUpdateBeatService_UpdateBeat_Call {
+	return &MockUpdateBeatService_UpdateBeat_Call{Call: _e.mock.On("UpdateBeat", ctx, request)}
 }
 
-func (_c *MockSelectBeatsSheetService_SelectBeatsSheet_Call) Run(run func(ctx context.Context, request services.SelectBeatsSheetRequest)) *MockSelectBeatsSheetService_SelectBeatsSheet_Call {
+func (_c *MockUpdateBeatService_UpdateBeat_Call) Run(run func(ctx context.Context, request services.UpdateBeatRequest)) *MockUpdateBeatService_UpdateBeat_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 services.SelectBeatsSheetRequest
+		var arg1 services.UpdateBeatRequest
 		if args[1] != nil {
-			arg1 = args[1].(services.SelectBeatsSheetRequest)
+			arg1 = args[1].(services.UpdateBeatRequest)
 		}
 		run(
 			arg0,
@@ -952,23 +3708,23 @@ func (_c *MockSelectBeatsSheetService_SelectBeatsSheet_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *MockSelectBeatsSheetService_SelectBeatsSheet_Call) Return(beatsSheet *models.BeatsSheet, err error) *MockSelectBeatsSheetService_SelectBeatsSheet_Call {
+func (_c *MockUpdateBeatService_UpdateBeat_Call) Return(beatsSheet *models.BeatsSheet, err error) *MockUpdateBeatService_UpdateBeat_Call {
 	_c.Call.Return(beatsSheet, err)
 	return _c
 }
 
-func (_c *MockSelectBeatsSheetService_SelectBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request services.SelectBeatsSheetRequest) (*models.BeatsSheet, error)) *MockSelectBeatsSheetService_SelectBeatsSheet_Call {
+func (_c *MockUpdateBeatService_UpdateBeat_Call) RunAndReturn(run func(ctx context.Context, request services.UpdateBeatRequest) (*models.BeatsSheet, error)) *MockUpdateBeatService_UpdateBeat_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSelectLoglineService creates a new instance of MockSelectLoglineService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockUpdateLoglineService creates a new instance of MockUpdateLoglineService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSelectLoglineService(t interface {
+func NewMockUpdateLoglineService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSelectLoglineService {
-	mock := &MockSelectLoglineService{}
+}) *MockUpdateLoglineService {
+	mock := &MockUpdateLoglineService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -976,40 +3732,40 @@ func NewMockSelectLoglineService(t interface {
 	return mock
 }
 
-// MockSelectLoglineService is an autogenerated mock type for the SelectLoglineService type
-type MockSelectLoglineService struct {
+// MockUpdateLoglineService is an autogenerated mock type for the UpdateLoglineService type
+type MockUpdateLoglineService struct {
 	mock.Mock
 }
 
-type MockSelectLoglineService_Expecter struct {
+type MockUpdateLoglineService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSelectLoglineService) EXPECT() *MockSelectLoglineService_Expecter {
-	return &MockSelectLoglineService_Expecter{mock: &_m.Mock}
+func (_m *MockUpdateLoglineService) EXPECT() *MockUpdateLoglineService_Expecter {
+	return &MockUpdateLoglineService_Expecter{mock: &_m.Mock}
 }
 
-// SelectLogline provides a mock function for the type MockSelectLoglineService
-func (_mock *MockSelectLoglineService) SelectLogline(ctx context.Context, request services.SelectLoglineRequest) (*models.Logline, error) {
+// UpdateLogline provides a mock function for the type MockUpdateLoglineService
+func (_mock *MockUpdateLoglineService) UpdateLogline(ctx context.Context, request services.UpdateLoglineRequest) (*models.Logline, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectLogline")
+		panic("no return value specified for UpdateLogline")
 	}
 
 	var r0 *models.Logline
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectLoglineRequest) (*models.Logline, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.UpdateLoglineRequest) (*models.Logline, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectLoglineRequest) *models.Logline); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.UpdateLoglineRequest) *models.Logline); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Logline)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectLoglineRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.UpdateLoglineRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1017,27 +3773,27 @@ func (_mock *MockSelectLoglineService) SelectLogline(ctx context.Context, reques
 	return r0, r1
 }
 
-// MockSelectLoglineService_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
-type MockSelectLoglineService_SelectLogline_Call struct {
+// MockUpdateLoglineService_UpdateLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLogline'
+type MockUpdateLoglineService_UpdateLogline_Call struct {
 	*mock.Call
 }
 
-// SelectLogline is a helper method to define mock.On call
+// UpdateLogline is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request services.SelectLoglineRequest
-func (_e *MockSelectLoglineService_Expecter) SelectLogline(ctx interface{}, request interface{}) *MockSelectLoglineService_SelectLogline_Call {
-	return &MockSelectLoglineService_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, request)}
+//   - request services.UpdateLoglineRequest
+func (_e *MockUpdateLoglineService_Expecter) UpdateLogline(ctx interface{}, request interface{}) *MockUpdateLoglineService_UpdateLogline_Call {
+	return &MockUpdateLoglineService_UpdateLogline_Call{Call: _e.mock.On("UpdateLogline", ctx, request)}
 }
 
-func (_c *MockSelectLoglineService_SelectLogline_Call) Run(run func(ctx context.Context, request services.SelectLoglineRequest)) *MockSelectLoglineService_SelectLogline_Call {
+func (_c *MockUpdateLoglineService_UpdateLogline_Call) Run(run func(ctx context.Context, request services.UpdateLoglineRequest)) *MockUpdateLoglineService_UpdateLogline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 services.SelectLoglineRequest
+		var arg1 services.UpdateLoglineRequest
 		if args[1] != nil {
-			arg1 = args[1].(services.SelectLoglineRequest)
+			arg1 = args[1].(services.UpdateLoglineRequest)
 		}
 		run(
 			arg0,
@@ -1047,12 +3803,297 @@ func (_c *MockSelectLoglineService_SelectLogline_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *MockSelectLoglineService_SelectLogline_Call) Return(logline *models.Logline, err error) *MockSelectLoglineService_SelectLogline_Call {
+func (_c *MockUpdateLoglineService_UpdateLogline_Call) Return(logline *models.Logline, err error) *MockUpdateLoglineService_UpdateLogline_Call {
 	_c.Call.Return(logline, err)
 	return _c
 }
 
-func (_c *MockSelectLoglineService_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, request services.SelectLoglineRequest) (*models.Logline, error)) *MockSelectLoglineService_SelectLogline_Call {
+func (_c *MockUpdateLoglineService_UpdateLogline_Call) RunAndReturn(run func(ctx context.Context, request services.UpdateLoglineRequest) (*models.Logline, error)) *MockUpdateLoglineService_UpdateLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdateProjectService creates a new instance of MockUpdateProjectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateProjectService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateProjectService {
+	mock := &MockUpdateProjectService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUpdateProjectService is an autogenerated mock type for the UpdateProjectService type
+type MockUpdateProjectService struct {
+	mock.Mock
+}
+
+type MockUpdateProjectService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateProjectService) EXPECT() *MockUpdateProjectService_Expecter {
+	return &MockUpdateProjectService_Expecter{mock: &_m.Mock}
+}
+
+// UpdateProject provides a mock function for the type MockUpdateProjectService
+func (_mock *MockUpdateProjectService) UpdateProject(ctx context.Context, request services.UpdateProjectRequest) (*models.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProject")
+	}
+
+	var r0 *models.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.UpdateProjectRequest) (*models.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.UpdateProjectRequest) *models.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.UpdateProjectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUpdateProjectService_UpdateProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProject'
+type MockUpdateProjectService_UpdateProject_Call struct {
+	*mock.Call
+}
+
+// UpdateProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.UpdateProjectRequest
+func (_e *MockUpdateProjectService_Expecter) UpdateProject(ctx interface{}, request interface{}) *MockUpdateProjectService_UpdateProject_Call {
+	return &MockUpdateProjectService_UpdateProject_Call{Call: _e.mock.On("UpdateProject", ctx, request)}
+}
+
+func (_c *MockUpdateProjectService_UpdateProject_Call) Run(run func(ctx context.Context, request services.UpdateProjectRequest)) *MockUpdateProjectService_UpdateProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.UpdateProjectRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.UpdateProjectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUpdateProjectService_UpdateProject_Call) Return(project *models.Project, err error) *MockUpdateProjectService_UpdateProject_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockUpdateProjectService_UpdateProject_Call) RunAndReturn(run func(ctx context.Context, request services.UpdateProjectRequest) (*models.Project, error)) *MockUpdateProjectService_UpdateProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdateStoryPlanService creates a new instance of MockUpdateStoryPlanService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateStoryPlanService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateStoryPlanService {
+	mock := &MockUpdateStoryPlanService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUpdateStoryPlanService is an autogenerated mock type for the UpdateStoryPlanService type
+type MockUpdateStoryPlanService struct {
+	mock.Mock
+}
+
+type MockUpdateStoryPlanService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateStoryPlanService) EXPECT() *MockUpdateStoryPlanService_Expecter {
+	return &MockUpdateStoryPlanService_Expecter{mock: &_m.Mock}
+}
+
+// UpdateStoryPlan provides a mock function for the type MockUpdateStoryPlanService
+func (_mock *MockUpdateStoryPlanService) UpdateStoryPlan(ctx context.Context, request services.UpdateStoryPlanRequest) (*storyplanmodel.Plan, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStoryPlan")
+	}
+
+	var r0 *storyplanmodel.Plan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.UpdateStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.UpdateStoryPlanRequest) *storyplanmodel.Plan); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*storyplanmodel.Plan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.UpdateStoryPlanRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUpdateStoryPlanService_UpdateStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStoryPlan'
+type MockUpdateStoryPlanService_UpdateStoryPlan_Call struct {
+	*mock.Call
+}
+
+// UpdateStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.UpdateStoryPlanRequest
+func (_e *MockUpdateStoryPlanService_Expecter) UpdateStoryPlan(ctx interface{}, request interface{}) *MockUpdateStoryPlanService_UpdateStoryPlan_Call {
+	return &MockUpdateStoryPlanService_UpdateStoryPlan_Call{Call: _e.mock.On("UpdateStoryPlan", ctx, request)}
+}
+
+func (_c *MockUpdateStoryPlanService_UpdateStoryPlan_Call) Run(run func(ctx context.Context, request services.UpdateStoryPlanRequest)) *MockUpdateStoryPlanService_UpdateStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.UpdateStoryPlanRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.UpdateStoryPlanRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUpdateStoryPlanService_UpdateStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockUpdateStoryPlanService_UpdateStoryPlan_Call {
+	_c.Call.Return(plan, err)
+	return _c
+}
+
+func (_c *MockUpdateStoryPlanService_UpdateStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.UpdateStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockUpdateStoryPlanService_UpdateStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpgradeBeatsSheetsService creates a new instance of MockUpgradeBeatsSheetsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpgradeBeatsSheetsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpgradeBeatsSheetsService {
+	mock := &MockUpgradeBeatsSheetsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUpgradeBeatsSheetsService is an autogenerated mock type for the UpgradeBeatsSheetsService type
+type MockUpgradeBeatsSheetsService struct {
+	mock.Mock
+}
+
+type MockUpgradeBeatsSheetsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpgradeBeatsSheetsService) EXPECT() *MockUpgradeBeatsSheetsService_Expecter {
+	return &MockUpgradeBeatsSheetsService_Expecter{mock: &_m.Mock}
+}
+
+// UpgradeBeatsSheets provides a mock function for the type MockUpgradeBeatsSheetsService
+func (_mock *MockUpgradeBeatsSheetsService) UpgradeBeatsSheets(ctx context.Context, request services.UpgradeBeatsSheetsRequest) ([]*models.BeatsSheetUpgrade, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpgradeBeatsSheets")
+	}
+
+	var r0 []*models.BeatsSheetUpgrade
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.UpgradeBeatsSheetsRequest) ([]*models.BeatsSheetUpgrade, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.UpgradeBeatsSheetsRequest) []*models.BeatsSheetUpgrade); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.BeatsSheetUpgrade)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.UpgradeBeatsSheetsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUpgradeBeatsSheetsService_UpgradeBeatsSheets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpgradeBeatsSheets'
+type MockUpgradeBeatsSheetsService_UpgradeBeatsSheets_Call struct {
+	*mock.Call
+}
+
+// UpgradeBeatsSheets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.UpgradeBeatsSheetsRequest
+func (_e *MockUpgradeBeatsSheetsService_Expecter) UpgradeBeatsSheets(ctx interface{}, request interface{}) *MockUpgradeBeatsSheetsService_UpgradeBeatsSheets_Call {
+	return &MockUpgradeBeatsSheetsService_UpgradeBeatsSheets_Call{Call: _e.mock.On("UpgradeBeatsSheets", ctx, request)}
+}
+
+func (_c *MockUpgradeBeatsSheetsService_UpgradeBeatsSheets_Call) Run(run func(ctx context.Context, request services.UpgradeBeatsSheetsRequest)) *MockUpgradeBeatsSheetsService_UpgradeBeatsSheets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.UpgradeBeatsSheetsRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.UpgradeBeatsSheetsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUpgradeBeatsSheetsService_UpgradeBeatsSheets_Call) Return(beatsSheetUpgrades []*models.BeatsSheetUpgrade, err error) *MockUpgradeBeatsSheetsService_UpgradeBeatsSheets_Call {
+	_c.Call.Return(beatsSheetUpgrades, err)
+	return _c
+}
+
+func (_c *MockUpgradeBeatsSheetsService_UpgradeBeatsSheets_Call) RunAndReturn(run func(ctx context.Context, request services.UpgradeBeatsSheetsRequest) ([]*models.BeatsSheetUpgrade, error)) *MockUpgradeBeatsSheetsService_UpgradeBeatsSheets_Call {
 	_c.Call.Return(run)
 	return _c
 }
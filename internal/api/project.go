@@ -0,0 +1,28 @@
+package api
+
+import (
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+func projectIDToAPI(projectID uuid.UUID) apimodels.OptProjectID {
+	return lo.Ternary(
+		projectID != uuid.Nil,
+		apimodels.NewOptProjectID(apimodels.ProjectID(projectID)),
+		apimodels.OptProjectID{},
+	)
+}
+
+func projectToAPI(project *models.Project) *apimodels.Project {
+	return &apimodels.Project{
+		ID:          apimodels.ProjectID(project.ID),
+		UserID:      apimodels.UserID(project.UserID),
+		Name:        project.Name,
+		Description: project.Description,
+		CreatedAt:   project.CreatedAt,
+		UpdatedAt:   timeToOptDateTime(project.UpdatedAt),
+	}
+}
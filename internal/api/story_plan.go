@@ -0,0 +1,142 @@
+package api
+
+import (
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func optIntToPtr(value apimodels.OptInt) *int {
+	return lo.Ternary(value.IsSet(), lo.ToPtr(value.Value), nil)
+}
+
+func ptrToOptInt(value *int) apimodels.OptInt {
+	if value == nil {
+		return apimodels.OptInt{}
+	}
+
+	return apimodels.NewOptInt(*value)
+}
+
+func userIDToAPI(userID *uuid.UUID) apimodels.OptUserID {
+	if userID == nil {
+		return apimodels.OptUserID{}
+	}
+
+	return apimodels.NewOptUserID(apimodels.UserID(*userID))
+}
+
+func storyPlanActsFromAPI(acts []apimodels.StoryPlanAct) []storyplanmodel.Act {
+	if len(acts) == 0 {
+		return nil
+	}
+
+	return lo.Map(acts, func(item apimodels.StoryPlanAct, _ int) storyplanmodel.Act {
+		return storyplanmodel.Act{
+			Name:    item.GetName(),
+			Key:     item.GetKey(),
+			Purpose: item.GetPurpose(),
+		}
+	})
+}
+
+func storyPlanRepeatFromAPI(repeat apimodels.OptStoryPlanRepeat) *storyplanmodel.Repeat {
+	if !repeat.IsSet() {
+		return nil
+	}
+
+	return &storyplanmodel.Repeat{
+		Min: optIntToPtr(repeat.Value.GetMin()),
+		Max: optIntToPtr(repeat.Value.GetMax()),
+	}
+}
+
+func storyPlanRepeatToAPI(repeat *storyplanmodel.Repeat) apimodels.OptStoryPlanRepeat {
+	if repeat == nil {
+		return apimodels.OptStoryPlanRepeat{}
+	}
+
+	return apimodels.NewOptStoryPlanRepeat(apimodels.StoryPlanRepeat{
+		Min: ptrToOptInt(repeat.Min),
+		Max: ptrToOptInt(repeat.Max),
+	})
+}
+
+func storyPlanBeatsFromAPI(beats []apimodels.StoryPlanBeat) []storyplanmodel.Beat {
+	return lo.Map(beats, func(item apimodels.StoryPlanBeat, _ int) storyplanmodel.Beat {
+		return storyplanmodel.Beat{
+			Name:      item.GetName(),
+			Key:       item.GetKey(),
+			KeyPoints: item.GetKeyPoints(),
+			Purpose:   item.GetPurpose(),
+			Scenes: storyplanmodel.Scenes{
+				Exact: optIntToPtr(item.Scenes.GetExact()),
+				Min:   optIntToPtr(item.Scenes.GetMin()),
+				Max:   optIntToPtr(item.Scenes.GetMax()),
+			},
+			Act:      item.GetAct().Or(""),
+			Optional: item.GetOptional().Or(false),
+			Repeat:   storyPlanRepeatFromAPI(item.GetRepeat()),
+		}
+	})
+}
+
+func storyPlanActsToAPI(acts []storyplanmodel.Act) []apimodels.StoryPlanAct {
+	if len(acts) == 0 {
+		return nil
+	}
+
+	return lo.Map(acts, func(item storyplanmodel.Act, _ int) apimodels.StoryPlanAct {
+		return apimodels.StoryPlanAct{
+			Name:    item.Name,
+			Key:     item.Key,
+			Purpose: item.Purpose,
+		}
+	})
+}
+
+func storyPlanToAPI(plan *storyplanmodel.Plan) *apimodels.StoryPlan {
+	return &apimodels.StoryPlan{
+		ID:      apimodels.StoryPlanID(plan.Metadata.ID),
+		Slug:    apimodels.Slug(plan.Metadata.Slug),
+		Version: plan.Metadata.Version,
+		UserID:  userIDToAPI(plan.Metadata.UserID),
+		Name:    plan.Metadata.Name,
+		Lang:    apimodels.Lang(plan.Metadata.Lang),
+		Acts:    storyPlanActsToAPI(plan.Acts),
+		Beats: lo.Map(plan.Beats, func(item storyplanmodel.Beat, _ int) apimodels.StoryPlanBeat {
+			return apimodels.StoryPlanBeat{
+				Name:      item.Name,
+				Key:       item.Key,
+				KeyPoints: item.KeyPoints,
+				Purpose:   item.Purpose,
+				Scenes: apimodels.StoryPlanScenes{
+					Exact: ptrToOptInt(item.Scenes.Exact),
+					Min:   ptrToOptInt(item.Scenes.Min),
+					Max:   ptrToOptInt(item.Scenes.Max),
+				},
+				Act:      lo.Ternary(item.Act != "", apimodels.NewOptString(item.Act), apimodels.OptString{}),
+				Optional: lo.Ternary(item.Optional, apimodels.NewOptBool(true), apimodels.OptBool{}),
+				Repeat:   storyPlanRepeatToAPI(item.Repeat),
+			}
+		}),
+		CreatedAt: plan.Metadata.CreatedAt,
+	}
+}
+
+func beatsSheetActsToAPI(acts []models.BeatsSheetAct) []apimodels.BeatsSheetAct {
+	if len(acts) == 0 {
+		return nil
+	}
+
+	return lo.Map(acts, func(item models.BeatsSheetAct, _ int) apimodels.BeatsSheetAct {
+		return apimodels.BeatsSheetAct{
+			Key:   item.Key,
+			Name:  item.Name,
+			Beats: item.Beats,
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed delete_beats_sheet.sql
+var deleteBeatsSheetQuery string
+
+// DeleteBeatsSheetData moves a single beats sheet to the trash.
+type DeleteBeatsSheetData struct {
+	ID uuid.UUID
+	// UserID must own the logline of the beats sheet.
+	UserID uuid.UUID
+
+	Now time.Time
+}
+
+type DeleteBeatsSheetRepository struct{}
+
+func NewDeleteBeatsSheetRepository() *DeleteBeatsSheetRepository {
+	return &DeleteBeatsSheetRepository{}
+}
+
+func (repository *DeleteBeatsSheetRepository) DeleteBeatsSheet(
+	ctx context.Context, data DeleteBeatsSheetData,
+) (*BeatsSheetEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.DeleteBeatsSheet")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("sheet.id", data.ID.String()),
+		attribute.String("sheet.userID", data.UserID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &BeatsSheetEntity{}
+
+	err = tx.NewRaw(deleteBeatsSheetQuery, data.ID, data.UserID, data.Now).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrBeatsSheetNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("delete beats sheet: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
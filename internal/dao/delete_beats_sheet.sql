@@ -0,0 +1,13 @@
+UPDATE beats_sheets
+SET
+  deleted_at = ?2
+FROM
+  loglines
+WHERE
+  beats_sheets.id = ?0
+  AND beats_sheets.deleted_at IS NULL
+  AND loglines.id = beats_sheets.logline_id
+  AND loglines.user_id = ?1
+  AND loglines.deleted_at IS NULL
+RETURNING
+  beats_sheets.*;
@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed delete_logline.sql
+var deleteLoglineQuery string
+
+// DeleteLoglineData moves a logline to the trash. The beats sheets of the logline are trashed along with it, at the
+// same date, so they can be restored together.
+type DeleteLoglineData struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+
+	Now time.Time
+}
+
+type DeleteLoglineRepository struct{}
+
+func NewDeleteLoglineRepository() *DeleteLoglineRepository {
+	return &DeleteLoglineRepository{}
+}
+
+func (repository *DeleteLoglineRepository) DeleteLogline(
+	ctx context.Context, data DeleteLoglineData,
+) (*LoglineEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.DeleteLogline")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("logline.id", data.ID.String()),
+		attribute.String("logline.userID", data.UserID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &LoglineEntity{}
+
+	err = tx.NewRaw(deleteLoglineQuery, data.ID, data.UserID, data.Now).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrLoglineNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("delete logline: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
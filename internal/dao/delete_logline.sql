@@ -0,0 +1,39 @@
+WITH
+  deleted_logline AS (
+    UPDATE loglines
+    SET
+      deleted_at = ?2
+    WHERE
+      id = ?0
+      AND user_id = ?1
+      AND deleted_at IS NULL
+    RETURNING
+      *
+  ),
+  deleted_beats_sheets AS (
+    UPDATE beats_sheets
+    SET
+      deleted_at = ?2
+    WHERE
+      logline_id IN (
+        SELECT
+          id
+        FROM
+          deleted_logline
+      )
+      AND deleted_at IS NULL
+  )
+SELECT
+  *,
+  ARRAY(
+    SELECT
+      tag
+    FROM
+      logline_tags
+    WHERE
+      logline_tags.logline_id = deleted_logline.id
+    ORDER BY
+      tag
+  ) AS tags
+FROM
+  deleted_logline;
@@ -0,0 +1,184 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestDeleteLogline(t *testing.T) {
+	logline := func(deletedAt time.Time) *dao.LoglineEntity {
+		return &dao.LoglineEntity{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Slug:      "test-slug",
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			Tags:      []string{},
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			DeletedAt: deletedAt,
+		}
+	}
+
+	beatsSheet := func(id, loglineID uuid.UUID, deletedAt time.Time) *dao.BeatsSheetEntity {
+		return &dao.BeatsSheetEntity{
+			ID:        id,
+			LoglineID: loglineID,
+			Content: []models.Beat{
+				{
+					Key:     "test-beat",
+					Title:   "Test Beat",
+					Content: "Test Beat Content",
+				},
+			},
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			DeletedAt: deletedAt,
+		}
+	}
+
+	testCases := []struct {
+		name string
+
+		loglineFixtures    []*dao.LoglineEntity
+		beatsSheetFixtures []*dao.BeatsSheetEntity
+
+		data dao.DeleteLoglineData
+
+		expect            *dao.LoglineEntity
+		expectBeatsSheets []*dao.BeatsSheetEntity
+		expectErr         error
+	}{
+		{
+			name: "Success",
+
+			loglineFixtures: []*dao.LoglineEntity{logline(time.Time{})},
+			beatsSheetFixtures: []*dao.BeatsSheetEntity{
+				beatsSheet(
+					uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					time.Time{},
+				),
+				// Already in the trash: keeps its original deletion date.
+				beatsSheet(
+					uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				),
+				// Other logline.
+				beatsSheet(
+					uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					time.Time{},
+				),
+			},
+
+			data: dao.DeleteLoglineData{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Now:    time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: logline(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			expectBeatsSheets: []*dao.BeatsSheetEntity{
+				beatsSheet(
+					uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				),
+				beatsSheet(
+					uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				),
+				beatsSheet(
+					uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					time.Time{},
+				),
+			},
+		},
+		{
+			name: "AlreadyDeleted",
+
+			loglineFixtures: []*dao.LoglineEntity{logline(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC))},
+
+			data: dao.DeleteLoglineData{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Now:    time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectBeatsSheets: []*dao.BeatsSheetEntity{},
+			expectErr:         dao.ErrLoglineNotFound,
+		},
+		{
+			name: "WrongUser",
+
+			loglineFixtures: []*dao.LoglineEntity{logline(time.Time{})},
+			beatsSheetFixtures: []*dao.BeatsSheetEntity{
+				beatsSheet(
+					uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					time.Time{},
+				),
+			},
+
+			data: dao.DeleteLoglineData{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+				Now:    time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectBeatsSheets: []*dao.BeatsSheetEntity{
+				beatsSheet(
+					uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					time.Time{},
+				),
+			},
+			expectErr: dao.ErrLoglineNotFound,
+		},
+	}
+
+	repository := dao.NewDeleteLoglineRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.loglineFixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.loglineFixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				if len(testCase.beatsSheetFixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.beatsSheetFixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.DeleteLogline(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+
+				beatsSheets := make([]*dao.BeatsSheetEntity, 0)
+				require.NoError(t, db.NewSelect().Model(&beatsSheets).Order("id").Scan(ctx))
+				require.Equal(t, testCase.expectBeatsSheets, beatsSheets)
+			})
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed delete_project.sql
+var deleteProjectQuery string
+
+// DeleteProjectData permanently deletes a project. The loglines of the project are kept, and no longer belong to
+// any project.
+type DeleteProjectData struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+type DeleteProjectRepository struct{}
+
+func NewDeleteProjectRepository() *DeleteProjectRepository {
+	return &DeleteProjectRepository{}
+}
+
+func (repository *DeleteProjectRepository) DeleteProject(
+	ctx context.Context, data DeleteProjectData,
+) (*ProjectEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.DeleteProject")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("project.id", data.ID.String()),
+		attribute.String("project.userID", data.UserID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &ProjectEntity{}
+
+	err = tx.NewRaw(deleteProjectQuery, data.ID, data.UserID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrProjectNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("delete project: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,6 @@
+DELETE FROM projects
+WHERE
+  id = ?0
+  AND user_id = ?1
+RETURNING
+  *;
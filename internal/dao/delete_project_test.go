@@ -0,0 +1,106 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestDeleteProject(t *testing.T) {
+	projectFixtures := []*dao.ProjectEntity{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Name:      "The Sea Trilogy",
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	loglineFixtures := []*dao.LoglineEntity{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Slug:      "test-slug",
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.DeleteProjectData
+
+		expect    *dao.ProjectEntity
+		expectErr error
+		// Project of the logline fixture after the deletion.
+		expectLoglineProjectID uuid.UUID
+	}{
+		{
+			name: "Success",
+
+			data: dao.DeleteProjectData{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			expect: projectFixtures[0],
+
+			// The logline is kept, out of any project.
+			expectLoglineProjectID: uuid.Nil,
+		},
+		{
+			name: "WrongUser",
+
+			data: dao.DeleteProjectData{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			},
+
+			expectErr: dao.ErrProjectNotFound,
+
+			expectLoglineProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+	}
+
+	repository := dao.NewDeleteProjectRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&projectFixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&loglineFixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.DeleteProject(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+
+				logline := new(dao.LoglineEntity)
+
+				err = db.NewSelect().Model(logline).Where("id = ?", loglineFixtures[0].ID).Scan(ctx)
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectLoglineProjectID, logline.ProjectID)
+			})
+		})
+	}
+}
@@ -15,13 +15,22 @@ var ErrBeatsSheetNotFound = errors.New("beats schematic not found")
 type BeatsSheetEntity struct {
 	bun.BaseModel `bun:"table:beats_sheets"`
 
-	ID        uuid.UUID `bun:"id,pk,type:uuid"`
-	LoglineID uuid.UUID `bun:"logline_id,type:uuid"`
+	ID          uuid.UUID `bun:"id,pk,type:uuid"`
+	LoglineID   uuid.UUID `bun:"logline_id,type:uuid"`
+	StoryPlanID uuid.UUID `bun:"story_plan_id,type:uuid,nullzero"`
+	// SourceID is the sheet this one was derived from, by converting it to another story plan or translating it to
+	// another language.
+	SourceID uuid.UUID `bun:"source_id,type:uuid,nullzero"`
+	// ParentID is the previous revision of this sheet, when it was created by applying regenerated or expanded beats
+	// to it. Unlike SourceID, the parent always follows the same story plan and language.
+	ParentID uuid.UUID `bun:"parent_id,type:uuid,nullzero"`
 
 	Content []models.Beat `bun:"content,type:jsonb"`
 	Lang    models.Lang   `bun:"lang"`
 
 	CreatedAt time.Time `bun:"created_at"`
+	// DeletedAt is set while the beats sheet is in the trash.
+	DeletedAt time.Time `bun:"deleted_at,nullzero"`
 }
 
 type BeatsSheetPreviewEntity struct {
@@ -32,3 +41,14 @@ type BeatsSheetPreviewEntity struct {
 
 	CreatedAt time.Time `bun:"created_at"`
 }
+
+type TrashedBeatsSheetEntity struct {
+	bun.BaseModel `bun:"table:beats_sheets"`
+
+	ID        uuid.UUID   `bun:"id,pk,type:uuid"`
+	LoglineID uuid.UUID   `bun:"logline_id,type:uuid"`
+	Lang      models.Lang `bun:"lang"`
+
+	CreatedAt time.Time `bun:"created_at"`
+	DeletedAt time.Time `bun:"deleted_at"`
+}
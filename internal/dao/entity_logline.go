@@ -21,22 +21,42 @@ type LoglineEntity struct {
 	ID     uuid.UUID   `bun:"id,pk,type:uuid"`
 	UserID uuid.UUID   `bun:"user_id,type:uuid"`
 	Slug   models.Slug `bun:"slug"`
-
-	Name    string      `bun:"name"`
-	Content string      `bun:"content"`
-	Lang    models.Lang `bun:"lang"`
+	// SourceID is the logline this one was derived from, for example by translating it to another language.
+	SourceID uuid.UUID `bun:"source_id,type:uuid,nullzero"`
+	// ProjectID is the project the logline is filed under, if any.
+	ProjectID uuid.UUID `bun:"project_id,type:uuid,nullzero"`
+
+	Name    string       `bun:"name"`
+	Content string       `bun:"content"`
+	Lang    models.Lang  `bun:"lang"`
+	Genre   models.Genre `bun:"genre,nullzero"`
+	// Tags are stored in the logline_tags table. Queries returning loglines aggregate them into an array.
+	Tags []string `bun:"tags,array,scanonly"`
 
 	CreatedAt time.Time `bun:"created_at"`
+	// UpdatedAt is set when the logline is edited after its creation.
+	UpdatedAt time.Time `bun:"updated_at,nullzero"`
+	// DeletedAt is set while the logline is in the trash.
+	DeletedAt time.Time `bun:"deleted_at,nullzero"`
 }
 
 type LoglinePreviewEntity struct {
 	bun.BaseModel `bun:"table:loglines"`
 
-	Slug models.Slug `bun:"slug"`
+	ID        uuid.UUID   `bun:"id,pk,type:uuid"`
+	Slug      models.Slug `bun:"slug"`
+	ProjectID uuid.UUID   `bun:"project_id,type:uuid,nullzero"`
 
-	Name    string      `bun:"name"`
-	Content string      `bun:"content"`
-	Lang    models.Lang `bun:"lang"`
+	Name    string       `bun:"name"`
+	Content string       `bun:"content"`
+	Lang    models.Lang  `bun:"lang"`
+	Genre   models.Genre `bun:"genre,nullzero"`
+	Tags    []string     `bun:"tags,array,scanonly"`
 
 	CreatedAt time.Time `bun:"created_at"`
 }
+
+type LoglineTagEntity struct {
+	Tag   string `bun:"tag"`
+	Count int    `bun:"count"`
+}
@@ -0,0 +1,28 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+var ErrLoglineRevisionNotFound = errors.New("logline revision not found")
+
+// LoglineRevisionEntity is a snapshot of the editable content of a logline. A new revision is saved every time the
+// logline is written.
+type LoglineRevisionEntity struct {
+	bun.BaseModel `bun:"table:logline_revisions"`
+
+	ID        uuid.UUID `bun:"id,pk,type:uuid"`
+	LoglineID uuid.UUID `bun:"logline_id,type:uuid"`
+
+	Name    string      `bun:"name"`
+	Content string      `bun:"content"`
+	Lang    models.Lang `bun:"lang"`
+
+	CreatedAt time.Time `bun:"created_at"`
+}
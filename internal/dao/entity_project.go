@@ -0,0 +1,26 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+var ErrProjectNotFound = errors.New("project not found")
+
+// ProjectEntity groups loglines of a user, usually the ones written for the same novel.
+type ProjectEntity struct {
+	bun.BaseModel `bun:"table:projects"`
+
+	ID     uuid.UUID `bun:"id,pk,type:uuid"`
+	UserID uuid.UUID `bun:"user_id,type:uuid"`
+
+	Name        string `bun:"name"`
+	Description string `bun:"description"`
+
+	CreatedAt time.Time `bun:"created_at"`
+	// UpdatedAt is set when the project is edited after its creation.
+	UpdatedAt time.Time `bun:"updated_at,nullzero"`
+}
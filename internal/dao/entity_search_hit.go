@@ -0,0 +1,25 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type SearchHitEntity struct {
+	Kind models.SearchHitKind `bun:"kind"`
+	// ID of the logline or beats sheet that matched the search.
+	ID uuid.UUID `bun:"id,type:uuid"`
+	// LoglineID is the logline the hit belongs to. For loglines, this is the same as ID.
+	LoglineID uuid.UUID   `bun:"logline_id,type:uuid"`
+	Slug      models.Slug `bun:"slug"`
+	Name      string      `bun:"name"`
+	Lang      models.Lang `bun:"lang"`
+
+	Rank    float64 `bun:"rank"`
+	Snippet string  `bun:"snippet"`
+
+	CreatedAt time.Time `bun:"created_at"`
+}
@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+var (
+	ErrStoryPlanNotFound      = errors.New("story plan not found")
+	ErrStoryPlanAlreadyExists = errors.New("story plan already exists")
+)
+
+// StoryPlanEntity is an immutable revision of a story plan. Revisions of the same plan share an owner, a slug and a
+// language, and are ordered by version.
+type StoryPlanEntity struct {
+	bun.BaseModel `bun:"table:story_plans"`
+
+	ID uuid.UUID `bun:"id,pk,type:uuid"`
+	// UserID is the owner of a custom plan. It is nil for built-in plans, which are available to everyone.
+	UserID  *uuid.UUID  `bun:"user_id,type:uuid"`
+	Slug    models.Slug `bun:"slug"`
+	Version int         `bun:"version"`
+
+	Name  string                `bun:"name"`
+	Lang  models.Lang           `bun:"lang"`
+	Acts  []storyplanmodel.Act  `bun:"acts,type:jsonb"`
+	Beats []storyplanmodel.Beat `bun:"beats,type:jsonb"`
+
+	// SeedHash identifies the embedded plan a built-in plan was last seeded from. It is carried over to the versions
+	// created through the API, and empty for custom plans.
+	SeedHash string `bun:"seed_hash,nullzero"`
+
+	CreatedAt time.Time `bun:"created_at"`
+}
+
+type StoryPlanPreviewEntity struct {
+	bun.BaseModel `bun:"table:story_plans"`
+
+	ID      uuid.UUID   `bun:"id,pk,type:uuid"`
+	UserID  *uuid.UUID  `bun:"user_id,type:uuid"`
+	Slug    models.Slug `bun:"slug"`
+	Version int         `bun:"version"`
+
+	Name string      `bun:"name"`
+	Lang models.Lang `bun:"lang"`
+
+	CreatedAt time.Time `bun:"created_at"`
+}
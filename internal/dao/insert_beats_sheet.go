@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"fmt"
 
+	"github.com/uptrace/bun"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel/golib/otel"
@@ -35,6 +36,9 @@ func (repository *InsertBeatsSheetRepository) InsertBeatsSheet(
 	span.SetAttributes(
 		attribute.String("sheet.id", data.Sheet.ID.String()),
 		attribute.String("sheet.loglineID", data.Sheet.LoglineID.String()),
+		attribute.String("sheet.storyPlanID", data.Sheet.StoryPlanID.String()),
+		attribute.String("sheet.sourceID", data.Sheet.SourceID.String()),
+		attribute.String("sheet.parentID", data.Sheet.ParentID.String()),
 		attribute.String("sheet.lang", data.Sheet.Lang.String()),
 	)
 
@@ -50,9 +54,12 @@ func (repository *InsertBeatsSheetRepository) InsertBeatsSheet(
 			insertBeatsSheetQuery,
 			data.Sheet.ID,
 			data.Sheet.LoglineID,
+			bun.NullZero(data.Sheet.StoryPlanID),
 			data.Sheet.Content,
 			data.Sheet.Lang,
 			data.Sheet.CreatedAt,
+			bun.NullZero(data.Sheet.SourceID),
+			bun.NullZero(data.Sheet.ParentID),
 		).
 		Scan(ctx, entity)
 	if err != nil {
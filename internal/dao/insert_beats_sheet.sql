@@ -1,6 +1,15 @@
 INSERT INTO
-  beats_sheets (id, logline_id, content, lang, created_at)
+  beats_sheets (
+    id,
+    logline_id,
+    story_plan_id,
+    content,
+    lang,
+    created_at,
+    source_id,
+    parent_id
+  )
 VALUES
-  (?0, ?1, ?2, ?3, ?4)
+  (?0, ?1, ?2, ?3, ?4, ?5, ?6, ?7)
 RETURNING
   *;
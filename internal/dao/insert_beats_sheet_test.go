@@ -13,13 +13,15 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/dao"
 	"github.com/a-novel/service-story-schematics/models"
 	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 func TestInsertBeatsSheet(t *testing.T) {
 	testCases := []struct {
 		name string
 
-		fixtures []*dao.BeatsSheetEntity
+		storyPlanFixtures []*dao.StoryPlanEntity
+		fixtures          []*dao.BeatsSheetEntity
 
 		data dao.InsertBeatsSheetData
 
@@ -128,6 +130,162 @@ func TestInsertBeatsSheet(t *testing.T) {
 				CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 			},
 		},
+		{
+			name: "WithStoryPlan",
+
+			storyPlanFixtures: []*dao.StoryPlanEntity{
+				{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Slug:    "test-plan",
+					Version: 1,
+					Name:    "Test Plan",
+					Lang:    models.LangEN,
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.InsertBeatsSheetData{
+				Sheet: models.BeatsSheet{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "test-beat",
+							Title:   "Test Beat",
+							Content: "Test Beat Content",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &dao.BeatsSheetEntity{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+				Content: []models.Beat{
+					{
+						Key:     "test-beat",
+						Title:   "Test Beat",
+						Content: "Test Beat Content",
+					},
+				},
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "WithSource",
+
+			fixtures: []*dao.BeatsSheetEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "test-beat",
+							Title:   "Test Beat",
+							Content: "Test Beat Content",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.InsertBeatsSheetData{
+				Sheet: models.BeatsSheet{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					SourceID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "other-beat",
+							Title:   "Other Beat",
+							Content: "Other Beat Content",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &dao.BeatsSheetEntity{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				SourceID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Content: []models.Beat{
+					{
+						Key:     "other-beat",
+						Title:   "Other Beat",
+						Content: "Other Beat Content",
+					},
+				},
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "WithParent",
+
+			fixtures: []*dao.BeatsSheetEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "test-beat",
+							Title:   "Test Beat",
+							Content: "Test Beat Content",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.InsertBeatsSheetData{
+				Sheet: models.BeatsSheet{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					ParentID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "test-beat",
+							Title:   "Test Beat",
+							Content: "Test Beat Content 2",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &dao.BeatsSheetEntity{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				ParentID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Content: []models.Beat{
+					{
+						Key:     "test-beat",
+						Title:   "Test Beat",
+						Content: "Test Beat Content 2",
+					},
+				},
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
 	}
 
 	repository := dao.NewInsertBeatsSheetRepository()
@@ -140,6 +298,11 @@ func TestInsertBeatsSheet(t *testing.T) {
 				db, err := postgres.GetContext(ctx)
 				require.NoError(t, err)
 
+				if len(testCase.storyPlanFixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.storyPlanFixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
 				if len(testCase.fixtures) > 0 {
 					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
 					require.NoError(t, err)
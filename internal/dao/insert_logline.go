@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/driver/pgdriver"
 	"go.opentelemetry.io/otel/attribute"
 
@@ -24,10 +26,18 @@ type InsertLoglineData struct {
 	ID     uuid.UUID
 	UserID uuid.UUID
 	Slug   models.Slug
+	// Optional, links the new logline to the one it was derived from.
+	SourceID uuid.UUID
+	// Optional. The project must belong to the same user.
+	ProjectID uuid.UUID
 
 	Name    string
 	Content string
 	Lang    models.Lang
+	// Optional.
+	Genre models.Genre
+	// Must not contain duplicates. See models.NormalizeTags.
+	Tags []string
 
 	Now time.Time
 }
@@ -50,6 +60,10 @@ func (repository *InsertLoglineRepository) InsertLogline(
 		attribute.String("logline.slug", data.Slug.String()),
 		attribute.String("logline.name", data.Name),
 		attribute.String("logline.lang", data.Lang.String()),
+		attribute.String("logline.sourceID", data.SourceID.String()),
+		attribute.String("logline.projectID", data.ProjectID.String()),
+		attribute.String("logline.genre", data.Genre.String()),
+		attribute.StringSlice("logline.tags", data.Tags),
 	)
 
 	tx, err := postgres.GetContext(ctx)
@@ -69,6 +83,10 @@ func (repository *InsertLoglineRepository) InsertLogline(
 			data.Content,
 			data.Lang,
 			data.Now,
+			bun.NullZero(data.SourceID),
+			bun.NullZero(data.Genre),
+			pgdialect.Array(data.Tags),
+			bun.NullZero(data.ProjectID),
 		).
 		Scan(ctx, entity)
 	if err != nil {
@@ -1,14 +1,48 @@
-INSERT INTO
-  loglines (
-    id,
-    user_id,
-    slug,
-    name,
-    content,
-    lang,
-    created_at
+WITH
+  inserted AS (
+    INSERT INTO
+      loglines (
+        id,
+        user_id,
+        slug,
+        name,
+        content,
+        lang,
+        created_at,
+        source_id,
+        genre,
+        project_id
+      )
+    VALUES
+      (?0, ?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?10)
+    RETURNING
+      *
+  ),
+  revision AS (
+    INSERT INTO
+      logline_revisions (id, logline_id, name, content, lang, created_at)
+    SELECT
+      gen_random_uuid(),
+      id,
+      name,
+      content,
+      lang,
+      created_at
+    FROM
+      inserted
+  ),
+  tags AS (
+    INSERT INTO
+      logline_tags (logline_id, tag)
+    SELECT
+      inserted.id,
+      unnest(?9::text[])
+    FROM
+      inserted
   )
-VALUES
-  (?0, ?1, ?2, ?3, ?4, ?5, ?6)
-RETURNING
-  *;
+SELECT
+  *,
+  -- Rows inserted by the statement are not visible yet, so the tags are returned as they were passed.
+  COALESCE(?9::text[], '{}') AS tags
+FROM
+  inserted;
@@ -25,6 +25,8 @@ func TestInserLogline(t *testing.T) {
 
 		expect    *dao.LoglineEntity
 		expectErr error
+		// Number of revisions saved for the logline by the insert.
+		expectRevisions int
 	}{
 		{
 			name: "Success",
@@ -46,8 +48,40 @@ func TestInserLogline(t *testing.T) {
 				Name:      "Test Name",
 				Content:   "Lorem ipsum dolor sit amet",
 				Lang:      models.LangEN,
+				Tags:      []string{},
 				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
+
+			expectRevisions: 1,
+		},
+		{
+			name: "GenreAndTags",
+
+			data: dao.InsertLoglineData{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:    "test-slug",
+				Name:    "Test Name",
+				Content: "Lorem ipsum dolor sit amet",
+				Lang:    models.LangEN,
+				Genre:   models.GenreFantasy,
+				Tags:    []string{"dragons", "quest"},
+				Now:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.LoglineEntity{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "test-slug",
+				Name:      "Test Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      models.LangEN,
+				Genre:     models.GenreFantasy,
+				Tags:      []string{"dragons", "quest"},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectRevisions: 1,
 		},
 		{
 			name: "AlreadyExists",
@@ -108,8 +142,11 @@ func TestInserLogline(t *testing.T) {
 				Name:      "Test Name",
 				Content:   "Lorem ipsum dolor sit amet",
 				Lang:      models.LangEN,
+				Tags:      []string{},
 				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
+
+			expectRevisions: 1,
 		},
 		{
 			name: "SameUserDifferentSlug",
@@ -143,8 +180,51 @@ func TestInserLogline(t *testing.T) {
 				Name:      "Test Name",
 				Content:   "Lorem ipsum dolor sit amet",
 				Lang:      models.LangEN,
+				Tags:      []string{},
 				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
+
+			expectRevisions: 1,
+		},
+		{
+			name: "WithSource",
+
+			fixtures: []*dao.LoglineEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.InsertLoglineData{
+				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:     "test-slug-fr",
+				SourceID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Name:     "Nom de test",
+				Content:  "Lorem ipsum dolor sit amet",
+				Lang:     models.LangFR,
+				Now:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.LoglineEntity{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "test-slug-fr",
+				SourceID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Name:      "Nom de test",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      models.LangFR,
+				Tags:      []string{},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectRevisions: 1,
 		},
 	}
 
@@ -166,6 +246,13 @@ func TestInserLogline(t *testing.T) {
 				res, err := repository.InsertLogline(ctx, testCase.data)
 				require.ErrorIs(t, err, testCase.expectErr)
 				require.Equal(t, testCase.expect, res)
+
+				revisions, err := db.NewSelect().
+					Model((*dao.LoglineRevisionEntity)(nil)).
+					Where("logline_id = ?", testCase.data.ID).
+					Count(ctx)
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectRevisions, revisions)
 			})
 		})
 	}
@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed insert_project.sql
+var insertProjectQuery string
+
+type InsertProjectData struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+
+	Name        string
+	Description string
+
+	Now time.Time
+}
+
+type InsertProjectRepository struct{}
+
+func NewInsertProjectRepository() *InsertProjectRepository {
+	return &InsertProjectRepository{}
+}
+
+func (repository *InsertProjectRepository) InsertProject(
+	ctx context.Context, data InsertProjectData,
+) (*ProjectEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.InsertProject")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("project.id", data.ID.String()),
+		attribute.String("project.userID", data.UserID.String()),
+		attribute.String("project.name", data.Name),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &ProjectEntity{}
+
+	err = tx.
+		NewRaw(insertProjectQuery, data.ID, data.UserID, data.Name, data.Description, data.Now).
+		Scan(ctx, entity)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("insert project: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
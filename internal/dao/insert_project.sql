@@ -0,0 +1,6 @@
+INSERT INTO
+  projects (id, user_id, name, description, created_at)
+VALUES
+  (?0, ?1, ?2, ?3, ?4)
+RETURNING
+  *;
@@ -0,0 +1,77 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestInsertProject(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		data dao.InsertProjectData
+
+		expect    *dao.ProjectEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			data: dao.InsertProjectData{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Name:        "The Sea Trilogy",
+				Description: "Three novels about the sea.",
+				Now:         time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.ProjectEntity{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Name:        "The Sea Trilogy",
+				Description: "Three novels about the sea.",
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "NoDescription",
+
+			data: dao.InsertProjectData{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Name:   "The Sea Trilogy",
+				Now:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.ProjectEntity{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Name:      "The Sea Trilogy",
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	repository := dao.NewInsertProjectRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				res, err := repository.InsertProject(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
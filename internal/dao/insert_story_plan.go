@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+//go:embed insert_story_plan.sql
+var insertStoryPlanQuery string
+
+type InsertStoryPlanData struct {
+	ID uuid.UUID
+	// UserID is the owner of a custom plan. Leave it nil to insert a built-in plan.
+	UserID *uuid.UUID
+	Slug   models.Slug
+
+	Name  string
+	Lang  models.Lang
+	Acts  []storyplanmodel.Act
+	Beats []storyplanmodel.Beat
+	// SeedHash is only set by the seeder, for built-in plans.
+	SeedHash string
+
+	Now time.Time
+}
+
+type InsertStoryPlanRepository struct{}
+
+func NewInsertStoryPlanRepository() *InsertStoryPlanRepository {
+	return &InsertStoryPlanRepository{}
+}
+
+func (repository *InsertStoryPlanRepository) InsertStoryPlan(
+	ctx context.Context, data InsertStoryPlanData,
+) (*StoryPlanEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.InsertStoryPlan")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("storyPlan.id", data.ID.String()),
+		attribute.String("storyPlan.slug", data.Slug.String()),
+		attribute.String("storyPlan.name", data.Name),
+		attribute.String("storyPlan.lang", data.Lang.String()),
+		attribute.String("storyPlan.userID", lo.FromPtr(data.UserID).String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &StoryPlanEntity{}
+
+	err = tx.
+		NewRaw(
+			insertStoryPlanQuery,
+			data.ID,
+			data.UserID,
+			data.Slug,
+			data.Name,
+			data.Lang,
+			data.Acts,
+			data.Beats,
+			data.Now,
+			bun.NullZero(data.SeedHash),
+		).
+		Scan(ctx, entity)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == "23505" {
+			return nil, otel.ReportError(span, errors.Join(err, ErrStoryPlanAlreadyExists))
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("insert story plan: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
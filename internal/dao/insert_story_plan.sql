@@ -0,0 +1,17 @@
+INSERT INTO
+  story_plans (
+    id,
+    user_id,
+    slug,
+    name,
+    lang,
+    version,
+    acts,
+    beats,
+    seed_hash,
+    created_at
+  )
+VALUES
+  (?0, ?1, ?2, ?3, ?4, 1, ?5, ?6, ?8, ?7)
+RETURNING
+  *;
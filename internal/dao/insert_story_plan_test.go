@@ -0,0 +1,193 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestInsertStoryPlan(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.StoryPlanEntity
+
+		data dao.InsertStoryPlanData
+
+		expect    *dao.StoryPlanEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			data: dao.InsertStoryPlanData{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes: storyplanmodel.Scenes{
+							Exact: lo.ToPtr(1),
+						},
+					},
+				},
+				SeedHash: "test-hash",
+				Now:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.StoryPlanEntity{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Slug:    "test-slug",
+				Version: 1,
+				Name:    "Test Name",
+				Lang:    models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes: storyplanmodel.Scenes{
+							Exact: lo.ToPtr(1),
+						},
+					},
+				},
+				SeedHash:  "test-hash",
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "AlreadyExists",
+
+			fixtures: []*dao.StoryPlanEntity{
+				{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Slug:    "test-slug",
+					Version: 1,
+					Name:    "Test Name 2",
+					Lang:    models.LangEN,
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.InsertStoryPlanData{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+				Now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrStoryPlanAlreadyExists,
+		},
+		{
+			name: "SameSlugDifferentLang",
+
+			fixtures: []*dao.StoryPlanEntity{
+				{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Slug:    "test-slug",
+					Version: 1,
+					Name:    "Test Name 2",
+					Lang:    models.LangFR,
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.InsertStoryPlanData{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+				Now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.StoryPlanEntity{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Slug:    "test-slug",
+				Version: 1,
+				Name:    "Test Name",
+				Lang:    models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	repository := dao.NewInsertStoryPlanRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.InsertStoryPlan(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -6,20 +6,36 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/uptrace/bun"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel/golib/otel"
 	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/models"
 )
 
-//go:embed list_beats_sheets.sql
-var listBeatsSheetsQuery string
+//go:embed list_beats_sheets.newest.sql
+var listBeatsSheetsNewestQuery string
+
+//go:embed list_beats_sheets.oldest.sql
+var listBeatsSheetsOldestQuery string
+
+// Beats sheets have no name, so they cannot be sorted by it.
+var listBeatsSheetsQueries = map[models.ListSort]string{
+	models.ListSortNewest: listBeatsSheetsNewestQuery,
+	models.ListSortOldest: listBeatsSheetsOldestQuery,
+}
 
 type ListBeatsSheetsData struct {
 	LoglineID uuid.UUID
-	Limit     int
-	Offset    int
+	// Defaults to models.ListSortNewest.
+	Sort models.ListSort
+	// Only return the beats sheets that come after the cursor, in the requested order. The cursor must have been
+	// created for the same sort.
+	Cursor *models.Cursor
+	Limit  int
 }
 
 type ListBeatsSheetsRepository struct{}
@@ -34,12 +50,21 @@ func (repository *ListBeatsSheetsRepository) ListBeatsSheets(
 	ctx, span := otel.Tracer().Start(ctx, "dao.ListBeatsSheets")
 	defer span.End()
 
+	sort := lo.CoalesceOrEmpty(data.Sort, models.ListSortNewest)
+	cursor := lo.FromPtr(data.Cursor)
+
 	span.SetAttributes(
 		attribute.String("logline.id", data.LoglineID.String()),
+		attribute.String("sort", sort.String()),
+		attribute.String("cursor.id", cursor.ID.String()),
 		attribute.Int("limit", data.Limit),
-		attribute.Int("offset", data.Offset),
 	)
 
+	query, ok := listBeatsSheetsQueries[sort]
+	if !ok {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: %s", models.ErrUnsupportedSort, sort))
+	}
+
 	tx, err := postgres.GetContext(ctx)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
@@ -47,7 +72,13 @@ func (repository *ListBeatsSheetsRepository) ListBeatsSheets(
 
 	entities := make([]*BeatsSheetPreviewEntity, 0)
 
-	err = tx.NewRaw(listBeatsSheetsQuery, data.LoglineID, bun.NullZero(data.Limit), data.Offset).Scan(ctx, &entities)
+	err = tx.NewRaw(
+		query,
+		data.LoglineID,
+		bun.NullZero(cursor.ID),
+		cursor.CreatedAt,
+		bun.NullZero(data.Limit),
+	).Scan(ctx, &entities)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("list beats sheet: %w", err))
 	}
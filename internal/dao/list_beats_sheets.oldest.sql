@@ -0,0 +1,18 @@
+SELECT
+  id,
+  lang,
+  created_at
+FROM
+  beats_sheets
+WHERE
+  logline_id = ?0
+  AND deleted_at IS NULL
+  AND (
+    ?1::uuid IS NULL
+    OR (created_at, id) > (?2, ?1)
+  )
+ORDER BY
+  created_at,
+  id
+LIMIT
+  ?3;
@@ -98,6 +98,79 @@ func TestListBeatsSheets(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Oldest",
+
+			fixtures: []*dao.BeatsSheetEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "test-beat",
+							Title:   "Test Beat",
+							Content: "Test Beat Content",
+						},
+						{
+							Key:     "test-beat-2",
+							Title:   "Test Beat 2",
+							Content: "Test Beat Content 2",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "test-beat",
+							Title:   "Test Beat",
+							Content: "Test Beat Content",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "test-beat-2",
+							Title:   "Test Beat 2",
+							Content: "Test Beat Content 2",
+						},
+					},
+					Lang:      models.LangFR,
+					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.ListBeatsSheetsData{
+				LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Sort:      models.ListSortOldest,
+			},
+
+			expect: []*dao.BeatsSheetPreviewEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Lang:      models.LangFR,
+					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
 		{
 			name: "Limit",
 
@@ -167,7 +240,7 @@ func TestListBeatsSheets(t *testing.T) {
 			},
 		},
 		{
-			name: "Offset",
+			name: "Cursor",
 
 			fixtures: []*dao.BeatsSheetEntity{
 				{
@@ -218,7 +291,11 @@ func TestListBeatsSheets(t *testing.T) {
 
 			data: dao.ListBeatsSheetsData{
 				LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-				Offset:    1,
+				Cursor: &models.Cursor{
+					Sort:      models.ListSortNewest,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
 			},
 
 			expect: []*dao.BeatsSheetPreviewEntity{
@@ -301,6 +378,16 @@ func TestListBeatsSheets(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "UnsupportedSort",
+
+			data: dao.ListBeatsSheetsData{
+				LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Sort:      models.ListSortName,
+			},
+
+			expectErr: models.ErrUnsupportedSort,
+		},
 	}
 
 	repository := dao.NewListBeatsSheetsRepository()
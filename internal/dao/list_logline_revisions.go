@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed list_logline_revisions.sql
+var listLoglineRevisionsQuery string
+
+type ListLoglineRevisionsData struct {
+	LoglineID uuid.UUID
+	Limit     int
+	Offset    int
+}
+
+type ListLoglineRevisionsRepository struct{}
+
+func NewListLoglineRevisionsRepository() *ListLoglineRevisionsRepository {
+	return &ListLoglineRevisionsRepository{}
+}
+
+// ListLoglineRevisions returns the revisions of a logline, most recent first.
+func (repository *ListLoglineRevisionsRepository) ListLoglineRevisions(
+	ctx context.Context, data ListLoglineRevisionsData,
+) ([]*LoglineRevisionEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ListLoglineRevisions")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("logline.id", data.LoglineID.String()),
+		attribute.Int("limit", data.Limit),
+		attribute.Int("offset", data.Offset),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entities := make([]*LoglineRevisionEntity, 0)
+
+	err = tx.
+		NewRaw(listLoglineRevisionsQuery, data.LoglineID, bun.NullZero(data.Limit), data.Offset).
+		Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list logline revisions: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
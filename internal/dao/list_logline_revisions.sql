@@ -1,13 +1,12 @@
 SELECT
-  id,
-  lang,
-  created_at
+  *
 FROM
-  beats_sheets
+  logline_revisions
 WHERE
   logline_id = ?0
 ORDER BY
-  created_at DESC
+  created_at DESC,
+  id DESC
 LIMIT
   ?1
 OFFSET
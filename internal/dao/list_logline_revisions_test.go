@@ -0,0 +1,137 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestListLoglineRevisions(t *testing.T) {
+	loglineFixtures := []*dao.LoglineEntity{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			UserID:    uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+			Slug:      "test-slug-1",
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			UserID:    uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+			Slug:      "test-slug-2",
+			Name:      "Other Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	fixtures := []*dao.LoglineRevisionEntity{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet, consectetur adipiscing elit",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Name:      "New Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		// Other logline.
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			Name:      "Other Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.ListLoglineRevisionsData
+
+		expect    []*dao.LoglineRevisionEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			data: dao.ListLoglineRevisionsData{
+				LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			expect: []*dao.LoglineRevisionEntity{fixtures[1], fixtures[2], fixtures[0]},
+		},
+		{
+			name: "Paginate",
+
+			data: dao.ListLoglineRevisionsData{
+				LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Limit:     1,
+				Offset:    1,
+			},
+
+			expect: []*dao.LoglineRevisionEntity{fixtures[2]},
+		},
+		{
+			name: "NoRevisions",
+
+			data: dao.ListLoglineRevisionsData{
+				LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000003"),
+			},
+
+			expect: []*dao.LoglineRevisionEntity{},
+		},
+	}
+
+	repository := dao.NewListLoglineRevisionsRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&loglineFixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.ListLoglineRevisions(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed list_logline_tags.sql
+var listLoglineTagsQuery string
+
+type ListLoglineTagsData struct {
+	UserID uuid.UUID
+}
+
+type ListLoglineTagsRepository struct{}
+
+func NewListLoglineTagsRepository() *ListLoglineTagsRepository {
+	return &ListLoglineTagsRepository{}
+}
+
+// ListLoglineTags returns the tags used on the loglines of a user, along with the number of loglines using each of
+// them. Most used tags come first. Loglines in the trash are not counted.
+func (repository *ListLoglineTagsRepository) ListLoglineTags(
+	ctx context.Context, data ListLoglineTagsData,
+) ([]*LoglineTagEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ListLoglineTags")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", data.UserID.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entities := make([]*LoglineTagEntity, 0)
+
+	err = tx.NewRaw(listLoglineTagsQuery, data.UserID).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list logline tags: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
@@ -0,0 +1,14 @@
+SELECT
+  logline_tags.tag,
+  COUNT(*) AS count
+FROM
+  logline_tags
+  JOIN loglines ON loglines.id = logline_tags.logline_id
+WHERE
+  loglines.user_id = ?0
+  AND loglines.deleted_at IS NULL
+GROUP BY
+  logline_tags.tag
+ORDER BY
+  count DESC,
+  logline_tags.tag;
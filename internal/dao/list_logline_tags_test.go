@@ -0,0 +1,128 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun/dialect/pgdialect"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestListLoglineTags(t *testing.T) {
+	logline := func(id, userID uuid.UUID, slug models.Slug, deletedAt time.Time) *dao.LoglineEntity {
+		return &dao.LoglineEntity{
+			ID:        id,
+			UserID:    userID,
+			Slug:      slug,
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			DeletedAt: deletedAt,
+		}
+	}
+
+	fixtures := []*dao.LoglineEntity{
+		logline(
+			uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			"test-slug-1",
+			time.Time{},
+		),
+		logline(
+			uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			"test-slug-2",
+			time.Time{},
+		),
+		// In the trash.
+		logline(
+			uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			"test-slug-3",
+			time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		),
+		// Other user.
+		logline(
+			uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			"test-slug-1",
+			time.Time{},
+		),
+	}
+
+	fixtureTags := map[uuid.UUID][]string{
+		fixtures[0].ID: {"fantasy", "quest"},
+		fixtures[1].ID: {"dragons", "quest"},
+		fixtures[2].ID: {"dragons", "trashed"},
+		fixtures[3].ID: {"quest", "other"},
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.ListLoglineTagsData
+
+		expect    []*dao.LoglineTagEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			data: dao.ListLoglineTagsData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			expect: []*dao.LoglineTagEntity{
+				{Tag: "quest", Count: 2},
+				{Tag: "dragons", Count: 1},
+				{Tag: "fantasy", Count: 1},
+			},
+		},
+		{
+			name: "Empty",
+
+			data: dao.ListLoglineTagsData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000003"),
+			},
+
+			expect: []*dao.LoglineTagEntity{},
+		},
+	}
+
+	repository := dao.NewListLoglineTagsRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				for id, tags := range fixtureTags {
+					_, err = db.NewRaw(
+						"INSERT INTO logline_tags (logline_id, tag) SELECT ?, unnest(?::text[])",
+						id, pgdialect.Array(tags),
+					).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.ListLoglineTags(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
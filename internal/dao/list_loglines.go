@@ -6,20 +6,47 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel/golib/otel"
 	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/models"
 )
 
-//go:embed list_loglines.sql
-var listLoglinesQuery string
+//go:embed list_loglines.newest.sql
+var listLoglinesNewestQuery string
+
+//go:embed list_loglines.oldest.sql
+var listLoglinesOldestQuery string
+
+//go:embed list_loglines.name.sql
+var listLoglinesNameQuery string
+
+var listLoglinesQueries = map[models.ListSort]string{
+	models.ListSortNewest: listLoglinesNewestQuery,
+	models.ListSortOldest: listLoglinesOldestQuery,
+	models.ListSortName:   listLoglinesNameQuery,
+}
 
 type ListLoglinesData struct {
 	UserID uuid.UUID
+	// Defaults to models.ListSortNewest.
+	Sort models.ListSort
+	// Only return the loglines that come after the cursor, in the requested order. The cursor must have been
+	// created for the same sort.
+	Cursor *models.Cursor
 	Limit  int
-	Offset int
+
+	// Optional filters. Loglines must match every tag in the list to be returned.
+	Genre models.Genre
+	Lang  models.Lang
+	Tags  []string
+	// Only return the loglines filed under this project.
+	ProjectID uuid.UUID
 }
 
 type ListLoglinesRepository struct{}
@@ -34,12 +61,25 @@ func (repository *ListLoglinesRepository) ListLoglines(
 	ctx, span := otel.Tracer().Start(ctx, "dao.ListLoglines")
 	defer span.End()
 
+	sort := lo.CoalesceOrEmpty(data.Sort, models.ListSortNewest)
+	cursor := lo.FromPtr(data.Cursor)
+
 	span.SetAttributes(
 		attribute.String("user.id", data.UserID.String()),
+		attribute.String("sort", sort.String()),
+		attribute.String("cursor.id", cursor.ID.String()),
 		attribute.Int("limit", data.Limit),
-		attribute.Int("offset", data.Offset),
+		attribute.String("genre", data.Genre.String()),
+		attribute.String("lang", data.Lang.String()),
+		attribute.StringSlice("tags", data.Tags),
+		attribute.String("projectID", data.ProjectID.String()),
 	)
 
+	query, ok := listLoglinesQueries[sort]
+	if !ok {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: %s", models.ErrUnsupportedSort, sort))
+	}
+
 	tx, err := postgres.GetContext(ctx)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
@@ -47,7 +87,18 @@ func (repository *ListLoglinesRepository) ListLoglines(
 
 	entities := make([]*LoglinePreviewEntity, 0)
 
-	err = tx.NewRaw(listLoglinesQuery, data.UserID, bun.NullZero(data.Limit), data.Offset).Scan(ctx, &entities)
+	err = tx.NewRaw(
+		query,
+		data.UserID,
+		bun.NullZero(cursor.ID),
+		cursor.CreatedAt,
+		cursor.Name,
+		bun.NullZero(data.Limit),
+		bun.NullZero(data.Genre),
+		bun.NullZero(data.Lang),
+		pgdialect.Array(data.Tags),
+		bun.NullZero(data.ProjectID),
+	).Scan(ctx, &entities)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("list loglines: %w", err))
 	}
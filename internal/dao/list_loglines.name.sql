@@ -0,0 +1,56 @@
+SELECT
+  id,
+  slug,
+  project_id,
+  name,
+  content,
+  lang,
+  genre,
+  ARRAY(
+    SELECT
+      tag
+    FROM
+      logline_tags
+    WHERE
+      logline_tags.logline_id = loglines.id
+    ORDER BY
+      tag
+  ) AS tags,
+  created_at
+FROM
+  loglines
+WHERE
+  user_id = ?0
+  AND deleted_at IS NULL
+  AND (
+    ?5::text IS NULL
+    OR genre = ?5
+  )
+  AND (
+    ?6::text IS NULL
+    OR lang = ?6
+  )
+  AND (
+    ?7::text[] IS NULL
+    OR ?7::text[] <@ ARRAY(
+      SELECT
+        tag
+      FROM
+        logline_tags
+      WHERE
+        logline_tags.logline_id = loglines.id
+    )
+  )
+  AND (
+    ?8::uuid IS NULL
+    OR project_id = ?8
+  )
+  AND (
+    ?1::uuid IS NULL
+    OR (name, id) > (?3, ?1)
+  )
+ORDER BY
+  name,
+  id
+LIMIT
+  ?4;
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun/dialect/pgdialect"
 
 	"github.com/a-novel/golib/postgres"
 
@@ -16,11 +18,91 @@ import (
 )
 
 func TestListLoglines(t *testing.T) {
+	projectFixtures := []*dao.ProjectEntity{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Name:      "Test Project",
+			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	fixtures := []*dao.LoglineEntity{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Slug:      "test-slug",
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			Genre:     models.GenreFantasy,
+			CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Slug:      "test-slug-2",
+			Name:      "Test Name 2",
+			Content:   "Lorem ipsum dolor sit amet 2",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Slug:      "test-slug-3",
+			Name:      "Test Name 3",
+			Content:   "Lorem ipsum dolor sit amet 3",
+			Lang:      models.LangFR,
+			Genre:     models.GenreFantasy,
+			CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		// Created at the same time as the previous one: the ID breaks the tie.
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Slug:      "test-slug-4",
+			Name:      "A Name",
+			Content:   "Lorem ipsum dolor sit amet 4",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		// Other user.
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			Slug:      "test-slug",
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2020, 1, 4, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	fixtureTags := map[uuid.UUID][]string{
+		fixtures[0].ID: {"dragons", "quest"},
+		fixtures[2].ID: {"quest"},
+		fixtures[4].ID: {"dragons", "quest"},
+	}
+
+	preview := func(index int) *dao.LoglinePreviewEntity {
+		return &dao.LoglinePreviewEntity{
+			ID:        fixtures[index].ID,
+			ProjectID: fixtures[index].ProjectID,
+			Slug:      fixtures[index].Slug,
+			Name:      fixtures[index].Name,
+			Content:   fixtures[index].Content,
+			Lang:      fixtures[index].Lang,
+			Genre:     fixtures[index].Genre,
+			Tags:      lo.CoalesceSliceOrEmpty(fixtureTags[fixtures[index].ID]),
+			CreatedAt: fixtures[index].CreatedAt,
+		}
+	}
+
 	testCases := []struct {
 		name string
 
-		fixtures []*dao.LoglineEntity
-
 		data dao.ListLoglinesData
 
 		expect    []*dao.LoglinePreviewEntity
@@ -29,227 +111,167 @@ func TestListLoglines(t *testing.T) {
 		{
 			name: "Success",
 
-			fixtures: []*dao.LoglineEntity{
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-					Slug:      "test-slug",
-					Name:      "Test Name",
-					Content:   "Lorem ipsum dolor sit amet",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
-				},
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-					Slug:      "test-slug-2",
-					Name:      "Test Name 2",
-					Content:   "Lorem ipsum dolor sit amet 2",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
-				},
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-					Slug:      "test-slug-3",
-					Name:      "Test Name 3",
-					Content:   "Lorem ipsum dolor sit amet 3",
-					Lang:      models.LangFR,
-					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
+			data: dao.ListLoglinesData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			expect: []*dao.LoglinePreviewEntity{preview(0), preview(3), preview(2), preview(1)},
+		},
+		{
+			name: "Limit",
+
+			data: dao.ListLoglinesData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Limit:  2,
 			},
 
+			expect: []*dao.LoglinePreviewEntity{preview(0), preview(3)},
+		},
+		{
+			name: "Oldest",
+
 			data: dao.ListLoglinesData{
 				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Sort:   models.ListSortOldest,
 			},
 
-			expect: []*dao.LoglinePreviewEntity{
-				{
-					Slug:      "test-slug",
-					Name:      "Test Name",
-					Content:   "Lorem ipsum dolor sit amet",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
-				},
-				{
-					Slug:      "test-slug-3",
-					Name:      "Test Name 3",
-					Content:   "Lorem ipsum dolor sit amet 3",
-					Lang:      models.LangFR,
+			expect: []*dao.LoglinePreviewEntity{preview(1), preview(2), preview(3), preview(0)},
+		},
+		{
+			name: "Name",
+
+			data: dao.ListLoglinesData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Sort:   models.ListSortName,
+			},
+
+			expect: []*dao.LoglinePreviewEntity{preview(3), preview(0), preview(1), preview(2)},
+		},
+		{
+			name: "Cursor",
+
+			data: dao.ListLoglinesData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Cursor: &models.Cursor{
+					Sort:      models.ListSortNewest,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
 					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
 				},
-				{
-					Slug:      "test-slug-2",
-					Name:      "Test Name 2",
-					Content:   "Lorem ipsum dolor sit amet 2",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: []*dao.LoglinePreviewEntity{preview(2), preview(1)},
+		},
+		{
+			name: "Cursor/Oldest",
+
+			data: dao.ListLoglinesData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Sort:   models.ListSortOldest,
+				Cursor: &models.Cursor{
+					Sort:      models.ListSortOldest,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
 				},
 			},
+
+			expect: []*dao.LoglinePreviewEntity{preview(3), preview(0)},
 		},
 		{
-			name: "Limit",
+			name: "Cursor/Name",
 
-			fixtures: []*dao.LoglineEntity{
-				{
+			data: dao.ListLoglinesData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Sort:   models.ListSortName,
+				Cursor: &models.Cursor{
+					Sort:      models.ListSortName,
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-					Slug:      "test-slug",
-					Name:      "Test Name",
-					Content:   "Lorem ipsum dolor sit amet",
-					Lang:      models.LangEN,
 					CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
-				},
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-					Slug:      "test-slug-2",
-					Name:      "Test Name 2",
-					Content:   "Lorem ipsum dolor sit amet 2",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
-				},
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-					Slug:      "test-slug-3",
-					Name:      "Test Name 3",
-					Content:   "Lorem ipsum dolor sit amet 3",
-					Lang:      models.LangFR,
-					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+					Name:      "Test Name",
 				},
 			},
 
+			expect: []*dao.LoglinePreviewEntity{preview(1), preview(2)},
+		},
+		{
+			name: "Genre",
+
 			data: dao.ListLoglinesData{
 				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-				Limit:  2,
+				Genre:  models.GenreFantasy,
 			},
 
-			expect: []*dao.LoglinePreviewEntity{
-				{
-					Slug:      "test-slug",
-					Name:      "Test Name",
-					Content:   "Lorem ipsum dolor sit amet",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
-				},
-				{
-					Slug:      "test-slug-3",
-					Name:      "Test Name 3",
-					Content:   "Lorem ipsum dolor sit amet 3",
-					Lang:      models.LangFR,
-					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
+			expect: []*dao.LoglinePreviewEntity{preview(0), preview(2)},
+		},
+		{
+			name: "Lang",
+
+			data: dao.ListLoglinesData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Lang:   models.LangFR,
 			},
+
+			expect: []*dao.LoglinePreviewEntity{preview(2)},
 		},
 		{
-			name: "Offset",
+			name: "Tags",
 
-			fixtures: []*dao.LoglineEntity{
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-					Slug:      "test-slug",
-					Name:      "Test Name",
-					Content:   "Lorem ipsum dolor sit amet",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
-				},
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-					Slug:      "test-slug-2",
-					Name:      "Test Name 2",
-					Content:   "Lorem ipsum dolor sit amet 2",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
-				},
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-					Slug:      "test-slug-3",
-					Name:      "Test Name 3",
-					Content:   "Lorem ipsum dolor sit amet 3",
-					Lang:      models.LangFR,
-					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
+			data: dao.ListLoglinesData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Tags:   []string{"quest"},
 			},
 
+			expect: []*dao.LoglinePreviewEntity{preview(0), preview(2)},
+		},
+		{
+			name: "Tags/MatchAll",
+
 			data: dao.ListLoglinesData{
 				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-				Offset: 1,
+				Tags:   []string{"quest", "dragons"},
 			},
 
-			expect: []*dao.LoglinePreviewEntity{
-				{
-					Slug:      "test-slug-3",
-					Name:      "Test Name 3",
-					Content:   "Lorem ipsum dolor sit amet 3",
-					Lang:      models.LangFR,
-					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
-				{
-					Slug:      "test-slug-2",
-					Name:      "Test Name 2",
-					Content:   "Lorem ipsum dolor sit amet 2",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
-				},
+			expect: []*dao.LoglinePreviewEntity{preview(0)},
+		},
+		{
+			name: "Project",
+
+			data: dao.ListLoglinesData{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			},
+
+			expect: []*dao.LoglinePreviewEntity{preview(1)},
 		},
 		{
-			name: "SkipOtherUsers",
+			name: "Filters/NoMatch",
 
-			fixtures: []*dao.LoglineEntity{
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-					Slug:      "test-slug",
-					Name:      "Test Name",
-					Content:   "Lorem ipsum dolor sit amet",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
-				},
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					UserID:    uuid.MustParse("00000000-0000-0000-0001-000000000002"),
-					Slug:      "test-slug-2",
-					Name:      "Test Name 2",
-					Content:   "Lorem ipsum dolor sit amet 2",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
-				},
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-					Slug:      "test-slug-3",
-					Name:      "Test Name 3",
-					Content:   "Lorem ipsum dolor sit amet 3",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
+			data: dao.ListLoglinesData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Genre:  models.GenreFantasy,
+				Lang:   models.LangEN,
+				Tags:   []string{"dragons", "unknown"},
 			},
 
+			expect: []*dao.LoglinePreviewEntity{},
+		},
+		{
+			name: "UnsupportedSort",
+
 			data: dao.ListLoglinesData{
 				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Sort:   "random",
 			},
 
-			expect: []*dao.LoglinePreviewEntity{
-				{
-					Slug:      "test-slug",
-					Name:      "Test Name",
-					Content:   "Lorem ipsum dolor sit amet",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
-				},
-				{
-					Slug:      "test-slug-3",
-					Name:      "Test Name 3",
-					Content:   "Lorem ipsum dolor sit amet 3",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
+			expectErr: models.ErrUnsupportedSort,
+		},
+		{
+			name: "Empty",
+
+			data: dao.ListLoglinesData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000003"),
 			},
+
+			expect: []*dao.LoglinePreviewEntity{},
 		},
 	}
 
@@ -257,22 +279,29 @@ func TestListLoglines(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			t.Run(testCase.name, func(t *testing.T) {
-				postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
-					t.Helper()
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
 
-					db, err := postgres.GetContext(ctx)
-					require.NoError(t, err)
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&projectFixtures).Exec(ctx)
+				require.NoError(t, err)
 
-					if len(testCase.fixtures) > 0 {
-						_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
-						require.NoError(t, err)
-					}
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				for id, tags := range fixtureTags {
+					_, err = db.NewRaw(
+						"INSERT INTO logline_tags (logline_id, tag) SELECT ?, unnest(?::text[])",
+						id, pgdialect.Array(tags),
+					).Exec(ctx)
+					require.NoError(t, err)
+				}
 
-					res, err := repository.ListLoglines(ctx, testCase.data)
-					require.ErrorIs(t, err, testCase.expectErr)
-					require.Equal(t, testCase.expect, res)
-				})
+				res, err := repository.ListLoglines(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
 			})
 		})
 	}
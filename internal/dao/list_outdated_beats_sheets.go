@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+//go:embed list_outdated_beats_sheets.sql
+var listOutdatedBeatsSheetsQuery string
+
+type ListOutdatedBeatsSheetsData struct {
+	// StoryPlanID is the target version of the story plan.
+	StoryPlanID uuid.UUID
+	// UserID restricts the results to the beats sheets of the user. Leave it empty to list the sheets of every user.
+	UserID uuid.UUID
+}
+
+type ListOutdatedBeatsSheetsRepository struct{}
+
+func NewListOutdatedBeatsSheetsRepository() *ListOutdatedBeatsSheetsRepository {
+	return &ListOutdatedBeatsSheetsRepository{}
+}
+
+// ListOutdatedBeatsSheets returns the beats sheets pinned to a version of the story plan older than the one
+// provided. Only versions sharing the same slug and language are considered. Sheets without a story plan are
+// treated as pinned to the first version of the built-in default plan.
+func (repository *ListOutdatedBeatsSheetsRepository) ListOutdatedBeatsSheets(
+	ctx context.Context, data ListOutdatedBeatsSheetsData,
+) ([]*BeatsSheetEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ListOutdatedBeatsSheets")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("data.storyPlanID", data.StoryPlanID.String()),
+		attribute.String("data.userID", data.UserID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entities := make([]*BeatsSheetEntity, 0)
+
+	err = tx.NewRaw(
+		listOutdatedBeatsSheetsQuery,
+		data.StoryPlanID,
+		bun.NullZero(data.UserID),
+		storyplanmodel.DefaultSlug,
+	).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list outdated beats sheets: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
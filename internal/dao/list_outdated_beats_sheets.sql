@@ -0,0 +1,34 @@
+SELECT
+  beats_sheets.*
+FROM
+  beats_sheets
+  -- Sheets created before story plans were pinned used the first version of the built-in default plan.
+  JOIN story_plans AS pinned_plans ON pinned_plans.id = beats_sheets.story_plan_id
+  OR (
+    beats_sheets.story_plan_id IS NULL
+    AND pinned_plans.user_id IS NULL
+    AND pinned_plans.slug = ?2
+    AND pinned_plans.lang = beats_sheets.lang
+    AND pinned_plans.version = 1
+  )
+  JOIN story_plans AS target_plans ON target_plans.user_id IS NOT DISTINCT FROM pinned_plans.user_id
+  AND target_plans.slug = pinned_plans.slug
+  AND target_plans.lang = pinned_plans.lang
+WHERE
+  target_plans.id = ?0
+  AND pinned_plans.version < target_plans.version
+  AND beats_sheets.deleted_at IS NULL
+  AND (
+    ?1::uuid IS NULL
+    OR beats_sheets.logline_id IN (
+      SELECT
+        id
+      FROM
+        loglines
+      WHERE
+        user_id = ?1
+    )
+  )
+ORDER BY
+  beats_sheets.created_at ASC,
+  beats_sheets.id ASC;
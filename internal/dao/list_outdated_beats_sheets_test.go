@@ -0,0 +1,250 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestListOutdatedBeatsSheets(t *testing.T) {
+	storyPlanFixtures := []*dao.StoryPlanEntity{
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+			Slug:    "test-plan",
+			Version: 1,
+			Name:    "Test Plan",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+			Slug:    "test-plan",
+			Version: 2,
+			Name:    "Test Plan",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-100000000003"),
+			Slug:    "test-plan",
+			Version: 3,
+			Name:    "Test Plan",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-100000000004"),
+			Slug:    "test-plan",
+			Version: 1,
+			Name:    "Plan de Test",
+			Lang:    models.LangFR,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-100000000006"),
+			Slug:    storyplanmodel.DefaultSlug,
+			Version: 1,
+			Name:    "Default Plan",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-100000000007"),
+			Slug:    storyplanmodel.DefaultSlug,
+			Version: 2,
+			Name:    "Default Plan",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	loglineFixtures := []*dao.LoglineEntity{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			UserID:    uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+			Slug:      "test-slug-1",
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			UserID:    uuid.MustParse("00000000-0000-1000-0000-000000000002"),
+			Slug:      "test-slug-2",
+			Name:      "Other Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	fixtures := []*dao.BeatsSheetEntity{
+		{
+			ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+			Content:     []models.Beat{{Key: "test-beat", Title: "Test Beat", Content: "Test Beat Content"}},
+			Lang:        models.LangEN,
+			CreatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+			Content:     []models.Beat{{Key: "test-beat", Title: "Test Beat", Content: "Test Beat Content"}},
+			Lang:        models.LangEN,
+			CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000003"),
+			Content:     []models.Beat{{Key: "test-beat", Title: "Test Beat", Content: "Test Beat Content"}},
+			Lang:        models.LangEN,
+			CreatedAt:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000004"),
+			Content:     []models.Beat{{Key: "test-beat", Title: "Test Beat", Content: "Test Beat Content"}},
+			Lang:        models.LangFR,
+			CreatedAt:   time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		},
+		// Legacy sheet, without a pinned plan.
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Content:   []models.Beat{{Key: "test-beat", Title: "Test Beat", Content: "Test Beat Content"}},
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.ListOutdatedBeatsSheetsData
+
+		expect    []*dao.BeatsSheetEntity
+		expectErr error
+	}{
+		{
+			name: "LatestVersion",
+
+			data: dao.ListOutdatedBeatsSheetsData{StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000003")},
+
+			expect: []*dao.BeatsSheetEntity{fixtures[1], fixtures[0]},
+		},
+		{
+			name: "IntermediateVersion",
+
+			data: dao.ListOutdatedBeatsSheetsData{StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000002")},
+
+			expect: []*dao.BeatsSheetEntity{fixtures[0]},
+		},
+		{
+			name: "FirstVersion",
+
+			data: dao.ListOutdatedBeatsSheetsData{StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001")},
+
+			expect: []*dao.BeatsSheetEntity{},
+		},
+		{
+			name: "User",
+
+			data: dao.ListOutdatedBeatsSheetsData{
+				StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000003"),
+				UserID:      uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+			},
+
+			expect: []*dao.BeatsSheetEntity{fixtures[0]},
+		},
+		{
+			name: "OtherUser",
+
+			data: dao.ListOutdatedBeatsSheetsData{
+				StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000003"),
+				UserID:      uuid.MustParse("00000000-0000-1000-0000-000000000002"),
+			},
+
+			expect: []*dao.BeatsSheetEntity{fixtures[1]},
+		},
+		{
+			name: "DefaultPlan",
+
+			data: dao.ListOutdatedBeatsSheetsData{StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000007")},
+
+			expect: []*dao.BeatsSheetEntity{fixtures[4]},
+		},
+		{
+			name: "DefaultPlan/FirstVersion",
+
+			data: dao.ListOutdatedBeatsSheetsData{StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000006")},
+
+			expect: []*dao.BeatsSheetEntity{},
+		},
+		{
+			name: "NotFound",
+
+			data: dao.ListOutdatedBeatsSheetsData{StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000005")},
+
+			expect: []*dao.BeatsSheetEntity{},
+		},
+	}
+
+	repository := dao.NewListOutdatedBeatsSheetsRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&storyPlanFixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&loglineFixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.ListOutdatedBeatsSheets(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
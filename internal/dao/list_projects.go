@@ -0,0 +1,70 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+//go:embed list_projects.sql
+var listProjectsQuery string
+
+type ListProjectsData struct {
+	UserID uuid.UUID
+	// Only return the projects that come after the cursor, by name. The cursor must have been created for the
+	// name sort.
+	Cursor *models.Cursor
+	Limit  int
+}
+
+type ListProjectsRepository struct{}
+
+func NewListProjectsRepository() *ListProjectsRepository {
+	return &ListProjectsRepository{}
+}
+
+// ListProjects returns the projects of a user, sorted by name.
+func (repository *ListProjectsRepository) ListProjects(
+	ctx context.Context, data ListProjectsData,
+) ([]*ProjectEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ListProjects")
+	defer span.End()
+
+	cursor := lo.FromPtr(data.Cursor)
+
+	span.SetAttributes(
+		attribute.String("user.id", data.UserID.String()),
+		attribute.String("cursor.id", cursor.ID.String()),
+		attribute.Int("limit", data.Limit),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entities := make([]*ProjectEntity, 0)
+
+	err = tx.NewRaw(
+		listProjectsQuery,
+		data.UserID,
+		bun.NullZero(cursor.ID),
+		cursor.Name,
+		bun.NullZero(data.Limit),
+	).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list projects: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
@@ -0,0 +1,15 @@
+SELECT
+  *
+FROM
+  projects
+WHERE
+  user_id = ?0
+  AND (
+    ?1::uuid IS NULL
+    OR (name, id) > (?2, ?1)
+  )
+ORDER BY
+  name,
+  id
+LIMIT
+  ?3;
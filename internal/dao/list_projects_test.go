@@ -0,0 +1,119 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestListProjects(t *testing.T) {
+	fixtures := []*dao.ProjectEntity{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Name:      "The Sea Trilogy",
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Name:        "A Mountain Saga",
+			Description: "Stories set in the mountains.",
+			CreatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		// Other user.
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			Name:      "Another Project",
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.ListProjectsData
+
+		expect    []*dao.ProjectEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			data: dao.ListProjectsData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			expect: []*dao.ProjectEntity{fixtures[1], fixtures[0]},
+		},
+		{
+			name: "Paginate",
+
+			data: dao.ListProjectsData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Cursor: &models.Cursor{
+					Sort: models.ListSortName,
+					ID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Name: "A Mountain Saga",
+				},
+				Limit: 1,
+			},
+
+			expect: []*dao.ProjectEntity{fixtures[0]},
+		},
+		{
+			name: "LastPage",
+
+			data: dao.ListProjectsData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Cursor: &models.Cursor{
+					Sort: models.ListSortName,
+					ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Name: "The Sea Trilogy",
+				},
+			},
+
+			expect: []*dao.ProjectEntity{},
+		},
+		{
+			name: "Empty",
+
+			data: dao.ListProjectsData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000003"),
+			},
+
+			expect: []*dao.ProjectEntity{},
+		},
+	}
+
+	repository := dao.NewListProjectsRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.ListProjects(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+//go:embed list_story_plan_translations.sql
+var listStoryPlanTranslationsQuery string
+
+// ListStoryPlanTranslationsData lists the latest version of a plan in every language but Lang.
+type ListStoryPlanTranslationsData struct {
+	// UserID is the owner of the plan. It is nil for built-in plans. Custom plans are never translations of
+	// built-in plans, even when they share the same slug.
+	UserID *uuid.UUID
+	Slug   models.Slug
+	Lang   models.Lang
+}
+
+type ListStoryPlanTranslationsRepository struct{}
+
+func NewListStoryPlanTranslationsRepository() *ListStoryPlanTranslationsRepository {
+	return &ListStoryPlanTranslationsRepository{}
+}
+
+func (repository *ListStoryPlanTranslationsRepository) ListStoryPlanTranslations(
+	ctx context.Context, data ListStoryPlanTranslationsData,
+) ([]*StoryPlanEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ListStoryPlanTranslations")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("data.userID", lo.FromPtr(data.UserID).String()),
+		attribute.String("data.slug", data.Slug.String()),
+		attribute.String("data.lang", data.Lang.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entities := make([]*StoryPlanEntity, 0)
+
+	err = tx.NewRaw(listStoryPlanTranslationsQuery, data.UserID, data.Slug, data.Lang).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list story plan translations: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
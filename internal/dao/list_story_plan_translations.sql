@@ -0,0 +1,11 @@
+SELECT DISTINCT
+  ON (lang) *
+FROM
+  story_plans
+WHERE
+  user_id IS NOT DISTINCT FROM ?0
+  AND slug = ?1
+  AND lang <> ?2
+ORDER BY
+  lang,
+  version DESC;
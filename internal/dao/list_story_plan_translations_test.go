@@ -0,0 +1,135 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestListStoryPlanTranslations(t *testing.T) {
+	fixtures := []*dao.StoryPlanEntity{
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Slug:    "test-slug",
+			Version: 1,
+			Name:    "Test Name",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Slug:    "test-slug",
+			Version: 1,
+			Name:    "Nom de Test",
+			Lang:    models.LangFR,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			Slug:    "test-slug",
+			Version: 2,
+			Name:    "Nom de Test Mis à Jour",
+			Lang:    models.LangFR,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		// Custom plan sharing the slug of a built-in plan.
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			UserID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+			Slug:    "test-slug",
+			Version: 1,
+			Name:    "Mon Nom de Test",
+			Lang:    models.LangFR,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.ListStoryPlanTranslationsData
+
+		expect    []*dao.StoryPlanEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			data: dao.ListStoryPlanTranslationsData{Slug: "test-slug", Lang: models.LangEN},
+
+			expect: []*dao.StoryPlanEntity{fixtures[2]},
+		},
+		{
+			name: "OtherLang",
+
+			data: dao.ListStoryPlanTranslationsData{Slug: "test-slug", Lang: models.LangFR},
+
+			expect: []*dao.StoryPlanEntity{fixtures[0]},
+		},
+		{
+			name: "CustomPlan",
+
+			data: dao.ListStoryPlanTranslationsData{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				Slug:   "test-slug",
+				Lang:   models.LangEN,
+			},
+
+			expect: []*dao.StoryPlanEntity{fixtures[3]},
+		},
+		{
+			name: "NoTranslation",
+
+			data: dao.ListStoryPlanTranslationsData{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				Slug:   "test-slug",
+				Lang:   models.LangFR,
+			},
+
+			expect: []*dao.StoryPlanEntity{},
+		},
+	}
+
+	repository := dao.NewListStoryPlanTranslationsRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.ListStoryPlanTranslations(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,31 @@
+SELECT
+  id,
+  user_id,
+  slug,
+  version,
+  name,
+  lang,
+  created_at
+FROM
+  (
+    SELECT DISTINCT
+      ON (user_id, slug, lang) *
+    FROM
+      story_plans
+    WHERE
+      user_id IS NULL
+      OR user_id = ?2
+    ORDER BY
+      user_id,
+      slug,
+      lang,
+      version DESC
+  ) AS latest_story_plans
+ORDER BY
+  created_at DESC,
+  slug DESC,
+  lang DESC
+LIMIT
+  ?0
+OFFSET
+  ?1;
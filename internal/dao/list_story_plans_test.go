@@ -0,0 +1,205 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestListStoryPlans(t *testing.T) {
+	fixtures := []*dao.StoryPlanEntity{
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Slug:    "test-slug-1",
+			Version: 1,
+			Name:    "Test Name 1",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Slug:    "test-slug-1",
+			Version: 1,
+			Name:    "Nom de Test 1",
+			Lang:    models.LangFR,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			Slug:    "test-slug-2",
+			Version: 1,
+			Name:    "Test Name 2",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			Slug:    "test-slug-2",
+			Version: 2,
+			Name:    "Test Name 2 Updated",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		// Custom plan, only listed for its owner.
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			UserID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+			Slug:    "test-slug-1",
+			Version: 1,
+			Name:    "My Test Name 1",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.ListStoryPlansData
+
+		expect    []*dao.StoryPlanPreviewEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			data: dao.ListStoryPlansData{},
+
+			expect: []*dao.StoryPlanPreviewEntity{
+				// Only the latest version of each plan is listed.
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+					Slug:      "test-slug-2",
+					Version:   2,
+					Name:      "Test Name 2 Updated",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Slug:      "test-slug-1",
+					Version:   1,
+					Name:      "Nom de Test 1",
+					Lang:      models.LangFR,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "test-slug-1",
+					Version:   1,
+					Name:      "Test Name 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Success/Custom",
+
+			data: dao.ListStoryPlansData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			expect: []*dao.StoryPlanPreviewEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+					UserID:    lo.ToPtr(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+					Slug:      "test-slug-1",
+					Version:   1,
+					Name:      "My Test Name 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+					Slug:      "test-slug-2",
+					Version:   2,
+					Name:      "Test Name 2 Updated",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Slug:      "test-slug-1",
+					Version:   1,
+					Name:      "Nom de Test 1",
+					Lang:      models.LangFR,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "test-slug-1",
+					Version:   1,
+					Name:      "Test Name 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Paginate",
+
+			data: dao.ListStoryPlansData{
+				Limit:  1,
+				Offset: 1,
+			},
+
+			expect: []*dao.StoryPlanPreviewEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Slug:      "test-slug-1",
+					Version:   1,
+					Name:      "Nom de Test 1",
+					Lang:      models.LangFR,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	repository := dao.NewListStoryPlansRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.ListStoryPlans(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed list_trashed_beats_sheets.sql
+var listTrashedBeatsSheetsQuery string
+
+type ListTrashedBeatsSheetsData struct {
+	UserID uuid.UUID
+	Limit  int
+	Offset int
+}
+
+type ListTrashedBeatsSheetsRepository struct{}
+
+func NewListTrashedBeatsSheetsRepository() *ListTrashedBeatsSheetsRepository {
+	return &ListTrashedBeatsSheetsRepository{}
+}
+
+// ListTrashedBeatsSheets returns the beats sheets of a user that were deleted on their own, most recently deleted
+// first. Sheets trashed along with their logline are not listed, as they can only be restored with it.
+func (repository *ListTrashedBeatsSheetsRepository) ListTrashedBeatsSheets(
+	ctx context.Context, data ListTrashedBeatsSheetsData,
+) ([]*TrashedBeatsSheetEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ListTrashedBeatsSheets")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", data.UserID.String()),
+		attribute.Int("limit", data.Limit),
+		attribute.Int("offset", data.Offset),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entities := make([]*TrashedBeatsSheetEntity, 0)
+
+	err = tx.
+		NewRaw(listTrashedBeatsSheetsQuery, data.UserID, bun.NullZero(data.Limit), data.Offset).
+		Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list trashed beats sheets: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
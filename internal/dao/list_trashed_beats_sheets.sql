@@ -0,0 +1,20 @@
+SELECT
+  beats_sheets.id,
+  beats_sheets.logline_id,
+  beats_sheets.lang,
+  beats_sheets.created_at,
+  beats_sheets.deleted_at
+FROM
+  beats_sheets
+  JOIN loglines ON loglines.id = beats_sheets.logline_id
+WHERE
+  loglines.user_id = ?0
+  AND loglines.deleted_at IS NULL
+  AND beats_sheets.deleted_at IS NOT NULL
+ORDER BY
+  beats_sheets.deleted_at DESC,
+  beats_sheets.id DESC
+LIMIT
+  ?1
+OFFSET
+  ?2;
@@ -0,0 +1,181 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestListTrashedBeatsSheets(t *testing.T) {
+	logline := func(id, userID uuid.UUID, deletedAt time.Time) *dao.LoglineEntity {
+		return &dao.LoglineEntity{
+			ID:        id,
+			UserID:    userID,
+			Slug:      models.Slug("test-slug-" + id.String()),
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			DeletedAt: deletedAt,
+		}
+	}
+
+	beatsSheet := func(id, loglineID uuid.UUID, deletedAt time.Time) *dao.BeatsSheetEntity {
+		return &dao.BeatsSheetEntity{
+			ID:        id,
+			LoglineID: loglineID,
+			Content: []models.Beat{
+				{
+					Key:     "test-beat",
+					Title:   "Test Beat",
+					Content: "Test Beat Content",
+				},
+			},
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			DeletedAt: deletedAt,
+		}
+	}
+
+	loglineFixtures := []*dao.LoglineEntity{
+		logline(
+			uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			time.Time{},
+		),
+		// Trashed logline: its sheets are restored with it, so they are not listed.
+		logline(
+			uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		),
+		// Other user.
+		logline(
+			uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			time.Time{},
+		),
+	}
+
+	beatsSheetFixtures := []*dao.BeatsSheetEntity{
+		beatsSheet(
+			uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		),
+		beatsSheet(
+			uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		),
+		beatsSheet(
+			uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			time.Time{},
+		),
+		beatsSheet(
+			uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		),
+		beatsSheet(
+			uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		),
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.ListTrashedBeatsSheetsData
+
+		expect    []*dao.TrashedBeatsSheetEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			data: dao.ListTrashedBeatsSheetsData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			expect: []*dao.TrashedBeatsSheetEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Paginate",
+
+			data: dao.ListTrashedBeatsSheetsData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Limit:  1,
+				Offset: 1,
+			},
+
+			expect: []*dao.TrashedBeatsSheetEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Empty",
+
+			data: dao.ListTrashedBeatsSheetsData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000003"),
+			},
+
+			expect: []*dao.TrashedBeatsSheetEntity{},
+		},
+	}
+
+	repository := dao.NewListTrashedBeatsSheetsRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&loglineFixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&beatsSheetFixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.ListTrashedBeatsSheets(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed list_trashed_loglines.sql
+var listTrashedLoglinesQuery string
+
+type ListTrashedLoglinesData struct {
+	UserID uuid.UUID
+	Limit  int
+	Offset int
+}
+
+type ListTrashedLoglinesRepository struct{}
+
+func NewListTrashedLoglinesRepository() *ListTrashedLoglinesRepository {
+	return &ListTrashedLoglinesRepository{}
+}
+
+// ListTrashedLoglines returns the loglines of a user that are in the trash, most recently deleted first.
+func (repository *ListTrashedLoglinesRepository) ListTrashedLoglines(
+	ctx context.Context, data ListTrashedLoglinesData,
+) ([]*LoglineEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ListTrashedLoglines")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", data.UserID.String()),
+		attribute.Int("limit", data.Limit),
+		attribute.Int("offset", data.Offset),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entities := make([]*LoglineEntity, 0)
+
+	err = tx.NewRaw(listTrashedLoglinesQuery, data.UserID, bun.NullZero(data.Limit), data.Offset).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list trashed loglines: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
@@ -0,0 +1,24 @@
+SELECT
+  *,
+  ARRAY(
+    SELECT
+      tag
+    FROM
+      logline_tags
+    WHERE
+      logline_tags.logline_id = loglines.id
+    ORDER BY
+      tag
+  ) AS tags
+FROM
+  loglines
+WHERE
+  user_id = ?0
+  AND deleted_at IS NOT NULL
+ORDER BY
+  deleted_at DESC,
+  id DESC
+LIMIT
+  ?1
+OFFSET
+  ?2;
@@ -0,0 +1,120 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestListTrashedLoglines(t *testing.T) {
+	logline := func(id, userID uuid.UUID, slug models.Slug, deletedAt time.Time) *dao.LoglineEntity {
+		return &dao.LoglineEntity{
+			ID:        id,
+			UserID:    userID,
+			Slug:      slug,
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			Tags:      []string{},
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			DeletedAt: deletedAt,
+		}
+	}
+
+	fixtures := []*dao.LoglineEntity{
+		logline(
+			uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			"test-slug-1",
+			time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		),
+		logline(
+			uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			"test-slug-2",
+			time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		),
+		// Not deleted.
+		logline(
+			uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			"test-slug-3",
+			time.Time{},
+		),
+		// Other user.
+		logline(
+			uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			"test-slug-1",
+			time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		),
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.ListTrashedLoglinesData
+
+		expect    []*dao.LoglineEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			data: dao.ListTrashedLoglinesData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			expect: []*dao.LoglineEntity{fixtures[1], fixtures[0]},
+		},
+		{
+			name: "Paginate",
+
+			data: dao.ListTrashedLoglinesData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Limit:  1,
+				Offset: 1,
+			},
+
+			expect: []*dao.LoglineEntity{fixtures[0]},
+		},
+		{
+			name: "Empty",
+
+			data: dao.ListTrashedLoglinesData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000003"),
+			},
+
+			expect: []*dao.LoglineEntity{},
+		},
+	}
+
+	repository := dao.NewListTrashedLoglinesRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.ListTrashedLoglines(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed purge_trash.sql
+var purgeTrashQuery string
+
+// PurgeTrashData permanently deletes every item that was moved to the trash before a given date.
+type PurgeTrashData struct {
+	Before time.Time
+}
+
+// PurgeTrashEntity counts the items deleted by a purge.
+type PurgeTrashEntity struct {
+	Loglines    int `bun:"loglines"`
+	BeatsSheets int `bun:"beats_sheets"`
+}
+
+type PurgeTrashRepository struct{}
+
+func NewPurgeTrashRepository() *PurgeTrashRepository {
+	return &PurgeTrashRepository{}
+}
+
+func (repository *PurgeTrashRepository) PurgeTrash(
+	ctx context.Context, data PurgeTrashData,
+) (*PurgeTrashEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PurgeTrash")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("before", data.Before.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &PurgeTrashEntity{}
+
+	err = tx.NewRaw(purgeTrashQuery, data.Before).Scan(ctx, entity)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("purge trash: %w", err))
+	}
+
+	span.SetAttributes(
+		attribute.Int("purged.loglines", entity.Loglines),
+		attribute.Int("purged.beatsSheets", entity.BeatsSheets),
+	)
+
+	return otel.ReportSuccess(span, entity), nil
+}
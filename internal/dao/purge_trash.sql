@@ -0,0 +1,34 @@
+WITH
+  purged_loglines AS (
+    DELETE FROM loglines
+    WHERE
+      deleted_at < ?0
+    RETURNING
+      id
+  ),
+  purged_beats_sheets AS (
+    DELETE FROM beats_sheets
+    WHERE
+      deleted_at < ?0
+      OR logline_id IN (
+        SELECT
+          id
+        FROM
+          purged_loglines
+      )
+    RETURNING
+      id
+  )
+SELECT
+  (
+    SELECT
+      COUNT(*)
+    FROM
+      purged_loglines
+  ) AS loglines,
+  (
+    SELECT
+      COUNT(*)
+    FROM
+      purged_beats_sheets
+  ) AS beats_sheets;
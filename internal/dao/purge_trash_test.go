@@ -0,0 +1,142 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestPurgeTrash(t *testing.T) {
+	logline := func(id uuid.UUID, deletedAt time.Time) *dao.LoglineEntity {
+		return &dao.LoglineEntity{
+			ID:        id,
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Slug:      models.Slug("test-slug-" + id.String()),
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			DeletedAt: deletedAt,
+		}
+	}
+
+	beatsSheet := func(id, loglineID uuid.UUID, deletedAt time.Time) *dao.BeatsSheetEntity {
+		return &dao.BeatsSheetEntity{
+			ID:        id,
+			LoglineID: loglineID,
+			Content: []models.Beat{
+				{
+					Key:     "test-beat",
+					Title:   "Test Beat",
+					Content: "Test Beat Content",
+				},
+			},
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			DeletedAt: deletedAt,
+		}
+	}
+
+	loglineFixtures := []*dao.LoglineEntity{
+		// Expired.
+		logline(uuid.MustParse("00000000-0000-0000-0000-000000000001"), time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+		// Still within retention.
+		logline(uuid.MustParse("00000000-0000-0000-0000-000000000002"), time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+		// Not deleted.
+		logline(uuid.MustParse("00000000-0000-0000-0000-000000000003"), time.Time{}),
+	}
+
+	beatsSheetFixtures := []*dao.BeatsSheetEntity{
+		// Trashed with an expired logline.
+		beatsSheet(
+			uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		),
+		// Trashed with a logline still within retention.
+		beatsSheet(
+			uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		),
+		// Expired on its own.
+		beatsSheet(
+			uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		),
+		// Not deleted.
+		beatsSheet(
+			uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			time.Time{},
+		),
+	}
+
+	revisionFixtures := []*dao.LoglineRevisionEntity{
+		// Revision of the expired logline.
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Name:      "Test Name",
+			Content:   "Lorem ipsum",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		// Revision of the logline still within retention.
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Name:      "Test Name",
+			Content:   "Lorem ipsum",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	repository := dao.NewPurgeTrashRepository()
+
+	postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+		t.Helper()
+
+		db, err := postgres.GetContext(ctx)
+		require.NoError(t, err)
+
+		_, err = db.NewInsert().Model(&loglineFixtures).Exec(ctx)
+		require.NoError(t, err)
+
+		_, err = db.NewInsert().Model(&beatsSheetFixtures).Exec(ctx)
+		require.NoError(t, err)
+
+		_, err = db.NewInsert().Model(&revisionFixtures).Exec(ctx)
+		require.NoError(t, err)
+
+		res, err := repository.PurgeTrash(ctx, dao.PurgeTrashData{
+			Before: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		})
+		require.NoError(t, err)
+		require.Equal(t, &dao.PurgeTrashEntity{Loglines: 1, BeatsSheets: 2}, res)
+
+		loglines := make([]*dao.LoglineEntity, 0)
+		require.NoError(t, db.NewSelect().Model(&loglines).Order("id").Scan(ctx))
+		require.Equal(t, loglineFixtures[1:], loglines)
+
+		beatsSheets := make([]*dao.BeatsSheetEntity, 0)
+		require.NoError(t, db.NewSelect().Model(&beatsSheets).Order("id").Scan(ctx))
+		require.Equal(t, []*dao.BeatsSheetEntity{beatsSheetFixtures[1], beatsSheetFixtures[3]}, beatsSheets)
+
+		// The history of purged loglines is purged with them.
+		revisions := make([]*dao.LoglineRevisionEntity, 0)
+		require.NoError(t, db.NewSelect().Model(&revisions).Order("id").Scan(ctx))
+		require.Equal(t, revisionFixtures[1:], revisions)
+	})
+}
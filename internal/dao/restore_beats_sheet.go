@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed restore_beats_sheet.sql
+var restoreBeatsSheetQuery string
+
+// RestoreBeatsSheetData takes a single beats sheet out of the trash. Sheets whose logline is in the trash cannot be
+// restored on their own: they come back with their logline.
+type RestoreBeatsSheetData struct {
+	ID uuid.UUID
+	// UserID must own the logline of the beats sheet.
+	UserID uuid.UUID
+}
+
+type RestoreBeatsSheetRepository struct{}
+
+func NewRestoreBeatsSheetRepository() *RestoreBeatsSheetRepository {
+	return &RestoreBeatsSheetRepository{}
+}
+
+func (repository *RestoreBeatsSheetRepository) RestoreBeatsSheet(
+	ctx context.Context, data RestoreBeatsSheetData,
+) (*BeatsSheetEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.RestoreBeatsSheet")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("sheet.id", data.ID.String()),
+		attribute.String("sheet.userID", data.UserID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &BeatsSheetEntity{}
+
+	err = tx.NewRaw(restoreBeatsSheetQuery, data.ID, data.UserID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrBeatsSheetNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("restore beats sheet: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed restore_logline.sql
+var restoreLoglineQuery string
+
+// RestoreLoglineData takes a logline out of the trash. Beats sheets that were trashed along with the logline are
+// restored too, while sheets deleted on their own beforehand remain in the trash.
+type RestoreLoglineData struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+type RestoreLoglineRepository struct{}
+
+func NewRestoreLoglineRepository() *RestoreLoglineRepository {
+	return &RestoreLoglineRepository{}
+}
+
+func (repository *RestoreLoglineRepository) RestoreLogline(
+	ctx context.Context, data RestoreLoglineData,
+) (*LoglineEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.RestoreLogline")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("logline.id", data.ID.String()),
+		attribute.String("logline.userID", data.UserID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &LoglineEntity{}
+
+	err = tx.NewRaw(restoreLoglineQuery, data.ID, data.UserID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrLoglineNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("restore logline: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,41 @@
+WITH
+  trashed_logline AS (
+    SELECT
+      id,
+      deleted_at
+    FROM
+      loglines
+    WHERE
+      id = ?0
+      AND user_id = ?1
+      AND deleted_at IS NOT NULL
+  ),
+  restored_beats_sheets AS (
+    UPDATE beats_sheets
+    SET
+      deleted_at = NULL
+    FROM
+      trashed_logline
+    WHERE
+      beats_sheets.logline_id = trashed_logline.id
+      AND beats_sheets.deleted_at = trashed_logline.deleted_at
+  )
+UPDATE loglines
+SET
+  deleted_at = NULL
+FROM
+  trashed_logline
+WHERE
+  loglines.id = trashed_logline.id
+RETURNING
+  loglines.*,
+  ARRAY(
+    SELECT
+      tag
+    FROM
+      logline_tags
+    WHERE
+      logline_tags.logline_id = loglines.id
+    ORDER BY
+      tag
+  ) AS tags;
@@ -0,0 +1,73 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+//go:embed search.sql
+var searchQuery string
+
+type SearchData struct {
+	UserID uuid.UUID
+	// Query uses the web search syntax: quoted phrases, "or", and "-" to exclude a word.
+	Query string
+	// Only return hits written in this language, if set.
+	Lang   models.Lang
+	Limit  int
+	Offset int
+}
+
+type SearchRepository struct{}
+
+func NewSearchRepository() *SearchRepository {
+	return &SearchRepository{}
+}
+
+// Search looks for the query in the loglines and beats sheets of a user, outside the trash. Each row is matched
+// using the dictionary of its own language, and hits are returned most relevant first.
+//
+// Snippets are HTML: the matched text is escaped before the matched words are wrapped in <mark></mark> tags.
+func (repository *SearchRepository) Search(ctx context.Context, data SearchData) ([]*SearchHitEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.Search")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", data.UserID.String()),
+		attribute.String("query", data.Query),
+		attribute.String("lang", data.Lang.String()),
+		attribute.Int("limit", data.Limit),
+		attribute.Int("offset", data.Offset),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entities := make([]*SearchHitEntity, 0)
+
+	err = tx.NewRaw(
+		searchQuery,
+		data.UserID,
+		data.Query,
+		bun.NullZero(data.Lang),
+		bun.NullZero(data.Limit),
+		data.Offset,
+	).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("search: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
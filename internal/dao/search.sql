@@ -0,0 +1,70 @@
+WITH
+  hits AS (
+    SELECT
+      'logline' AS kind,
+      loglines.id,
+      loglines.id AS logline_id,
+      loglines.slug,
+      loglines.name,
+      loglines.lang,
+      ts_rank(loglines.search_vector, query) AS rank,
+      ts_headline(
+        lang_regconfig (loglines.lang),
+        html_escape (loglines.content),
+        query,
+        'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MinWords=10, MaxWords=30'
+      ) AS snippet,
+      loglines.created_at
+    FROM
+      loglines
+      CROSS JOIN LATERAL websearch_to_tsquery(lang_regconfig (loglines.lang), ?1) AS query
+    WHERE
+      loglines.user_id = ?0
+      AND loglines.deleted_at IS NULL
+      AND (
+        ?2::text IS NULL
+        OR loglines.lang = ?2
+      )
+      AND loglines.search_vector @@ query
+    UNION ALL
+    SELECT
+      'beatsSheet' AS kind,
+      beats_sheets.id,
+      loglines.id AS logline_id,
+      loglines.slug,
+      loglines.name,
+      beats_sheets.lang,
+      ts_rank(beats_sheets.search_vector, query) AS rank,
+      ts_headline(
+        lang_regconfig (beats_sheets.lang),
+        html_escape (beats_sheet_text (beats_sheets.content)),
+        query,
+        'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MinWords=10, MaxWords=30'
+      ) AS snippet,
+      beats_sheets.created_at
+    FROM
+      beats_sheets
+      JOIN loglines ON loglines.id = beats_sheets.logline_id
+      CROSS JOIN LATERAL websearch_to_tsquery(lang_regconfig (beats_sheets.lang), ?1) AS query
+    WHERE
+      loglines.user_id = ?0
+      AND loglines.deleted_at IS NULL
+      AND beats_sheets.deleted_at IS NULL
+      AND (
+        ?2::text IS NULL
+        OR beats_sheets.lang = ?2
+      )
+      AND beats_sheets.search_vector @@ query
+  )
+SELECT
+  *
+FROM
+  hits
+ORDER BY
+  rank DESC,
+  created_at DESC,
+  id
+LIMIT
+  ?3
+OFFSET
+  ?4;
@@ -0,0 +1,269 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestSearch(t *testing.T) {
+	type hit struct {
+		Kind      models.SearchHitKind
+		ID        uuid.UUID
+		LoglineID uuid.UUID
+	}
+
+	loglineFixtures := []*dao.LoglineEntity{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Slug:      "the-runner",
+			Name:      "The Runner",
+			Content:   "A retired athlete keeps running from her past.",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Slug:      "le-phare",
+			Name:      "Le Phare",
+			Content:   "Une gardienne de phare découvre que les navires ne reviennent plus.",
+			Lang:      models.LangFR,
+			CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		// Trashed logline: neither the logline nor its sheets are searched.
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Slug:      "the-other-runner",
+			Name:      "The Other Runner",
+			Content:   "Two strangers keep running across the desert.",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			DeletedAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		},
+		// Other user.
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			Slug:      "the-runner",
+			Name:      "The Runner",
+			Content:   "A retired athlete keeps running from her past.",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		// Markup in the content must not leak into the snippets.
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Slug:      "the-keeper",
+			Name:      "The Keeper",
+			Content:   "A keeper hides <script>alert('x')</script> in the tower.",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	beatsSheetFixtures := []*dao.BeatsSheetEntity{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Content: []models.Beat{
+				{Key: "opening", Title: "Opening", Content: "She runs every morning along the coast."},
+				{Key: "catalyst", Title: "Catalyst", Content: "A letter arrives."},
+			},
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Content: []models.Beat{
+				{Key: "opening", Title: "Ouverture", Content: "Les navires disparaissent un à un."},
+			},
+			Lang:      models.LangFR,
+			CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		// Trashed beats sheet.
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Content: []models.Beat{
+				{Key: "opening", Title: "Opening", Content: "She runs every evening."},
+			},
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			DeletedAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		},
+		// Sheet of a trashed logline.
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			Content: []models.Beat{
+				{Key: "opening", Title: "Opening", Content: "They run at dawn."},
+			},
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			Content: []models.Beat{
+				{Key: "opening", Title: "Opening", Content: "The keeper writes <b>\"stay\"</b> on the door."},
+			},
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 6, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.SearchData
+
+		expect []hit
+		// Every snippet must contain these strings.
+		expectSnippetContains []string
+		expectErr             error
+	}{
+		{
+			name: "Success",
+
+			// Matches "running" and "runs" through stemming.
+			data: dao.SearchData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Query:  "run",
+			},
+
+			expect: []hit{
+				{
+					Kind:      models.SearchHitKindLogline,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				{
+					Kind:      models.SearchHitKindBeatsSheet,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+			},
+		},
+		{
+			name: "Lang",
+
+			data: dao.SearchData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Query:  "navire",
+				Lang:   models.LangFR,
+			},
+
+			expect: []hit{
+				{
+					Kind:      models.SearchHitKindLogline,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				},
+				{
+					Kind:      models.SearchHitKindBeatsSheet,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				},
+			},
+		},
+		{
+			name: "LangMismatch",
+
+			data: dao.SearchData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Query:  "run",
+				Lang:   models.LangFR,
+			},
+
+			expect: []hit{},
+		},
+		{
+			name: "EscapeSnippet",
+
+			data: dao.SearchData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Query:  "keeper",
+			},
+
+			expect: []hit{
+				{
+					Kind:      models.SearchHitKindLogline,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+				},
+				{
+					Kind:      models.SearchHitKindBeatsSheet,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+				},
+			},
+
+			expectSnippetContains: []string{"<mark>keeper</mark>", "&lt;", "&gt;"},
+		},
+		{
+			name: "NoMatch",
+
+			data: dao.SearchData{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Query:  "dragon",
+			},
+
+			expect: []hit{},
+		},
+	}
+
+	repository := dao.NewSearchRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&loglineFixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&beatsSheetFixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.Search(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+
+				hits := make([]hit, 0, len(res))
+
+				for _, item := range res {
+					require.Positive(t, item.Rank)
+					require.Contains(t, item.Snippet, "<mark>")
+					// Only the highlight tags are markup, the matched text is escaped.
+					require.NotContains(t, item.Snippet, "<script>")
+					require.NotContains(t, item.Snippet, "<b>")
+
+					for _, expected := range testCase.expectSnippetContains {
+						require.Contains(t, item.Snippet, expected)
+					}
+
+					hits = append(hits, hit{Kind: item.Kind, ID: item.ID, LoglineID: item.LoglineID})
+				}
+
+				// Relative ranks depend on the text search configuration, only the set of hits is checked.
+				require.ElementsMatch(t, testCase.expect, hits)
+			})
+		})
+	}
+}
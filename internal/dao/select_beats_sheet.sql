@@ -3,4 +3,5 @@ SELECT
 FROM
   beats_sheets
 WHERE
-  id = ?0;
+  id = ?0
+  AND deleted_at IS NULL;
@@ -71,6 +71,30 @@ func TestSelectBeatsSheet(t *testing.T) {
 				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
 		},
+		{
+			name: "Deleted",
+
+			fixtures: []*dao.BeatsSheetEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "test-beat",
+							Title:   "Test Beat",
+							Content: "Test Beat Content",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+
+			expectErr: dao.ErrBeatsSheetNotFound,
+		},
 		{
 			name: "NotFound",
 
@@ -1,7 +1,18 @@
 SELECT
-  *
+  *,
+  ARRAY(
+    SELECT
+      tag
+    FROM
+      logline_tags
+    WHERE
+      logline_tags.logline_id = loglines.id
+    ORDER BY
+      tag
+  ) AS tags
 FROM
   loglines
 WHERE
   id = ?0
-  AND user_id = ?1;
+  AND user_id = ?1
+  AND deleted_at IS NULL;
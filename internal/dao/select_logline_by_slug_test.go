@@ -53,6 +53,7 @@ func TestSelectLoglineBySlug(t *testing.T) {
 				Name:      "Test Name 2",
 				Content:   "Lorem ipsum dolor sit amet 2",
 				Lang:      models.LangEN,
+				Tags:      []string{},
 				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
 		},
@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed select_logline_revision.sql
+var selectLoglineRevisionQuery string
+
+type SelectLoglineRevisionRepository struct{}
+
+func NewSelectLoglineRevisionRepository() *SelectLoglineRevisionRepository {
+	return &SelectLoglineRevisionRepository{}
+}
+
+func (repository *SelectLoglineRevisionRepository) SelectLoglineRevision(
+	ctx context.Context, data uuid.UUID,
+) (*LoglineRevisionEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.SelectLoglineRevision")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("revision.id", data.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &LoglineRevisionEntity{}
+
+	err = tx.NewRaw(selectLoglineRevisionQuery, data).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrLoglineRevisionNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("select logline revision: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
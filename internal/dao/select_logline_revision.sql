@@ -0,0 +1,6 @@
+SELECT
+  *
+FROM
+  logline_revisions
+WHERE
+  id = ?0;
@@ -53,6 +53,7 @@ func TestSelectLogline(t *testing.T) {
 				Name:      "Test Name 2",
 				Content:   "Lorem ipsum dolor sit amet 2",
 				Lang:      models.LangEN,
+				Tags:      []string{},
 				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
 		},
@@ -78,6 +79,29 @@ func TestSelectLogline(t *testing.T) {
 
 			expectErr: dao.ErrLoglineNotFound,
 		},
+		{
+			name: "Deleted",
+
+			fixtures: []*dao.LoglineEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name 2",
+					Content:   "Lorem ipsum dolor sit amet 2",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.SelectLoglineData{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
 		{
 			name: "NotFound",
 
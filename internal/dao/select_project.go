@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed select_project.sql
+var selectProjectQuery string
+
+type SelectProjectData struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+type SelectProjectRepository struct{}
+
+func NewSelectProjectRepository() *SelectProjectRepository {
+	return &SelectProjectRepository{}
+}
+
+func (repository *SelectProjectRepository) SelectProject(
+	ctx context.Context, data SelectProjectData,
+) (*ProjectEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.SelectProject")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("project.id", data.ID.String()),
+		attribute.String("project.userID", data.UserID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &ProjectEntity{}
+
+	err = tx.NewRaw(selectProjectQuery, data.ID, data.UserID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrProjectNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("select project: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,7 @@
+SELECT
+  *
+FROM
+  projects
+WHERE
+  id = ?0
+  AND user_id = ?1;
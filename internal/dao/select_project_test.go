@@ -0,0 +1,87 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestSelectProject(t *testing.T) {
+	fixtures := []*dao.ProjectEntity{
+		{
+			ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Name:        "The Sea Trilogy",
+			Description: "Three novels about the sea.",
+			CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.SelectProjectData
+
+		expect    *dao.ProjectEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			data: dao.SelectProjectData{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			expect: fixtures[0],
+		},
+		{
+			name: "WrongUserID",
+
+			data: dao.SelectProjectData{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			},
+
+			expectErr: dao.ErrProjectNotFound,
+		},
+		{
+			name: "NotFound",
+
+			data: dao.SelectProjectData{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			expectErr: dao.ErrProjectNotFound,
+		},
+	}
+
+	repository := dao.NewSelectProjectRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.SelectProject(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
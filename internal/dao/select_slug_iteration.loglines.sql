@@ -6,4 +6,8 @@ WHERE
   slug ~ ?0
   AND user_id = ?1
 ORDER BY
-  created_at DESC;
+  SUBSTRING(
+    slug
+    FROM
+      '([0-9]+)$'
+  )::int DESC;
@@ -147,6 +147,44 @@ func TestSelectSlugIteration(t *testing.T) {
 			expect:          "test-slug-101",
 			expectIteration: 101,
 		},
+		{
+			// Loglines can be renamed, so the latest iteration is not necessarily the most recent logline.
+			name: "RenamedIterations",
+
+			fixtures: []any{
+				&dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug-4",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				&dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug-2",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.SelectSlugIterationData{
+				Slug: "test-slug",
+
+				Target: dao.SlugIterationTargetLogline,
+
+				Args: []any{
+					uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				},
+			},
+
+			expect:          "test-slug-5",
+			expectIteration: 5,
+		},
 	}
 
 	repository := dao.NewSelectSlugIterationRepository()
@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed select_story_plan.sql
+var selectStoryPlanQuery string
+
+// SelectStoryPlanData selects a plan by ID. Custom plans are only returned to their owner.
+type SelectStoryPlanData struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+type SelectStoryPlanRepository struct{}
+
+func NewSelectStoryPlanRepository() *SelectStoryPlanRepository {
+	return &SelectStoryPlanRepository{}
+}
+
+func (repository *SelectStoryPlanRepository) SelectStoryPlan(
+	ctx context.Context, data SelectStoryPlanData,
+) (*StoryPlanEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.SelectStoryPlan")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("storyPlan.id", data.ID.String()),
+		attribute.String("storyPlan.userID", data.UserID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &StoryPlanEntity{}
+
+	err = tx.NewRaw(selectStoryPlanQuery, data.ID, data.UserID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrStoryPlanNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("select story plan: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,10 @@
+SELECT
+  *
+FROM
+  story_plans
+WHERE
+  id = ?0
+  AND (
+    user_id IS NULL
+    OR user_id = ?1
+  );
@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+//go:embed select_story_plan_by_slug.sql
+var selectStoryPlanBySlugQuery string
+
+// SelectStoryPlanBySlugData selects the latest version of a plan. Custom plans owned by UserID take precedence over
+// the built-in plan with the same slug.
+type SelectStoryPlanBySlugData struct {
+	Slug   models.Slug
+	Lang   models.Lang
+	UserID uuid.UUID
+}
+
+type SelectStoryPlanBySlugRepository struct{}
+
+func NewSelectStoryPlanBySlugRepository() *SelectStoryPlanBySlugRepository {
+	return &SelectStoryPlanBySlugRepository{}
+}
+
+func (repository *SelectStoryPlanBySlugRepository) SelectStoryPlanBySlug(
+	ctx context.Context, data SelectStoryPlanBySlugData,
+) (*StoryPlanEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.SelectStoryPlanBySlug")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("storyPlan.slug", data.Slug.String()),
+		attribute.String("storyPlan.lang", data.Lang.String()),
+		attribute.String("storyPlan.userID", data.UserID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &StoryPlanEntity{}
+
+	err = tx.NewRaw(selectStoryPlanBySlugQuery, data.Slug, data.Lang, data.UserID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrStoryPlanNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("select story plan: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,17 @@
+SELECT
+  *
+FROM
+  story_plans
+WHERE
+  slug = ?0
+  AND lang = ?1
+  AND (
+    user_id IS NULL
+    OR user_id = ?2
+  )
+ORDER BY
+  -- Custom plans shadow the built-in plans with the same slug.
+  user_id NULLS LAST,
+  version DESC
+LIMIT
+  1;
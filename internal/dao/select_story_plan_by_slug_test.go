@@ -0,0 +1,179 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestSelectStoryPlanBySlug(t *testing.T) {
+	fixtures := []*dao.StoryPlanEntity{
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Slug:    "test-slug",
+			Version: 1,
+			Name:    "Test Name",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{
+					Name:      "Test Beat",
+					Key:       "test-beat",
+					KeyPoints: []string{"Test Key Point"},
+					Purpose:   "Test Purpose",
+				},
+			},
+			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Slug:    "test-slug",
+			Version: 1,
+			Name:    "Nom de Test",
+			Lang:    models.LangFR,
+			Beats: []storyplanmodel.Beat{
+				{
+					Name:      "Battement de Test",
+					Key:       "test-beat",
+					KeyPoints: []string{"Point Clé de Test"},
+					Purpose:   "Objectif de Test",
+				},
+			},
+			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			Slug:    "test-slug",
+			Version: 2,
+			Name:    "Test Name",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{
+					Name:      "Test Beat",
+					Key:       "test-beat",
+					KeyPoints: []string{"Test Key Point"},
+					Purpose:   "Test Purpose",
+				},
+				{
+					Name:      "Test Beat 2",
+					Key:       "test-beat-2",
+					KeyPoints: []string{"Test Key Point 2"},
+					Purpose:   "Test Purpose 2",
+				},
+			},
+			CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		// Custom plan with the same slug as a built-in one.
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			UserID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+			Slug:    "test-slug",
+			Version: 1,
+			Name:    "My Test Name",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{
+					Name:      "My Test Beat",
+					Key:       "my-test-beat",
+					KeyPoints: []string{"My Test Key Point"},
+					Purpose:   "My Test Purpose",
+				},
+			},
+			CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.SelectStoryPlanBySlugData
+
+		expect    *dao.StoryPlanEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			data: dao.SelectStoryPlanBySlugData{
+				Slug: "test-slug",
+				Lang: models.LangEN,
+			},
+
+			// Latest version is returned.
+			expect: fixtures[2],
+		},
+		{
+			name: "Success/OtherLang",
+
+			data: dao.SelectStoryPlanBySlugData{
+				Slug: "test-slug",
+				Lang: models.LangFR,
+			},
+
+			expect: fixtures[1],
+		},
+		{
+			name: "Success/Custom",
+
+			data: dao.SelectStoryPlanBySlugData{
+				Slug:   "test-slug",
+				Lang:   models.LangEN,
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			// Custom plans shadow built-in ones.
+			expect: fixtures[3],
+		},
+		{
+			name: "Success/OtherUser",
+
+			data: dao.SelectStoryPlanBySlugData{
+				Slug:   "test-slug",
+				Lang:   models.LangEN,
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+			},
+
+			expect: fixtures[2],
+		},
+		{
+			name: "NotFound",
+
+			data: dao.SelectStoryPlanBySlugData{
+				Slug: "other-slug",
+				Lang: models.LangEN,
+			},
+
+			expectErr: dao.ErrStoryPlanNotFound,
+		},
+	}
+
+	repository := dao.NewSelectStoryPlanBySlugRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.SelectStoryPlanBySlug(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
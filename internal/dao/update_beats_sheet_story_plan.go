@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed update_beats_sheet_story_plan.sql
+var updateBeatsSheetStoryPlanQuery string
+
+type UpdateBeatsSheetStoryPlanData struct {
+	ID          uuid.UUID
+	StoryPlanID uuid.UUID
+}
+
+type UpdateBeatsSheetStoryPlanRepository struct{}
+
+func NewUpdateBeatsSheetStoryPlanRepository() *UpdateBeatsSheetStoryPlanRepository {
+	return &UpdateBeatsSheetStoryPlanRepository{}
+}
+
+// UpdateBeatsSheetStoryPlan pins an existing beats sheet to another version of its story plan. The content of the
+// sheet is left untouched.
+func (repository *UpdateBeatsSheetStoryPlanRepository) UpdateBeatsSheetStoryPlan(
+	ctx context.Context, data UpdateBeatsSheetStoryPlanData,
+) (*BeatsSheetEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.UpdateBeatsSheetStoryPlan")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("sheet.id", data.ID.String()),
+		attribute.String("sheet.storyPlanID", data.StoryPlanID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &BeatsSheetEntity{}
+
+	err = tx.NewRaw(updateBeatsSheetStoryPlanQuery, data.ID, data.StoryPlanID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrBeatsSheetNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("update beats sheet story plan: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
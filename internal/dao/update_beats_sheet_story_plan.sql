@@ -0,0 +1,7 @@
+UPDATE beats_sheets
+SET
+  story_plan_id = ?1
+WHERE
+  id = ?0
+RETURNING
+  *;
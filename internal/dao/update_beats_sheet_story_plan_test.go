@@ -0,0 +1,115 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestUpdateBeatsSheetStoryPlan(t *testing.T) {
+	storyPlanFixtures := []*dao.StoryPlanEntity{
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+			Slug:    "test-plan",
+			Version: 1,
+			Name:    "Test Plan",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+			Slug:    "test-plan",
+			Version: 2,
+			Name:    "Test Plan",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	fixtures := []*dao.BeatsSheetEntity{
+		{
+			ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+			Content:     []models.Beat{{Key: "test-beat", Title: "Test Beat", Content: "Test Beat Content"}},
+			Lang:        models.LangEN,
+			CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.UpdateBeatsSheetStoryPlanData
+
+		expect    *dao.BeatsSheetEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			data: dao.UpdateBeatsSheetStoryPlanData{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+			},
+
+			expect: &dao.BeatsSheetEntity{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+				Content:     []models.Beat{{Key: "test-beat", Title: "Test Beat", Content: "Test Beat Content"}},
+				Lang:        models.LangEN,
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "NotFound",
+
+			data: dao.UpdateBeatsSheetStoryPlanData{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+			},
+
+			expectErr: dao.ErrBeatsSheetNotFound,
+		},
+	}
+
+	repository := dao.NewUpdateBeatsSheetStoryPlanRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&storyPlanFixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.UpdateBeatsSheetStoryPlan(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
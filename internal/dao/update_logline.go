@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+//go:embed update_logline.sql
+var updateLoglineQuery string
+
+// UpdateLoglineData replaces the editable fields of an existing logline. Unlike story plans, loglines are edited in
+// place.
+type UpdateLoglineData struct {
+	ID uuid.UUID
+	// UserID must match the owner of the logline.
+	UserID uuid.UUID
+	// Slug must remain unique for the user.
+	Slug models.Slug
+
+	Name    string
+	Content string
+	Lang    models.Lang
+	// Empty to remove the genre.
+	Genre models.Genre
+	// Replaces the tags of the logline. Must not contain duplicates. See models.NormalizeTags.
+	Tags []string
+	// Moves the logline to another project. Empty to remove it from its current project. The project must belong to
+	// the same user.
+	ProjectID uuid.UUID
+
+	Now time.Time
+}
+
+type UpdateLoglineRepository struct{}
+
+func NewUpdateLoglineRepository() *UpdateLoglineRepository {
+	return &UpdateLoglineRepository{}
+}
+
+func (repository *UpdateLoglineRepository) UpdateLogline(
+	ctx context.Context, data UpdateLoglineData,
+) (*LoglineEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.UpdateLogline")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("logline.id", data.ID.String()),
+		attribute.String("logline.userID", data.UserID.String()),
+		attribute.String("logline.slug", data.Slug.String()),
+		attribute.String("logline.name", data.Name),
+		attribute.String("logline.lang", data.Lang.String()),
+		attribute.String("logline.genre", data.Genre.String()),
+		attribute.StringSlice("logline.tags", data.Tags),
+		attribute.String("logline.projectID", data.ProjectID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &LoglineEntity{}
+
+	err = tx.
+		NewRaw(
+			updateLoglineQuery,
+			data.ID,
+			data.UserID,
+			data.Slug,
+			data.Name,
+			data.Content,
+			data.Lang,
+			data.Now,
+			bun.NullZero(data.Genre),
+			// A null array would match no tag, and leave the current ones in place.
+			pgdialect.Array(lo.Ternary(data.Tags != nil, data.Tags, []string{})),
+			bun.NullZero(data.ProjectID),
+		).
+		Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrLoglineNotFound)
+		}
+
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == "23505" {
+			return nil, otel.ReportError(span, errors.Join(err, ErrLoglineAlreadyExists))
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("update logline: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
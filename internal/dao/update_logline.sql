@@ -0,0 +1,66 @@
+WITH
+  updated AS (
+    UPDATE loglines
+    SET
+      slug = ?2,
+      name = ?3,
+      content = ?4,
+      lang = ?5,
+      updated_at = ?6,
+      genre = ?7,
+      project_id = ?9
+    WHERE
+      id = ?0
+      AND user_id = ?1
+      AND deleted_at IS NULL
+    RETURNING
+      *
+  ),
+  revision AS (
+    INSERT INTO
+      logline_revisions (id, logline_id, name, content, lang, created_at)
+    SELECT
+      gen_random_uuid(),
+      updated.id,
+      updated.name,
+      updated.content,
+      updated.lang,
+      updated.updated_at
+    FROM
+      updated
+      -- Statements in a WITH clause see the table as it was before the update.
+      JOIN loglines AS previous ON previous.id = updated.id
+    WHERE
+      -- Renaming the slug alone does not change the content.
+      (previous.name, previous.content, previous.lang) IS DISTINCT FROM (
+        updated.name,
+        updated.content,
+        updated.lang
+      )
+  ),
+  removed_tags AS (
+    DELETE FROM logline_tags
+    WHERE
+      logline_id IN (
+        SELECT
+          id
+        FROM
+          updated
+      )
+      AND NOT (tag = ANY (?8::text[]))
+  ),
+  added_tags AS (
+    INSERT INTO
+      logline_tags (logline_id, tag)
+    SELECT
+      updated.id,
+      unnest(?8::text[])
+    FROM
+      updated
+    ON CONFLICT DO NOTHING
+  )
+SELECT
+  *,
+  ?8::text[] AS tags
+FROM
+  updated;
@@ -0,0 +1,268 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun/dialect/pgdialect"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestUpdateLogline(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.LoglineEntity
+		// Tags attached to the first fixture.
+		fixtureTags []string
+
+		data dao.UpdateLoglineData
+
+		expect    *dao.LoglineEntity
+		expectErr error
+		// Number of revisions saved for the logline by the update.
+		expectRevisions int
+	}{
+		{
+			name: "Success",
+
+			fixtures: []*dao.LoglineEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.UpdateLoglineData{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:    "new-slug",
+				Name:    "New Name",
+				Content: "Lorem ipsum dolor sit amet, consectetur adipiscing elit",
+				Lang:    models.LangFR,
+				Now:     time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.LoglineEntity{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "new-slug",
+				Name:      "New Name",
+				Content:   "Lorem ipsum dolor sit amet, consectetur adipiscing elit",
+				Lang:      models.LangFR,
+				Tags:      []string{},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectRevisions: 1,
+		},
+		{
+			name: "GenreAndTags",
+
+			fixtures: []*dao.LoglineEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					Genre:     models.GenreDrama,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			fixtureTags: []string{"family", "war"},
+
+			data: dao.UpdateLoglineData{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:    "test-slug",
+				Name:    "Test Name",
+				Content: "Lorem ipsum dolor sit amet",
+				Lang:    models.LangEN,
+				Genre:   models.GenreHistorical,
+				Tags:    []string{"revenge", "war"},
+				Now:     time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.LoglineEntity{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "test-slug",
+				Name:      "Test Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      models.LangEN,
+				Genre:     models.GenreHistorical,
+				Tags:      []string{"revenge", "war"},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			// Only the metadata changed, so there is no new content to save.
+			expectRevisions: 0,
+		},
+		{
+			name: "SlugTaken",
+
+			fixtures: []*dao.LoglineEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "new-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.UpdateLoglineData{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:    "new-slug",
+				Name:    "New Name",
+				Content: "Lorem ipsum dolor sit amet",
+				Lang:    models.LangEN,
+				Now:     time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrLoglineAlreadyExists,
+		},
+		{
+			name: "SlugTakenByOtherUser",
+
+			fixtures: []*dao.LoglineEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+					Slug:      "new-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.UpdateLoglineData{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:    "new-slug",
+				Name:    "Test Name",
+				Content: "Lorem ipsum dolor sit amet",
+				Lang:    models.LangEN,
+				Now:     time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.LoglineEntity{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "new-slug",
+				Name:      "Test Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      models.LangEN,
+				Tags:      []string{},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			// Only the slug changed, so there is no new content to save.
+			expectRevisions: 0,
+		},
+		{
+			name: "WrongUser",
+
+			fixtures: []*dao.LoglineEntity{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.UpdateLoglineData{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+				Slug:    "test-slug",
+				Name:    "New Name",
+				Content: "Lorem ipsum dolor sit amet",
+				Lang:    models.LangEN,
+				Now:     time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+	}
+
+	repository := dao.NewUpdateLoglineRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				if len(testCase.fixtureTags) > 0 {
+					_, err = db.NewRaw(
+						"INSERT INTO logline_tags (logline_id, tag) SELECT ?, unnest(?::text[])",
+						testCase.fixtures[0].ID, pgdialect.Array(testCase.fixtureTags),
+					).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.UpdateLogline(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+
+				revisions, err := db.NewSelect().
+					Model((*dao.LoglineRevisionEntity)(nil)).
+					Where("logline_id = ?", testCase.data.ID).
+					Count(ctx)
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectRevisions, revisions)
+			})
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed update_project.sql
+var updateProjectQuery string
+
+// UpdateProjectData replaces the editable fields of an existing project.
+type UpdateProjectData struct {
+	ID uuid.UUID
+	// UserID must match the owner of the project.
+	UserID uuid.UUID
+
+	Name        string
+	Description string
+
+	Now time.Time
+}
+
+type UpdateProjectRepository struct{}
+
+func NewUpdateProjectRepository() *UpdateProjectRepository {
+	return &UpdateProjectRepository{}
+}
+
+func (repository *UpdateProjectRepository) UpdateProject(
+	ctx context.Context, data UpdateProjectData,
+) (*ProjectEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.UpdateProject")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("project.id", data.ID.String()),
+		attribute.String("project.userID", data.UserID.String()),
+		attribute.String("project.name", data.Name),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &ProjectEntity{}
+
+	err = tx.
+		NewRaw(updateProjectQuery, data.ID, data.UserID, data.Name, data.Description, data.Now).
+		Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrProjectNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("update project: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
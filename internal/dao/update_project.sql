@@ -0,0 +1,10 @@
+UPDATE projects
+SET
+  name = ?2,
+  description = ?3,
+  updated_at = ?4
+WHERE
+  id = ?0
+  AND user_id = ?1
+RETURNING
+  *;
@@ -0,0 +1,99 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+//go:embed update_story_plan.sql
+var updateStoryPlanQuery string
+
+// UpdateStoryPlanData describes a new revision of an existing story plan. Story plans are immutable: rather than
+// modifying the plan in place, a new version is created under the same slug and language, so beats sheets pinned to
+// a previous version remain valid.
+type UpdateStoryPlanData struct {
+	// ID of any existing version of the plan to update.
+	ID uuid.UUID
+	// NewID is the unique identifier of the new version.
+	NewID uuid.UUID
+	// UserID must match the owner of the plan. It is nil for built-in plans.
+	UserID *uuid.UUID
+
+	Name  string
+	Acts  []storyplanmodel.Act
+	Beats []storyplanmodel.Beat
+	// SeedHash replaces the seed hash of the plan. Leave it empty to carry over the one of the latest version.
+	SeedHash string
+
+	Now time.Time
+}
+
+type UpdateStoryPlanRepository struct{}
+
+func NewUpdateStoryPlanRepository() *UpdateStoryPlanRepository {
+	return &UpdateStoryPlanRepository{}
+}
+
+func (repository *UpdateStoryPlanRepository) UpdateStoryPlan(
+	ctx context.Context, data UpdateStoryPlanData,
+) (*StoryPlanEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.UpdateStoryPlan")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("storyPlan.id", data.ID.String()),
+		attribute.String("storyPlan.newID", data.NewID.String()),
+		attribute.String("storyPlan.name", data.Name),
+		attribute.String("storyPlan.userID", lo.FromPtr(data.UserID).String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &StoryPlanEntity{}
+
+	err = tx.
+		NewRaw(
+			updateStoryPlanQuery,
+			data.ID,
+			data.NewID,
+			data.Name,
+			data.Acts,
+			data.Beats,
+			data.Now,
+			data.UserID,
+			bun.NullZero(data.SeedHash),
+		).
+		Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrStoryPlanNotFound)
+		}
+
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == "23505" {
+			return nil, otel.ReportError(span, errors.Join(err, ErrStoryPlanAlreadyExists))
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("update story plan: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
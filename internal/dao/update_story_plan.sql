@@ -0,0 +1,56 @@
+INSERT INTO
+  story_plans (
+    id,
+    user_id,
+    slug,
+    name,
+    lang,
+    version,
+    acts,
+    beats,
+    seed_hash,
+    created_at
+  )
+SELECT
+  ?1,
+  current_plan.user_id,
+  current_plan.slug,
+  ?2,
+  current_plan.lang,
+  (
+    SELECT
+      MAX(versions.version)
+    FROM
+      story_plans AS versions
+    WHERE
+      versions.user_id IS NOT DISTINCT FROM current_plan.user_id
+      AND versions.slug = current_plan.slug
+      AND versions.lang = current_plan.lang
+  ) + 1,
+  ?3,
+  ?4,
+  COALESCE(
+    ?7::text,
+    (
+      SELECT
+        versions.seed_hash
+      FROM
+        story_plans AS versions
+      WHERE
+        versions.user_id IS NOT DISTINCT FROM current_plan.user_id
+        AND versions.slug = current_plan.slug
+        AND versions.lang = current_plan.lang
+      ORDER BY
+        versions.version DESC
+      LIMIT
+        1
+    )
+  ),
+  ?5
+FROM
+  story_plans AS current_plan
+WHERE
+  current_plan.id = ?0
+  AND current_plan.user_id IS NOT DISTINCT FROM ?6
+RETURNING
+  *;
@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+	"github.com/a-novel/golib/postgres"
+)
+
+//go:embed update_story_plan_seed_hash.sql
+var updateStoryPlanSeedHashQuery string
+
+type UpdateStoryPlanSeedHashData struct {
+	ID       uuid.UUID
+	SeedHash string
+}
+
+type UpdateStoryPlanSeedHashRepository struct{}
+
+func NewUpdateStoryPlanSeedHashRepository() *UpdateStoryPlanSeedHashRepository {
+	return &UpdateStoryPlanSeedHashRepository{}
+}
+
+// UpdateStoryPlanSeedHash records the embedded plan a built-in plan version matches, without creating a new
+// version. It lets the seeder adopt the plans stored before seed hashes were recorded.
+func (repository *UpdateStoryPlanSeedHashRepository) UpdateStoryPlanSeedHash(
+	ctx context.Context, data UpdateStoryPlanSeedHashData,
+) (*StoryPlanEntity, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.UpdateStoryPlanSeedHash")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("storyPlan.id", data.ID.String()),
+		attribute.String("storyPlan.seedHash", data.SeedHash),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get postgres client: %w", err))
+	}
+
+	entity := &StoryPlanEntity{}
+
+	err = tx.NewRaw(updateStoryPlanSeedHashQuery, data.ID, data.SeedHash).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, otel.ReportError(span, ErrStoryPlanNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("update story plan seed hash: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,8 @@
+UPDATE story_plans
+SET
+  seed_hash = ?1
+WHERE
+  id = ?0
+  AND user_id IS NULL
+RETURNING
+  *;
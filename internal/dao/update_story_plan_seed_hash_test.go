@@ -0,0 +1,118 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestUpdateStoryPlanSeedHash(t *testing.T) {
+	fixtures := []*dao.StoryPlanEntity{
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Slug:    "test-slug",
+			Version: 1,
+			Name:    "Test Name",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		// Custom plan.
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			UserID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+			Slug:    "test-slug",
+			Version: 1,
+			Name:    "Test Name",
+			Lang:    models.LangEN,
+			Beats: []storyplanmodel.Beat{
+				{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+			},
+			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		data dao.UpdateStoryPlanSeedHashData
+
+		expect    *dao.StoryPlanEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			data: dao.UpdateStoryPlanSeedHashData{
+				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				SeedHash: "test-hash",
+			},
+
+			expect: &dao.StoryPlanEntity{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Slug:    "test-slug",
+				Version: 1,
+				Name:    "Test Name",
+				Lang:    models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{Name: "Test Beat", Key: "test-beat", KeyPoints: []string{"Test Key Point"}, Purpose: "Test Purpose"},
+				},
+				SeedHash:  "test-hash",
+				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "CustomPlan",
+
+			data: dao.UpdateStoryPlanSeedHashData{
+				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				SeedHash: "test-hash",
+			},
+
+			expectErr: dao.ErrStoryPlanNotFound,
+		},
+		{
+			name: "NotFound",
+
+			data: dao.UpdateStoryPlanSeedHashData{
+				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				SeedHash: "test-hash",
+			},
+
+			expectErr: dao.ErrStoryPlanNotFound,
+		},
+	}
+
+	repository := dao.NewUpdateStoryPlanSeedHashRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.UpdateStoryPlanSeedHash(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,348 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/postgres"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestUpdateStoryPlan(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.StoryPlanEntity
+
+		data dao.UpdateStoryPlanData
+
+		expect    *dao.StoryPlanEntity
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: []*dao.StoryPlanEntity{
+				{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:    "test-slug",
+					Version: 1,
+					Name:    "Test Name",
+					Lang:    models.LangEN,
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.UpdateStoryPlanData{
+				ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				NewID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Name:  "Test Name Updated",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+					{
+						Name:      "Test Beat 2",
+						Key:       "test-beat-2",
+						KeyPoints: []string{"Test Key Point 2"},
+						Purpose:   "Test Purpose 2",
+					},
+				},
+				Now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.StoryPlanEntity{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Slug:    "test-slug",
+				Version: 2,
+				Name:    "Test Name Updated",
+				Lang:    models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+					{
+						Name:      "Test Beat 2",
+						Key:       "test-beat-2",
+						KeyPoints: []string{"Test Key Point 2"},
+						Purpose:   "Test Purpose 2",
+					},
+				},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "FromOlderVersion",
+
+			fixtures: []*dao.StoryPlanEntity{
+				{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:    "test-slug",
+					Version: 1,
+					Name:    "Test Name",
+					Lang:    models.LangEN,
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+					SeedHash:  "hash-1",
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Slug:    "test-slug",
+					Version: 2,
+					Name:    "Test Name",
+					Lang:    models.LangEN,
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+					SeedHash:  "hash-2",
+					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				// Same slug, other language: must not affect versioning.
+				{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Slug:    "test-slug",
+					Version: 5,
+					Name:    "Nom de Test",
+					Lang:    models.LangFR,
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.UpdateStoryPlanData{
+				ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				NewID: uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+				Name:  "Test Name Updated",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+				Now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.StoryPlanEntity{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+				Slug:    "test-slug",
+				Version: 3,
+				Name:    "Test Name Updated",
+				Lang:    models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+				// The seed hash is carried over from the latest version.
+				SeedHash:  "hash-2",
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "ReplaceSeedHash",
+
+			fixtures: []*dao.StoryPlanEntity{
+				{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:    "test-slug",
+					Version: 1,
+					Name:    "Test Name",
+					Lang:    models.LangEN,
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+					SeedHash:  "hash-1",
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.UpdateStoryPlanData{
+				ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				NewID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Name:  "Test Name",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+				SeedHash: "hash-2",
+				Now:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.StoryPlanEntity{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Slug:    "test-slug",
+				Version: 2,
+				Name:    "Test Name",
+				Lang:    models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+				SeedHash:  "hash-2",
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "NotFound",
+
+			fixtures: []*dao.StoryPlanEntity{
+				{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Slug:    "test-slug",
+					Version: 1,
+					Name:    "Test Name",
+					Lang:    models.LangEN,
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.UpdateStoryPlanData{
+				ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				NewID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				Name:  "Test Name Updated",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+				Now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrStoryPlanNotFound,
+		},
+		{
+			name: "NotOwner",
+
+			fixtures: []*dao.StoryPlanEntity{
+				{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+					Slug:    "test-slug",
+					Version: 1,
+					Name:    "Test Name",
+					Lang:    models.LangEN,
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			data: dao.UpdateStoryPlanData{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				NewID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-1000-000000000002")),
+				Name:   "Test Name Updated",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+				Now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrStoryPlanNotFound,
+		},
+	}
+
+	repository := dao.NewUpdateStoryPlanRepository()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.UpdateStoryPlan(ctx, testCase.data)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package daoai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/packages/param"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/daoai/prompts"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type ConvertBeatsSheetTemplates struct {
+	System *template.Template
+	Input  *template.Template
+}
+
+var ConvertBeatsSheetPrompts = prompts.MapLocalized(
+	prompts.ConvertBeatsSheet,
+	func(prompt prompts.ConvertBeatsSheetType) ConvertBeatsSheetTemplates {
+		return ConvertBeatsSheetTemplates{
+			System: template.Must(template.New("").Parse(prompt.System)),
+			Input:  template.Must(template.New("").Parse(prompt.Input)),
+		}
+	},
+)
+
+type ConvertBeatsSheetRequest struct {
+	Logline string
+	// Beats of the sheet to convert, following the source plan.
+	Beats      []models.Beat
+	SourcePlan *storyplanmodel.Plan
+	// TargetPlan is the plan the converted sheet must follow.
+	TargetPlan *storyplanmodel.Plan
+	UserID     string
+	Lang       models.Lang
+}
+
+type ConvertBeatsSheetRepository struct {
+	config *config.OpenAI
+}
+
+func NewConvertBeatsSheetRepository(config *config.OpenAI) *ConvertBeatsSheetRepository {
+	return &ConvertBeatsSheetRepository{config: config}
+}
+
+func (repository *ConvertBeatsSheetRepository) ConvertBeatsSheet(
+	ctx context.Context, request ConvertBeatsSheetRequest,
+) ([]models.Beat, error) {
+	ctx, span := otel.Tracer().Start(ctx, "daoai.ConvertBeatsSheet")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.logline", request.Logline),
+		attribute.String("request.sourcePlan", request.SourcePlan.Metadata.Slug.String()),
+		attribute.String("request.targetPlan", request.TargetPlan.Metadata.Slug.String()),
+		attribute.String("request.userID", request.UserID),
+		attribute.String("request.lang", request.Lang.String()),
+	)
+
+	templates, promptLang := ConvertBeatsSheetPrompts.Get(request.Lang)
+
+	span.SetAttributes(attribute.String("prompt.lang", promptLang.String()))
+
+	systemPrompt := new(strings.Builder)
+
+	err := templates.System.Execute(systemPrompt, map[string]any{
+		"SourcePlanName": request.SourcePlan.Metadata.Name,
+		"PlanName":       request.TargetPlan.Metadata.Name,
+		"Acts":           request.TargetPlan.Acts,
+		"FlexibleBeats":  request.TargetPlan.FlexibleBeats(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute system prompt: %w", err))
+	}
+
+	userPrompt := new(strings.Builder)
+
+	err = templates.Input.Execute(userPrompt, map[string]any{
+		"SourcePlanName": request.SourcePlan.Metadata.Name,
+		"Logline":        request.Logline,
+		"Beats":          request.Beats,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute user prompt: %w", err))
+	}
+
+	chatCompletion, err := repository.config.Client().
+		Chat.Completions.
+		New(ctx, openai.ChatCompletionNewParams{
+			Model: repository.config.Model,
+			User:  param.NewOpt(request.UserID),
+			Messages: []openai.ChatCompletionMessageParamUnion{
+				openai.SystemMessage(ForceNextAnswerLocale(request.Lang, systemPrompt.String())),
+				openai.UserMessage(userPrompt.String()),
+			},
+			ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
+				OfJSONSchema: &openai.ResponseFormatJSONSchemaParam{
+					JSONSchema: openai.ResponseFormatJSONSchemaJSONSchemaParam{
+						Name:        "story_beats",
+						Description: openai.String("The story beats, converted to the new story plan."),
+						Schema:      request.TargetPlan.OutputSchema(),
+						Strict:      openai.Bool(true),
+					},
+				},
+			},
+		})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	var beats struct {
+		Beats []models.Beat `json:"beats"`
+	}
+
+	err = json.Unmarshal([]byte(chatCompletion.Choices[0].Message.Content), &beats)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = request.TargetPlan.Validate(beats.Beats)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidBeatSheet))
+	}
+
+	return otel.ReportSuccess(span, beats.Beats), nil
+}
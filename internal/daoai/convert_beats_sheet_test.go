@@ -0,0 +1,66 @@
+package daoai_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/internal/daoai/testdata"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestConvertBeatsSheet(t *testing.T) {
+	const errorMsg = "The below beats sheet does not tell the same story as the below original beats sheet.\n\n" +
+		"beats sheet:\n\n%s\n\noriginal beats sheet:\n\n%s\n\nlogline:\n\n%s"
+
+	repository := daoai.NewConvertBeatsSheetRepository(&config.OpenAIPresetDefault)
+
+	for _, lang := range models.Langs {
+		t.Run(lang.String(), func(t *testing.T) {
+			t.Parallel()
+
+			data := testdata.ConvertBeatsSheetPrompt
+
+			for name, testCase := range data.Cases {
+				t.Run(name, func(t *testing.T) {
+					t.Parallel()
+
+					targetPlan := storyplanmodel.Kishotenketsu[lang]
+
+					beatsSheet, err := repository.ConvertBeatsSheet(t.Context(), daoai.ConvertBeatsSheetRequest{
+						Logline:    testCase.Logline,
+						Beats:      testCase.Beats,
+						SourcePlan: storyplanmodel.FreytagPyramid[lang],
+						TargetPlan: targetPlan,
+						UserID:     TestUser,
+						Lang:       lang,
+					})
+					require.NoError(t, err)
+
+					require.NoError(t, targetPlan.Validate(beatsSheet))
+
+					aggregate := func(beats []models.Beat) string {
+						return strings.Join(lo.Map(beats, func(item models.Beat, _ int) string {
+							return item.Title + "\n" + item.Content
+						}), "\n\n")
+					}
+
+					aggregated, aggregatedSource := aggregate(beatsSheet), aggregate(testCase.Beats)
+
+					CheckAgent(
+						t,
+						fmt.Sprintf(data.CheckAgent, aggregated, aggregatedSource, testCase.Logline),
+						fmt.Sprintf(errorMsg, aggregated, aggregatedSource, testCase.Logline),
+					)
+					CheckLang(t, lang, aggregated)
+				})
+			}
+		})
+	}
+}
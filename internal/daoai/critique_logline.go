@@ -0,0 +1,106 @@
+package daoai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/packages/param"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/daoai/prompts"
+	"github.com/a-novel/service-story-schematics/internal/daoai/schemas"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+type CritiqueLoglineTemplates struct {
+	System *template.Template
+}
+
+var CritiqueLoglinePrompts = prompts.MapLocalized(
+	prompts.CritiqueLogline,
+	func(prompt prompts.CritiqueLoglineType) CritiqueLoglineTemplates {
+		return CritiqueLoglineTemplates{
+			System: template.Must(template.New("").Parse(prompt.System)),
+		}
+	},
+)
+
+type CritiqueLoglineRequest struct {
+	Logline string
+	UserID  string
+	Lang    models.Lang
+}
+
+type CritiqueLoglineRepository struct {
+	config *config.OpenAI
+}
+
+func NewCritiqueLoglineRepository(config *config.OpenAI) *CritiqueLoglineRepository {
+	return &CritiqueLoglineRepository{config: config}
+}
+
+func (repository *CritiqueLoglineRepository) CritiqueLogline(
+	ctx context.Context, request CritiqueLoglineRequest,
+) (*models.LoglineCritique, error) {
+	ctx, span := otel.Tracer().Start(ctx, "daoai.CritiqueLogline")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.userID", request.UserID),
+		attribute.String("request.lang", request.Lang.String()),
+		attribute.String("request.logline", request.Logline),
+	)
+
+	templates, promptLang := CritiqueLoglinePrompts.Get(request.Lang)
+
+	span.SetAttributes(attribute.String("prompt.lang", promptLang.String()))
+
+	systemPrompt := new(strings.Builder)
+
+	err := templates.System.Execute(systemPrompt, nil)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute system prompt: %w", err))
+	}
+
+	chatCompletion, err := repository.config.Client().
+		Chat.Completions.
+		New(ctx, openai.ChatCompletionNewParams{
+			Model: repository.config.Model,
+			User:  param.NewOpt(request.UserID),
+			Messages: []openai.ChatCompletionMessageParamUnion{
+				openai.SystemMessage(ForceNextAnswerLocale(request.Lang, systemPrompt.String())),
+				openai.UserMessage(request.Logline),
+			},
+			ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
+				OfJSONSchema: &openai.ResponseFormatJSONSchemaParam{
+					JSONSchema: openai.ResponseFormatJSONSchemaJSONSchemaParam{
+						Name:        "logline_critique",
+						Description: openai.String(schemas.LoglineCritique.Description),
+						Schema:      schemas.LoglineCritique.Schema,
+						Strict:      openai.Bool(true),
+					},
+				},
+			},
+		})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	var critique models.LoglineCritique
+
+	err = json.Unmarshal([]byte(chatCompletion.Choices[0].Message.Content), &critique)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	critique.Lang = request.Lang
+
+	return otel.ReportSuccess(span, &critique), nil
+}
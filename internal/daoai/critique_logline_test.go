@@ -0,0 +1,63 @@
+package daoai_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/internal/daoai/testdata"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestCritiqueLogline(t *testing.T) {
+	const errorMsg = "The greater AI decreted that this critique:\n\n%s\n\nIs not relevant for this logline:\n\n%s"
+
+	repository := daoai.NewCritiqueLoglineRepository(&config.OpenAIPresetDefault)
+
+	for _, lang := range models.Langs {
+		t.Run(lang.String(), func(t *testing.T) {
+			t.Parallel()
+
+			data := testdata.CritiqueLoglinePrompt
+
+			for name, testCase := range data.Cases {
+				t.Run(name, func(t *testing.T) {
+					t.Parallel()
+
+					resp, err := repository.CritiqueLogline(t.Context(), daoai.CritiqueLoglineRequest{
+						Logline: testCase.Logline,
+						Lang:    lang,
+						UserID:  TestUser,
+					})
+					require.NoError(t, err)
+
+					require.NotNil(t, resp)
+					require.Equal(t, lang, resp.Lang)
+
+					for _, score := range []models.LoglineCritiqueScore{
+						resp.Protagonist, resp.Goal, resp.Stakes, resp.Hook, resp.GenreClarity,
+					} {
+						require.GreaterOrEqual(t, score.Score, 1)
+						require.LessOrEqual(t, score.Score, 10)
+						require.NotEmpty(t, score.Justification)
+						require.NotEmpty(t, score.Suggestion)
+					}
+
+					critique := string(lo.Must(json.MarshalIndent(resp, "", "  ")))
+
+					CheckAgent(
+						t,
+						fmt.Sprintf(data.CheckAgent, critique, testCase.Logline),
+						fmt.Sprintf(errorMsg, critique, testCase.Logline),
+					)
+					CheckLang(t, lang, resp.Protagonist.Justification)
+				})
+			}
+		})
+	}
+}
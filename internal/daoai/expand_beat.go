@@ -21,16 +21,23 @@ import (
 	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
-var ExpandBeatPrompts = struct {
+type ExpandBeatTemplates struct {
 	System *template.Template
 	Input1 *template.Template
 	Input2 *template.Template
-}{
-	System: template.Must(template.New("").Parse(prompts.ExpandBeat.System)),
-	Input1: template.Must(template.New("").Parse(prompts.ExpandBeat.Input1)),
-	Input2: template.Must(template.New("").Parse(prompts.ExpandBeat.Input2)),
 }
 
+var ExpandBeatPrompts = prompts.MapLocalized(
+	prompts.ExpandBeat,
+	func(prompt prompts.ExpandBeatsType) ExpandBeatTemplates {
+		return ExpandBeatTemplates{
+			System: template.Must(template.New("").Parse(prompt.System)),
+			Input1: template.Must(template.New("").Parse(prompt.Input1)),
+			Input2: template.Must(template.New("").Parse(prompt.Input2)),
+		}
+	},
+)
+
 var ErrUnknownTargetKey = errors.New("unknown target key")
 
 type ExpandBeatRequest struct {
@@ -39,7 +46,9 @@ type ExpandBeatRequest struct {
 	Plan      *storyplanmodel.Plan
 	Lang      models.Lang
 	TargetKey string
-	UserID    string
+	// When the key is repeated in the sheet, the (zero-based) occurrence of the beat to expand.
+	Occurrence int
+	UserID     string
 }
 
 type ExpandBeatRepository struct {
@@ -60,9 +69,14 @@ func (repository *ExpandBeatRepository) ExpandBeat(
 		attribute.String("request.userID", request.UserID),
 		attribute.String("request.Lang", request.Lang.String()),
 		attribute.String("request.targetKey", request.TargetKey),
+		attribute.Int("request.occurrence", request.Occurrence),
 		attribute.String("request.logline", request.Logline),
 	)
 
+	templates, promptLang := ExpandBeatPrompts.Get(request.Lang)
+
+	span.SetAttributes(attribute.String("prompt.lang", promptLang.String()))
+
 	targetBeat, err := request.Plan.GetBeat(request.TargetKey)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("get target beat: %w", err))
@@ -70,8 +84,9 @@ func (repository *ExpandBeatRepository) ExpandBeat(
 
 	systemPrompt := new(strings.Builder)
 
-	err = ExpandBeatPrompts.System.Execute(systemPrompt, map[string]any{
+	err = templates.System.Execute(systemPrompt, map[string]any{
 		"PlanName": request.Plan.Metadata.Name,
+		"Acts":     request.Plan.Acts,
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("parse system message: %w", err))
@@ -79,14 +94,14 @@ func (repository *ExpandBeatRepository) ExpandBeat(
 
 	userPrompt1 := new(strings.Builder)
 
-	err = ExpandBeatPrompts.Input1.Execute(userPrompt1, request)
+	err = templates.Input1.Execute(userPrompt1, request)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("parse user message 1: %w", err))
 	}
 
 	userPrompt2 := new(strings.Builder)
 
-	err = ExpandBeatPrompts.Input2.Execute(userPrompt2, request)
+	err = templates.Input2.Execute(userPrompt2, request)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("parse user message 2: %w", err))
 	}
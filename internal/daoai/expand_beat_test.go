@@ -20,7 +20,7 @@ func TestExpandBeat(t *testing.T) {
 
 	repository := daoai.NewExpandBeatRepository(&config.OpenAIPresetDefault)
 
-	for _, lang := range []models.Lang{models.LangEN, models.LangFR} {
+	for _, lang := range models.Langs {
 		t.Run(lang.String(), func(t *testing.T) {
 			t.Parallel()
 
@@ -19,12 +19,19 @@ import (
 	"github.com/a-novel/service-story-schematics/models/config"
 )
 
-var ExpandLoglinePrompts = struct {
+type ExpandLoglineTemplates struct {
 	System *template.Template
-}{
-	System: template.Must(template.New("").Parse(prompts.ExpandLogline.System)),
 }
 
+var ExpandLoglinePrompts = prompts.MapLocalized(
+	prompts.ExpandLogline,
+	func(prompt prompts.ExpandLoglinesType) ExpandLoglineTemplates {
+		return ExpandLoglineTemplates{
+			System: template.Must(template.New("").Parse(prompt.System)),
+		}
+	},
+)
+
 type ExpandLoglineRequest struct {
 	Logline string
 	UserID  string
@@ -51,9 +58,13 @@ func (repository *ExpandLoglineRepository) ExpandLogline(
 		attribute.String("request.logline", request.Logline),
 	)
 
+	templates, promptLang := ExpandLoglinePrompts.Get(request.Lang)
+
+	span.SetAttributes(attribute.String("prompt.lang", promptLang.String()))
+
 	systemPrompt := new(strings.Builder)
 
-	err := ExpandLoglinePrompts.System.Execute(systemPrompt, nil)
+	err := templates.System.Execute(systemPrompt, nil)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("execute system prompt: %w", err))
 	}
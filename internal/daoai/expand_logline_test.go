@@ -17,7 +17,7 @@ func TestExpandLogline(t *testing.T) {
 
 	repository := daoai.NewExpandLoglineRepository(&config.OpenAIPresetDefault)
 
-	for _, lang := range []models.Lang{models.LangEN, models.LangFR} {
+	for _, lang := range models.Langs {
 		t.Run(lang.String(), func(t *testing.T) {
 			t.Parallel()
 
@@ -20,12 +20,19 @@ import (
 	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
-var GenerateBeatsSheetPrompts = struct {
+type GenerateBeatsSheetTemplates struct {
 	System *template.Template
-}{
-	System: template.Must(template.New("").Parse(prompts.GenerateBeatsSheet.System)),
 }
 
+var GenerateBeatsSheetPrompts = prompts.MapLocalized(
+	prompts.GenerateBeatsSheet,
+	func(prompt prompts.GenerateBeatsSheetsType) GenerateBeatsSheetTemplates {
+		return GenerateBeatsSheetTemplates{
+			System: template.Must(template.New("").Parse(prompt.System)),
+		}
+	},
+)
+
 var ErrInvalidBeatSheet = errors.New("invalid beat sheet")
 
 type GenerateBeatsSheetRequest struct {
@@ -55,10 +62,16 @@ func (repository *GenerateBeatsSheetRepository) GenerateBeatsSheet(
 		attribute.String("request.userID", request.UserID),
 	)
 
+	templates, promptLang := GenerateBeatsSheetPrompts.Get(request.Lang)
+
+	span.SetAttributes(attribute.String("prompt.lang", promptLang.String()))
+
 	systemPrompt := new(strings.Builder)
 
-	err := GenerateBeatsSheetPrompts.System.Execute(systemPrompt, map[string]any{
-		"PlanName": request.Plan.Metadata.Name,
+	err := templates.System.Execute(systemPrompt, map[string]any{
+		"PlanName":      request.Plan.Metadata.Name,
+		"Acts":          request.Plan.Acts,
+		"FlexibleBeats": request.Plan.FlexibleBeats(),
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("execute system prompt: %w", err))
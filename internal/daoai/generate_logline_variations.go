@@ -0,0 +1,125 @@
+package daoai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/packages/param"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/daoai/prompts"
+	"github.com/a-novel/service-story-schematics/internal/daoai/schemas"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+type GenerateLoglineVariationsTemplates struct {
+	System *template.Template
+}
+
+var GenerateLoglineVariationsPrompts = prompts.MapLocalized(
+	prompts.GenerateLoglineVariations,
+	func(prompt prompts.GenerateLoglineVariationsType) GenerateLoglineVariationsTemplates {
+		return GenerateLoglineVariationsTemplates{
+			System: template.Must(template.New("").Parse(prompt.System)),
+		}
+	},
+)
+
+type GenerateLoglineVariationsRequest struct {
+	Logline string
+	Count   int
+	// The dimensions the variations may change. Dimensions that are not listed are kept from the original logline.
+	Dimensions []models.LoglineDimension
+	UserID     string
+	Lang       models.Lang
+}
+
+type GenerateLoglineVariationsRepository struct {
+	config *config.OpenAI
+}
+
+func NewGenerateLoglineVariationsRepository(config *config.OpenAI) *GenerateLoglineVariationsRepository {
+	return &GenerateLoglineVariationsRepository{config: config}
+}
+
+func (repository *GenerateLoglineVariationsRepository) GenerateLoglineVariations(
+	ctx context.Context, request GenerateLoglineVariationsRequest,
+) ([]models.LoglineVariation, error) {
+	ctx, span := otel.Tracer().Start(ctx, "daoai.GenerateLoglineVariations")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.userID", request.UserID),
+		attribute.String("request.lang", request.Lang.String()),
+		attribute.String("request.logline", request.Logline),
+		attribute.Int("request.count", request.Count),
+		attribute.StringSlice("request.dimensions", lo.Map(
+			request.Dimensions,
+			func(item models.LoglineDimension, _ int) string { return item.String() },
+		)),
+	)
+
+	templates, promptLang := GenerateLoglineVariationsPrompts.Get(request.Lang)
+
+	span.SetAttributes(attribute.String("prompt.lang", promptLang.String()))
+
+	systemPrompt := new(strings.Builder)
+
+	err := templates.System.Execute(systemPrompt, request)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute system prompt: %w", err))
+	}
+
+	// Only let the model report one of the requested dimensions as the one it changed.
+	schema := schemas.LoglineVariations(lo.Map(
+		request.Dimensions,
+		func(item models.LoglineDimension, _ int) string { return item.String() },
+	))
+
+	chatCompletion, err := repository.config.Client().
+		Chat.Completions.
+		New(ctx, openai.ChatCompletionNewParams{
+			Model: repository.config.Model,
+			User:  param.NewOpt(request.UserID),
+			Messages: []openai.ChatCompletionMessageParamUnion{
+				openai.SystemMessage(ForceNextAnswerLocale(request.Lang, systemPrompt.String())),
+				openai.UserMessage(request.Logline),
+			},
+			ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
+				OfJSONSchema: &openai.ResponseFormatJSONSchemaParam{
+					JSONSchema: openai.ResponseFormatJSONSchemaJSONSchemaParam{
+						Name:        "logline_variations",
+						Description: openai.String(schema.Description),
+						Schema:      schema.Schema,
+						Strict:      openai.Bool(true),
+					},
+				},
+			},
+		})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	var variations struct {
+		Loglines []models.LoglineVariation `json:"loglines"`
+	}
+
+	err = json.Unmarshal([]byte(chatCompletion.Choices[0].Message.Content), &variations)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	for i := range variations.Loglines {
+		variations.Loglines[i].Lang = request.Lang
+	}
+
+	return otel.ReportSuccess(span, variations.Loglines), nil
+}
@@ -0,0 +1,61 @@
+package daoai_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/internal/daoai/testdata"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestGenerateLoglineVariations(t *testing.T) {
+	const errorMsg = "The greater AI decreted that this logline:\n\n%s\n\nIs not a %s variation of this logline:\n\n%s"
+
+	repository := daoai.NewGenerateLoglineVariationsRepository(&config.OpenAIPresetDefault)
+
+	for _, lang := range models.Langs {
+		t.Run(lang.String(), func(t *testing.T) {
+			t.Parallel()
+
+			data := testdata.GenerateLoglineVariationsPrompt
+
+			for name, testCase := range data.Cases {
+				t.Run(name, func(t *testing.T) {
+					t.Parallel()
+
+					variations, err := repository.GenerateLoglineVariations(
+						t.Context(),
+						daoai.GenerateLoglineVariationsRequest{
+							Logline:    testCase.Logline,
+							Count:      testCase.Count,
+							Dimensions: testCase.Dimensions,
+							UserID:     TestUser,
+							Lang:       lang,
+						},
+					)
+					require.NoError(t, err)
+
+					require.Len(t, variations, testCase.Count)
+
+					for _, variation := range variations {
+						require.NotEmpty(t, variation.Name)
+						require.NotEmpty(t, variation.Content)
+						require.Equal(t, lang, variation.Lang)
+						require.Contains(t, testCase.Dimensions, variation.Dimension)
+
+						CheckAgent(
+							t,
+							fmt.Sprintf(data.CheckAgent, variation.Content, variation.Dimension, testCase.Logline),
+							fmt.Sprintf(errorMsg, variation.Content, variation.Dimension, testCase.Logline),
+						)
+						CheckLang(t, lang, variation.Content)
+					}
+				})
+			}
+		})
+	}
+}
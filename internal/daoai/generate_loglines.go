@@ -19,14 +19,21 @@ import (
 	"github.com/a-novel/service-story-schematics/models/config"
 )
 
-var GenerateLoglinesPrompts = struct {
+type GenerateLoglinesTemplates struct {
 	Themed *template.Template
 	Random *template.Template
-}{
-	Themed: template.Must(template.New("").Parse(prompts.GenerateLoglines.System.Themed)),
-	Random: template.Must(template.New("").Parse(prompts.GenerateLoglines.System.Random)),
 }
 
+var GenerateLoglinesPrompts = prompts.MapLocalized(
+	prompts.GenerateLoglines,
+	func(prompt prompts.GenerateLoglinessType) GenerateLoglinesTemplates {
+		return GenerateLoglinesTemplates{
+			Themed: template.Must(template.New("").Parse(prompt.System.Themed)),
+			Random: template.Must(template.New("").Parse(prompt.System.Random)),
+		}
+	},
+)
+
 type GenerateLoglinesRequest struct {
 	Count  int
 	Theme  string
@@ -55,6 +62,10 @@ func (repository *GenerateLoglinesRepository) GenerateLoglines(
 		attribute.String("request.theme", request.Theme),
 	)
 
+	templates, promptLang := GenerateLoglinesPrompts.Get(request.Lang)
+
+	span.SetAttributes(attribute.String("prompt.lang", promptLang.String()))
+
 	var (
 		err      error
 		messages []openai.ChatCompletionMessageParamUnion
@@ -62,7 +73,7 @@ func (repository *GenerateLoglinesRepository) GenerateLoglines(
 
 	if request.Theme != "" {
 		systemPrompt := new(strings.Builder)
-		err = GenerateLoglinesPrompts.Themed.Execute(systemPrompt, request)
+		err = templates.Themed.Execute(systemPrompt, request)
 
 		messages = []openai.ChatCompletionMessageParamUnion{
 			openai.SystemMessage(ForceNextAnswerLocale(request.Lang, systemPrompt.String())),
@@ -70,7 +81,7 @@ func (repository *GenerateLoglinesRepository) GenerateLoglines(
 		}
 	} else {
 		userPrompt := new(strings.Builder)
-		err = GenerateLoglinesPrompts.Random.Execute(userPrompt, request)
+		err = templates.Random.Execute(userPrompt, request)
 
 		messages = []openai.ChatCompletionMessageParamUnion{
 			openai.SystemMessage(ForceNextAnswerLocale(request.Lang, "")),
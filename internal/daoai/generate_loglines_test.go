@@ -19,7 +19,7 @@ func TestGenerateLoglines(t *testing.T) {
 
 	repository := daoai.NewGenerateLoglinesRepository(&config.OpenAIPresetDefault)
 
-	for _, lang := range []models.Lang{models.LangEN, models.LangFR} {
+	for _, lang := range models.Langs {
 		t.Run(lang.String(), func(t *testing.T) {
 			t.Parallel()
 
@@ -0,0 +1,8 @@
+package prompts
+
+type ConvertBeatsSheetType struct {
+	System string `yaml:"system"`
+	Input  string `yaml:"input"`
+}
+
+var ConvertBeatsSheet = mustLoadLocalized[ConvertBeatsSheetType]("convert_beats_sheet")
@@ -0,0 +1,7 @@
+package prompts
+
+type CritiqueLoglineType struct {
+	System string `yaml:"system"`
+}
+
+var CritiqueLogline = mustLoadLocalized[CritiqueLoglineType]("critique_logline")
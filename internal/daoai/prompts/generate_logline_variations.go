@@ -0,0 +1,7 @@
+package prompts
+
+type GenerateLoglineVariationsType struct {
+	System string `yaml:"system"`
+}
+
+var GenerateLoglineVariations = mustLoadLocalized[GenerateLoglineVariationsType]("generate_logline_variations")
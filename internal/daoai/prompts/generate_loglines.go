@@ -1,16 +1,5 @@
 package prompts
 
-import (
-	_ "embed"
-
-	"github.com/goccy/go-yaml"
-
-	"github.com/a-novel/golib/config"
-)
-
-//go:embed generate_loglines.en.yaml
-var generateLoglinesEnFile []byte
-
 type GenerateLoglinessType struct {
 	System struct {
 		Themed string `yaml:"themed"`
@@ -18,4 +7,4 @@ type GenerateLoglinessType struct {
 	} `yaml:"system"`
 }
 
-var GenerateLoglines = config.MustUnmarshal[GenerateLoglinessType](yaml.Unmarshal, generateLoglinesEnFile)
+var GenerateLoglines = mustLoadLocalized[GenerateLoglinessType]("generate_loglines")
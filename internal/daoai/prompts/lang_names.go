@@ -0,0 +1,10 @@
+package prompts
+
+import (
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+// LangNamesType gives the name of each language, as written in the language of the prompt that refers to it.
+type LangNamesType map[models.Lang]string
+
+var LangNames = mustLoadLocalized[LangNamesType]("lang_names")
@@ -0,0 +1,62 @@
+package prompts
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/goccy/go-yaml"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/config"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+//go:embed *.yaml
+var promptFiles embed.FS
+
+// Localized holds the variants of a prompt, for each language it is natively written in. The english variant is
+// always available, and is used as a fallback for languages without a native variant.
+type Localized[T any] map[models.Lang]T
+
+// Get returns the variant of the prompt to use for the given language, along with the language of that variant.
+func (localized Localized[T]) Get(lang models.Lang) (T, models.Lang) {
+	if prompt, ok := localized[lang]; ok {
+		return prompt, lang
+	}
+
+	return localized[models.LangEN], models.LangEN
+}
+
+// MapLocalized converts every variant of a localized prompt.
+func MapLocalized[T, U any](localized Localized[T], mapper func(prompt T) U) Localized[U] {
+	return lo.MapValues(localized, func(prompt T, _ models.Lang) U {
+		return mapper(prompt)
+	})
+}
+
+// mustLoadLocalized loads every variant of a prompt, from files named "<name>.<lang>.yaml".
+func mustLoadLocalized[T any](name string) Localized[T] {
+	localized := make(Localized[T])
+
+	for _, lang := range models.Langs {
+		file, err := promptFiles.ReadFile(fmt.Sprintf("%s.%s.yaml", name, lang))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			panic(fmt.Sprintf("read prompt %s (%s): %v", name, lang, err))
+		}
+
+		localized[lang] = config.MustUnmarshal[T](yaml.Unmarshal, file)
+	}
+
+	if _, ok := localized[models.LangEN]; !ok {
+		panic(fmt.Sprintf("prompt %s has no english variant", name))
+	}
+
+	return localized
+}
@@ -0,0 +1,100 @@
+package prompts_test
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/daoai/prompts"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestLocalized(t *testing.T) {
+	t.Parallel()
+
+	localized := prompts.Localized[string]{
+		models.LangEN: "english",
+		models.LangFR: "french",
+	}
+
+	testCases := []struct {
+		name string
+
+		lang models.Lang
+
+		expect     string
+		expectLang models.Lang
+	}{
+		{
+			name: "Native",
+
+			lang: models.LangFR,
+
+			expect:     "french",
+			expectLang: models.LangFR,
+		},
+		{
+			name: "English",
+
+			lang: models.LangEN,
+
+			expect:     "english",
+			expectLang: models.LangEN,
+		},
+		{
+			name: "Fallback",
+
+			lang: models.LangDE,
+
+			expect:     "english",
+			expectLang: models.LangEN,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			prompt, lang := localized.Get(testCase.lang)
+			require.Equal(t, testCase.expect, prompt)
+			require.Equal(t, testCase.expectLang, lang)
+		})
+	}
+}
+
+func TestPrompts(t *testing.T) {
+	t.Parallel()
+
+	langs := map[string][]models.Lang{
+		"ConvertBeatsSheet":   lo.Keys(prompts.ConvertBeatsSheet),
+		"ExpandBeat":          lo.Keys(prompts.ExpandBeat),
+		"ExpandLogline":       lo.Keys(prompts.ExpandLogline),
+		"GenerateBeatsSheet":  lo.Keys(prompts.GenerateBeatsSheet),
+		"GenerateLoglines":    lo.Keys(prompts.GenerateLoglines),
+		"LangNames":           lo.Keys(prompts.LangNames),
+		"RegenerateBeats":     lo.Keys(prompts.RegenerateBeats),
+		"TranslateBeatsSheet": lo.Keys(prompts.TranslateBeatsSheet),
+		"TranslateLogline":    lo.Keys(prompts.TranslateLogline),
+	}
+
+	for name, variants := range langs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ElementsMatch(t, []models.Lang{models.LangEN, models.LangFR}, variants)
+		})
+	}
+}
+
+func TestLangNames(t *testing.T) {
+	t.Parallel()
+
+	for lang, names := range prompts.LangNames {
+		t.Run(lang.String(), func(t *testing.T) {
+			t.Parallel()
+
+			require.ElementsMatch(t, models.Langs, lo.Keys(names))
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package prompts
+
+type TranslateBeatsSheetType struct {
+	System string `yaml:"system"`
+	Input  string `yaml:"input"`
+}
+
+var TranslateBeatsSheet = mustLoadLocalized[TranslateBeatsSheetType]("translate_beats_sheet")
@@ -0,0 +1,7 @@
+package prompts
+
+type TranslateLoglineType struct {
+	System string `yaml:"system"`
+}
+
+var TranslateLogline = mustLoadLocalized[TranslateLoglineType]("translate_logline")
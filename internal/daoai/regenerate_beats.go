@@ -20,16 +20,23 @@ import (
 	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
-var RegenerateBeatsPrompts = struct {
+type RegenerateBeatsTemplates struct {
 	System *template.Template
 	Input1 *template.Template
 	Input2 *template.Template
-}{
-	System: template.Must(template.New("").Parse(prompts.RegenerateBeats.System)),
-	Input1: template.Must(template.New("").Parse(prompts.RegenerateBeats.Input1)),
-	Input2: template.Must(template.New("").Parse(prompts.RegenerateBeats.Input2)),
 }
 
+var RegenerateBeatsPrompts = prompts.MapLocalized(
+	prompts.RegenerateBeats,
+	func(prompt prompts.RegenerateBeatsType) RegenerateBeatsTemplates {
+		return RegenerateBeatsTemplates{
+			System: template.Must(template.New("").Parse(prompt.System)),
+			Input1: template.Must(template.New("").Parse(prompt.Input1)),
+			Input2: template.Must(template.New("").Parse(prompt.Input2)),
+		}
+	},
+)
+
 type RegenerateBeatsRequest struct {
 	Logline        string
 	Beats          []models.Beat
@@ -60,10 +67,16 @@ func (repository *RegenerateBeatsRepository) RegenerateBeats(
 		attribute.String("request.lang", request.Lang.String()),
 	)
 
+	templates, promptLang := RegenerateBeatsPrompts.Get(request.Lang)
+
+	span.SetAttributes(attribute.String("prompt.lang", promptLang.String()))
+
 	systemPrompt := new(strings.Builder)
 
-	err := RegenerateBeatsPrompts.System.Execute(systemPrompt, map[string]any{
-		"PlanName": request.Plan.Metadata.Name,
+	err := templates.System.Execute(systemPrompt, map[string]any{
+		"PlanName":      request.Plan.Metadata.Name,
+		"Acts":          request.Plan.Acts,
+		"FlexibleBeats": request.Plan.FlexibleBeats(),
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("parse system message: %w", err))
@@ -71,7 +84,7 @@ func (repository *RegenerateBeatsRepository) RegenerateBeats(
 
 	userPrompt1 := new(strings.Builder)
 
-	err = RegenerateBeatsPrompts.Input1.Execute(userPrompt1, map[string]any{
+	err = templates.Input1.Execute(userPrompt1, map[string]any{
 		"Logline": request.Logline,
 	})
 	if err != nil {
@@ -80,7 +93,7 @@ func (repository *RegenerateBeatsRepository) RegenerateBeats(
 
 	userPrompt2 := new(strings.Builder)
 
-	err = RegenerateBeatsPrompts.Input2.Execute(userPrompt2, map[string]any{
+	err = templates.Input2.Execute(userPrompt2, map[string]any{
 		"Beats": request.RegenerateKeys,
 	})
 	if err != nil {
@@ -144,23 +157,19 @@ func (repository *RegenerateBeatsRepository) extrudedBeatsSheet(request Regenera
 	return strings.Join(parts, "\n\n")
 }
 
+// mergeSourceAndNewBeats rebuilds the beats sheet following the order of the plan. Regenerated beats replace every
+// occurrence of the source beats with the same key, so optional or repeated beats may change in number.
 func (repository *RegenerateBeatsRepository) mergeSourceAndNewBeats(
 	request RegenerateBeatsRequest, newBeats []models.Beat,
 ) []models.Beat {
 	var output []models.Beat
 
-	for _, beat := range request.Beats {
-		if lo.Contains(request.RegenerateKeys, beat.Key) {
-			for _, newBeat := range newBeats {
-				if newBeat.Key == beat.Key {
-					output = append(output, newBeat)
-
-					break
-				}
-			}
-		} else {
-			output = append(output, beat)
-		}
+	for _, planBeat := range request.Plan.Beats {
+		source := lo.Ternary(lo.Contains(request.RegenerateKeys, planBeat.Key), newBeats, request.Beats)
+
+		output = append(output, lo.Filter(source, func(beat models.Beat, _ int) bool {
+			return beat.Key == planBeat.Key
+		})...)
 	}
 
 	return output
@@ -21,7 +21,7 @@ func TestRegenerateBeats(t *testing.T) {
 
 	repository := daoai.NewRegenerateBeatsRepository(&config.OpenAIPresetDefault)
 
-	for _, lang := range []models.Lang{models.LangEN, models.LangFR} {
+	for _, lang := range models.Langs {
 		t.Run(lang.String(), func(t *testing.T) {
 			t.Parallel()
 
@@ -0,0 +1,14 @@
+package schemas
+
+import (
+	_ "embed"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/a-novel/golib/config"
+)
+
+//go:embed logline_critique.en.yaml
+var loglineCritiqueEnFile []byte
+
+var LoglineCritique = config.MustUnmarshal[Schema](yaml.Unmarshal, loglineCritiqueEnFile)
@@ -0,0 +1,71 @@
+package schemas
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// LoglineVariations extends Loglines, so each logline also reports which of the given dimensions it changed.
+func LoglineVariations(dimensions []string) Schema {
+	schema := deepCopy(Loglines.Schema)
+
+	items := object(schema, "properties", "loglines", "items")
+
+	required, ok := items["required"].([]any)
+	if !ok {
+		panic("schema: loglines items have no required properties")
+	}
+
+	items["required"] = append(required, "dimension")
+	object(items, "properties")["dimension"] = map[string]any{
+		"type":        "string",
+		"description": "The dimension of the original logline that was changed.",
+		"enum":        dimensions,
+	}
+
+	return Schema{
+		Description: "A collection of loglines derived from an original logline, each changing a single dimension of it.",
+		Schema:      schema,
+	}
+}
+
+// object returns the object found under the given keys of a schema. Schemas are embedded, so a missing object is a
+// programming error.
+func object(value any, keys ...string) map[string]any {
+	output, ok := value.(map[string]any)
+	if !ok {
+		panic("schema: not an object")
+	}
+
+	for _, key := range keys {
+		output, ok = output[key].(map[string]any)
+		if !ok {
+			panic(fmt.Sprintf("schema: %q is not an object", key))
+		}
+	}
+
+	return output
+}
+
+// deepCopy copies a schema decoded from YAML, so it can be extended without altering the original.
+func deepCopy(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		output := maps.Clone(typed)
+		for key, item := range output {
+			output[key] = deepCopy(item)
+		}
+
+		return output
+	case []any:
+		output := slices.Clone(typed)
+		for i, item := range output {
+			output[i] = deepCopy(item)
+		}
+
+		return output
+	default:
+		return value
+	}
+}
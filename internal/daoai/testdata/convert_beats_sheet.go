@@ -0,0 +1,26 @@
+package testdata
+
+import (
+	_ "embed"
+
+	"github.com/a-novel/golib/config"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/goccy/go-yaml"
+)
+
+//go:embed convert_beats_sheet.en.yaml
+var convertBeatsSheetEnFile []byte
+
+type ConvertBeatsSheetTestCase struct {
+	Logline string        `yaml:"logline"`
+	Beats   []models.Beat `yaml:"beats"`
+}
+
+type ConvertBeatsSheetPromptsType struct {
+	Cases      map[string]ConvertBeatsSheetTestCase `yaml:"cases"`
+	CheckAgent string                               `yaml:"checkAgent"`
+}
+
+var ConvertBeatsSheetPrompt = config.MustUnmarshal[ConvertBeatsSheetPromptsType](
+	yaml.Unmarshal, convertBeatsSheetEnFile,
+)
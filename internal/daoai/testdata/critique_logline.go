@@ -0,0 +1,22 @@
+package testdata
+
+import (
+	_ "embed"
+
+	"github.com/a-novel/golib/config"
+	"github.com/goccy/go-yaml"
+)
+
+//go:embed critique_logline.en.yaml
+var critiqueLoglineEnFile []byte
+
+type CritiqueLoglineTestCase struct {
+	Logline string `yaml:"logline"`
+}
+
+type CritiqueLoglinePromptsType struct {
+	Cases      map[string]CritiqueLoglineTestCase `yaml:"cases"`
+	CheckAgent string                             `yaml:"checkAgent"`
+}
+
+var CritiqueLoglinePrompt = config.MustUnmarshal[CritiqueLoglinePromptsType](yaml.Unmarshal, critiqueLoglineEnFile)
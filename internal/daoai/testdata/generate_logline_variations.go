@@ -0,0 +1,28 @@
+package testdata
+
+import (
+	_ "embed"
+
+	"github.com/a-novel/golib/config"
+	"github.com/goccy/go-yaml"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+//go:embed generate_logline_variations.en.yaml
+var generateLoglineVariationsEnFile []byte
+
+type GenerateLoglineVariationsTestCase struct {
+	Logline    string                    `yaml:"logline"`
+	Count      int                       `yaml:"count"`
+	Dimensions []models.LoglineDimension `yaml:"dimensions"`
+}
+
+type GenerateLoglineVariationsPromptsType struct {
+	Cases      map[string]GenerateLoglineVariationsTestCase `yaml:"cases"`
+	CheckAgent string                                       `yaml:"checkAgent"`
+}
+
+var GenerateLoglineVariationsPrompt = config.MustUnmarshal[GenerateLoglineVariationsPromptsType](
+	yaml.Unmarshal, generateLoglineVariationsEnFile,
+)
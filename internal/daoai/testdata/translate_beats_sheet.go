@@ -0,0 +1,26 @@
+package testdata
+
+import (
+	_ "embed"
+
+	"github.com/a-novel/golib/config"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/goccy/go-yaml"
+)
+
+//go:embed translate_beats_sheet.en.yaml
+var translateBeatsSheetEnFile []byte
+
+type TranslateBeatsSheetTestCase struct {
+	Logline string        `yaml:"logline"`
+	Beats   []models.Beat `yaml:"beats"`
+}
+
+type TranslateBeatsSheetPromptsType struct {
+	Cases      map[string]TranslateBeatsSheetTestCase `yaml:"cases"`
+	CheckAgent string                                 `yaml:"checkAgent"`
+}
+
+var TranslateBeatsSheetPrompt = config.MustUnmarshal[TranslateBeatsSheetPromptsType](
+	yaml.Unmarshal, translateBeatsSheetEnFile,
+)
@@ -0,0 +1,23 @@
+package testdata
+
+import (
+	_ "embed"
+
+	"github.com/a-novel/golib/config"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/goccy/go-yaml"
+)
+
+//go:embed translate_logline.en.yaml
+var translateLoglineEnFile []byte
+
+type TranslateLoglineTestCase struct {
+	Logline models.LoglineIdea `yaml:"logline"`
+}
+
+type TranslateLoglinePromptsType struct {
+	Cases      map[string]TranslateLoglineTestCase `yaml:"cases"`
+	CheckAgent string                              `yaml:"checkAgent"`
+}
+
+var TranslateLoglinePrompt = config.MustUnmarshal[TranslateLoglinePromptsType](yaml.Unmarshal, translateLoglineEnFile)
@@ -0,0 +1,155 @@
+package daoai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/packages/param"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/daoai/prompts"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+// ErrTranslationKeysMismatch is returned when a translated beats sheet does not keep the beats of the original
+// sheet, in the same order.
+var ErrTranslationKeysMismatch = errors.New("translated beats do not match the original beats")
+
+type TranslateBeatsSheetTemplates struct {
+	System *template.Template
+	Input  *template.Template
+}
+
+var TranslateBeatsSheetPrompts = prompts.MapLocalized(
+	prompts.TranslateBeatsSheet,
+	func(prompt prompts.TranslateBeatsSheetType) TranslateBeatsSheetTemplates {
+		return TranslateBeatsSheetTemplates{
+			System: template.Must(template.New("").Parse(prompt.System)),
+			Input:  template.Must(template.New("").Parse(prompt.Input)),
+		}
+	},
+)
+
+type TranslateBeatsSheetRequest struct {
+	Logline string
+	// Beats of the sheet to translate, written in SourceLang.
+	Beats      []models.Beat
+	SourceLang models.Lang
+	// Plan is the translation of the sheet's story plan, in the target language.
+	Plan   *storyplanmodel.Plan
+	UserID string
+	// Lang is the language to translate the sheet to.
+	Lang models.Lang
+}
+
+type TranslateBeatsSheetRepository struct {
+	config *config.OpenAI
+}
+
+func NewTranslateBeatsSheetRepository(config *config.OpenAI) *TranslateBeatsSheetRepository {
+	return &TranslateBeatsSheetRepository{config: config}
+}
+
+func (repository *TranslateBeatsSheetRepository) TranslateBeatsSheet(
+	ctx context.Context, request TranslateBeatsSheetRequest,
+) ([]models.Beat, error) {
+	ctx, span := otel.Tracer().Start(ctx, "daoai.TranslateBeatsSheet")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.logline", request.Logline),
+		attribute.String("request.plan", request.Plan.Metadata.Slug.String()),
+		attribute.String("request.userID", request.UserID),
+		attribute.String("request.sourceLang", request.SourceLang.String()),
+		attribute.String("request.lang", request.Lang.String()),
+	)
+
+	templates, promptLang := TranslateBeatsSheetPrompts.Get(request.Lang)
+
+	span.SetAttributes(attribute.String("prompt.lang", promptLang.String()))
+
+	langNames, _ := prompts.LangNames.Get(promptLang)
+
+	systemPrompt := new(strings.Builder)
+
+	err := templates.System.Execute(systemPrompt, map[string]any{
+		"PlanName":   request.Plan.Metadata.Name,
+		"SourceLang": langNames[request.SourceLang],
+		"TargetLang": langNames[request.Lang],
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute system prompt: %w", err))
+	}
+
+	sourceBeats, err := json.Marshal(map[string]any{"beats": request.Beats})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("marshal beats: %w", err))
+	}
+
+	userPrompt := new(strings.Builder)
+
+	err = templates.Input.Execute(userPrompt, map[string]any{
+		"Logline": request.Logline,
+		"Beats":   string(sourceBeats),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute user prompt: %w", err))
+	}
+
+	chatCompletion, err := repository.config.Client().
+		Chat.Completions.
+		New(ctx, openai.ChatCompletionNewParams{
+			Model: repository.config.Model,
+			User:  param.NewOpt(request.UserID),
+			Messages: []openai.ChatCompletionMessageParamUnion{
+				openai.SystemMessage(ForceNextAnswerLocale(request.Lang, systemPrompt.String())),
+				openai.UserMessage(userPrompt.String()),
+			},
+			ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
+				OfJSONSchema: &openai.ResponseFormatJSONSchemaParam{
+					JSONSchema: openai.ResponseFormatJSONSchemaJSONSchemaParam{
+						Name:        "story_beats",
+						Description: openai.String("The story beats, translated to the target language."),
+						Schema:      request.Plan.OutputSchema(),
+						Strict:      openai.Bool(true),
+					},
+				},
+			},
+		})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	var beats struct {
+		Beats []models.Beat `json:"beats"`
+	}
+
+	err = json.Unmarshal([]byte(chatCompletion.Choices[0].Message.Content), &beats)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	beatKey := func(item models.Beat, _ int) string { return item.Key }
+
+	if !slices.Equal(lo.Map(request.Beats, beatKey), lo.Map(beats.Beats, beatKey)) {
+		return nil, otel.ReportError(span, errors.Join(ErrTranslationKeysMismatch, ErrInvalidBeatSheet))
+	}
+
+	err = request.Plan.Validate(beats.Beats)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidBeatSheet))
+	}
+
+	return otel.ReportSuccess(span, beats.Beats), nil
+}
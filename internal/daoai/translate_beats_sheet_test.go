@@ -0,0 +1,72 @@
+package daoai_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/internal/daoai/testdata"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestTranslateBeatsSheet(t *testing.T) {
+	const errorMsg = "The below beats sheet is not a translation of the below original beats sheet.\n\n" +
+		"beats sheet:\n\n%s\n\noriginal beats sheet:\n\n%s"
+
+	repository := daoai.NewTranslateBeatsSheetRepository(&config.OpenAIPresetDefault)
+
+	for _, lang := range models.Langs {
+		if lang == models.LangEN {
+			continue
+		}
+
+		t.Run(lang.String(), func(t *testing.T) {
+			t.Parallel()
+
+			data := testdata.TranslateBeatsSheetPrompt
+
+			for name, testCase := range data.Cases {
+				t.Run(name, func(t *testing.T) {
+					t.Parallel()
+
+					beatsSheet, err := repository.TranslateBeatsSheet(t.Context(), daoai.TranslateBeatsSheetRequest{
+						Logline:    testCase.Logline,
+						Beats:      testCase.Beats,
+						SourceLang: models.LangEN,
+						Plan:       storyplanmodel.FreytagPyramid[lang],
+						UserID:     TestUser,
+						Lang:       lang,
+					})
+					require.NoError(t, err)
+
+					require.Equal(
+						t,
+						lo.Map(testCase.Beats, func(item models.Beat, _ int) string { return item.Key }),
+						lo.Map(beatsSheet, func(item models.Beat, _ int) string { return item.Key }),
+					)
+
+					aggregate := func(beats []models.Beat) string {
+						return strings.Join(lo.Map(beats, func(item models.Beat, _ int) string {
+							return item.Title + "\n" + item.Content
+						}), "\n\n")
+					}
+
+					aggregated, aggregatedSource := aggregate(beatsSheet), aggregate(testCase.Beats)
+
+					CheckAgent(
+						t,
+						fmt.Sprintf(data.CheckAgent, aggregated, aggregatedSource),
+						fmt.Sprintf(errorMsg, aggregated, aggregatedSource),
+					)
+					CheckLang(t, lang, aggregated)
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package daoai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/packages/param"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/daoai/prompts"
+	"github.com/a-novel/service-story-schematics/internal/daoai/schemas"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+type TranslateLoglineTemplates struct {
+	System *template.Template
+}
+
+var TranslateLoglinePrompts = prompts.MapLocalized(
+	prompts.TranslateLogline,
+	func(prompt prompts.TranslateLoglineType) TranslateLoglineTemplates {
+		return TranslateLoglineTemplates{
+			System: template.Must(template.New("").Parse(prompt.System)),
+		}
+	},
+)
+
+type TranslateLoglineRequest struct {
+	// Logline to translate, written in its own language.
+	Logline models.LoglineIdea
+	UserID  string
+	// Lang is the language to translate the logline to.
+	Lang models.Lang
+}
+
+type TranslateLoglineRepository struct {
+	config *config.OpenAI
+}
+
+func NewTranslateLoglineRepository(config *config.OpenAI) *TranslateLoglineRepository {
+	return &TranslateLoglineRepository{config: config}
+}
+
+func (repository *TranslateLoglineRepository) TranslateLogline(
+	ctx context.Context, request TranslateLoglineRequest,
+) (*models.LoglineIdea, error) {
+	ctx, span := otel.Tracer().Start(ctx, "daoai.TranslateLogline")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.userID", request.UserID),
+		attribute.String("request.lang", request.Lang.String()),
+		attribute.String("request.logline.name", request.Logline.Name),
+		attribute.String("request.logline.lang", request.Logline.Lang.String()),
+	)
+
+	templates, promptLang := TranslateLoglinePrompts.Get(request.Lang)
+
+	span.SetAttributes(attribute.String("prompt.lang", promptLang.String()))
+
+	langNames, _ := prompts.LangNames.Get(promptLang)
+
+	systemPrompt := new(strings.Builder)
+
+	err := templates.System.Execute(systemPrompt, map[string]any{
+		"SourceLang": langNames[request.Logline.Lang],
+		"TargetLang": langNames[request.Lang],
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute system prompt: %w", err))
+	}
+
+	chatCompletion, err := repository.config.Client().
+		Chat.Completions.
+		New(ctx, openai.ChatCompletionNewParams{
+			Model: repository.config.Model,
+			User:  param.NewOpt(request.UserID),
+			Messages: []openai.ChatCompletionMessageParamUnion{
+				openai.SystemMessage(ForceNextAnswerLocale(request.Lang, systemPrompt.String())),
+				openai.UserMessage(request.Logline.Name + "\n\n" + request.Logline.Content),
+			},
+			ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
+				OfJSONSchema: &openai.ResponseFormatJSONSchemaParam{
+					JSONSchema: openai.ResponseFormatJSONSchemaJSONSchemaParam{
+						Name:        "logline",
+						Description: openai.String(schemas.Logline.Description),
+						Schema:      schemas.Logline.Schema,
+						Strict:      openai.Bool(true),
+					},
+				},
+			},
+		})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	var logline models.LoglineIdea
+
+	err = json.Unmarshal([]byte(chatCompletion.Choices[0].Message.Content), &logline)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	logline.Lang = request.Lang
+
+	return otel.ReportSuccess(span, &logline), nil
+}
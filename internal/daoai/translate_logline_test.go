@@ -0,0 +1,60 @@
+package daoai_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/internal/daoai/testdata"
+	"github.com/a-novel/service-story-schematics/models"
+	"github.com/a-novel/service-story-schematics/models/config"
+)
+
+func TestTranslateLogline(t *testing.T) {
+	const errorMsg = "The greater AI decreted that this logline:\n\n%s\n\nIs not a translation of this one:\n\n%s"
+
+	repository := daoai.NewTranslateLoglineRepository(&config.OpenAIPresetDefault)
+
+	for _, lang := range models.Langs {
+		t.Run(lang.String(), func(t *testing.T) {
+			t.Parallel()
+
+			data := testdata.TranslateLoglinePrompt
+
+			for name, testCase := range data.Cases {
+				if testCase.Logline.Lang == lang {
+					continue
+				}
+
+				t.Run(name, func(t *testing.T) {
+					t.Parallel()
+
+					resp, err := repository.TranslateLogline(t.Context(), daoai.TranslateLoglineRequest{
+						Logline: testCase.Logline,
+						Lang:    lang,
+						UserID:  TestUser,
+					})
+					require.NoError(t, err)
+
+					require.NotNil(t, resp)
+
+					require.NotEmpty(t, resp.Name)
+					require.NotEmpty(t, resp.Content)
+					require.Equal(t, lang, resp.Lang)
+
+					translated := resp.Name + "\n\n" + resp.Content
+					source := testCase.Logline.Name + "\n\n" + testCase.Logline.Content
+
+					CheckAgent(
+						t,
+						fmt.Sprintf(data.CheckAgent, translated, source),
+						fmt.Sprintf(errorMsg, translated, source),
+					)
+					CheckLang(t, lang, resp.Content)
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Package langdetect guesses the language of a text, without relying on any external service.
+//
+// Detection is based on the frequency of common function words, word endings and characters specific to a
+// language. It is reliable on sentences and paragraphs, but short texts (such as a title alone) usually do not carry
+// enough evidence, and come with a low confidence.
+package langdetect
+
+import (
+	_ "embed"
+	"strings"
+	"unicode"
+
+	"github.com/goccy/go-yaml"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/config"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+// MinConfidence is the confidence above which a detection can be trusted.
+const MinConfidence = 0.5
+
+// minEvidence is the score a language must reach for its detection to be fully confident. Below this, confidence
+// decreases with the amount of evidence found.
+const minEvidence = 8.0
+
+// minSuffixedWordLength is the minimum length of a word for its ending to be looked at.
+const minSuffixedWordLength = 5
+
+//go:embed profiles.yaml
+var profilesFile []byte
+
+type profile struct {
+	Words    []string `yaml:"words"`
+	Suffixes []string `yaml:"suffixes"`
+	Chars    string   `yaml:"chars"`
+}
+
+var profiles = config.MustUnmarshal[map[models.Lang]profile](yaml.Unmarshal, profilesFile)
+
+// Each feature is weighted by the inverse of the number of languages it belongs to, so features shared
+// by many languages weigh less than the ones that set a language apart.
+var (
+	wordWeights   = computeWeights(func(value profile) []string { return value.Words })
+	suffixWeights = computeWeights(func(value profile) []string { return value.Suffixes })
+	charWeights   = computeWeights(func(value profile) []string {
+		return lo.Map([]rune(value.Chars), func(item rune, _ int) string { return string(item) })
+	})
+)
+
+func computeWeights(features func(value profile) []string) map[string]map[models.Lang]float64 {
+	weights := make(map[string]map[models.Lang]float64)
+
+	for lang, value := range profiles {
+		for _, feature := range features(value) {
+			if weights[feature] == nil {
+				weights[feature] = make(map[models.Lang]float64)
+			}
+
+			weights[feature][lang] = 1
+		}
+	}
+
+	for _, langs := range weights {
+		for lang := range langs {
+			langs[lang] = 1 / float64(len(langs))
+		}
+	}
+
+	return weights
+}
+
+// Detect returns the most likely language of a text, among the languages supported by the service.
+//
+// When the text does not carry enough evidence to favor one language over the others, the returned detection
+// has an empty language and a confidence of 0.
+func Detect(text string) models.LangDetection {
+	text = strings.ToLower(text)
+
+	scores := make(map[models.Lang]float64, len(models.Langs))
+
+	for _, word := range strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsLetter(r) }) {
+		for lang, weight := range wordWeights[word] {
+			scores[lang] += weight
+		}
+
+		// Function words are short, so only look at the endings of longer words.
+		if len([]rune(word)) < minSuffixedWordLength {
+			continue
+		}
+
+		for suffix, langs := range suffixWeights {
+			if strings.HasSuffix(word, suffix) {
+				for lang, weight := range langs {
+					scores[lang] += weight
+				}
+			}
+		}
+	}
+
+	for _, char := range text {
+		for lang, weight := range charWeights[string(char)] {
+			scores[lang] += weight
+		}
+	}
+
+	var (
+		best          models.Lang
+		bestScore     float64
+		runnerUpScore float64
+	)
+
+	// Iterate over the ordered list of languages, so ties are resolved consistently.
+	for _, lang := range models.Langs {
+		switch score := scores[lang]; {
+		case score > bestScore:
+			best, bestScore, runnerUpScore = lang, score, bestScore
+		case score > runnerUpScore:
+			runnerUpScore = score
+		}
+	}
+
+	if bestScore == runnerUpScore {
+		return models.LangDetection{}
+	}
+
+	// Confidence grows with the lead of the best language over the runner-up, and with the amount of evidence.
+	margin := (bestScore - runnerUpScore) / bestScore
+	evidence := min(1, bestScore/minEvidence)
+
+	return models.LangDetection{
+		Lang:       best,
+		Confidence: margin * evidence,
+	}
+}
@@ -0,0 +1,130 @@
+package langdetect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/langdetect"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		text string
+
+		expect models.Lang
+	}{
+		{
+			name: "English",
+			text: "As a team of scientists discover a way to harness the energy of a nearby supernova, they must " +
+				"also contend with the implications of altering the course of human history and the emergence of a " +
+				"new, technologically advanced world order.",
+			expect: models.LangEN,
+		},
+		{
+			name: "French",
+			text: "Alors qu'une équipe de scientifiques découvre un moyen d'exploiter l'énergie d'une supernova " +
+				"proche, elle doit aussi faire face aux conséquences d'un changement du cours de l'histoire humaine " +
+				"et à l'émergence d'un nouvel ordre mondial.",
+			expect: models.LangFR,
+		},
+		{
+			name: "Spanish",
+			text: "Mientras un equipo de científicos descubre una forma de aprovechar la energía de una supernova " +
+				"cercana, también deben afrontar las consecuencias de alterar el curso de la historia humana.",
+			expect: models.LangES,
+		},
+		{
+			name: "German",
+			text: "Als ein Team von Wissenschaftlern einen Weg entdeckt, die Energie einer nahen Supernova zu " +
+				"nutzen, müssen sie sich auch mit den Folgen auseinandersetzen, den Lauf der Menschheitsgeschichte " +
+				"zu verändern.",
+			expect: models.LangDE,
+		},
+		{
+			name: "Italian",
+			text: "Mentre un gruppo di scienziati scopre un modo per sfruttare l'energia di una supernova vicina, " +
+				"deve anche affrontare le conseguenze di alterare il corso della storia umana.",
+			expect: models.LangIT,
+		},
+		{
+			name: "Portuguese",
+			text: "Enquanto uma equipe de cientistas descobre uma forma de aproveitar a energia de uma supernova " +
+				"próxima, a equipe também precisa lidar com as consequências de alterar o curso da história humana.",
+			expect: models.LangPT,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			detection := langdetect.Detect(testCase.text)
+			require.Equal(t, testCase.expect, detection.Lang)
+			require.GreaterOrEqual(t, detection.Confidence, langdetect.MinConfidence)
+			require.LessOrEqual(t, detection.Confidence, 1.0)
+		})
+	}
+}
+
+func TestDetectUndetermined(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		text string
+	}{
+		{
+			name: "Empty",
+			text: "",
+		},
+		{
+			name: "NoWords",
+			text: "1984 - 2001",
+		},
+		{
+			name: "Title",
+			text: "The Aurora Initiative",
+		},
+		{
+			name: "Ambiguous",
+			text: "Once upon a time",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			detection := langdetect.Detect(testCase.text)
+			require.Less(t, detection.Confidence, langdetect.MinConfidence)
+		})
+	}
+}
+
+// Short sentences may be detected wrong, but never with enough confidence to be trusted.
+func TestDetectStoryPlans(t *testing.T) {
+	t.Parallel()
+
+	for _, plan := range storyplanmodel.DefaultPlans {
+		t.Run(plan.Metadata.Slug.String()+"/"+plan.Metadata.Lang.String(), func(t *testing.T) {
+			t.Parallel()
+
+			for _, beat := range plan.Beats {
+				for _, text := range append([]string{beat.Name, beat.Purpose}, beat.KeyPoints...) {
+					detection := langdetect.Detect(text)
+					if detection.Lang != plan.Metadata.Lang {
+						require.Less(t, detection.Confidence, langdetect.MinConfidence, text)
+					}
+				}
+			}
+		})
+	}
+}
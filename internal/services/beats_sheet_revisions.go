@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+// insertBeatsSheetRevision saves content as a new revision of the parent sheet. The parent sheet is left untouched.
+// The content is checked against the plan of the sheet before being saved.
+func insertBeatsSheetRevision(
+	ctx context.Context,
+	insertBeatsSheet func(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error),
+	parent *dao.BeatsSheetEntity,
+	storyPlan *storyplanmodel.Plan,
+	content []models.Beat,
+) (*models.BeatsSheet, error) {
+	err := storyPlan.Validate(content)
+	if err != nil {
+		return nil, fmt.Errorf("check story plan: %w", err)
+	}
+
+	resp, err := insertBeatsSheet(ctx, dao.InsertBeatsSheetData{
+		Sheet: models.BeatsSheet{
+			ID:          uuid.New(),
+			LoglineID:   parent.LoglineID,
+			StoryPlanID: parent.StoryPlanID,
+			ParentID:    parent.ID,
+			Content:     content,
+			Lang:        parent.Lang,
+			CreatedAt:   time.Now(),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("insert beats sheet: %w", err)
+	}
+
+	return &models.BeatsSheet{
+		ID:          resp.ID,
+		LoglineID:   resp.LoglineID,
+		StoryPlanID: resp.StoryPlanID,
+		ParentID:    resp.ParentID,
+		Content:     resp.Content,
+		Lang:        resp.Lang,
+		Acts:        storyPlan.GroupBeats(resp.Content),
+		CreatedAt:   resp.CreatedAt,
+	}, nil
+}
+
+// beatIndex returns the index, in content, of the given (zero-based) occurrence of the beat with the given key.
+func beatIndex(content []models.Beat, key string, occurrence int) (int, error) {
+	indexes := lo.FilterMap(content, func(item models.Beat, index int) (int, bool) {
+		return index, item.Key == key
+	})
+	if occurrence < 0 || occurrence >= len(indexes) {
+		return 0, fmt.Errorf("%w: %s (occurrence %d)", ErrBeatNotFound, key, occurrence)
+	}
+
+	return indexes[occurrence], nil
+}
+
+// mergeBeats returns a copy of content, where the given beats replace the ones with the same key. When a key occurs
+// multiple times in content, all its occurrences are replaced, at the position of the first one.
+func mergeBeats(content, beats []models.Beat) []models.Beat {
+	merged := make([]models.Beat, 0, len(content))
+	replaced := make(map[string]bool)
+
+	for _, beat := range content {
+		replacements := lo.Filter(beats, func(item models.Beat, _ int) bool { return item.Key == beat.Key })
+
+		switch {
+		case len(replacements) == 0:
+			merged = append(merged, beat)
+		case !replaced[beat.Key]:
+			replaced[beat.Key] = true
+
+			merged = append(merged, replacements...)
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type ConvertBeatsSheetSource interface {
+	ConvertBeatsSheet(ctx context.Context, request daoai.ConvertBeatsSheetRequest) ([]models.Beat, error)
+	InsertBeatsSheet(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)
+	SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)
+	SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)
+	SelectStoryPlan(ctx context.Context, request SelectStoryPlanRequest) (*storyplanmodel.Plan, error)
+}
+
+func NewConvertBeatsSheetServiceSource(
+	convertBeatsSheetDAO *daoai.ConvertBeatsSheetRepository,
+	insertBeatsSheetDAO *dao.InsertBeatsSheetRepository,
+	selectBeatsSheetDAO *dao.SelectBeatsSheetRepository,
+	selectLoglineDAO *dao.SelectLoglineRepository,
+	selectStoryPlan *SelectStoryPlanService,
+) ConvertBeatsSheetSource {
+	return &struct {
+		*daoai.ConvertBeatsSheetRepository
+		*dao.InsertBeatsSheetRepository
+		*dao.SelectBeatsSheetRepository
+		*dao.SelectLoglineRepository
+		*SelectStoryPlanService
+	}{
+		ConvertBeatsSheetRepository: convertBeatsSheetDAO,
+		InsertBeatsSheetRepository:  insertBeatsSheetDAO,
+		SelectBeatsSheetRepository:  selectBeatsSheetDAO,
+		SelectLoglineRepository:     selectLoglineDAO,
+		SelectStoryPlanService:      selectStoryPlan,
+	}
+}
+
+// ConvertBeatsSheetRequest retells the story of an existing beats sheet following another story plan. The result
+// is saved as a new beats sheet, linked to the source one.
+type ConvertBeatsSheetRequest struct {
+	BeatsSheetID uuid.UUID
+	UserID       uuid.UUID
+	// The plan to convert the sheet to. It must use the same language as the source sheet.
+	StoryPlanID uuid.UUID
+}
+
+type ConvertBeatsSheetService struct {
+	source ConvertBeatsSheetSource
+}
+
+func NewConvertBeatsSheetService(source ConvertBeatsSheetSource) *ConvertBeatsSheetService {
+	return &ConvertBeatsSheetService{source: source}
+}
+
+func (service *ConvertBeatsSheetService) ConvertBeatsSheet(
+	ctx context.Context, request ConvertBeatsSheetRequest,
+) (*models.BeatsSheet, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ConvertBeatsSheet")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.beatsSheetID", request.BeatsSheetID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.storyPlanID", request.StoryPlanID.String()),
+	)
+
+	beatsSheet, err := service.source.SelectBeatsSheet(ctx, request.BeatsSheetID)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select beats sheet: %w", err))
+	}
+
+	// Make sure the selected beats sheet is linked to a logline that belongs to the user.
+	logline, err := service.source.SelectLogline(ctx, dao.SelectLoglineData{
+		ID:     beatsSheet.LoglineID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check logline: %w", err))
+	}
+
+	// Older sheets have no plan attached, and use the default one.
+	sourcePlan, err := service.source.SelectStoryPlan(ctx, SelectStoryPlanRequest{
+		ID:     lo.Ternary(beatsSheet.StoryPlanID != uuid.Nil, &beatsSheet.StoryPlanID, nil),
+		Lang:   beatsSheet.Lang,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get source story plan: %w", err))
+	}
+
+	targetPlan, err := service.source.SelectStoryPlan(ctx, SelectStoryPlanRequest{
+		ID:     &request.StoryPlanID,
+		Lang:   beatsSheet.Lang,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get target story plan: %w", err))
+	}
+
+	if targetPlan.Metadata.Lang != beatsSheet.Lang {
+		return nil, otel.ReportError(span, fmt.Errorf(
+			"%w: plan is %s, beats sheet is %s", ErrStoryPlanLangMismatch, targetPlan.Metadata.Lang, beatsSheet.Lang,
+		))
+	}
+
+	converted, err := service.source.ConvertBeatsSheet(ctx, daoai.ConvertBeatsSheetRequest{
+		Logline:    logline.Name + "\n\n" + logline.Content,
+		Beats:      beatsSheet.Content,
+		SourcePlan: sourcePlan,
+		TargetPlan: targetPlan,
+		UserID:     request.UserID.String(),
+		Lang:       beatsSheet.Lang,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("convert beats sheet: %w", err))
+	}
+
+	// The conversion is generated, so make sure it actually follows the target plan before saving it.
+	err = targetPlan.Validate(converted)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check story plan: %w", err))
+	}
+
+	resp, err := service.source.InsertBeatsSheet(ctx, dao.InsertBeatsSheetData{
+		Sheet: models.BeatsSheet{
+			ID:          uuid.New(),
+			LoglineID:   beatsSheet.LoglineID,
+			StoryPlanID: targetPlan.Metadata.ID,
+			SourceID:    beatsSheet.ID,
+			Content:     converted,
+			Lang:        beatsSheet.Lang,
+			CreatedAt:   time.Now(),
+		},
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("insert beats sheet: %w", err))
+	}
+
+	span.SetAttributes(attribute.String("dao.insertBeatsSheet.id", resp.ID.String()))
+
+	return otel.ReportSuccess(span, &models.BeatsSheet{
+		ID:          resp.ID,
+		LoglineID:   resp.LoglineID,
+		StoryPlanID: resp.StoryPlanID,
+		SourceID:    resp.SourceID,
+		Content:     resp.Content,
+		Lang:        resp.Lang,
+		Acts:        targetPlan.GroupBeats(resp.Content),
+		CreatedAt:   resp.CreatedAt,
+	}), nil
+}
@@ -0,0 +1,338 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestConvertBeatsSheet(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectBeatsSheetData struct {
+		resp *dao.BeatsSheetEntity
+		err  error
+	}
+
+	type selectLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	type selectStoryPlanData struct {
+		resp *storyplanmodel.Plan
+		err  error
+	}
+
+	type convertBeatsSheetData struct {
+		resp []models.Beat
+		err  error
+	}
+
+	type insertBeatsSheetData struct {
+		resp *dao.BeatsSheetEntity
+		err  error
+	}
+
+	sourceSheet := &dao.BeatsSheetEntity{
+		ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+		Content: []models.Beat{
+			{Key: "beginning", Title: "Beginning", Content: "Beginning Content"},
+			{Key: "end", Title: "End", Content: "End Content"},
+		},
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	logline := &dao.LoglineEntity{
+		ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Slug:      "logline-1",
+		Name:      "Logline 1",
+		Content:   "Content 1",
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	sourcePlan := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			ID:   uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+			Name: "Source Plan",
+			Lang: models.LangEN,
+		},
+		Beats: []storyplanmodel.Beat{
+			{Name: "Beginning", Key: "beginning"},
+			{Name: "End", Key: "end"},
+		},
+	}
+
+	targetPlan := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			ID:   uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+			Name: "Target Plan",
+			Lang: models.LangEN,
+		},
+		Acts: []storyplanmodel.Act{
+			{Name: "Act 1", Key: "act-1"},
+		},
+		Beats: []storyplanmodel.Beat{
+			{Name: "Setup", Key: "setup", Act: "act-1"},
+			{Name: "Conflict", Key: "conflict", Act: "act-1"},
+			{Name: "Resolution", Key: "resolution", Act: "act-1"},
+		},
+	}
+
+	converted := []models.Beat{
+		{Key: "setup", Title: "Setup", Content: "Setup Content"},
+		{Key: "conflict", Title: "Conflict", Content: "Conflict Content"},
+		{Key: "resolution", Title: "Resolution", Content: "Resolution Content"},
+	}
+
+	request := services.ConvertBeatsSheetRequest{
+		BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		StoryPlanID:  uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.ConvertBeatsSheetRequest
+
+		selectBeatsSheetData  *selectBeatsSheetData
+		selectLoglineData     *selectLoglineData
+		selectSourcePlanData  *selectStoryPlanData
+		selectTargetPlanData  *selectStoryPlanData
+		convertBeatsSheetData *convertBeatsSheetData
+		insertBeatsSheetData  *insertBeatsSheetData
+
+		expect    *models.BeatsSheet
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			selectBeatsSheetData:  &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:     &selectLoglineData{resp: logline},
+			selectSourcePlanData:  &selectStoryPlanData{resp: sourcePlan},
+			selectTargetPlanData:  &selectStoryPlanData{resp: targetPlan},
+			convertBeatsSheetData: &convertBeatsSheetData{resp: converted},
+			insertBeatsSheetData: &insertBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+					SourceID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content:     converted,
+					Lang:        models.LangEN,
+					CreatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.BeatsSheet{
+				ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+				LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+				StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+				SourceID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Content:     converted,
+				Lang:        models.LangEN,
+				Acts: []models.BeatsSheetAct{
+					{Key: "act-1", Name: "Act 1", Beats: []string{"setup", "conflict", "resolution"}},
+				},
+				CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "LangMismatch",
+
+			request: request,
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{resp: logline},
+			selectSourcePlanData: &selectStoryPlanData{resp: sourcePlan},
+			selectTargetPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						ID:   uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+						Name: "Plan Cible",
+						Lang: models.LangFR,
+					},
+					Beats: targetPlan.Beats,
+				},
+			},
+
+			expectErr: services.ErrStoryPlanLangMismatch,
+		},
+		{
+			name: "InvalidConversion",
+
+			request: request,
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{resp: logline},
+			selectSourcePlanData: &selectStoryPlanData{resp: sourcePlan},
+			selectTargetPlanData: &selectStoryPlanData{resp: targetPlan},
+			convertBeatsSheetData: &convertBeatsSheetData{
+				resp: converted[:2],
+			},
+
+			expectErr: storyplanmodel.ErrMissingBeat,
+		},
+		{
+			name: "ConvertBeatsSheet/Error",
+
+			request: request,
+
+			selectBeatsSheetData:  &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:     &selectLoglineData{resp: logline},
+			selectSourcePlanData:  &selectStoryPlanData{resp: sourcePlan},
+			selectTargetPlanData:  &selectStoryPlanData{resp: targetPlan},
+			convertBeatsSheetData: &convertBeatsSheetData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "InsertBeatsSheet/Error",
+
+			request: request,
+
+			selectBeatsSheetData:  &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:     &selectLoglineData{resp: logline},
+			selectSourcePlanData:  &selectStoryPlanData{resp: sourcePlan},
+			selectTargetPlanData:  &selectStoryPlanData{resp: targetPlan},
+			convertBeatsSheetData: &convertBeatsSheetData{resp: converted},
+			insertBeatsSheetData:  &insertBeatsSheetData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "SelectTargetPlan/Error",
+
+			request: request,
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{resp: logline},
+			selectSourcePlanData: &selectStoryPlanData{resp: sourcePlan},
+			selectTargetPlanData: &selectStoryPlanData{err: dao.ErrStoryPlanNotFound},
+
+			expectErr: dao.ErrStoryPlanNotFound,
+		},
+		{
+			name: "SelectLogline/Error",
+
+			request: request,
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{err: dao.ErrLoglineNotFound},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+		{
+			name: "SelectBeatsSheet/Error",
+
+			request: request,
+
+			selectBeatsSheetData: &selectBeatsSheetData{err: dao.ErrBeatsSheetNotFound},
+
+			expectErr: dao.ErrBeatsSheetNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockConvertBeatsSheetSource(t)
+
+			if testCase.selectBeatsSheetData != nil {
+				source.EXPECT().
+					SelectBeatsSheet(mock.Anything, testCase.request.BeatsSheetID).
+					Return(testCase.selectBeatsSheetData.resp, testCase.selectBeatsSheetData.err)
+			}
+
+			if testCase.selectLoglineData != nil {
+				source.EXPECT().
+					SelectLogline(mock.Anything, dao.SelectLoglineData{
+						ID:     sourceSheet.LoglineID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectLoglineData.resp, testCase.selectLoglineData.err)
+			}
+
+			if testCase.selectSourcePlanData != nil {
+				source.EXPECT().
+					SelectStoryPlan(mock.Anything, services.SelectStoryPlanRequest{
+						ID:     &sourceSheet.StoryPlanID,
+						Lang:   sourceSheet.Lang,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectSourcePlanData.resp, testCase.selectSourcePlanData.err)
+			}
+
+			if testCase.selectTargetPlanData != nil {
+				source.EXPECT().
+					SelectStoryPlan(mock.Anything, services.SelectStoryPlanRequest{
+						ID:     &testCase.request.StoryPlanID,
+						Lang:   sourceSheet.Lang,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectTargetPlanData.resp, testCase.selectTargetPlanData.err)
+			}
+
+			if testCase.convertBeatsSheetData != nil {
+				source.EXPECT().
+					ConvertBeatsSheet(mock.Anything, daoai.ConvertBeatsSheetRequest{
+						Logline:    logline.Name + "\n\n" + logline.Content,
+						Beats:      sourceSheet.Content,
+						SourcePlan: testCase.selectSourcePlanData.resp,
+						TargetPlan: testCase.selectTargetPlanData.resp,
+						UserID:     testCase.request.UserID.String(),
+						Lang:       sourceSheet.Lang,
+					}).
+					Return(testCase.convertBeatsSheetData.resp, testCase.convertBeatsSheetData.err)
+			}
+
+			if testCase.insertBeatsSheetData != nil {
+				source.EXPECT().
+					InsertBeatsSheet(mock.Anything, mock.MatchedBy(func(data dao.InsertBeatsSheetData) bool {
+						return assert.NotEqual(t, uuid.Nil, data.Sheet.ID) &&
+							assert.Equal(t, sourceSheet.LoglineID, data.Sheet.LoglineID) &&
+							assert.Equal(t, testCase.request.StoryPlanID, data.Sheet.StoryPlanID) &&
+							assert.Equal(t, sourceSheet.ID, data.Sheet.SourceID) &&
+							assert.Equal(t, testCase.convertBeatsSheetData.resp, data.Sheet.Content) &&
+							assert.Equal(t, sourceSheet.Lang, data.Sheet.Lang) &&
+							assert.WithinDuration(t, time.Now(), data.Sheet.CreatedAt, time.Second)
+					})).
+					Return(testCase.insertBeatsSheetData.resp, testCase.insertBeatsSheetData.err)
+			}
+
+			service := services.NewConvertBeatsSheetService(source)
+
+			resp, err := service.ConvertBeatsSheet(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
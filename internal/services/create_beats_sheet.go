@@ -3,9 +3,11 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel/golib/otel"
@@ -40,8 +42,10 @@ func NewCreateBeatsSheetServiceSource(
 type CreateBeatsSheetRequest struct {
 	LoglineID uuid.UUID
 	UserID    uuid.UUID
-	Content   []models.Beat
-	Lang      models.Lang
+	// Optional, defaults to the default story plan for the language.
+	StoryPlanID *uuid.UUID
+	Content     []models.Beat
+	Lang        models.Lang
 }
 
 type CreateBeatsSheetService struct {
@@ -61,10 +65,25 @@ func (service *CreateBeatsSheetService) CreateBeatsSheet(
 	span.SetAttributes(
 		attribute.String("request.loglineID", request.LoglineID.String()),
 		attribute.String("request.lang", request.Lang.String()),
+		attribute.String("request.storyPlanID", lo.FromPtr(request.StoryPlanID).String()),
 		attribute.String("request.userID", request.UserID.String()),
 	)
 
-	_, err := service.source.SelectLogline(ctx, dao.SelectLoglineData{
+	detection, err := checkContentLang(request.Lang, strings.Join(
+		lo.Map(request.Content, func(item models.Beat, _ int) string { return item.Title + "\n" + item.Content }),
+		"\n\n",
+	))
+
+	span.SetAttributes(
+		attribute.String("detection.lang", detection.Lang.String()),
+		attribute.Float64("detection.confidence", detection.Confidence),
+	)
+
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check content lang: %w", err))
+	}
+
+	_, err = service.source.SelectLogline(ctx, dao.SelectLoglineData{
 		ID:     request.LoglineID,
 		UserID: request.UserID,
 	})
@@ -73,12 +92,22 @@ func (service *CreateBeatsSheetService) CreateBeatsSheet(
 	}
 
 	storyPlan, err := service.source.SelectStoryPlan(ctx, SelectStoryPlanRequest{
-		Lang: request.Lang,
+		ID:     request.StoryPlanID,
+		Lang:   request.Lang,
+		UserID: request.UserID,
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("get story plan: %w", err))
 	}
 
+	if storyPlan.Metadata.Lang != request.Lang {
+		return nil, otel.ReportError(span, fmt.Errorf(
+			"%w: plan is %s, beats sheet is %s", ErrStoryPlanLangMismatch, storyPlan.Metadata.Lang, request.Lang,
+		))
+	}
+
+	span.SetAttributes(attribute.String("storyPlan.id", storyPlan.Metadata.ID.String()))
+
 	// Ensure story plan matches the beats sheet.
 	err = storyPlan.Validate(request.Content)
 	if err != nil {
@@ -87,11 +116,12 @@ func (service *CreateBeatsSheetService) CreateBeatsSheet(
 
 	resp, err := service.source.InsertBeatsSheet(ctx, dao.InsertBeatsSheetData{
 		Sheet: models.BeatsSheet{
-			ID:        uuid.New(),
-			LoglineID: request.LoglineID,
-			Content:   request.Content,
-			Lang:      request.Lang,
-			CreatedAt: time.Now(),
+			ID:          uuid.New(),
+			LoglineID:   request.LoglineID,
+			StoryPlanID: storyPlan.Metadata.ID,
+			Content:     request.Content,
+			Lang:        request.Lang,
+			CreatedAt:   time.Now(),
 		},
 	})
 	if err != nil {
@@ -101,10 +131,12 @@ func (service *CreateBeatsSheetService) CreateBeatsSheet(
 	span.SetAttributes(attribute.String("dao.insertBeatsSheet.id", resp.ID.String()))
 
 	return otel.ReportSuccess(span, &models.BeatsSheet{
-		ID:        resp.ID,
-		LoglineID: resp.LoglineID,
-		Content:   resp.Content,
-		Lang:      resp.Lang,
-		CreatedAt: resp.CreatedAt,
+		ID:          resp.ID,
+		LoglineID:   resp.LoglineID,
+		StoryPlanID: resp.StoryPlanID,
+		Content:     resp.Content,
+		Lang:        resp.Lang,
+		Acts:        storyPlan.GroupBeats(resp.Content),
+		CreatedAt:   resp.CreatedAt,
 	}), nil
 }
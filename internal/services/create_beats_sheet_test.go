@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -335,6 +336,155 @@ func TestCreateBeatsSheet(t *testing.T) {
 
 			expectErr: storyplanmodel.ErrInvalidPlan,
 		},
+		{
+			name: "Success/StoryPlanID",
+
+			request: services.CreateBeatsSheetRequest{
+				UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID:   uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+				StoryPlanID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+				Content: []models.Beat{
+					{
+						Key:     "test-beat",
+						Title:   "Test Beat",
+						Content: "Test Beat Content",
+					},
+				},
+				Lang: models.LangEN,
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name 2",
+					Content:   "Lorem ipsum dolor sit amet 2",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						ID:   uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+						Slug: "custom-plan",
+						Name: "Custom Plan",
+						Lang: models.LangEN,
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+				},
+			},
+
+			insertBeatsSheetData: &insertBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "test-beat",
+							Title:   "Test Beat",
+							Content: "Test Beat Content",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.BeatsSheet{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				LoglineID:   uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+				StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+				Content: []models.Beat{
+					{
+						Key:     "test-beat",
+						Title:   "Test Beat",
+						Content: "Test Beat Content",
+					},
+				},
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "StoryPlanLangMismatch",
+
+			request: services.CreateBeatsSheetRequest{
+				UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID:   uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+				StoryPlanID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+				Content: []models.Beat{
+					{
+						Key:     "test-beat",
+						Title:   "Test Beat",
+						Content: "Test Beat Content",
+					},
+				},
+				Lang: models.LangEN,
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name 2",
+					Content:   "Lorem ipsum dolor sit amet 2",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						ID:   uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+						Slug: "custom-plan",
+						Name: "Plan Personnalisé",
+						Lang: models.LangFR,
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Battement de Test",
+							Key:       "test-beat",
+							KeyPoints: []string{"Point Clé de Test"},
+							Purpose:   "Objectif de Test",
+						},
+					},
+				},
+			},
+
+			expectErr: services.ErrStoryPlanLangMismatch,
+		},
+		{
+			name: "ContentLangMismatch",
+
+			request: services.CreateBeatsSheetRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID: uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+				Content: []models.Beat{
+					{
+						Key:   "test-beat",
+						Title: "L'appel de l'aventure",
+						Content: "Alors qu'une équipe de scientifiques découvre un moyen d'exploiter l'énergie d'une " +
+							"supernova proche, elle doit aussi faire face aux conséquences de ses choix.",
+					},
+				},
+				Lang: models.LangEN,
+			},
+
+			expectErr: services.ErrContentLangMismatch,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -357,7 +507,9 @@ func TestCreateBeatsSheet(t *testing.T) {
 			if testCase.selectStoryPlanData != nil {
 				source.EXPECT().
 					SelectStoryPlan(mock.Anything, services.SelectStoryPlanRequest{
-						Lang: testCase.request.Lang,
+						ID:     testCase.request.StoryPlanID,
+						Lang:   testCase.request.Lang,
+						UserID: testCase.request.UserID,
 					}).
 					Return(testCase.selectStoryPlanData.resp, testCase.selectStoryPlanData.err)
 			}
@@ -367,6 +519,7 @@ func TestCreateBeatsSheet(t *testing.T) {
 					InsertBeatsSheet(mock.Anything, mock.MatchedBy(func(data dao.InsertBeatsSheetData) bool {
 						return assert.NotEqual(t, data.Sheet.ID, uuid.Nil) &&
 							assert.Equal(t, testCase.request.LoglineID, data.Sheet.LoglineID) &&
+							assert.Equal(t, testCase.selectStoryPlanData.resp.Metadata.ID, data.Sheet.StoryPlanID) &&
 							assert.Equal(t, testCase.request.Content, data.Sheet.Content) &&
 							assert.Equal(t, testCase.request.Lang, data.Sheet.Lang) &&
 							assert.WithinDuration(t, time.Now(), data.Sheet.CreatedAt, time.Second)
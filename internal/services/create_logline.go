@@ -13,23 +13,28 @@ import (
 
 	"github.com/a-novel/service-story-schematics/internal/dao"
 	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 type CreateLoglineSource interface {
 	InsertLogline(ctx context.Context, data dao.InsertLoglineData) (*dao.LoglineEntity, error)
 	SelectSlugIteration(ctx context.Context, data dao.SelectSlugIterationData) (models.Slug, int, error)
+	SelectProject(ctx context.Context, data dao.SelectProjectData) (*dao.ProjectEntity, error)
 }
 
 func NewCreateLoglineServiceSource(
 	insertLoglineDAO *dao.InsertLoglineRepository,
 	selectSlugIterationDAO *dao.SelectSlugIterationRepository,
+	selectProjectDAO *dao.SelectProjectRepository,
 ) CreateLoglineSource {
 	return &struct {
 		*dao.InsertLoglineRepository
 		*dao.SelectSlugIterationRepository
+		*dao.SelectProjectRepository
 	}{
 		InsertLoglineRepository:       insertLoglineDAO,
 		SelectSlugIterationRepository: selectSlugIterationDAO,
+		SelectProjectRepository:       selectProjectDAO,
 	}
 }
 
@@ -39,6 +44,12 @@ type CreateLoglineRequest struct {
 	Name    string
 	Content string
 	Lang    models.Lang
+	// Optional.
+	Genre models.Genre
+	// Optional. Tags are normalized before being saved, see models.NormalizeTags.
+	Tags []string
+	// Optional. The project must belong to the user.
+	ProjectID uuid.UUID
 }
 
 type CreateLoglineService struct {
@@ -60,17 +71,59 @@ func (service *CreateLoglineService) CreateLogline(
 		attribute.String("request.slug", request.Slug.String()),
 		attribute.String("request.name", request.Name),
 		attribute.String("request.lang", request.Lang.String()),
+		attribute.String("request.genre", request.Genre.String()),
+		attribute.StringSlice("request.tags", request.Tags),
+		attribute.String("request.projectID", request.ProjectID.String()),
 		attribute.Bool("slug.taken", false),
 	)
 
+	err := storyplanmodel.CheckLang(request.Lang)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check lang: %w", err))
+	}
+
+	err = models.CheckGenre(request.Genre)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check genre: %w", err))
+	}
+
+	tags, err := models.NormalizeTags(request.Tags)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("normalize tags: %w", err))
+	}
+
+	if request.ProjectID != uuid.Nil {
+		_, err = service.source.SelectProject(ctx, dao.SelectProjectData{
+			ID:     request.ProjectID,
+			UserID: request.UserID,
+		})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("select project: %w", err))
+		}
+	}
+
+	detection, err := checkContentLang(request.Lang, request.Name+"\n\n"+request.Content)
+
+	span.SetAttributes(
+		attribute.String("detection.lang", detection.Lang.String()),
+		attribute.Float64("detection.confidence", detection.Confidence),
+	)
+
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check content lang: %w", err))
+	}
+
 	data := dao.InsertLoglineData{
-		ID:      uuid.New(),
-		UserID:  request.UserID,
-		Slug:    request.Slug,
-		Name:    request.Name,
-		Content: request.Content,
-		Lang:    request.Lang,
-		Now:     time.Now(),
+		ID:        uuid.New(),
+		UserID:    request.UserID,
+		Slug:      request.Slug,
+		Name:      request.Name,
+		Content:   request.Content,
+		Lang:      request.Lang,
+		Genre:     request.Genre,
+		Tags:      tags,
+		ProjectID: request.ProjectID,
+		Now:       time.Now(),
 	}
 
 	resp, err := service.source.InsertLogline(ctx, data)
@@ -101,9 +154,13 @@ func (service *CreateLoglineService) CreateLogline(
 		ID:        resp.ID,
 		UserID:    resp.UserID,
 		Slug:      resp.Slug,
+		SourceID:  resp.SourceID,
+		ProjectID: resp.ProjectID,
 		Name:      resp.Name,
 		Content:   resp.Content,
 		Lang:      resp.Lang,
+		Genre:     resp.Genre,
+		Tags:      resp.Tags,
 		CreatedAt: resp.CreatedAt,
 	}), nil
 }
@@ -2,10 +2,12 @@ package services_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -14,6 +16,7 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
 	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 func TestCreateLogline(t *testing.T) {
@@ -32,15 +35,24 @@ func TestCreateLogline(t *testing.T) {
 		err       error
 	}
 
+	type selectProjectData struct {
+		resp *dao.ProjectEntity
+		err  error
+	}
+
 	testCases := []struct {
 		name string
 
 		request services.CreateLoglineRequest
 
+		selectProjectData       *selectProjectData
 		insertLoglineData       *insertLoglineData
 		selectSlugIterationData *selectSlugIterationData
 		reinsertLoglineData     *insertLoglineData
 
+		// Tags expected to be saved, after normalization.
+		expectTags []string
+
 		expect    *models.Logline
 		expectErr error
 	}{
@@ -77,6 +89,107 @@ func TestCreateLogline(t *testing.T) {
 				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
 		},
+		{
+			name: "GenreAndTags",
+
+			request: services.CreateLoglineRequest{
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:    "test-slug",
+				Name:    "Test Logline",
+				Content: "Once upon a time",
+				Lang:    models.LangEN,
+				Genre:   models.GenreFantasy,
+				Tags:    []string{"  Dragons ", "quest", "dragons", ""},
+			},
+
+			insertLoglineData: &insertLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Logline",
+					Content:   "Once upon a time",
+					Lang:      models.LangEN,
+					Genre:     models.GenreFantasy,
+					Tags:      []string{"dragons", "quest"},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectTags: []string{"dragons", "quest"},
+
+			expect: &models.Logline{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "test-slug",
+				Name:      "Test Logline",
+				Content:   "Once upon a time",
+				Lang:      models.LangEN,
+				Genre:     models.GenreFantasy,
+				Tags:      []string{"dragons", "quest"},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Project",
+
+			request: services.CreateLoglineRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "test-slug",
+				Name:      "Test Logline",
+				Content:   "Once upon a time",
+				Lang:      models.LangEN,
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+
+			selectProjectData: &selectProjectData{
+				resp: &dao.ProjectEntity{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Name:   "Test Project",
+				},
+			},
+
+			insertLoglineData: &insertLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Slug:      "test-slug",
+					Name:      "Test Logline",
+					Content:   "Once upon a time",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.Logline{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Slug:      "test-slug",
+				Name:      "Test Logline",
+				Content:   "Once upon a time",
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "ProjectNotFound",
+
+			request: services.CreateLoglineRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "test-slug",
+				Name:      "Test Logline",
+				Content:   "Once upon a time",
+				Lang:      models.LangEN,
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+
+			selectProjectData: &selectProjectData{err: dao.ErrProjectNotFound},
+
+			expectErr: dao.ErrProjectNotFound,
+		},
 		{
 			name: "RetrySlug",
 
@@ -119,6 +232,161 @@ func TestCreateLogline(t *testing.T) {
 				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
 		},
+		{
+			name: "UnsupportedLang",
+
+			request: services.CreateLoglineRequest{
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:    "test-slug",
+				Name:    "Test Logline",
+				Content: "Once upon a time",
+				Lang:    "xx",
+			},
+
+			expectErr: storyplanmodel.ErrUnsupportedLang,
+		},
+		{
+			name: "UnsupportedGenre",
+
+			request: services.CreateLoglineRequest{
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:    "test-slug",
+				Name:    "Test Logline",
+				Content: "Once upon a time",
+				Lang:    models.LangEN,
+				Genre:   "space-western",
+			},
+
+			expectErr: models.ErrUnsupportedGenre,
+		},
+		{
+			name: "InvalidTags",
+
+			request: services.CreateLoglineRequest{
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:    "test-slug",
+				Name:    "Test Logline",
+				Content: "Once upon a time",
+				Lang:    models.LangEN,
+				Tags:    []string{strings.Repeat("a", models.MaxTagLength+1)},
+			},
+
+			expectErr: models.ErrInvalidTags,
+		},
+		{
+			name: "ContentLangMismatch",
+
+			request: services.CreateLoglineRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:   "test-slug",
+				Name:   "L'initiative Aurore",
+				Content: "Alors qu'une équipe de scientifiques découvre un moyen d'exploiter l'énergie d'une " +
+					"supernova proche, elle doit aussi faire face aux conséquences de ses choix.",
+				Lang: models.LangEN,
+			},
+
+			expectErr: services.ErrContentLangMismatch,
+		},
+		// Short loglines often mix languages, and must not be rejected.
+		{
+			name: "MixedLang/Title",
+
+			request: services.CreateLoglineRequest{
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:    "test-slug",
+				Name:    "Le Petit Café",
+				Content: "A shy barista in Paris must save her café from closing.",
+				Lang:    models.LangEN,
+			},
+
+			insertLoglineData: &insertLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Le Petit Café",
+					Content:   "A shy barista in Paris must save her café from closing.",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.Logline{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "test-slug",
+				Name:      "Le Petit Café",
+				Content:   "A shy barista in Paris must save her café from closing.",
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "MixedLang/Content",
+
+			request: services.CreateLoglineRequest{
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:    "test-slug",
+				Name:    "Bon appétit",
+				Content: "A chef et son frère ouvrent un restaurant.",
+				Lang:    models.LangEN,
+			},
+
+			insertLoglineData: &insertLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Bon appétit",
+					Content:   "A chef et son frère ouvrent un restaurant.",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.Logline{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "test-slug",
+				Name:      "Bon appétit",
+				Content:   "A chef et son frère ouvrent un restaurant.",
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "MixedLang/Declared",
+
+			request: services.CreateLoglineRequest{
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:    "test-slug",
+				Name:    "Midnight in Montmartre",
+				Content: "Une peintre rencontre un fantôme qui lui montre le Paris des années folles.",
+				Lang:    models.LangFR,
+			},
+
+			insertLoglineData: &insertLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Midnight in Montmartre",
+					Content:   "Une peintre rencontre un fantôme qui lui montre le Paris des années folles.",
+					Lang:      models.LangFR,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.Logline{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "test-slug",
+				Name:      "Midnight in Montmartre",
+				Content:   "Une peintre rencontre un fantôme qui lui montre le Paris des années folles.",
+				Lang:      models.LangFR,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
 		{
 			name: "InsertError",
 
@@ -193,6 +461,15 @@ func TestCreateLogline(t *testing.T) {
 
 			source := servicesmocks.NewMockCreateLoglineSource(t)
 
+			if testCase.selectProjectData != nil {
+				source.EXPECT().
+					SelectProject(mock.Anything, dao.SelectProjectData{
+						ID:     testCase.request.ProjectID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectProjectData.resp, testCase.selectProjectData.err)
+			}
+
 			if testCase.insertLoglineData != nil {
 				initialCall := source.EXPECT().
 					InsertLogline(mock.Anything, mock.MatchedBy(func(data dao.InsertLoglineData) bool {
@@ -202,6 +479,9 @@ func TestCreateLogline(t *testing.T) {
 							assert.Equal(t, testCase.request.Name, data.Name) &&
 							assert.Equal(t, testCase.request.Content, data.Content) &&
 							assert.Equal(t, testCase.request.Lang, data.Lang) &&
+							assert.Equal(t, testCase.request.Genre, data.Genre) &&
+							assert.Equal(t, lo.CoalesceSliceOrEmpty(testCase.expectTags), data.Tags) &&
+							assert.Equal(t, testCase.request.ProjectID, data.ProjectID) &&
 							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
 					})).
 					Return(testCase.insertLoglineData.resp, testCase.insertLoglineData.err).
@@ -216,6 +496,8 @@ func TestCreateLogline(t *testing.T) {
 								assert.Equal(t, testCase.request.Name, data.Name) &&
 								assert.Equal(t, testCase.request.Content, data.Content) &&
 								assert.Equal(t, testCase.request.Lang, data.Lang) &&
+								assert.Equal(t, testCase.request.Genre, data.Genre) &&
+								assert.Equal(t, lo.CoalesceSliceOrEmpty(testCase.expectTags), data.Tags) &&
 								assert.WithinDuration(t, time.Now(), data.Now, time.Second)
 						})).
 						Return(testCase.reinsertLoglineData.resp, testCase.reinsertLoglineData.err).
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type CreateProjectSource interface {
+	InsertProject(ctx context.Context, data dao.InsertProjectData) (*dao.ProjectEntity, error)
+}
+
+type CreateProjectRequest struct {
+	UserID uuid.UUID
+	Name   string
+	// Optional.
+	Description string
+}
+
+type CreateProjectService struct {
+	source CreateProjectSource
+}
+
+func NewCreateProjectService(source CreateProjectSource) *CreateProjectService {
+	return &CreateProjectService{source: source}
+}
+
+func (service *CreateProjectService) CreateProject(
+	ctx context.Context, request CreateProjectRequest,
+) (*models.Project, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CreateProject")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.name", request.Name),
+	)
+
+	resp, err := service.source.InsertProject(ctx, dao.InsertProjectData{
+		ID:          uuid.New(),
+		UserID:      request.UserID,
+		Name:        request.Name,
+		Description: request.Description,
+		Now:         time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("insert project: %w", err))
+	}
+
+	span.SetAttributes(attribute.String("dao.insertProject.id", resp.ID.String()))
+
+	return otel.ReportSuccess(span, &models.Project{
+		ID:          resp.ID,
+		UserID:      resp.UserID,
+		Name:        resp.Name,
+		Description: resp.Description,
+		CreatedAt:   resp.CreatedAt,
+	}), nil
+}
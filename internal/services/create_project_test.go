@@ -0,0 +1,108 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestCreateProject(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type insertProjectData struct {
+		resp *dao.ProjectEntity
+		err  error
+	}
+
+	request := services.CreateProjectRequest{
+		UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Name:        "The Sea Trilogy",
+		Description: "Three novels about the sea.",
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.CreateProjectRequest
+
+		insertProjectData *insertProjectData
+
+		expect    *models.Project
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			insertProjectData: &insertProjectData{
+				resp: &dao.ProjectEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Name:        "The Sea Trilogy",
+					Description: "Three novels about the sea.",
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.Project{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Name:        "The Sea Trilogy",
+				Description: "Three novels about the sea.",
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error",
+
+			request: request,
+
+			insertProjectData: &insertProjectData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockCreateProjectSource(t)
+
+			if testCase.insertProjectData != nil {
+				source.EXPECT().
+					InsertProject(mock.Anything, mock.MatchedBy(func(data dao.InsertProjectData) bool {
+						return assert.NotEqual(t, uuid.Nil, data.ID) &&
+							assert.Equal(t, testCase.request.UserID, data.UserID) &&
+							assert.Equal(t, testCase.request.Name, data.Name) &&
+							assert.Equal(t, testCase.request.Description, data.Description) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(testCase.insertProjectData.resp, testCase.insertProjectData.err)
+			}
+
+			service := services.NewCreateProjectService(source)
+
+			resp, err := service.CreateProject(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
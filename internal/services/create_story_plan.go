@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type CreateStoryPlanSource interface {
+	InsertStoryPlan(ctx context.Context, data dao.InsertStoryPlanData) (*dao.StoryPlanEntity, error)
+	ListStoryPlanTranslations(ctx context.Context, data dao.ListStoryPlanTranslationsData) ([]*dao.StoryPlanEntity, error)
+}
+
+func NewCreateStoryPlanServiceSource(
+	insertStoryPlanDAO *dao.InsertStoryPlanRepository,
+	listStoryPlanTranslationsDAO *dao.ListStoryPlanTranslationsRepository,
+) CreateStoryPlanSource {
+	return &struct {
+		*dao.InsertStoryPlanRepository
+		*dao.ListStoryPlanTranslationsRepository
+	}{
+		InsertStoryPlanRepository:           insertStoryPlanDAO,
+		ListStoryPlanTranslationsRepository: listStoryPlanTranslationsDAO,
+	}
+}
+
+type CreateStoryPlanRequest struct {
+	// UserID is set to create a custom plan, owned by the given user. Otherwise, a built-in plan is created.
+	UserID *uuid.UUID
+	Slug   models.Slug
+	Name   string
+	Lang   models.Lang
+	Acts   []storyplanmodel.Act
+	Beats  []storyplanmodel.Beat
+}
+
+type CreateStoryPlanService struct {
+	source CreateStoryPlanSource
+}
+
+func NewCreateStoryPlanService(source CreateStoryPlanSource) *CreateStoryPlanService {
+	return &CreateStoryPlanService{source: source}
+}
+
+func (service *CreateStoryPlanService) CreateStoryPlan(
+	ctx context.Context, request CreateStoryPlanRequest,
+) (*storyplanmodel.Plan, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CreateStoryPlan")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.userID", lo.FromPtr(request.UserID).String()),
+		attribute.String("request.slug", request.Slug.String()),
+		attribute.String("request.name", request.Name),
+		attribute.String("request.lang", request.Lang.String()),
+		attribute.Int("request.acts.count", len(request.Acts)),
+		attribute.Int("request.beats.count", len(request.Beats)),
+	)
+
+	err := storyplanmodel.CheckLang(request.Lang)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check lang: %w", err))
+	}
+
+	plan := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{Slug: request.Slug, Lang: request.Lang},
+		Acts:     request.Acts,
+		Beats:    request.Beats,
+	}
+
+	err = plan.Lint()
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("lint plan: %w", err))
+	}
+
+	translations, err := service.source.ListStoryPlanTranslations(ctx, dao.ListStoryPlanTranslationsData{
+		UserID: request.UserID,
+		Slug:   request.Slug,
+		Lang:   request.Lang,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list story plan translations: %w", err))
+	}
+
+	err = lintStoryPlanTranslations(plan, translations)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("lint plan translations: %w", err))
+	}
+
+	resp, err := service.source.InsertStoryPlan(ctx, dao.InsertStoryPlanData{
+		ID:     uuid.New(),
+		UserID: request.UserID,
+		Slug:   request.Slug,
+		Name:   request.Name,
+		Lang:   request.Lang,
+		Acts:   request.Acts,
+		Beats:  request.Beats,
+		Now:    time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("insert story plan: %w", err))
+	}
+
+	span.SetAttributes(attribute.String("dao.insertStoryPlan.id", resp.ID.String()))
+
+	return otel.ReportSuccess(span, storyPlanEntityToModel(resp)), nil
+}
+
+// lintStoryPlanTranslations checks a plan against the latest version of the same plan in other languages. All the
+// languages of a plan must keep the same structure, so beats sheets can be translated from one to another.
+func lintStoryPlanTranslations(plan *storyplanmodel.Plan, translations []*dao.StoryPlanEntity) error {
+	return storyplanmodel.LintTranslations(append(
+		[]*storyplanmodel.Plan{plan},
+		lo.Map(translations, func(item *dao.StoryPlanEntity, _ int) *storyplanmodel.Plan {
+			return storyPlanEntityToModel(item)
+		})...,
+	)...)
+}
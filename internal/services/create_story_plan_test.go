@@ -0,0 +1,349 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestCreateStoryPlan(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type listStoryPlanTranslationsData struct {
+		resp []*dao.StoryPlanEntity
+		err  error
+	}
+
+	type insertStoryPlanData struct {
+		resp *dao.StoryPlanEntity
+		err  error
+	}
+
+	translation := &dao.StoryPlanEntity{
+		ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Slug:    "test-slug",
+		Version: 1,
+		Name:    "Nom de Test",
+		Lang:    models.LangFR,
+		Beats: []storyplanmodel.Beat{
+			{
+				Name:      "Battement de Test",
+				Key:       "test-beat",
+				KeyPoints: []string{"Point Clé de Test"},
+				Purpose:   "Objectif de Test",
+			},
+		},
+		CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.CreateStoryPlanRequest
+
+		listStoryPlanTranslationsData *listStoryPlanTranslationsData
+		insertStoryPlanData           *insertStoryPlanData
+
+		expect    *storyplanmodel.Plan
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.CreateStoryPlanRequest{
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			listStoryPlanTranslationsData: &listStoryPlanTranslationsData{
+				resp: []*dao.StoryPlanEntity{translation},
+			},
+			insertStoryPlanData: &insertStoryPlanData{
+				resp: &dao.StoryPlanEntity{
+					ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug: "test-slug",
+					Name: "Test Name",
+					Lang: models.LangEN,
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &storyplanmodel.Plan{
+				Metadata: storyplanmodel.Metadata{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+		},
+		{
+			name: "AlreadyExists",
+
+			request: services.CreateStoryPlanRequest{
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			listStoryPlanTranslationsData: &listStoryPlanTranslationsData{
+				resp: []*dao.StoryPlanEntity{},
+			},
+			insertStoryPlanData: &insertStoryPlanData{
+				err: dao.ErrStoryPlanAlreadyExists,
+			},
+
+			expectErr: dao.ErrStoryPlanAlreadyExists,
+		},
+		{
+			name: "TranslationMismatch",
+
+			request: services.CreateStoryPlanRequest{
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "other-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			listStoryPlanTranslationsData: &listStoryPlanTranslationsData{
+				resp: []*dao.StoryPlanEntity{translation},
+			},
+
+			expectErr: storyplanmodel.ErrTranslationMismatch,
+		},
+		{
+			name: "ListTranslationsError",
+
+			request: services.CreateStoryPlanRequest{
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			listStoryPlanTranslationsData: &listStoryPlanTranslationsData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "InvalidActs",
+
+			request: services.CreateStoryPlanRequest{
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: models.LangEN,
+				Acts: []storyplanmodel.Act{
+					{Name: "Act 1", Key: "act-1", Purpose: "Test Purpose"},
+				},
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Act:       "act-2",
+					},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrUnknownAct,
+		},
+		{
+			name: "InvalidScenes",
+
+			request: services.CreateStoryPlanRequest{
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    storyplanmodel.Scenes{Min: lo.ToPtr(3), Max: lo.ToPtr(2)},
+					},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrInvalidScenes,
+		},
+		{
+			name: "DuplicateBeat",
+
+			request: services.CreateStoryPlanRequest{
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrDuplicateBeat,
+		},
+		{
+			name: "UnsupportedLang",
+
+			request: services.CreateStoryPlanRequest{
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: "xx",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrUnsupportedLang,
+		},
+		{
+			name: "Error",
+
+			request: services.CreateStoryPlanRequest{
+				Slug: "test-slug",
+				Name: "Test Name",
+				Lang: models.LangEN,
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			listStoryPlanTranslationsData: &listStoryPlanTranslationsData{
+				resp: []*dao.StoryPlanEntity{},
+			},
+			insertStoryPlanData: &insertStoryPlanData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockCreateStoryPlanSource(t)
+
+			if testCase.listStoryPlanTranslationsData != nil {
+				source.EXPECT().
+					ListStoryPlanTranslations(mock.Anything, dao.ListStoryPlanTranslationsData{
+						UserID: testCase.request.UserID,
+						Slug:   testCase.request.Slug,
+						Lang:   testCase.request.Lang,
+					}).
+					Return(testCase.listStoryPlanTranslationsData.resp, testCase.listStoryPlanTranslationsData.err)
+			}
+
+			if testCase.insertStoryPlanData != nil {
+				source.EXPECT().
+					InsertStoryPlan(mock.Anything, mock.MatchedBy(func(data dao.InsertStoryPlanData) bool {
+						return assert.NotEqual(t, data.ID, uuid.Nil) &&
+							assert.Equal(t, testCase.request.Slug, data.Slug) &&
+							assert.Equal(t, testCase.request.Name, data.Name) &&
+							assert.Equal(t, testCase.request.Lang, data.Lang) &&
+							assert.Equal(t, testCase.request.UserID, data.UserID) &&
+							assert.Equal(t, testCase.request.Acts, data.Acts) &&
+							assert.Equal(t, testCase.request.Beats, data.Beats) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(testCase.insertStoryPlanData.resp, testCase.insertStoryPlanData.err)
+			}
+
+			service := services.NewCreateStoryPlanService(source)
+
+			resp, err := service.CreateStoryPlan(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+var ErrInvalidCritiqueSource = errors.New("critique requires either a logline ID or a logline idea, but not both")
+
+type CritiqueLoglineSource interface {
+	CritiqueLogline(ctx context.Context, request daoai.CritiqueLoglineRequest) (*models.LoglineCritique, error)
+	SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)
+}
+
+func NewCritiqueLoglineServiceSource(
+	critiqueLoglineDAO *daoai.CritiqueLoglineRepository,
+	selectLoglineDAO *dao.SelectLoglineRepository,
+) CritiqueLoglineSource {
+	return &struct {
+		*daoai.CritiqueLoglineRepository
+		*dao.SelectLoglineRepository
+	}{
+		CritiqueLoglineRepository: critiqueLoglineDAO,
+		SelectLoglineRepository:   selectLoglineDAO,
+	}
+}
+
+// CritiqueLoglineRequest critiques either a logline of the user, or a logline idea that was not saved. Exactly one
+// of LoglineID and Logline must be set.
+type CritiqueLoglineRequest struct {
+	UserID    uuid.UUID
+	LoglineID *uuid.UUID
+	Logline   *models.LoglineIdea
+}
+
+type CritiqueLoglineService struct {
+	source CritiqueLoglineSource
+}
+
+func NewCritiqueLoglineService(source CritiqueLoglineSource) *CritiqueLoglineService {
+	return &CritiqueLoglineService{source: source}
+}
+
+func (service *CritiqueLoglineService) CritiqueLogline(
+	ctx context.Context, request CritiqueLoglineRequest,
+) (*models.LoglineCritique, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CritiqueLogline")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.loglineID", lo.FromPtr(request.LoglineID).String()),
+		attribute.String("request.logline.name", lo.FromPtr(request.Logline).Name),
+	)
+
+	if (request.LoglineID == nil) == (request.Logline == nil) {
+		return nil, otel.ReportError(span, ErrInvalidCritiqueSource)
+	}
+
+	logline := lo.FromPtr(request.Logline)
+
+	if request.LoglineID != nil {
+		entity, err := service.source.SelectLogline(ctx, dao.SelectLoglineData{
+			ID:     *request.LoglineID,
+			UserID: request.UserID,
+		})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("select logline: %w", err))
+		}
+
+		logline = models.LoglineIdea{
+			Name:    entity.Name,
+			Content: entity.Content,
+			Lang:    entity.Lang,
+		}
+	}
+
+	err := storyplanmodel.CheckLang(logline.Lang)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check lang: %w", err))
+	}
+
+	resp, err := service.source.CritiqueLogline(ctx, daoai.CritiqueLoglineRequest{
+		Logline: logline.Name + "\n\n" + logline.Content,
+		UserID:  request.UserID.String(),
+		Lang:    logline.Lang,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("critique logline: %w", err))
+	}
+
+	return otel.ReportSuccess(span, resp), nil
+}
@@ -0,0 +1,213 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestCritiqueLogline(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	type critiqueLoglineData struct {
+		resp *models.LoglineCritique
+		err  error
+	}
+
+	critique := &models.LoglineCritique{
+		Protagonist:  models.LoglineCritiqueScore{Score: 3, Justification: "Generic.", Suggestion: "Name them."},
+		Goal:         models.LoglineCritiqueScore{Score: 5, Justification: "Vague.", Suggestion: "Be concrete."},
+		Stakes:       models.LoglineCritiqueScore{Score: 2, Justification: "Missing.", Suggestion: "Add a threat."},
+		Hook:         models.LoglineCritiqueScore{Score: 4, Justification: "Flat.", Suggestion: "Add irony."},
+		GenreClarity: models.LoglineCritiqueScore{Score: 7, Justification: "Clear.", Suggestion: "Keep it."},
+		Lang:         models.LangEN,
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.CritiqueLoglineRequest
+
+		selectLoglineData   *selectLoglineData
+		critiqueLoglineData *critiqueLoglineData
+
+		expectCritique daoai.CritiqueLoglineRequest
+
+		expect    *models.LoglineCritique
+		expectErr error
+	}{
+		{
+			name: "Idea",
+
+			request: services.CritiqueLoglineRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Logline: &models.LoglineIdea{
+					Name:    "test title",
+					Content: "test content",
+					Lang:    models.LangEN,
+				},
+			},
+
+			critiqueLoglineData: &critiqueLoglineData{resp: critique},
+
+			expectCritique: daoai.CritiqueLoglineRequest{
+				Logline: "test title\n\ntest content",
+				UserID:  "00000000-0000-0000-1000-000000000001",
+				Lang:    models.LangEN,
+			},
+
+			expect: critique,
+		},
+		{
+			name: "StoredLogline",
+
+			request: services.CritiqueLoglineRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:    "test-slug",
+					Name:    "stored title",
+					Content: "stored content",
+					Lang:    models.LangFR,
+				},
+			},
+			critiqueLoglineData: &critiqueLoglineData{resp: critique},
+
+			expectCritique: daoai.CritiqueLoglineRequest{
+				Logline: "stored title\n\nstored content",
+				UserID:  "00000000-0000-0000-1000-000000000001",
+				Lang:    models.LangFR,
+			},
+
+			expect: critique,
+		},
+		{
+			name: "LoglineNotFound",
+
+			request: services.CritiqueLoglineRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			selectLoglineData: &selectLoglineData{err: dao.ErrLoglineNotFound},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+		{
+			name: "NoSource",
+
+			request: services.CritiqueLoglineRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			expectErr: services.ErrInvalidCritiqueSource,
+		},
+		{
+			name: "BothSources",
+
+			request: services.CritiqueLoglineRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Logline: &models.LoglineIdea{
+					Name:    "test title",
+					Content: "test content",
+					Lang:    models.LangEN,
+				},
+			},
+
+			expectErr: services.ErrInvalidCritiqueSource,
+		},
+		{
+			name: "UnsupportedLang",
+
+			request: services.CritiqueLoglineRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Logline: &models.LoglineIdea{
+					Name:    "test title",
+					Content: "test content",
+					Lang:    "xx",
+				},
+			},
+
+			expectErr: storyplanmodel.ErrUnsupportedLang,
+		},
+		{
+			name: "Error",
+
+			request: services.CritiqueLoglineRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Logline: &models.LoglineIdea{
+					Name:    "test title",
+					Content: "test content",
+					Lang:    models.LangEN,
+				},
+			},
+
+			critiqueLoglineData: &critiqueLoglineData{err: errFoo},
+
+			expectCritique: daoai.CritiqueLoglineRequest{
+				Logline: "test title\n\ntest content",
+				UserID:  "00000000-0000-0000-1000-000000000001",
+				Lang:    models.LangEN,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockCritiqueLoglineSource(t)
+
+			if testCase.selectLoglineData != nil {
+				source.EXPECT().
+					SelectLogline(mock.Anything, dao.SelectLoglineData{
+						ID:     lo.FromPtr(testCase.request.LoglineID),
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectLoglineData.resp, testCase.selectLoglineData.err)
+			}
+
+			if testCase.critiqueLoglineData != nil {
+				source.EXPECT().
+					CritiqueLogline(mock.Anything, testCase.expectCritique).
+					Return(testCase.critiqueLoglineData.resp, testCase.critiqueLoglineData.err)
+			}
+
+			service := services.NewCritiqueLoglineService(source)
+
+			resp, err := service.CritiqueLogline(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
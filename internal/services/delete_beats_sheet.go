@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type DeleteBeatsSheetSource interface {
+	DeleteBeatsSheet(ctx context.Context, data dao.DeleteBeatsSheetData) (*dao.BeatsSheetEntity, error)
+}
+
+// DeleteBeatsSheetRequest moves a single beats sheet to the trash. The logline of the sheet must belong to the user.
+type DeleteBeatsSheetRequest struct {
+	BeatsSheetID uuid.UUID
+	UserID       uuid.UUID
+}
+
+type DeleteBeatsSheetService struct {
+	source DeleteBeatsSheetSource
+}
+
+func NewDeleteBeatsSheetService(source DeleteBeatsSheetSource) *DeleteBeatsSheetService {
+	return &DeleteBeatsSheetService{source: source}
+}
+
+func (service *DeleteBeatsSheetService) DeleteBeatsSheet(
+	ctx context.Context, request DeleteBeatsSheetRequest,
+) (*models.TrashedBeatsSheet, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.DeleteBeatsSheet")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.beatsSheetID", request.BeatsSheetID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+	)
+
+	resp, err := service.source.DeleteBeatsSheet(ctx, dao.DeleteBeatsSheetData{
+		ID:     request.BeatsSheetID,
+		UserID: request.UserID,
+		Now:    time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("delete beats sheet: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &models.TrashedBeatsSheet{
+		ID:        resp.ID,
+		LoglineID: resp.LoglineID,
+		Lang:      resp.Lang,
+		CreatedAt: resp.CreatedAt,
+		DeletedAt: resp.DeletedAt,
+	}), nil
+}
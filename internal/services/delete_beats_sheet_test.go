@@ -0,0 +1,121 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestDeleteBeatsSheet(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type deleteBeatsSheetData struct {
+		resp *dao.BeatsSheetEntity
+		err  error
+	}
+
+	request := services.DeleteBeatsSheetRequest{
+		BeatsSheetID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:       uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.DeleteBeatsSheetRequest
+
+		deleteBeatsSheetData *deleteBeatsSheetData
+
+		expect    *models.TrashedBeatsSheet
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			deleteBeatsSheetData: &deleteBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Content: []models.Beat{
+						{
+							Key:     "test-beat",
+							Title:   "Test Beat",
+							Content: "Test Beat Content",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.TrashedBeatsSheet{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "NotFound",
+
+			request: request,
+
+			deleteBeatsSheetData: &deleteBeatsSheetData{err: dao.ErrBeatsSheetNotFound},
+
+			expectErr: dao.ErrBeatsSheetNotFound,
+		},
+		{
+			name: "Error",
+
+			request: request,
+
+			deleteBeatsSheetData: &deleteBeatsSheetData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockDeleteBeatsSheetSource(t)
+
+			if testCase.deleteBeatsSheetData != nil {
+				source.EXPECT().
+					DeleteBeatsSheet(mock.Anything, mock.MatchedBy(func(data dao.DeleteBeatsSheetData) bool {
+						return assert.Equal(t, testCase.request.BeatsSheetID, data.ID) &&
+							assert.Equal(t, testCase.request.UserID, data.UserID) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(testCase.deleteBeatsSheetData.resp, testCase.deleteBeatsSheetData.err)
+			}
+
+			service := services.NewDeleteBeatsSheetService(source)
+
+			resp, err := service.DeleteBeatsSheet(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
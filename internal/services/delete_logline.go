@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type DeleteLoglineSource interface {
+	DeleteLogline(ctx context.Context, data dao.DeleteLoglineData) (*dao.LoglineEntity, error)
+}
+
+// DeleteLoglineRequest moves a logline to the trash, along with its beats sheets.
+type DeleteLoglineRequest struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+type DeleteLoglineService struct {
+	source DeleteLoglineSource
+}
+
+func NewDeleteLoglineService(source DeleteLoglineSource) *DeleteLoglineService {
+	return &DeleteLoglineService{source: source}
+}
+
+func (service *DeleteLoglineService) DeleteLogline(
+	ctx context.Context, request DeleteLoglineRequest,
+) (*models.Logline, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.DeleteLogline")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.id", request.ID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+	)
+
+	resp, err := service.source.DeleteLogline(ctx, dao.DeleteLoglineData{
+		ID:     request.ID,
+		UserID: request.UserID,
+		Now:    time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("delete logline: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &models.Logline{
+		ID:        resp.ID,
+		UserID:    resp.UserID,
+		Slug:      resp.Slug,
+		SourceID:  resp.SourceID,
+		ProjectID: resp.ProjectID,
+		Name:      resp.Name,
+		Content:   resp.Content,
+		Lang:      resp.Lang,
+		Genre:     resp.Genre,
+		Tags:      resp.Tags,
+		CreatedAt: resp.CreatedAt,
+		UpdatedAt: resp.UpdatedAt,
+		DeletedAt: resp.DeletedAt,
+	}), nil
+}
@@ -0,0 +1,120 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestDeleteLogline(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type deleteLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	request := services.DeleteLoglineRequest{
+		ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.DeleteLoglineRequest
+
+		deleteLoglineData *deleteLoglineData
+
+		expect    *models.Logline
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			deleteLoglineData: &deleteLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.Logline{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "test-slug",
+				Name:      "Test Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "NotFound",
+
+			request: request,
+
+			deleteLoglineData: &deleteLoglineData{err: dao.ErrLoglineNotFound},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+		{
+			name: "Error",
+
+			request: request,
+
+			deleteLoglineData: &deleteLoglineData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockDeleteLoglineSource(t)
+
+			if testCase.deleteLoglineData != nil {
+				source.EXPECT().
+					DeleteLogline(mock.Anything, mock.MatchedBy(func(data dao.DeleteLoglineData) bool {
+						return assert.Equal(t, testCase.request.ID, data.ID) &&
+							assert.Equal(t, testCase.request.UserID, data.UserID) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(testCase.deleteLoglineData.resp, testCase.deleteLoglineData.err)
+			}
+
+			service := services.NewDeleteLoglineService(source)
+
+			resp, err := service.DeleteLogline(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type DeleteProjectSource interface {
+	DeleteProject(ctx context.Context, data dao.DeleteProjectData) (*dao.ProjectEntity, error)
+}
+
+// DeleteProjectRequest permanently deletes a project. The loglines of the project are kept, and no longer belong to
+// any project.
+type DeleteProjectRequest struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+type DeleteProjectService struct {
+	source DeleteProjectSource
+}
+
+func NewDeleteProjectService(source DeleteProjectSource) *DeleteProjectService {
+	return &DeleteProjectService{source: source}
+}
+
+func (service *DeleteProjectService) DeleteProject(
+	ctx context.Context, request DeleteProjectRequest,
+) (*models.Project, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.DeleteProject")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.id", request.ID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+	)
+
+	resp, err := service.source.DeleteProject(ctx, dao.DeleteProjectData{
+		ID:     request.ID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("delete project: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &models.Project{
+		ID:          resp.ID,
+		UserID:      resp.UserID,
+		Name:        resp.Name,
+		Description: resp.Description,
+		CreatedAt:   resp.CreatedAt,
+		UpdatedAt:   resp.UpdatedAt,
+	}), nil
+}
@@ -0,0 +1,114 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestDeleteProject(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type deleteProjectData struct {
+		resp *dao.ProjectEntity
+		err  error
+	}
+
+	request := services.DeleteProjectRequest{
+		ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.DeleteProjectRequest
+
+		deleteProjectData *deleteProjectData
+
+		expect    *models.Project
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			deleteProjectData: &deleteProjectData{
+				resp: &dao.ProjectEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Name:        "The Sea Trilogy",
+					Description: "Three novels about the sea.",
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.Project{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Name:        "The Sea Trilogy",
+				Description: "Three novels about the sea.",
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "NotFound",
+
+			request: request,
+
+			deleteProjectData: &deleteProjectData{err: dao.ErrProjectNotFound},
+
+			expectErr: dao.ErrProjectNotFound,
+		},
+		{
+			name: "Error",
+
+			request: request,
+
+			deleteProjectData: &deleteProjectData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockDeleteProjectSource(t)
+
+			if testCase.deleteProjectData != nil {
+				source.EXPECT().
+					DeleteProject(mock.Anything, dao.DeleteProjectData{
+						ID:     testCase.request.ID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.deleteProjectData.resp, testCase.deleteProjectData.err)
+			}
+
+			service := services.NewDeleteProjectService(source)
+
+			resp, err := service.DeleteProject(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/langdetect"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+// ErrContentLangMismatch is returned when user-submitted content is confidently detected to be written in another
+// language than the one it is declared in.
+var ErrContentLangMismatch = errors.New("content language does not match the declared language")
+
+// contentLangMismatchConfidence is the confidence above which content detected in another language is rejected.
+// It is well above langdetect.MinConfidence: short loglines often mix languages (a French title over an English
+// pitch, or borrowed words), and wrongly rejecting them is worse than accepting a mislabeled one.
+const contentLangMismatchConfidence = 0.7
+
+// checkContentLang makes sure the declared language of a content matches the detected one. Only confident
+// detections are considered, so short, mixed or ambiguous contents are always accepted.
+func checkContentLang(lang models.Lang, content string) (models.LangDetection, error) {
+	detection := langdetect.Detect(content)
+
+	if detection.Lang == "" || detection.Lang == lang || detection.Confidence < contentLangMismatchConfidence {
+		return detection, nil
+	}
+
+	return detection, fmt.Errorf(
+		"%w: content is detected as %s (confidence %.2f), declared as %s",
+		ErrContentLangMismatch, detection.Lang, detection.Confidence, lang,
+	)
+}
+
+type DetectLangRequest struct {
+	Text string
+}
+
+type DetectLangService struct{}
+
+func NewDetectLangService() *DetectLangService {
+	return &DetectLangService{}
+}
+
+func (service *DetectLangService) DetectLang(
+	ctx context.Context, request DetectLangRequest,
+) (*models.LangDetection, error) {
+	_, span := otel.Tracer().Start(ctx, "service.DetectLang")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("request.text.length", len(request.Text)))
+
+	detection := langdetect.Detect(request.Text)
+
+	span.SetAttributes(
+		attribute.String("detection.lang", detection.Lang.String()),
+		attribute.Float64("detection.confidence", detection.Confidence),
+	)
+
+	return otel.ReportSuccess(span, &detection), nil
+}
@@ -0,0 +1,65 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/services"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestDetectLang(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		request services.DetectLangRequest
+
+		expectLang models.Lang
+	}{
+		{
+			name: "English",
+
+			request: services.DetectLangRequest{
+				Text: "As a team of scientists discover a way to harness the energy of a nearby supernova, they " +
+					"must also contend with the implications of altering the course of human history.",
+			},
+
+			expectLang: models.LangEN,
+		},
+		{
+			name: "French",
+
+			request: services.DetectLangRequest{
+				Text: "Alors qu'une équipe de scientifiques découvre un moyen d'exploiter l'énergie d'une " +
+					"supernova proche, elle doit aussi faire face aux conséquences de ses choix.",
+			},
+
+			expectLang: models.LangFR,
+		},
+		{
+			name: "Undetermined",
+
+			request: services.DetectLangRequest{
+				Text: "",
+			},
+
+			expectLang: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := services.NewDetectLangService()
+
+			resp, err := service.DetectLang(t.Context(), testCase.request)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.Equal(t, testCase.expectLang, resp.Lang)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/textdiff"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type DiffBeatsSheetsSource interface {
+	SelectBeatsSheet(ctx context.Context, request SelectBeatsSheetRequest) (*models.BeatsSheet, error)
+}
+
+// DiffBeatsSheetsRequest compares two beats sheets of the user. The order matters: changes are reported as going
+// from the first sheet to the second.
+type DiffBeatsSheetsRequest struct {
+	FromID uuid.UUID
+	ToID   uuid.UUID
+	UserID uuid.UUID
+}
+
+type DiffBeatsSheetsService struct {
+	source DiffBeatsSheetsSource
+}
+
+func NewDiffBeatsSheetsService(source DiffBeatsSheetsSource) *DiffBeatsSheetsService {
+	return &DiffBeatsSheetsService{source: source}
+}
+
+func (service *DiffBeatsSheetsService) DiffBeatsSheets(
+	ctx context.Context, request DiffBeatsSheetsRequest,
+) (*models.BeatsSheetDiff, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.DiffBeatsSheets")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.fromID", request.FromID.String()),
+		attribute.String("request.toID", request.ToID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+	)
+
+	from, err := service.source.SelectBeatsSheet(ctx, SelectBeatsSheetRequest{
+		BeatsSheetID: request.FromID,
+		UserID:       request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select from beats sheet: %w", err))
+	}
+
+	to, err := service.source.SelectBeatsSheet(ctx, SelectBeatsSheetRequest{
+		BeatsSheetID: request.ToID,
+		UserID:       request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select to beats sheet: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &models.BeatsSheetDiff{
+		From:  from,
+		To:    to,
+		Beats: diffBeats(from.Content, to.Content),
+	}), nil
+}
+
+// diffBeats matches the beats of both sheets by key, then compares the matched beats word by word.
+func diffBeats(from, to []models.Beat) []models.BeatDiff {
+	// Repeated keys are matched in order: the nth occurrence in the older sheet goes with the nth occurrence in the
+	// newer one.
+	fromIndexes := make(map[string][]int)
+	for index, beat := range from {
+		fromIndexes[beat.Key] = append(fromIndexes[beat.Key], index)
+	}
+
+	matched := make([]bool, len(from))
+	diffs := make([]models.BeatDiff, 0, len(to))
+	// The position, in diffs, of the kept beats, and their index in the older sheet.
+	keptDiffs := make([]int, 0, len(to))
+	keptFromIndexes := make([]int, 0, len(to))
+
+	for toIndex, beat := range to {
+		candidates := fromIndexes[beat.Key]
+		if len(candidates) == 0 {
+			diffs = append(diffs, models.BeatDiff{
+				Key:     beat.Key,
+				Status:  models.BeatDiffStatusAdded,
+				ToIndex: &toIndex,
+				Title:   textdiff.Words("", beat.Title),
+				Content: textdiff.Words("", beat.Content),
+			})
+
+			continue
+		}
+
+		fromIndex := candidates[0]
+		fromIndexes[beat.Key] = candidates[1:]
+		matched[fromIndex] = true
+
+		keptDiffs = append(keptDiffs, len(diffs))
+		keptFromIndexes = append(keptFromIndexes, fromIndex)
+
+		diffs = append(diffs, models.BeatDiff{
+			Key:       beat.Key,
+			Status:    models.BeatDiffStatusKept,
+			FromIndex: &fromIndex,
+			ToIndex:   &toIndex,
+			Modified:  from[fromIndex].Title != beat.Title || from[fromIndex].Content != beat.Content,
+			Title:     textdiff.Words(from[fromIndex].Title, beat.Title),
+			Content:   textdiff.Words(from[fromIndex].Content, beat.Content),
+		})
+	}
+
+	// The kept beats that stayed in place form the longest sequence whose order is the same in both sheets. Every
+	// other kept beat was moved.
+	inPlace := longestIncreasingSubsequence(keptFromIndexes)
+	for position, diffIndex := range keptDiffs {
+		diffs[diffIndex].Moved = !inPlace[position]
+	}
+
+	for fromIndex, beat := range from {
+		if matched[fromIndex] {
+			continue
+		}
+
+		diffs = append(diffs, models.BeatDiff{
+			Key:       beat.Key,
+			Status:    models.BeatDiffStatusRemoved,
+			FromIndex: &fromIndex,
+			Title:     textdiff.Words(beat.Title, ""),
+			Content:   textdiff.Words(beat.Content, ""),
+		})
+	}
+
+	return diffs
+}
+
+// longestIncreasingSubsequence flags the values that belong to a longest strictly increasing subsequence of the
+// input. Sheets are capped to a few hundred beats, so the quadratic version is enough.
+func longestIncreasingSubsequence(values []int) []bool {
+	lengths := make([]int, len(values))
+	previous := make([]int, len(values))
+	last := -1
+
+	for i := range values {
+		lengths[i], previous[i] = 1, -1
+
+		for j := range i {
+			if values[j] < values[i] && lengths[j]+1 > lengths[i] {
+				lengths[i], previous[i] = lengths[j]+1, j
+			}
+		}
+
+		if last == -1 || lengths[i] > lengths[last] {
+			last = i
+		}
+	}
+
+	flags := make([]bool, len(values))
+	for i := last; i != -1; i = previous[i] {
+		flags[i] = true
+	}
+
+	return flags
+}
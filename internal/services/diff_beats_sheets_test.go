@@ -0,0 +1,303 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestDiffBeatsSheets(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectBeatsSheetData struct {
+		resp *models.BeatsSheet
+		err  error
+	}
+
+	from := &models.BeatsSheet{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		Content: []models.Beat{
+			{Key: "opening", Title: "Opening", Content: "A young hero sleeps."},
+			{Key: "catalyst", Title: "Catalyst", Content: "A letter arrives."},
+			{Key: "debate", Title: "Debate", Content: "The hero hesitates."},
+			{Key: "finale", Title: "Finale", Content: "The hero wins."},
+		},
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	to := &models.BeatsSheet{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		ParentID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Content: []models.Beat{
+			{Key: "opening", Title: "Opening", Content: "A reluctant hero sleeps."},
+			{Key: "debate", Title: "Debate", Content: "The hero hesitates."},
+			{Key: "catalyst", Title: "Catalyst", Content: "A letter arrives."},
+			{Key: "midpoint", Title: "Midpoint", Content: "All is lost."},
+		},
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	repeatedFrom := &models.BeatsSheet{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		Content: []models.Beat{
+			{Key: "opening", Title: "Opening", Content: "A young hero sleeps."},
+			{Key: "trial", Title: "Trial 1", Content: "The hero fights."},
+			{Key: "trial", Title: "Trial 2", Content: "The hero runs."},
+		},
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	repeatedTo := &models.BeatsSheet{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+		LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		Content: []models.Beat{
+			{Key: "opening", Title: "Opening", Content: "A young hero sleeps."},
+			{Key: "trial", Title: "Trial 1", Content: "The hero fights."},
+		},
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.DiffBeatsSheetsRequest
+
+		selectFromData *selectBeatsSheetData
+		selectToData   *selectBeatsSheetData
+
+		expect    *models.BeatsSheetDiff
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.DiffBeatsSheetsRequest{
+				FromID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ToID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectFromData: &selectBeatsSheetData{
+				resp: from,
+			},
+			selectToData: &selectBeatsSheetData{
+				resp: to,
+			},
+
+			expect: &models.BeatsSheetDiff{
+				From: from,
+				To:   to,
+				Beats: []models.BeatDiff{
+					{
+						Key:       "opening",
+						Status:    models.BeatDiffStatusKept,
+						FromIndex: lo.ToPtr(0),
+						ToIndex:   lo.ToPtr(0),
+						Modified:  true,
+						Title: []models.DiffChunk{
+							{Op: models.DiffOpEqual, Text: "Opening"},
+						},
+						Content: []models.DiffChunk{
+							{Op: models.DiffOpEqual, Text: "A "},
+							{Op: models.DiffOpDelete, Text: "young"},
+							{Op: models.DiffOpInsert, Text: "reluctant"},
+							{Op: models.DiffOpEqual, Text: " hero sleeps."},
+						},
+					},
+					{
+						Key:       "debate",
+						Status:    models.BeatDiffStatusKept,
+						FromIndex: lo.ToPtr(2),
+						ToIndex:   lo.ToPtr(1),
+						Title: []models.DiffChunk{
+							{Op: models.DiffOpEqual, Text: "Debate"},
+						},
+						Content: []models.DiffChunk{
+							{Op: models.DiffOpEqual, Text: "The hero hesitates."},
+						},
+					},
+					{
+						Key:       "catalyst",
+						Status:    models.BeatDiffStatusKept,
+						FromIndex: lo.ToPtr(1),
+						ToIndex:   lo.ToPtr(2),
+						Moved:     true,
+						Title: []models.DiffChunk{
+							{Op: models.DiffOpEqual, Text: "Catalyst"},
+						},
+						Content: []models.DiffChunk{
+							{Op: models.DiffOpEqual, Text: "A letter arrives."},
+						},
+					},
+					{
+						Key:     "midpoint",
+						Status:  models.BeatDiffStatusAdded,
+						ToIndex: lo.ToPtr(3),
+						Title: []models.DiffChunk{
+							{Op: models.DiffOpInsert, Text: "Midpoint"},
+						},
+						Content: []models.DiffChunk{
+							{Op: models.DiffOpInsert, Text: "All is lost."},
+						},
+					},
+					{
+						Key:       "finale",
+						Status:    models.BeatDiffStatusRemoved,
+						FromIndex: lo.ToPtr(3),
+						Title: []models.DiffChunk{
+							{Op: models.DiffOpDelete, Text: "Finale"},
+						},
+						Content: []models.DiffChunk{
+							{Op: models.DiffOpDelete, Text: "The hero wins."},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RepeatedBeats",
+
+			request: services.DiffBeatsSheetsRequest{
+				FromID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				ToID:   uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectFromData: &selectBeatsSheetData{
+				resp: repeatedFrom,
+			},
+			selectToData: &selectBeatsSheetData{
+				resp: repeatedTo,
+			},
+
+			expect: &models.BeatsSheetDiff{
+				From: repeatedFrom,
+				To:   repeatedTo,
+				Beats: []models.BeatDiff{
+					{
+						Key:       "opening",
+						Status:    models.BeatDiffStatusKept,
+						FromIndex: lo.ToPtr(0),
+						ToIndex:   lo.ToPtr(0),
+						Title: []models.DiffChunk{
+							{Op: models.DiffOpEqual, Text: "Opening"},
+						},
+						Content: []models.DiffChunk{
+							{Op: models.DiffOpEqual, Text: "A young hero sleeps."},
+						},
+					},
+					{
+						Key:       "trial",
+						Status:    models.BeatDiffStatusKept,
+						FromIndex: lo.ToPtr(1),
+						ToIndex:   lo.ToPtr(1),
+						Title: []models.DiffChunk{
+							{Op: models.DiffOpEqual, Text: "Trial 1"},
+						},
+						Content: []models.DiffChunk{
+							{Op: models.DiffOpEqual, Text: "The hero fights."},
+						},
+					},
+					{
+						Key:       "trial",
+						Status:    models.BeatDiffStatusRemoved,
+						FromIndex: lo.ToPtr(2),
+						Title: []models.DiffChunk{
+							{Op: models.DiffOpDelete, Text: "Trial 2"},
+						},
+						Content: []models.DiffChunk{
+							{Op: models.DiffOpDelete, Text: "The hero runs."},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SelectFromError",
+
+			request: services.DiffBeatsSheetsRequest{
+				FromID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ToID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectFromData: &selectBeatsSheetData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "SelectToError",
+
+			request: services.DiffBeatsSheetsRequest{
+				FromID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ToID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectFromData: &selectBeatsSheetData{
+				resp: from,
+			},
+			selectToData: &selectBeatsSheetData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockDiffBeatsSheetsSource(t)
+
+			if testCase.selectFromData != nil {
+				source.EXPECT().
+					SelectBeatsSheet(mock.Anything, services.SelectBeatsSheetRequest{
+						BeatsSheetID: testCase.request.FromID,
+						UserID:       testCase.request.UserID,
+					}).
+					Return(testCase.selectFromData.resp, testCase.selectFromData.err)
+			}
+
+			if testCase.selectToData != nil {
+				source.EXPECT().
+					SelectBeatsSheet(mock.Anything, services.SelectBeatsSheetRequest{
+						BeatsSheetID: testCase.request.ToID,
+						UserID:       testCase.request.UserID,
+					}).
+					Return(testCase.selectToData.resp, testCase.selectToData.err)
+			}
+
+			service := services.NewDiffBeatsSheetsService(source)
+
+			resp, err := service.DiffBeatsSheets(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
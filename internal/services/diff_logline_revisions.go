@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/textdiff"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type DiffLoglineRevisionsSource interface {
+	SelectLoglineRevision(ctx context.Context, request SelectLoglineRevisionRequest) (*models.LoglineRevision, error)
+}
+
+// DiffLoglineRevisionsRequest compares two revisions of the user's loglines. The order matters: changes are
+// reported as going from the first revision to the second.
+type DiffLoglineRevisionsRequest struct {
+	FromID uuid.UUID
+	ToID   uuid.UUID
+	UserID uuid.UUID
+}
+
+type DiffLoglineRevisionsService struct {
+	source DiffLoglineRevisionsSource
+}
+
+func NewDiffLoglineRevisionsService(source DiffLoglineRevisionsSource) *DiffLoglineRevisionsService {
+	return &DiffLoglineRevisionsService{source: source}
+}
+
+func (service *DiffLoglineRevisionsService) DiffLoglineRevisions(
+	ctx context.Context, request DiffLoglineRevisionsRequest,
+) (*models.LoglineRevisionDiff, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.DiffLoglineRevisions")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.fromID", request.FromID.String()),
+		attribute.String("request.toID", request.ToID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+	)
+
+	from, err := service.source.SelectLoglineRevision(ctx, SelectLoglineRevisionRequest{
+		ID:     request.FromID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select from revision: %w", err))
+	}
+
+	to, err := service.source.SelectLoglineRevision(ctx, SelectLoglineRevisionRequest{
+		ID:     request.ToID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select to revision: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &models.LoglineRevisionDiff{
+		From:    from,
+		To:      to,
+		Name:    textdiff.Words(from.Name, to.Name),
+		Content: textdiff.Words(from.Content, to.Content),
+	}), nil
+}
@@ -0,0 +1,156 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestDiffLoglineRevisions(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectLoglineRevisionData struct {
+		resp *models.LoglineRevision
+		err  error
+	}
+
+	from := &models.LoglineRevision{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		Name:      "Test Name",
+		Content:   "A young hero leaves home.",
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	to := &models.LoglineRevision{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		Name:      "Test Name",
+		Content:   "A reluctant hero leaves home.",
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.DiffLoglineRevisionsRequest
+
+		selectFromData *selectLoglineRevisionData
+		selectToData   *selectLoglineRevisionData
+
+		expect    *models.LoglineRevisionDiff
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.DiffLoglineRevisionsRequest{
+				FromID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ToID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectFromData: &selectLoglineRevisionData{
+				resp: from,
+			},
+			selectToData: &selectLoglineRevisionData{
+				resp: to,
+			},
+
+			expect: &models.LoglineRevisionDiff{
+				From: from,
+				To:   to,
+				Name: []models.DiffChunk{
+					{Op: models.DiffOpEqual, Text: "Test Name"},
+				},
+				Content: []models.DiffChunk{
+					{Op: models.DiffOpEqual, Text: "A "},
+					{Op: models.DiffOpDelete, Text: "young"},
+					{Op: models.DiffOpInsert, Text: "reluctant"},
+					{Op: models.DiffOpEqual, Text: " hero leaves home."},
+				},
+			},
+		},
+		{
+			name: "SelectFromError",
+
+			request: services.DiffLoglineRevisionsRequest{
+				FromID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ToID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectFromData: &selectLoglineRevisionData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "SelectToError",
+
+			request: services.DiffLoglineRevisionsRequest{
+				FromID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ToID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectFromData: &selectLoglineRevisionData{
+				resp: from,
+			},
+			selectToData: &selectLoglineRevisionData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockDiffLoglineRevisionsSource(t)
+
+			if testCase.selectFromData != nil {
+				source.EXPECT().
+					SelectLoglineRevision(mock.Anything, services.SelectLoglineRevisionRequest{
+						ID:     testCase.request.FromID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectFromData.resp, testCase.selectFromData.err)
+			}
+
+			if testCase.selectToData != nil {
+				source.EXPECT().
+					SelectLoglineRevision(mock.Anything, services.SelectLoglineRevisionRequest{
+						ID:     testCase.request.ToID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectToData.resp, testCase.selectToData.err)
+			}
+
+			service := services.NewDiffLoglineRevisionsService(source)
+
+			resp, err := service.DiffLoglineRevisions(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel/golib/otel"
@@ -17,6 +18,7 @@ import (
 
 type ExpandBeatSource interface {
 	ExpandBeat(ctx context.Context, request daoai.ExpandBeatRequest) (*models.Beat, error)
+	InsertBeatsSheet(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)
 	SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)
 	SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)
 	SelectStoryPlan(ctx context.Context, request SelectStoryPlanRequest) (*storyplanmodel.Plan, error)
@@ -24,17 +26,20 @@ type ExpandBeatSource interface {
 
 func NewExpandBeatServiceSource(
 	expandBeatDAO *daoai.ExpandBeatRepository,
+	insertBeatsSheetDAO *dao.InsertBeatsSheetRepository,
 	selectBeatsSheetDAO *dao.SelectBeatsSheetRepository,
 	selectLoglineDAO *dao.SelectLoglineRepository,
 	selectStoryPlan *SelectStoryPlanService,
 ) ExpandBeatSource {
 	return &struct {
 		*daoai.ExpandBeatRepository
+		*dao.InsertBeatsSheetRepository
 		*dao.SelectBeatsSheetRepository
 		*dao.SelectLoglineRepository
 		*SelectStoryPlanService
 	}{
 		ExpandBeatRepository:       expandBeatDAO,
+		InsertBeatsSheetRepository: insertBeatsSheetDAO,
 		SelectBeatsSheetRepository: selectBeatsSheetDAO,
 		SelectLoglineRepository:    selectLoglineDAO,
 		SelectStoryPlanService:     selectStoryPlan,
@@ -44,7 +49,12 @@ func NewExpandBeatServiceSource(
 type ExpandBeatRequest struct {
 	BeatsSheetID uuid.UUID
 	TargetKey    string
-	UserID       uuid.UUID
+	// When the key is repeated in the sheet, the (zero-based) occurrence of the beat to expand.
+	Occurrence int
+	UserID     uuid.UUID
+	// Apply saves the expanded beat as a new revision of the sheet, instead of only returning it. The original sheet
+	// is left untouched, and becomes the parent of the new one.
+	Apply bool
 }
 
 type ExpandBeatService struct {
@@ -57,14 +67,16 @@ func NewExpandBeatService(source ExpandBeatSource) *ExpandBeatService {
 
 func (service *ExpandBeatService) ExpandBeat(
 	ctx context.Context, request ExpandBeatRequest,
-) (*models.Beat, error) {
+) (*models.ExpandedBeat, error) {
 	ctx, span := otel.Tracer().Start(ctx, "service.ExpandBeat")
 	defer span.End()
 
 	span.SetAttributes(
 		attribute.String("request.beatsSheetID", request.BeatsSheetID.String()),
 		attribute.String("request.targetKey", request.TargetKey),
+		attribute.Int("request.occurrence", request.Occurrence),
 		attribute.String("request.userID", request.UserID.String()),
+		attribute.Bool("request.apply", request.Apply),
 	)
 
 	beatsSheet, err := service.source.SelectBeatsSheet(ctx, request.BeatsSheetID)
@@ -81,24 +93,55 @@ func (service *ExpandBeatService) ExpandBeat(
 		return nil, otel.ReportError(span, fmt.Errorf("select logline: %w", err))
 	}
 
+	// Use the plan the beats sheet was created with. Older sheets have no plan attached, and use the default one.
 	storyPlan, err := service.source.SelectStoryPlan(ctx, SelectStoryPlanRequest{
-		Lang: beatsSheet.Lang,
+		ID:     lo.Ternary(beatsSheet.StoryPlanID != uuid.Nil, &beatsSheet.StoryPlanID, nil),
+		Lang:   beatsSheet.Lang,
+		UserID: request.UserID,
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, err)
 	}
 
+	// Check the beat exists before generating it, whether the result is applied or not.
+	index, err := beatIndex(beatsSheet.Content, request.TargetKey, request.Occurrence)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
 	expanded, err := service.source.ExpandBeat(ctx, daoai.ExpandBeatRequest{
-		Logline:   logline.Name + "\n\n" + logline.Content,
-		Beats:     beatsSheet.Content,
-		Plan:      storyPlan,
-		Lang:      beatsSheet.Lang,
-		TargetKey: request.TargetKey,
-		UserID:    request.UserID.String(),
+		Logline:    logline.Name + "\n\n" + logline.Content,
+		Beats:      beatsSheet.Content,
+		Plan:       storyPlan,
+		Lang:       beatsSheet.Lang,
+		TargetKey:  request.TargetKey,
+		Occurrence: request.Occurrence,
+		UserID:     request.UserID.String(),
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, err)
 	}
 
-	return otel.ReportSuccess(span, expanded), nil
+	if !request.Apply {
+		return otel.ReportSuccess(span, &models.ExpandedBeat{Beat: expanded}), nil
+	}
+
+	// Only replace the expanded occurrence: when the beat is repeated, the other occurrences are kept as is.
+	content := make([]models.Beat, len(beatsSheet.Content))
+	copy(content, beatsSheet.Content)
+	content[index] = *expanded
+
+	beatsSheetRevision, err := insertBeatsSheetRevision(
+		ctx, service.source.InsertBeatsSheet, beatsSheet, storyPlan, content,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(attribute.String("dao.insertBeatsSheet.id", beatsSheetRevision.ID.String()))
+
+	return otel.ReportSuccess(span, &models.ExpandedBeat{
+		Beat:       expanded,
+		BeatsSheet: beatsSheetRevision,
+	}), nil
 }
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -27,6 +29,11 @@ func TestExpandBeat(t *testing.T) {
 		err  error
 	}
 
+	type insertBeatsSheetData struct {
+		resp *dao.BeatsSheetEntity
+		err  error
+	}
+
 	type selectBeatsSheetData struct {
 		resp *dao.BeatsSheetEntity
 		err  error
@@ -51,8 +58,11 @@ func TestExpandBeat(t *testing.T) {
 		selectLoglineData    *selectLoglineData
 		selectStoryPlanData  *selectStoryPlanData
 		expandBeatData       *expandBeatData
+		insertBeatsSheetData *insertBeatsSheetData
 
-		expect    *models.Beat
+		expectInsertContent []models.Beat
+
+		expect    *models.ExpandedBeat
 		expectErr error
 	}{
 		{
@@ -60,7 +70,7 @@ func TestExpandBeat(t *testing.T) {
 
 			request: services.ExpandBeatRequest{
 				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-				TargetKey:    "test",
+				TargetKey:    "beat-1",
 				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			},
 
@@ -125,18 +135,623 @@ func TestExpandBeat(t *testing.T) {
 				},
 			},
 
-			expect: &models.Beat{
-				Key:     "beat-1",
-				Title:   "Generated Beat 1 (expanded)",
-				Content: "Generated Content 1 (expanded)",
+			expect: &models.ExpandedBeat{
+				Beat: &models.Beat{
+					Key:     "beat-1",
+					Title:   "Generated Beat 1 (expanded)",
+					Content: "Generated Content 1 (expanded)",
+				},
+			},
+		},
+		{
+			name: "Success/StoredStoryPlan",
+
+			request: services.ExpandBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				TargetKey:    "beat-1",
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Generated Beat 1",
+							Content: "Generated Content 1",
+						},
+						{
+							Key:     "beat-2",
+							Title:   "Generated Beat 2",
+							Content: "Generated Content 2",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "logline-1",
+					Name:      "Logline 1",
+					Content:   "Content 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						Name: "Test Story Plan",
+						Lang: models.LangEN,
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name: "Beat 1",
+							Key:  "beat-1",
+							KeyPoints: []string{
+								"Key Point 1",
+								"Key Point 2",
+							},
+							Purpose: "Purpose 1",
+						},
+					},
+				},
+			},
+
+			expandBeatData: &expandBeatData{
+				resp: &models.Beat{
+					Key:     "beat-1",
+					Title:   "Generated Beat 1 (expanded)",
+					Content: "Generated Content 1 (expanded)",
+				},
+			},
+
+			expect: &models.ExpandedBeat{
+				Beat: &models.Beat{
+					Key:     "beat-1",
+					Title:   "Generated Beat 1 (expanded)",
+					Content: "Generated Content 1 (expanded)",
+				},
+			},
+		},
+		{
+			name: "Success/Apply",
+
+			request: services.ExpandBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				TargetKey:    "beat-2",
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Apply:        true,
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Generated Beat 1",
+							Content: "Generated Content 1",
+						},
+						{
+							Key:     "beat-2",
+							Title:   "Generated Beat 2",
+							Content: "Generated Content 2",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "logline-1",
+					Name:      "Logline 1",
+					Content:   "Content 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						Name: "Test Story Plan",
+						Lang: models.LangEN,
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Beat 1",
+							Key:       "beat-1",
+							KeyPoints: []string{"Key Point 1"},
+							Purpose:   "Purpose 1",
+						},
+						{
+							Name:      "Beat 2",
+							Key:       "beat-2",
+							KeyPoints: []string{"Key Point 2"},
+							Purpose:   "Purpose 2",
+						},
+					},
+				},
+			},
+
+			expandBeatData: &expandBeatData{
+				resp: &models.Beat{
+					Key:     "beat-2",
+					Title:   "Generated Beat 2 (expanded)",
+					Content: "Generated Content 2 (expanded)",
+				},
+			},
+
+			expectInsertContent: []models.Beat{
+				{
+					Key:     "beat-1",
+					Title:   "Generated Beat 1",
+					Content: "Generated Content 1",
+				},
+				{
+					Key:     "beat-2",
+					Title:   "Generated Beat 2 (expanded)",
+					Content: "Generated Content 2 (expanded)",
+				},
+			},
+
+			insertBeatsSheetData: &insertBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					ParentID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Generated Beat 1",
+							Content: "Generated Content 1",
+						},
+						{
+							Key:     "beat-2",
+							Title:   "Generated Beat 2 (expanded)",
+							Content: "Generated Content 2 (expanded)",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.ExpandedBeat{
+				Beat: &models.Beat{
+					Key:     "beat-2",
+					Title:   "Generated Beat 2 (expanded)",
+					Content: "Generated Content 2 (expanded)",
+				},
+				BeatsSheet: &models.BeatsSheet{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					ParentID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Generated Beat 1",
+							Content: "Generated Content 1",
+						},
+						{
+							Key:     "beat-2",
+							Title:   "Generated Beat 2 (expanded)",
+							Content: "Generated Content 2 (expanded)",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
 			},
 		},
+		{
+			name: "Success/Apply/RepeatedBeat",
+
+			request: services.ExpandBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				TargetKey:    "trial",
+				Occurrence:   1,
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Apply:        true,
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{Key: "beat-1", Title: "Generated Beat 1", Content: "Generated Content 1"},
+						{Key: "trial", Title: "Trial 1", Content: "Trial Content 1"},
+						{Key: "trial", Title: "Trial 2", Content: "Trial Content 2"},
+						{Key: "trial", Title: "Trial 3", Content: "Trial Content 3"},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "logline-1",
+					Name:      "Logline 1",
+					Content:   "Content 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						Name: "Test Story Plan",
+						Lang: models.LangEN,
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Beat 1",
+							Key:       "beat-1",
+							KeyPoints: []string{"Key Point 1"},
+							Purpose:   "Purpose 1",
+						},
+						{
+							Name:      "Trial",
+							Key:       "trial",
+							KeyPoints: []string{"Key Point 2"},
+							Purpose:   "Purpose 2",
+							Repeat:    &storyplanmodel.Repeat{},
+						},
+					},
+				},
+			},
+
+			expandBeatData: &expandBeatData{
+				resp: &models.Beat{
+					Key:     "trial",
+					Title:   "Trial 2 (expanded)",
+					Content: "Trial Content 2 (expanded)",
+				},
+			},
+
+			expectInsertContent: []models.Beat{
+				{Key: "beat-1", Title: "Generated Beat 1", Content: "Generated Content 1"},
+				{Key: "trial", Title: "Trial 1", Content: "Trial Content 1"},
+				{Key: "trial", Title: "Trial 2 (expanded)", Content: "Trial Content 2 (expanded)"},
+				{Key: "trial", Title: "Trial 3", Content: "Trial Content 3"},
+			},
+
+			insertBeatsSheetData: &insertBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					ParentID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{Key: "beat-1", Title: "Generated Beat 1", Content: "Generated Content 1"},
+						{Key: "trial", Title: "Trial 1", Content: "Trial Content 1"},
+						{Key: "trial", Title: "Trial 2 (expanded)", Content: "Trial Content 2 (expanded)"},
+						{Key: "trial", Title: "Trial 3", Content: "Trial Content 3"},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.ExpandedBeat{
+				Beat: &models.Beat{
+					Key:     "trial",
+					Title:   "Trial 2 (expanded)",
+					Content: "Trial Content 2 (expanded)",
+				},
+				BeatsSheet: &models.BeatsSheet{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					ParentID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{Key: "beat-1", Title: "Generated Beat 1", Content: "Generated Content 1"},
+						{Key: "trial", Title: "Trial 1", Content: "Trial Content 1"},
+						{Key: "trial", Title: "Trial 2 (expanded)", Content: "Trial Content 2 (expanded)"},
+						{Key: "trial", Title: "Trial 3", Content: "Trial Content 3"},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "OccurrenceNotFound",
+
+			request: services.ExpandBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				TargetKey:    "beat-2",
+				Occurrence:   1,
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{Key: "beat-1", Title: "Generated Beat 1", Content: "Generated Content 1"},
+						{Key: "beat-2", Title: "Generated Beat 2", Content: "Generated Content 2"},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "logline-1",
+					Name:      "Logline 1",
+					Content:   "Content 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						Name: "Test Story Plan",
+						Lang: models.LangEN,
+					},
+					Beats: []storyplanmodel.Beat{
+						{Name: "Beat 1", Key: "beat-1", KeyPoints: []string{"Key Point 1"}, Purpose: "Purpose 1"},
+						{Name: "Beat 2", Key: "beat-2", KeyPoints: []string{"Key Point 2"}, Purpose: "Purpose 2"},
+					},
+				},
+			},
+
+			expectErr: services.ErrBeatNotFound,
+		},
+		{
+			name: "Apply/OccurrenceNotFound",
+
+			request: services.ExpandBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				TargetKey:    "beat-2",
+				Occurrence:   1,
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Apply:        true,
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{Key: "beat-1", Title: "Generated Beat 1", Content: "Generated Content 1"},
+						{Key: "beat-2", Title: "Generated Beat 2", Content: "Generated Content 2"},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "logline-1",
+					Name:      "Logline 1",
+					Content:   "Content 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						Name: "Test Story Plan",
+						Lang: models.LangEN,
+					},
+					Beats: []storyplanmodel.Beat{
+						{Name: "Beat 1", Key: "beat-1", KeyPoints: []string{"Key Point 1"}, Purpose: "Purpose 1"},
+						{Name: "Beat 2", Key: "beat-2", KeyPoints: []string{"Key Point 2"}, Purpose: "Purpose 2"},
+					},
+				},
+			},
+
+			expectErr: services.ErrBeatNotFound,
+		},
+		{
+			name: "Apply/InvalidPlan",
+
+			request: services.ExpandBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				TargetKey:    "beat-2",
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Apply:        true,
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Generated Beat 1",
+							Content: "Generated Content 1",
+						},
+						{
+							Key:     "beat-2",
+							Title:   "Generated Beat 2",
+							Content: "Generated Content 2",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "logline-1",
+					Name:      "Logline 1",
+					Content:   "Content 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						Name: "Test Story Plan",
+						Lang: models.LangEN,
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Beat 1",
+							Key:       "beat-1",
+							KeyPoints: []string{"Key Point 1"},
+							Purpose:   "Purpose 1",
+						},
+					},
+				},
+			},
+
+			expandBeatData: &expandBeatData{
+				resp: &models.Beat{
+					Key:     "beat-2",
+					Title:   "Generated Beat 2 (expanded)",
+					Content: "Generated Content 2 (expanded)",
+				},
+			},
+
+			expectErr: storyplanmodel.ErrInvalidPlan,
+		},
+		{
+			name: "InsertBeatsSheet/Error",
+
+			request: services.ExpandBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				TargetKey:    "beat-2",
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Apply:        true,
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Generated Beat 1",
+							Content: "Generated Content 1",
+						},
+						{
+							Key:     "beat-2",
+							Title:   "Generated Beat 2",
+							Content: "Generated Content 2",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "logline-1",
+					Name:      "Logline 1",
+					Content:   "Content 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						Name: "Test Story Plan",
+						Lang: models.LangEN,
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Beat 1",
+							Key:       "beat-1",
+							KeyPoints: []string{"Key Point 1"},
+							Purpose:   "Purpose 1",
+						},
+						{
+							Name:      "Beat 2",
+							Key:       "beat-2",
+							KeyPoints: []string{"Key Point 2"},
+							Purpose:   "Purpose 2",
+						},
+					},
+				},
+			},
+
+			expandBeatData: &expandBeatData{
+				resp: &models.Beat{
+					Key:     "beat-2",
+					Title:   "Generated Beat 2 (expanded)",
+					Content: "Generated Content 2 (expanded)",
+				},
+			},
+
+			expectInsertContent: []models.Beat{
+				{
+					Key:     "beat-1",
+					Title:   "Generated Beat 1",
+					Content: "Generated Content 1",
+				},
+				{
+					Key:     "beat-2",
+					Title:   "Generated Beat 2 (expanded)",
+					Content: "Generated Content 2 (expanded)",
+				},
+			},
+
+			insertBeatsSheetData: &insertBeatsSheetData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
 		{
 			name: "ExpandBeat/Error",
 
 			request: services.ExpandBeatRequest{
 				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
-				TargetKey:    "test",
+				TargetKey:    "beat-1",
 				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			},
 
@@ -327,7 +942,15 @@ func TestExpandBeat(t *testing.T) {
 				source.EXPECT().
 					SelectStoryPlan(
 						mock.Anything,
-						services.SelectStoryPlanRequest{Lang: testCase.selectBeatsSheetData.resp.Lang},
+						services.SelectStoryPlanRequest{
+							ID: lo.Ternary(
+								testCase.selectBeatsSheetData.resp.StoryPlanID != uuid.Nil,
+								&testCase.selectBeatsSheetData.resp.StoryPlanID,
+								nil,
+							),
+							Lang:   testCase.selectBeatsSheetData.resp.Lang,
+							UserID: testCase.request.UserID,
+						},
 					).
 					Return(testCase.selectStoryPlanData.resp, testCase.selectStoryPlanData.err)
 			}
@@ -335,22 +958,43 @@ func TestExpandBeat(t *testing.T) {
 			if testCase.expandBeatData != nil {
 				source.EXPECT().
 					ExpandBeat(mock.Anything, daoai.ExpandBeatRequest{
-						Logline:   testCase.selectLoglineData.resp.Name + "\n\n" + testCase.selectLoglineData.resp.Content,
-						Beats:     testCase.selectBeatsSheetData.resp.Content,
-						Plan:      testCase.selectStoryPlanData.resp,
-						Lang:      testCase.selectBeatsSheetData.resp.Lang,
-						TargetKey: testCase.request.TargetKey,
-						UserID:    testCase.request.UserID.String(),
+						Logline:    testCase.selectLoglineData.resp.Name + "\n\n" + testCase.selectLoglineData.resp.Content,
+						Beats:      testCase.selectBeatsSheetData.resp.Content,
+						Plan:       testCase.selectStoryPlanData.resp,
+						Lang:       testCase.selectBeatsSheetData.resp.Lang,
+						TargetKey:  testCase.request.TargetKey,
+						Occurrence: testCase.request.Occurrence,
+						UserID:     testCase.request.UserID.String(),
 					}).
 					Return(testCase.expandBeatData.resp, testCase.expandBeatData.err)
 			}
 
+			if testCase.insertBeatsSheetData != nil {
+				source.EXPECT().
+					InsertBeatsSheet(mock.Anything, mock.MatchedBy(func(data dao.InsertBeatsSheetData) bool {
+						return assert.NotEqual(t, uuid.Nil, data.Sheet.ID) &&
+							assert.Equal(t, testCase.selectBeatsSheetData.resp.LoglineID, data.Sheet.LoglineID) &&
+							assert.Equal(t, testCase.selectBeatsSheetData.resp.StoryPlanID, data.Sheet.StoryPlanID) &&
+							assert.Equal(t, testCase.selectBeatsSheetData.resp.ID, data.Sheet.ParentID) &&
+							assert.Equal(t, uuid.Nil, data.Sheet.SourceID) &&
+							assert.Equal(t, testCase.expectInsertContent, data.Sheet.Content) &&
+							assert.Equal(t, testCase.selectBeatsSheetData.resp.Lang, data.Sheet.Lang) &&
+							assert.WithinDuration(t, time.Now(), data.Sheet.CreatedAt, time.Second)
+					})).
+					Return(testCase.insertBeatsSheetData.resp, testCase.insertBeatsSheetData.err)
+			}
+
 			service := services.NewExpandBeatService(source)
 
 			resp, err := service.ExpandBeat(ctx, testCase.request)
 			require.ErrorIs(t, err, testCase.expectErr)
 			require.Equal(t, testCase.expect, resp)
 
+			// Expanding a beat is a paid call: it must not happen when the request is invalid.
+			if testCase.expandBeatData == nil {
+				source.AssertNotCalled(t, "ExpandBeat", mock.Anything, mock.Anything)
+			}
+
 			source.AssertExpectations(t)
 		})
 	}
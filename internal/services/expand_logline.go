@@ -11,6 +11,7 @@ import (
 
 	"github.com/a-novel/service-story-schematics/internal/daoai"
 	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 type ExpandLoglineSource interface {
@@ -42,6 +43,11 @@ func (service *ExpandLoglineService) ExpandLogline(
 		attribute.String("request.userID", request.UserID.String()),
 	)
 
+	err := storyplanmodel.CheckLang(request.Logline.Lang)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check lang: %w", err))
+	}
+
 	resp, err := service.source.ExpandLogline(ctx, daoai.ExpandLoglineRequest{
 		Logline: request.Logline.Name + "\n\n" + request.Logline.Content,
 		UserID:  request.UserID.String(),
@@ -12,6 +12,7 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
 	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 func TestExpandLogline(t *testing.T) {
@@ -60,6 +61,20 @@ func TestExpandLogline(t *testing.T) {
 				Lang:    models.LangEN,
 			},
 		},
+		{
+			name: "UnsupportedLang",
+
+			request: services.ExpandLoglineRequest{
+				Logline: models.LoglineIdea{
+					Name:    "test title",
+					Content: "test content",
+					Lang:    "xx",
+				},
+				UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			expectErr: storyplanmodel.ErrUnsupportedLang,
+		},
 		{
 			name: "Error",
 
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type ForkStoryPlanSource interface {
+	SelectStoryPlan(ctx context.Context, request SelectStoryPlanRequest) (*storyplanmodel.Plan, error)
+	InsertStoryPlan(ctx context.Context, data dao.InsertStoryPlanData) (*dao.StoryPlanEntity, error)
+}
+
+func NewForkStoryPlanServiceSource(
+	selectStoryPlan *SelectStoryPlanService,
+	insertStoryPlanDAO *dao.InsertStoryPlanRepository,
+) ForkStoryPlanSource {
+	return &struct {
+		*SelectStoryPlanService
+		*dao.InsertStoryPlanRepository
+	}{
+		SelectStoryPlanService:    selectStoryPlan,
+		InsertStoryPlanRepository: insertStoryPlanDAO,
+	}
+}
+
+// ForkStoryPlanRequest copies an existing plan into a new custom plan, owned by the user.
+type ForkStoryPlanRequest struct {
+	// ID of the plan to fork. It must either be a built-in plan, or a custom plan owned by the user.
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// Optional, defaults to the slug of the forked plan.
+	Slug *models.Slug
+	// Optional, defaults to the name of the forked plan.
+	Name *string
+}
+
+type ForkStoryPlanService struct {
+	source ForkStoryPlanSource
+}
+
+func NewForkStoryPlanService(source ForkStoryPlanSource) *ForkStoryPlanService {
+	return &ForkStoryPlanService{source: source}
+}
+
+func (service *ForkStoryPlanService) ForkStoryPlan(
+	ctx context.Context, request ForkStoryPlanRequest,
+) (*storyplanmodel.Plan, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ForkStoryPlan")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.id", request.ID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.slug", lo.FromPtr(request.Slug).String()),
+		attribute.String("request.name", lo.FromPtr(request.Name)),
+	)
+
+	source, err := service.source.SelectStoryPlan(ctx, SelectStoryPlanRequest{
+		ID:     &request.ID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select story plan: %w", err))
+	}
+
+	resp, err := service.source.InsertStoryPlan(ctx, dao.InsertStoryPlanData{
+		ID:     uuid.New(),
+		UserID: &request.UserID,
+		Slug:   lo.FromPtrOr(request.Slug, source.Metadata.Slug),
+		Name:   lo.FromPtrOr(request.Name, source.Metadata.Name),
+		Lang:   source.Metadata.Lang,
+		Acts:   source.Acts,
+		Beats:  source.Beats,
+		Now:    time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("insert story plan: %w", err))
+	}
+
+	span.SetAttributes(attribute.String("dao.insertStoryPlan.id", resp.ID.String()))
+
+	return otel.ReportSuccess(span, storyPlanEntityToModel(resp)), nil
+}
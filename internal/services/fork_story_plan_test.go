@@ -0,0 +1,262 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestForkStoryPlan(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectStoryPlanData struct {
+		resp *storyplanmodel.Plan
+		err  error
+	}
+
+	type insertStoryPlanData struct {
+		expectSlug models.Slug
+		expectName string
+
+		resp *dao.StoryPlanEntity
+		err  error
+	}
+
+	userID := uuid.MustParse("00000000-0000-0000-1000-000000000001")
+
+	source := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Slug:      "test-slug",
+			Version:   3,
+			Name:      "Test Name",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		Acts: []storyplanmodel.Act{
+			{Name: "Test Act", Key: "test-act", Purpose: "Test Purpose"},
+		},
+		Beats: []storyplanmodel.Beat{
+			{
+				Name:      "Test Beat",
+				Key:       "test-beat",
+				KeyPoints: []string{"Test Key Point"},
+				Purpose:   "Test Purpose",
+				Act:       "test-act",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.ForkStoryPlanRequest
+
+		selectStoryPlanData *selectStoryPlanData
+		insertStoryPlanData *insertStoryPlanData
+
+		expect    *storyplanmodel.Plan
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.ForkStoryPlanRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: userID,
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: source,
+			},
+
+			insertStoryPlanData: &insertStoryPlanData{
+				expectSlug: "test-slug",
+				expectName: "Test Name",
+
+				resp: &dao.StoryPlanEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    &userID,
+					Slug:      "test-slug",
+					Version:   1,
+					Name:      "Test Name",
+					Lang:      models.LangEN,
+					Acts:      source.Acts,
+					Beats:     source.Beats,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &storyplanmodel.Plan{
+				Metadata: storyplanmodel.Metadata{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    &userID,
+					Slug:      "test-slug",
+					Version:   1,
+					Name:      "Test Name",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				Acts:  source.Acts,
+				Beats: source.Beats,
+			},
+		},
+		{
+			name: "Success/Rename",
+
+			request: services.ForkStoryPlanRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: userID,
+				Slug:   lo.ToPtr(models.Slug("my-slug")),
+				Name:   lo.ToPtr("My Name"),
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: source,
+			},
+
+			insertStoryPlanData: &insertStoryPlanData{
+				expectSlug: "my-slug",
+				expectName: "My Name",
+
+				resp: &dao.StoryPlanEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    &userID,
+					Slug:      "my-slug",
+					Version:   1,
+					Name:      "My Name",
+					Lang:      models.LangEN,
+					Acts:      source.Acts,
+					Beats:     source.Beats,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &storyplanmodel.Plan{
+				Metadata: storyplanmodel.Metadata{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    &userID,
+					Slug:      "my-slug",
+					Version:   1,
+					Name:      "My Name",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				Acts:  source.Acts,
+				Beats: source.Beats,
+			},
+		},
+		{
+			name: "SelectStoryPlanError",
+
+			request: services.ForkStoryPlanRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: userID,
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				err: dao.ErrStoryPlanNotFound,
+			},
+
+			expectErr: dao.ErrStoryPlanNotFound,
+		},
+		{
+			name: "AlreadyExists",
+
+			request: services.ForkStoryPlanRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: userID,
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: source,
+			},
+
+			insertStoryPlanData: &insertStoryPlanData{
+				expectSlug: "test-slug",
+				expectName: "Test Name",
+
+				err: dao.ErrStoryPlanAlreadyExists,
+			},
+
+			expectErr: dao.ErrStoryPlanAlreadyExists,
+		},
+		{
+			name: "InsertError",
+
+			request: services.ForkStoryPlanRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: userID,
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: source,
+			},
+
+			insertStoryPlanData: &insertStoryPlanData{
+				expectSlug: "test-slug",
+				expectName: "Test Name",
+
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockForkStoryPlanSource(t)
+
+			if testCase.selectStoryPlanData != nil {
+				source.EXPECT().
+					SelectStoryPlan(mock.Anything, services.SelectStoryPlanRequest{
+						ID:     &testCase.request.ID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectStoryPlanData.resp, testCase.selectStoryPlanData.err)
+			}
+
+			if testCase.insertStoryPlanData != nil {
+				source.EXPECT().
+					InsertStoryPlan(mock.Anything, mock.MatchedBy(func(data dao.InsertStoryPlanData) bool {
+						return assert.NotEqual(t, uuid.Nil, data.ID) &&
+							assert.Equal(t, &testCase.request.UserID, data.UserID) &&
+							assert.Equal(t, testCase.insertStoryPlanData.expectSlug, data.Slug) &&
+							assert.Equal(t, testCase.insertStoryPlanData.expectName, data.Name) &&
+							assert.Equal(t, testCase.selectStoryPlanData.resp.Metadata.Lang, data.Lang) &&
+							assert.Equal(t, testCase.selectStoryPlanData.resp.Acts, data.Acts) &&
+							assert.Equal(t, testCase.selectStoryPlanData.resp.Beats, data.Beats) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(testCase.insertStoryPlanData.resp, testCase.insertStoryPlanData.err)
+			}
+
+			service := services.NewForkStoryPlanService(source)
+
+			resp, err := service.ForkStoryPlan(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
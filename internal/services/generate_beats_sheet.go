@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel/golib/otel"
@@ -40,7 +41,9 @@ func NewGenerateBeatsSheetServiceSource(
 type GenerateBeatsSheetRequest struct {
 	LoglineID uuid.UUID
 	UserID    uuid.UUID
-	Lang      models.Lang
+	// Optional, defaults to the default story plan for the language.
+	StoryPlanID *uuid.UUID
+	Lang        models.Lang
 }
 
 type GenerateBeatsSheetService struct {
@@ -60,6 +63,7 @@ func (service *GenerateBeatsSheetService) GenerateBeatsSheet(
 	span.SetAttributes(
 		attribute.String("request.loglineID", request.LoglineID.String()),
 		attribute.String("request.lang", request.Lang.String()),
+		attribute.String("request.storyPlanID", lo.FromPtr(request.StoryPlanID).String()),
 		attribute.String("request.userID", request.UserID.String()),
 	)
 
@@ -72,12 +76,22 @@ func (service *GenerateBeatsSheetService) GenerateBeatsSheet(
 	}
 
 	storyPlan, err := service.source.SelectStoryPlan(ctx, SelectStoryPlanRequest{
-		Lang: request.Lang,
+		ID:     request.StoryPlanID,
+		Lang:   request.Lang,
+		UserID: request.UserID,
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("get story plan: %w", err))
 	}
 
+	if storyPlan.Metadata.Lang != request.Lang {
+		return nil, otel.ReportError(span, fmt.Errorf(
+			"%w: plan is %s, beats sheet is %s", ErrStoryPlanLangMismatch, storyPlan.Metadata.Lang, request.Lang,
+		))
+	}
+
+	span.SetAttributes(attribute.String("storyPlan.id", storyPlan.Metadata.ID.String()))
+
 	resp, err := service.source.GenerateBeatsSheet(ctx, daoai.GenerateBeatsSheetRequest{
 		Logline: logline.Name + "\n\n" + logline.Content,
 		Plan:    storyPlan,
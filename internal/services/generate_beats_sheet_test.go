@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -207,6 +208,108 @@ func TestGenerateBeatsSheet(t *testing.T) {
 
 			expectErr: errFoo,
 		},
+		{
+			name: "Success/StoryPlanID",
+
+			request: services.GenerateBeatsSheetRequest{
+				LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				StoryPlanID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+				Lang:        models.LangEN,
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						ID:   uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+						Slug: "custom-plan",
+						Name: "Custom Plan",
+						Lang: models.LangEN,
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+				},
+			},
+
+			generateBeatsSheetData: &generateBeatsSheetData{
+				resp: []models.Beat{
+					{
+						Key:     "test-beat",
+						Title:   "Test Beat",
+						Content: "Test Beat Content",
+					},
+				},
+			},
+
+			expect: []models.Beat{
+				{
+					Key:     "test-beat",
+					Title:   "Test Beat",
+					Content: "Test Beat Content",
+				},
+			},
+		},
+		{
+			name: "StoryPlanLangMismatch",
+
+			request: services.GenerateBeatsSheetRequest{
+				LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				StoryPlanID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-100000000001")),
+				Lang:        models.LangEN,
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						ID:   uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+						Slug: "custom-plan",
+						Name: "Plan Personnalisé",
+						Lang: models.LangFR,
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Battement de Test",
+							Key:       "test-beat",
+							KeyPoints: []string{"Point Clé de Test"},
+							Purpose:   "Objectif de Test",
+						},
+					},
+				},
+			},
+
+			expectErr: services.ErrStoryPlanLangMismatch,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -241,7 +344,11 @@ func TestGenerateBeatsSheet(t *testing.T) {
 				source.EXPECT().
 					SelectStoryPlan(
 						mock.Anything,
-						services.SelectStoryPlanRequest{Lang: testCase.request.Lang},
+						services.SelectStoryPlanRequest{
+							ID:     testCase.request.StoryPlanID,
+							Lang:   testCase.request.Lang,
+							UserID: testCase.request.UserID,
+						},
 					).
 					Return(testCase.selectStoryPlanData.resp, testCase.selectStoryPlanData.err)
 			}
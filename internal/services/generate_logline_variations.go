@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+var (
+	ErrInvalidVariationSource = errors.New(
+		"variations require either a logline ID or a logline idea, but not both",
+	)
+	ErrMissingVariations = errors.New("not enough variations were generated")
+)
+
+type GenerateLoglineVariationsSource interface {
+	GenerateLoglineVariations(
+		ctx context.Context, request daoai.GenerateLoglineVariationsRequest,
+	) ([]models.LoglineVariation, error)
+	SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)
+}
+
+func NewGenerateLoglineVariationsServiceSource(
+	generateLoglineVariationsDAO *daoai.GenerateLoglineVariationsRepository,
+	selectLoglineDAO *dao.SelectLoglineRepository,
+) GenerateLoglineVariationsSource {
+	return &struct {
+		*daoai.GenerateLoglineVariationsRepository
+		*dao.SelectLoglineRepository
+	}{
+		GenerateLoglineVariationsRepository: generateLoglineVariationsDAO,
+		SelectLoglineRepository:             selectLoglineDAO,
+	}
+}
+
+// GenerateLoglineVariationsRequest derives variations from either a logline of the user, or a logline idea that
+// was not saved. Exactly one of LoglineID and Logline must be set.
+type GenerateLoglineVariationsRequest struct {
+	UserID    uuid.UUID
+	LoglineID *uuid.UUID
+	Logline   *models.LoglineIdea
+	Count     int
+	// The dimensions the variations may change. Every other dimension is kept from the original logline. If empty,
+	// any dimension may change.
+	Dimensions []models.LoglineDimension
+}
+
+type GenerateLoglineVariationsService struct {
+	source GenerateLoglineVariationsSource
+}
+
+func NewGenerateLoglineVariationsService(source GenerateLoglineVariationsSource) *GenerateLoglineVariationsService {
+	return &GenerateLoglineVariationsService{source: source}
+}
+
+func (service *GenerateLoglineVariationsService) GenerateLoglineVariations(
+	ctx context.Context, request GenerateLoglineVariationsRequest,
+) ([]models.LoglineVariation, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.GenerateLoglineVariations")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.loglineID", lo.FromPtr(request.LoglineID).String()),
+		attribute.String("request.logline.name", lo.FromPtr(request.Logline).Name),
+		attribute.Int("request.count", request.Count),
+		attribute.StringSlice("request.dimensions", lo.Map(
+			request.Dimensions,
+			func(item models.LoglineDimension, _ int) string { return item.String() },
+		)),
+	)
+
+	if (request.LoglineID == nil) == (request.Logline == nil) {
+		return nil, otel.ReportError(span, ErrInvalidVariationSource)
+	}
+
+	dimensions := lo.Uniq(request.Dimensions)
+	if len(dimensions) == 0 {
+		dimensions = models.LoglineDimensions
+	}
+
+	for _, dimension := range dimensions {
+		err := models.CheckLoglineDimension(dimension)
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("check dimension: %w", err))
+		}
+	}
+
+	logline := lo.FromPtr(request.Logline)
+
+	if request.LoglineID != nil {
+		entity, err := service.source.SelectLogline(ctx, dao.SelectLoglineData{
+			ID:     *request.LoglineID,
+			UserID: request.UserID,
+		})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("select logline: %w", err))
+		}
+
+		logline = models.LoglineIdea{
+			Name:    entity.Name,
+			Content: entity.Content,
+			Lang:    entity.Lang,
+		}
+	}
+
+	err := storyplanmodel.CheckLang(logline.Lang)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check lang: %w", err))
+	}
+
+	resp, err := service.source.GenerateLoglineVariations(ctx, daoai.GenerateLoglineVariationsRequest{
+		Logline:    logline.Name + "\n\n" + logline.Content,
+		Count:      request.Count,
+		Dimensions: dimensions,
+		UserID:     request.UserID.String(),
+		Lang:       logline.Lang,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("generate logline variations: %w", err))
+	}
+
+	// The model may still stray from the requested dimensions, or return more variations than asked.
+	resp = lo.Filter(resp, func(item models.LoglineVariation, _ int) bool {
+		return lo.Contains(dimensions, item.Dimension)
+	})
+
+	if len(resp) < request.Count {
+		return nil, otel.ReportError(span, fmt.Errorf(
+			"%w: expected %d, got %d on the requested dimensions", ErrMissingVariations, request.Count, len(resp),
+		))
+	}
+
+	return otel.ReportSuccess(span, resp[:request.Count]), nil
+}
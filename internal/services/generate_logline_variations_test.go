@@ -0,0 +1,347 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestGenerateLoglineVariations(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	type generateLoglineVariationsData struct {
+		resp []models.LoglineVariation
+		err  error
+	}
+
+	idea := &models.LoglineIdea{
+		Name:    "test title",
+		Content: "test content",
+		Lang:    models.LangEN,
+	}
+
+	variations := []models.LoglineVariation{
+		{
+			LoglineIdea: models.LoglineIdea{Name: "variation 1", Content: "content 1", Lang: models.LangEN},
+			Dimension:   models.LoglineDimensionTone,
+		},
+		{
+			LoglineIdea: models.LoglineIdea{Name: "variation 2", Content: "content 2", Lang: models.LangEN},
+			Dimension:   models.LoglineDimensionEnding,
+		},
+	}
+
+	unrequestedVariation := models.LoglineVariation{
+		LoglineIdea: models.LoglineIdea{Name: "variation 3", Content: "content 3", Lang: models.LangEN},
+		Dimension:   models.LoglineDimensionGenre,
+	}
+
+	settingVariations := []models.LoglineVariation{
+		{
+			LoglineIdea: models.LoglineIdea{Name: "variante 1", Content: "contenu 1", Lang: models.LangFR},
+			Dimension:   models.LoglineDimensionSetting,
+		},
+		{
+			LoglineIdea: models.LoglineIdea{Name: "variante 2", Content: "contenu 2", Lang: models.LangFR},
+			Dimension:   models.LoglineDimensionSetting,
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.GenerateLoglineVariationsRequest
+
+		selectLoglineData             *selectLoglineData
+		generateLoglineVariationsData *generateLoglineVariationsData
+
+		expectGenerate daoai.GenerateLoglineVariationsRequest
+
+		expect    []models.LoglineVariation
+		expectErr error
+	}{
+		{
+			name: "Idea",
+
+			request: services.GenerateLoglineVariationsRequest{
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Logline: idea,
+				Count:   2,
+				Dimensions: []models.LoglineDimension{
+					models.LoglineDimensionTone,
+					models.LoglineDimensionEnding,
+					models.LoglineDimensionTone,
+				},
+			},
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{resp: variations},
+
+			expectGenerate: daoai.GenerateLoglineVariationsRequest{
+				Logline:    "test title\n\ntest content",
+				Count:      2,
+				Dimensions: []models.LoglineDimension{models.LoglineDimensionTone, models.LoglineDimensionEnding},
+				UserID:     "00000000-0000-0000-1000-000000000001",
+				Lang:       models.LangEN,
+			},
+
+			expect: variations,
+		},
+		{
+			name: "StoredLogline",
+
+			request: services.GenerateLoglineVariationsRequest{
+				UserID:     uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Count:      2,
+				Dimensions: []models.LoglineDimension{models.LoglineDimensionSetting},
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:    "test-slug",
+					Name:    "stored title",
+					Content: "stored content",
+					Lang:    models.LangFR,
+				},
+			},
+			generateLoglineVariationsData: &generateLoglineVariationsData{resp: settingVariations},
+
+			expectGenerate: daoai.GenerateLoglineVariationsRequest{
+				Logline:    "stored title\n\nstored content",
+				Count:      2,
+				Dimensions: []models.LoglineDimension{models.LoglineDimensionSetting},
+				UserID:     "00000000-0000-0000-1000-000000000001",
+				Lang:       models.LangFR,
+			},
+
+			expect: settingVariations,
+		},
+		{
+			name: "AllDimensions",
+
+			request: services.GenerateLoglineVariationsRequest{
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Logline: idea,
+				Count:   2,
+			},
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{resp: variations},
+
+			expectGenerate: daoai.GenerateLoglineVariationsRequest{
+				Logline:    "test title\n\ntest content",
+				Count:      2,
+				Dimensions: models.LoglineDimensions,
+				UserID:     "00000000-0000-0000-1000-000000000001",
+				Lang:       models.LangEN,
+			},
+
+			expect: variations,
+		},
+		{
+			name: "UnrequestedDimension",
+
+			request: services.GenerateLoglineVariationsRequest{
+				UserID:     uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Logline:    idea,
+				Count:      2,
+				Dimensions: []models.LoglineDimension{models.LoglineDimensionTone, models.LoglineDimensionEnding},
+			},
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{
+				resp: []models.LoglineVariation{variations[0], unrequestedVariation, variations[1]},
+			},
+
+			expectGenerate: daoai.GenerateLoglineVariationsRequest{
+				Logline:    "test title\n\ntest content",
+				Count:      2,
+				Dimensions: []models.LoglineDimension{models.LoglineDimensionTone, models.LoglineDimensionEnding},
+				UserID:     "00000000-0000-0000-1000-000000000001",
+				Lang:       models.LangEN,
+			},
+
+			expect: variations,
+		},
+		{
+			name: "TooManyVariations",
+
+			request: services.GenerateLoglineVariationsRequest{
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Logline: idea,
+				Count:   1,
+			},
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{resp: variations},
+
+			expectGenerate: daoai.GenerateLoglineVariationsRequest{
+				Logline:    "test title\n\ntest content",
+				Count:      1,
+				Dimensions: models.LoglineDimensions,
+				UserID:     "00000000-0000-0000-1000-000000000001",
+				Lang:       models.LangEN,
+			},
+
+			expect: variations[:1],
+		},
+		{
+			name: "MissingVariations",
+
+			request: services.GenerateLoglineVariationsRequest{
+				UserID:     uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Logline:    idea,
+				Count:      2,
+				Dimensions: []models.LoglineDimension{models.LoglineDimensionTone, models.LoglineDimensionEnding},
+			},
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{
+				resp: []models.LoglineVariation{variations[0], unrequestedVariation},
+			},
+
+			expectGenerate: daoai.GenerateLoglineVariationsRequest{
+				Logline:    "test title\n\ntest content",
+				Count:      2,
+				Dimensions: []models.LoglineDimension{models.LoglineDimensionTone, models.LoglineDimensionEnding},
+				UserID:     "00000000-0000-0000-1000-000000000001",
+				Lang:       models.LangEN,
+			},
+
+			expectErr: services.ErrMissingVariations,
+		},
+		{
+			name: "LoglineNotFound",
+
+			request: services.GenerateLoglineVariationsRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Count:     2,
+			},
+
+			selectLoglineData: &selectLoglineData{err: dao.ErrLoglineNotFound},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+		{
+			name: "NoSource",
+
+			request: services.GenerateLoglineVariationsRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Count:  2,
+			},
+
+			expectErr: services.ErrInvalidVariationSource,
+		},
+		{
+			name: "BothSources",
+
+			request: services.GenerateLoglineVariationsRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Logline:   idea,
+				Count:     2,
+			},
+
+			expectErr: services.ErrInvalidVariationSource,
+		},
+		{
+			name: "UnsupportedDimension",
+
+			request: services.GenerateLoglineVariationsRequest{
+				UserID:     uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Logline:    idea,
+				Count:      2,
+				Dimensions: []models.LoglineDimension{"pacing"},
+			},
+
+			expectErr: models.ErrUnsupportedLoglineDimension,
+		},
+		{
+			name: "UnsupportedLang",
+
+			request: services.GenerateLoglineVariationsRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Logline: &models.LoglineIdea{
+					Name:    "test title",
+					Content: "test content",
+					Lang:    "xx",
+				},
+				Count: 2,
+			},
+
+			expectErr: storyplanmodel.ErrUnsupportedLang,
+		},
+		{
+			name: "Error",
+
+			request: services.GenerateLoglineVariationsRequest{
+				UserID:     uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Logline:    idea,
+				Count:      2,
+				Dimensions: []models.LoglineDimension{models.LoglineDimensionGenre},
+			},
+
+			generateLoglineVariationsData: &generateLoglineVariationsData{err: errFoo},
+
+			expectGenerate: daoai.GenerateLoglineVariationsRequest{
+				Logline:    "test title\n\ntest content",
+				Count:      2,
+				Dimensions: []models.LoglineDimension{models.LoglineDimensionGenre},
+				UserID:     "00000000-0000-0000-1000-000000000001",
+				Lang:       models.LangEN,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockGenerateLoglineVariationsSource(t)
+
+			if testCase.selectLoglineData != nil {
+				source.EXPECT().
+					SelectLogline(mock.Anything, dao.SelectLoglineData{
+						ID:     lo.FromPtr(testCase.request.LoglineID),
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectLoglineData.resp, testCase.selectLoglineData.err)
+			}
+
+			if testCase.generateLoglineVariationsData != nil {
+				source.EXPECT().
+					GenerateLoglineVariations(mock.Anything, testCase.expectGenerate).
+					Return(testCase.generateLoglineVariationsData.resp, testCase.generateLoglineVariationsData.err)
+			}
+
+			service := services.NewGenerateLoglineVariationsService(source)
+
+			resp, err := service.GenerateLoglineVariations(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/a-novel/service-story-schematics/internal/daoai"
 	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 type GenerateLoglinesSource interface {
@@ -44,6 +46,11 @@ func (service *GenerateLoglinesService) GenerateLoglines(
 		attribute.String("request.lang", request.Lang.String()),
 	)
 
+	err := storyplanmodel.CheckLang(request.Lang)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check lang: %w", err))
+	}
+
 	resp, err := service.source.GenerateLoglines(ctx, daoai.GenerateLoglinesRequest{
 		Count:  request.Count,
 		Theme:  request.Theme,
@@ -12,6 +12,7 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
 	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 func TestGenerateLoglines(t *testing.T) {
@@ -72,6 +73,18 @@ func TestGenerateLoglines(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "UnsupportedLang",
+
+			request: services.GenerateLoglinesRequest{
+				Count:  5,
+				Theme:  "test-theme",
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Lang:   "xx",
+			},
+
+			expectErr: storyplanmodel.ErrUnsupportedLang,
+		},
 		{
 			name: "Error",
 
@@ -34,8 +34,12 @@ func NewListBeatsSheetsServiceSource(
 type ListBeatsSheetsRequest struct {
 	UserID    uuid.UUID
 	LoglineID uuid.UUID
-	Limit     int
-	Offset    int
+	// Defaults to models.ListSortNewest.
+	Sort models.ListSort
+	// The cursor returned with the previous page. Leave empty to get the first page.
+	Cursor string
+	// When 0, every remaining beats sheet is returned at once.
+	Limit int
 }
 
 type ListBeatsSheetsService struct {
@@ -48,18 +52,26 @@ func NewListBeatsSheetsService(source ListBeatsSheetsSource) *ListBeatsSheetsSer
 
 func (service *ListBeatsSheetsService) ListBeatsSheets(
 	ctx context.Context, request ListBeatsSheetsRequest,
-) ([]*models.BeatsSheetPreview, error) {
+) (*models.Page[*models.BeatsSheetPreview], error) {
 	ctx, span := otel.Tracer().Start(ctx, "service.ListBeatsSheets")
 	defer span.End()
 
+	sort := lo.CoalesceOrEmpty(request.Sort, models.ListSortNewest)
+
 	span.SetAttributes(
 		attribute.String("request.userID", request.UserID.String()),
 		attribute.String("request.loglineID", request.LoglineID.String()),
+		attribute.String("request.sort", sort.String()),
+		attribute.String("request.cursor", request.Cursor),
 		attribute.Int("request.limit", request.Limit),
-		attribute.Int("request.offset", request.Offset),
 	)
 
-	_, err := service.source.SelectLogline(ctx, dao.SelectLoglineData{
+	cursor, err := parseListCursor(request.Cursor, sort)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	_, err = service.source.SelectLogline(ctx, dao.SelectLoglineData{
 		ID:     request.LoglineID,
 		UserID: request.UserID,
 	})
@@ -69,8 +81,9 @@ func (service *ListBeatsSheetsService) ListBeatsSheets(
 
 	data := dao.ListBeatsSheetsData{
 		LoglineID: request.LoglineID,
-		Limit:     request.Limit,
-		Offset:    request.Offset,
+		Sort:      sort,
+		Cursor:    cursor,
+		Limit:     listLimitWithLookahead(request.Limit),
 	}
 
 	resp, err := service.source.ListBeatsSheets(ctx, data)
@@ -80,7 +93,16 @@ func (service *ListBeatsSheetsService) ListBeatsSheets(
 
 	span.SetAttributes(attribute.Int("dao.listBeatsSheets.count", len(resp)))
 
-	output := lo.Map(resp, func(item *dao.BeatsSheetPreviewEntity, _ int) *models.BeatsSheetPreview {
+	output := new(models.Page[*models.BeatsSheetPreview])
+
+	if request.Limit > 0 && len(resp) > request.Limit {
+		resp = resp[:request.Limit]
+		last := resp[len(resp)-1]
+
+		output.NextCursor = (&models.Cursor{Sort: sort, ID: last.ID, CreatedAt: last.CreatedAt}).String()
+	}
+
+	output.Items = lo.Map(resp, func(item *dao.BeatsSheetPreviewEntity, _ int) *models.BeatsSheetPreview {
 		return &models.BeatsSheetPreview{
 			ID:        item.ID,
 			Lang:      item.Lang,
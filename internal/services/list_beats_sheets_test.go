@@ -21,6 +21,8 @@ func TestListBeatsSheets(t *testing.T) {
 	errFoo := errors.New("foo")
 
 	type listBeatsSheetsData struct {
+		data dao.ListBeatsSheetsData
+
 		resp []*dao.BeatsSheetPreviewEntity
 		err  error
 	}
@@ -38,7 +40,7 @@ func TestListBeatsSheets(t *testing.T) {
 		listBeatsSheetsData *listBeatsSheetsData
 		selectLoglineData   *selectLoglineData
 
-		expect    []*models.BeatsSheetPreview
+		expect    *models.Page[*models.BeatsSheetPreview]
 		expectErr error
 	}{
 		{
@@ -48,7 +50,6 @@ func TestListBeatsSheets(t *testing.T) {
 				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
 				LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
 				Limit:     10,
-				Offset:    20,
 			},
 
 			selectLoglineData: &selectLoglineData{
@@ -64,6 +65,11 @@ func TestListBeatsSheets(t *testing.T) {
 			},
 
 			listBeatsSheetsData: &listBeatsSheetsData{
+				data: dao.ListBeatsSheetsData{
+					LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					Sort:      models.ListSortNewest,
+					Limit:     11,
+				},
 				resp: []*dao.BeatsSheetPreviewEntity{
 					{
 						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
@@ -78,18 +84,89 @@ func TestListBeatsSheets(t *testing.T) {
 				},
 			},
 
-			expect: []*models.BeatsSheetPreview{
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			expect: &models.Page[*models.BeatsSheetPreview]{
+				Items: []*models.BeatsSheetPreview{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+		},
+		{
+			name: "NextPage",
+
+			request: services.ListBeatsSheetsRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+				Sort:      models.ListSortOldest,
+				Limit:     1,
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name 2",
+					Content:   "Lorem ipsum dolor sit amet 2",
 					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			listBeatsSheetsData: &listBeatsSheetsData{
+				data: dao.ListBeatsSheetsData{
+					LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					Sort:      models.ListSortOldest,
+					Limit:     2,
 				},
-				{
+				resp: []*dao.BeatsSheetPreviewEntity{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: &models.Page[*models.BeatsSheetPreview]{
+				Items: []*models.BeatsSheetPreview{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+				NextCursor: (&models.Cursor{
+					Sort:      models.ListSortOldest,
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Lang:      models.LangEN,
 					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
+				}).String(),
+			},
+		},
+		{
+			name: "InvalidCursor",
+
+			request: services.ListBeatsSheetsRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+				Cursor:    "foo",
+				Limit:     10,
 			},
+
+			expectErr: models.ErrInvalidCursor,
 		},
 		{
 			name: "ListError",
@@ -98,7 +175,6 @@ func TestListBeatsSheets(t *testing.T) {
 				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
 				LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
 				Limit:     10,
-				Offset:    20,
 			},
 
 			selectLoglineData: &selectLoglineData{
@@ -114,6 +190,11 @@ func TestListBeatsSheets(t *testing.T) {
 			},
 
 			listBeatsSheetsData: &listBeatsSheetsData{
+				data: dao.ListBeatsSheetsData{
+					LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					Sort:      models.ListSortNewest,
+					Limit:     11,
+				},
 				err: errFoo,
 			},
 
@@ -126,7 +207,6 @@ func TestListBeatsSheets(t *testing.T) {
 				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
 				LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
 				Limit:     10,
-				Offset:    20,
 			},
 
 			selectLoglineData: &selectLoglineData{
@@ -147,11 +227,7 @@ func TestListBeatsSheets(t *testing.T) {
 
 			if testCase.listBeatsSheetsData != nil {
 				source.EXPECT().
-					ListBeatsSheets(mock.Anything, dao.ListBeatsSheetsData{
-						LoglineID: testCase.request.LoglineID,
-						Limit:     testCase.request.Limit,
-						Offset:    testCase.request.Offset,
-					}).
+					ListBeatsSheets(mock.Anything, testCase.listBeatsSheetsData.data).
 					Return(testCase.listBeatsSheetsData.resp, testCase.listBeatsSheetsData.err)
 			}
 
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type ListLoglineRevisionsSource interface {
+	ListLoglineRevisions(ctx context.Context, data dao.ListLoglineRevisionsData) ([]*dao.LoglineRevisionEntity, error)
+	SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)
+}
+
+func NewListLoglineRevisionsServiceSource(
+	listLoglineRevisionsDAO *dao.ListLoglineRevisionsRepository,
+	selectLoglineDAO *dao.SelectLoglineRepository,
+) ListLoglineRevisionsSource {
+	return &struct {
+		*dao.ListLoglineRevisionsRepository
+		*dao.SelectLoglineRepository
+	}{
+		ListLoglineRevisionsRepository: listLoglineRevisionsDAO,
+		SelectLoglineRepository:        selectLoglineDAO,
+	}
+}
+
+type ListLoglineRevisionsRequest struct {
+	UserID    uuid.UUID
+	LoglineID uuid.UUID
+	Limit     int
+	Offset    int
+}
+
+type ListLoglineRevisionsService struct {
+	source ListLoglineRevisionsSource
+}
+
+func NewListLoglineRevisionsService(source ListLoglineRevisionsSource) *ListLoglineRevisionsService {
+	return &ListLoglineRevisionsService{source: source}
+}
+
+func (service *ListLoglineRevisionsService) ListLoglineRevisions(
+	ctx context.Context, request ListLoglineRevisionsRequest,
+) ([]*models.LoglineRevision, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ListLoglineRevisions")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.loglineID", request.LoglineID.String()),
+		attribute.Int("request.limit", request.Limit),
+		attribute.Int("request.offset", request.Offset),
+	)
+
+	_, err := service.source.SelectLogline(ctx, dao.SelectLoglineData{
+		ID:     request.LoglineID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	resp, err := service.source.ListLoglineRevisions(ctx, dao.ListLoglineRevisionsData{
+		LoglineID: request.LoglineID,
+		Limit:     request.Limit,
+		Offset:    request.Offset,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(attribute.Int("dao.listLoglineRevisions.count", len(resp)))
+
+	output := lo.Map(resp, func(item *dao.LoglineRevisionEntity, _ int) *models.LoglineRevision {
+		return loglineRevisionEntityToModel(item)
+	})
+
+	return otel.ReportSuccess(span, output), nil
+}
@@ -0,0 +1,178 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestListLoglineRevisions(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type listLoglineRevisionsData struct {
+		resp []*dao.LoglineRevisionEntity
+		err  error
+	}
+
+	type selectLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	logline := &dao.LoglineEntity{
+		ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Slug:      "test-slug",
+		Name:      "Test Name",
+		Content:   "Lorem ipsum dolor sit amet, consectetur adipiscing elit",
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.ListLoglineRevisionsRequest
+
+		listLoglineRevisionsData *listLoglineRevisionsData
+		selectLoglineData        *selectLoglineData
+
+		expect    []*models.LoglineRevision
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.ListLoglineRevisionsRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+				Limit:     10,
+				Offset:    20,
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: logline,
+			},
+
+			listLoglineRevisionsData: &listLoglineRevisionsData{
+				resp: []*dao.LoglineRevisionEntity{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+						Name:      "Test Name",
+						Content:   "Lorem ipsum dolor sit amet, consectetur adipiscing elit",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+						Name:      "Test Name",
+						Content:   "Lorem ipsum dolor sit amet",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: []*models.LoglineRevision{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet, consectetur adipiscing elit",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "ListError",
+
+			request: services.ListLoglineRevisionsRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: logline,
+			},
+
+			listLoglineRevisionsData: &listLoglineRevisionsData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "CheckLoglineError",
+
+			request: services.ListLoglineRevisionsRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+			},
+
+			selectLoglineData: &selectLoglineData{
+				err: dao.ErrLoglineNotFound,
+			},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockListLoglineRevisionsSource(t)
+
+			if testCase.listLoglineRevisionsData != nil {
+				source.EXPECT().
+					ListLoglineRevisions(mock.Anything, dao.ListLoglineRevisionsData{
+						LoglineID: testCase.request.LoglineID,
+						Limit:     testCase.request.Limit,
+						Offset:    testCase.request.Offset,
+					}).
+					Return(testCase.listLoglineRevisionsData.resp, testCase.listLoglineRevisionsData.err)
+			}
+
+			if testCase.selectLoglineData != nil {
+				source.EXPECT().
+					SelectLogline(mock.Anything, dao.SelectLoglineData{
+						ID:     testCase.request.LoglineID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectLoglineData.resp, testCase.selectLoglineData.err)
+			}
+
+			service := services.NewListLoglineRevisionsService(source)
+
+			resp, err := service.ListLoglineRevisions(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type ListLoglineTagsSource interface {
+	ListLoglineTags(ctx context.Context, data dao.ListLoglineTagsData) ([]*dao.LoglineTagEntity, error)
+}
+
+type ListLoglineTagsRequest struct {
+	UserID uuid.UUID
+}
+
+type ListLoglineTagsService struct {
+	source ListLoglineTagsSource
+}
+
+func NewListLoglineTagsService(source ListLoglineTagsSource) *ListLoglineTagsService {
+	return &ListLoglineTagsService{source: source}
+}
+
+func (service *ListLoglineTagsService) ListLoglineTags(
+	ctx context.Context, request ListLoglineTagsRequest,
+) ([]*models.LoglineTag, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ListLoglineTags")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("request.userID", request.UserID.String()))
+
+	resp, err := service.source.ListLoglineTags(ctx, dao.ListLoglineTagsData{
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list logline tags: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("dao.listLoglineTags.count", len(resp)))
+
+	output := lo.Map(resp, func(item *dao.LoglineTagEntity, _ int) *models.LoglineTag {
+		return &models.LoglineTag{
+			Tag:   item.Tag,
+			Count: item.Count,
+		}
+	})
+
+	return otel.ReportSuccess(span, output), nil
+}
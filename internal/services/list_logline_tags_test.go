@@ -0,0 +1,105 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestListLoglineTags(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type listLoglineTagsData struct {
+		resp []*dao.LoglineTagEntity
+		err  error
+	}
+
+	request := services.ListLoglineTagsRequest{
+		UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.ListLoglineTagsRequest
+
+		listLoglineTagsData *listLoglineTagsData
+
+		expect    []*models.LoglineTag
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			listLoglineTagsData: &listLoglineTagsData{
+				resp: []*dao.LoglineTagEntity{
+					{Tag: "quest", Count: 3},
+					{Tag: "dragons", Count: 1},
+				},
+			},
+
+			expect: []*models.LoglineTag{
+				{Tag: "quest", Count: 3},
+				{Tag: "dragons", Count: 1},
+			},
+		},
+		{
+			name: "Empty",
+
+			request: request,
+
+			listLoglineTagsData: &listLoglineTagsData{
+				resp: []*dao.LoglineTagEntity{},
+			},
+
+			expect: []*models.LoglineTag{},
+		},
+		{
+			name: "Error",
+
+			request: request,
+
+			listLoglineTagsData: &listLoglineTagsData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockListLoglineTagsSource(t)
+
+			if testCase.listLoglineTagsData != nil {
+				source.EXPECT().
+					ListLoglineTags(mock.Anything, dao.ListLoglineTagsData{
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.listLoglineTagsData.resp, testCase.listLoglineTagsData.err)
+			}
+
+			service := services.NewListLoglineTagsService(source)
+
+			resp, err := service.ListLoglineTags(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -19,8 +20,18 @@ type ListLoglinesSource interface {
 
 type ListLoglinesRequest struct {
 	UserID uuid.UUID
-	Limit  int
-	Offset int
+	// Defaults to models.ListSortNewest.
+	Sort models.ListSort
+	// The cursor returned with the previous page. Leave empty to get the first page.
+	Cursor string
+	// When 0, every remaining logline is returned at once.
+	Limit int
+
+	// Optional filters. When tags are given, only the loglines carrying all of them are returned.
+	Genre     models.Genre
+	Lang      models.Lang
+	Tags      []string
+	ProjectID uuid.UUID
 }
 
 type ListLoglinesService struct {
@@ -33,20 +44,51 @@ func NewListLoglinesService(source ListLoglinesSource) *ListLoglinesService {
 
 func (service *ListLoglinesService) ListLoglines(
 	ctx context.Context, request ListLoglinesRequest,
-) ([]*models.LoglinePreview, error) {
+) (*models.Page[*models.LoglinePreview], error) {
 	ctx, span := otel.Tracer().Start(ctx, "service.ListLoglines")
 	defer span.End()
 
+	sort := lo.CoalesceOrEmpty(request.Sort, models.ListSortNewest)
+
 	span.SetAttributes(
 		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.sort", sort.String()),
+		attribute.String("request.cursor", request.Cursor),
 		attribute.Int("request.limit", request.Limit),
-		attribute.Int("request.offset", request.Offset),
+		attribute.String("request.genre", request.Genre.String()),
+		attribute.String("request.lang", request.Lang.String()),
+		attribute.StringSlice("request.tags", request.Tags),
+		attribute.String("request.projectID", request.ProjectID.String()),
 	)
 
+	cursor, err := parseListCursor(request.Cursor, sort)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = models.CheckGenre(request.Genre)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check genre: %w", err))
+	}
+
+	var tags []string
+
+	if len(request.Tags) > 0 {
+		tags, err = models.NormalizeTags(request.Tags)
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("normalize tags: %w", err))
+		}
+	}
+
 	resp, err := service.source.ListLoglines(ctx, dao.ListLoglinesData{
-		UserID: request.UserID,
-		Limit:  request.Limit,
-		Offset: request.Offset,
+		UserID:    request.UserID,
+		Sort:      sort,
+		Cursor:    cursor,
+		Limit:     listLimitWithLookahead(request.Limit),
+		Genre:     request.Genre,
+		Lang:      request.Lang,
+		Tags:      tags,
+		ProjectID: request.ProjectID,
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, err)
@@ -54,15 +96,56 @@ func (service *ListLoglinesService) ListLoglines(
 
 	span.SetAttributes(attribute.Int("dao.listLoglines.count", len(resp)))
 
-	output := lo.Map(resp, func(item *dao.LoglinePreviewEntity, _ int) *models.LoglinePreview {
+	output := new(models.Page[*models.LoglinePreview])
+
+	if request.Limit > 0 && len(resp) > request.Limit {
+		resp = resp[:request.Limit]
+		last := resp[len(resp)-1]
+
+		output.NextCursor = (&models.Cursor{
+			Sort:      sort,
+			ID:        last.ID,
+			CreatedAt: last.CreatedAt,
+			Name:      lo.Ternary(sort == models.ListSortName, last.Name, ""),
+		}).String()
+	}
+
+	output.Items = lo.Map(resp, func(item *dao.LoglinePreviewEntity, _ int) *models.LoglinePreview {
 		return &models.LoglinePreview{
 			Slug:      item.Slug,
+			ProjectID: item.ProjectID,
 			Name:      item.Name,
 			Content:   item.Content,
 			Lang:      item.Lang,
+			Genre:     item.Genre,
+			Tags:      item.Tags,
 			CreatedAt: item.CreatedAt,
 		}
 	})
 
 	return otel.ReportSuccess(span, output), nil
 }
+
+// parseListCursor decodes the cursor of a list request. The cursor must have been issued for the requested sort,
+// since its position means nothing in another order. An empty cursor starts from the beginning of the list.
+func parseListCursor(token string, sort models.ListSort) (*models.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	cursor, err := models.ParseCursor(token)
+	if err != nil {
+		return nil, fmt.Errorf("parse cursor: %w", err)
+	}
+
+	if cursor.Sort != sort {
+		return nil, fmt.Errorf("%w: cursor was issued for sort %s, not %s", models.ErrInvalidCursor, cursor.Sort, sort)
+	}
+
+	return cursor, nil
+}
+
+// listLimitWithLookahead requests one more item than the page holds, to tell whether another page follows it.
+func listLimitWithLookahead(limit int) int {
+	return lo.Ternary(limit > 0, limit+1, 0)
+}
@@ -21,10 +21,70 @@ func TestListLoglines(t *testing.T) {
 	errFoo := errors.New("foo")
 
 	type listLoglinesData struct {
+		data dao.ListLoglinesData
+
 		resp []*dao.LoglinePreviewEntity
 		err  error
 	}
 
+	entities := []*dao.LoglinePreviewEntity{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Slug:      "test-slug",
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			Slug:      "test-slug-3",
+			Name:      "Test Name 3",
+			Content:   "Lorem ipsum dolor sit amet 3",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Slug:      "test-slug-2",
+			Name:      "Test Name 2",
+			Content:   "Lorem ipsum dolor sit amet 2",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	previews := []*models.LoglinePreview{
+		{
+			Slug:      "test-slug",
+			Name:      "Test Name",
+			Content:   "Lorem ipsum dolor sit amet",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Slug:      "test-slug-3",
+			Name:      "Test Name 3",
+			Content:   "Lorem ipsum dolor sit amet 3",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Slug:      "test-slug-2",
+			Name:      "Test Name 2",
+			Content:   "Lorem ipsum dolor sit amet 2",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	nameCursor := &models.Cursor{
+		Sort:      models.ListSortName,
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		Name:      "Test Name 3",
+	}
+
 	testCases := []struct {
 		name string
 
@@ -32,7 +92,7 @@ func TestListLoglines(t *testing.T) {
 
 		listLoglinesData *listLoglinesData
 
-		expect    []*models.LoglinePreview
+		expect    *models.Page[*models.LoglinePreview]
 		expectErr error
 	}{
 		{
@@ -41,58 +101,120 @@ func TestListLoglines(t *testing.T) {
 			request: services.ListLoglinesRequest{
 				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
 				Limit:  10,
-				Offset: 20,
 			},
 
 			listLoglinesData: &listLoglinesData{
-				resp: []*dao.LoglinePreviewEntity{
-					{
-						Slug:      "test-slug",
-						Name:      "Test Name",
-						Content:   "Lorem ipsum dolor sit amet",
-						Lang:      models.LangEN,
-						CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
-					},
-					{
-						Slug:      "test-slug-3",
-						Name:      "Test Name 3",
-						Content:   "Lorem ipsum dolor sit amet 3",
-						Lang:      models.LangEN,
-						CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
-					},
-					{
-						Slug:      "test-slug-2",
-						Name:      "Test Name 2",
-						Content:   "Lorem ipsum dolor sit amet 2",
-						Lang:      models.LangEN,
-						CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
-					},
+				data: dao.ListLoglinesData{
+					UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Sort:   models.ListSortNewest,
+					Limit:  11,
 				},
+				resp: entities,
 			},
 
-			expect: []*models.LoglinePreview{
-				{
-					Slug:      "test-slug",
-					Name:      "Test Name",
-					Content:   "Lorem ipsum dolor sit amet",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+			expect: &models.Page[*models.LoglinePreview]{Items: previews},
+		},
+		{
+			name: "NextPage",
+
+			request: services.ListLoglinesRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Sort:   models.ListSortName,
+				Limit:  2,
+			},
+
+			listLoglinesData: &listLoglinesData{
+				data: dao.ListLoglinesData{
+					UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Sort:   models.ListSortName,
+					Limit:  3,
 				},
-				{
-					Slug:      "test-slug-3",
-					Name:      "Test Name 3",
-					Content:   "Lorem ipsum dolor sit amet 3",
-					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				resp: entities,
+			},
+
+			expect: &models.Page[*models.LoglinePreview]{
+				Items:      previews[:2],
+				NextCursor: nameCursor.String(),
+			},
+		},
+		{
+			name: "Cursor",
+
+			request: services.ListLoglinesRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Sort:   models.ListSortName,
+				Cursor: nameCursor.String(),
+				Limit:  2,
+			},
+
+			listLoglinesData: &listLoglinesData{
+				data: dao.ListLoglinesData{
+					UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Sort:   models.ListSortName,
+					Cursor: nameCursor,
+					Limit:  3,
 				},
-				{
-					Slug:      "test-slug-2",
-					Name:      "Test Name 2",
-					Content:   "Lorem ipsum dolor sit amet 2",
+				resp: entities[2:],
+			},
+
+			expect: &models.Page[*models.LoglinePreview]{Items: previews[2:]},
+		},
+		{
+			name: "Cursor/OtherSort",
+
+			request: services.ListLoglinesRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Cursor: nameCursor.String(),
+				Limit:  2,
+			},
+
+			expectErr: models.ErrInvalidCursor,
+		},
+		{
+			name: "Cursor/Invalid",
+
+			request: services.ListLoglinesRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Cursor: "foo",
+				Limit:  2,
+			},
+
+			expectErr: models.ErrInvalidCursor,
+		},
+		{
+			name: "Filters",
+
+			request: services.ListLoglinesRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Genre:     models.GenreFantasy,
+				Lang:      models.LangEN,
+				Tags:      []string{"Quest", " dragons"},
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+
+			listLoglinesData: &listLoglinesData{
+				data: dao.ListLoglinesData{
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Sort:      models.ListSortNewest,
+					Genre:     models.GenreFantasy,
 					Lang:      models.LangEN,
-					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					Tags:      []string{"dragons", "quest"},
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 				},
+				resp: entities,
 			},
+
+			expect: &models.Page[*models.LoglinePreview]{Items: previews},
+		},
+		{
+			name: "UnsupportedGenre",
+
+			request: services.ListLoglinesRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Genre:  "space-western",
+			},
+
+			expectErr: models.ErrUnsupportedGenre,
 		},
 		{
 			name: "Error",
@@ -100,10 +222,14 @@ func TestListLoglines(t *testing.T) {
 			request: services.ListLoglinesRequest{
 				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
 				Limit:  10,
-				Offset: 20,
 			},
 
 			listLoglinesData: &listLoglinesData{
+				data: dao.ListLoglinesData{
+					UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Sort:   models.ListSortNewest,
+					Limit:  11,
+				},
 				err: errFoo,
 			},
 
@@ -121,11 +247,7 @@ func TestListLoglines(t *testing.T) {
 
 			if testCase.listLoglinesData != nil {
 				source.EXPECT().
-					ListLoglines(mock.Anything, dao.ListLoglinesData{
-						UserID: testCase.request.UserID,
-						Limit:  testCase.request.Limit,
-						Offset: testCase.request.Offset,
-					}).
+					ListLoglines(mock.Anything, testCase.listLoglinesData.data).
 					Return(testCase.listLoglinesData.resp, testCase.listLoglinesData.err)
 			}
 
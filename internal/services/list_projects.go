@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type ListProjectsSource interface {
+	ListProjects(ctx context.Context, data dao.ListProjectsData) ([]*dao.ProjectEntity, error)
+}
+
+type ListProjectsRequest struct {
+	UserID uuid.UUID
+	// The cursor returned with the previous page. Leave empty to get the first page.
+	Cursor string
+	// When 0, every remaining project is returned at once.
+	Limit int
+}
+
+type ListProjectsService struct {
+	source ListProjectsSource
+}
+
+func NewListProjectsService(source ListProjectsSource) *ListProjectsService {
+	return &ListProjectsService{source: source}
+}
+
+func (service *ListProjectsService) ListProjects(
+	ctx context.Context, request ListProjectsRequest,
+) (*models.Page[*models.Project], error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ListProjects")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.cursor", request.Cursor),
+		attribute.Int("request.limit", request.Limit),
+	)
+
+	// Projects are always sorted by name.
+	cursor, err := parseListCursor(request.Cursor, models.ListSortName)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	resp, err := service.source.ListProjects(ctx, dao.ListProjectsData{
+		UserID: request.UserID,
+		Cursor: cursor,
+		Limit:  listLimitWithLookahead(request.Limit),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(attribute.Int("dao.listProjects.count", len(resp)))
+
+	output := new(models.Page[*models.Project])
+
+	if request.Limit > 0 && len(resp) > request.Limit {
+		resp = resp[:request.Limit]
+		last := resp[len(resp)-1]
+
+		output.NextCursor = (&models.Cursor{
+			Sort:      models.ListSortName,
+			ID:        last.ID,
+			CreatedAt: last.CreatedAt,
+			Name:      last.Name,
+		}).String()
+	}
+
+	output.Items = lo.Map(resp, func(item *dao.ProjectEntity, _ int) *models.Project {
+		return &models.Project{
+			ID:          item.ID,
+			UserID:      item.UserID,
+			Name:        item.Name,
+			Description: item.Description,
+			CreatedAt:   item.CreatedAt,
+			UpdatedAt:   item.UpdatedAt,
+		}
+	})
+
+	return otel.ReportSuccess(span, output), nil
+}
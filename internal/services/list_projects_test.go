@@ -0,0 +1,205 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestListProjects(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type listProjectsData struct {
+		data dao.ListProjectsData
+
+		resp []*dao.ProjectEntity
+		err  error
+	}
+
+	entities := []*dao.ProjectEntity{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Name:      "A Mountain Saga",
+			CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Name:        "The Sea Trilogy",
+			Description: "Three novels about the sea.",
+			CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	projects := []*models.Project{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Name:      "A Mountain Saga",
+			CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Name:        "The Sea Trilogy",
+			Description: "Three novels about the sea.",
+			CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	cursor := &models.Cursor{
+		Sort:      models.ListSortName,
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		Name:      "A Mountain Saga",
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.ListProjectsRequest
+
+		listProjectsData *listProjectsData
+
+		expect    *models.Page[*models.Project]
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.ListProjectsRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Limit:  10,
+			},
+
+			listProjectsData: &listProjectsData{
+				data: dao.ListProjectsData{
+					UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Limit:  11,
+				},
+				resp: entities,
+			},
+
+			expect: &models.Page[*models.Project]{Items: projects},
+		},
+		{
+			name: "NextPage",
+
+			request: services.ListProjectsRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Limit:  1,
+			},
+
+			listProjectsData: &listProjectsData{
+				data: dao.ListProjectsData{
+					UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Limit:  2,
+				},
+				resp: entities,
+			},
+
+			expect: &models.Page[*models.Project]{
+				Items:      projects[:1],
+				NextCursor: cursor.String(),
+			},
+		},
+		{
+			name: "Cursor",
+
+			request: services.ListProjectsRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Cursor: cursor.String(),
+				Limit:  1,
+			},
+
+			listProjectsData: &listProjectsData{
+				data: dao.ListProjectsData{
+					UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Cursor: cursor,
+					Limit:  2,
+				},
+				resp: entities[1:],
+			},
+
+			expect: &models.Page[*models.Project]{Items: projects[1:]},
+		},
+		{
+			name: "Cursor/OtherSort",
+
+			request: services.ListProjectsRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Cursor: (&models.Cursor{Sort: models.ListSortNewest, ID: cursor.ID}).String(),
+				Limit:  1,
+			},
+
+			expectErr: models.ErrInvalidCursor,
+		},
+		{
+			name: "Cursor/Invalid",
+
+			request: services.ListProjectsRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Cursor: "foo",
+				Limit:  1,
+			},
+
+			expectErr: models.ErrInvalidCursor,
+		},
+		{
+			name: "Error",
+
+			request: services.ListProjectsRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Limit:  10,
+			},
+
+			listProjectsData: &listProjectsData{
+				data: dao.ListProjectsData{
+					UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Limit:  11,
+				},
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockListProjectsSource(t)
+
+			if testCase.listProjectsData != nil {
+				source.EXPECT().
+					ListProjects(mock.Anything, testCase.listProjectsData.data).
+					Return(testCase.listProjectsData.resp, testCase.listProjectsData.err)
+			}
+
+			service := services.NewListProjectsService(source)
+
+			resp, err := service.ListProjects(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
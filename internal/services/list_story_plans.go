@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type ListStoryPlansSource interface {
+	ListStoryPlans(ctx context.Context, data dao.ListStoryPlansData) ([]*dao.StoryPlanPreviewEntity, error)
+}
+
+type ListStoryPlansRequest struct {
+	UserID uuid.UUID
+	Limit  int
+	Offset int
+}
+
+type ListStoryPlansService struct {
+	source ListStoryPlansSource
+}
+
+func NewListStoryPlansService(source ListStoryPlansSource) *ListStoryPlansService {
+	return &ListStoryPlansService{source: source}
+}
+
+func (service *ListStoryPlansService) ListStoryPlans(
+	ctx context.Context, request ListStoryPlansRequest,
+) ([]*storyplanmodel.Metadata, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ListStoryPlans")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.Int("request.limit", request.Limit),
+		attribute.Int("request.offset", request.Offset),
+	)
+
+	resp, err := service.source.ListStoryPlans(ctx, dao.ListStoryPlansData{
+		UserID: request.UserID,
+		Limit:  request.Limit,
+		Offset: request.Offset,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(attribute.Int("dao.listStoryPlans.count", len(resp)))
+
+	output := lo.Map(resp, func(item *dao.StoryPlanPreviewEntity, _ int) *storyplanmodel.Metadata {
+		return &storyplanmodel.Metadata{
+			ID:        item.ID,
+			UserID:    item.UserID,
+			Slug:      item.Slug,
+			Version:   item.Version,
+			Name:      item.Name,
+			Lang:      item.Lang,
+			CreatedAt: item.CreatedAt,
+		}
+	})
+
+	return otel.ReportSuccess(span, output), nil
+}
@@ -0,0 +1,129 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestListStoryPlans(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type listStoryPlansData struct {
+		resp []*dao.StoryPlanPreviewEntity
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.ListStoryPlansRequest
+
+		listStoryPlansData *listStoryPlansData
+
+		expect    []*storyplanmodel.Metadata
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.ListStoryPlansRequest{
+				Limit:  10,
+				Offset: 2,
+			},
+
+			listStoryPlansData: &listStoryPlansData{
+				resp: []*dao.StoryPlanPreviewEntity{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Slug:      "test-slug",
+						Version:   1,
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Slug:      "test-slug",
+						Version:   1,
+						Name:      "Nom de Test",
+						Lang:      models.LangFR,
+						CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: []*storyplanmodel.Metadata{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "test-slug",
+					Version:   1,
+					Name:      "Test Name",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Slug:      "test-slug",
+					Version:   1,
+					Name:      "Nom de Test",
+					Lang:      models.LangFR,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Error",
+
+			request: services.ListStoryPlansRequest{
+				Limit:  10,
+				Offset: 2,
+			},
+
+			listStoryPlansData: &listStoryPlansData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockListStoryPlansSource(t)
+
+			if testCase.listStoryPlansData != nil {
+				source.EXPECT().
+					ListStoryPlans(mock.Anything, dao.ListStoryPlansData{
+						Limit:  testCase.request.Limit,
+						Offset: testCase.request.Offset,
+					}).
+					Return(testCase.listStoryPlansData.resp, testCase.listStoryPlansData.err)
+			}
+
+			service := services.NewListStoryPlansService(source)
+
+			resp, err := service.ListStoryPlans(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
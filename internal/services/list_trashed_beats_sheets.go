@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type ListTrashedBeatsSheetsSource interface {
+	ListTrashedBeatsSheets(
+		ctx context.Context, data dao.ListTrashedBeatsSheetsData,
+	) ([]*dao.TrashedBeatsSheetEntity, error)
+}
+
+type ListTrashedBeatsSheetsRequest struct {
+	UserID uuid.UUID
+	Limit  int
+	Offset int
+}
+
+type ListTrashedBeatsSheetsService struct {
+	source ListTrashedBeatsSheetsSource
+}
+
+func NewListTrashedBeatsSheetsService(source ListTrashedBeatsSheetsSource) *ListTrashedBeatsSheetsService {
+	return &ListTrashedBeatsSheetsService{source: source}
+}
+
+func (service *ListTrashedBeatsSheetsService) ListTrashedBeatsSheets(
+	ctx context.Context, request ListTrashedBeatsSheetsRequest,
+) ([]*models.TrashedBeatsSheet, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ListTrashedBeatsSheets")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.Int("request.limit", request.Limit),
+		attribute.Int("request.offset", request.Offset),
+	)
+
+	resp, err := service.source.ListTrashedBeatsSheets(ctx, dao.ListTrashedBeatsSheetsData{
+		UserID: request.UserID,
+		Limit:  request.Limit,
+		Offset: request.Offset,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list trashed beats sheets: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("dao.listTrashedBeatsSheets.count", len(resp)))
+
+	output := lo.Map(resp, func(item *dao.TrashedBeatsSheetEntity, _ int) *models.TrashedBeatsSheet {
+		return &models.TrashedBeatsSheet{
+			ID:        item.ID,
+			LoglineID: item.LoglineID,
+			Lang:      item.Lang,
+			CreatedAt: item.CreatedAt,
+			DeletedAt: item.DeletedAt,
+		}
+	})
+
+	return otel.ReportSuccess(span, output), nil
+}
@@ -0,0 +1,123 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestListTrashedBeatsSheets(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type listTrashedBeatsSheetsData struct {
+		resp []*dao.TrashedBeatsSheetEntity
+		err  error
+	}
+
+	request := services.ListTrashedBeatsSheetsRequest{
+		UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Limit:  10,
+		Offset: 20,
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.ListTrashedBeatsSheetsRequest
+
+		listTrashedBeatsSheetsData *listTrashedBeatsSheetsData
+
+		expect    []*models.TrashedBeatsSheet
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			listTrashedBeatsSheetsData: &listTrashedBeatsSheetsData{
+				resp: []*dao.TrashedBeatsSheetEntity{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						DeletedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Lang:      models.LangFR,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: []*models.TrashedBeatsSheet{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Lang:      models.LangFR,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Error",
+
+			request: request,
+
+			listTrashedBeatsSheetsData: &listTrashedBeatsSheetsData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockListTrashedBeatsSheetsSource(t)
+
+			if testCase.listTrashedBeatsSheetsData != nil {
+				source.EXPECT().
+					ListTrashedBeatsSheets(mock.Anything, dao.ListTrashedBeatsSheetsData{
+						UserID: testCase.request.UserID,
+						Limit:  testCase.request.Limit,
+						Offset: testCase.request.Offset,
+					}).
+					Return(testCase.listTrashedBeatsSheetsData.resp, testCase.listTrashedBeatsSheetsData.err)
+			}
+
+			service := services.NewListTrashedBeatsSheetsService(source)
+
+			resp, err := service.ListTrashedBeatsSheets(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type ListTrashedLoglinesSource interface {
+	ListTrashedLoglines(ctx context.Context, data dao.ListTrashedLoglinesData) ([]*dao.LoglineEntity, error)
+}
+
+type ListTrashedLoglinesRequest struct {
+	UserID uuid.UUID
+	Limit  int
+	Offset int
+}
+
+type ListTrashedLoglinesService struct {
+	source ListTrashedLoglinesSource
+}
+
+func NewListTrashedLoglinesService(source ListTrashedLoglinesSource) *ListTrashedLoglinesService {
+	return &ListTrashedLoglinesService{source: source}
+}
+
+func (service *ListTrashedLoglinesService) ListTrashedLoglines(
+	ctx context.Context, request ListTrashedLoglinesRequest,
+) ([]*models.Logline, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ListTrashedLoglines")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.Int("request.limit", request.Limit),
+		attribute.Int("request.offset", request.Offset),
+	)
+
+	resp, err := service.source.ListTrashedLoglines(ctx, dao.ListTrashedLoglinesData{
+		UserID: request.UserID,
+		Limit:  request.Limit,
+		Offset: request.Offset,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list trashed loglines: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("dao.listTrashedLoglines.count", len(resp)))
+
+	output := lo.Map(resp, func(item *dao.LoglineEntity, _ int) *models.Logline {
+		return &models.Logline{
+			ID:        item.ID,
+			UserID:    item.UserID,
+			Slug:      item.Slug,
+			SourceID:  item.SourceID,
+			ProjectID: item.ProjectID,
+			Name:      item.Name,
+			Content:   item.Content,
+			Lang:      item.Lang,
+			Genre:     item.Genre,
+			Tags:      item.Tags,
+			CreatedAt: item.CreatedAt,
+			UpdatedAt: item.UpdatedAt,
+			DeletedAt: item.DeletedAt,
+		}
+	})
+
+	return otel.ReportSuccess(span, output), nil
+}
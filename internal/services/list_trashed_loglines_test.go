@@ -0,0 +1,137 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestListTrashedLoglines(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type listTrashedLoglinesData struct {
+		resp []*dao.LoglineEntity
+		err  error
+	}
+
+	request := services.ListTrashedLoglinesRequest{
+		UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Limit:  10,
+		Offset: 20,
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.ListTrashedLoglinesRequest
+
+		listTrashedLoglinesData *listTrashedLoglinesData
+
+		expect    []*models.Logline
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			listTrashedLoglinesData: &listTrashedLoglinesData{
+				resp: []*dao.LoglineEntity{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+						Slug:      "test-slug-2",
+						Name:      "Test Name 2",
+						Content:   "Lorem ipsum dolor sit amet 2",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						DeletedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+						Slug:      "test-slug",
+						Name:      "Test Name",
+						Content:   "Lorem ipsum dolor sit amet",
+						Lang:      models.LangFR,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC),
+						DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: []*models.Logline{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug-2",
+					Name:      "Test Name 2",
+					Content:   "Lorem ipsum dolor sit amet 2",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangFR,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC),
+					DeletedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Error",
+
+			request: request,
+
+			listTrashedLoglinesData: &listTrashedLoglinesData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockListTrashedLoglinesSource(t)
+
+			if testCase.listTrashedLoglinesData != nil {
+				source.EXPECT().
+					ListTrashedLoglines(mock.Anything, dao.ListTrashedLoglinesData{
+						UserID: testCase.request.UserID,
+						Limit:  testCase.request.Limit,
+						Offset: testCase.request.Offset,
+					}).
+					Return(testCase.listTrashedLoglinesData.resp, testCase.listTrashedLoglinesData.err)
+			}
+
+			service := services.NewListTrashedLoglinesService(source)
+
+			resp, err := service.ListTrashedLoglines(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
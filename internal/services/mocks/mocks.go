@@ -16,13 +16,13 @@ import (
 	mock "github.com/stretchr/testify/mock"
 )
 
-// NewMockCreateBeatsSheetSource creates a new instance of MockCreateBeatsSheetSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockConvertBeatsSheetSource creates a new instance of MockConvertBeatsSheetSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCreateBeatsSheetSource(t interface {
+func NewMockConvertBeatsSheetSource(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCreateBeatsSheetSource {
-	mock := &MockCreateBeatsSheetSource{}
+}) *MockConvertBeatsSheetSource {
+	mock := &MockConvertBeatsSheetSource{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -30,21 +30,89 @@ func NewMockCreateBeatsSheetSource(t interface {
 	return mock
 }
 
-// MockCreateBeatsSheetSource is an autogenerated mock type for the CreateBeatsSheetSource type
-type MockCreateBeatsSheetSource struct {
+// MockConvertBeatsSheetSource is an autogenerated mock type for the ConvertBeatsSheetSource type
+type MockConvertBeatsSheetSource struct {
 	mock.Mock
 }
 
-type MockCreateBeatsSheetSource_Expecter struct {
+type MockConvertBeatsSheetSource_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCreateBeatsSheetSource) EXPECT() *MockCreateBeatsSheetSource_Expecter {
-	return &MockCreateBeatsSheetSource_Expecter{mock: &_m.Mock}
+func (_m *MockConvertBeatsSheetSource) EXPECT() *MockConvertBeatsSheetSource_Expecter {
+	return &MockConvertBeatsSheetSource_Expecter{mock: &_m.Mock}
 }
 
-// InsertBeatsSheet provides a mock function for the type MockCreateBeatsSheetSource
-func (_mock *MockCreateBeatsSheetSource) InsertBeatsSheet(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error) {
+// ConvertBeatsSheet provides a mock function for the type MockConvertBeatsSheetSource
+func (_mock *MockConvertBeatsSheetSource) ConvertBeatsSheet(ctx context.Context, request daoai.ConvertBeatsSheetRequest) ([]models.Beat, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertBeatsSheet")
+	}
+
+	var r0 []models.Beat
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.ConvertBeatsSheetRequest) ([]models.Beat, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.ConvertBeatsSheetRequest) []models.Beat); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Beat)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.ConvertBeatsSheetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConvertBeatsSheetSource_ConvertBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertBeatsSheet'
+type MockConvertBeatsSheetSource_ConvertBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// ConvertBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request daoai.ConvertBeatsSheetRequest
+func (_e *MockConvertBeatsSheetSource_Expecter) ConvertBeatsSheet(ctx interface{}, request interface{}) *MockConvertBeatsSheetSource_ConvertBeatsSheet_Call {
+	return &MockConvertBeatsSheetSource_ConvertBeatsSheet_Call{Call: _e.mock.On("ConvertBeatsSheet", ctx, request)}
+}
+
+func (_c *MockConvertBeatsSheetSource_ConvertBeatsSheet_Call) Run(run func(ctx context.Context, request daoai.ConvertBeatsSheetRequest)) *MockConvertBeatsSheetSource_ConvertBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 daoai.ConvertBeatsSheetRequest
+		if args[1] != nil {
+			arg1 = args[1].(daoai.ConvertBeatsSheetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConvertBeatsSheetSource_ConvertBeatsSheet_Call) Return(beats []models.Beat, err error) *MockConvertBeatsSheetSource_ConvertBeatsSheet_Call {
+	_c.Call.Return(beats, err)
+	return _c
+}
+
+func (_c *MockConvertBeatsSheetSource_ConvertBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request daoai.ConvertBeatsSheetRequest) ([]models.Beat, error)) *MockConvertBeatsSheetSource_ConvertBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertBeatsSheet provides a mock function for the type MockConvertBeatsSheetSource
+func (_mock *MockConvertBeatsSheetSource) InsertBeatsSheet(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
@@ -71,19 +139,19 @@ func (_mock *MockCreateBeatsSheetSource) InsertBeatsSheet(ctx context.Context, d
 	return r0, r1
 }
 
-// MockCreateBeatsSheetSource_InsertBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertBeatsSheet'
-type MockCreateBeatsSheetSource_InsertBeatsSheet_Call struct {
+// MockConvertBeatsSheetSource_InsertBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertBeatsSheet'
+type MockConvertBeatsSheetSource_InsertBeatsSheet_Call struct {
 	*mock.Call
 }
 
 // InsertBeatsSheet is a helper method to define mock.On call
 //   - ctx context.Context
 //   - data dao.InsertBeatsSheetData
-func (_e *MockCreateBeatsSheetSource_Expecter) InsertBeatsSheet(ctx interface{}, data interface{}) *MockCreateBeatsSheetSource_InsertBeatsSheet_Call {
-	return &MockCreateBeatsSheetSource_InsertBeatsSheet_Call{Call: _e.mock.On("InsertBeatsSheet", ctx, data)}
+func (_e *MockConvertBeatsSheetSource_Expecter) InsertBeatsSheet(ctx interface{}, data interface{}) *MockConvertBeatsSheetSource_InsertBeatsSheet_Call {
+	return &MockConvertBeatsSheetSource_InsertBeatsSheet_Call{Call: _e.mock.On("InsertBeatsSheet", ctx, data)}
 }
 
-func (_c *MockCreateBeatsSheetSource_InsertBeatsSheet_Call) Run(run func(ctx context.Context, data dao.InsertBeatsSheetData)) *MockCreateBeatsSheetSource_InsertBeatsSheet_Call {
+func (_c *MockConvertBeatsSheetSource_InsertBeatsSheet_Call) Run(run func(ctx context.Context, data dao.InsertBeatsSheetData)) *MockConvertBeatsSheetSource_InsertBeatsSheet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -101,18 +169,86 @@ func (_c *MockCreateBeatsSheetSource_InsertBeatsSheet_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *MockCreateBeatsSheetSource_InsertBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockCreateBeatsSheetSource_InsertBeatsSheet_Call {
+func (_c *MockConvertBeatsSheetSource_InsertBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockConvertBeatsSheetSource_InsertBeatsSheet_Call {
 	_c.Call.Return(beatsSheetEntity, err)
 	return _c
 }
 
-func (_c *MockCreateBeatsSheetSource_InsertBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)) *MockCreateBeatsSheetSource_InsertBeatsSheet_Call {
+func (_c *MockConvertBeatsSheetSource_InsertBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)) *MockConvertBeatsSheetSource_InsertBeatsSheet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectLogline provides a mock function for the type MockCreateBeatsSheetSource
-func (_mock *MockCreateBeatsSheetSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+// SelectBeatsSheet provides a mock function for the type MockConvertBeatsSheetSource
+func (_mock *MockConvertBeatsSheetSource) SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectBeatsSheet")
+	}
+
+	var r0 *dao.BeatsSheetEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.BeatsSheetEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.BeatsSheetEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConvertBeatsSheetSource_SelectBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectBeatsSheet'
+type MockConvertBeatsSheetSource_SelectBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// SelectBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data uuid.UUID
+func (_e *MockConvertBeatsSheetSource_Expecter) SelectBeatsSheet(ctx interface{}, data interface{}) *MockConvertBeatsSheetSource_SelectBeatsSheet_Call {
+	return &MockConvertBeatsSheetSource_SelectBeatsSheet_Call{Call: _e.mock.On("SelectBeatsSheet", ctx, data)}
+}
+
+func (_c *MockConvertBeatsSheetSource_SelectBeatsSheet_Call) Run(run func(ctx context.Context, data uuid.UUID)) *MockConvertBeatsSheetSource_SelectBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConvertBeatsSheetSource_SelectBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockConvertBeatsSheetSource_SelectBeatsSheet_Call {
+	_c.Call.Return(beatsSheetEntity, err)
+	return _c
+}
+
+func (_c *MockConvertBeatsSheetSource_SelectBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)) *MockConvertBeatsSheetSource_SelectBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectLogline provides a mock function for the type MockConvertBeatsSheetSource
+func (_mock *MockConvertBeatsSheetSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
@@ -139,19 +275,19 @@ func (_mock *MockCreateBeatsSheetSource) SelectLogline(ctx context.Context, data
 	return r0, r1
 }
 
-// MockCreateBeatsSheetSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
-type MockCreateBeatsSheetSource_SelectLogline_Call struct {
+// MockConvertBeatsSheetSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockConvertBeatsSheetSource_SelectLogline_Call struct {
 	*mock.Call
 }
 
 // SelectLogline is a helper method to define mock.On call
 //   - ctx context.Context
 //   - data dao.SelectLoglineData
-func (_e *MockCreateBeatsSheetSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockCreateBeatsSheetSource_SelectLogline_Call {
-	return &MockCreateBeatsSheetSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+func (_e *MockConvertBeatsSheetSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockConvertBeatsSheetSource_SelectLogline_Call {
+	return &MockConvertBeatsSheetSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
 }
 
-func (_c *MockCreateBeatsSheetSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockCreateBeatsSheetSource_SelectLogline_Call {
+func (_c *MockConvertBeatsSheetSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockConvertBeatsSheetSource_SelectLogline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -169,18 +305,18 @@ func (_c *MockCreateBeatsSheetSource_SelectLogline_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *MockCreateBeatsSheetSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockCreateBeatsSheetSource_SelectLogline_Call {
+func (_c *MockConvertBeatsSheetSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockConvertBeatsSheetSource_SelectLogline_Call {
 	_c.Call.Return(loglineEntity, err)
 	return _c
 }
 
-func (_c *MockCreateBeatsSheetSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockCreateBeatsSheetSource_SelectLogline_Call {
+func (_c *MockConvertBeatsSheetSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockConvertBeatsSheetSource_SelectLogline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectStoryPlan provides a mock function for the type MockCreateBeatsSheetSource
-func (_mock *MockCreateBeatsSheetSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
+// SelectStoryPlan provides a mock function for the type MockConvertBeatsSheetSource
+func (_mock *MockConvertBeatsSheetSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -207,19 +343,19 @@ func (_mock *MockCreateBeatsSheetSource) SelectStoryPlan(ctx context.Context, re
 	return r0, r1
 }
 
-// MockCreateBeatsSheetSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
-type MockCreateBeatsSheetSource_SelectStoryPlan_Call struct {
+// MockConvertBeatsSheetSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
+type MockConvertBeatsSheetSource_SelectStoryPlan_Call struct {
 	*mock.Call
 }
 
 // SelectStoryPlan is a helper method to define mock.On call
 //   - ctx context.Context
 //   - request services.SelectStoryPlanRequest
-func (_e *MockCreateBeatsSheetSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockCreateBeatsSheetSource_SelectStoryPlan_Call {
-	return &MockCreateBeatsSheetSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
+func (_e *MockConvertBeatsSheetSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockConvertBeatsSheetSource_SelectStoryPlan_Call {
+	return &MockConvertBeatsSheetSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
 }
 
-func (_c *MockCreateBeatsSheetSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockCreateBeatsSheetSource_SelectStoryPlan_Call {
+func (_c *MockConvertBeatsSheetSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockConvertBeatsSheetSource_SelectStoryPlan_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -237,23 +373,23 @@ func (_c *MockCreateBeatsSheetSource_SelectStoryPlan_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockCreateBeatsSheetSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockCreateBeatsSheetSource_SelectStoryPlan_Call {
+func (_c *MockConvertBeatsSheetSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockConvertBeatsSheetSource_SelectStoryPlan_Call {
 	_c.Call.Return(plan, err)
 	return _c
 }
 
-func (_c *MockCreateBeatsSheetSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockCreateBeatsSheetSource_SelectStoryPlan_Call {
+func (_c *MockConvertBeatsSheetSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockConvertBeatsSheetSource_SelectStoryPlan_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCreateLoglineSource creates a new instance of MockCreateLoglineSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCreateBeatsSheetSource creates a new instance of MockCreateBeatsSheetSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCreateLoglineSource(t interface {
+func NewMockCreateBeatsSheetSource(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCreateLoglineSource {
-	mock := &MockCreateLoglineSource{}
+}) *MockCreateBeatsSheetSource {
+	mock := &MockCreateBeatsSheetSource{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -261,40 +397,40 @@ func NewMockCreateLoglineSource(t interface {
 	return mock
 }
 
-// MockCreateLoglineSource is an autogenerated mock type for the CreateLoglineSource type
-type MockCreateLoglineSource struct {
+// MockCreateBeatsSheetSource is an autogenerated mock type for the CreateBeatsSheetSource type
+type MockCreateBeatsSheetSource struct {
 	mock.Mock
 }
 
-type MockCreateLoglineSource_Expecter struct {
+type MockCreateBeatsSheetSource_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCreateLoglineSource) EXPECT() *MockCreateLoglineSource_Expecter {
-	return &MockCreateLoglineSource_Expecter{mock: &_m.Mock}
+func (_m *MockCreateBeatsSheetSource) EXPECT() *MockCreateBeatsSheetSource_Expecter {
+	return &MockCreateBeatsSheetSource_Expecter{mock: &_m.Mock}
 }
 
-// InsertLogline provides a mock function for the type MockCreateLoglineSource
-func (_mock *MockCreateLoglineSource) InsertLogline(ctx context.Context, data dao.InsertLoglineData) (*dao.LoglineEntity, error) {
+// InsertBeatsSheet provides a mock function for the type MockCreateBeatsSheetSource
+func (_mock *MockCreateBeatsSheetSource) InsertBeatsSheet(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InsertLogline")
+		panic("no return value specified for InsertBeatsSheet")
 	}
 
-	var r0 *dao.LoglineEntity
+	var r0 *dao.BeatsSheetEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertLoglineData) (*dao.LoglineEntity, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)); ok {
 		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertLoglineData) *dao.LoglineEntity); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertBeatsSheetData) *dao.BeatsSheetEntity); ok {
 		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.LoglineEntity)
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.InsertLoglineData) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.InsertBeatsSheetData) error); ok {
 		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
@@ -302,27 +438,27 @@ func (_mock *MockCreateLoglineSource) InsertLogline(ctx context.Context, data da
 	return r0, r1
 }
 
-// MockCreateLoglineSource_InsertLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertLogline'
-type MockCreateLoglineSource_InsertLogline_Call struct {
+// MockCreateBeatsSheetSource_InsertBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertBeatsSheet'
+type MockCreateBeatsSheetSource_InsertBeatsSheet_Call struct {
 	*mock.Call
 }
 
-// InsertLogline is a helper method to define mock.On call
+// InsertBeatsSheet is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data dao.InsertLoglineData
-func (_e *MockCreateLoglineSource_Expecter) InsertLogline(ctx interface{}, data interface{}) *MockCreateLoglineSource_InsertLogline_Call {
-	return &MockCreateLoglineSource_InsertLogline_Call{Call: _e.mock.On("InsertLogline", ctx, data)}
+//   - data dao.InsertBeatsSheetData
+func (_e *MockCreateBeatsSheetSource_Expecter) InsertBeatsSheet(ctx interface{}, data interface{}) *MockCreateBeatsSheetSource_InsertBeatsSheet_Call {
+	return &MockCreateBeatsSheetSource_InsertBeatsSheet_Call{Call: _e.mock.On("InsertBeatsSheet", ctx, data)}
 }
 
-func (_c *MockCreateLoglineSource_InsertLogline_Call) Run(run func(ctx context.Context, data dao.InsertLoglineData)) *MockCreateLoglineSource_InsertLogline_Call {
+func (_c *MockCreateBeatsSheetSource_InsertBeatsSheet_Call) Run(run func(ctx context.Context, data dao.InsertBeatsSheetData)) *MockCreateBeatsSheetSource_InsertBeatsSheet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 dao.InsertLoglineData
+		var arg1 dao.InsertBeatsSheetData
 		if args[1] != nil {
-			arg1 = args[1].(dao.InsertLoglineData)
+			arg1 = args[1].(dao.InsertBeatsSheetData)
 		}
 		run(
 			arg0,
@@ -332,69 +468,65 @@ func (_c *MockCreateLoglineSource_InsertLogline_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockCreateLoglineSource_InsertLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockCreateLoglineSource_InsertLogline_Call {
-	_c.Call.Return(loglineEntity, err)
+func (_c *MockCreateBeatsSheetSource_InsertBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockCreateBeatsSheetSource_InsertBeatsSheet_Call {
+	_c.Call.Return(beatsSheetEntity, err)
 	return _c
 }
 
-func (_c *MockCreateLoglineSource_InsertLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertLoglineData) (*dao.LoglineEntity, error)) *MockCreateLoglineSource_InsertLogline_Call {
+func (_c *MockCreateBeatsSheetSource_InsertBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)) *MockCreateBeatsSheetSource_InsertBeatsSheet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectSlugIteration provides a mock function for the type MockCreateLoglineSource
-func (_mock *MockCreateLoglineSource) SelectSlugIteration(ctx context.Context, data dao.SelectSlugIterationData) (models.Slug, int, error) {
+// SelectLogline provides a mock function for the type MockCreateBeatsSheetSource
+func (_mock *MockCreateBeatsSheetSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectSlugIteration")
+		panic("no return value specified for SelectLogline")
 	}
 
-	var r0 models.Slug
-	var r1 int
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectSlugIterationData) (models.Slug, int, error)); ok {
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
 		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectSlugIterationData) models.Slug); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
 		r0 = returnFunc(ctx, data)
 	} else {
-		r0 = ret.Get(0).(models.Slug)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectSlugIterationData) int); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
 		r1 = returnFunc(ctx, data)
 	} else {
-		r1 = ret.Get(1).(int)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, dao.SelectSlugIterationData) error); ok {
-		r2 = returnFunc(ctx, data)
-	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockCreateLoglineSource_SelectSlugIteration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectSlugIteration'
-type MockCreateLoglineSource_SelectSlugIteration_Call struct {
+// MockCreateBeatsSheetSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockCreateBeatsSheetSource_SelectLogline_Call struct {
 	*mock.Call
 }
 
-// SelectSlugIteration is a helper method to define mock.On call
+// SelectLogline is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data dao.SelectSlugIterationData
-func (_e *MockCreateLoglineSource_Expecter) SelectSlugIteration(ctx interface{}, data interface{}) *MockCreateLoglineSource_SelectSlugIteration_Call {
-	return &MockCreateLoglineSource_SelectSlugIteration_Call{Call: _e.mock.On("SelectSlugIteration", ctx, data)}
+//   - data dao.SelectLoglineData
+func (_e *MockCreateBeatsSheetSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockCreateBeatsSheetSource_SelectLogline_Call {
+	return &MockCreateBeatsSheetSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
 }
 
-func (_c *MockCreateLoglineSource_SelectSlugIteration_Call) Run(run func(ctx context.Context, data dao.SelectSlugIterationData)) *MockCreateLoglineSource_SelectSlugIteration_Call {
+func (_c *MockCreateBeatsSheetSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockCreateBeatsSheetSource_SelectLogline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 dao.SelectSlugIterationData
+		var arg1 dao.SelectLoglineData
 		if args[1] != nil {
-			arg1 = args[1].(dao.SelectSlugIterationData)
+			arg1 = args[1].(dao.SelectLoglineData)
 		}
 		run(
 			arg0,
@@ -404,23 +536,91 @@ func (_c *MockCreateLoglineSource_SelectSlugIteration_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *MockCreateLoglineSource_SelectSlugIteration_Call) Return(slug models.Slug, n int, err error) *MockCreateLoglineSource_SelectSlugIteration_Call {
-	_c.Call.Return(slug, n, err)
+func (_c *MockCreateBeatsSheetSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockCreateBeatsSheetSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
 	return _c
 }
 
-func (_c *MockCreateLoglineSource_SelectSlugIteration_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectSlugIterationData) (models.Slug, int, error)) *MockCreateLoglineSource_SelectSlugIteration_Call {
+func (_c *MockCreateBeatsSheetSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockCreateBeatsSheetSource_SelectLogline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockExpandBeatSource creates a new instance of MockExpandBeatSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// SelectStoryPlan provides a mock function for the type MockCreateBeatsSheetSource
+func (_mock *MockCreateBeatsSheetSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectStoryPlan")
+	}
+
+	var r0 *storyplanmodel.Plan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) *storyplanmodel.Plan); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*storyplanmodel.Plan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectStoryPlanRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCreateBeatsSheetSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
+type MockCreateBeatsSheetSource_SelectStoryPlan_Call struct {
+	*mock.Call
+}
+
+// SelectStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectStoryPlanRequest
+func (_e *MockCreateBeatsSheetSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockCreateBeatsSheetSource_SelectStoryPlan_Call {
+	return &MockCreateBeatsSheetSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
+}
+
+func (_c *MockCreateBeatsSheetSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockCreateBeatsSheetSource_SelectStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectStoryPlanRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectStoryPlanRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCreateBeatsSheetSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockCreateBeatsSheetSource_SelectStoryPlan_Call {
+	_c.Call.Return(plan, err)
+	return _c
+}
+
+func (_c *MockCreateBeatsSheetSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockCreateBeatsSheetSource_SelectStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateLoglineSource creates a new instance of MockCreateLoglineSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockExpandBeatSource(t interface {
+func NewMockCreateLoglineSource(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockExpandBeatSource {
-	mock := &MockExpandBeatSource{}
+}) *MockCreateLoglineSource {
+	mock := &MockCreateLoglineSource{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -428,68 +628,68 @@ func NewMockExpandBeatSource(t interface {
 	return mock
 }
 
-// MockExpandBeatSource is an autogenerated mock type for the ExpandBeatSource type
-type MockExpandBeatSource struct {
+// MockCreateLoglineSource is an autogenerated mock type for the CreateLoglineSource type
+type MockCreateLoglineSource struct {
 	mock.Mock
 }
 
-type MockExpandBeatSource_Expecter struct {
+type MockCreateLoglineSource_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockExpandBeatSource) EXPECT() *MockExpandBeatSource_Expecter {
-	return &MockExpandBeatSource_Expecter{mock: &_m.Mock}
+func (_m *MockCreateLoglineSource) EXPECT() *MockCreateLoglineSource_Expecter {
+	return &MockCreateLoglineSource_Expecter{mock: &_m.Mock}
 }
 
-// ExpandBeat provides a mock function for the type MockExpandBeatSource
-func (_mock *MockExpandBeatSource) ExpandBeat(ctx context.Context, request daoai.ExpandBeatRequest) (*models.Beat, error) {
-	ret := _mock.Called(ctx, request)
+// InsertLogline provides a mock function for the type MockCreateLoglineSource
+func (_mock *MockCreateLoglineSource) InsertLogline(ctx context.Context, data dao.InsertLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExpandBeat")
+		panic("no return value specified for InsertLogline")
 	}
 
-	var r0 *models.Beat
+	var r0 *dao.LoglineEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.ExpandBeatRequest) (*models.Beat, error)); ok {
-		return returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.ExpandBeatRequest) *models.Beat); ok {
-		r0 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Beat)
+			r0 = ret.Get(0).(*dao.LoglineEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.ExpandBeatRequest) error); ok {
-		r1 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.InsertLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockExpandBeatSource_ExpandBeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandBeat'
-type MockExpandBeatSource_ExpandBeat_Call struct {
+// MockCreateLoglineSource_InsertLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertLogline'
+type MockCreateLoglineSource_InsertLogline_Call struct {
 	*mock.Call
 }
 
-// ExpandBeat is a helper method to define mock.On call
+// InsertLogline is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request daoai.ExpandBeatRequest
-func (_e *MockExpandBeatSource_Expecter) ExpandBeat(ctx interface{}, request interface{}) *MockExpandBeatSource_ExpandBeat_Call {
-	return &MockExpandBeatSource_ExpandBeat_Call{Call: _e.mock.On("ExpandBeat", ctx, request)}
+//   - data dao.InsertLoglineData
+func (_e *MockCreateLoglineSource_Expecter) InsertLogline(ctx interface{}, data interface{}) *MockCreateLoglineSource_InsertLogline_Call {
+	return &MockCreateLoglineSource_InsertLogline_Call{Call: _e.mock.On("InsertLogline", ctx, data)}
 }
 
-func (_c *MockExpandBeatSource_ExpandBeat_Call) Run(run func(ctx context.Context, request daoai.ExpandBeatRequest)) *MockExpandBeatSource_ExpandBeat_Call {
+func (_c *MockCreateLoglineSource_InsertLogline_Call) Run(run func(ctx context.Context, data dao.InsertLoglineData)) *MockCreateLoglineSource_InsertLogline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 daoai.ExpandBeatRequest
+		var arg1 dao.InsertLoglineData
 		if args[1] != nil {
-			arg1 = args[1].(daoai.ExpandBeatRequest)
+			arg1 = args[1].(dao.InsertLoglineData)
 		}
 		run(
 			arg0,
@@ -499,37 +699,37 @@ func (_c *MockExpandBeatSource_ExpandBeat_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockExpandBeatSource_ExpandBeat_Call) Return(beat *models.Beat, err error) *MockExpandBeatSource_ExpandBeat_Call {
-	_c.Call.Return(beat, err)
+func (_c *MockCreateLoglineSource_InsertLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockCreateLoglineSource_InsertLogline_Call {
+	_c.Call.Return(loglineEntity, err)
 	return _c
 }
 
-func (_c *MockExpandBeatSource_ExpandBeat_Call) RunAndReturn(run func(ctx context.Context, request daoai.ExpandBeatRequest) (*models.Beat, error)) *MockExpandBeatSource_ExpandBeat_Call {
+func (_c *MockCreateLoglineSource_InsertLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertLoglineData) (*dao.LoglineEntity, error)) *MockCreateLoglineSource_InsertLogline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectBeatsSheet provides a mock function for the type MockExpandBeatSource
-func (_mock *MockExpandBeatSource) SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error) {
+// SelectProject provides a mock function for the type MockCreateLoglineSource
+func (_mock *MockCreateLoglineSource) SelectProject(ctx context.Context, data dao.SelectProjectData) (*dao.ProjectEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectBeatsSheet")
+		panic("no return value specified for SelectProject")
 	}
 
-	var r0 *dao.BeatsSheetEntity
+	var r0 *dao.ProjectEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.BeatsSheetEntity, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectProjectData) (*dao.ProjectEntity, error)); ok {
 		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.BeatsSheetEntity); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectProjectData) *dao.ProjectEntity); ok {
 		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
+			r0 = ret.Get(0).(*dao.ProjectEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectProjectData) error); ok {
 		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
@@ -537,27 +737,5627 @@ func (_mock *MockExpandBeatSource) SelectBeatsSheet(ctx context.Context, data uu
 	return r0, r1
 }
 
-// MockExpandBeatSource_SelectBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectBeatsSheet'
-type MockExpandBeatSource_SelectBeatsSheet_Call struct {
+// MockCreateLoglineSource_SelectProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectProject'
+type MockCreateLoglineSource_SelectProject_Call struct {
 	*mock.Call
 }
 
-// SelectBeatsSheet is a helper method to define mock.On call
+// SelectProject is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data uuid.UUID
-func (_e *MockExpandBeatSource_Expecter) SelectBeatsSheet(ctx interface{}, data interface{}) *MockExpandBeatSource_SelectBeatsSheet_Call {
-	return &MockExpandBeatSource_SelectBeatsSheet_Call{Call: _e.mock.On("SelectBeatsSheet", ctx, data)}
+//   - data dao.SelectProjectData
+func (_e *MockCreateLoglineSource_Expecter) SelectProject(ctx interface{}, data interface{}) *MockCreateLoglineSource_SelectProject_Call {
+	return &MockCreateLoglineSource_SelectProject_Call{Call: _e.mock.On("SelectProject", ctx, data)}
 }
 
-func (_c *MockExpandBeatSource_SelectBeatsSheet_Call) Run(run func(ctx context.Context, data uuid.UUID)) *MockExpandBeatSource_SelectBeatsSheet_Call {
+func (_c *MockCreateLoglineSource_SelectProject_Call) Run(run func(ctx context.Context, data dao.SelectProjectData)) *MockCreateLoglineSource_SelectProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 dao.SelectProjectData
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(dao.SelectProjectData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCreateLoglineSource_SelectProject_Call) Return(projectEntity *dao.ProjectEntity, err error) *MockCreateLoglineSource_SelectProject_Call {
+	_c.Call.Return(projectEntity, err)
+	return _c
+}
+
+func (_c *MockCreateLoglineSource_SelectProject_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectProjectData) (*dao.ProjectEntity, error)) *MockCreateLoglineSource_SelectProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectSlugIteration provides a mock function for the type MockCreateLoglineSource
+func (_mock *MockCreateLoglineSource) SelectSlugIteration(ctx context.Context, data dao.SelectSlugIterationData) (models.Slug, int, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectSlugIteration")
+	}
+
+	var r0 models.Slug
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectSlugIterationData) (models.Slug, int, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectSlugIterationData) models.Slug); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		r0 = ret.Get(0).(models.Slug)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectSlugIterationData) int); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, dao.SelectSlugIterationData) error); ok {
+		r2 = returnFunc(ctx, data)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockCreateLoglineSource_SelectSlugIteration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectSlugIteration'
+type MockCreateLoglineSource_SelectSlugIteration_Call struct {
+	*mock.Call
+}
+
+// SelectSlugIteration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectSlugIterationData
+func (_e *MockCreateLoglineSource_Expecter) SelectSlugIteration(ctx interface{}, data interface{}) *MockCreateLoglineSource_SelectSlugIteration_Call {
+	return &MockCreateLoglineSource_SelectSlugIteration_Call{Call: _e.mock.On("SelectSlugIteration", ctx, data)}
+}
+
+func (_c *MockCreateLoglineSource_SelectSlugIteration_Call) Run(run func(ctx context.Context, data dao.SelectSlugIterationData)) *MockCreateLoglineSource_SelectSlugIteration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectSlugIterationData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectSlugIterationData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCreateLoglineSource_SelectSlugIteration_Call) Return(slug models.Slug, n int, err error) *MockCreateLoglineSource_SelectSlugIteration_Call {
+	_c.Call.Return(slug, n, err)
+	return _c
+}
+
+func (_c *MockCreateLoglineSource_SelectSlugIteration_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectSlugIterationData) (models.Slug, int, error)) *MockCreateLoglineSource_SelectSlugIteration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateProjectSource creates a new instance of MockCreateProjectSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateProjectSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateProjectSource {
+	mock := &MockCreateProjectSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCreateProjectSource is an autogenerated mock type for the CreateProjectSource type
+type MockCreateProjectSource struct {
+	mock.Mock
+}
+
+type MockCreateProjectSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateProjectSource) EXPECT() *MockCreateProjectSource_Expecter {
+	return &MockCreateProjectSource_Expecter{mock: &_m.Mock}
+}
+
+// InsertProject provides a mock function for the type MockCreateProjectSource
+func (_mock *MockCreateProjectSource) InsertProject(ctx context.Context, data dao.InsertProjectData) (*dao.ProjectEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertProject")
+	}
+
+	var r0 *dao.ProjectEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertProjectData) (*dao.ProjectEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertProjectData) *dao.ProjectEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.InsertProjectData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCreateProjectSource_InsertProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertProject'
+type MockCreateProjectSource_InsertProject_Call struct {
+	*mock.Call
+}
+
+// InsertProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.InsertProjectData
+func (_e *MockCreateProjectSource_Expecter) InsertProject(ctx interface{}, data interface{}) *MockCreateProjectSource_InsertProject_Call {
+	return &MockCreateProjectSource_InsertProject_Call{Call: _e.mock.On("InsertProject", ctx, data)}
+}
+
+func (_c *MockCreateProjectSource_InsertProject_Call) Run(run func(ctx context.Context, data dao.InsertProjectData)) *MockCreateProjectSource_InsertProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.InsertProjectData
+		if args[1] != nil {
+			arg1 = args[1].(dao.InsertProjectData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCreateProjectSource_InsertProject_Call) Return(projectEntity *dao.ProjectEntity, err error) *MockCreateProjectSource_InsertProject_Call {
+	_c.Call.Return(projectEntity, err)
+	return _c
+}
+
+func (_c *MockCreateProjectSource_InsertProject_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertProjectData) (*dao.ProjectEntity, error)) *MockCreateProjectSource_InsertProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateStoryPlanSource creates a new instance of MockCreateStoryPlanSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateStoryPlanSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateStoryPlanSource {
+	mock := &MockCreateStoryPlanSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCreateStoryPlanSource is an autogenerated mock type for the CreateStoryPlanSource type
+type MockCreateStoryPlanSource struct {
+	mock.Mock
+}
+
+type MockCreateStoryPlanSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateStoryPlanSource) EXPECT() *MockCreateStoryPlanSource_Expecter {
+	return &MockCreateStoryPlanSource_Expecter{mock: &_m.Mock}
+}
+
+// InsertStoryPlan provides a mock function for the type MockCreateStoryPlanSource
+func (_mock *MockCreateStoryPlanSource) InsertStoryPlan(ctx context.Context, data dao.InsertStoryPlanData) (*dao.StoryPlanEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertStoryPlan")
+	}
+
+	var r0 *dao.StoryPlanEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertStoryPlanData) (*dao.StoryPlanEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertStoryPlanData) *dao.StoryPlanEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.StoryPlanEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.InsertStoryPlanData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCreateStoryPlanSource_InsertStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertStoryPlan'
+type MockCreateStoryPlanSource_InsertStoryPlan_Call struct {
+	*mock.Call
+}
+
+// InsertStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.InsertStoryPlanData
+func (_e *MockCreateStoryPlanSource_Expecter) InsertStoryPlan(ctx interface{}, data interface{}) *MockCreateStoryPlanSource_InsertStoryPlan_Call {
+	return &MockCreateStoryPlanSource_InsertStoryPlan_Call{Call: _e.mock.On("InsertStoryPlan", ctx, data)}
+}
+
+func (_c *MockCreateStoryPlanSource_InsertStoryPlan_Call) Run(run func(ctx context.Context, data dao.InsertStoryPlanData)) *MockCreateStoryPlanSource_InsertStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.InsertStoryPlanData
+		if args[1] != nil {
+			arg1 = args[1].(dao.InsertStoryPlanData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCreateStoryPlanSource_InsertStoryPlan_Call) Return(storyPlanEntity *dao.StoryPlanEntity, err error) *MockCreateStoryPlanSource_InsertStoryPlan_Call {
+	_c.Call.Return(storyPlanEntity, err)
+	return _c
+}
+
+func (_c *MockCreateStoryPlanSource_InsertStoryPlan_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertStoryPlanData) (*dao.StoryPlanEntity, error)) *MockCreateStoryPlanSource_InsertStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStoryPlanTranslations provides a mock function for the type MockCreateStoryPlanSource
+func (_mock *MockCreateStoryPlanSource) ListStoryPlanTranslations(ctx context.Context, data dao.ListStoryPlanTranslationsData) ([]*dao.StoryPlanEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStoryPlanTranslations")
+	}
+
+	var r0 []*dao.StoryPlanEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListStoryPlanTranslationsData) ([]*dao.StoryPlanEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListStoryPlanTranslationsData) []*dao.StoryPlanEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.StoryPlanEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.ListStoryPlanTranslationsData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCreateStoryPlanSource_ListStoryPlanTranslations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStoryPlanTranslations'
+type MockCreateStoryPlanSource_ListStoryPlanTranslations_Call struct {
+	*mock.Call
+}
+
+// ListStoryPlanTranslations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.ListStoryPlanTranslationsData
+func (_e *MockCreateStoryPlanSource_Expecter) ListStoryPlanTranslations(ctx interface{}, data interface{}) *MockCreateStoryPlanSource_ListStoryPlanTranslations_Call {
+	return &MockCreateStoryPlanSource_ListStoryPlanTranslations_Call{Call: _e.mock.On("ListStoryPlanTranslations", ctx, data)}
+}
+
+func (_c *MockCreateStoryPlanSource_ListStoryPlanTranslations_Call) Run(run func(ctx context.Context, data dao.ListStoryPlanTranslationsData)) *MockCreateStoryPlanSource_ListStoryPlanTranslations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.ListStoryPlanTranslationsData
+		if args[1] != nil {
+			arg1 = args[1].(dao.ListStoryPlanTranslationsData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCreateStoryPlanSource_ListStoryPlanTranslations_Call) Return(storyPlanEntitys []*dao.StoryPlanEntity, err error) *MockCreateStoryPlanSource_ListStoryPlanTranslations_Call {
+	_c.Call.Return(storyPlanEntitys, err)
+	return _c
+}
+
+func (_c *MockCreateStoryPlanSource_ListStoryPlanTranslations_Call) RunAndReturn(run func(ctx context.Context, data dao.ListStoryPlanTranslationsData) ([]*dao.StoryPlanEntity, error)) *MockCreateStoryPlanSource_ListStoryPlanTranslations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCritiqueLoglineSource creates a new instance of MockCritiqueLoglineSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCritiqueLoglineSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCritiqueLoglineSource {
+	mock := &MockCritiqueLoglineSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCritiqueLoglineSource is an autogenerated mock type for the CritiqueLoglineSource type
+type MockCritiqueLoglineSource struct {
+	mock.Mock
+}
+
+type MockCritiqueLoglineSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCritiqueLoglineSource) EXPECT() *MockCritiqueLoglineSource_Expecter {
+	return &MockCritiqueLoglineSource_Expecter{mock: &_m.Mock}
+}
+
+// CritiqueLogline provides a mock function for the type MockCritiqueLoglineSource
+func (_mock *MockCritiqueLoglineSource) CritiqueLogline(ctx context.Context, request daoai.CritiqueLoglineRequest) (*models.LoglineCritique, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CritiqueLogline")
+	}
+
+	var r0 *models.LoglineCritique
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.CritiqueLoglineRequest) (*models.LoglineCritique, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.CritiqueLoglineRequest) *models.LoglineCritique); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LoglineCritique)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.CritiqueLoglineRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCritiqueLoglineSource_CritiqueLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CritiqueLogline'
+type MockCritiqueLoglineSource_CritiqueLogline_Call struct {
+	*mock.Call
+}
+
+// CritiqueLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request daoai.CritiqueLoglineRequest
+func (_e *MockCritiqueLoglineSource_Expecter) CritiqueLogline(ctx interface{}, request interface{}) *MockCritiqueLoglineSource_CritiqueLogline_Call {
+	return &MockCritiqueLoglineSource_CritiqueLogline_Call{Call: _e.mock.On("CritiqueLogline", ctx, request)}
+}
+
+func (_c *MockCritiqueLoglineSource_CritiqueLogline_Call) Run(run func(ctx context.Context, request daoai.CritiqueLoglineRequest)) *MockCritiqueLoglineSource_CritiqueLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 daoai.CritiqueLoglineRequest
+		if args[1] != nil {
+			arg1 = args[1].(daoai.CritiqueLoglineRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCritiqueLoglineSource_CritiqueLogline_Call) Return(loglineCritique *models.LoglineCritique, err error) *MockCritiqueLoglineSource_CritiqueLogline_Call {
+	_c.Call.Return(loglineCritique, err)
+	return _c
+}
+
+func (_c *MockCritiqueLoglineSource_CritiqueLogline_Call) RunAndReturn(run func(ctx context.Context, request daoai.CritiqueLoglineRequest) (*models.LoglineCritique, error)) *MockCritiqueLoglineSource_CritiqueLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectLogline provides a mock function for the type MockCritiqueLoglineSource
+func (_mock *MockCritiqueLoglineSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCritiqueLoglineSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockCritiqueLoglineSource_SelectLogline_Call struct {
+	*mock.Call
+}
+
+// SelectLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectLoglineData
+func (_e *MockCritiqueLoglineSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockCritiqueLoglineSource_SelectLogline_Call {
+	return &MockCritiqueLoglineSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+}
+
+func (_c *MockCritiqueLoglineSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockCritiqueLoglineSource_SelectLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCritiqueLoglineSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockCritiqueLoglineSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockCritiqueLoglineSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockCritiqueLoglineSource_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteBeatsSheetSource creates a new instance of MockDeleteBeatsSheetSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteBeatsSheetSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteBeatsSheetSource {
+	mock := &MockDeleteBeatsSheetSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeleteBeatsSheetSource is an autogenerated mock type for the DeleteBeatsSheetSource type
+type MockDeleteBeatsSheetSource struct {
+	mock.Mock
+}
+
+type MockDeleteBeatsSheetSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteBeatsSheetSource) EXPECT() *MockDeleteBeatsSheetSource_Expecter {
+	return &MockDeleteBeatsSheetSource_Expecter{mock: &_m.Mock}
+}
+
+// DeleteBeatsSheet provides a mock function for the type MockDeleteBeatsSheetSource
+func (_mock *MockDeleteBeatsSheetSource) DeleteBeatsSheet(ctx context.Context, data dao.DeleteBeatsSheetData) (*dao.BeatsSheetEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBeatsSheet")
+	}
+
+	var r0 *dao.BeatsSheetEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.DeleteBeatsSheetData) (*dao.BeatsSheetEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.DeleteBeatsSheetData) *dao.BeatsSheetEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.DeleteBeatsSheetData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeleteBeatsSheetSource_DeleteBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBeatsSheet'
+type MockDeleteBeatsSheetSource_DeleteBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// DeleteBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.DeleteBeatsSheetData
+func (_e *MockDeleteBeatsSheetSource_Expecter) DeleteBeatsSheet(ctx interface{}, data interface{}) *MockDeleteBeatsSheetSource_DeleteBeatsSheet_Call {
+	return &MockDeleteBeatsSheetSource_DeleteBeatsSheet_Call{Call: _e.mock.On("DeleteBeatsSheet", ctx, data)}
+}
+
+func (_c *MockDeleteBeatsSheetSource_DeleteBeatsSheet_Call) Run(run func(ctx context.Context, data dao.DeleteBeatsSheetData)) *MockDeleteBeatsSheetSource_DeleteBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.DeleteBeatsSheetData
+		if args[1] != nil {
+			arg1 = args[1].(dao.DeleteBeatsSheetData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeleteBeatsSheetSource_DeleteBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockDeleteBeatsSheetSource_DeleteBeatsSheet_Call {
+	_c.Call.Return(beatsSheetEntity, err)
+	return _c
+}
+
+func (_c *MockDeleteBeatsSheetSource_DeleteBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data dao.DeleteBeatsSheetData) (*dao.BeatsSheetEntity, error)) *MockDeleteBeatsSheetSource_DeleteBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteLoglineSource creates a new instance of MockDeleteLoglineSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteLoglineSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteLoglineSource {
+	mock := &MockDeleteLoglineSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeleteLoglineSource is an autogenerated mock type for the DeleteLoglineSource type
+type MockDeleteLoglineSource struct {
+	mock.Mock
+}
+
+type MockDeleteLoglineSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteLoglineSource) EXPECT() *MockDeleteLoglineSource_Expecter {
+	return &MockDeleteLoglineSource_Expecter{mock: &_m.Mock}
+}
+
+// DeleteLogline provides a mock function for the type MockDeleteLoglineSource
+func (_mock *MockDeleteLoglineSource) DeleteLogline(ctx context.Context, data dao.DeleteLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.DeleteLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.DeleteLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.DeleteLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeleteLoglineSource_DeleteLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLogline'
+type MockDeleteLoglineSource_DeleteLogline_Call struct {
+	*mock.Call
+}
+
+// DeleteLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.DeleteLoglineData
+func (_e *MockDeleteLoglineSource_Expecter) DeleteLogline(ctx interface{}, data interface{}) *MockDeleteLoglineSource_DeleteLogline_Call {
+	return &MockDeleteLoglineSource_DeleteLogline_Call{Call: _e.mock.On("DeleteLogline", ctx, data)}
+}
+
+func (_c *MockDeleteLoglineSource_DeleteLogline_Call) Run(run func(ctx context.Context, data dao.DeleteLoglineData)) *MockDeleteLoglineSource_DeleteLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.DeleteLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.DeleteLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeleteLoglineSource_DeleteLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockDeleteLoglineSource_DeleteLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockDeleteLoglineSource_DeleteLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.DeleteLoglineData) (*dao.LoglineEntity, error)) *MockDeleteLoglineSource_DeleteLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteProjectSource creates a new instance of MockDeleteProjectSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteProjectSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteProjectSource {
+	mock := &MockDeleteProjectSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeleteProjectSource is an autogenerated mock type for the DeleteProjectSource type
+type MockDeleteProjectSource struct {
+	mock.Mock
+}
+
+type MockDeleteProjectSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteProjectSource) EXPECT() *MockDeleteProjectSource_Expecter {
+	return &MockDeleteProjectSource_Expecter{mock: &_m.Mock}
+}
+
+// DeleteProject provides a mock function for the type MockDeleteProjectSource
+func (_mock *MockDeleteProjectSource) DeleteProject(ctx context.Context, data dao.DeleteProjectData) (*dao.ProjectEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProject")
+	}
+
+	var r0 *dao.ProjectEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.DeleteProjectData) (*dao.ProjectEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.DeleteProjectData) *dao.ProjectEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.DeleteProjectData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeleteProjectSource_DeleteProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProject'
+type MockDeleteProjectSource_DeleteProject_Call struct {
+	*mock.Call
+}
+
+// DeleteProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.DeleteProjectData
+func (_e *MockDeleteProjectSource_Expecter) DeleteProject(ctx interface{}, data interface{}) *MockDeleteProjectSource_DeleteProject_Call {
+	return &MockDeleteProjectSource_DeleteProject_Call{Call: _e.mock.On("DeleteProject", ctx, data)}
+}
+
+func (_c *MockDeleteProjectSource_DeleteProject_Call) Run(run func(ctx context.Context, data dao.DeleteProjectData)) *MockDeleteProjectSource_DeleteProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.DeleteProjectData
+		if args[1] != nil {
+			arg1 = args[1].(dao.DeleteProjectData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeleteProjectSource_DeleteProject_Call) Return(projectEntity *dao.ProjectEntity, err error) *MockDeleteProjectSource_DeleteProject_Call {
+	_c.Call.Return(projectEntity, err)
+	return _c
+}
+
+func (_c *MockDeleteProjectSource_DeleteProject_Call) RunAndReturn(run func(ctx context.Context, data dao.DeleteProjectData) (*dao.ProjectEntity, error)) *MockDeleteProjectSource_DeleteProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDiffBeatsSheetsSource creates a new instance of MockDiffBeatsSheetsSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDiffBeatsSheetsSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDiffBeatsSheetsSource {
+	mock := &MockDiffBeatsSheetsSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDiffBeatsSheetsSource is an autogenerated mock type for the DiffBeatsSheetsSource type
+type MockDiffBeatsSheetsSource struct {
+	mock.Mock
+}
+
+type MockDiffBeatsSheetsSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDiffBeatsSheetsSource) EXPECT() *MockDiffBeatsSheetsSource_Expecter {
+	return &MockDiffBeatsSheetsSource_Expecter{mock: &_m.Mock}
+}
+
+// SelectBeatsSheet provides a mock function for the type MockDiffBeatsSheetsSource
+func (_mock *MockDiffBeatsSheetsSource) SelectBeatsSheet(ctx context.Context, request services.SelectBeatsSheetRequest) (*models.BeatsSheet, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectBeatsSheet")
+	}
+
+	var r0 *models.BeatsSheet
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectBeatsSheetRequest) (*models.BeatsSheet, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectBeatsSheetRequest) *models.BeatsSheet); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BeatsSheet)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectBeatsSheetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDiffBeatsSheetsSource_SelectBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectBeatsSheet'
+type MockDiffBeatsSheetsSource_SelectBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// SelectBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectBeatsSheetRequest
+func (_e *MockDiffBeatsSheetsSource_Expecter) SelectBeatsSheet(ctx interface{}, request interface{}) *MockDiffBeatsSheetsSource_SelectBeatsSheet_Call {
+	return &MockDiffBeatsSheetsSource_SelectBeatsSheet_Call{Call: _e.mock.On("SelectBeatsSheet", ctx, request)}
+}
+
+func (_c *MockDiffBeatsSheetsSource_SelectBeatsSheet_Call) Run(run func(ctx context.Context, request services.SelectBeatsSheetRequest)) *MockDiffBeatsSheetsSource_SelectBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectBeatsSheetRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectBeatsSheetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDiffBeatsSheetsSource_SelectBeatsSheet_Call) Return(beatsSheet *models.BeatsSheet, err error) *MockDiffBeatsSheetsSource_SelectBeatsSheet_Call {
+	_c.Call.Return(beatsSheet, err)
+	return _c
+}
+
+func (_c *MockDiffBeatsSheetsSource_SelectBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request services.SelectBeatsSheetRequest) (*models.BeatsSheet, error)) *MockDiffBeatsSheetsSource_SelectBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDiffLoglineRevisionsSource creates a new instance of MockDiffLoglineRevisionsSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDiffLoglineRevisionsSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDiffLoglineRevisionsSource {
+	mock := &MockDiffLoglineRevisionsSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDiffLoglineRevisionsSource is an autogenerated mock type for the DiffLoglineRevisionsSource type
+type MockDiffLoglineRevisionsSource struct {
+	mock.Mock
+}
+
+type MockDiffLoglineRevisionsSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDiffLoglineRevisionsSource) EXPECT() *MockDiffLoglineRevisionsSource_Expecter {
+	return &MockDiffLoglineRevisionsSource_Expecter{mock: &_m.Mock}
+}
+
+// SelectLoglineRevision provides a mock function for the type MockDiffLoglineRevisionsSource
+func (_mock *MockDiffLoglineRevisionsSource) SelectLoglineRevision(ctx context.Context, request services.SelectLoglineRevisionRequest) (*models.LoglineRevision, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLoglineRevision")
+	}
+
+	var r0 *models.LoglineRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectLoglineRevisionRequest) (*models.LoglineRevision, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectLoglineRevisionRequest) *models.LoglineRevision); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LoglineRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectLoglineRevisionRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDiffLoglineRevisionsSource_SelectLoglineRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLoglineRevision'
+type MockDiffLoglineRevisionsSource_SelectLoglineRevision_Call struct {
+	*mock.Call
+}
+
+// SelectLoglineRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectLoglineRevisionRequest
+func (_e *MockDiffLoglineRevisionsSource_Expecter) SelectLoglineRevision(ctx interface{}, request interface{}) *MockDiffLoglineRevisionsSource_SelectLoglineRevision_Call {
+	return &MockDiffLoglineRevisionsSource_SelectLoglineRevision_Call{Call: _e.mock.On("SelectLoglineRevision", ctx, request)}
+}
+
+func (_c *MockDiffLoglineRevisionsSource_SelectLoglineRevision_Call) Run(run func(ctx context.Context, request services.SelectLoglineRevisionRequest)) *MockDiffLoglineRevisionsSource_SelectLoglineRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectLoglineRevisionRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectLoglineRevisionRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDiffLoglineRevisionsSource_SelectLoglineRevision_Call) Return(loglineRevision *models.LoglineRevision, err error) *MockDiffLoglineRevisionsSource_SelectLoglineRevision_Call {
+	_c.Call.Return(loglineRevision, err)
+	return _c
+}
+
+func (_c *MockDiffLoglineRevisionsSource_SelectLoglineRevision_Call) RunAndReturn(run func(ctx context.Context, request services.SelectLoglineRevisionRequest) (*models.LoglineRevision, error)) *MockDiffLoglineRevisionsSource_SelectLoglineRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExpandBeatSource creates a new instance of MockExpandBeatSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExpandBeatSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExpandBeatSource {
+	mock := &MockExpandBeatSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExpandBeatSource is an autogenerated mock type for the ExpandBeatSource type
+type MockExpandBeatSource struct {
+	mock.Mock
+}
+
+type MockExpandBeatSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExpandBeatSource) EXPECT() *MockExpandBeatSource_Expecter {
+	return &MockExpandBeatSource_Expecter{mock: &_m.Mock}
+}
+
+// ExpandBeat provides a mock function for the type MockExpandBeatSource
+func (_mock *MockExpandBeatSource) ExpandBeat(ctx context.Context, request daoai.ExpandBeatRequest) (*models.Beat, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandBeat")
+	}
+
+	var r0 *models.Beat
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.ExpandBeatRequest) (*models.Beat, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.ExpandBeatRequest) *models.Beat); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Beat)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.ExpandBeatRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpandBeatSource_ExpandBeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandBeat'
+type MockExpandBeatSource_ExpandBeat_Call struct {
+	*mock.Call
+}
+
+// ExpandBeat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request daoai.ExpandBeatRequest
+func (_e *MockExpandBeatSource_Expecter) ExpandBeat(ctx interface{}, request interface{}) *MockExpandBeatSource_ExpandBeat_Call {
+	return &MockExpandBeatSource_ExpandBeat_Call{Call: _e.mock.On("ExpandBeat", ctx, request)}
+}
+
+func (_c *MockExpandBeatSource_ExpandBeat_Call) Run(run func(ctx context.Context, request daoai.ExpandBeatRequest)) *MockExpandBeatSource_ExpandBeat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 daoai.ExpandBeatRequest
+		if args[1] != nil {
+			arg1 = args[1].(daoai.ExpandBeatRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpandBeatSource_ExpandBeat_Call) Return(beat *models.Beat, err error) *MockExpandBeatSource_ExpandBeat_Call {
+	_c.Call.Return(beat, err)
+	return _c
+}
+
+func (_c *MockExpandBeatSource_ExpandBeat_Call) RunAndReturn(run func(ctx context.Context, request daoai.ExpandBeatRequest) (*models.Beat, error)) *MockExpandBeatSource_ExpandBeat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertBeatsSheet provides a mock function for the type MockExpandBeatSource
+func (_mock *MockExpandBeatSource) InsertBeatsSheet(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertBeatsSheet")
+	}
+
+	var r0 *dao.BeatsSheetEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertBeatsSheetData) *dao.BeatsSheetEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.InsertBeatsSheetData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpandBeatSource_InsertBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertBeatsSheet'
+type MockExpandBeatSource_InsertBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// InsertBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.InsertBeatsSheetData
+func (_e *MockExpandBeatSource_Expecter) InsertBeatsSheet(ctx interface{}, data interface{}) *MockExpandBeatSource_InsertBeatsSheet_Call {
+	return &MockExpandBeatSource_InsertBeatsSheet_Call{Call: _e.mock.On("InsertBeatsSheet", ctx, data)}
+}
+
+func (_c *MockExpandBeatSource_InsertBeatsSheet_Call) Run(run func(ctx context.Context, data dao.InsertBeatsSheetData)) *MockExpandBeatSource_InsertBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.InsertBeatsSheetData
+		if args[1] != nil {
+			arg1 = args[1].(dao.InsertBeatsSheetData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpandBeatSource_InsertBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockExpandBeatSource_InsertBeatsSheet_Call {
+	_c.Call.Return(beatsSheetEntity, err)
+	return _c
+}
+
+func (_c *MockExpandBeatSource_InsertBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)) *MockExpandBeatSource_InsertBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectBeatsSheet provides a mock function for the type MockExpandBeatSource
+func (_mock *MockExpandBeatSource) SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectBeatsSheet")
+	}
+
+	var r0 *dao.BeatsSheetEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.BeatsSheetEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.BeatsSheetEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpandBeatSource_SelectBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectBeatsSheet'
+type MockExpandBeatSource_SelectBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// SelectBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data uuid.UUID
+func (_e *MockExpandBeatSource_Expecter) SelectBeatsSheet(ctx interface{}, data interface{}) *MockExpandBeatSource_SelectBeatsSheet_Call {
+	return &MockExpandBeatSource_SelectBeatsSheet_Call{Call: _e.mock.On("SelectBeatsSheet", ctx, data)}
+}
+
+func (_c *MockExpandBeatSource_SelectBeatsSheet_Call) Run(run func(ctx context.Context, data uuid.UUID)) *MockExpandBeatSource_SelectBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpandBeatSource_SelectBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockExpandBeatSource_SelectBeatsSheet_Call {
+	_c.Call.Return(beatsSheetEntity, err)
+	return _c
+}
+
+func (_c *MockExpandBeatSource_SelectBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)) *MockExpandBeatSource_SelectBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectLogline provides a mock function for the type MockExpandBeatSource
+func (_mock *MockExpandBeatSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpandBeatSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockExpandBeatSource_SelectLogline_Call struct {
+	*mock.Call
+}
+
+// SelectLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectLoglineData
+func (_e *MockExpandBeatSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockExpandBeatSource_SelectLogline_Call {
+	return &MockExpandBeatSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+}
+
+func (_c *MockExpandBeatSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockExpandBeatSource_SelectLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpandBeatSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockExpandBeatSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockExpandBeatSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockExpandBeatSource_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectStoryPlan provides a mock function for the type MockExpandBeatSource
+func (_mock *MockExpandBeatSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectStoryPlan")
+	}
+
+	var r0 *storyplanmodel.Plan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) *storyplanmodel.Plan); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*storyplanmodel.Plan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectStoryPlanRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpandBeatSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
+type MockExpandBeatSource_SelectStoryPlan_Call struct {
+	*mock.Call
+}
+
+// SelectStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectStoryPlanRequest
+func (_e *MockExpandBeatSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockExpandBeatSource_SelectStoryPlan_Call {
+	return &MockExpandBeatSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
+}
+
+func (_c *MockExpandBeatSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockExpandBeatSource_SelectStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectStoryPlanRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectStoryPlanRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpandBeatSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockExpandBeatSource_SelectStoryPlan_Call {
+	_c.Call.Return(plan, err)
+	return _c
+}
+
+func (_c *MockExpandBeatSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockExpandBeatSource_SelectStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExpandLoglineSource creates a new instance of MockExpandLoglineSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExpandLoglineSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExpandLoglineSource {
+	mock := &MockExpandLoglineSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExpandLoglineSource is an autogenerated mock type for the ExpandLoglineSource type
+type MockExpandLoglineSource struct {
+	mock.Mock
+}
+
+type MockExpandLoglineSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExpandLoglineSource) EXPECT() *MockExpandLoglineSource_Expecter {
+	return &MockExpandLoglineSource_Expecter{mock: &_m.Mock}
+}
+
+// ExpandLogline provides a mock function for the type MockExpandLoglineSource
+func (_mock *MockExpandLoglineSource) ExpandLogline(ctx context.Context, request daoai.ExpandLoglineRequest) (*models.LoglineIdea, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandLogline")
+	}
+
+	var r0 *models.LoglineIdea
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.ExpandLoglineRequest) (*models.LoglineIdea, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.ExpandLoglineRequest) *models.LoglineIdea); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LoglineIdea)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.ExpandLoglineRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpandLoglineSource_ExpandLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandLogline'
+type MockExpandLoglineSource_ExpandLogline_Call struct {
+	*mock.Call
+}
+
+// ExpandLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request daoai.ExpandLoglineRequest
+func (_e *MockExpandLoglineSource_Expecter) ExpandLogline(ctx interface{}, request interface{}) *MockExpandLoglineSource_ExpandLogline_Call {
+	return &MockExpandLoglineSource_ExpandLogline_Call{Call: _e.mock.On("ExpandLogline", ctx, request)}
+}
+
+func (_c *MockExpandLoglineSource_ExpandLogline_Call) Run(run func(ctx context.Context, request daoai.ExpandLoglineRequest)) *MockExpandLoglineSource_ExpandLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 daoai.ExpandLoglineRequest
+		if args[1] != nil {
+			arg1 = args[1].(daoai.ExpandLoglineRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpandLoglineSource_ExpandLogline_Call) Return(loglineIdea *models.LoglineIdea, err error) *MockExpandLoglineSource_ExpandLogline_Call {
+	_c.Call.Return(loglineIdea, err)
+	return _c
+}
+
+func (_c *MockExpandLoglineSource_ExpandLogline_Call) RunAndReturn(run func(ctx context.Context, request daoai.ExpandLoglineRequest) (*models.LoglineIdea, error)) *MockExpandLoglineSource_ExpandLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockForkStoryPlanSource creates a new instance of MockForkStoryPlanSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockForkStoryPlanSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockForkStoryPlanSource {
+	mock := &MockForkStoryPlanSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockForkStoryPlanSource is an autogenerated mock type for the ForkStoryPlanSource type
+type MockForkStoryPlanSource struct {
+	mock.Mock
+}
+
+type MockForkStoryPlanSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockForkStoryPlanSource) EXPECT() *MockForkStoryPlanSource_Expecter {
+	return &MockForkStoryPlanSource_Expecter{mock: &_m.Mock}
+}
+
+// InsertStoryPlan provides a mock function for the type MockForkStoryPlanSource
+func (_mock *MockForkStoryPlanSource) InsertStoryPlan(ctx context.Context, data dao.InsertStoryPlanData) (*dao.StoryPlanEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertStoryPlan")
+	}
+
+	var r0 *dao.StoryPlanEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertStoryPlanData) (*dao.StoryPlanEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertStoryPlanData) *dao.StoryPlanEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.StoryPlanEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.InsertStoryPlanData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockForkStoryPlanSource_InsertStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertStoryPlan'
+type MockForkStoryPlanSource_InsertStoryPlan_Call struct {
+	*mock.Call
+}
+
+// InsertStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.InsertStoryPlanData
+func (_e *MockForkStoryPlanSource_Expecter) InsertStoryPlan(ctx interface{}, data interface{}) *MockForkStoryPlanSource_InsertStoryPlan_Call {
+	return &MockForkStoryPlanSource_InsertStoryPlan_Call{Call: _e.mock.On("InsertStoryPlan", ctx, data)}
+}
+
+func (_c *MockForkStoryPlanSource_InsertStoryPlan_Call) Run(run func(ctx context.Context, data dao.InsertStoryPlanData)) *MockForkStoryPlanSource_InsertStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.InsertStoryPlanData
+		if args[1] != nil {
+			arg1 = args[1].(dao.InsertStoryPlanData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockForkStoryPlanSource_InsertStoryPlan_Call) Return(storyPlanEntity *dao.StoryPlanEntity, err error) *MockForkStoryPlanSource_InsertStoryPlan_Call {
+	_c.Call.Return(storyPlanEntity, err)
+	return _c
+}
+
+func (_c *MockForkStoryPlanSource_InsertStoryPlan_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertStoryPlanData) (*dao.StoryPlanEntity, error)) *MockForkStoryPlanSource_InsertStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectStoryPlan provides a mock function for the type MockForkStoryPlanSource
+func (_mock *MockForkStoryPlanSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectStoryPlan")
+	}
+
+	var r0 *storyplanmodel.Plan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) *storyplanmodel.Plan); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*storyplanmodel.Plan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectStoryPlanRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockForkStoryPlanSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
+type MockForkStoryPlanSource_SelectStoryPlan_Call struct {
+	*mock.Call
+}
+
+// SelectStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectStoryPlanRequest
+func (_e *MockForkStoryPlanSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockForkStoryPlanSource_SelectStoryPlan_Call {
+	return &MockForkStoryPlanSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
+}
+
+func (_c *MockForkStoryPlanSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockForkStoryPlanSource_SelectStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectStoryPlanRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectStoryPlanRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockForkStoryPlanSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockForkStoryPlanSource_SelectStoryPlan_Call {
+	_c.Call.Return(plan, err)
+	return _c
+}
+
+func (_c *MockForkStoryPlanSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockForkStoryPlanSource_SelectStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGenerateBeatsSheetSource creates a new instance of MockGenerateBeatsSheetSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateBeatsSheetSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateBeatsSheetSource {
+	mock := &MockGenerateBeatsSheetSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGenerateBeatsSheetSource is an autogenerated mock type for the GenerateBeatsSheetSource type
+type MockGenerateBeatsSheetSource struct {
+	mock.Mock
+}
+
+type MockGenerateBeatsSheetSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateBeatsSheetSource) EXPECT() *MockGenerateBeatsSheetSource_Expecter {
+	return &MockGenerateBeatsSheetSource_Expecter{mock: &_m.Mock}
+}
+
+// GenerateBeatsSheet provides a mock function for the type MockGenerateBeatsSheetSource
+func (_mock *MockGenerateBeatsSheetSource) GenerateBeatsSheet(ctx context.Context, request daoai.GenerateBeatsSheetRequest) ([]models.Beat, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateBeatsSheet")
+	}
+
+	var r0 []models.Beat
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.GenerateBeatsSheetRequest) ([]models.Beat, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.GenerateBeatsSheetRequest) []models.Beat); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Beat)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.GenerateBeatsSheetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateBeatsSheet'
+type MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// GenerateBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request daoai.GenerateBeatsSheetRequest
+func (_e *MockGenerateBeatsSheetSource_Expecter) GenerateBeatsSheet(ctx interface{}, request interface{}) *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call {
+	return &MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call{Call: _e.mock.On("GenerateBeatsSheet", ctx, request)}
+}
+
+func (_c *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call) Run(run func(ctx context.Context, request daoai.GenerateBeatsSheetRequest)) *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 daoai.GenerateBeatsSheetRequest
+		if args[1] != nil {
+			arg1 = args[1].(daoai.GenerateBeatsSheetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call) Return(beats []models.Beat, err error) *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call {
+	_c.Call.Return(beats, err)
+	return _c
+}
+
+func (_c *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request daoai.GenerateBeatsSheetRequest) ([]models.Beat, error)) *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectLogline provides a mock function for the type MockGenerateBeatsSheetSource
+func (_mock *MockGenerateBeatsSheetSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGenerateBeatsSheetSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockGenerateBeatsSheetSource_SelectLogline_Call struct {
+	*mock.Call
+}
+
+// SelectLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectLoglineData
+func (_e *MockGenerateBeatsSheetSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockGenerateBeatsSheetSource_SelectLogline_Call {
+	return &MockGenerateBeatsSheetSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+}
+
+func (_c *MockGenerateBeatsSheetSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockGenerateBeatsSheetSource_SelectLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenerateBeatsSheetSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockGenerateBeatsSheetSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockGenerateBeatsSheetSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockGenerateBeatsSheetSource_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectStoryPlan provides a mock function for the type MockGenerateBeatsSheetSource
+func (_mock *MockGenerateBeatsSheetSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectStoryPlan")
+	}
+
+	var r0 *storyplanmodel.Plan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) *storyplanmodel.Plan); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*storyplanmodel.Plan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectStoryPlanRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGenerateBeatsSheetSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
+type MockGenerateBeatsSheetSource_SelectStoryPlan_Call struct {
+	*mock.Call
+}
+
+// SelectStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectStoryPlanRequest
+func (_e *MockGenerateBeatsSheetSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockGenerateBeatsSheetSource_SelectStoryPlan_Call {
+	return &MockGenerateBeatsSheetSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
+}
+
+func (_c *MockGenerateBeatsSheetSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockGenerateBeatsSheetSource_SelectStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectStoryPlanRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectStoryPlanRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenerateBeatsSheetSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockGenerateBeatsSheetSource_SelectStoryPlan_Call {
+	_c.Call.Return(plan, err)
+	return _c
+}
+
+func (_c *MockGenerateBeatsSheetSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockGenerateBeatsSheetSource_SelectStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGenerateLoglineVariationsSource creates a new instance of MockGenerateLoglineVariationsSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateLoglineVariationsSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateLoglineVariationsSource {
+	mock := &MockGenerateLoglineVariationsSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGenerateLoglineVariationsSource is an autogenerated mock type for the GenerateLoglineVariationsSource type
+type MockGenerateLoglineVariationsSource struct {
+	mock.Mock
+}
+
+type MockGenerateLoglineVariationsSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateLoglineVariationsSource) EXPECT() *MockGenerateLoglineVariationsSource_Expecter {
+	return &MockGenerateLoglineVariationsSource_Expecter{mock: &_m.Mock}
+}
+
+// GenerateLoglineVariations provides a mock function for the type MockGenerateLoglineVariationsSource
+func (_mock *MockGenerateLoglineVariationsSource) GenerateLoglineVariations(ctx context.Context, request daoai.GenerateLoglineVariationsRequest) ([]models.LoglineVariation, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateLoglineVariations")
+	}
+
+	var r0 []models.LoglineVariation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.GenerateLoglineVariationsRequest) ([]models.LoglineVariation, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.GenerateLoglineVariationsRequest) []models.LoglineVariation); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LoglineVariation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.GenerateLoglineVariationsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGenerateLoglineVariationsSource_GenerateLoglineVariations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateLoglineVariations'
+type MockGenerateLoglineVariationsSource_GenerateLoglineVariations_Call struct {
+	*mock.Call
+}
+
+// GenerateLoglineVariations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request daoai.GenerateLoglineVariationsRequest
+func (_e *MockGenerateLoglineVariationsSource_Expecter) GenerateLoglineVariations(ctx interface{}, request interface{}) *MockGenerateLoglineVariationsSource_GenerateLoglineVariations_Call {
+	return &MockGenerateLoglineVariationsSource_GenerateLoglineVariations_Call{Call: _e.mock.On("GenerateLoglineVariations", ctx, request)}
+}
+
+func (_c *MockGenerateLoglineVariationsSource_GenerateLoglineVariations_Call) Run(run func(ctx context.Context, request daoai.GenerateLoglineVariationsRequest)) *MockGenerateLoglineVariationsSource_GenerateLoglineVariations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 daoai.GenerateLoglineVariationsRequest
+		if args[1] != nil {
+			arg1 = args[1].(daoai.GenerateLoglineVariationsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenerateLoglineVariationsSource_GenerateLoglineVariations_Call) Return(loglineVariations []models.LoglineVariation, err error) *MockGenerateLoglineVariationsSource_GenerateLoglineVariations_Call {
+	_c.Call.Return(loglineVariations, err)
+	return _c
+}
+
+func (_c *MockGenerateLoglineVariationsSource_GenerateLoglineVariations_Call) RunAndReturn(run func(ctx context.Context, request daoai.GenerateLoglineVariationsRequest) ([]models.LoglineVariation, error)) *MockGenerateLoglineVariationsSource_GenerateLoglineVariations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectLogline provides a mock function for the type MockGenerateLoglineVariationsSource
+func (_mock *MockGenerateLoglineVariationsSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGenerateLoglineVariationsSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockGenerateLoglineVariationsSource_SelectLogline_Call struct {
+	*mock.Call
+}
+
+// SelectLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectLoglineData
+func (_e *MockGenerateLoglineVariationsSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockGenerateLoglineVariationsSource_SelectLogline_Call {
+	return &MockGenerateLoglineVariationsSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+}
+
+func (_c *MockGenerateLoglineVariationsSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockGenerateLoglineVariationsSource_SelectLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenerateLoglineVariationsSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockGenerateLoglineVariationsSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockGenerateLoglineVariationsSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockGenerateLoglineVariationsSource_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGenerateLoglinesSource creates a new instance of MockGenerateLoglinesSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateLoglinesSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateLoglinesSource {
+	mock := &MockGenerateLoglinesSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGenerateLoglinesSource is an autogenerated mock type for the GenerateLoglinesSource type
+type MockGenerateLoglinesSource struct {
+	mock.Mock
+}
+
+type MockGenerateLoglinesSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateLoglinesSource) EXPECT() *MockGenerateLoglinesSource_Expecter {
+	return &MockGenerateLoglinesSource_Expecter{mock: &_m.Mock}
+}
+
+// GenerateLoglines provides a mock function for the type MockGenerateLoglinesSource
+func (_mock *MockGenerateLoglinesSource) GenerateLoglines(ctx context.Context, request daoai.GenerateLoglinesRequest) ([]models.LoglineIdea, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateLoglines")
+	}
+
+	var r0 []models.LoglineIdea
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.GenerateLoglinesRequest) ([]models.LoglineIdea, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.GenerateLoglinesRequest) []models.LoglineIdea); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LoglineIdea)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.GenerateLoglinesRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGenerateLoglinesSource_GenerateLoglines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateLoglines'
+type MockGenerateLoglinesSource_GenerateLoglines_Call struct {
+	*mock.Call
+}
+
+// GenerateLoglines is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request daoai.GenerateLoglinesRequest
+func (_e *MockGenerateLoglinesSource_Expecter) GenerateLoglines(ctx interface{}, request interface{}) *MockGenerateLoglinesSource_GenerateLoglines_Call {
+	return &MockGenerateLoglinesSource_GenerateLoglines_Call{Call: _e.mock.On("GenerateLoglines", ctx, request)}
+}
+
+func (_c *MockGenerateLoglinesSource_GenerateLoglines_Call) Run(run func(ctx context.Context, request daoai.GenerateLoglinesRequest)) *MockGenerateLoglinesSource_GenerateLoglines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 daoai.GenerateLoglinesRequest
+		if args[1] != nil {
+			arg1 = args[1].(daoai.GenerateLoglinesRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenerateLoglinesSource_GenerateLoglines_Call) Return(loglineIdeas []models.LoglineIdea, err error) *MockGenerateLoglinesSource_GenerateLoglines_Call {
+	_c.Call.Return(loglineIdeas, err)
+	return _c
+}
+
+func (_c *MockGenerateLoglinesSource_GenerateLoglines_Call) RunAndReturn(run func(ctx context.Context, request daoai.GenerateLoglinesRequest) ([]models.LoglineIdea, error)) *MockGenerateLoglinesSource_GenerateLoglines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListBeatsSheetsSource creates a new instance of MockListBeatsSheetsSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListBeatsSheetsSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListBeatsSheetsSource {
+	mock := &MockListBeatsSheetsSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListBeatsSheetsSource is an autogenerated mock type for the ListBeatsSheetsSource type
+type MockListBeatsSheetsSource struct {
+	mock.Mock
+}
+
+type MockListBeatsSheetsSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListBeatsSheetsSource) EXPECT() *MockListBeatsSheetsSource_Expecter {
+	return &MockListBeatsSheetsSource_Expecter{mock: &_m.Mock}
+}
+
+// ListBeatsSheets provides a mock function for the type MockListBeatsSheetsSource
+func (_mock *MockListBeatsSheetsSource) ListBeatsSheets(ctx context.Context, data dao.ListBeatsSheetsData) ([]*dao.BeatsSheetPreviewEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBeatsSheets")
+	}
+
+	var r0 []*dao.BeatsSheetPreviewEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListBeatsSheetsData) ([]*dao.BeatsSheetPreviewEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListBeatsSheetsData) []*dao.BeatsSheetPreviewEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.BeatsSheetPreviewEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.ListBeatsSheetsData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListBeatsSheetsSource_ListBeatsSheets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBeatsSheets'
+type MockListBeatsSheetsSource_ListBeatsSheets_Call struct {
+	*mock.Call
+}
+
+// ListBeatsSheets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.ListBeatsSheetsData
+func (_e *MockListBeatsSheetsSource_Expecter) ListBeatsSheets(ctx interface{}, data interface{}) *MockListBeatsSheetsSource_ListBeatsSheets_Call {
+	return &MockListBeatsSheetsSource_ListBeatsSheets_Call{Call: _e.mock.On("ListBeatsSheets", ctx, data)}
+}
+
+func (_c *MockListBeatsSheetsSource_ListBeatsSheets_Call) Run(run func(ctx context.Context, data dao.ListBeatsSheetsData)) *MockListBeatsSheetsSource_ListBeatsSheets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.ListBeatsSheetsData
+		if args[1] != nil {
+			arg1 = args[1].(dao.ListBeatsSheetsData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListBeatsSheetsSource_ListBeatsSheets_Call) Return(beatsSheetPreviewEntitys []*dao.BeatsSheetPreviewEntity, err error) *MockListBeatsSheetsSource_ListBeatsSheets_Call {
+	_c.Call.Return(beatsSheetPreviewEntitys, err)
+	return _c
+}
+
+func (_c *MockListBeatsSheetsSource_ListBeatsSheets_Call) RunAndReturn(run func(ctx context.Context, data dao.ListBeatsSheetsData) ([]*dao.BeatsSheetPreviewEntity, error)) *MockListBeatsSheetsSource_ListBeatsSheets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectLogline provides a mock function for the type MockListBeatsSheetsSource
+func (_mock *MockListBeatsSheetsSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListBeatsSheetsSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockListBeatsSheetsSource_SelectLogline_Call struct {
+	*mock.Call
+}
+
+// SelectLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectLoglineData
+func (_e *MockListBeatsSheetsSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockListBeatsSheetsSource_SelectLogline_Call {
+	return &MockListBeatsSheetsSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+}
+
+func (_c *MockListBeatsSheetsSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockListBeatsSheetsSource_SelectLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListBeatsSheetsSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockListBeatsSheetsSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockListBeatsSheetsSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockListBeatsSheetsSource_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListLoglineRevisionsSource creates a new instance of MockListLoglineRevisionsSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListLoglineRevisionsSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListLoglineRevisionsSource {
+	mock := &MockListLoglineRevisionsSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListLoglineRevisionsSource is an autogenerated mock type for the ListLoglineRevisionsSource type
+type MockListLoglineRevisionsSource struct {
+	mock.Mock
+}
+
+type MockListLoglineRevisionsSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListLoglineRevisionsSource) EXPECT() *MockListLoglineRevisionsSource_Expecter {
+	return &MockListLoglineRevisionsSource_Expecter{mock: &_m.Mock}
+}
+
+// ListLoglineRevisions provides a mock function for the type MockListLoglineRevisionsSource
+func (_mock *MockListLoglineRevisionsSource) ListLoglineRevisions(ctx context.Context, data dao.ListLoglineRevisionsData) ([]*dao.LoglineRevisionEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLoglineRevisions")
+	}
+
+	var r0 []*dao.LoglineRevisionEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListLoglineRevisionsData) ([]*dao.LoglineRevisionEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListLoglineRevisionsData) []*dao.LoglineRevisionEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.LoglineRevisionEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.ListLoglineRevisionsData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListLoglineRevisionsSource_ListLoglineRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLoglineRevisions'
+type MockListLoglineRevisionsSource_ListLoglineRevisions_Call struct {
+	*mock.Call
+}
+
+// ListLoglineRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.ListLoglineRevisionsData
+func (_e *MockListLoglineRevisionsSource_Expecter) ListLoglineRevisions(ctx interface{}, data interface{}) *MockListLoglineRevisionsSource_ListLoglineRevisions_Call {
+	return &MockListLoglineRevisionsSource_ListLoglineRevisions_Call{Call: _e.mock.On("ListLoglineRevisions", ctx, data)}
+}
+
+func (_c *MockListLoglineRevisionsSource_ListLoglineRevisions_Call) Run(run func(ctx context.Context, data dao.ListLoglineRevisionsData)) *MockListLoglineRevisionsSource_ListLoglineRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.ListLoglineRevisionsData
+		if args[1] != nil {
+			arg1 = args[1].(dao.ListLoglineRevisionsData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListLoglineRevisionsSource_ListLoglineRevisions_Call) Return(loglineRevisionEntitys []*dao.LoglineRevisionEntity, err error) *MockListLoglineRevisionsSource_ListLoglineRevisions_Call {
+	_c.Call.Return(loglineRevisionEntitys, err)
+	return _c
+}
+
+func (_c *MockListLoglineRevisionsSource_ListLoglineRevisions_Call) RunAndReturn(run func(ctx context.Context, data dao.ListLoglineRevisionsData) ([]*dao.LoglineRevisionEntity, error)) *MockListLoglineRevisionsSource_ListLoglineRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectLogline provides a mock function for the type MockListLoglineRevisionsSource
+func (_mock *MockListLoglineRevisionsSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListLoglineRevisionsSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockListLoglineRevisionsSource_SelectLogline_Call struct {
+	*mock.Call
+}
+
+// SelectLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectLoglineData
+func (_e *MockListLoglineRevisionsSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockListLoglineRevisionsSource_SelectLogline_Call {
+	return &MockListLoglineRevisionsSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+}
+
+func (_c *MockListLoglineRevisionsSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockListLoglineRevisionsSource_SelectLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListLoglineRevisionsSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockListLoglineRevisionsSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockListLoglineRevisionsSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockListLoglineRevisionsSource_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListLoglineTagsSource creates a new instance of MockListLoglineTagsSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListLoglineTagsSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListLoglineTagsSource {
+	mock := &MockListLoglineTagsSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListLoglineTagsSource is an autogenerated mock type for the ListLoglineTagsSource type
+type MockListLoglineTagsSource struct {
+	mock.Mock
+}
+
+type MockListLoglineTagsSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListLoglineTagsSource) EXPECT() *MockListLoglineTagsSource_Expecter {
+	return &MockListLoglineTagsSource_Expecter{mock: &_m.Mock}
+}
+
+// ListLoglineTags provides a mock function for the type MockListLoglineTagsSource
+func (_mock *MockListLoglineTagsSource) ListLoglineTags(ctx context.Context, data dao.ListLoglineTagsData) ([]*dao.LoglineTagEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLoglineTags")
+	}
+
+	var r0 []*dao.LoglineTagEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListLoglineTagsData) ([]*dao.LoglineTagEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListLoglineTagsData) []*dao.LoglineTagEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.LoglineTagEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.ListLoglineTagsData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListLoglineTagsSource_ListLoglineTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLoglineTags'
+type MockListLoglineTagsSource_ListLoglineTags_Call struct {
+	*mock.Call
+}
+
+// ListLoglineTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.ListLoglineTagsData
+func (_e *MockListLoglineTagsSource_Expecter) ListLoglineTags(ctx interface{}, data interface{}) *MockListLoglineTagsSource_ListLoglineTags_Call {
+	return &MockListLoglineTagsSource_ListLoglineTags_Call{Call: _e.mock.On("ListLoglineTags", ctx, data)}
+}
+
+func (_c *MockListLoglineTagsSource_ListLoglineTags_Call) Run(run func(ctx context.Context, data dao.ListLoglineTagsData)) *MockListLoglineTagsSource_ListLoglineTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.ListLoglineTagsData
+		if args[1] != nil {
+			arg1 = args[1].(dao.ListLoglineTagsData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListLoglineTagsSource_ListLoglineTags_Call) Return(loglineTagEntitys []*dao.LoglineTagEntity, err error) *MockListLoglineTagsSource_ListLoglineTags_Call {
+	_c.Call.Return(loglineTagEntitys, err)
+	return _c
+}
+
+func (_c *MockListLoglineTagsSource_ListLoglineTags_Call) RunAndReturn(run func(ctx context.Context, data dao.ListLoglineTagsData) ([]*dao.LoglineTagEntity, error)) *MockListLoglineTagsSource_ListLoglineTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListLoglinesSource creates a new instance of MockListLoglinesSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListLoglinesSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListLoglinesSource {
+	mock := &MockListLoglinesSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListLoglinesSource is an autogenerated mock type for the ListLoglinesSource type
+type MockListLoglinesSource struct {
+	mock.Mock
+}
+
+type MockListLoglinesSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListLoglinesSource) EXPECT() *MockListLoglinesSource_Expecter {
+	return &MockListLoglinesSource_Expecter{mock: &_m.Mock}
+}
+
+// ListLoglines provides a mock function for the type MockListLoglinesSource
+func (_mock *MockListLoglinesSource) ListLoglines(ctx context.Context, data dao.ListLoglinesData) ([]*dao.LoglinePreviewEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLoglines")
+	}
+
+	var r0 []*dao.LoglinePreviewEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListLoglinesData) ([]*dao.LoglinePreviewEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListLoglinesData) []*dao.LoglinePreviewEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.LoglinePreviewEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.ListLoglinesData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListLoglinesSource_ListLoglines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLoglines'
+type MockListLoglinesSource_ListLoglines_Call struct {
+	*mock.Call
+}
+
+// ListLoglines is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.ListLoglinesData
+func (_e *MockListLoglinesSource_Expecter) ListLoglines(ctx interface{}, data interface{}) *MockListLoglinesSource_ListLoglines_Call {
+	return &MockListLoglinesSource_ListLoglines_Call{Call: _e.mock.On("ListLoglines", ctx, data)}
+}
+
+func (_c *MockListLoglinesSource_ListLoglines_Call) Run(run func(ctx context.Context, data dao.ListLoglinesData)) *MockListLoglinesSource_ListLoglines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.ListLoglinesData
+		if args[1] != nil {
+			arg1 = args[1].(dao.ListLoglinesData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListLoglinesSource_ListLoglines_Call) Return(loglinePreviewEntitys []*dao.LoglinePreviewEntity, err error) *MockListLoglinesSource_ListLoglines_Call {
+	_c.Call.Return(loglinePreviewEntitys, err)
+	return _c
+}
+
+func (_c *MockListLoglinesSource_ListLoglines_Call) RunAndReturn(run func(ctx context.Context, data dao.ListLoglinesData) ([]*dao.LoglinePreviewEntity, error)) *MockListLoglinesSource_ListLoglines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListProjectsSource creates a new instance of MockListProjectsSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListProjectsSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListProjectsSource {
+	mock := &MockListProjectsSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListProjectsSource is an autogenerated mock type for the ListProjectsSource type
+type MockListProjectsSource struct {
+	mock.Mock
+}
+
+type MockListProjectsSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListProjectsSource) EXPECT() *MockListProjectsSource_Expecter {
+	return &MockListProjectsSource_Expecter{mock: &_m.Mock}
+}
+
+// ListProjects provides a mock function for the type MockListProjectsSource
+func (_mock *MockListProjectsSource) ListProjects(ctx context.Context, data dao.ListProjectsData) ([]*dao.ProjectEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjects")
+	}
+
+	var r0 []*dao.ProjectEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListProjectsData) ([]*dao.ProjectEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListProjectsData) []*dao.ProjectEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ProjectEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.ListProjectsData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListProjectsSource_ListProjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjects'
+type MockListProjectsSource_ListProjects_Call struct {
+	*mock.Call
+}
+
+// ListProjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.ListProjectsData
+func (_e *MockListProjectsSource_Expecter) ListProjects(ctx interface{}, data interface{}) *MockListProjectsSource_ListProjects_Call {
+	return &MockListProjectsSource_ListProjects_Call{Call: _e.mock.On("ListProjects", ctx, data)}
+}
+
+func (_c *MockListProjectsSource_ListProjects_Call) Run(run func(ctx context.Context, data dao.ListProjectsData)) *MockListProjectsSource_ListProjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.ListProjectsData
+		if args[1] != nil {
+			arg1 = args[1].(dao.ListProjectsData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListProjectsSource_ListProjects_Call) Return(projectEntitys []*dao.ProjectEntity, err error) *MockListProjectsSource_ListProjects_Call {
+	_c.Call.Return(projectEntitys, err)
+	return _c
+}
+
+func (_c *MockListProjectsSource_ListProjects_Call) RunAndReturn(run func(ctx context.Context, data dao.ListProjectsData) ([]*dao.ProjectEntity, error)) *MockListProjectsSource_ListProjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListStoryPlansSource creates a new instance of MockListStoryPlansSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListStoryPlansSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListStoryPlansSource {
+	mock := &MockListStoryPlansSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListStoryPlansSource is an autogenerated mock type for the ListStoryPlansSource type
+type MockListStoryPlansSource struct {
+	mock.Mock
+}
+
+type MockListStoryPlansSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListStoryPlansSource) EXPECT() *MockListStoryPlansSource_Expecter {
+	return &MockListStoryPlansSource_Expecter{mock: &_m.Mock}
+}
+
+// ListStoryPlans provides a mock function for the type MockListStoryPlansSource
+func (_mock *MockListStoryPlansSource) ListStoryPlans(ctx context.Context, data dao.ListStoryPlansData) ([]*dao.StoryPlanPreviewEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStoryPlans")
+	}
+
+	var r0 []*dao.StoryPlanPreviewEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListStoryPlansData) ([]*dao.StoryPlanPreviewEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListStoryPlansData) []*dao.StoryPlanPreviewEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.StoryPlanPreviewEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.ListStoryPlansData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListStoryPlansSource_ListStoryPlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStoryPlans'
+type MockListStoryPlansSource_ListStoryPlans_Call struct {
+	*mock.Call
+}
+
+// ListStoryPlans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.ListStoryPlansData
+func (_e *MockListStoryPlansSource_Expecter) ListStoryPlans(ctx interface{}, data interface{}) *MockListStoryPlansSource_ListStoryPlans_Call {
+	return &MockListStoryPlansSource_ListStoryPlans_Call{Call: _e.mock.On("ListStoryPlans", ctx, data)}
+}
+
+func (_c *MockListStoryPlansSource_ListStoryPlans_Call) Run(run func(ctx context.Context, data dao.ListStoryPlansData)) *MockListStoryPlansSource_ListStoryPlans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.ListStoryPlansData
+		if args[1] != nil {
+			arg1 = args[1].(dao.ListStoryPlansData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListStoryPlansSource_ListStoryPlans_Call) Return(storyPlanPreviewEntitys []*dao.StoryPlanPreviewEntity, err error) *MockListStoryPlansSource_ListStoryPlans_Call {
+	_c.Call.Return(storyPlanPreviewEntitys, err)
+	return _c
+}
+
+func (_c *MockListStoryPlansSource_ListStoryPlans_Call) RunAndReturn(run func(ctx context.Context, data dao.ListStoryPlansData) ([]*dao.StoryPlanPreviewEntity, error)) *MockListStoryPlansSource_ListStoryPlans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListTrashedBeatsSheetsSource creates a new instance of MockListTrashedBeatsSheetsSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListTrashedBeatsSheetsSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListTrashedBeatsSheetsSource {
+	mock := &MockListTrashedBeatsSheetsSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListTrashedBeatsSheetsSource is an autogenerated mock type for the ListTrashedBeatsSheetsSource type
+type MockListTrashedBeatsSheetsSource struct {
+	mock.Mock
+}
+
+type MockListTrashedBeatsSheetsSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListTrashedBeatsSheetsSource) EXPECT() *MockListTrashedBeatsSheetsSource_Expecter {
+	return &MockListTrashedBeatsSheetsSource_Expecter{mock: &_m.Mock}
+}
+
+// ListTrashedBeatsSheets provides a mock function for the type MockListTrashedBeatsSheetsSource
+func (_mock *MockListTrashedBeatsSheetsSource) ListTrashedBeatsSheets(ctx context.Context, data dao.ListTrashedBeatsSheetsData) ([]*dao.TrashedBeatsSheetEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrashedBeatsSheets")
+	}
+
+	var r0 []*dao.TrashedBeatsSheetEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListTrashedBeatsSheetsData) ([]*dao.TrashedBeatsSheetEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListTrashedBeatsSheetsData) []*dao.TrashedBeatsSheetEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.TrashedBeatsSheetEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.ListTrashedBeatsSheetsData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListTrashedBeatsSheetsSource_ListTrashedBeatsSheets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrashedBeatsSheets'
+type MockListTrashedBeatsSheetsSource_ListTrashedBeatsSheets_Call struct {
+	*mock.Call
+}
+
+// ListTrashedBeatsSheets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.ListTrashedBeatsSheetsData
+func (_e *MockListTrashedBeatsSheetsSource_Expecter) ListTrashedBeatsSheets(ctx interface{}, data interface{}) *MockListTrashedBeatsSheetsSource_ListTrashedBeatsSheets_Call {
+	return &MockListTrashedBeatsSheetsSource_ListTrashedBeatsSheets_Call{Call: _e.mock.On("ListTrashedBeatsSheets", ctx, data)}
+}
+
+func (_c *MockListTrashedBeatsSheetsSource_ListTrashedBeatsSheets_Call) Run(run func(ctx context.Context, data dao.ListTrashedBeatsSheetsData)) *MockListTrashedBeatsSheetsSource_ListTrashedBeatsSheets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.ListTrashedBeatsSheetsData
+		if args[1] != nil {
+			arg1 = args[1].(dao.ListTrashedBeatsSheetsData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListTrashedBeatsSheetsSource_ListTrashedBeatsSheets_Call) Return(trashedBeatsSheetEntitys []*dao.TrashedBeatsSheetEntity, err error) *MockListTrashedBeatsSheetsSource_ListTrashedBeatsSheets_Call {
+	_c.Call.Return(trashedBeatsSheetEntitys, err)
+	return _c
+}
+
+func (_c *MockListTrashedBeatsSheetsSource_ListTrashedBeatsSheets_Call) RunAndReturn(run func(ctx context.Context, data dao.ListTrashedBeatsSheetsData) ([]*dao.TrashedBeatsSheetEntity, error)) *MockListTrashedBeatsSheetsSource_ListTrashedBeatsSheets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListTrashedLoglinesSource creates a new instance of MockListTrashedLoglinesSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListTrashedLoglinesSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListTrashedLoglinesSource {
+	mock := &MockListTrashedLoglinesSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListTrashedLoglinesSource is an autogenerated mock type for the ListTrashedLoglinesSource type
+type MockListTrashedLoglinesSource struct {
+	mock.Mock
+}
+
+type MockListTrashedLoglinesSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListTrashedLoglinesSource) EXPECT() *MockListTrashedLoglinesSource_Expecter {
+	return &MockListTrashedLoglinesSource_Expecter{mock: &_m.Mock}
+}
+
+// ListTrashedLoglines provides a mock function for the type MockListTrashedLoglinesSource
+func (_mock *MockListTrashedLoglinesSource) ListTrashedLoglines(ctx context.Context, data dao.ListTrashedLoglinesData) ([]*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrashedLoglines")
+	}
+
+	var r0 []*dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListTrashedLoglinesData) ([]*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListTrashedLoglinesData) []*dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.ListTrashedLoglinesData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListTrashedLoglinesSource_ListTrashedLoglines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrashedLoglines'
+type MockListTrashedLoglinesSource_ListTrashedLoglines_Call struct {
+	*mock.Call
+}
+
+// ListTrashedLoglines is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.ListTrashedLoglinesData
+func (_e *MockListTrashedLoglinesSource_Expecter) ListTrashedLoglines(ctx interface{}, data interface{}) *MockListTrashedLoglinesSource_ListTrashedLoglines_Call {
+	return &MockListTrashedLoglinesSource_ListTrashedLoglines_Call{Call: _e.mock.On("ListTrashedLoglines", ctx, data)}
+}
+
+func (_c *MockListTrashedLoglinesSource_ListTrashedLoglines_Call) Run(run func(ctx context.Context, data dao.ListTrashedLoglinesData)) *MockListTrashedLoglinesSource_ListTrashedLoglines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.ListTrashedLoglinesData
+		if args[1] != nil {
+			arg1 = args[1].(dao.ListTrashedLoglinesData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListTrashedLoglinesSource_ListTrashedLoglines_Call) Return(loglineEntitys []*dao.LoglineEntity, err error) *MockListTrashedLoglinesSource_ListTrashedLoglines_Call {
+	_c.Call.Return(loglineEntitys, err)
+	return _c
+}
+
+func (_c *MockListTrashedLoglinesSource_ListTrashedLoglines_Call) RunAndReturn(run func(ctx context.Context, data dao.ListTrashedLoglinesData) ([]*dao.LoglineEntity, error)) *MockListTrashedLoglinesSource_ListTrashedLoglines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPurgeTrashSource creates a new instance of MockPurgeTrashSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPurgeTrashSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPurgeTrashSource {
+	mock := &MockPurgeTrashSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPurgeTrashSource is an autogenerated mock type for the PurgeTrashSource type
+type MockPurgeTrashSource struct {
+	mock.Mock
+}
+
+type MockPurgeTrashSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPurgeTrashSource) EXPECT() *MockPurgeTrashSource_Expecter {
+	return &MockPurgeTrashSource_Expecter{mock: &_m.Mock}
+}
+
+// PurgeTrash provides a mock function for the type MockPurgeTrashSource
+func (_mock *MockPurgeTrashSource) PurgeTrash(ctx context.Context, data dao.PurgeTrashData) (*dao.PurgeTrashEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTrash")
+	}
+
+	var r0 *dao.PurgeTrashEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.PurgeTrashData) (*dao.PurgeTrashEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.PurgeTrashData) *dao.PurgeTrashEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.PurgeTrashEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.PurgeTrashData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPurgeTrashSource_PurgeTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTrash'
+type MockPurgeTrashSource_PurgeTrash_Call struct {
+	*mock.Call
+}
+
+// PurgeTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.PurgeTrashData
+func (_e *MockPurgeTrashSource_Expecter) PurgeTrash(ctx interface{}, data interface{}) *MockPurgeTrashSource_PurgeTrash_Call {
+	return &MockPurgeTrashSource_PurgeTrash_Call{Call: _e.mock.On("PurgeTrash", ctx, data)}
+}
+
+func (_c *MockPurgeTrashSource_PurgeTrash_Call) Run(run func(ctx context.Context, data dao.PurgeTrashData)) *MockPurgeTrashSource_PurgeTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.PurgeTrashData
+		if args[1] != nil {
+			arg1 = args[1].(dao.PurgeTrashData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPurgeTrashSource_PurgeTrash_Call) Return(purgeTrashEntity *dao.PurgeTrashEntity, err error) *MockPurgeTrashSource_PurgeTrash_Call {
+	_c.Call.Return(purgeTrashEntity, err)
+	return _c
+}
+
+func (_c *MockPurgeTrashSource_PurgeTrash_Call) RunAndReturn(run func(ctx context.Context, data dao.PurgeTrashData) (*dao.PurgeTrashEntity, error)) *MockPurgeTrashSource_PurgeTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRegenerateBeatsSource creates a new instance of MockRegenerateBeatsSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRegenerateBeatsSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRegenerateBeatsSource {
+	mock := &MockRegenerateBeatsSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRegenerateBeatsSource is an autogenerated mock type for the RegenerateBeatsSource type
+type MockRegenerateBeatsSource struct {
+	mock.Mock
+}
+
+type MockRegenerateBeatsSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRegenerateBeatsSource) EXPECT() *MockRegenerateBeatsSource_Expecter {
+	return &MockRegenerateBeatsSource_Expecter{mock: &_m.Mock}
+}
+
+// InsertBeatsSheet provides a mock function for the type MockRegenerateBeatsSource
+func (_mock *MockRegenerateBeatsSource) InsertBeatsSheet(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertBeatsSheet")
+	}
+
+	var r0 *dao.BeatsSheetEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertBeatsSheetData) *dao.BeatsSheetEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.InsertBeatsSheetData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRegenerateBeatsSource_InsertBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertBeatsSheet'
+type MockRegenerateBeatsSource_InsertBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// InsertBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.InsertBeatsSheetData
+func (_e *MockRegenerateBeatsSource_Expecter) InsertBeatsSheet(ctx interface{}, data interface{}) *MockRegenerateBeatsSource_InsertBeatsSheet_Call {
+	return &MockRegenerateBeatsSource_InsertBeatsSheet_Call{Call: _e.mock.On("InsertBeatsSheet", ctx, data)}
+}
+
+func (_c *MockRegenerateBeatsSource_InsertBeatsSheet_Call) Run(run func(ctx context.Context, data dao.InsertBeatsSheetData)) *MockRegenerateBeatsSource_InsertBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.InsertBeatsSheetData
+		if args[1] != nil {
+			arg1 = args[1].(dao.InsertBeatsSheetData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRegenerateBeatsSource_InsertBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockRegenerateBeatsSource_InsertBeatsSheet_Call {
+	_c.Call.Return(beatsSheetEntity, err)
+	return _c
+}
+
+func (_c *MockRegenerateBeatsSource_InsertBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)) *MockRegenerateBeatsSource_InsertBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegenerateBeats provides a mock function for the type MockRegenerateBeatsSource
+func (_mock *MockRegenerateBeatsSource) RegenerateBeats(ctx context.Context, request daoai.RegenerateBeatsRequest) ([]models.Beat, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegenerateBeats")
+	}
+
+	var r0 []models.Beat
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.RegenerateBeatsRequest) ([]models.Beat, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.RegenerateBeatsRequest) []models.Beat); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Beat)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.RegenerateBeatsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRegenerateBeatsSource_RegenerateBeats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegenerateBeats'
+type MockRegenerateBeatsSource_RegenerateBeats_Call struct {
+	*mock.Call
+}
+
+// RegenerateBeats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request daoai.RegenerateBeatsRequest
+func (_e *MockRegenerateBeatsSource_Expecter) RegenerateBeats(ctx interface{}, request interface{}) *MockRegenerateBeatsSource_RegenerateBeats_Call {
+	return &MockRegenerateBeatsSource_RegenerateBeats_Call{Call: _e.mock.On("RegenerateBeats", ctx, request)}
+}
+
+func (_c *MockRegenerateBeatsSource_RegenerateBeats_Call) Run(run func(ctx context.Context, request daoai.RegenerateBeatsRequest)) *MockRegenerateBeatsSource_RegenerateBeats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 daoai.RegenerateBeatsRequest
+		if args[1] != nil {
+			arg1 = args[1].(daoai.RegenerateBeatsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRegenerateBeatsSource_RegenerateBeats_Call) Return(beats []models.Beat, err error) *MockRegenerateBeatsSource_RegenerateBeats_Call {
+	_c.Call.Return(beats, err)
+	return _c
+}
+
+func (_c *MockRegenerateBeatsSource_RegenerateBeats_Call) RunAndReturn(run func(ctx context.Context, request daoai.RegenerateBeatsRequest) ([]models.Beat, error)) *MockRegenerateBeatsSource_RegenerateBeats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectBeatsSheet provides a mock function for the type MockRegenerateBeatsSource
+func (_mock *MockRegenerateBeatsSource) SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectBeatsSheet")
+	}
+
+	var r0 *dao.BeatsSheetEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.BeatsSheetEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.BeatsSheetEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRegenerateBeatsSource_SelectBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectBeatsSheet'
+type MockRegenerateBeatsSource_SelectBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// SelectBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data uuid.UUID
+func (_e *MockRegenerateBeatsSource_Expecter) SelectBeatsSheet(ctx interface{}, data interface{}) *MockRegenerateBeatsSource_SelectBeatsSheet_Call {
+	return &MockRegenerateBeatsSource_SelectBeatsSheet_Call{Call: _e.mock.On("SelectBeatsSheet", ctx, data)}
+}
+
+func (_c *MockRegenerateBeatsSource_SelectBeatsSheet_Call) Run(run func(ctx context.Context, data uuid.UUID)) *MockRegenerateBeatsSource_SelectBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRegenerateBeatsSource_SelectBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockRegenerateBeatsSource_SelectBeatsSheet_Call {
+	_c.Call.Return(beatsSheetEntity, err)
+	return _c
+}
+
+func (_c *MockRegenerateBeatsSource_SelectBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)) *MockRegenerateBeatsSource_SelectBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectLogline provides a mock function for the type MockRegenerateBeatsSource
+func (_mock *MockRegenerateBeatsSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRegenerateBeatsSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockRegenerateBeatsSource_SelectLogline_Call struct {
+	*mock.Call
+}
+
+// SelectLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectLoglineData
+func (_e *MockRegenerateBeatsSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockRegenerateBeatsSource_SelectLogline_Call {
+	return &MockRegenerateBeatsSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+}
+
+func (_c *MockRegenerateBeatsSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockRegenerateBeatsSource_SelectLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRegenerateBeatsSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockRegenerateBeatsSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockRegenerateBeatsSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockRegenerateBeatsSource_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectStoryPlan provides a mock function for the type MockRegenerateBeatsSource
+func (_mock *MockRegenerateBeatsSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectStoryPlan")
+	}
+
+	var r0 *storyplanmodel.Plan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) *storyplanmodel.Plan); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*storyplanmodel.Plan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectStoryPlanRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRegenerateBeatsSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
+type MockRegenerateBeatsSource_SelectStoryPlan_Call struct {
+	*mock.Call
+}
+
+// SelectStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectStoryPlanRequest
+func (_e *MockRegenerateBeatsSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockRegenerateBeatsSource_SelectStoryPlan_Call {
+	return &MockRegenerateBeatsSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
+}
+
+func (_c *MockRegenerateBeatsSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockRegenerateBeatsSource_SelectStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectStoryPlanRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectStoryPlanRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRegenerateBeatsSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockRegenerateBeatsSource_SelectStoryPlan_Call {
+	_c.Call.Return(plan, err)
+	return _c
+}
+
+func (_c *MockRegenerateBeatsSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockRegenerateBeatsSource_SelectStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestoreBeatsSheetSource creates a new instance of MockRestoreBeatsSheetSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestoreBeatsSheetSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestoreBeatsSheetSource {
+	mock := &MockRestoreBeatsSheetSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRestoreBeatsSheetSource is an autogenerated mock type for the RestoreBeatsSheetSource type
+type MockRestoreBeatsSheetSource struct {
+	mock.Mock
+}
+
+type MockRestoreBeatsSheetSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestoreBeatsSheetSource) EXPECT() *MockRestoreBeatsSheetSource_Expecter {
+	return &MockRestoreBeatsSheetSource_Expecter{mock: &_m.Mock}
+}
+
+// RestoreBeatsSheet provides a mock function for the type MockRestoreBeatsSheetSource
+func (_mock *MockRestoreBeatsSheetSource) RestoreBeatsSheet(ctx context.Context, data dao.RestoreBeatsSheetData) (*dao.BeatsSheetEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreBeatsSheet")
+	}
+
+	var r0 *dao.BeatsSheetEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.RestoreBeatsSheetData) (*dao.BeatsSheetEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.RestoreBeatsSheetData) *dao.BeatsSheetEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.RestoreBeatsSheetData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestoreBeatsSheetSource_RestoreBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreBeatsSheet'
+type MockRestoreBeatsSheetSource_RestoreBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// RestoreBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.RestoreBeatsSheetData
+func (_e *MockRestoreBeatsSheetSource_Expecter) RestoreBeatsSheet(ctx interface{}, data interface{}) *MockRestoreBeatsSheetSource_RestoreBeatsSheet_Call {
+	return &MockRestoreBeatsSheetSource_RestoreBeatsSheet_Call{Call: _e.mock.On("RestoreBeatsSheet", ctx, data)}
+}
+
+func (_c *MockRestoreBeatsSheetSource_RestoreBeatsSheet_Call) Run(run func(ctx context.Context, data dao.RestoreBeatsSheetData)) *MockRestoreBeatsSheetSource_RestoreBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.RestoreBeatsSheetData
+		if args[1] != nil {
+			arg1 = args[1].(dao.RestoreBeatsSheetData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestoreBeatsSheetSource_RestoreBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockRestoreBeatsSheetSource_RestoreBeatsSheet_Call {
+	_c.Call.Return(beatsSheetEntity, err)
+	return _c
+}
+
+func (_c *MockRestoreBeatsSheetSource_RestoreBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data dao.RestoreBeatsSheetData) (*dao.BeatsSheetEntity, error)) *MockRestoreBeatsSheetSource_RestoreBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectBeatsSheet provides a mock function for the type MockRestoreBeatsSheetSource
+func (_mock *MockRestoreBeatsSheetSource) SelectBeatsSheet(ctx context.Context, request services.SelectBeatsSheetRequest) (*models.BeatsSheet, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectBeatsSheet")
+	}
+
+	var r0 *models.BeatsSheet
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectBeatsSheetRequest) (*models.BeatsSheet, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectBeatsSheetRequest) *models.BeatsSheet); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BeatsSheet)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectBeatsSheetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestoreBeatsSheetSource_SelectBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectBeatsSheet'
+type MockRestoreBeatsSheetSource_SelectBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// SelectBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectBeatsSheetRequest
+func (_e *MockRestoreBeatsSheetSource_Expecter) SelectBeatsSheet(ctx interface{}, request interface{}) *MockRestoreBeatsSheetSource_SelectBeatsSheet_Call {
+	return &MockRestoreBeatsSheetSource_SelectBeatsSheet_Call{Call: _e.mock.On("SelectBeatsSheet", ctx, request)}
+}
+
+func (_c *MockRestoreBeatsSheetSource_SelectBeatsSheet_Call) Run(run func(ctx context.Context, request services.SelectBeatsSheetRequest)) *MockRestoreBeatsSheetSource_SelectBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectBeatsSheetRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectBeatsSheetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestoreBeatsSheetSource_SelectBeatsSheet_Call) Return(beatsSheet *models.BeatsSheet, err error) *MockRestoreBeatsSheetSource_SelectBeatsSheet_Call {
+	_c.Call.Return(beatsSheet, err)
+	return _c
+}
+
+func (_c *MockRestoreBeatsSheetSource_SelectBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request services.SelectBeatsSheetRequest) (*models.BeatsSheet, error)) *MockRestoreBeatsSheetSource_SelectBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestoreLoglineSource creates a new instance of MockRestoreLoglineSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestoreLoglineSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestoreLoglineSource {
+	mock := &MockRestoreLoglineSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRestoreLoglineSource is an autogenerated mock type for the RestoreLoglineSource type
+type MockRestoreLoglineSource struct {
+	mock.Mock
+}
+
+type MockRestoreLoglineSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestoreLoglineSource) EXPECT() *MockRestoreLoglineSource_Expecter {
+	return &MockRestoreLoglineSource_Expecter{mock: &_m.Mock}
+}
+
+// RestoreLogline provides a mock function for the type MockRestoreLoglineSource
+func (_mock *MockRestoreLoglineSource) RestoreLogline(ctx context.Context, data dao.RestoreLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.RestoreLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.RestoreLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.RestoreLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestoreLoglineSource_RestoreLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreLogline'
+type MockRestoreLoglineSource_RestoreLogline_Call struct {
+	*mock.Call
+}
+
+// RestoreLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.RestoreLoglineData
+func (_e *MockRestoreLoglineSource_Expecter) RestoreLogline(ctx interface{}, data interface{}) *MockRestoreLoglineSource_RestoreLogline_Call {
+	return &MockRestoreLoglineSource_RestoreLogline_Call{Call: _e.mock.On("RestoreLogline", ctx, data)}
+}
+
+func (_c *MockRestoreLoglineSource_RestoreLogline_Call) Run(run func(ctx context.Context, data dao.RestoreLoglineData)) *MockRestoreLoglineSource_RestoreLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.RestoreLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.RestoreLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestoreLoglineSource_RestoreLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockRestoreLoglineSource_RestoreLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockRestoreLoglineSource_RestoreLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.RestoreLoglineData) (*dao.LoglineEntity, error)) *MockRestoreLoglineSource_RestoreLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestoreLoglineRevisionSource creates a new instance of MockRestoreLoglineRevisionSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestoreLoglineRevisionSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestoreLoglineRevisionSource {
+	mock := &MockRestoreLoglineRevisionSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRestoreLoglineRevisionSource is an autogenerated mock type for the RestoreLoglineRevisionSource type
+type MockRestoreLoglineRevisionSource struct {
+	mock.Mock
+}
+
+type MockRestoreLoglineRevisionSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestoreLoglineRevisionSource) EXPECT() *MockRestoreLoglineRevisionSource_Expecter {
+	return &MockRestoreLoglineRevisionSource_Expecter{mock: &_m.Mock}
+}
+
+// SelectLogline provides a mock function for the type MockRestoreLoglineRevisionSource
+func (_mock *MockRestoreLoglineRevisionSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestoreLoglineRevisionSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockRestoreLoglineRevisionSource_SelectLogline_Call struct {
+	*mock.Call
+}
+
+// SelectLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectLoglineData
+func (_e *MockRestoreLoglineRevisionSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockRestoreLoglineRevisionSource_SelectLogline_Call {
+	return &MockRestoreLoglineRevisionSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+}
+
+func (_c *MockRestoreLoglineRevisionSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockRestoreLoglineRevisionSource_SelectLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestoreLoglineRevisionSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockRestoreLoglineRevisionSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockRestoreLoglineRevisionSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockRestoreLoglineRevisionSource_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectLoglineRevision provides a mock function for the type MockRestoreLoglineRevisionSource
+func (_mock *MockRestoreLoglineRevisionSource) SelectLoglineRevision(ctx context.Context, data uuid.UUID) (*dao.LoglineRevisionEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLoglineRevision")
+	}
+
+	var r0 *dao.LoglineRevisionEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.LoglineRevisionEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.LoglineRevisionEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineRevisionEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestoreLoglineRevisionSource_SelectLoglineRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLoglineRevision'
+type MockRestoreLoglineRevisionSource_SelectLoglineRevision_Call struct {
+	*mock.Call
+}
+
+// SelectLoglineRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data uuid.UUID
+func (_e *MockRestoreLoglineRevisionSource_Expecter) SelectLoglineRevision(ctx interface{}, data interface{}) *MockRestoreLoglineRevisionSource_SelectLoglineRevision_Call {
+	return &MockRestoreLoglineRevisionSource_SelectLoglineRevision_Call{Call: _e.mock.On("SelectLoglineRevision", ctx, data)}
+}
+
+func (_c *MockRestoreLoglineRevisionSource_SelectLoglineRevision_Call) Run(run func(ctx context.Context, data uuid.UUID)) *MockRestoreLoglineRevisionSource_SelectLoglineRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestoreLoglineRevisionSource_SelectLoglineRevision_Call) Return(loglineRevisionEntity *dao.LoglineRevisionEntity, err error) *MockRestoreLoglineRevisionSource_SelectLoglineRevision_Call {
+	_c.Call.Return(loglineRevisionEntity, err)
+	return _c
+}
+
+func (_c *MockRestoreLoglineRevisionSource_SelectLoglineRevision_Call) RunAndReturn(run func(ctx context.Context, data uuid.UUID) (*dao.LoglineRevisionEntity, error)) *MockRestoreLoglineRevisionSource_SelectLoglineRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLogline provides a mock function for the type MockRestoreLoglineRevisionSource
+func (_mock *MockRestoreLoglineRevisionSource) UpdateLogline(ctx context.Context, data dao.UpdateLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.UpdateLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestoreLoglineRevisionSource_UpdateLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLogline'
+type MockRestoreLoglineRevisionSource_UpdateLogline_Call struct {
+	*mock.Call
+}
+
+// UpdateLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.UpdateLoglineData
+func (_e *MockRestoreLoglineRevisionSource_Expecter) UpdateLogline(ctx interface{}, data interface{}) *MockRestoreLoglineRevisionSource_UpdateLogline_Call {
+	return &MockRestoreLoglineRevisionSource_UpdateLogline_Call{Call: _e.mock.On("UpdateLogline", ctx, data)}
+}
+
+func (_c *MockRestoreLoglineRevisionSource_UpdateLogline_Call) Run(run func(ctx context.Context, data dao.UpdateLoglineData)) *MockRestoreLoglineRevisionSource_UpdateLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.UpdateLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.UpdateLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestoreLoglineRevisionSource_UpdateLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockRestoreLoglineRevisionSource_UpdateLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockRestoreLoglineRevisionSource_UpdateLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.UpdateLoglineData) (*dao.LoglineEntity, error)) *MockRestoreLoglineRevisionSource_UpdateLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSearchSource creates a new instance of MockSearchSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSearchSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSearchSource {
+	mock := &MockSearchSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSearchSource is an autogenerated mock type for the SearchSource type
+type MockSearchSource struct {
+	mock.Mock
+}
+
+type MockSearchSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSearchSource) EXPECT() *MockSearchSource_Expecter {
+	return &MockSearchSource_Expecter{mock: &_m.Mock}
+}
+
+// Search provides a mock function for the type MockSearchSource
+func (_mock *MockSearchSource) Search(ctx context.Context, data dao.SearchData) ([]*dao.SearchHitEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*dao.SearchHitEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SearchData) ([]*dao.SearchHitEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SearchData) []*dao.SearchHitEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.SearchHitEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SearchData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSearchSource_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockSearchSource_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SearchData
+func (_e *MockSearchSource_Expecter) Search(ctx interface{}, data interface{}) *MockSearchSource_Search_Call {
+	return &MockSearchSource_Search_Call{Call: _e.mock.On("Search", ctx, data)}
+}
+
+func (_c *MockSearchSource_Search_Call) Run(run func(ctx context.Context, data dao.SearchData)) *MockSearchSource_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SearchData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SearchData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSearchSource_Search_Call) Return(searchHitEntitys []*dao.SearchHitEntity, err error) *MockSearchSource_Search_Call {
+	_c.Call.Return(searchHitEntitys, err)
+	return _c
+}
+
+func (_c *MockSearchSource_Search_Call) RunAndReturn(run func(ctx context.Context, data dao.SearchData) ([]*dao.SearchHitEntity, error)) *MockSearchSource_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSeedStoryPlansSource creates a new instance of MockSeedStoryPlansSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSeedStoryPlansSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSeedStoryPlansSource {
+	mock := &MockSeedStoryPlansSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSeedStoryPlansSource is an autogenerated mock type for the SeedStoryPlansSource type
+type MockSeedStoryPlansSource struct {
+	mock.Mock
+}
+
+type MockSeedStoryPlansSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSeedStoryPlansSource) EXPECT() *MockSeedStoryPlansSource_Expecter {
+	return &MockSeedStoryPlansSource_Expecter{mock: &_m.Mock}
+}
+
+// InsertStoryPlan provides a mock function for the type MockSeedStoryPlansSource
+func (_mock *MockSeedStoryPlansSource) InsertStoryPlan(ctx context.Context, data dao.InsertStoryPlanData) (*dao.StoryPlanEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertStoryPlan")
+	}
+
+	var r0 *dao.StoryPlanEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertStoryPlanData) (*dao.StoryPlanEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertStoryPlanData) *dao.StoryPlanEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.StoryPlanEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.InsertStoryPlanData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeedStoryPlansSource_InsertStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertStoryPlan'
+type MockSeedStoryPlansSource_InsertStoryPlan_Call struct {
+	*mock.Call
+}
+
+// InsertStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.InsertStoryPlanData
+func (_e *MockSeedStoryPlansSource_Expecter) InsertStoryPlan(ctx interface{}, data interface{}) *MockSeedStoryPlansSource_InsertStoryPlan_Call {
+	return &MockSeedStoryPlansSource_InsertStoryPlan_Call{Call: _e.mock.On("InsertStoryPlan", ctx, data)}
+}
+
+func (_c *MockSeedStoryPlansSource_InsertStoryPlan_Call) Run(run func(ctx context.Context, data dao.InsertStoryPlanData)) *MockSeedStoryPlansSource_InsertStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.InsertStoryPlanData
+		if args[1] != nil {
+			arg1 = args[1].(dao.InsertStoryPlanData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeedStoryPlansSource_InsertStoryPlan_Call) Return(storyPlanEntity *dao.StoryPlanEntity, err error) *MockSeedStoryPlansSource_InsertStoryPlan_Call {
+	_c.Call.Return(storyPlanEntity, err)
+	return _c
+}
+
+func (_c *MockSeedStoryPlansSource_InsertStoryPlan_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertStoryPlanData) (*dao.StoryPlanEntity, error)) *MockSeedStoryPlansSource_InsertStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectStoryPlanBySlug provides a mock function for the type MockSeedStoryPlansSource
+func (_mock *MockSeedStoryPlansSource) SelectStoryPlanBySlug(ctx context.Context, data dao.SelectStoryPlanBySlugData) (*dao.StoryPlanEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectStoryPlanBySlug")
+	}
+
+	var r0 *dao.StoryPlanEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectStoryPlanBySlugData) (*dao.StoryPlanEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectStoryPlanBySlugData) *dao.StoryPlanEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.StoryPlanEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectStoryPlanBySlugData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeedStoryPlansSource_SelectStoryPlanBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlanBySlug'
+type MockSeedStoryPlansSource_SelectStoryPlanBySlug_Call struct {
+	*mock.Call
+}
+
+// SelectStoryPlanBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectStoryPlanBySlugData
+func (_e *MockSeedStoryPlansSource_Expecter) SelectStoryPlanBySlug(ctx interface{}, data interface{}) *MockSeedStoryPlansSource_SelectStoryPlanBySlug_Call {
+	return &MockSeedStoryPlansSource_SelectStoryPlanBySlug_Call{Call: _e.mock.On("SelectStoryPlanBySlug", ctx, data)}
+}
+
+func (_c *MockSeedStoryPlansSource_SelectStoryPlanBySlug_Call) Run(run func(ctx context.Context, data dao.SelectStoryPlanBySlugData)) *MockSeedStoryPlansSource_SelectStoryPlanBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectStoryPlanBySlugData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectStoryPlanBySlugData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeedStoryPlansSource_SelectStoryPlanBySlug_Call) Return(storyPlanEntity *dao.StoryPlanEntity, err error) *MockSeedStoryPlansSource_SelectStoryPlanBySlug_Call {
+	_c.Call.Return(storyPlanEntity, err)
+	return _c
+}
+
+func (_c *MockSeedStoryPlansSource_SelectStoryPlanBySlug_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectStoryPlanBySlugData) (*dao.StoryPlanEntity, error)) *MockSeedStoryPlansSource_SelectStoryPlanBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStoryPlan provides a mock function for the type MockSeedStoryPlansSource
+func (_mock *MockSeedStoryPlansSource) UpdateStoryPlan(ctx context.Context, data dao.UpdateStoryPlanData) (*dao.StoryPlanEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStoryPlan")
+	}
+
+	var r0 *dao.StoryPlanEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateStoryPlanData) (*dao.StoryPlanEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateStoryPlanData) *dao.StoryPlanEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.StoryPlanEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.UpdateStoryPlanData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeedStoryPlansSource_UpdateStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStoryPlan'
+type MockSeedStoryPlansSource_UpdateStoryPlan_Call struct {
+	*mock.Call
+}
+
+// UpdateStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.UpdateStoryPlanData
+func (_e *MockSeedStoryPlansSource_Expecter) UpdateStoryPlan(ctx interface{}, data interface{}) *MockSeedStoryPlansSource_UpdateStoryPlan_Call {
+	return &MockSeedStoryPlansSource_UpdateStoryPlan_Call{Call: _e.mock.On("UpdateStoryPlan", ctx, data)}
+}
+
+func (_c *MockSeedStoryPlansSource_UpdateStoryPlan_Call) Run(run func(ctx context.Context, data dao.UpdateStoryPlanData)) *MockSeedStoryPlansSource_UpdateStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.UpdateStoryPlanData
+		if args[1] != nil {
+			arg1 = args[1].(dao.UpdateStoryPlanData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeedStoryPlansSource_UpdateStoryPlan_Call) Return(storyPlanEntity *dao.StoryPlanEntity, err error) *MockSeedStoryPlansSource_UpdateStoryPlan_Call {
+	_c.Call.Return(storyPlanEntity, err)
+	return _c
+}
+
+func (_c *MockSeedStoryPlansSource_UpdateStoryPlan_Call) RunAndReturn(run func(ctx context.Context, data dao.UpdateStoryPlanData) (*dao.StoryPlanEntity, error)) *MockSeedStoryPlansSource_UpdateStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStoryPlanSeedHash provides a mock function for the type MockSeedStoryPlansSource
+func (_mock *MockSeedStoryPlansSource) UpdateStoryPlanSeedHash(ctx context.Context, data dao.UpdateStoryPlanSeedHashData) (*dao.StoryPlanEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStoryPlanSeedHash")
+	}
+
+	var r0 *dao.StoryPlanEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateStoryPlanSeedHashData) (*dao.StoryPlanEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateStoryPlanSeedHashData) *dao.StoryPlanEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.StoryPlanEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.UpdateStoryPlanSeedHashData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeedStoryPlansSource_UpdateStoryPlanSeedHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStoryPlanSeedHash'
+type MockSeedStoryPlansSource_UpdateStoryPlanSeedHash_Call struct {
+	*mock.Call
+}
+
+// UpdateStoryPlanSeedHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.UpdateStoryPlanSeedHashData
+func (_e *MockSeedStoryPlansSource_Expecter) UpdateStoryPlanSeedHash(ctx interface{}, data interface{}) *MockSeedStoryPlansSource_UpdateStoryPlanSeedHash_Call {
+	return &MockSeedStoryPlansSource_UpdateStoryPlanSeedHash_Call{Call: _e.mock.On("UpdateStoryPlanSeedHash", ctx, data)}
+}
+
+func (_c *MockSeedStoryPlansSource_UpdateStoryPlanSeedHash_Call) Run(run func(ctx context.Context, data dao.UpdateStoryPlanSeedHashData)) *MockSeedStoryPlansSource_UpdateStoryPlanSeedHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.UpdateStoryPlanSeedHashData
+		if args[1] != nil {
+			arg1 = args[1].(dao.UpdateStoryPlanSeedHashData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeedStoryPlansSource_UpdateStoryPlanSeedHash_Call) Return(storyPlanEntity *dao.StoryPlanEntity, err error) *MockSeedStoryPlansSource_UpdateStoryPlanSeedHash_Call {
+	_c.Call.Return(storyPlanEntity, err)
+	return _c
+}
+
+func (_c *MockSeedStoryPlansSource_UpdateStoryPlanSeedHash_Call) RunAndReturn(run func(ctx context.Context, data dao.UpdateStoryPlanSeedHashData) (*dao.StoryPlanEntity, error)) *MockSeedStoryPlansSource_UpdateStoryPlanSeedHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSelectBeatsSheetSource creates a new instance of MockSelectBeatsSheetSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSelectBeatsSheetSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSelectBeatsSheetSource {
+	mock := &MockSelectBeatsSheetSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSelectBeatsSheetSource is an autogenerated mock type for the SelectBeatsSheetSource type
+type MockSelectBeatsSheetSource struct {
+	mock.Mock
+}
+
+type MockSelectBeatsSheetSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSelectBeatsSheetSource) EXPECT() *MockSelectBeatsSheetSource_Expecter {
+	return &MockSelectBeatsSheetSource_Expecter{mock: &_m.Mock}
+}
+
+// SelectBeatsSheet provides a mock function for the type MockSelectBeatsSheetSource
+func (_mock *MockSelectBeatsSheetSource) SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectBeatsSheet")
+	}
+
+	var r0 *dao.BeatsSheetEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.BeatsSheetEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.BeatsSheetEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectBeatsSheetSource_SelectBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectBeatsSheet'
+type MockSelectBeatsSheetSource_SelectBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// SelectBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data uuid.UUID
+func (_e *MockSelectBeatsSheetSource_Expecter) SelectBeatsSheet(ctx interface{}, data interface{}) *MockSelectBeatsSheetSource_SelectBeatsSheet_Call {
+	return &MockSelectBeatsSheetSource_SelectBeatsSheet_Call{Call: _e.mock.On("SelectBeatsSheet", ctx, data)}
+}
+
+func (_c *MockSelectBeatsSheetSource_SelectBeatsSheet_Call) Run(run func(ctx context.Context, data uuid.UUID)) *MockSelectBeatsSheetSource_SelectBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectBeatsSheetSource_SelectBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockSelectBeatsSheetSource_SelectBeatsSheet_Call {
+	_c.Call.Return(beatsSheetEntity, err)
+	return _c
+}
+
+func (_c *MockSelectBeatsSheetSource_SelectBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)) *MockSelectBeatsSheetSource_SelectBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectLogline provides a mock function for the type MockSelectBeatsSheetSource
+func (_mock *MockSelectBeatsSheetSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectBeatsSheetSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockSelectBeatsSheetSource_SelectLogline_Call struct {
+	*mock.Call
+}
+
+// SelectLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectLoglineData
+func (_e *MockSelectBeatsSheetSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockSelectBeatsSheetSource_SelectLogline_Call {
+	return &MockSelectBeatsSheetSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+}
+
+func (_c *MockSelectBeatsSheetSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockSelectBeatsSheetSource_SelectLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectBeatsSheetSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockSelectBeatsSheetSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockSelectBeatsSheetSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockSelectBeatsSheetSource_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectStoryPlan provides a mock function for the type MockSelectBeatsSheetSource
+func (_mock *MockSelectBeatsSheetSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectStoryPlan")
+	}
+
+	var r0 *storyplanmodel.Plan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) *storyplanmodel.Plan); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*storyplanmodel.Plan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectStoryPlanRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectBeatsSheetSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
+type MockSelectBeatsSheetSource_SelectStoryPlan_Call struct {
+	*mock.Call
+}
+
+// SelectStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectStoryPlanRequest
+func (_e *MockSelectBeatsSheetSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockSelectBeatsSheetSource_SelectStoryPlan_Call {
+	return &MockSelectBeatsSheetSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
+}
+
+func (_c *MockSelectBeatsSheetSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockSelectBeatsSheetSource_SelectStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectStoryPlanRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectStoryPlanRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectBeatsSheetSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockSelectBeatsSheetSource_SelectStoryPlan_Call {
+	_c.Call.Return(plan, err)
+	return _c
+}
+
+func (_c *MockSelectBeatsSheetSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockSelectBeatsSheetSource_SelectStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSelectLoglineSource creates a new instance of MockSelectLoglineSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSelectLoglineSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSelectLoglineSource {
+	mock := &MockSelectLoglineSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSelectLoglineSource is an autogenerated mock type for the SelectLoglineSource type
+type MockSelectLoglineSource struct {
+	mock.Mock
+}
+
+type MockSelectLoglineSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSelectLoglineSource) EXPECT() *MockSelectLoglineSource_Expecter {
+	return &MockSelectLoglineSource_Expecter{mock: &_m.Mock}
+}
+
+// SelectLogline provides a mock function for the type MockSelectLoglineSource
+func (_mock *MockSelectLoglineSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectLoglineSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockSelectLoglineSource_SelectLogline_Call struct {
+	*mock.Call
+}
+
+// SelectLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectLoglineData
+func (_e *MockSelectLoglineSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockSelectLoglineSource_SelectLogline_Call {
+	return &MockSelectLoglineSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+}
+
+func (_c *MockSelectLoglineSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockSelectLoglineSource_SelectLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectLoglineSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockSelectLoglineSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockSelectLoglineSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockSelectLoglineSource_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectLoglineBySlug provides a mock function for the type MockSelectLoglineSource
+func (_mock *MockSelectLoglineSource) SelectLoglineBySlug(ctx context.Context, data dao.SelectLoglineBySlugData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLoglineBySlug")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineBySlugData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineBySlugData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineBySlugData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectLoglineSource_SelectLoglineBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLoglineBySlug'
+type MockSelectLoglineSource_SelectLoglineBySlug_Call struct {
+	*mock.Call
+}
+
+// SelectLoglineBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectLoglineBySlugData
+func (_e *MockSelectLoglineSource_Expecter) SelectLoglineBySlug(ctx interface{}, data interface{}) *MockSelectLoglineSource_SelectLoglineBySlug_Call {
+	return &MockSelectLoglineSource_SelectLoglineBySlug_Call{Call: _e.mock.On("SelectLoglineBySlug", ctx, data)}
+}
+
+func (_c *MockSelectLoglineSource_SelectLoglineBySlug_Call) Run(run func(ctx context.Context, data dao.SelectLoglineBySlugData)) *MockSelectLoglineSource_SelectLoglineBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectLoglineBySlugData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectLoglineBySlugData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectLoglineSource_SelectLoglineBySlug_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockSelectLoglineSource_SelectLoglineBySlug_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockSelectLoglineSource_SelectLoglineBySlug_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineBySlugData) (*dao.LoglineEntity, error)) *MockSelectLoglineSource_SelectLoglineBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSelectLoglineRevisionSource creates a new instance of MockSelectLoglineRevisionSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSelectLoglineRevisionSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSelectLoglineRevisionSource {
+	mock := &MockSelectLoglineRevisionSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSelectLoglineRevisionSource is an autogenerated mock type for the SelectLoglineRevisionSource type
+type MockSelectLoglineRevisionSource struct {
+	mock.Mock
+}
+
+type MockSelectLoglineRevisionSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSelectLoglineRevisionSource) EXPECT() *MockSelectLoglineRevisionSource_Expecter {
+	return &MockSelectLoglineRevisionSource_Expecter{mock: &_m.Mock}
+}
+
+// SelectLogline provides a mock function for the type MockSelectLoglineRevisionSource
+func (_mock *MockSelectLoglineRevisionSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectLoglineRevisionSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockSelectLoglineRevisionSource_SelectLogline_Call struct {
+	*mock.Call
+}
+
+// SelectLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectLoglineData
+func (_e *MockSelectLoglineRevisionSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockSelectLoglineRevisionSource_SelectLogline_Call {
+	return &MockSelectLoglineRevisionSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+}
+
+func (_c *MockSelectLoglineRevisionSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockSelectLoglineRevisionSource_SelectLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectLoglineRevisionSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockSelectLoglineRevisionSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockSelectLoglineRevisionSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockSelectLoglineRevisionSource_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectLoglineRevision provides a mock function for the type MockSelectLoglineRevisionSource
+func (_mock *MockSelectLoglineRevisionSource) SelectLoglineRevision(ctx context.Context, data uuid.UUID) (*dao.LoglineRevisionEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLoglineRevision")
+	}
+
+	var r0 *dao.LoglineRevisionEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.LoglineRevisionEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.LoglineRevisionEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineRevisionEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectLoglineRevisionSource_SelectLoglineRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLoglineRevision'
+type MockSelectLoglineRevisionSource_SelectLoglineRevision_Call struct {
+	*mock.Call
+}
+
+// SelectLoglineRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data uuid.UUID
+func (_e *MockSelectLoglineRevisionSource_Expecter) SelectLoglineRevision(ctx interface{}, data interface{}) *MockSelectLoglineRevisionSource_SelectLoglineRevision_Call {
+	return &MockSelectLoglineRevisionSource_SelectLoglineRevision_Call{Call: _e.mock.On("SelectLoglineRevision", ctx, data)}
+}
+
+func (_c *MockSelectLoglineRevisionSource_SelectLoglineRevision_Call) Run(run func(ctx context.Context, data uuid.UUID)) *MockSelectLoglineRevisionSource_SelectLoglineRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectLoglineRevisionSource_SelectLoglineRevision_Call) Return(loglineRevisionEntity *dao.LoglineRevisionEntity, err error) *MockSelectLoglineRevisionSource_SelectLoglineRevision_Call {
+	_c.Call.Return(loglineRevisionEntity, err)
+	return _c
+}
+
+func (_c *MockSelectLoglineRevisionSource_SelectLoglineRevision_Call) RunAndReturn(run func(ctx context.Context, data uuid.UUID) (*dao.LoglineRevisionEntity, error)) *MockSelectLoglineRevisionSource_SelectLoglineRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSelectProjectSource creates a new instance of MockSelectProjectSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSelectProjectSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSelectProjectSource {
+	mock := &MockSelectProjectSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSelectProjectSource is an autogenerated mock type for the SelectProjectSource type
+type MockSelectProjectSource struct {
+	mock.Mock
+}
+
+type MockSelectProjectSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSelectProjectSource) EXPECT() *MockSelectProjectSource_Expecter {
+	return &MockSelectProjectSource_Expecter{mock: &_m.Mock}
+}
+
+// SelectProject provides a mock function for the type MockSelectProjectSource
+func (_mock *MockSelectProjectSource) SelectProject(ctx context.Context, data dao.SelectProjectData) (*dao.ProjectEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectProject")
+	}
+
+	var r0 *dao.ProjectEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectProjectData) (*dao.ProjectEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectProjectData) *dao.ProjectEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectProjectData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectProjectSource_SelectProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectProject'
+type MockSelectProjectSource_SelectProject_Call struct {
+	*mock.Call
+}
+
+// SelectProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectProjectData
+func (_e *MockSelectProjectSource_Expecter) SelectProject(ctx interface{}, data interface{}) *MockSelectProjectSource_SelectProject_Call {
+	return &MockSelectProjectSource_SelectProject_Call{Call: _e.mock.On("SelectProject", ctx, data)}
+}
+
+func (_c *MockSelectProjectSource_SelectProject_Call) Run(run func(ctx context.Context, data dao.SelectProjectData)) *MockSelectProjectSource_SelectProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectProjectData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectProjectData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectProjectSource_SelectProject_Call) Return(projectEntity *dao.ProjectEntity, err error) *MockSelectProjectSource_SelectProject_Call {
+	_c.Call.Return(projectEntity, err)
+	return _c
+}
+
+func (_c *MockSelectProjectSource_SelectProject_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectProjectData) (*dao.ProjectEntity, error)) *MockSelectProjectSource_SelectProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSelectStoryPlanSource creates a new instance of MockSelectStoryPlanSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSelectStoryPlanSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSelectStoryPlanSource {
+	mock := &MockSelectStoryPlanSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSelectStoryPlanSource is an autogenerated mock type for the SelectStoryPlanSource type
+type MockSelectStoryPlanSource struct {
+	mock.Mock
+}
+
+type MockSelectStoryPlanSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSelectStoryPlanSource) EXPECT() *MockSelectStoryPlanSource_Expecter {
+	return &MockSelectStoryPlanSource_Expecter{mock: &_m.Mock}
+}
+
+// SelectStoryPlan provides a mock function for the type MockSelectStoryPlanSource
+func (_mock *MockSelectStoryPlanSource) SelectStoryPlan(ctx context.Context, data dao.SelectStoryPlanData) (*dao.StoryPlanEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectStoryPlan")
+	}
+
+	var r0 *dao.StoryPlanEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectStoryPlanData) (*dao.StoryPlanEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectStoryPlanData) *dao.StoryPlanEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.StoryPlanEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectStoryPlanData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectStoryPlanSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
+type MockSelectStoryPlanSource_SelectStoryPlan_Call struct {
+	*mock.Call
+}
+
+// SelectStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectStoryPlanData
+func (_e *MockSelectStoryPlanSource_Expecter) SelectStoryPlan(ctx interface{}, data interface{}) *MockSelectStoryPlanSource_SelectStoryPlan_Call {
+	return &MockSelectStoryPlanSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, data)}
+}
+
+func (_c *MockSelectStoryPlanSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, data dao.SelectStoryPlanData)) *MockSelectStoryPlanSource_SelectStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectStoryPlanData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectStoryPlanData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectStoryPlanSource_SelectStoryPlan_Call) Return(storyPlanEntity *dao.StoryPlanEntity, err error) *MockSelectStoryPlanSource_SelectStoryPlan_Call {
+	_c.Call.Return(storyPlanEntity, err)
+	return _c
+}
+
+func (_c *MockSelectStoryPlanSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectStoryPlanData) (*dao.StoryPlanEntity, error)) *MockSelectStoryPlanSource_SelectStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectStoryPlanBySlug provides a mock function for the type MockSelectStoryPlanSource
+func (_mock *MockSelectStoryPlanSource) SelectStoryPlanBySlug(ctx context.Context, data dao.SelectStoryPlanBySlugData) (*dao.StoryPlanEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectStoryPlanBySlug")
+	}
+
+	var r0 *dao.StoryPlanEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectStoryPlanBySlugData) (*dao.StoryPlanEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectStoryPlanBySlugData) *dao.StoryPlanEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.StoryPlanEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectStoryPlanBySlugData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSelectStoryPlanSource_SelectStoryPlanBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlanBySlug'
+type MockSelectStoryPlanSource_SelectStoryPlanBySlug_Call struct {
+	*mock.Call
+}
+
+// SelectStoryPlanBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectStoryPlanBySlugData
+func (_e *MockSelectStoryPlanSource_Expecter) SelectStoryPlanBySlug(ctx interface{}, data interface{}) *MockSelectStoryPlanSource_SelectStoryPlanBySlug_Call {
+	return &MockSelectStoryPlanSource_SelectStoryPlanBySlug_Call{Call: _e.mock.On("SelectStoryPlanBySlug", ctx, data)}
+}
+
+func (_c *MockSelectStoryPlanSource_SelectStoryPlanBySlug_Call) Run(run func(ctx context.Context, data dao.SelectStoryPlanBySlugData)) *MockSelectStoryPlanSource_SelectStoryPlanBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectStoryPlanBySlugData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectStoryPlanBySlugData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSelectStoryPlanSource_SelectStoryPlanBySlug_Call) Return(storyPlanEntity *dao.StoryPlanEntity, err error) *MockSelectStoryPlanSource_SelectStoryPlanBySlug_Call {
+	_c.Call.Return(storyPlanEntity, err)
+	return _c
+}
+
+func (_c *MockSelectStoryPlanSource_SelectStoryPlanBySlug_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectStoryPlanBySlugData) (*dao.StoryPlanEntity, error)) *MockSelectStoryPlanSource_SelectStoryPlanBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTranslateBeatsSheetSource creates a new instance of MockTranslateBeatsSheetSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTranslateBeatsSheetSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTranslateBeatsSheetSource {
+	mock := &MockTranslateBeatsSheetSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTranslateBeatsSheetSource is an autogenerated mock type for the TranslateBeatsSheetSource type
+type MockTranslateBeatsSheetSource struct {
+	mock.Mock
+}
+
+type MockTranslateBeatsSheetSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTranslateBeatsSheetSource) EXPECT() *MockTranslateBeatsSheetSource_Expecter {
+	return &MockTranslateBeatsSheetSource_Expecter{mock: &_m.Mock}
+}
+
+// InsertBeatsSheet provides a mock function for the type MockTranslateBeatsSheetSource
+func (_mock *MockTranslateBeatsSheetSource) InsertBeatsSheet(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertBeatsSheet")
+	}
+
+	var r0 *dao.BeatsSheetEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertBeatsSheetData) *dao.BeatsSheetEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.InsertBeatsSheetData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTranslateBeatsSheetSource_InsertBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertBeatsSheet'
+type MockTranslateBeatsSheetSource_InsertBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// InsertBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.InsertBeatsSheetData
+func (_e *MockTranslateBeatsSheetSource_Expecter) InsertBeatsSheet(ctx interface{}, data interface{}) *MockTranslateBeatsSheetSource_InsertBeatsSheet_Call {
+	return &MockTranslateBeatsSheetSource_InsertBeatsSheet_Call{Call: _e.mock.On("InsertBeatsSheet", ctx, data)}
+}
+
+func (_c *MockTranslateBeatsSheetSource_InsertBeatsSheet_Call) Run(run func(ctx context.Context, data dao.InsertBeatsSheetData)) *MockTranslateBeatsSheetSource_InsertBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.InsertBeatsSheetData
+		if args[1] != nil {
+			arg1 = args[1].(dao.InsertBeatsSheetData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTranslateBeatsSheetSource_InsertBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockTranslateBeatsSheetSource_InsertBeatsSheet_Call {
+	_c.Call.Return(beatsSheetEntity, err)
+	return _c
+}
+
+func (_c *MockTranslateBeatsSheetSource_InsertBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)) *MockTranslateBeatsSheetSource_InsertBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectBeatsSheet provides a mock function for the type MockTranslateBeatsSheetSource
+func (_mock *MockTranslateBeatsSheetSource) SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectBeatsSheet")
+	}
+
+	var r0 *dao.BeatsSheetEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.BeatsSheetEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.BeatsSheetEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTranslateBeatsSheetSource_SelectBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectBeatsSheet'
+type MockTranslateBeatsSheetSource_SelectBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// SelectBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data uuid.UUID
+func (_e *MockTranslateBeatsSheetSource_Expecter) SelectBeatsSheet(ctx interface{}, data interface{}) *MockTranslateBeatsSheetSource_SelectBeatsSheet_Call {
+	return &MockTranslateBeatsSheetSource_SelectBeatsSheet_Call{Call: _e.mock.On("SelectBeatsSheet", ctx, data)}
+}
+
+func (_c *MockTranslateBeatsSheetSource_SelectBeatsSheet_Call) Run(run func(ctx context.Context, data uuid.UUID)) *MockTranslateBeatsSheetSource_SelectBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTranslateBeatsSheetSource_SelectBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockTranslateBeatsSheetSource_SelectBeatsSheet_Call {
+	_c.Call.Return(beatsSheetEntity, err)
+	return _c
+}
+
+func (_c *MockTranslateBeatsSheetSource_SelectBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)) *MockTranslateBeatsSheetSource_SelectBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectLogline provides a mock function for the type MockTranslateBeatsSheetSource
+func (_mock *MockTranslateBeatsSheetSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTranslateBeatsSheetSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockTranslateBeatsSheetSource_SelectLogline_Call struct {
+	*mock.Call
+}
+
+// SelectLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.SelectLoglineData
+func (_e *MockTranslateBeatsSheetSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockTranslateBeatsSheetSource_SelectLogline_Call {
+	return &MockTranslateBeatsSheetSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+}
+
+func (_c *MockTranslateBeatsSheetSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockTranslateBeatsSheetSource_SelectLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.SelectLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.SelectLoglineData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTranslateBeatsSheetSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockTranslateBeatsSheetSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
+}
+
+func (_c *MockTranslateBeatsSheetSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockTranslateBeatsSheetSource_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectStoryPlan provides a mock function for the type MockTranslateBeatsSheetSource
+func (_mock *MockTranslateBeatsSheetSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectStoryPlan")
+	}
+
+	var r0 *storyplanmodel.Plan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) *storyplanmodel.Plan); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*storyplanmodel.Plan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectStoryPlanRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTranslateBeatsSheetSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
+type MockTranslateBeatsSheetSource_SelectStoryPlan_Call struct {
+	*mock.Call
+}
+
+// SelectStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectStoryPlanRequest
+func (_e *MockTranslateBeatsSheetSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockTranslateBeatsSheetSource_SelectStoryPlan_Call {
+	return &MockTranslateBeatsSheetSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
+}
+
+func (_c *MockTranslateBeatsSheetSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockTranslateBeatsSheetSource_SelectStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectStoryPlanRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectStoryPlanRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTranslateBeatsSheetSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockTranslateBeatsSheetSource_SelectStoryPlan_Call {
+	_c.Call.Return(plan, err)
+	return _c
+}
+
+func (_c *MockTranslateBeatsSheetSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockTranslateBeatsSheetSource_SelectStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TranslateBeatsSheet provides a mock function for the type MockTranslateBeatsSheetSource
+func (_mock *MockTranslateBeatsSheetSource) TranslateBeatsSheet(ctx context.Context, request daoai.TranslateBeatsSheetRequest) ([]models.Beat, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TranslateBeatsSheet")
+	}
+
+	var r0 []models.Beat
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.TranslateBeatsSheetRequest) ([]models.Beat, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.TranslateBeatsSheetRequest) []models.Beat); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Beat)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.TranslateBeatsSheetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTranslateBeatsSheetSource_TranslateBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TranslateBeatsSheet'
+type MockTranslateBeatsSheetSource_TranslateBeatsSheet_Call struct {
+	*mock.Call
+}
+
+// TranslateBeatsSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request daoai.TranslateBeatsSheetRequest
+func (_e *MockTranslateBeatsSheetSource_Expecter) TranslateBeatsSheet(ctx interface{}, request interface{}) *MockTranslateBeatsSheetSource_TranslateBeatsSheet_Call {
+	return &MockTranslateBeatsSheetSource_TranslateBeatsSheet_Call{Call: _e.mock.On("TranslateBeatsSheet", ctx, request)}
+}
+
+func (_c *MockTranslateBeatsSheetSource_TranslateBeatsSheet_Call) Run(run func(ctx context.Context, request daoai.TranslateBeatsSheetRequest)) *MockTranslateBeatsSheetSource_TranslateBeatsSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 daoai.TranslateBeatsSheetRequest
+		if args[1] != nil {
+			arg1 = args[1].(daoai.TranslateBeatsSheetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTranslateBeatsSheetSource_TranslateBeatsSheet_Call) Return(beats []models.Beat, err error) *MockTranslateBeatsSheetSource_TranslateBeatsSheet_Call {
+	_c.Call.Return(beats, err)
+	return _c
+}
+
+func (_c *MockTranslateBeatsSheetSource_TranslateBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request daoai.TranslateBeatsSheetRequest) ([]models.Beat, error)) *MockTranslateBeatsSheetSource_TranslateBeatsSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTranslateLoglineSource creates a new instance of MockTranslateLoglineSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTranslateLoglineSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTranslateLoglineSource {
+	mock := &MockTranslateLoglineSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTranslateLoglineSource is an autogenerated mock type for the TranslateLoglineSource type
+type MockTranslateLoglineSource struct {
+	mock.Mock
+}
+
+type MockTranslateLoglineSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTranslateLoglineSource) EXPECT() *MockTranslateLoglineSource_Expecter {
+	return &MockTranslateLoglineSource_Expecter{mock: &_m.Mock}
+}
+
+// InsertLogline provides a mock function for the type MockTranslateLoglineSource
+func (_mock *MockTranslateLoglineSource) InsertLogline(ctx context.Context, data dao.InsertLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertLogline")
+	}
+
+	var r0 *dao.LoglineEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoglineEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.InsertLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTranslateLoglineSource_InsertLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertLogline'
+type MockTranslateLoglineSource_InsertLogline_Call struct {
+	*mock.Call
+}
+
+// InsertLogline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data dao.InsertLoglineData
+func (_e *MockTranslateLoglineSource_Expecter) InsertLogline(ctx interface{}, data interface{}) *MockTranslateLoglineSource_InsertLogline_Call {
+	return &MockTranslateLoglineSource_InsertLogline_Call{Call: _e.mock.On("InsertLogline", ctx, data)}
+}
+
+func (_c *MockTranslateLoglineSource_InsertLogline_Call) Run(run func(ctx context.Context, data dao.InsertLoglineData)) *MockTranslateLoglineSource_InsertLogline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dao.InsertLoglineData
+		if args[1] != nil {
+			arg1 = args[1].(dao.InsertLoglineData)
 		}
 		run(
 			arg0,
@@ -567,18 +6367,18 @@ func (_c *MockExpandBeatSource_SelectBeatsSheet_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockExpandBeatSource_SelectBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockExpandBeatSource_SelectBeatsSheet_Call {
-	_c.Call.Return(beatsSheetEntity, err)
+func (_c *MockTranslateLoglineSource_InsertLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockTranslateLoglineSource_InsertLogline_Call {
+	_c.Call.Return(loglineEntity, err)
 	return _c
 }
 
-func (_c *MockExpandBeatSource_SelectBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)) *MockExpandBeatSource_SelectBeatsSheet_Call {
+func (_c *MockTranslateLoglineSource_InsertLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertLoglineData) (*dao.LoglineEntity, error)) *MockTranslateLoglineSource_InsertLogline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectLogline provides a mock function for the type MockExpandBeatSource
-func (_mock *MockExpandBeatSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+// SelectLogline provides a mock function for the type MockTranslateLoglineSource
+func (_mock *MockTranslateLoglineSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
@@ -605,19 +6405,19 @@ func (_mock *MockExpandBeatSource) SelectLogline(ctx context.Context, data dao.S
 	return r0, r1
 }
 
-// MockExpandBeatSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
-type MockExpandBeatSource_SelectLogline_Call struct {
+// MockTranslateLoglineSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockTranslateLoglineSource_SelectLogline_Call struct {
 	*mock.Call
 }
 
 // SelectLogline is a helper method to define mock.On call
 //   - ctx context.Context
 //   - data dao.SelectLoglineData
-func (_e *MockExpandBeatSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockExpandBeatSource_SelectLogline_Call {
-	return &MockExpandBeatSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+func (_e *MockTranslateLoglineSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockTranslateLoglineSource_SelectLogline_Call {
+	return &MockTranslateLoglineSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
 }
 
-func (_c *MockExpandBeatSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockExpandBeatSource_SelectLogline_Call {
+func (_c *MockTranslateLoglineSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockTranslateLoglineSource_SelectLogline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -635,65 +6435,69 @@ func (_c *MockExpandBeatSource_SelectLogline_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockExpandBeatSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockExpandBeatSource_SelectLogline_Call {
+func (_c *MockTranslateLoglineSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockTranslateLoglineSource_SelectLogline_Call {
 	_c.Call.Return(loglineEntity, err)
 	return _c
 }
 
-func (_c *MockExpandBeatSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockExpandBeatSource_SelectLogline_Call {
+func (_c *MockTranslateLoglineSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockTranslateLoglineSource_SelectLogline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectStoryPlan provides a mock function for the type MockExpandBeatSource
-func (_mock *MockExpandBeatSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
-	ret := _mock.Called(ctx, request)
+// SelectSlugIteration provides a mock function for the type MockTranslateLoglineSource
+func (_mock *MockTranslateLoglineSource) SelectSlugIteration(ctx context.Context, data dao.SelectSlugIterationData) (models.Slug, int, error) {
+	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectStoryPlan")
+		panic("no return value specified for SelectSlugIteration")
 	}
 
-	var r0 *storyplanmodel.Plan
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
-		return returnFunc(ctx, request)
+	var r0 models.Slug
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectSlugIterationData) (models.Slug, int, error)); ok {
+		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) *storyplanmodel.Plan); ok {
-		r0 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectSlugIterationData) models.Slug); ok {
+		r0 = returnFunc(ctx, data)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*storyplanmodel.Plan)
-		}
+		r0 = ret.Get(0).(models.Slug)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectStoryPlanRequest) error); ok {
-		r1 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectSlugIterationData) int); ok {
+		r1 = returnFunc(ctx, data)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, dao.SelectSlugIterationData) error); ok {
+		r2 = returnFunc(ctx, data)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockExpandBeatSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
-type MockExpandBeatSource_SelectStoryPlan_Call struct {
+// MockTranslateLoglineSource_SelectSlugIteration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectSlugIteration'
+type MockTranslateLoglineSource_SelectSlugIteration_Call struct {
 	*mock.Call
 }
 
-// SelectStoryPlan is a helper method to define mock.On call
+// SelectSlugIteration is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request services.SelectStoryPlanRequest
-func (_e *MockExpandBeatSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockExpandBeatSource_SelectStoryPlan_Call {
-	return &MockExpandBeatSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
+//   - data dao.SelectSlugIterationData
+func (_e *MockTranslateLoglineSource_Expecter) SelectSlugIteration(ctx interface{}, data interface{}) *MockTranslateLoglineSource_SelectSlugIteration_Call {
+	return &MockTranslateLoglineSource_SelectSlugIteration_Call{Call: _e.mock.On("SelectSlugIteration", ctx, data)}
 }
 
-func (_c *MockExpandBeatSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockExpandBeatSource_SelectStoryPlan_Call {
+func (_c *MockTranslateLoglineSource_SelectSlugIteration_Call) Run(run func(ctx context.Context, data dao.SelectSlugIterationData)) *MockTranslateLoglineSource_SelectSlugIteration_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 services.SelectStoryPlanRequest
+		var arg1 dao.SelectSlugIterationData
 		if args[1] != nil {
-			arg1 = args[1].(services.SelectStoryPlanRequest)
+			arg1 = args[1].(dao.SelectSlugIterationData)
 		}
 		run(
 			arg0,
@@ -703,64 +6507,37 @@ func (_c *MockExpandBeatSource_SelectStoryPlan_Call) Run(run func(ctx context.Co
 	return _c
 }
 
-func (_c *MockExpandBeatSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockExpandBeatSource_SelectStoryPlan_Call {
-	_c.Call.Return(plan, err)
+func (_c *MockTranslateLoglineSource_SelectSlugIteration_Call) Return(slug models.Slug, n int, err error) *MockTranslateLoglineSource_SelectSlugIteration_Call {
+	_c.Call.Return(slug, n, err)
 	return _c
 }
 
-func (_c *MockExpandBeatSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockExpandBeatSource_SelectStoryPlan_Call {
+func (_c *MockTranslateLoglineSource_SelectSlugIteration_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectSlugIterationData) (models.Slug, int, error)) *MockTranslateLoglineSource_SelectSlugIteration_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockExpandLoglineSource creates a new instance of MockExpandLoglineSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockExpandLoglineSource(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockExpandLoglineSource {
-	mock := &MockExpandLoglineSource{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockExpandLoglineSource is an autogenerated mock type for the ExpandLoglineSource type
-type MockExpandLoglineSource struct {
-	mock.Mock
-}
-
-type MockExpandLoglineSource_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockExpandLoglineSource) EXPECT() *MockExpandLoglineSource_Expecter {
-	return &MockExpandLoglineSource_Expecter{mock: &_m.Mock}
-}
-
-// ExpandLogline provides a mock function for the type MockExpandLoglineSource
-func (_mock *MockExpandLoglineSource) ExpandLogline(ctx context.Context, request daoai.ExpandLoglineRequest) (*models.LoglineIdea, error) {
+// TranslateLogline provides a mock function for the type MockTranslateLoglineSource
+func (_mock *MockTranslateLoglineSource) TranslateLogline(ctx context.Context, request daoai.TranslateLoglineRequest) (*models.LoglineIdea, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExpandLogline")
+		panic("no return value specified for TranslateLogline")
 	}
 
 	var r0 *models.LoglineIdea
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.ExpandLoglineRequest) (*models.LoglineIdea, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.TranslateLoglineRequest) (*models.LoglineIdea, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.ExpandLoglineRequest) *models.LoglineIdea); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.TranslateLoglineRequest) *models.LoglineIdea); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.LoglineIdea)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.ExpandLoglineRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.TranslateLoglineRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -768,27 +6545,27 @@ func (_mock *MockExpandLoglineSource) ExpandLogline(ctx context.Context, request
 	return r0, r1
 }
 
-// MockExpandLoglineSource_ExpandLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandLogline'
-type MockExpandLoglineSource_ExpandLogline_Call struct {
+// MockTranslateLoglineSource_TranslateLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TranslateLogline'
+type MockTranslateLoglineSource_TranslateLogline_Call struct {
 	*mock.Call
 }
 
-// ExpandLogline is a helper method to define mock.On call
+// TranslateLogline is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request daoai.ExpandLoglineRequest
-func (_e *MockExpandLoglineSource_Expecter) ExpandLogline(ctx interface{}, request interface{}) *MockExpandLoglineSource_ExpandLogline_Call {
-	return &MockExpandLoglineSource_ExpandLogline_Call{Call: _e.mock.On("ExpandLogline", ctx, request)}
+//   - request daoai.TranslateLoglineRequest
+func (_e *MockTranslateLoglineSource_Expecter) TranslateLogline(ctx interface{}, request interface{}) *MockTranslateLoglineSource_TranslateLogline_Call {
+	return &MockTranslateLoglineSource_TranslateLogline_Call{Call: _e.mock.On("TranslateLogline", ctx, request)}
 }
 
-func (_c *MockExpandLoglineSource_ExpandLogline_Call) Run(run func(ctx context.Context, request daoai.ExpandLoglineRequest)) *MockExpandLoglineSource_ExpandLogline_Call {
+func (_c *MockTranslateLoglineSource_TranslateLogline_Call) Run(run func(ctx context.Context, request daoai.TranslateLoglineRequest)) *MockTranslateLoglineSource_TranslateLogline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 daoai.ExpandLoglineRequest
+		var arg1 daoai.TranslateLoglineRequest
 		if args[1] != nil {
-			arg1 = args[1].(daoai.ExpandLoglineRequest)
+			arg1 = args[1].(daoai.TranslateLoglineRequest)
 		}
 		run(
 			arg0,
@@ -798,23 +6575,23 @@ func (_c *MockExpandLoglineSource_ExpandLogline_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockExpandLoglineSource_ExpandLogline_Call) Return(loglineIdea *models.LoglineIdea, err error) *MockExpandLoglineSource_ExpandLogline_Call {
+func (_c *MockTranslateLoglineSource_TranslateLogline_Call) Return(loglineIdea *models.LoglineIdea, err error) *MockTranslateLoglineSource_TranslateLogline_Call {
 	_c.Call.Return(loglineIdea, err)
 	return _c
 }
 
-func (_c *MockExpandLoglineSource_ExpandLogline_Call) RunAndReturn(run func(ctx context.Context, request daoai.ExpandLoglineRequest) (*models.LoglineIdea, error)) *MockExpandLoglineSource_ExpandLogline_Call {
+func (_c *MockTranslateLoglineSource_TranslateLogline_Call) RunAndReturn(run func(ctx context.Context, request daoai.TranslateLoglineRequest) (*models.LoglineIdea, error)) *MockTranslateLoglineSource_TranslateLogline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockGenerateBeatsSheetSource creates a new instance of MockGenerateBeatsSheetSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockUpdateBeatSource creates a new instance of MockUpdateBeatSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockGenerateBeatsSheetSource(t interface {
+func NewMockUpdateBeatSource(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockGenerateBeatsSheetSource {
-	mock := &MockGenerateBeatsSheetSource{}
+}) *MockUpdateBeatSource {
+	mock := &MockUpdateBeatSource{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -822,68 +6599,68 @@ func NewMockGenerateBeatsSheetSource(t interface {
 	return mock
 }
 
-// MockGenerateBeatsSheetSource is an autogenerated mock type for the GenerateBeatsSheetSource type
-type MockGenerateBeatsSheetSource struct {
+// MockUpdateBeatSource is an autogenerated mock type for the UpdateBeatSource type
+type MockUpdateBeatSource struct {
 	mock.Mock
 }
 
-type MockGenerateBeatsSheetSource_Expecter struct {
+type MockUpdateBeatSource_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockGenerateBeatsSheetSource) EXPECT() *MockGenerateBeatsSheetSource_Expecter {
-	return &MockGenerateBeatsSheetSource_Expecter{mock: &_m.Mock}
+func (_m *MockUpdateBeatSource) EXPECT() *MockUpdateBeatSource_Expecter {
+	return &MockUpdateBeatSource_Expecter{mock: &_m.Mock}
 }
 
-// GenerateBeatsSheet provides a mock function for the type MockGenerateBeatsSheetSource
-func (_mock *MockGenerateBeatsSheetSource) GenerateBeatsSheet(ctx context.Context, request daoai.GenerateBeatsSheetRequest) ([]models.Beat, error) {
-	ret := _mock.Called(ctx, request)
+// InsertBeatsSheet provides a mock function for the type MockUpdateBeatSource
+func (_mock *MockUpdateBeatSource) InsertBeatsSheet(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error) {
+	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenerateBeatsSheet")
+		panic("no return value specified for InsertBeatsSheet")
 	}
 
-	var r0 []models.Beat
+	var r0 *dao.BeatsSheetEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.GenerateBeatsSheetRequest) ([]models.Beat, error)); ok {
-		return returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)); ok {
+		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.GenerateBeatsSheetRequest) []models.Beat); ok {
-		r0 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.InsertBeatsSheetData) *dao.BeatsSheetEntity); ok {
+		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Beat)
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.GenerateBeatsSheetRequest) error); ok {
-		r1 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.InsertBeatsSheetData) error); ok {
+		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateBeatsSheet'
-type MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call struct {
+// MockUpdateBeatSource_InsertBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertBeatsSheet'
+type MockUpdateBeatSource_InsertBeatsSheet_Call struct {
 	*mock.Call
 }
 
-// GenerateBeatsSheet is a helper method to define mock.On call
+// InsertBeatsSheet is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request daoai.GenerateBeatsSheetRequest
-func (_e *MockGenerateBeatsSheetSource_Expecter) GenerateBeatsSheet(ctx interface{}, request interface{}) *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call {
-	return &MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call{Call: _e.mock.On("GenerateBeatsSheet", ctx, request)}
+//   - data dao.InsertBeatsSheetData
+func (_e *MockUpdateBeatSource_Expecter) InsertBeatsSheet(ctx interface{}, data interface{}) *MockUpdateBeatSource_InsertBeatsSheet_Call {
+	return &MockUpdateBeatSource_InsertBeatsSheet_Call{Call: _e.mock.On("InsertBeatsSheet", ctx, data)}
 }
 
-func (_c *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call) Run(run func(ctx context.Context, request daoai.GenerateBeatsSheetRequest)) *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call {
+func (_c *MockUpdateBeatSource_InsertBeatsSheet_Call) Run(run func(ctx context.Context, data dao.InsertBeatsSheetData)) *MockUpdateBeatSource_InsertBeatsSheet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 daoai.GenerateBeatsSheetRequest
+		var arg1 dao.InsertBeatsSheetData
 		if args[1] != nil {
-			arg1 = args[1].(daoai.GenerateBeatsSheetRequest)
+			arg1 = args[1].(dao.InsertBeatsSheetData)
 		}
 		run(
 			arg0,
@@ -893,37 +6670,37 @@ func (_c *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call) Run(run func(ctx
 	return _c
 }
 
-func (_c *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call) Return(beats []models.Beat, err error) *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call {
-	_c.Call.Return(beats, err)
+func (_c *MockUpdateBeatSource_InsertBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockUpdateBeatSource_InsertBeatsSheet_Call {
+	_c.Call.Return(beatsSheetEntity, err)
 	return _c
 }
 
-func (_c *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, request daoai.GenerateBeatsSheetRequest) ([]models.Beat, error)) *MockGenerateBeatsSheetSource_GenerateBeatsSheet_Call {
+func (_c *MockUpdateBeatSource_InsertBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)) *MockUpdateBeatSource_InsertBeatsSheet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectLogline provides a mock function for the type MockGenerateBeatsSheetSource
-func (_mock *MockGenerateBeatsSheetSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+// SelectBeatsSheet provides a mock function for the type MockUpdateBeatSource
+func (_mock *MockUpdateBeatSource) SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectLogline")
+		panic("no return value specified for SelectBeatsSheet")
 	}
 
-	var r0 *dao.LoglineEntity
+	var r0 *dao.BeatsSheetEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.BeatsSheetEntity, error)); ok {
 		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.BeatsSheetEntity); ok {
 		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.LoglineEntity)
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
 		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
@@ -931,27 +6708,27 @@ func (_mock *MockGenerateBeatsSheetSource) SelectLogline(ctx context.Context, da
 	return r0, r1
 }
 
-// MockGenerateBeatsSheetSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
-type MockGenerateBeatsSheetSource_SelectLogline_Call struct {
+// MockUpdateBeatSource_SelectBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectBeatsSheet'
+type MockUpdateBeatSource_SelectBeatsSheet_Call struct {
 	*mock.Call
 }
 
-// SelectLogline is a helper method to define mock.On call
+// SelectBeatsSheet is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data dao.SelectLoglineData
-func (_e *MockGenerateBeatsSheetSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockGenerateBeatsSheetSource_SelectLogline_Call {
-	return &MockGenerateBeatsSheetSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+//   - data uuid.UUID
+func (_e *MockUpdateBeatSource_Expecter) SelectBeatsSheet(ctx interface{}, data interface{}) *MockUpdateBeatSource_SelectBeatsSheet_Call {
+	return &MockUpdateBeatSource_SelectBeatsSheet_Call{Call: _e.mock.On("SelectBeatsSheet", ctx, data)}
 }
 
-func (_c *MockGenerateBeatsSheetSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockGenerateBeatsSheetSource_SelectLogline_Call {
+func (_c *MockUpdateBeatSource_SelectBeatsSheet_Call) Run(run func(ctx context.Context, data uuid.UUID)) *MockUpdateBeatSource_SelectBeatsSheet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 dao.SelectLoglineData
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(dao.SelectLoglineData)
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
@@ -961,65 +6738,65 @@ func (_c *MockGenerateBeatsSheetSource_SelectLogline_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockGenerateBeatsSheetSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockGenerateBeatsSheetSource_SelectLogline_Call {
-	_c.Call.Return(loglineEntity, err)
+func (_c *MockUpdateBeatSource_SelectBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockUpdateBeatSource_SelectBeatsSheet_Call {
+	_c.Call.Return(beatsSheetEntity, err)
 	return _c
 }
 
-func (_c *MockGenerateBeatsSheetSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockGenerateBeatsSheetSource_SelectLogline_Call {
+func (_c *MockUpdateBeatSource_SelectBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)) *MockUpdateBeatSource_SelectBeatsSheet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectStoryPlan provides a mock function for the type MockGenerateBeatsSheetSource
-func (_mock *MockGenerateBeatsSheetSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
-	ret := _mock.Called(ctx, request)
+// SelectLogline provides a mock function for the type MockUpdateBeatSource
+func (_mock *MockUpdateBeatSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectStoryPlan")
+		panic("no return value specified for SelectLogline")
 	}
 
-	var r0 *storyplanmodel.Plan
+	var r0 *dao.LoglineEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
-		return returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) *storyplanmodel.Plan); ok {
-		r0 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*storyplanmodel.Plan)
+			r0 = ret.Get(0).(*dao.LoglineEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectStoryPlanRequest) error); ok {
-		r1 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockGenerateBeatsSheetSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
-type MockGenerateBeatsSheetSource_SelectStoryPlan_Call struct {
+// MockUpdateBeatSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockUpdateBeatSource_SelectLogline_Call struct {
 	*mock.Call
 }
 
-// SelectStoryPlan is a helper method to define mock.On call
+// SelectLogline is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request services.SelectStoryPlanRequest
-func (_e *MockGenerateBeatsSheetSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockGenerateBeatsSheetSource_SelectStoryPlan_Call {
-	return &MockGenerateBeatsSheetSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
+//   - data dao.SelectLoglineData
+func (_e *MockUpdateBeatSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockUpdateBeatSource_SelectLogline_Call {
+	return &MockUpdateBeatSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
 }
 
-func (_c *MockGenerateBeatsSheetSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockGenerateBeatsSheetSource_SelectStoryPlan_Call {
+func (_c *MockUpdateBeatSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockUpdateBeatSource_SelectLogline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 services.SelectStoryPlanRequest
+		var arg1 dao.SelectLoglineData
 		if args[1] != nil {
-			arg1 = args[1].(services.SelectStoryPlanRequest)
+			arg1 = args[1].(dao.SelectLoglineData)
 		}
 		run(
 			arg0,
@@ -1028,65 +6805,38 @@ func (_c *MockGenerateBeatsSheetSource_SelectStoryPlan_Call) Run(run func(ctx co
 	})
 	return _c
 }
-
-func (_c *MockGenerateBeatsSheetSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockGenerateBeatsSheetSource_SelectStoryPlan_Call {
-	_c.Call.Return(plan, err)
-	return _c
-}
-
-func (_c *MockGenerateBeatsSheetSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockGenerateBeatsSheetSource_SelectStoryPlan_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// NewMockGenerateLoglinesSource creates a new instance of MockGenerateLoglinesSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockGenerateLoglinesSource(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockGenerateLoglinesSource {
-	mock := &MockGenerateLoglinesSource{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockGenerateLoglinesSource is an autogenerated mock type for the GenerateLoglinesSource type
-type MockGenerateLoglinesSource struct {
-	mock.Mock
-}
-
-type MockGenerateLoglinesSource_Expecter struct {
-	mock *mock.Mock
+
+func (_c *MockUpdateBeatSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockUpdateBeatSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
+	return _c
 }
 
-func (_m *MockGenerateLoglinesSource) EXPECT() *MockGenerateLoglinesSource_Expecter {
-	return &MockGenerateLoglinesSource_Expecter{mock: &_m.Mock}
+func (_c *MockUpdateBeatSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockUpdateBeatSource_SelectLogline_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// GenerateLoglines provides a mock function for the type MockGenerateLoglinesSource
-func (_mock *MockGenerateLoglinesSource) GenerateLoglines(ctx context.Context, request daoai.GenerateLoglinesRequest) ([]models.LoglineIdea, error) {
+// SelectStoryPlan provides a mock function for the type MockUpdateBeatSource
+func (_mock *MockUpdateBeatSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenerateLoglines")
+		panic("no return value specified for SelectStoryPlan")
 	}
 
-	var r0 []models.LoglineIdea
+	var r0 *storyplanmodel.Plan
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.GenerateLoglinesRequest) ([]models.LoglineIdea, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.GenerateLoglinesRequest) []models.LoglineIdea); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) *storyplanmodel.Plan); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.LoglineIdea)
+			r0 = ret.Get(0).(*storyplanmodel.Plan)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.GenerateLoglinesRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectStoryPlanRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1094,27 +6844,27 @@ func (_mock *MockGenerateLoglinesSource) GenerateLoglines(ctx context.Context, r
 	return r0, r1
 }
 
-// MockGenerateLoglinesSource_GenerateLoglines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateLoglines'
-type MockGenerateLoglinesSource_GenerateLoglines_Call struct {
+// MockUpdateBeatSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
+type MockUpdateBeatSource_SelectStoryPlan_Call struct {
 	*mock.Call
 }
 
-// GenerateLoglines is a helper method to define mock.On call
+// SelectStoryPlan is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request daoai.GenerateLoglinesRequest
-func (_e *MockGenerateLoglinesSource_Expecter) GenerateLoglines(ctx interface{}, request interface{}) *MockGenerateLoglinesSource_GenerateLoglines_Call {
-	return &MockGenerateLoglinesSource_GenerateLoglines_Call{Call: _e.mock.On("GenerateLoglines", ctx, request)}
+//   - request services.SelectStoryPlanRequest
+func (_e *MockUpdateBeatSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockUpdateBeatSource_SelectStoryPlan_Call {
+	return &MockUpdateBeatSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
 }
 
-func (_c *MockGenerateLoglinesSource_GenerateLoglines_Call) Run(run func(ctx context.Context, request daoai.GenerateLoglinesRequest)) *MockGenerateLoglinesSource_GenerateLoglines_Call {
+func (_c *MockUpdateBeatSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockUpdateBeatSource_SelectStoryPlan_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 daoai.GenerateLoglinesRequest
+		var arg1 services.SelectStoryPlanRequest
 		if args[1] != nil {
-			arg1 = args[1].(daoai.GenerateLoglinesRequest)
+			arg1 = args[1].(services.SelectStoryPlanRequest)
 		}
 		run(
 			arg0,
@@ -1124,23 +6874,23 @@ func (_c *MockGenerateLoglinesSource_GenerateLoglines_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *MockGenerateLoglinesSource_GenerateLoglines_Call) Return(loglineIdeas []models.LoglineIdea, err error) *MockGenerateLoglinesSource_GenerateLoglines_Call {
-	_c.Call.Return(loglineIdeas, err)
+func (_c *MockUpdateBeatSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockUpdateBeatSource_SelectStoryPlan_Call {
+	_c.Call.Return(plan, err)
 	return _c
 }
 
-func (_c *MockGenerateLoglinesSource_GenerateLoglines_Call) RunAndReturn(run func(ctx context.Context, request daoai.GenerateLoglinesRequest) ([]models.LoglineIdea, error)) *MockGenerateLoglinesSource_GenerateLoglines_Call {
+func (_c *MockUpdateBeatSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockUpdateBeatSource_SelectStoryPlan_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockListBeatsSheetsSource creates a new instance of MockListBeatsSheetsSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockUpdateLoglineSource creates a new instance of MockUpdateLoglineSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockListBeatsSheetsSource(t interface {
+func NewMockUpdateLoglineSource(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockListBeatsSheetsSource {
-	mock := &MockListBeatsSheetsSource{}
+}) *MockUpdateLoglineSource {
+	mock := &MockUpdateLoglineSource{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1148,40 +6898,40 @@ func NewMockListBeatsSheetsSource(t interface {
 	return mock
 }
 
-// MockListBeatsSheetsSource is an autogenerated mock type for the ListBeatsSheetsSource type
-type MockListBeatsSheetsSource struct {
+// MockUpdateLoglineSource is an autogenerated mock type for the UpdateLoglineSource type
+type MockUpdateLoglineSource struct {
 	mock.Mock
 }
 
-type MockListBeatsSheetsSource_Expecter struct {
+type MockUpdateLoglineSource_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockListBeatsSheetsSource) EXPECT() *MockListBeatsSheetsSource_Expecter {
-	return &MockListBeatsSheetsSource_Expecter{mock: &_m.Mock}
+func (_m *MockUpdateLoglineSource) EXPECT() *MockUpdateLoglineSource_Expecter {
+	return &MockUpdateLoglineSource_Expecter{mock: &_m.Mock}
 }
 
-// ListBeatsSheets provides a mock function for the type MockListBeatsSheetsSource
-func (_mock *MockListBeatsSheetsSource) ListBeatsSheets(ctx context.Context, data dao.ListBeatsSheetsData) ([]*dao.BeatsSheetPreviewEntity, error) {
+// SelectLogline provides a mock function for the type MockUpdateLoglineSource
+func (_mock *MockUpdateLoglineSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListBeatsSheets")
+		panic("no return value specified for SelectLogline")
 	}
 
-	var r0 []*dao.BeatsSheetPreviewEntity
+	var r0 *dao.LoglineEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListBeatsSheetsData) ([]*dao.BeatsSheetPreviewEntity, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
 		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListBeatsSheetsData) []*dao.BeatsSheetPreviewEntity); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
 		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.BeatsSheetPreviewEntity)
+			r0 = ret.Get(0).(*dao.LoglineEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.ListBeatsSheetsData) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
 		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
@@ -1189,27 +6939,27 @@ func (_mock *MockListBeatsSheetsSource) ListBeatsSheets(ctx context.Context, dat
 	return r0, r1
 }
 
-// MockListBeatsSheetsSource_ListBeatsSheets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBeatsSheets'
-type MockListBeatsSheetsSource_ListBeatsSheets_Call struct {
+// MockUpdateLoglineSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
+type MockUpdateLoglineSource_SelectLogline_Call struct {
 	*mock.Call
 }
 
-// ListBeatsSheets is a helper method to define mock.On call
+// SelectLogline is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data dao.ListBeatsSheetsData
-func (_e *MockListBeatsSheetsSource_Expecter) ListBeatsSheets(ctx interface{}, data interface{}) *MockListBeatsSheetsSource_ListBeatsSheets_Call {
-	return &MockListBeatsSheetsSource_ListBeatsSheets_Call{Call: _e.mock.On("ListBeatsSheets", ctx, data)}
+//   - data dao.SelectLoglineData
+func (_e *MockUpdateLoglineSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockUpdateLoglineSource_SelectLogline_Call {
+	return &MockUpdateLoglineSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
 }
 
-func (_c *MockListBeatsSheetsSource_ListBeatsSheets_Call) Run(run func(ctx context.Context, data dao.ListBeatsSheetsData)) *MockListBeatsSheetsSource_ListBeatsSheets_Call {
+func (_c *MockUpdateLoglineSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockUpdateLoglineSource_SelectLogline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 dao.ListBeatsSheetsData
+		var arg1 dao.SelectLoglineData
 		if args[1] != nil {
-			arg1 = args[1].(dao.ListBeatsSheetsData)
+			arg1 = args[1].(dao.SelectLoglineData)
 		}
 		run(
 			arg0,
@@ -1219,37 +6969,37 @@ func (_c *MockListBeatsSheetsSource_ListBeatsSheets_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *MockListBeatsSheetsSource_ListBeatsSheets_Call) Return(beatsSheetPreviewEntitys []*dao.BeatsSheetPreviewEntity, err error) *MockListBeatsSheetsSource_ListBeatsSheets_Call {
-	_c.Call.Return(beatsSheetPreviewEntitys, err)
+func (_c *MockUpdateLoglineSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockUpdateLoglineSource_SelectLogline_Call {
+	_c.Call.Return(loglineEntity, err)
 	return _c
 }
 
-func (_c *MockListBeatsSheetsSource_ListBeatsSheets_Call) RunAndReturn(run func(ctx context.Context, data dao.ListBeatsSheetsData) ([]*dao.BeatsSheetPreviewEntity, error)) *MockListBeatsSheetsSource_ListBeatsSheets_Call {
+func (_c *MockUpdateLoglineSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockUpdateLoglineSource_SelectLogline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectLogline provides a mock function for the type MockListBeatsSheetsSource
-func (_mock *MockListBeatsSheetsSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+// SelectProject provides a mock function for the type MockUpdateLoglineSource
+func (_mock *MockUpdateLoglineSource) SelectProject(ctx context.Context, data dao.SelectProjectData) (*dao.ProjectEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectLogline")
+		panic("no return value specified for SelectProject")
 	}
 
-	var r0 *dao.LoglineEntity
+	var r0 *dao.ProjectEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectProjectData) (*dao.ProjectEntity, error)); ok {
 		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectProjectData) *dao.ProjectEntity); ok {
 		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.LoglineEntity)
+			r0 = ret.Get(0).(*dao.ProjectEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectProjectData) error); ok {
 		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
@@ -1257,27 +7007,27 @@ func (_mock *MockListBeatsSheetsSource) SelectLogline(ctx context.Context, data
 	return r0, r1
 }
 
-// MockListBeatsSheetsSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
-type MockListBeatsSheetsSource_SelectLogline_Call struct {
+// MockUpdateLoglineSource_SelectProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectProject'
+type MockUpdateLoglineSource_SelectProject_Call struct {
 	*mock.Call
 }
 
-// SelectLogline is a helper method to define mock.On call
+// SelectProject is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data dao.SelectLoglineData
-func (_e *MockListBeatsSheetsSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockListBeatsSheetsSource_SelectLogline_Call {
-	return &MockListBeatsSheetsSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+//   - data dao.SelectProjectData
+func (_e *MockUpdateLoglineSource_Expecter) SelectProject(ctx interface{}, data interface{}) *MockUpdateLoglineSource_SelectProject_Call {
+	return &MockUpdateLoglineSource_SelectProject_Call{Call: _e.mock.On("SelectProject", ctx, data)}
 }
 
-func (_c *MockListBeatsSheetsSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockListBeatsSheetsSource_SelectLogline_Call {
+func (_c *MockUpdateLoglineSource_SelectProject_Call) Run(run func(ctx context.Context, data dao.SelectProjectData)) *MockUpdateLoglineSource_SelectProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 dao.SelectLoglineData
+		var arg1 dao.SelectProjectData
 		if args[1] != nil {
-			arg1 = args[1].(dao.SelectLoglineData)
+			arg1 = args[1].(dao.SelectProjectData)
 		}
 		run(
 			arg0,
@@ -1287,92 +7037,69 @@ func (_c *MockListBeatsSheetsSource_SelectLogline_Call) Run(run func(ctx context
 	return _c
 }
 
-func (_c *MockListBeatsSheetsSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockListBeatsSheetsSource_SelectLogline_Call {
-	_c.Call.Return(loglineEntity, err)
+func (_c *MockUpdateLoglineSource_SelectProject_Call) Return(projectEntity *dao.ProjectEntity, err error) *MockUpdateLoglineSource_SelectProject_Call {
+	_c.Call.Return(projectEntity, err)
 	return _c
 }
 
-func (_c *MockListBeatsSheetsSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockListBeatsSheetsSource_SelectLogline_Call {
+func (_c *MockUpdateLoglineSource_SelectProject_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectProjectData) (*dao.ProjectEntity, error)) *MockUpdateLoglineSource_SelectProject_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockListLoglinesSource creates a new instance of MockListLoglinesSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockListLoglinesSource(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockListLoglinesSource {
-	mock := &MockListLoglinesSource{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockListLoglinesSource is an autogenerated mock type for the ListLoglinesSource type
-type MockListLoglinesSource struct {
-	mock.Mock
-}
-
-type MockListLoglinesSource_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockListLoglinesSource) EXPECT() *MockListLoglinesSource_Expecter {
-	return &MockListLoglinesSource_Expecter{mock: &_m.Mock}
-}
-
-// ListLoglines provides a mock function for the type MockListLoglinesSource
-func (_mock *MockListLoglinesSource) ListLoglines(ctx context.Context, data dao.ListLoglinesData) ([]*dao.LoglinePreviewEntity, error) {
+// SelectSlugIteration provides a mock function for the type MockUpdateLoglineSource
+func (_mock *MockUpdateLoglineSource) SelectSlugIteration(ctx context.Context, data dao.SelectSlugIterationData) (models.Slug, int, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListLoglines")
+		panic("no return value specified for SelectSlugIteration")
 	}
 
-	var r0 []*dao.LoglinePreviewEntity
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListLoglinesData) ([]*dao.LoglinePreviewEntity, error)); ok {
+	var r0 models.Slug
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectSlugIterationData) (models.Slug, int, error)); ok {
 		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListLoglinesData) []*dao.LoglinePreviewEntity); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectSlugIterationData) models.Slug); ok {
 		r0 = returnFunc(ctx, data)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.LoglinePreviewEntity)
-		}
+		r0 = ret.Get(0).(models.Slug)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.ListLoglinesData) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectSlugIterationData) int); ok {
 		r1 = returnFunc(ctx, data)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, dao.SelectSlugIterationData) error); ok {
+		r2 = returnFunc(ctx, data)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockListLoglinesSource_ListLoglines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLoglines'
-type MockListLoglinesSource_ListLoglines_Call struct {
+// MockUpdateLoglineSource_SelectSlugIteration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectSlugIteration'
+type MockUpdateLoglineSource_SelectSlugIteration_Call struct {
 	*mock.Call
 }
 
-// ListLoglines is a helper method to define mock.On call
+// SelectSlugIteration is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data dao.ListLoglinesData
-func (_e *MockListLoglinesSource_Expecter) ListLoglines(ctx interface{}, data interface{}) *MockListLoglinesSource_ListLoglines_Call {
-	return &MockListLoglinesSource_ListLoglines_Call{Call: _e.mock.On("ListLoglines", ctx, data)}
+//   - data dao.SelectSlugIterationData
+func (_e *MockUpdateLoglineSource_Expecter) SelectSlugIteration(ctx interface{}, data interface{}) *MockUpdateLoglineSource_SelectSlugIteration_Call {
+	return &MockUpdateLoglineSource_SelectSlugIteration_Call{Call: _e.mock.On("SelectSlugIteration", ctx, data)}
 }
 
-func (_c *MockListLoglinesSource_ListLoglines_Call) Run(run func(ctx context.Context, data dao.ListLoglinesData)) *MockListLoglinesSource_ListLoglines_Call {
+func (_c *MockUpdateLoglineSource_SelectSlugIteration_Call) Run(run func(ctx context.Context, data dao.SelectSlugIterationData)) *MockUpdateLoglineSource_SelectSlugIteration_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 dao.ListLoglinesData
+		var arg1 dao.SelectSlugIterationData
 		if args[1] != nil {
-			arg1 = args[1].(dao.ListLoglinesData)
+			arg1 = args[1].(dao.SelectSlugIterationData)
 		}
 		run(
 			arg0,
@@ -1382,92 +7109,65 @@ func (_c *MockListLoglinesSource_ListLoglines_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockListLoglinesSource_ListLoglines_Call) Return(loglinePreviewEntitys []*dao.LoglinePreviewEntity, err error) *MockListLoglinesSource_ListLoglines_Call {
-	_c.Call.Return(loglinePreviewEntitys, err)
+func (_c *MockUpdateLoglineSource_SelectSlugIteration_Call) Return(slug models.Slug, n int, err error) *MockUpdateLoglineSource_SelectSlugIteration_Call {
+	_c.Call.Return(slug, n, err)
 	return _c
 }
 
-func (_c *MockListLoglinesSource_ListLoglines_Call) RunAndReturn(run func(ctx context.Context, data dao.ListLoglinesData) ([]*dao.LoglinePreviewEntity, error)) *MockListLoglinesSource_ListLoglines_Call {
+func (_c *MockUpdateLoglineSource_SelectSlugIteration_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectSlugIterationData) (models.Slug, int, error)) *MockUpdateLoglineSource_SelectSlugIteration_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRegenerateBeatsSource creates a new instance of MockRegenerateBeatsSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRegenerateBeatsSource(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRegenerateBeatsSource {
-	mock := &MockRegenerateBeatsSource{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockRegenerateBeatsSource is an autogenerated mock type for the RegenerateBeatsSource type
-type MockRegenerateBeatsSource struct {
-	mock.Mock
-}
-
-type MockRegenerateBeatsSource_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockRegenerateBeatsSource) EXPECT() *MockRegenerateBeatsSource_Expecter {
-	return &MockRegenerateBeatsSource_Expecter{mock: &_m.Mock}
-}
-
-// RegenerateBeats provides a mock function for the type MockRegenerateBeatsSource
-func (_mock *MockRegenerateBeatsSource) RegenerateBeats(ctx context.Context, request daoai.RegenerateBeatsRequest) ([]models.Beat, error) {
-	ret := _mock.Called(ctx, request)
+// UpdateLogline provides a mock function for the type MockUpdateLoglineSource
+func (_mock *MockUpdateLoglineSource) UpdateLogline(ctx context.Context, data dao.UpdateLoglineData) (*dao.LoglineEntity, error) {
+	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegenerateBeats")
+		panic("no return value specified for UpdateLogline")
 	}
 
-	var r0 []models.Beat
+	var r0 *dao.LoglineEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.RegenerateBeatsRequest) ([]models.Beat, error)); ok {
-		return returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateLoglineData) (*dao.LoglineEntity, error)); ok {
+		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, daoai.RegenerateBeatsRequest) []models.Beat); ok {
-		r0 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateLoglineData) *dao.LoglineEntity); ok {
+		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Beat)
+			r0 = ret.Get(0).(*dao.LoglineEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, daoai.RegenerateBeatsRequest) error); ok {
-		r1 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.UpdateLoglineData) error); ok {
+		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRegenerateBeatsSource_RegenerateBeats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegenerateBeats'
-type MockRegenerateBeatsSource_RegenerateBeats_Call struct {
+// MockUpdateLoglineSource_UpdateLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLogline'
+type MockUpdateLoglineSource_UpdateLogline_Call struct {
 	*mock.Call
 }
 
-// RegenerateBeats is a helper method to define mock.On call
+// UpdateLogline is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request daoai.RegenerateBeatsRequest
-func (_e *MockRegenerateBeatsSource_Expecter) RegenerateBeats(ctx interface{}, request interface{}) *MockRegenerateBeatsSource_RegenerateBeats_Call {
-	return &MockRegenerateBeatsSource_RegenerateBeats_Call{Call: _e.mock.On("RegenerateBeats", ctx, request)}
+//   - data dao.UpdateLoglineData
+func (_e *MockUpdateLoglineSource_Expecter) UpdateLogline(ctx interface{}, data interface{}) *MockUpdateLoglineSource_UpdateLogline_Call {
+	return &MockUpdateLoglineSource_UpdateLogline_Call{Call: _e.mock.On("UpdateLogline", ctx, data)}
 }
 
-func (_c *MockRegenerateBeatsSource_RegenerateBeats_Call) Run(run func(ctx context.Context, request daoai.RegenerateBeatsRequest)) *MockRegenerateBeatsSource_RegenerateBeats_Call {
+func (_c *MockUpdateLoglineSource_UpdateLogline_Call) Run(run func(ctx context.Context, data dao.UpdateLoglineData)) *MockUpdateLoglineSource_UpdateLogline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 daoai.RegenerateBeatsRequest
+		var arg1 dao.UpdateLoglineData
 		if args[1] != nil {
-			arg1 = args[1].(daoai.RegenerateBeatsRequest)
+			arg1 = args[1].(dao.UpdateLoglineData)
 		}
 		run(
 			arg0,
@@ -1477,37 +7177,64 @@ func (_c *MockRegenerateBeatsSource_RegenerateBeats_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *MockRegenerateBeatsSource_RegenerateBeats_Call) Return(beats []models.Beat, err error) *MockRegenerateBeatsSource_RegenerateBeats_Call {
-	_c.Call.Return(beats, err)
+func (_c *MockUpdateLoglineSource_UpdateLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockUpdateLoglineSource_UpdateLogline_Call {
+	_c.Call.Return(loglineEntity, err)
 	return _c
 }
 
-func (_c *MockRegenerateBeatsSource_RegenerateBeats_Call) RunAndReturn(run func(ctx context.Context, request daoai.RegenerateBeatsRequest) ([]models.Beat, error)) *MockRegenerateBeatsSource_RegenerateBeats_Call {
+func (_c *MockUpdateLoglineSource_UpdateLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.UpdateLoglineData) (*dao.LoglineEntity, error)) *MockUpdateLoglineSource_UpdateLogline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectBeatsSheet provides a mock function for the type MockRegenerateBeatsSource
-func (_mock *MockRegenerateBeatsSource) SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error) {
+// NewMockUpdateProjectSource creates a new instance of MockUpdateProjectSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateProjectSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateProjectSource {
+	mock := &MockUpdateProjectSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUpdateProjectSource is an autogenerated mock type for the UpdateProjectSource type
+type MockUpdateProjectSource struct {
+	mock.Mock
+}
+
+type MockUpdateProjectSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateProjectSource) EXPECT() *MockUpdateProjectSource_Expecter {
+	return &MockUpdateProjectSource_Expecter{mock: &_m.Mock}
+}
+
+// SelectProject provides a mock function for the type MockUpdateProjectSource
+func (_mock *MockUpdateProjectSource) SelectProject(ctx context.Context, data dao.SelectProjectData) (*dao.ProjectEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectBeatsSheet")
+		panic("no return value specified for SelectProject")
 	}
 
-	var r0 *dao.BeatsSheetEntity
+	var r0 *dao.ProjectEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.BeatsSheetEntity, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectProjectData) (*dao.ProjectEntity, error)); ok {
 		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.BeatsSheetEntity); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectProjectData) *dao.ProjectEntity); ok {
 		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
+			r0 = ret.Get(0).(*dao.ProjectEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectProjectData) error); ok {
 		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
@@ -1515,27 +7242,27 @@ func (_mock *MockRegenerateBeatsSource) SelectBeatsSheet(ctx context.Context, da
 	return r0, r1
 }
 
-// MockRegenerateBeatsSource_SelectBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectBeatsSheet'
-type MockRegenerateBeatsSource_SelectBeatsSheet_Call struct {
+// MockUpdateProjectSource_SelectProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectProject'
+type MockUpdateProjectSource_SelectProject_Call struct {
 	*mock.Call
 }
 
-// SelectBeatsSheet is a helper method to define mock.On call
+// SelectProject is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data uuid.UUID
-func (_e *MockRegenerateBeatsSource_Expecter) SelectBeatsSheet(ctx interface{}, data interface{}) *MockRegenerateBeatsSource_SelectBeatsSheet_Call {
-	return &MockRegenerateBeatsSource_SelectBeatsSheet_Call{Call: _e.mock.On("SelectBeatsSheet", ctx, data)}
+//   - data dao.SelectProjectData
+func (_e *MockUpdateProjectSource_Expecter) SelectProject(ctx interface{}, data interface{}) *MockUpdateProjectSource_SelectProject_Call {
+	return &MockUpdateProjectSource_SelectProject_Call{Call: _e.mock.On("SelectProject", ctx, data)}
 }
 
-func (_c *MockRegenerateBeatsSource_SelectBeatsSheet_Call) Run(run func(ctx context.Context, data uuid.UUID)) *MockRegenerateBeatsSource_SelectBeatsSheet_Call {
+func (_c *MockUpdateProjectSource_SelectProject_Call) Run(run func(ctx context.Context, data dao.SelectProjectData)) *MockUpdateProjectSource_SelectProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 dao.SelectProjectData
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(dao.SelectProjectData)
 		}
 		run(
 			arg0,
@@ -1545,37 +7272,37 @@ func (_c *MockRegenerateBeatsSource_SelectBeatsSheet_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockRegenerateBeatsSource_SelectBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockRegenerateBeatsSource_SelectBeatsSheet_Call {
-	_c.Call.Return(beatsSheetEntity, err)
+func (_c *MockUpdateProjectSource_SelectProject_Call) Return(projectEntity *dao.ProjectEntity, err error) *MockUpdateProjectSource_SelectProject_Call {
+	_c.Call.Return(projectEntity, err)
 	return _c
 }
 
-func (_c *MockRegenerateBeatsSource_SelectBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)) *MockRegenerateBeatsSource_SelectBeatsSheet_Call {
+func (_c *MockUpdateProjectSource_SelectProject_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectProjectData) (*dao.ProjectEntity, error)) *MockUpdateProjectSource_SelectProject_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectLogline provides a mock function for the type MockRegenerateBeatsSource
-func (_mock *MockRegenerateBeatsSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+// UpdateProject provides a mock function for the type MockUpdateProjectSource
+func (_mock *MockUpdateProjectSource) UpdateProject(ctx context.Context, data dao.UpdateProjectData) (*dao.ProjectEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectLogline")
+		panic("no return value specified for UpdateProject")
 	}
 
-	var r0 *dao.LoglineEntity
+	var r0 *dao.ProjectEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateProjectData) (*dao.ProjectEntity, error)); ok {
 		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateProjectData) *dao.ProjectEntity); ok {
 		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.LoglineEntity)
+			r0 = ret.Get(0).(*dao.ProjectEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.UpdateProjectData) error); ok {
 		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
@@ -1583,27 +7310,27 @@ func (_mock *MockRegenerateBeatsSource) SelectLogline(ctx context.Context, data
 	return r0, r1
 }
 
-// MockRegenerateBeatsSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
-type MockRegenerateBeatsSource_SelectLogline_Call struct {
+// MockUpdateProjectSource_UpdateProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProject'
+type MockUpdateProjectSource_UpdateProject_Call struct {
 	*mock.Call
 }
 
-// SelectLogline is a helper method to define mock.On call
+// UpdateProject is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data dao.SelectLoglineData
-func (_e *MockRegenerateBeatsSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockRegenerateBeatsSource_SelectLogline_Call {
-	return &MockRegenerateBeatsSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+//   - data dao.UpdateProjectData
+func (_e *MockUpdateProjectSource_Expecter) UpdateProject(ctx interface{}, data interface{}) *MockUpdateProjectSource_UpdateProject_Call {
+	return &MockUpdateProjectSource_UpdateProject_Call{Call: _e.mock.On("UpdateProject", ctx, data)}
 }
 
-func (_c *MockRegenerateBeatsSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockRegenerateBeatsSource_SelectLogline_Call {
+func (_c *MockUpdateProjectSource_UpdateProject_Call) Run(run func(ctx context.Context, data dao.UpdateProjectData)) *MockUpdateProjectSource_UpdateProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 dao.SelectLoglineData
+		var arg1 dao.UpdateProjectData
 		if args[1] != nil {
-			arg1 = args[1].(dao.SelectLoglineData)
+			arg1 = args[1].(dao.UpdateProjectData)
 		}
 		run(
 			arg0,
@@ -1613,65 +7340,92 @@ func (_c *MockRegenerateBeatsSource_SelectLogline_Call) Run(run func(ctx context
 	return _c
 }
 
-func (_c *MockRegenerateBeatsSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockRegenerateBeatsSource_SelectLogline_Call {
-	_c.Call.Return(loglineEntity, err)
+func (_c *MockUpdateProjectSource_UpdateProject_Call) Return(projectEntity *dao.ProjectEntity, err error) *MockUpdateProjectSource_UpdateProject_Call {
+	_c.Call.Return(projectEntity, err)
 	return _c
 }
 
-func (_c *MockRegenerateBeatsSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockRegenerateBeatsSource_SelectLogline_Call {
+func (_c *MockUpdateProjectSource_UpdateProject_Call) RunAndReturn(run func(ctx context.Context, data dao.UpdateProjectData) (*dao.ProjectEntity, error)) *MockUpdateProjectSource_UpdateProject_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectStoryPlan provides a mock function for the type MockRegenerateBeatsSource
-func (_mock *MockRegenerateBeatsSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
-	ret := _mock.Called(ctx, request)
+// NewMockUpdateStoryPlanSource creates a new instance of MockUpdateStoryPlanSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateStoryPlanSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateStoryPlanSource {
+	mock := &MockUpdateStoryPlanSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUpdateStoryPlanSource is an autogenerated mock type for the UpdateStoryPlanSource type
+type MockUpdateStoryPlanSource struct {
+	mock.Mock
+}
+
+type MockUpdateStoryPlanSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateStoryPlanSource) EXPECT() *MockUpdateStoryPlanSource_Expecter {
+	return &MockUpdateStoryPlanSource_Expecter{mock: &_m.Mock}
+}
+
+// ListStoryPlanTranslations provides a mock function for the type MockUpdateStoryPlanSource
+func (_mock *MockUpdateStoryPlanSource) ListStoryPlanTranslations(ctx context.Context, data dao.ListStoryPlanTranslationsData) ([]*dao.StoryPlanEntity, error) {
+	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectStoryPlan")
+		panic("no return value specified for ListStoryPlanTranslations")
 	}
 
-	var r0 *storyplanmodel.Plan
+	var r0 []*dao.StoryPlanEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
-		return returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListStoryPlanTranslationsData) ([]*dao.StoryPlanEntity, error)); ok {
+		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) *storyplanmodel.Plan); ok {
-		r0 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListStoryPlanTranslationsData) []*dao.StoryPlanEntity); ok {
+		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*storyplanmodel.Plan)
+			r0 = ret.Get(0).([]*dao.StoryPlanEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectStoryPlanRequest) error); ok {
-		r1 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.ListStoryPlanTranslationsData) error); ok {
+		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRegenerateBeatsSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
-type MockRegenerateBeatsSource_SelectStoryPlan_Call struct {
+// MockUpdateStoryPlanSource_ListStoryPlanTranslations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStoryPlanTranslations'
+type MockUpdateStoryPlanSource_ListStoryPlanTranslations_Call struct {
 	*mock.Call
 }
 
-// SelectStoryPlan is a helper method to define mock.On call
+// ListStoryPlanTranslations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request services.SelectStoryPlanRequest
-func (_e *MockRegenerateBeatsSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockRegenerateBeatsSource_SelectStoryPlan_Call {
-	return &MockRegenerateBeatsSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
+//   - data dao.ListStoryPlanTranslationsData
+func (_e *MockUpdateStoryPlanSource_Expecter) ListStoryPlanTranslations(ctx interface{}, data interface{}) *MockUpdateStoryPlanSource_ListStoryPlanTranslations_Call {
+	return &MockUpdateStoryPlanSource_ListStoryPlanTranslations_Call{Call: _e.mock.On("ListStoryPlanTranslations", ctx, data)}
 }
 
-func (_c *MockRegenerateBeatsSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockRegenerateBeatsSource_SelectStoryPlan_Call {
+func (_c *MockUpdateStoryPlanSource_ListStoryPlanTranslations_Call) Run(run func(ctx context.Context, data dao.ListStoryPlanTranslationsData)) *MockUpdateStoryPlanSource_ListStoryPlanTranslations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 services.SelectStoryPlanRequest
+		var arg1 dao.ListStoryPlanTranslationsData
 		if args[1] != nil {
-			arg1 = args[1].(services.SelectStoryPlanRequest)
+			arg1 = args[1].(dao.ListStoryPlanTranslationsData)
 		}
 		run(
 			arg0,
@@ -1681,64 +7435,37 @@ func (_c *MockRegenerateBeatsSource_SelectStoryPlan_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *MockRegenerateBeatsSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockRegenerateBeatsSource_SelectStoryPlan_Call {
-	_c.Call.Return(plan, err)
+func (_c *MockUpdateStoryPlanSource_ListStoryPlanTranslations_Call) Return(storyPlanEntitys []*dao.StoryPlanEntity, err error) *MockUpdateStoryPlanSource_ListStoryPlanTranslations_Call {
+	_c.Call.Return(storyPlanEntitys, err)
 	return _c
 }
 
-func (_c *MockRegenerateBeatsSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockRegenerateBeatsSource_SelectStoryPlan_Call {
+func (_c *MockUpdateStoryPlanSource_ListStoryPlanTranslations_Call) RunAndReturn(run func(ctx context.Context, data dao.ListStoryPlanTranslationsData) ([]*dao.StoryPlanEntity, error)) *MockUpdateStoryPlanSource_ListStoryPlanTranslations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSelectBeatsSheetSource creates a new instance of MockSelectBeatsSheetSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockSelectBeatsSheetSource(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockSelectBeatsSheetSource {
-	mock := &MockSelectBeatsSheetSource{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockSelectBeatsSheetSource is an autogenerated mock type for the SelectBeatsSheetSource type
-type MockSelectBeatsSheetSource struct {
-	mock.Mock
-}
-
-type MockSelectBeatsSheetSource_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockSelectBeatsSheetSource) EXPECT() *MockSelectBeatsSheetSource_Expecter {
-	return &MockSelectBeatsSheetSource_Expecter{mock: &_m.Mock}
-}
-
-// SelectBeatsSheet provides a mock function for the type MockSelectBeatsSheetSource
-func (_mock *MockSelectBeatsSheetSource) SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error) {
+// SelectStoryPlan provides a mock function for the type MockUpdateStoryPlanSource
+func (_mock *MockUpdateStoryPlanSource) SelectStoryPlan(ctx context.Context, data dao.SelectStoryPlanData) (*dao.StoryPlanEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectBeatsSheet")
+		panic("no return value specified for SelectStoryPlan")
 	}
 
-	var r0 *dao.BeatsSheetEntity
+	var r0 *dao.StoryPlanEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.BeatsSheetEntity, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectStoryPlanData) (*dao.StoryPlanEntity, error)); ok {
 		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.BeatsSheetEntity); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectStoryPlanData) *dao.StoryPlanEntity); ok {
 		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
+			r0 = ret.Get(0).(*dao.StoryPlanEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectStoryPlanData) error); ok {
 		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
@@ -1746,27 +7473,27 @@ func (_mock *MockSelectBeatsSheetSource) SelectBeatsSheet(ctx context.Context, d
 	return r0, r1
 }
 
-// MockSelectBeatsSheetSource_SelectBeatsSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectBeatsSheet'
-type MockSelectBeatsSheetSource_SelectBeatsSheet_Call struct {
+// MockUpdateStoryPlanSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
+type MockUpdateStoryPlanSource_SelectStoryPlan_Call struct {
 	*mock.Call
 }
 
-// SelectBeatsSheet is a helper method to define mock.On call
+// SelectStoryPlan is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data uuid.UUID
-func (_e *MockSelectBeatsSheetSource_Expecter) SelectBeatsSheet(ctx interface{}, data interface{}) *MockSelectBeatsSheetSource_SelectBeatsSheet_Call {
-	return &MockSelectBeatsSheetSource_SelectBeatsSheet_Call{Call: _e.mock.On("SelectBeatsSheet", ctx, data)}
+//   - data dao.SelectStoryPlanData
+func (_e *MockUpdateStoryPlanSource_Expecter) SelectStoryPlan(ctx interface{}, data interface{}) *MockUpdateStoryPlanSource_SelectStoryPlan_Call {
+	return &MockUpdateStoryPlanSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, data)}
 }
 
-func (_c *MockSelectBeatsSheetSource_SelectBeatsSheet_Call) Run(run func(ctx context.Context, data uuid.UUID)) *MockSelectBeatsSheetSource_SelectBeatsSheet_Call {
+func (_c *MockUpdateStoryPlanSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, data dao.SelectStoryPlanData)) *MockUpdateStoryPlanSource_SelectStoryPlan_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 dao.SelectStoryPlanData
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(dao.SelectStoryPlanData)
 		}
 		run(
 			arg0,
@@ -1776,37 +7503,37 @@ func (_c *MockSelectBeatsSheetSource_SelectBeatsSheet_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *MockSelectBeatsSheetSource_SelectBeatsSheet_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockSelectBeatsSheetSource_SelectBeatsSheet_Call {
-	_c.Call.Return(beatsSheetEntity, err)
+func (_c *MockUpdateStoryPlanSource_SelectStoryPlan_Call) Return(storyPlanEntity *dao.StoryPlanEntity, err error) *MockUpdateStoryPlanSource_SelectStoryPlan_Call {
+	_c.Call.Return(storyPlanEntity, err)
 	return _c
 }
 
-func (_c *MockSelectBeatsSheetSource_SelectBeatsSheet_Call) RunAndReturn(run func(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)) *MockSelectBeatsSheetSource_SelectBeatsSheet_Call {
+func (_c *MockUpdateStoryPlanSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectStoryPlanData) (*dao.StoryPlanEntity, error)) *MockUpdateStoryPlanSource_SelectStoryPlan_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectLogline provides a mock function for the type MockSelectBeatsSheetSource
-func (_mock *MockSelectBeatsSheetSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+// UpdateStoryPlan provides a mock function for the type MockUpdateStoryPlanSource
+func (_mock *MockUpdateStoryPlanSource) UpdateStoryPlan(ctx context.Context, data dao.UpdateStoryPlanData) (*dao.StoryPlanEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectLogline")
+		panic("no return value specified for UpdateStoryPlan")
 	}
 
-	var r0 *dao.LoglineEntity
+	var r0 *dao.StoryPlanEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateStoryPlanData) (*dao.StoryPlanEntity, error)); ok {
 		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateStoryPlanData) *dao.StoryPlanEntity); ok {
 		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.LoglineEntity)
+			r0 = ret.Get(0).(*dao.StoryPlanEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.UpdateStoryPlanData) error); ok {
 		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
@@ -1814,27 +7541,27 @@ func (_mock *MockSelectBeatsSheetSource) SelectLogline(ctx context.Context, data
 	return r0, r1
 }
 
-// MockSelectBeatsSheetSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
-type MockSelectBeatsSheetSource_SelectLogline_Call struct {
+// MockUpdateStoryPlanSource_UpdateStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStoryPlan'
+type MockUpdateStoryPlanSource_UpdateStoryPlan_Call struct {
 	*mock.Call
 }
 
-// SelectLogline is a helper method to define mock.On call
+// UpdateStoryPlan is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data dao.SelectLoglineData
-func (_e *MockSelectBeatsSheetSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockSelectBeatsSheetSource_SelectLogline_Call {
-	return &MockSelectBeatsSheetSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+//   - data dao.UpdateStoryPlanData
+func (_e *MockUpdateStoryPlanSource_Expecter) UpdateStoryPlan(ctx interface{}, data interface{}) *MockUpdateStoryPlanSource_UpdateStoryPlan_Call {
+	return &MockUpdateStoryPlanSource_UpdateStoryPlan_Call{Call: _e.mock.On("UpdateStoryPlan", ctx, data)}
 }
 
-func (_c *MockSelectBeatsSheetSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockSelectBeatsSheetSource_SelectLogline_Call {
+func (_c *MockUpdateStoryPlanSource_UpdateStoryPlan_Call) Run(run func(ctx context.Context, data dao.UpdateStoryPlanData)) *MockUpdateStoryPlanSource_UpdateStoryPlan_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 dao.SelectLoglineData
+		var arg1 dao.UpdateStoryPlanData
 		if args[1] != nil {
-			arg1 = args[1].(dao.SelectLoglineData)
+			arg1 = args[1].(dao.UpdateStoryPlanData)
 		}
 		run(
 			arg0,
@@ -1844,23 +7571,23 @@ func (_c *MockSelectBeatsSheetSource_SelectLogline_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *MockSelectBeatsSheetSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockSelectBeatsSheetSource_SelectLogline_Call {
-	_c.Call.Return(loglineEntity, err)
+func (_c *MockUpdateStoryPlanSource_UpdateStoryPlan_Call) Return(storyPlanEntity *dao.StoryPlanEntity, err error) *MockUpdateStoryPlanSource_UpdateStoryPlan_Call {
+	_c.Call.Return(storyPlanEntity, err)
 	return _c
 }
 
-func (_c *MockSelectBeatsSheetSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockSelectBeatsSheetSource_SelectLogline_Call {
+func (_c *MockUpdateStoryPlanSource_UpdateStoryPlan_Call) RunAndReturn(run func(ctx context.Context, data dao.UpdateStoryPlanData) (*dao.StoryPlanEntity, error)) *MockUpdateStoryPlanSource_UpdateStoryPlan_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSelectLoglineSource creates a new instance of MockSelectLoglineSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockUpgradeBeatsSheetsSource creates a new instance of MockUpgradeBeatsSheetsSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSelectLoglineSource(t interface {
+func NewMockUpgradeBeatsSheetsSource(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSelectLoglineSource {
-	mock := &MockSelectLoglineSource{}
+}) *MockUpgradeBeatsSheetsSource {
+	mock := &MockUpgradeBeatsSheetsSource{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1868,40 +7595,40 @@ func NewMockSelectLoglineSource(t interface {
 	return mock
 }
 
-// MockSelectLoglineSource is an autogenerated mock type for the SelectLoglineSource type
-type MockSelectLoglineSource struct {
+// MockUpgradeBeatsSheetsSource is an autogenerated mock type for the UpgradeBeatsSheetsSource type
+type MockUpgradeBeatsSheetsSource struct {
 	mock.Mock
 }
 
-type MockSelectLoglineSource_Expecter struct {
+type MockUpgradeBeatsSheetsSource_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSelectLoglineSource) EXPECT() *MockSelectLoglineSource_Expecter {
-	return &MockSelectLoglineSource_Expecter{mock: &_m.Mock}
+func (_m *MockUpgradeBeatsSheetsSource) EXPECT() *MockUpgradeBeatsSheetsSource_Expecter {
+	return &MockUpgradeBeatsSheetsSource_Expecter{mock: &_m.Mock}
 }
 
-// SelectLogline provides a mock function for the type MockSelectLoglineSource
-func (_mock *MockSelectLoglineSource) SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error) {
+// ListOutdatedBeatsSheets provides a mock function for the type MockUpgradeBeatsSheetsSource
+func (_mock *MockUpgradeBeatsSheetsSource) ListOutdatedBeatsSheets(ctx context.Context, data dao.ListOutdatedBeatsSheetsData) ([]*dao.BeatsSheetEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectLogline")
+		panic("no return value specified for ListOutdatedBeatsSheets")
 	}
 
-	var r0 *dao.LoglineEntity
+	var r0 []*dao.BeatsSheetEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) (*dao.LoglineEntity, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListOutdatedBeatsSheetsData) ([]*dao.BeatsSheetEntity, error)); ok {
 		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineData) *dao.LoglineEntity); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.ListOutdatedBeatsSheetsData) []*dao.BeatsSheetEntity); ok {
 		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.LoglineEntity)
+			r0 = ret.Get(0).([]*dao.BeatsSheetEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineData) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.ListOutdatedBeatsSheetsData) error); ok {
 		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
@@ -1909,27 +7636,27 @@ func (_mock *MockSelectLoglineSource) SelectLogline(ctx context.Context, data da
 	return r0, r1
 }
 
-// MockSelectLoglineSource_SelectLogline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLogline'
-type MockSelectLoglineSource_SelectLogline_Call struct {
+// MockUpgradeBeatsSheetsSource_ListOutdatedBeatsSheets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOutdatedBeatsSheets'
+type MockUpgradeBeatsSheetsSource_ListOutdatedBeatsSheets_Call struct {
 	*mock.Call
 }
 
-// SelectLogline is a helper method to define mock.On call
+// ListOutdatedBeatsSheets is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data dao.SelectLoglineData
-func (_e *MockSelectLoglineSource_Expecter) SelectLogline(ctx interface{}, data interface{}) *MockSelectLoglineSource_SelectLogline_Call {
-	return &MockSelectLoglineSource_SelectLogline_Call{Call: _e.mock.On("SelectLogline", ctx, data)}
+//   - data dao.ListOutdatedBeatsSheetsData
+func (_e *MockUpgradeBeatsSheetsSource_Expecter) ListOutdatedBeatsSheets(ctx interface{}, data interface{}) *MockUpgradeBeatsSheetsSource_ListOutdatedBeatsSheets_Call {
+	return &MockUpgradeBeatsSheetsSource_ListOutdatedBeatsSheets_Call{Call: _e.mock.On("ListOutdatedBeatsSheets", ctx, data)}
 }
 
-func (_c *MockSelectLoglineSource_SelectLogline_Call) Run(run func(ctx context.Context, data dao.SelectLoglineData)) *MockSelectLoglineSource_SelectLogline_Call {
+func (_c *MockUpgradeBeatsSheetsSource_ListOutdatedBeatsSheets_Call) Run(run func(ctx context.Context, data dao.ListOutdatedBeatsSheetsData)) *MockUpgradeBeatsSheetsSource_ListOutdatedBeatsSheets_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 dao.SelectLoglineData
+		var arg1 dao.ListOutdatedBeatsSheetsData
 		if args[1] != nil {
-			arg1 = args[1].(dao.SelectLoglineData)
+			arg1 = args[1].(dao.ListOutdatedBeatsSheetsData)
 		}
 		run(
 			arg0,
@@ -1939,37 +7666,105 @@ func (_c *MockSelectLoglineSource_SelectLogline_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockSelectLoglineSource_SelectLogline_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockSelectLoglineSource_SelectLogline_Call {
-	_c.Call.Return(loglineEntity, err)
+func (_c *MockUpgradeBeatsSheetsSource_ListOutdatedBeatsSheets_Call) Return(beatsSheetEntitys []*dao.BeatsSheetEntity, err error) *MockUpgradeBeatsSheetsSource_ListOutdatedBeatsSheets_Call {
+	_c.Call.Return(beatsSheetEntitys, err)
 	return _c
 }
 
-func (_c *MockSelectLoglineSource_SelectLogline_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)) *MockSelectLoglineSource_SelectLogline_Call {
+func (_c *MockUpgradeBeatsSheetsSource_ListOutdatedBeatsSheets_Call) RunAndReturn(run func(ctx context.Context, data dao.ListOutdatedBeatsSheetsData) ([]*dao.BeatsSheetEntity, error)) *MockUpgradeBeatsSheetsSource_ListOutdatedBeatsSheets_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectLoglineBySlug provides a mock function for the type MockSelectLoglineSource
-func (_mock *MockSelectLoglineSource) SelectLoglineBySlug(ctx context.Context, data dao.SelectLoglineBySlugData) (*dao.LoglineEntity, error) {
+// SelectStoryPlan provides a mock function for the type MockUpgradeBeatsSheetsSource
+func (_mock *MockUpgradeBeatsSheetsSource) SelectStoryPlan(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectStoryPlan")
+	}
+
+	var r0 *storyplanmodel.Plan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.SelectStoryPlanRequest) *storyplanmodel.Plan); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*storyplanmodel.Plan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.SelectStoryPlanRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUpgradeBeatsSheetsSource_SelectStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectStoryPlan'
+type MockUpgradeBeatsSheetsSource_SelectStoryPlan_Call struct {
+	*mock.Call
+}
+
+// SelectStoryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.SelectStoryPlanRequest
+func (_e *MockUpgradeBeatsSheetsSource_Expecter) SelectStoryPlan(ctx interface{}, request interface{}) *MockUpgradeBeatsSheetsSource_SelectStoryPlan_Call {
+	return &MockUpgradeBeatsSheetsSource_SelectStoryPlan_Call{Call: _e.mock.On("SelectStoryPlan", ctx, request)}
+}
+
+func (_c *MockUpgradeBeatsSheetsSource_SelectStoryPlan_Call) Run(run func(ctx context.Context, request services.SelectStoryPlanRequest)) *MockUpgradeBeatsSheetsSource_SelectStoryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.SelectStoryPlanRequest
+		if args[1] != nil {
+			arg1 = args[1].(services.SelectStoryPlanRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUpgradeBeatsSheetsSource_SelectStoryPlan_Call) Return(plan *storyplanmodel.Plan, err error) *MockUpgradeBeatsSheetsSource_SelectStoryPlan_Call {
+	_c.Call.Return(plan, err)
+	return _c
+}
+
+func (_c *MockUpgradeBeatsSheetsSource_SelectStoryPlan_Call) RunAndReturn(run func(ctx context.Context, request services.SelectStoryPlanRequest) (*storyplanmodel.Plan, error)) *MockUpgradeBeatsSheetsSource_SelectStoryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBeatsSheetStoryPlan provides a mock function for the type MockUpgradeBeatsSheetsSource
+func (_mock *MockUpgradeBeatsSheetsSource) UpdateBeatsSheetStoryPlan(ctx context.Context, data dao.UpdateBeatsSheetStoryPlanData) (*dao.BeatsSheetEntity, error) {
 	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectLoglineBySlug")
+		panic("no return value specified for UpdateBeatsSheetStoryPlan")
 	}
 
-	var r0 *dao.LoglineEntity
+	var r0 *dao.BeatsSheetEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineBySlugData) (*dao.LoglineEntity, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateBeatsSheetStoryPlanData) (*dao.BeatsSheetEntity, error)); ok {
 		return returnFunc(ctx, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.SelectLoglineBySlugData) *dao.LoglineEntity); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dao.UpdateBeatsSheetStoryPlanData) *dao.BeatsSheetEntity); ok {
 		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.LoglineEntity)
+			r0 = ret.Get(0).(*dao.BeatsSheetEntity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.SelectLoglineBySlugData) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dao.UpdateBeatsSheetStoryPlanData) error); ok {
 		r1 = returnFunc(ctx, data)
 	} else {
 		r1 = ret.Error(1)
@@ -1977,27 +7772,27 @@ func (_mock *MockSelectLoglineSource) SelectLoglineBySlug(ctx context.Context, d
 	return r0, r1
 }
 
-// MockSelectLoglineSource_SelectLoglineBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectLoglineBySlug'
-type MockSelectLoglineSource_SelectLoglineBySlug_Call struct {
+// MockUpgradeBeatsSheetsSource_UpdateBeatsSheetStoryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBeatsSheetStoryPlan'
+type MockUpgradeBeatsSheetsSource_UpdateBeatsSheetStoryPlan_Call struct {
 	*mock.Call
 }
 
-// SelectLoglineBySlug is a helper method to define mock.On call
+// UpdateBeatsSheetStoryPlan is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data dao.SelectLoglineBySlugData
-func (_e *MockSelectLoglineSource_Expecter) SelectLoglineBySlug(ctx interface{}, data interface{}) *MockSelectLoglineSource_SelectLoglineBySlug_Call {
-	return &MockSelectLoglineSource_SelectLoglineBySlug_Call{Call: _e.mock.On("SelectLoglineBySlug", ctx, data)}
+//   - data dao.UpdateBeatsSheetStoryPlanData
+func (_e *MockUpgradeBeatsSheetsSource_Expecter) UpdateBeatsSheetStoryPlan(ctx interface{}, data interface{}) *MockUpgradeBeatsSheetsSource_UpdateBeatsSheetStoryPlan_Call {
+	return &MockUpgradeBeatsSheetsSource_UpdateBeatsSheetStoryPlan_Call{Call: _e.mock.On("UpdateBeatsSheetStoryPlan", ctx, data)}
 }
 
-func (_c *MockSelectLoglineSource_SelectLoglineBySlug_Call) Run(run func(ctx context.Context, data dao.SelectLoglineBySlugData)) *MockSelectLoglineSource_SelectLoglineBySlug_Call {
+func (_c *MockUpgradeBeatsSheetsSource_UpdateBeatsSheetStoryPlan_Call) Run(run func(ctx context.Context, data dao.UpdateBeatsSheetStoryPlanData)) *MockUpgradeBeatsSheetsSource_UpdateBeatsSheetStoryPlan_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 dao.SelectLoglineBySlugData
+		var arg1 dao.UpdateBeatsSheetStoryPlanData
 		if args[1] != nil {
-			arg1 = args[1].(dao.SelectLoglineBySlugData)
+			arg1 = args[1].(dao.UpdateBeatsSheetStoryPlanData)
 		}
 		run(
 			arg0,
@@ -2007,12 +7802,12 @@ func (_c *MockSelectLoglineSource_SelectLoglineBySlug_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *MockSelectLoglineSource_SelectLoglineBySlug_Call) Return(loglineEntity *dao.LoglineEntity, err error) *MockSelectLoglineSource_SelectLoglineBySlug_Call {
-	_c.Call.Return(loglineEntity, err)
+func (_c *MockUpgradeBeatsSheetsSource_UpdateBeatsSheetStoryPlan_Call) Return(beatsSheetEntity *dao.BeatsSheetEntity, err error) *MockUpgradeBeatsSheetsSource_UpdateBeatsSheetStoryPlan_Call {
+	_c.Call.Return(beatsSheetEntity, err)
 	return _c
 }
 
-func (_c *MockSelectLoglineSource_SelectLoglineBySlug_Call) RunAndReturn(run func(ctx context.Context, data dao.SelectLoglineBySlugData) (*dao.LoglineEntity, error)) *MockSelectLoglineSource_SelectLoglineBySlug_Call {
+func (_c *MockUpgradeBeatsSheetsSource_UpdateBeatsSheetStoryPlan_Call) RunAndReturn(run func(ctx context.Context, data dao.UpdateBeatsSheetStoryPlanData) (*dao.BeatsSheetEntity, error)) *MockUpgradeBeatsSheetsSource_UpdateBeatsSheetStoryPlan_Call {
 	_c.Call.Return(run)
 	return _c
 }
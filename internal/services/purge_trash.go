@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+// ErrInvalidRetention is returned when the trash retention period is not strictly positive.
+var ErrInvalidRetention = errors.New("invalid trash retention")
+
+type PurgeTrashSource interface {
+	PurgeTrash(ctx context.Context, data dao.PurgeTrashData) (*dao.PurgeTrashEntity, error)
+}
+
+// PurgeTrashRequest permanently deletes the items that have been in the trash for longer than the retention period.
+type PurgeTrashRequest struct {
+	Retention time.Duration
+}
+
+type PurgeTrashService struct {
+	source PurgeTrashSource
+}
+
+func NewPurgeTrashService(source PurgeTrashSource) *PurgeTrashService {
+	return &PurgeTrashService{source: source}
+}
+
+func (service *PurgeTrashService) PurgeTrash(
+	ctx context.Context, request PurgeTrashRequest,
+) (*models.TrashPurge, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.PurgeTrash")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("request.retention", request.Retention.String()))
+
+	if request.Retention <= 0 {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: %s", ErrInvalidRetention, request.Retention))
+	}
+
+	resp, err := service.source.PurgeTrash(ctx, dao.PurgeTrashData{
+		Before: time.Now().Add(-request.Retention),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("purge trash: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &models.TrashPurge{
+		Loglines:    resp.Loglines,
+		BeatsSheets: resp.BeatsSheets,
+	}), nil
+}
@@ -0,0 +1,99 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestPurgeTrash(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type purgeTrashData struct {
+		resp *dao.PurgeTrashEntity
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.PurgeTrashRequest
+
+		purgeTrashData *purgeTrashData
+
+		expect    *models.TrashPurge
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.PurgeTrashRequest{Retention: 30 * 24 * time.Hour},
+
+			purgeTrashData: &purgeTrashData{
+				resp: &dao.PurgeTrashEntity{Loglines: 2, BeatsSheets: 5},
+			},
+
+			expect: &models.TrashPurge{Loglines: 2, BeatsSheets: 5},
+		},
+		{
+			name: "InvalidRetention",
+
+			request: services.PurgeTrashRequest{Retention: 0},
+
+			expectErr: services.ErrInvalidRetention,
+		},
+		{
+			name: "NegativeRetention",
+
+			request: services.PurgeTrashRequest{Retention: -time.Hour},
+
+			expectErr: services.ErrInvalidRetention,
+		},
+		{
+			name: "Error",
+
+			request: services.PurgeTrashRequest{Retention: 30 * 24 * time.Hour},
+
+			purgeTrashData: &purgeTrashData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockPurgeTrashSource(t)
+
+			if testCase.purgeTrashData != nil {
+				source.EXPECT().
+					PurgeTrash(mock.Anything, mock.MatchedBy(func(data dao.PurgeTrashData) bool {
+						return assert.WithinDuration(t, time.Now().Add(-testCase.request.Retention), data.Before, time.Second)
+					})).
+					Return(testCase.purgeTrashData.resp, testCase.purgeTrashData.err)
+			}
+
+			service := services.NewPurgeTrashService(source)
+
+			resp, err := service.PurgeTrash(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel/golib/otel"
@@ -15,6 +16,7 @@ import (
 )
 
 type RegenerateBeatsSource interface {
+	InsertBeatsSheet(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)
 	RegenerateBeats(ctx context.Context, request daoai.RegenerateBeatsRequest) ([]models.Beat, error)
 	SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)
 	SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)
@@ -22,17 +24,20 @@ type RegenerateBeatsSource interface {
 }
 
 func NewRegenerateBeatsServiceSource(
+	insertBeatsSheetDAO *dao.InsertBeatsSheetRepository,
 	regenerateBeatsDAO *daoai.RegenerateBeatsRepository,
 	selectBeatsSheetDAO *dao.SelectBeatsSheetRepository,
 	selectLoglineDAO *dao.SelectLoglineRepository,
 	selectStoryPlan *SelectStoryPlanService,
 ) RegenerateBeatsSource {
 	return &struct {
+		*dao.InsertBeatsSheetRepository
 		*daoai.RegenerateBeatsRepository
 		*dao.SelectBeatsSheetRepository
 		*dao.SelectLoglineRepository
 		*SelectStoryPlanService
 	}{
+		InsertBeatsSheetRepository: insertBeatsSheetDAO,
 		RegenerateBeatsRepository:  regenerateBeatsDAO,
 		SelectBeatsSheetRepository: selectBeatsSheetDAO,
 		SelectLoglineRepository:    selectLoglineDAO,
@@ -44,6 +49,9 @@ type RegenerateBeatsRequest struct {
 	BeatsSheetID   uuid.UUID
 	UserID         uuid.UUID
 	RegenerateKeys []string
+	// Apply saves the regenerated beats as a new revision of the sheet, instead of only returning them. The original
+	// sheet is left untouched, and becomes the parent of the new one.
+	Apply bool
 }
 
 type RegenerateBeatsService struct {
@@ -56,7 +64,7 @@ func NewRegenerateBeatsService(source RegenerateBeatsSource) *RegenerateBeatsSer
 
 func (service *RegenerateBeatsService) RegenerateBeats(
 	ctx context.Context, request RegenerateBeatsRequest,
-) ([]models.Beat, error) {
+) (*models.RegeneratedBeats, error) {
 	ctx, span := otel.Tracer().Start(ctx, "service.RegenerateBeats")
 	defer span.End()
 
@@ -64,6 +72,7 @@ func (service *RegenerateBeatsService) RegenerateBeats(
 		attribute.String("request.beatsSheetID", request.BeatsSheetID.String()),
 		attribute.String("request.userID", request.UserID.String()),
 		attribute.StringSlice("request.regenerateKeys", request.RegenerateKeys),
+		attribute.Bool("request.apply", request.Apply),
 	)
 
 	beatsSheet, err := service.source.SelectBeatsSheet(ctx, request.BeatsSheetID)
@@ -80,8 +89,11 @@ func (service *RegenerateBeatsService) RegenerateBeats(
 		return nil, otel.ReportError(span, err)
 	}
 
+	// Use the plan the beats sheet was created with. Older sheets have no plan attached, and use the default one.
 	storyPlan, err := service.source.SelectStoryPlan(ctx, SelectStoryPlanRequest{
-		Lang: beatsSheet.Lang,
+		ID:     lo.Ternary(beatsSheet.StoryPlanID != uuid.Nil, &beatsSheet.StoryPlanID, nil),
+		Lang:   beatsSheet.Lang,
+		UserID: request.UserID,
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, err)
@@ -99,5 +111,23 @@ func (service *RegenerateBeatsService) RegenerateBeats(
 		return nil, otel.ReportError(span, err)
 	}
 
-	return otel.ReportSuccess(span, regenerated), nil
+	if !request.Apply {
+		return otel.ReportSuccess(span, &models.RegeneratedBeats{Beats: regenerated}), nil
+	}
+
+	content := mergeBeats(beatsSheet.Content, regenerated)
+
+	beatsSheetRevision, err := insertBeatsSheetRevision(
+		ctx, service.source.InsertBeatsSheet, beatsSheet, storyPlan, content,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(attribute.String("dao.insertBeatsSheet.id", beatsSheetRevision.ID.String()))
+
+	return otel.ReportSuccess(span, &models.RegeneratedBeats{
+		Beats:      regenerated,
+		BeatsSheet: beatsSheetRevision,
+	}), nil
 }
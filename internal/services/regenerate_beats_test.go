@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -27,6 +29,11 @@ func TestRegenerateBeats(t *testing.T) {
 		err  error
 	}
 
+	type insertBeatsSheetData struct {
+		resp *dao.BeatsSheetEntity
+		err  error
+	}
+
 	type selectBeatsSheetData struct {
 		resp *dao.BeatsSheetEntity
 		err  error
@@ -51,8 +58,9 @@ func TestRegenerateBeats(t *testing.T) {
 		selectLoglineData    *selectLoglineData
 		selectStoryPlanData  *selectStoryPlanData
 		regenerateBeatsData  *regenerateBeatsData
+		insertBeatsSheetData *insertBeatsSheetData
 
-		expect    []models.Beat
+		expect    *models.RegeneratedBeats
 		expectErr error
 	}{
 		{
@@ -135,19 +143,403 @@ func TestRegenerateBeats(t *testing.T) {
 				},
 			},
 
-			expect: []models.Beat{
-				{
-					Key:     "beat-1",
-					Title:   "Regenerated Beat 1",
-					Content: "Regenerated Content 1",
+			expect: &models.RegeneratedBeats{
+				Beats: []models.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Regenerated Beat 1",
+						Content: "Regenerated Content 1",
+					},
+					{
+						Key:     "beat-2",
+						Title:   "Regenerated Beat 2",
+						Content: "Regenerated Content 2",
+					},
 				},
-				{
-					Key:     "beat-2",
-					Title:   "Regenerated Beat 2",
-					Content: "Regenerated Content 2",
+			},
+		},
+		{
+			name: "Success/StoredStoryPlan",
+
+			request: services.RegenerateBeatsRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				RegenerateKeys: []string{
+					"beat-1",
+					"beat-2",
+				},
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Generated Beat 1",
+							Content: "Generated Content 1",
+						},
+						{
+							Key:     "beat-2",
+							Title:   "Generated Beat 2",
+							Content: "Generated Content 2",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "logline-1",
+					Name:      "Logline 1",
+					Content:   "Content 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						Name: "Test Story Plan",
+						Lang: models.LangEN,
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name: "Beat 1",
+							Key:  "beat-1",
+							KeyPoints: []string{
+								"Key Point 1",
+								"Key Point 2",
+							},
+							Purpose: "Purpose 1",
+						},
+					},
+				},
+			},
+
+			regenerateBeatsData: &regenerateBeatsData{
+				resp: []models.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Regenerated Beat 1",
+						Content: "Regenerated Content 1",
+					},
+					{
+						Key:     "beat-2",
+						Title:   "Regenerated Beat 2",
+						Content: "Regenerated Content 2",
+					},
+				},
+			},
+
+			expect: &models.RegeneratedBeats{
+				Beats: []models.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Regenerated Beat 1",
+						Content: "Regenerated Content 1",
+					},
+					{
+						Key:     "beat-2",
+						Title:   "Regenerated Beat 2",
+						Content: "Regenerated Content 2",
+					},
 				},
 			},
 		},
+		{
+			name: "Success/Apply",
+
+			request: services.RegenerateBeatsRequest{
+				BeatsSheetID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				RegenerateKeys: []string{"beat-1"},
+				Apply:          true,
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Generated Beat 1",
+							Content: "Generated Content 1",
+						},
+						{
+							Key:     "beat-2",
+							Title:   "Generated Beat 2",
+							Content: "Generated Content 2",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "logline-1",
+					Name:      "Logline 1",
+					Content:   "Content 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						Name: "Test Story Plan",
+						Lang: models.LangEN,
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Beat 1",
+							Key:       "beat-1",
+							KeyPoints: []string{"Key Point 1"},
+							Purpose:   "Purpose 1",
+						},
+						{
+							Name:      "Beat 2",
+							Key:       "beat-2",
+							KeyPoints: []string{"Key Point 2"},
+							Purpose:   "Purpose 2",
+						},
+					},
+				},
+			},
+
+			regenerateBeatsData: &regenerateBeatsData{
+				resp: []models.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Regenerated Beat 1",
+						Content: "Regenerated Content 1",
+					},
+				},
+			},
+
+			insertBeatsSheetData: &insertBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					ParentID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Regenerated Beat 1",
+							Content: "Regenerated Content 1",
+						},
+						{
+							Key:     "beat-2",
+							Title:   "Generated Beat 2",
+							Content: "Generated Content 2",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.RegeneratedBeats{
+				Beats: []models.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Regenerated Beat 1",
+						Content: "Regenerated Content 1",
+					},
+				},
+				BeatsSheet: &models.BeatsSheet{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					ParentID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Regenerated Beat 1",
+							Content: "Regenerated Content 1",
+						},
+						{
+							Key:     "beat-2",
+							Title:   "Generated Beat 2",
+							Content: "Generated Content 2",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Apply/InvalidPlan",
+
+			request: services.RegenerateBeatsRequest{
+				BeatsSheetID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				RegenerateKeys: []string{"beat-1"},
+				Apply:          true,
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Generated Beat 1",
+							Content: "Generated Content 1",
+						},
+						{
+							Key:     "beat-2",
+							Title:   "Generated Beat 2",
+							Content: "Generated Content 2",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "logline-1",
+					Name:      "Logline 1",
+					Content:   "Content 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						Name: "Test Story Plan",
+						Lang: models.LangEN,
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Beat 1",
+							Key:       "beat-1",
+							KeyPoints: []string{"Key Point 1"},
+							Purpose:   "Purpose 1",
+						},
+					},
+				},
+			},
+
+			regenerateBeatsData: &regenerateBeatsData{
+				resp: []models.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Regenerated Beat 1",
+						Content: "Regenerated Content 1",
+					},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrInvalidPlan,
+		},
+		{
+			name: "InsertBeatsSheet/Error",
+
+			request: services.RegenerateBeatsRequest{
+				BeatsSheetID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				RegenerateKeys: []string{"beat-1"},
+				Apply:          true,
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "beat-1",
+							Title:   "Generated Beat 1",
+							Content: "Generated Content 1",
+						},
+						{
+							Key:     "beat-2",
+							Title:   "Generated Beat 2",
+							Content: "Generated Content 2",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "logline-1",
+					Name:      "Logline 1",
+					Content:   "Content 1",
+					Lang:      models.LangEN,
+					CreatedAt: time.Now(),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: &storyplanmodel.Plan{
+					Metadata: storyplanmodel.Metadata{
+						Name: "Test Story Plan",
+						Lang: models.LangEN,
+					},
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Beat 1",
+							Key:       "beat-1",
+							KeyPoints: []string{"Key Point 1"},
+							Purpose:   "Purpose 1",
+						},
+						{
+							Name:      "Beat 2",
+							Key:       "beat-2",
+							KeyPoints: []string{"Key Point 2"},
+							Purpose:   "Purpose 2",
+						},
+					},
+				},
+			},
+
+			regenerateBeatsData: &regenerateBeatsData{
+				resp: []models.Beat{
+					{
+						Key:     "beat-1",
+						Title:   "Regenerated Beat 1",
+						Content: "Regenerated Content 1",
+					},
+				},
+			},
+
+			insertBeatsSheetData: &insertBeatsSheetData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
 		{
 			name: "RegenerateBeats/Error",
 
@@ -356,7 +748,15 @@ func TestRegenerateBeats(t *testing.T) {
 				source.EXPECT().
 					SelectStoryPlan(
 						mock.Anything,
-						services.SelectStoryPlanRequest{Lang: testCase.selectBeatsSheetData.resp.Lang},
+						services.SelectStoryPlanRequest{
+							ID: lo.Ternary(
+								testCase.selectBeatsSheetData.resp.StoryPlanID != uuid.Nil,
+								&testCase.selectBeatsSheetData.resp.StoryPlanID,
+								nil,
+							),
+							Lang:   testCase.selectBeatsSheetData.resp.Lang,
+							UserID: testCase.request.UserID,
+						},
 					).
 					Return(testCase.selectStoryPlanData.resp, testCase.selectStoryPlanData.err)
 			}
@@ -374,6 +774,24 @@ func TestRegenerateBeats(t *testing.T) {
 					Return(testCase.regenerateBeatsData.resp, testCase.regenerateBeatsData.err)
 			}
 
+			if testCase.insertBeatsSheetData != nil {
+				source.EXPECT().
+					InsertBeatsSheet(mock.Anything, mock.MatchedBy(func(data dao.InsertBeatsSheetData) bool {
+						return assert.NotEqual(t, uuid.Nil, data.Sheet.ID) &&
+							assert.Equal(t, testCase.selectBeatsSheetData.resp.LoglineID, data.Sheet.LoglineID) &&
+							assert.Equal(t, testCase.selectBeatsSheetData.resp.StoryPlanID, data.Sheet.StoryPlanID) &&
+							assert.Equal(t, testCase.selectBeatsSheetData.resp.ID, data.Sheet.ParentID) &&
+							assert.Equal(t, uuid.Nil, data.Sheet.SourceID) &&
+							assert.Equal(t, []models.Beat{
+								testCase.regenerateBeatsData.resp[0],
+								testCase.selectBeatsSheetData.resp.Content[1],
+							}, data.Sheet.Content) &&
+							assert.Equal(t, testCase.selectBeatsSheetData.resp.Lang, data.Sheet.Lang) &&
+							assert.WithinDuration(t, time.Now(), data.Sheet.CreatedAt, time.Second)
+					})).
+					Return(testCase.insertBeatsSheetData.resp, testCase.insertBeatsSheetData.err)
+			}
+
 			service := services.NewRegenerateBeatsService(source)
 
 			resp, err := service.RegenerateBeats(ctx, testCase.request)
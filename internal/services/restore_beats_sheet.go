@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type RestoreBeatsSheetSource interface {
+	RestoreBeatsSheet(ctx context.Context, data dao.RestoreBeatsSheetData) (*dao.BeatsSheetEntity, error)
+	SelectBeatsSheet(ctx context.Context, request SelectBeatsSheetRequest) (*models.BeatsSheet, error)
+}
+
+func NewRestoreBeatsSheetServiceSource(
+	restoreBeatsSheetDAO *dao.RestoreBeatsSheetRepository,
+	selectBeatsSheet *SelectBeatsSheetService,
+) RestoreBeatsSheetSource {
+	return &struct {
+		*dao.RestoreBeatsSheetRepository
+		*SelectBeatsSheetService
+	}{
+		RestoreBeatsSheetRepository: restoreBeatsSheetDAO,
+		SelectBeatsSheetService:     selectBeatsSheet,
+	}
+}
+
+// RestoreBeatsSheetRequest takes a single beats sheet out of the trash. Sheets trashed along with their logline must
+// be restored through the logline.
+type RestoreBeatsSheetRequest struct {
+	BeatsSheetID uuid.UUID
+	UserID       uuid.UUID
+}
+
+type RestoreBeatsSheetService struct {
+	source RestoreBeatsSheetSource
+}
+
+func NewRestoreBeatsSheetService(source RestoreBeatsSheetSource) *RestoreBeatsSheetService {
+	return &RestoreBeatsSheetService{source: source}
+}
+
+func (service *RestoreBeatsSheetService) RestoreBeatsSheet(
+	ctx context.Context, request RestoreBeatsSheetRequest,
+) (*models.BeatsSheet, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.RestoreBeatsSheet")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.beatsSheetID", request.BeatsSheetID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+	)
+
+	_, err := service.source.RestoreBeatsSheet(ctx, dao.RestoreBeatsSheetData{
+		ID:     request.BeatsSheetID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("restore beats sheet: %w", err))
+	}
+
+	// Return the full sheet, with its beats grouped into the acts of its story plan.
+	resp, err := service.source.SelectBeatsSheet(ctx, SelectBeatsSheetRequest(request))
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select beats sheet: %w", err))
+	}
+
+	return otel.ReportSuccess(span, resp), nil
+}
@@ -0,0 +1,146 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestRestoreBeatsSheet(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type restoreBeatsSheetData struct {
+		resp *dao.BeatsSheetEntity
+		err  error
+	}
+
+	type selectBeatsSheetData struct {
+		resp *models.BeatsSheet
+		err  error
+	}
+
+	request := services.RestoreBeatsSheetRequest{
+		BeatsSheetID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:       uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+	}
+
+	restored := &dao.BeatsSheetEntity{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Content: []models.Beat{
+			{
+				Key:     "test-beat",
+				Title:   "Test Beat",
+				Content: "Test Beat Content",
+			},
+		},
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	sheet := &models.BeatsSheet{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Content: []models.Beat{
+			{
+				Key:     "test-beat",
+				Title:   "Test Beat",
+				Content: "Test Beat Content",
+			},
+		},
+		Lang: models.LangEN,
+		Acts: []models.BeatsSheetAct{
+			{Key: "setup", Name: "Setup", Beats: []string{"test-beat"}},
+		},
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.RestoreBeatsSheetRequest
+
+		restoreBeatsSheetData *restoreBeatsSheetData
+		selectBeatsSheetData  *selectBeatsSheetData
+
+		expect    *models.BeatsSheet
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			restoreBeatsSheetData: &restoreBeatsSheetData{resp: restored},
+			selectBeatsSheetData:  &selectBeatsSheetData{resp: sheet},
+
+			expect: sheet,
+		},
+		{
+			name: "NotFound",
+
+			request: request,
+
+			restoreBeatsSheetData: &restoreBeatsSheetData{err: dao.ErrBeatsSheetNotFound},
+
+			expectErr: dao.ErrBeatsSheetNotFound,
+		},
+		{
+			name: "SelectError",
+
+			request: request,
+
+			restoreBeatsSheetData: &restoreBeatsSheetData{resp: restored},
+			selectBeatsSheetData:  &selectBeatsSheetData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockRestoreBeatsSheetSource(t)
+
+			if testCase.restoreBeatsSheetData != nil {
+				source.EXPECT().
+					RestoreBeatsSheet(mock.Anything, dao.RestoreBeatsSheetData{
+						ID:     testCase.request.BeatsSheetID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.restoreBeatsSheetData.resp, testCase.restoreBeatsSheetData.err)
+			}
+
+			if testCase.selectBeatsSheetData != nil {
+				source.EXPECT().
+					SelectBeatsSheet(mock.Anything, services.SelectBeatsSheetRequest{
+						BeatsSheetID: testCase.request.BeatsSheetID,
+						UserID:       testCase.request.UserID,
+					}).
+					Return(testCase.selectBeatsSheetData.resp, testCase.selectBeatsSheetData.err)
+			}
+
+			service := services.NewRestoreBeatsSheetService(source)
+
+			resp, err := service.RestoreBeatsSheet(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
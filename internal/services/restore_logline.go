@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type RestoreLoglineSource interface {
+	RestoreLogline(ctx context.Context, data dao.RestoreLoglineData) (*dao.LoglineEntity, error)
+}
+
+// RestoreLoglineRequest takes a logline out of the trash, along with the beats sheets that were trashed with it.
+type RestoreLoglineRequest struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+type RestoreLoglineService struct {
+	source RestoreLoglineSource
+}
+
+func NewRestoreLoglineService(source RestoreLoglineSource) *RestoreLoglineService {
+	return &RestoreLoglineService{source: source}
+}
+
+func (service *RestoreLoglineService) RestoreLogline(
+	ctx context.Context, request RestoreLoglineRequest,
+) (*models.Logline, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.RestoreLogline")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.id", request.ID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+	)
+
+	resp, err := service.source.RestoreLogline(ctx, dao.RestoreLoglineData{
+		ID:     request.ID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("restore logline: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &models.Logline{
+		ID:        resp.ID,
+		UserID:    resp.UserID,
+		Slug:      resp.Slug,
+		SourceID:  resp.SourceID,
+		ProjectID: resp.ProjectID,
+		Name:      resp.Name,
+		Content:   resp.Content,
+		Lang:      resp.Lang,
+		Genre:     resp.Genre,
+		Tags:      resp.Tags,
+		CreatedAt: resp.CreatedAt,
+		UpdatedAt: resp.UpdatedAt,
+		DeletedAt: resp.DeletedAt,
+	}), nil
+}
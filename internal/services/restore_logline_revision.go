@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type RestoreLoglineRevisionSource interface {
+	SelectLoglineRevision(ctx context.Context, data uuid.UUID) (*dao.LoglineRevisionEntity, error)
+	SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)
+	UpdateLogline(ctx context.Context, data dao.UpdateLoglineData) (*dao.LoglineEntity, error)
+}
+
+func NewRestoreLoglineRevisionServiceSource(
+	selectLoglineRevisionDAO *dao.SelectLoglineRevisionRepository,
+	selectLoglineDAO *dao.SelectLoglineRepository,
+	updateLoglineDAO *dao.UpdateLoglineRepository,
+) RestoreLoglineRevisionSource {
+	return &struct {
+		*dao.SelectLoglineRevisionRepository
+		*dao.SelectLoglineRepository
+		*dao.UpdateLoglineRepository
+	}{
+		SelectLoglineRevisionRepository: selectLoglineRevisionDAO,
+		SelectLoglineRepository:         selectLoglineDAO,
+		UpdateLoglineRepository:         updateLoglineDAO,
+	}
+}
+
+// RestoreLoglineRevisionRequest writes the content of an older revision back to its logline. The slug of the
+// logline is kept, and the restored content is saved as a new revision, so the history is never rewritten.
+type RestoreLoglineRevisionRequest struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+type RestoreLoglineRevisionService struct {
+	source RestoreLoglineRevisionSource
+}
+
+func NewRestoreLoglineRevisionService(source RestoreLoglineRevisionSource) *RestoreLoglineRevisionService {
+	return &RestoreLoglineRevisionService{source: source}
+}
+
+func (service *RestoreLoglineRevisionService) RestoreLoglineRevision(
+	ctx context.Context, request RestoreLoglineRevisionRequest,
+) (*models.Logline, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.RestoreLoglineRevision")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.id", request.ID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+	)
+
+	revision, err := service.source.SelectLoglineRevision(ctx, request.ID)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select logline revision: %w", err))
+	}
+
+	// Also makes sure the revision belongs to a logline of the user.
+	logline, err := service.source.SelectLogline(ctx, dao.SelectLoglineData{
+		ID:     revision.LoglineID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select logline: %w", err))
+	}
+
+	resp, err := service.source.UpdateLogline(ctx, dao.UpdateLoglineData{
+		ID:      logline.ID,
+		UserID:  request.UserID,
+		Slug:    logline.Slug,
+		Name:    revision.Name,
+		Content: revision.Content,
+		Lang:    revision.Lang,
+		// Revisions only track the content, the metadata of the logline stays as is.
+		Genre:     logline.Genre,
+		Tags:      logline.Tags,
+		ProjectID: logline.ProjectID,
+		Now:       time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("update logline: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &models.Logline{
+		ID:        resp.ID,
+		UserID:    resp.UserID,
+		Slug:      resp.Slug,
+		SourceID:  resp.SourceID,
+		ProjectID: resp.ProjectID,
+		Name:      resp.Name,
+		Content:   resp.Content,
+		Lang:      resp.Lang,
+		Genre:     resp.Genre,
+		Tags:      resp.Tags,
+		CreatedAt: resp.CreatedAt,
+		UpdatedAt: resp.UpdatedAt,
+	}), nil
+}
@@ -0,0 +1,208 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestRestoreLoglineRevision(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectLoglineRevisionData struct {
+		resp *dao.LoglineRevisionEntity
+		err  error
+	}
+
+	type selectLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	type updateLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	revision := &dao.LoglineRevisionEntity{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		Name:      "Old Name",
+		Content:   "A young hero leaves home.",
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	logline := &dao.LoglineEntity{
+		ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Slug:      "test-slug",
+		Name:      "New Name",
+		Content:   "A reluctant hero leaves home.",
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.RestoreLoglineRevisionRequest
+
+		selectLoglineRevisionData *selectLoglineRevisionData
+		selectLoglineData         *selectLoglineData
+		updateLoglineData         *updateLoglineData
+
+		expect    *models.Logline
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.RestoreLoglineRevisionRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectLoglineRevisionData: &selectLoglineRevisionData{
+				resp: revision,
+			},
+			selectLoglineData: &selectLoglineData{
+				resp: logline,
+			},
+			updateLoglineData: &updateLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Old Name",
+					Content:   "A young hero leaves home.",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.Logline{
+				ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "test-slug",
+				Name:      "Old Name",
+				Content:   "A young hero leaves home.",
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "SelectRevisionError",
+
+			request: services.RestoreLoglineRevisionRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectLoglineRevisionData: &selectLoglineRevisionData{
+				err: dao.ErrLoglineRevisionNotFound,
+			},
+
+			expectErr: dao.ErrLoglineRevisionNotFound,
+		},
+		{
+			name: "SelectLoglineError",
+
+			request: services.RestoreLoglineRevisionRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectLoglineRevisionData: &selectLoglineRevisionData{
+				resp: revision,
+			},
+			selectLoglineData: &selectLoglineData{
+				err: dao.ErrLoglineNotFound,
+			},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+		{
+			name: "UpdateError",
+
+			request: services.RestoreLoglineRevisionRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectLoglineRevisionData: &selectLoglineRevisionData{
+				resp: revision,
+			},
+			selectLoglineData: &selectLoglineData{
+				resp: logline,
+			},
+			updateLoglineData: &updateLoglineData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockRestoreLoglineRevisionSource(t)
+
+			if testCase.selectLoglineRevisionData != nil {
+				source.EXPECT().
+					SelectLoglineRevision(mock.Anything, testCase.request.ID).
+					Return(testCase.selectLoglineRevisionData.resp, testCase.selectLoglineRevisionData.err)
+			}
+
+			if testCase.selectLoglineData != nil {
+				source.EXPECT().
+					SelectLogline(mock.Anything, dao.SelectLoglineData{
+						ID:     revision.LoglineID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectLoglineData.resp, testCase.selectLoglineData.err)
+			}
+
+			if testCase.updateLoglineData != nil {
+				source.EXPECT().
+					UpdateLogline(mock.Anything, mock.MatchedBy(func(data dao.UpdateLoglineData) bool {
+						return assert.Equal(t, logline.ID, data.ID) &&
+							assert.Equal(t, testCase.request.UserID, data.UserID) &&
+							assert.Equal(t, logline.Slug, data.Slug) &&
+							assert.Equal(t, revision.Name, data.Name) &&
+							assert.Equal(t, revision.Content, data.Content) &&
+							assert.Equal(t, revision.Lang, data.Lang) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(testCase.updateLoglineData.resp, testCase.updateLoglineData.err)
+			}
+
+			service := services.NewRestoreLoglineRevisionService(source)
+
+			resp, err := service.RestoreLoglineRevision(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestRestoreLogline(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type restoreLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	request := services.RestoreLoglineRequest{
+		ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.RestoreLoglineRequest
+
+		restoreLoglineData *restoreLoglineData
+
+		expect    *models.Logline
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			restoreLoglineData: &restoreLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Content:   "Lorem ipsum dolor sit amet",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeletedAt: time.Time{},
+				},
+			},
+
+			expect: &models.Logline{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:      "test-slug",
+				Name:      "Test Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				DeletedAt: time.Time{},
+			},
+		},
+		{
+			name: "NotFound",
+
+			request: request,
+
+			restoreLoglineData: &restoreLoglineData{err: dao.ErrLoglineNotFound},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+		{
+			name: "Error",
+
+			request: request,
+
+			restoreLoglineData: &restoreLoglineData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockRestoreLoglineSource(t)
+
+			if testCase.restoreLoglineData != nil {
+				source.EXPECT().
+					RestoreLogline(mock.Anything, dao.RestoreLoglineData{
+						ID:     testCase.request.ID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.restoreLoglineData.resp, testCase.restoreLoglineData.err)
+			}
+
+			service := services.NewRestoreLoglineService(source)
+
+			resp, err := service.RestoreLogline(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
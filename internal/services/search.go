@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type SearchSource interface {
+	Search(ctx context.Context, data dao.SearchData) ([]*dao.SearchHitEntity, error)
+}
+
+type SearchRequest struct {
+	UserID uuid.UUID
+	Query  string
+	Lang   models.Lang
+	Limit  int
+	Offset int
+}
+
+type SearchService struct {
+	source SearchSource
+}
+
+func NewSearchService(source SearchSource) *SearchService {
+	return &SearchService{source: source}
+}
+
+func (service *SearchService) Search(ctx context.Context, request SearchRequest) ([]*models.SearchHit, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.Search")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.query", request.Query),
+		attribute.String("request.lang", request.Lang.String()),
+		attribute.Int("request.limit", request.Limit),
+		attribute.Int("request.offset", request.Offset),
+	)
+
+	resp, err := service.source.Search(ctx, dao.SearchData{
+		UserID: request.UserID,
+		Query:  request.Query,
+		Lang:   request.Lang,
+		Limit:  request.Limit,
+		Offset: request.Offset,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("search: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("dao.search.count", len(resp)))
+
+	output := lo.Map(resp, func(item *dao.SearchHitEntity, _ int) *models.SearchHit {
+		return &models.SearchHit{
+			Kind:      item.Kind,
+			ID:        item.ID,
+			LoglineID: item.LoglineID,
+			Slug:      item.Slug,
+			Name:      item.Name,
+			Lang:      item.Lang,
+			Rank:      item.Rank,
+			Snippet:   item.Snippet,
+			CreatedAt: item.CreatedAt,
+		}
+	})
+
+	return otel.ReportSuccess(span, output), nil
+}
@@ -0,0 +1,143 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type searchData struct {
+		resp []*dao.SearchHitEntity
+		err  error
+	}
+
+	request := services.SearchRequest{
+		UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Query:  "lighthouse",
+		Lang:   models.LangEN,
+		Limit:  10,
+		Offset: 20,
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.SearchRequest
+
+		searchData *searchData
+
+		expect    []*models.SearchHit
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			searchData: &searchData{
+				resp: []*dao.SearchHitEntity{
+					{
+						Kind:      models.SearchHitKindLogline,
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Slug:      "test-slug",
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						Rank:      0.6,
+						Snippet:   "A keeper of the <mark>lighthouse</mark>",
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						Kind:      models.SearchHitKindBeatsSheet,
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Slug:      "test-slug",
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						Rank:      0.1,
+						Snippet:   "The <mark>lighthouse</mark> goes dark",
+						CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: []*models.SearchHit{
+				{
+					Kind:      models.SearchHitKindLogline,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Lang:      models.LangEN,
+					Rank:      0.6,
+					Snippet:   "A keeper of the <mark>lighthouse</mark>",
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Kind:      models.SearchHitKindBeatsSheet,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name",
+					Lang:      models.LangEN,
+					Rank:      0.1,
+					Snippet:   "The <mark>lighthouse</mark> goes dark",
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Error",
+
+			request: request,
+
+			searchData: &searchData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockSearchSource(t)
+
+			if testCase.searchData != nil {
+				source.EXPECT().
+					Search(mock.Anything, dao.SearchData{
+						UserID: testCase.request.UserID,
+						Query:  testCase.request.Query,
+						Lang:   testCase.request.Lang,
+						Limit:  testCase.request.Limit,
+						Offset: testCase.request.Offset,
+					}).
+					Return(testCase.searchData.resp, testCase.searchData.err)
+			}
+
+			service := services.NewSearchService(source)
+
+			resp, err := service.Search(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type SeedStoryPlansSource interface {
+	InsertStoryPlan(ctx context.Context, data dao.InsertStoryPlanData) (*dao.StoryPlanEntity, error)
+	SelectStoryPlanBySlug(ctx context.Context, data dao.SelectStoryPlanBySlugData) (*dao.StoryPlanEntity, error)
+	UpdateStoryPlan(ctx context.Context, data dao.UpdateStoryPlanData) (*dao.StoryPlanEntity, error)
+	UpdateStoryPlanSeedHash(ctx context.Context, data dao.UpdateStoryPlanSeedHashData) (*dao.StoryPlanEntity, error)
+}
+
+func NewSeedStoryPlansServiceSource(
+	insertStoryPlanDAO *dao.InsertStoryPlanRepository,
+	selectStoryPlanBySlugDAO *dao.SelectStoryPlanBySlugRepository,
+	updateStoryPlanDAO *dao.UpdateStoryPlanRepository,
+	updateStoryPlanSeedHashDAO *dao.UpdateStoryPlanSeedHashRepository,
+) SeedStoryPlansSource {
+	return &struct {
+		*dao.InsertStoryPlanRepository
+		*dao.SelectStoryPlanBySlugRepository
+		*dao.UpdateStoryPlanRepository
+		*dao.UpdateStoryPlanSeedHashRepository
+	}{
+		InsertStoryPlanRepository:         insertStoryPlanDAO,
+		SelectStoryPlanBySlugRepository:   selectStoryPlanBySlugDAO,
+		UpdateStoryPlanRepository:         updateStoryPlanDAO,
+		UpdateStoryPlanSeedHashRepository: updateStoryPlanSeedHashDAO,
+	}
+}
+
+// SeedStoryPlansRequest lists the built-in plans that must be available in the database. Plans are matched by
+// slug and language. A plan is only saved as a new version when it changed since it was last seeded, so edits made
+// by admins through the API stay in use until the plan from the request itself changes. Previous versions are
+// kept, and beats sheets built against them remain valid. Plans are linted first, and plans sharing a slug must be
+// translations of one another.
+type SeedStoryPlansRequest struct {
+	Plans []*storyplanmodel.Plan
+}
+
+type SeedStoryPlansService struct {
+	source SeedStoryPlansSource
+}
+
+func NewSeedStoryPlansService(source SeedStoryPlansSource) *SeedStoryPlansService {
+	return &SeedStoryPlansService{source: source}
+}
+
+// SeedStoryPlans inserts the missing or outdated plans from the request, and returns the newly created versions.
+func (service *SeedStoryPlansService) SeedStoryPlans(
+	ctx context.Context, request SeedStoryPlansRequest,
+) ([]*storyplanmodel.Plan, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.SeedStoryPlans")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("request.plans.count", len(request.Plans)))
+
+	// Refuse to start with broken plans, rather than discovering them when generating a beats sheet.
+	err := storyplanmodel.LintPlans(request.Plans)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("lint plans: %w", err))
+	}
+
+	output := make([]*storyplanmodel.Plan, 0, len(request.Plans))
+
+	for _, plan := range request.Plans {
+		seedHash, err := storyPlanSeedHash(plan.Metadata.Name, plan.Acts, plan.Beats)
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf(
+				"hash story plan %s (%s): %w", plan.Metadata.Slug, plan.Metadata.Lang, err,
+			))
+		}
+
+		current, err := service.source.SelectStoryPlanBySlug(ctx, dao.SelectStoryPlanBySlugData{
+			Slug: plan.Metadata.Slug,
+			Lang: plan.Metadata.Lang,
+		})
+		if err == nil {
+			unchanged, err := service.unchangedSinceSeed(ctx, current, seedHash)
+			if err != nil {
+				return nil, otel.ReportError(span, fmt.Errorf(
+					"check story plan %s (%s): %w", plan.Metadata.Slug, plan.Metadata.Lang, err,
+				))
+			}
+
+			if unchanged {
+				continue
+			}
+
+			resp, err := service.source.UpdateStoryPlan(ctx, dao.UpdateStoryPlanData{
+				ID:       current.ID,
+				NewID:    uuid.New(),
+				Name:     plan.Metadata.Name,
+				Acts:     plan.Acts,
+				Beats:    plan.Beats,
+				SeedHash: seedHash,
+				Now:      time.Now(),
+			})
+			if err != nil {
+				return nil, otel.ReportError(span, fmt.Errorf(
+					"update story plan %s (%s): %w", plan.Metadata.Slug, plan.Metadata.Lang, err,
+				))
+			}
+
+			output = append(output, storyPlanEntityToModel(resp))
+
+			continue
+		}
+
+		if !errors.Is(err, dao.ErrStoryPlanNotFound) {
+			return nil, otel.ReportError(span, fmt.Errorf(
+				"select story plan %s (%s): %w", plan.Metadata.Slug, plan.Metadata.Lang, err,
+			))
+		}
+
+		resp, err := service.source.InsertStoryPlan(ctx, dao.InsertStoryPlanData{
+			ID:       uuid.New(),
+			Slug:     plan.Metadata.Slug,
+			Name:     plan.Metadata.Name,
+			Lang:     plan.Metadata.Lang,
+			Acts:     plan.Acts,
+			Beats:    plan.Beats,
+			SeedHash: seedHash,
+			Now:      time.Now(),
+		})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf(
+				"insert story plan %s (%s): %w", plan.Metadata.Slug, plan.Metadata.Lang, err,
+			))
+		}
+
+		output = append(output, storyPlanEntityToModel(resp))
+	}
+
+	span.SetAttributes(attribute.Int("seeded.count", len(output)))
+
+	return otel.ReportSuccess(span, output), nil
+}
+
+// unchangedSinceSeed reports whether the embedded plan is the one the stored plan was last seeded from. Versions
+// stored before seed hashes were recorded are adopted when their content matches the embedded plan; otherwise, the
+// embedded plan is seeded once more.
+func (service *SeedStoryPlansService) unchangedSinceSeed(
+	ctx context.Context, current *dao.StoryPlanEntity, seedHash string,
+) (bool, error) {
+	if current.SeedHash != "" {
+		return current.SeedHash == seedHash, nil
+	}
+
+	storedHash, err := storyPlanSeedHash(current.Name, current.Acts, current.Beats)
+	if err != nil {
+		return false, fmt.Errorf("hash stored plan: %w", err)
+	}
+
+	if storedHash != seedHash {
+		return false, nil
+	}
+
+	_, err = service.source.UpdateStoryPlanSeedHash(ctx, dao.UpdateStoryPlanSeedHashData{
+		ID:       current.ID,
+		SeedHash: seedHash,
+	})
+	if err != nil {
+		return false, fmt.Errorf("update seed hash: %w", err)
+	}
+
+	return true, nil
+}
+
+// storyPlanSeedHash identifies the content of a plan. Acts and beats are hashed through their JSON representation,
+// which is how they are stored.
+func storyPlanSeedHash(name string, acts []storyplanmodel.Act, beats []storyplanmodel.Beat) (string, error) {
+	content, err := json.Marshal(struct {
+		Name  string                `json:"name"`
+		Acts  []storyplanmodel.Act  `json:"acts,omitempty"`
+		Beats []storyplanmodel.Beat `json:"beats"`
+	}{Name: name, Acts: acts, Beats: beats})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:]), nil
+}
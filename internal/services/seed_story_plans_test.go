@@ -0,0 +1,599 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestSeedStoryPlans(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	planEN := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			Slug: "test-slug",
+			Name: "Test Name",
+			Lang: models.LangEN,
+		},
+		Beats: []storyplanmodel.Beat{
+			{
+				Name:      "Test Beat",
+				Key:       "test-beat",
+				KeyPoints: []string{"Test Key Point"},
+				Purpose:   "Test Purpose",
+			},
+		},
+	}
+
+	planFR := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			Slug: "test-slug",
+			Name: "Nom de Test",
+			Lang: models.LangFR,
+		},
+		Beats: []storyplanmodel.Beat{
+			{
+				Name:      "Battement de Test",
+				Key:       "test-beat",
+				KeyPoints: []string{"Point Clé de Test"},
+				Purpose:   "Objectif de Test",
+			},
+		},
+	}
+
+	type selectStoryPlanBySlugData struct {
+		resp *dao.StoryPlanEntity
+		err  error
+	}
+
+	type insertStoryPlanData struct {
+		resp *dao.StoryPlanEntity
+		err  error
+	}
+
+	type updateStoryPlanData struct {
+		resp *dao.StoryPlanEntity
+		err  error
+	}
+
+	type updateStoryPlanSeedHashData struct {
+		resp *dao.StoryPlanEntity
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.SeedStoryPlansRequest
+
+		// Indexed on the plans from the request.
+		selectStoryPlanBySlugData []*selectStoryPlanBySlugData
+		insertStoryPlanData       []*insertStoryPlanData
+		updateStoryPlanData       []*updateStoryPlanData
+		// Seed hashes are stamped on versions stored before they were recorded.
+		updateStoryPlanSeedHashData []*updateStoryPlanSeedHashData
+
+		expect    []*storyplanmodel.Plan
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.SeedStoryPlansRequest{
+				Plans: []*storyplanmodel.Plan{planEN, planFR},
+			},
+
+			selectStoryPlanBySlugData: []*selectStoryPlanBySlugData{
+				{
+					resp: &dao.StoryPlanEntity{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Slug:      "test-slug",
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						Beats:     planEN.Beats,
+						CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+				{
+					err: dao.ErrStoryPlanNotFound,
+				},
+			},
+			updateStoryPlanSeedHashData: []*updateStoryPlanSeedHashData{
+				{
+					resp: &dao.StoryPlanEntity{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Slug:      "test-slug",
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						Beats:     planEN.Beats,
+						SeedHash:  "test-hash",
+						CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			insertStoryPlanData: []*insertStoryPlanData{
+				nil,
+				{
+					resp: &dao.StoryPlanEntity{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Slug:      "test-slug",
+						Name:      "Nom de Test",
+						Lang:      models.LangFR,
+						Beats:     planFR.Beats,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: []*storyplanmodel.Plan{
+				{
+					Metadata: storyplanmodel.Metadata{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Slug:      "test-slug",
+						Name:      "Nom de Test",
+						Lang:      models.LangFR,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					Beats: planFR.Beats,
+				},
+			},
+		},
+		{
+			name: "Outdated",
+
+			request: services.SeedStoryPlansRequest{
+				Plans: []*storyplanmodel.Plan{planEN},
+			},
+
+			selectStoryPlanBySlugData: []*selectStoryPlanBySlugData{
+				{
+					resp: &dao.StoryPlanEntity{
+						ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Slug:    "test-slug",
+						Version: 1,
+						Name:    "Test Name",
+						Lang:    models.LangEN,
+						Beats: []storyplanmodel.Beat{
+							{
+								Name:      "Old Beat",
+								Key:       "test-beat",
+								KeyPoints: []string{"Test Key Point"},
+								Purpose:   "Test Purpose",
+							},
+						},
+						CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			updateStoryPlanData: []*updateStoryPlanData{
+				{
+					resp: &dao.StoryPlanEntity{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Slug:      "test-slug",
+						Version:   2,
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						Beats:     planEN.Beats,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: []*storyplanmodel.Plan{
+				{
+					Metadata: storyplanmodel.Metadata{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Slug:      "test-slug",
+						Version:   2,
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					Beats: planEN.Beats,
+				},
+			},
+		},
+		{
+			name: "Outdated/SeedHash",
+
+			request: services.SeedStoryPlansRequest{
+				Plans: []*storyplanmodel.Plan{planEN},
+			},
+
+			selectStoryPlanBySlugData: []*selectStoryPlanBySlugData{
+				{
+					resp: &dao.StoryPlanEntity{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Slug:      "test-slug",
+						Version:   1,
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						Beats:     planEN.Beats,
+						SeedHash:  "previous-hash",
+						CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			updateStoryPlanData: []*updateStoryPlanData{
+				{
+					resp: &dao.StoryPlanEntity{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Slug:      "test-slug",
+						Version:   2,
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						Beats:     planEN.Beats,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: []*storyplanmodel.Plan{
+				{
+					Metadata: storyplanmodel.Metadata{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Slug:      "test-slug",
+						Version:   2,
+						Name:      "Test Name",
+						Lang:      models.LangEN,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					Beats: planEN.Beats,
+				},
+			},
+		},
+		{
+			name: "TranslationMismatch",
+
+			request: services.SeedStoryPlansRequest{
+				Plans: []*storyplanmodel.Plan{
+					planEN,
+					{
+						Metadata: planFR.Metadata,
+						Beats: []storyplanmodel.Beat{
+							{
+								Name:      "Battement de Test",
+								Key:       "other-beat",
+								KeyPoints: []string{"Point Clé de Test"},
+								Purpose:   "Objectif de Test",
+							},
+						},
+					},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrTranslationMismatch,
+		},
+		{
+			name: "InvalidPlan",
+
+			request: services.SeedStoryPlansRequest{
+				Plans: []*storyplanmodel.Plan{{Metadata: planEN.Metadata}},
+			},
+
+			expectErr: storyplanmodel.ErrMissingBeat,
+		},
+		{
+			name: "SelectError",
+
+			request: services.SeedStoryPlansRequest{
+				Plans: []*storyplanmodel.Plan{planEN, planFR},
+			},
+
+			selectStoryPlanBySlugData: []*selectStoryPlanBySlugData{
+				{
+					err: errFoo,
+				},
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "UpdateError",
+
+			request: services.SeedStoryPlansRequest{
+				Plans: []*storyplanmodel.Plan{planEN},
+			},
+
+			selectStoryPlanBySlugData: []*selectStoryPlanBySlugData{
+				{
+					resp: &dao.StoryPlanEntity{
+						ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Slug: "test-slug",
+						Name: "Old Name",
+						Lang: models.LangEN,
+					},
+				},
+			},
+			updateStoryPlanData: []*updateStoryPlanData{
+				{
+					err: errFoo,
+				},
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "UpdateSeedHashError",
+
+			request: services.SeedStoryPlansRequest{
+				Plans: []*storyplanmodel.Plan{planEN},
+			},
+
+			selectStoryPlanBySlugData: []*selectStoryPlanBySlugData{
+				{
+					resp: &dao.StoryPlanEntity{
+						ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Slug:  "test-slug",
+						Name:  "Test Name",
+						Lang:  models.LangEN,
+						Beats: planEN.Beats,
+					},
+				},
+			},
+			updateStoryPlanSeedHashData: []*updateStoryPlanSeedHashData{
+				{
+					err: errFoo,
+				},
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "InsertError",
+
+			request: services.SeedStoryPlansRequest{
+				Plans: []*storyplanmodel.Plan{planEN, planFR},
+			},
+
+			selectStoryPlanBySlugData: []*selectStoryPlanBySlugData{
+				{
+					err: dao.ErrStoryPlanNotFound,
+				},
+			},
+			insertStoryPlanData: []*insertStoryPlanData{
+				{
+					err: errFoo,
+				},
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockSeedStoryPlansSource(t)
+
+			for i, selectData := range testCase.selectStoryPlanBySlugData {
+				plan := testCase.request.Plans[i]
+
+				source.EXPECT().
+					SelectStoryPlanBySlug(mock.Anything, dao.SelectStoryPlanBySlugData{
+						Slug: plan.Metadata.Slug,
+						Lang: plan.Metadata.Lang,
+					}).
+					Return(selectData.resp, selectData.err)
+			}
+
+			for i, insertData := range testCase.insertStoryPlanData {
+				if insertData == nil {
+					continue
+				}
+
+				plan := testCase.request.Plans[i]
+
+				source.EXPECT().
+					InsertStoryPlan(mock.Anything, mock.MatchedBy(func(data dao.InsertStoryPlanData) bool {
+						return data.Lang == plan.Metadata.Lang &&
+							assert.NotEqual(t, data.ID, uuid.Nil) &&
+							assert.Equal(t, plan.Metadata.Slug, data.Slug) &&
+							assert.Equal(t, plan.Metadata.Name, data.Name) &&
+							assert.Equal(t, plan.Beats, data.Beats) &&
+							assert.NotEmpty(t, data.SeedHash) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(insertData.resp, insertData.err)
+			}
+
+			for i, updateData := range testCase.updateStoryPlanData {
+				if updateData == nil {
+					continue
+				}
+
+				plan := testCase.request.Plans[i]
+
+				source.EXPECT().
+					UpdateStoryPlan(mock.Anything, mock.MatchedBy(func(data dao.UpdateStoryPlanData) bool {
+						return assert.Equal(t, testCase.selectStoryPlanBySlugData[i].resp.ID, data.ID) &&
+							assert.NotEqual(t, uuid.Nil, data.NewID) &&
+							assert.Nil(t, data.UserID) &&
+							assert.Equal(t, plan.Metadata.Name, data.Name) &&
+							assert.Equal(t, plan.Acts, data.Acts) &&
+							assert.Equal(t, plan.Beats, data.Beats) &&
+							assert.NotEmpty(t, data.SeedHash) &&
+							assert.NotEqual(t, testCase.selectStoryPlanBySlugData[i].resp.SeedHash, data.SeedHash) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(updateData.resp, updateData.err)
+			}
+
+			for i, seedHashData := range testCase.updateStoryPlanSeedHashData {
+				if seedHashData == nil {
+					continue
+				}
+
+				source.EXPECT().
+					UpdateStoryPlanSeedHash(mock.Anything, mock.MatchedBy(func(data dao.UpdateStoryPlanSeedHashData) bool {
+						return assert.Equal(t, testCase.selectStoryPlanBySlugData[i].resp.ID, data.ID) &&
+							assert.NotEmpty(t, data.SeedHash)
+					})).
+					Return(seedHashData.resp, seedHashData.err)
+			}
+
+			service := services.NewSeedStoryPlansService(source)
+
+			resp, err := service.SeedStoryPlans(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
+
+// Built-in plans are only saved as new versions when the embedded plans change. Edits made through the API on the
+// latest version are kept until then.
+func TestSeedStoryPlansTwice(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	plan := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			Slug: "test-slug",
+			Name: "Test Name",
+			Lang: models.LangEN,
+		},
+		Beats: []storyplanmodel.Beat{
+			{
+				Name:      "Test Beat",
+				Key:       "test-beat",
+				KeyPoints: []string{"Test Key Point"},
+				Purpose:   "Test Purpose",
+			},
+		},
+	}
+
+	// The embedded plan now groups its beats into acts.
+	changedPlan := &storyplanmodel.Plan{
+		Metadata: plan.Metadata,
+		Acts:     []storyplanmodel.Act{{Key: "act-1", Name: "Act 1", Purpose: "Test Purpose"}},
+		Beats: []storyplanmodel.Beat{
+			{
+				Name:      "Test Beat",
+				Key:       "test-beat",
+				KeyPoints: []string{"Test Key Point"},
+				Purpose:   "Test Purpose",
+				Act:       "act-1",
+			},
+		},
+	}
+
+	source := servicesmocks.NewMockSeedStoryPlansSource(t)
+	service := services.NewSeedStoryPlansService(source)
+
+	selectData := dao.SelectStoryPlanBySlugData{Slug: plan.Metadata.Slug, Lang: plan.Metadata.Lang}
+
+	var seedHash string
+
+	// First run: the plan is missing, and is inserted.
+	source.EXPECT().SelectStoryPlanBySlug(mock.Anything, selectData).Return(nil, dao.ErrStoryPlanNotFound).Once()
+	source.EXPECT().
+		InsertStoryPlan(mock.Anything, mock.MatchedBy(func(data dao.InsertStoryPlanData) bool {
+			seedHash = data.SeedHash
+
+			return assert.NotEmpty(t, data.SeedHash)
+		})).
+		Return(&dao.StoryPlanEntity{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Slug:      "test-slug",
+			Version:   1,
+			Name:      "Test Name",
+			Lang:      models.LangEN,
+			Beats:     plan.Beats,
+			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		}, nil).
+		Once()
+
+	resp, err := service.SeedStoryPlans(ctx, services.SeedStoryPlansRequest{Plans: []*storyplanmodel.Plan{plan}})
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+
+	// Second run: an admin fixed a typo through the API. The new version carries the seed hash over, and is kept.
+	edited := &dao.StoryPlanEntity{
+		ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Slug:    "test-slug",
+		Version: 2,
+		Name:    "Test Name",
+		Lang:    models.LangEN,
+		Beats: []storyplanmodel.Beat{
+			{
+				Name:      "Test Beat",
+				Key:       "test-beat",
+				KeyPoints: []string{"Test key point"},
+				Purpose:   "Test Purpose",
+			},
+		},
+		SeedHash:  seedHash,
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	source.EXPECT().SelectStoryPlanBySlug(mock.Anything, selectData).Return(edited, nil).Once()
+
+	resp, err = service.SeedStoryPlans(ctx, services.SeedStoryPlansRequest{Plans: []*storyplanmodel.Plan{plan}})
+	require.NoError(t, err)
+	require.Empty(t, resp)
+
+	// Third run: the embedded plan changed, and is saved as a new version of the edited one.
+	updated := &dao.StoryPlanEntity{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		Slug:      "test-slug",
+		Version:   3,
+		Name:      "Test Name",
+		Lang:      models.LangEN,
+		Acts:      changedPlan.Acts,
+		Beats:     changedPlan.Beats,
+		CreatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	source.EXPECT().SelectStoryPlanBySlug(mock.Anything, selectData).Return(edited, nil).Once()
+	source.EXPECT().
+		UpdateStoryPlan(mock.Anything, mock.MatchedBy(func(data dao.UpdateStoryPlanData) bool {
+			updated.SeedHash = data.SeedHash
+
+			return assert.Equal(t, edited.ID, data.ID) &&
+				assert.Equal(t, changedPlan.Acts, data.Acts) &&
+				assert.Equal(t, changedPlan.Beats, data.Beats) &&
+				assert.NotEmpty(t, data.SeedHash) &&
+				assert.NotEqual(t, seedHash, data.SeedHash)
+		})).
+		Return(updated, nil).
+		Once()
+
+	resp, err = service.SeedStoryPlans(
+		ctx, services.SeedStoryPlansRequest{Plans: []*storyplanmodel.Plan{changedPlan}},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	require.Equal(t, 3, resp[0].Metadata.Version)
+	require.Equal(t, changedPlan.Acts, resp[0].Acts)
+
+	// Fourth run: the latest version is up to date.
+	source.EXPECT().SelectStoryPlanBySlug(mock.Anything, selectData).Return(updated, nil).Once()
+
+	resp, err = service.SeedStoryPlans(
+		ctx, services.SeedStoryPlansRequest{Plans: []*storyplanmodel.Plan{changedPlan}},
+	)
+	require.NoError(t, err)
+	require.Empty(t, resp)
+
+	source.AssertExpectations(t)
+}
@@ -5,29 +5,35 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel/golib/otel"
 
 	"github.com/a-novel/service-story-schematics/internal/dao"
 	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 type SelectBeatsSheetSource interface {
 	SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)
 	SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)
+	SelectStoryPlan(ctx context.Context, request SelectStoryPlanRequest) (*storyplanmodel.Plan, error)
 }
 
 func NewSelectBeatsSheetServiceSource(
 	selectBeatsSheetDAO *dao.SelectBeatsSheetRepository,
 	selectLoglineDAO *dao.SelectLoglineRepository,
+	selectStoryPlan *SelectStoryPlanService,
 ) SelectBeatsSheetSource {
 	return &struct {
 		*dao.SelectBeatsSheetRepository
 		*dao.SelectLoglineRepository
+		*SelectStoryPlanService
 	}{
 		SelectBeatsSheetRepository: selectBeatsSheetDAO,
 		SelectLoglineRepository:    selectLoglineDAO,
+		SelectStoryPlanService:     selectStoryPlan,
 	}
 }
 
@@ -69,11 +75,26 @@ func (service *SelectBeatsSheetService) SelectBeatsSheet(
 		return nil, otel.ReportError(span, fmt.Errorf("check logline: %w", err))
 	}
 
+	// Retrieve the plan the sheet follows, to group its beats into acts. Older sheets have no plan attached, and use
+	// the default one.
+	storyPlan, err := service.source.SelectStoryPlan(ctx, SelectStoryPlanRequest{
+		ID:     lo.Ternary(data.StoryPlanID != uuid.Nil, &data.StoryPlanID, nil),
+		Lang:   data.Lang,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get story plan: %w", err))
+	}
+
 	return otel.ReportSuccess(span, &models.BeatsSheet{
-		ID:        data.ID,
-		LoglineID: data.LoglineID,
-		Content:   data.Content,
-		Lang:      data.Lang,
-		CreatedAt: data.CreatedAt,
+		ID:          data.ID,
+		LoglineID:   data.LoglineID,
+		StoryPlanID: data.StoryPlanID,
+		SourceID:    data.SourceID,
+		ParentID:    data.ParentID,
+		Content:     data.Content,
+		Lang:        data.Lang,
+		Acts:        storyPlan.GroupBeats(data.Content),
+		CreatedAt:   data.CreatedAt,
 	}), nil
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
 	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 func TestSelectBeatsSheet(t *testing.T) {
@@ -30,6 +32,26 @@ func TestSelectBeatsSheet(t *testing.T) {
 		err  error
 	}
 
+	type selectStoryPlanData struct {
+		resp *storyplanmodel.Plan
+		err  error
+	}
+
+	storyPlan := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			ID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+			Lang: models.LangEN,
+		},
+		Acts: []storyplanmodel.Act{
+			{Key: "act-1", Name: "Act 1"},
+			{Key: "act-2", Name: "Act 2"},
+		},
+		Beats: []storyplanmodel.Beat{
+			{Key: "test-beat", Act: "act-1"},
+			{Key: "test-beat-2", Act: "act-2"},
+		},
+	}
+
 	testCases := []struct {
 		name string
 
@@ -37,6 +59,7 @@ func TestSelectBeatsSheet(t *testing.T) {
 
 		selectBeatsSheetData *selectBeatsSheetData
 		selectLoglineData    *selectLoglineData
+		selectStoryPlanData  *selectStoryPlanData
 
 		expect    *models.BeatsSheet
 		expectErr error
@@ -51,8 +74,9 @@ func TestSelectBeatsSheet(t *testing.T) {
 
 			selectBeatsSheetData: &selectBeatsSheetData{
 				resp: &dao.BeatsSheetEntity{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
 					Content: []models.Beat{
 						{
 							Key:     "test-beat",
@@ -82,9 +106,14 @@ func TestSelectBeatsSheet(t *testing.T) {
 				},
 			},
 
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: storyPlan,
+			},
+
 			expect: &models.BeatsSheet{
-				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-				LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				StoryPlanID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
 				Content: []models.Beat{
 					{
 						Key:     "test-beat",
@@ -97,7 +126,11 @@ func TestSelectBeatsSheet(t *testing.T) {
 						Content: "Test Beat Content 2",
 					},
 				},
-				Lang:      models.LangEN,
+				Lang: models.LangEN,
+				Acts: []models.BeatsSheetAct{
+					{Key: "act-1", Name: "Act 1", Beats: []string{"test-beat"}},
+					{Key: "act-2", Name: "Act 2", Beats: []string{"test-beat-2"}},
+				},
 				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
 		},
@@ -148,6 +181,43 @@ func TestSelectBeatsSheet(t *testing.T) {
 				err: errFoo,
 			},
 
+			expectErr: errFoo,
+		},
+		{
+			name: "SelectStoryPlanError",
+
+			request: services.SelectBeatsSheetRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:       uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					LoglineID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{
+							Key:     "test-beat",
+							Title:   "Test Beat",
+							Content: "Test Beat Content",
+						},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:     uuid.MustParse("00000000-1000-0000-0000-000000000001"),
+					UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				err: errFoo,
+			},
+
 			expectErr: errFoo,
 		},
 	}
@@ -175,6 +245,18 @@ func TestSelectBeatsSheet(t *testing.T) {
 					Return(testCase.selectBeatsSheetData.resp, testCase.selectBeatsSheetData.err)
 			}
 
+			if testCase.selectStoryPlanData != nil {
+				storyPlanID := testCase.selectBeatsSheetData.resp.StoryPlanID
+
+				source.EXPECT().
+					SelectStoryPlan(mock.Anything, services.SelectStoryPlanRequest{
+						ID:     lo.Ternary(storyPlanID != uuid.Nil, &storyPlanID, nil),
+						Lang:   testCase.selectBeatsSheetData.resp.Lang,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectStoryPlanData.resp, testCase.selectStoryPlanData.err)
+			}
+
 			service := services.NewSelectBeatsSheetService(source)
 
 			resp, err := service.SelectBeatsSheet(ctx, testCase.request)
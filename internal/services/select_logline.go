@@ -71,10 +71,15 @@ func (service *SelectLoglineService) SelectLogline(
 			ID:        data.ID,
 			UserID:    data.UserID,
 			Slug:      data.Slug,
+			SourceID:  data.SourceID,
+			ProjectID: data.ProjectID,
 			Name:      data.Name,
 			Content:   data.Content,
 			Lang:      data.Lang,
+			Genre:     data.Genre,
+			Tags:      data.Tags,
 			CreatedAt: data.CreatedAt,
+			UpdatedAt: data.UpdatedAt,
 		}), nil
 	}
 
@@ -90,9 +95,14 @@ func (service *SelectLoglineService) SelectLogline(
 		ID:        data.ID,
 		UserID:    data.UserID,
 		Slug:      data.Slug,
+		SourceID:  data.SourceID,
+		ProjectID: data.ProjectID,
 		Name:      data.Name,
 		Content:   data.Content,
 		Lang:      data.Lang,
+		Genre:     data.Genre,
+		Tags:      data.Tags,
 		CreatedAt: data.CreatedAt,
+		UpdatedAt: data.UpdatedAt,
 	}), nil
 }
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type SelectLoglineRevisionSource interface {
+	SelectLoglineRevision(ctx context.Context, data uuid.UUID) (*dao.LoglineRevisionEntity, error)
+	SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)
+}
+
+func NewSelectLoglineRevisionServiceSource(
+	selectLoglineRevisionDAO *dao.SelectLoglineRevisionRepository,
+	selectLoglineDAO *dao.SelectLoglineRepository,
+) SelectLoglineRevisionSource {
+	return &struct {
+		*dao.SelectLoglineRevisionRepository
+		*dao.SelectLoglineRepository
+	}{
+		SelectLoglineRevisionRepository: selectLoglineRevisionDAO,
+		SelectLoglineRepository:         selectLoglineDAO,
+	}
+}
+
+type SelectLoglineRevisionRequest struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+type SelectLoglineRevisionService struct {
+	source SelectLoglineRevisionSource
+}
+
+func NewSelectLoglineRevisionService(source SelectLoglineRevisionSource) *SelectLoglineRevisionService {
+	return &SelectLoglineRevisionService{source: source}
+}
+
+func (service *SelectLoglineRevisionService) SelectLoglineRevision(
+	ctx context.Context, request SelectLoglineRevisionRequest,
+) (*models.LoglineRevision, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.SelectLoglineRevision")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.id", request.ID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+	)
+
+	data, err := service.source.SelectLoglineRevision(ctx, request.ID)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select logline revision: %w", err))
+	}
+
+	// Make sure the revision belongs to a logline of the user.
+	_, err = service.source.SelectLogline(ctx, dao.SelectLoglineData{
+		ID:     data.LoglineID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check logline: %w", err))
+	}
+
+	return otel.ReportSuccess(span, loglineRevisionEntityToModel(data)), nil
+}
+
+func loglineRevisionEntityToModel(entity *dao.LoglineRevisionEntity) *models.LoglineRevision {
+	return &models.LoglineRevision{
+		ID:        entity.ID,
+		LoglineID: entity.LoglineID,
+		Name:      entity.Name,
+		Content:   entity.Content,
+		Lang:      entity.Lang,
+		CreatedAt: entity.CreatedAt,
+	}
+}
@@ -0,0 +1,152 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestSelectLoglineRevision(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectLoglineRevisionData struct {
+		resp *dao.LoglineRevisionEntity
+		err  error
+	}
+
+	type selectLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	revision := &dao.LoglineRevisionEntity{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		Name:      "Test Name",
+		Content:   "Lorem ipsum dolor sit amet",
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.SelectLoglineRevisionRequest
+
+		selectLoglineRevisionData *selectLoglineRevisionData
+		selectLoglineData         *selectLoglineData
+
+		expect    *models.LoglineRevision
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.SelectLoglineRevisionRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectLoglineRevisionData: &selectLoglineRevisionData{
+				resp: revision,
+			},
+
+			selectLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Slug:      "test-slug",
+					Name:      "Test Name 2",
+					Content:   "Lorem ipsum dolor sit amet 2",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.LoglineRevision{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				LoglineID: uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+				Name:      "Test Name",
+				Content:   "Lorem ipsum dolor sit amet",
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "SelectRevisionError",
+
+			request: services.SelectLoglineRevisionRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectLoglineRevisionData: &selectLoglineRevisionData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "CheckLoglineError",
+
+			request: services.SelectLoglineRevisionRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectLoglineRevisionData: &selectLoglineRevisionData{
+				resp: revision,
+			},
+
+			selectLoglineData: &selectLoglineData{
+				err: dao.ErrLoglineNotFound,
+			},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockSelectLoglineRevisionSource(t)
+
+			if testCase.selectLoglineRevisionData != nil {
+				source.EXPECT().
+					SelectLoglineRevision(mock.Anything, testCase.request.ID).
+					Return(testCase.selectLoglineRevisionData.resp, testCase.selectLoglineRevisionData.err)
+			}
+
+			if testCase.selectLoglineData != nil {
+				source.EXPECT().
+					SelectLogline(mock.Anything, dao.SelectLoglineData{
+						ID:     testCase.selectLoglineRevisionData.resp.LoglineID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectLoglineData.resp, testCase.selectLoglineData.err)
+			}
+
+			service := services.NewSelectLoglineRevisionService(source)
+
+			resp, err := service.SelectLoglineRevision(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type SelectProjectSource interface {
+	SelectProject(ctx context.Context, data dao.SelectProjectData) (*dao.ProjectEntity, error)
+}
+
+type SelectProjectRequest struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+type SelectProjectService struct {
+	source SelectProjectSource
+}
+
+func NewSelectProjectService(source SelectProjectSource) *SelectProjectService {
+	return &SelectProjectService{source: source}
+}
+
+func (service *SelectProjectService) SelectProject(
+	ctx context.Context, request SelectProjectRequest,
+) (*models.Project, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.SelectProject")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.id", request.ID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+	)
+
+	resp, err := service.source.SelectProject(ctx, dao.SelectProjectData{
+		ID:     request.ID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select project: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &models.Project{
+		ID:          resp.ID,
+		UserID:      resp.UserID,
+		Name:        resp.Name,
+		Description: resp.Description,
+		CreatedAt:   resp.CreatedAt,
+		UpdatedAt:   resp.UpdatedAt,
+	}), nil
+}
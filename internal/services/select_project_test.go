@@ -0,0 +1,114 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestSelectProject(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectProjectData struct {
+		resp *dao.ProjectEntity
+		err  error
+	}
+
+	request := services.SelectProjectRequest{
+		ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.SelectProjectRequest
+
+		selectProjectData *selectProjectData
+
+		expect    *models.Project
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			selectProjectData: &selectProjectData{
+				resp: &dao.ProjectEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Name:        "The Sea Trilogy",
+					Description: "Three novels about the sea.",
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.Project{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Name:        "The Sea Trilogy",
+				Description: "Three novels about the sea.",
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "NotFound",
+
+			request: request,
+
+			selectProjectData: &selectProjectData{err: dao.ErrProjectNotFound},
+
+			expectErr: dao.ErrProjectNotFound,
+		},
+		{
+			name: "Error",
+
+			request: request,
+
+			selectProjectData: &selectProjectData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockSelectProjectSource(t)
+
+			if testCase.selectProjectData != nil {
+				source.EXPECT().
+					SelectProject(mock.Anything, dao.SelectProjectData{
+						ID:     testCase.request.ID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectProjectData.resp, testCase.selectProjectData.err)
+			}
+
+			service := services.NewSelectProjectService(source)
+
+			resp, err := service.SelectProject(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
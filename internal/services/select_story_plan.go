@@ -3,41 +3,115 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel/golib/otel"
 
+	"github.com/a-novel/service-story-schematics/internal/dao"
 	"github.com/a-novel/service-story-schematics/models"
 	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
-var ErrStoryPlanNotFound = errors.New("story plan not found")
+// ErrStoryPlanLangMismatch is returned when a story plan is explicitly requested for content written in another
+// language.
+var ErrStoryPlanLangMismatch = errors.New("story plan language does not match the requested language")
 
+type SelectStoryPlanSource interface {
+	SelectStoryPlan(ctx context.Context, data dao.SelectStoryPlanData) (*dao.StoryPlanEntity, error)
+	SelectStoryPlanBySlug(ctx context.Context, data dao.SelectStoryPlanBySlugData) (*dao.StoryPlanEntity, error)
+}
+
+func NewSelectStoryPlanServiceSource(
+	selectStoryPlanDAO *dao.SelectStoryPlanRepository,
+	selectStoryPlanBySlugDAO *dao.SelectStoryPlanBySlugRepository,
+) SelectStoryPlanSource {
+	return &struct {
+		*dao.SelectStoryPlanRepository
+		*dao.SelectStoryPlanBySlugRepository
+	}{
+		SelectStoryPlanRepository:       selectStoryPlanDAO,
+		SelectStoryPlanBySlugRepository: selectStoryPlanBySlugDAO,
+	}
+}
+
+// SelectStoryPlanRequest selects a story plan either by its unique ID, or by its slug and language. When neither
+// ID nor Slug is provided, the default story plan is returned for the requested language.
+//
+// Built-in plans are available to everyone, while custom plans are only available to the user that owns them.
 type SelectStoryPlanRequest struct {
-	Lang models.Lang
+	ID     *uuid.UUID
+	Slug   *models.Slug
+	Lang   models.Lang
+	UserID uuid.UUID
 }
 
-type SelectStoryPlanService struct{}
+type SelectStoryPlanService struct {
+	source SelectStoryPlanSource
+}
 
-func NewSelectStoryPlanService() *SelectStoryPlanService {
-	return &SelectStoryPlanService{}
+func NewSelectStoryPlanService(source SelectStoryPlanSource) *SelectStoryPlanService {
+	return &SelectStoryPlanService{source: source}
 }
 
 func (service *SelectStoryPlanService) SelectStoryPlan(
 	ctx context.Context, request SelectStoryPlanRequest,
 ) (*storyplanmodel.Plan, error) {
-	_, span := otel.Tracer().Start(ctx, "service.SelectStoryPlan")
+	ctx, span := otel.Tracer().Start(ctx, "service.SelectStoryPlan")
 	defer span.End()
 
 	span.SetAttributes(
+		attribute.String("request.id", lo.FromPtr(request.ID).String()),
+		attribute.String("request.slug", lo.FromPtr(request.Slug).String()),
 		attribute.String("request.lang", request.Lang.String()),
+		attribute.String("request.userID", request.UserID.String()),
+	)
+
+	var (
+		entity *dao.StoryPlanEntity
+		err    error
 	)
 
-	plan, ok := storyplanmodel.SaveTheCat[request.Lang]
-	if !ok {
-		return nil, ErrStoryPlanNotFound
+	if request.ID != nil {
+		entity, err = service.source.SelectStoryPlan(ctx, dao.SelectStoryPlanData{
+			ID:     *request.ID,
+			UserID: request.UserID,
+		})
+	} else {
+		err = storyplanmodel.CheckLang(request.Lang)
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("check lang: %w", err))
+		}
+
+		entity, err = service.source.SelectStoryPlanBySlug(ctx, dao.SelectStoryPlanBySlugData{
+			Slug:   lo.CoalesceOrEmpty(lo.FromPtr(request.Slug), storyplanmodel.DefaultSlug),
+			Lang:   request.Lang,
+			UserID: request.UserID,
+		})
+	}
+
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select story plan: %w", err))
 	}
 
-	return plan, nil
+	return otel.ReportSuccess(span, storyPlanEntityToModel(entity)), nil
+}
+
+func storyPlanEntityToModel(entity *dao.StoryPlanEntity) *storyplanmodel.Plan {
+	return &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			ID:        entity.ID,
+			UserID:    entity.UserID,
+			Slug:      entity.Slug,
+			Version:   entity.Version,
+			Name:      entity.Name,
+			Lang:      entity.Lang,
+			CreatedAt: entity.CreatedAt,
+		},
+		Acts:  entity.Acts,
+		Beats: entity.Beats,
+	}
 }
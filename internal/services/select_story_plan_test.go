@@ -1,11 +1,18 @@
 package services_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/a-novel/service-story-schematics/internal/dao"
 	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
 	"github.com/a-novel/service-story-schematics/models"
 	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
@@ -13,31 +20,165 @@ import (
 func TestSelectStoryPlan(t *testing.T) {
 	t.Parallel()
 
+	errFoo := errors.New("foo")
+
+	type selectStoryPlanData struct {
+		resp *dao.StoryPlanEntity
+		err  error
+	}
+
+	type selectStoryPlanBySlugData struct {
+		expectSlug models.Slug
+		resp       *dao.StoryPlanEntity
+		err        error
+	}
+
+	entity := &dao.StoryPlanEntity{
+		ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Slug:    "test-slug",
+		Version: 1,
+		Name:    "Test Name",
+		Lang:    models.LangEN,
+		Beats: []storyplanmodel.Beat{
+			{
+				Name:      "Test Beat",
+				Key:       "test-beat",
+				KeyPoints: []string{"Test Key Point"},
+				Purpose:   "Test Purpose",
+			},
+		},
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	plan := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Slug:      "test-slug",
+			Version:   1,
+			Name:      "Test Name",
+			Lang:      models.LangEN,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		Beats: []storyplanmodel.Beat{
+			{
+				Name:      "Test Beat",
+				Key:       "test-beat",
+				KeyPoints: []string{"Test Key Point"},
+				Purpose:   "Test Purpose",
+			},
+		},
+	}
+
+	customEntity := *entity
+	customEntity.UserID = lo.ToPtr(uuid.MustParse("00000000-0000-0000-1000-000000000001"))
+
+	customPlan := *plan
+	customPlan.Metadata.UserID = customEntity.UserID
+
 	testCases := []struct {
 		name string
 
 		request services.SelectStoryPlanRequest
 
+		selectStoryPlanData       *selectStoryPlanData
+		selectStoryPlanBySlugData *selectStoryPlanBySlugData
+
 		expect    *storyplanmodel.Plan
 		expectErr error
 	}{
 		{
-			name: "Success",
+			name: "Success/ID",
 
 			request: services.SelectStoryPlanRequest{
+				ID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: entity,
+			},
+
+			expect: plan,
+		},
+		{
+			name: "Success/Slug",
+
+			request: services.SelectStoryPlanRequest{
+				Slug: lo.ToPtr(models.Slug("test-slug")),
 				Lang: models.LangEN,
 			},
 
-			expect: storyplanmodel.SaveTheCat[models.LangEN],
+			selectStoryPlanBySlugData: &selectStoryPlanBySlugData{
+				expectSlug: "test-slug",
+				resp:       entity,
+			},
+
+			expect: plan,
 		},
 		{
-			name: "NotFound",
+			name: "Success/Default",
 
 			request: services.SelectStoryPlanRequest{
-				Lang: models.Lang("unknown"),
+				Lang: models.LangEN,
+			},
+
+			selectStoryPlanBySlugData: &selectStoryPlanBySlugData{
+				expectSlug: storyplanmodel.DefaultSlug,
+				resp:       entity,
 			},
 
-			expectErr: services.ErrStoryPlanNotFound,
+			expect: plan,
+		},
+		{
+			name: "Success/Custom",
+
+			request: services.SelectStoryPlanRequest{
+				Slug:   lo.ToPtr(models.Slug("test-slug")),
+				Lang:   models.LangEN,
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectStoryPlanBySlugData: &selectStoryPlanBySlugData{
+				expectSlug: "test-slug",
+				resp:       &customEntity,
+			},
+
+			expect: &customPlan,
+		},
+		{
+			name: "UnsupportedLang",
+
+			request: services.SelectStoryPlanRequest{
+				Lang: "xx",
+			},
+
+			expectErr: storyplanmodel.ErrUnsupportedLang,
+		},
+		{
+			name: "Error/ID",
+
+			request: services.SelectStoryPlanRequest{
+				ID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				err: dao.ErrStoryPlanNotFound,
+			},
+
+			expectErr: dao.ErrStoryPlanNotFound,
+		},
+		{
+			name: "Error/Slug",
+
+			request: services.SelectStoryPlanRequest{
+				Lang: models.LangEN,
+			},
+
+			selectStoryPlanBySlugData: &selectStoryPlanBySlugData{
+				expectSlug: storyplanmodel.DefaultSlug,
+				err:        errFoo,
+			},
+
+			expectErr: errFoo,
 		},
 	}
 
@@ -47,11 +188,34 @@ func TestSelectStoryPlan(t *testing.T) {
 
 			ctx := t.Context()
 
-			service := services.NewSelectStoryPlanService()
+			source := servicesmocks.NewMockSelectStoryPlanSource(t)
+
+			if testCase.selectStoryPlanData != nil {
+				source.EXPECT().
+					SelectStoryPlan(mock.Anything, dao.SelectStoryPlanData{
+						ID:     *testCase.request.ID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectStoryPlanData.resp, testCase.selectStoryPlanData.err)
+			}
+
+			if testCase.selectStoryPlanBySlugData != nil {
+				source.EXPECT().
+					SelectStoryPlanBySlug(mock.Anything, dao.SelectStoryPlanBySlugData{
+						Slug:   testCase.selectStoryPlanBySlugData.expectSlug,
+						Lang:   testCase.request.Lang,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectStoryPlanBySlugData.resp, testCase.selectStoryPlanBySlugData.err)
+			}
+
+			service := services.NewSelectStoryPlanService(source)
 
 			resp, err := service.SelectStoryPlan(ctx, testCase.request)
 			require.ErrorIs(t, err, testCase.expectErr)
 			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
 		})
 	}
 }
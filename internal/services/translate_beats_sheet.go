@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+// ErrTranslationLangMismatch is returned when a translated beats sheet is attached to a logline that is not
+// written in the target language.
+var ErrTranslationLangMismatch = errors.New("logline language does not match the translation language")
+
+type TranslateBeatsSheetSource interface {
+	SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)
+	SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)
+	SelectStoryPlan(ctx context.Context, request SelectStoryPlanRequest) (*storyplanmodel.Plan, error)
+	TranslateBeatsSheet(ctx context.Context, request daoai.TranslateBeatsSheetRequest) ([]models.Beat, error)
+	InsertBeatsSheet(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)
+}
+
+func NewTranslateBeatsSheetServiceSource(
+	selectBeatsSheetDAO *dao.SelectBeatsSheetRepository,
+	selectLoglineDAO *dao.SelectLoglineRepository,
+	selectStoryPlan *SelectStoryPlanService,
+	translateBeatsSheetDAO *daoai.TranslateBeatsSheetRepository,
+	insertBeatsSheetDAO *dao.InsertBeatsSheetRepository,
+) TranslateBeatsSheetSource {
+	return &struct {
+		*dao.SelectBeatsSheetRepository
+		*dao.SelectLoglineRepository
+		*SelectStoryPlanService
+		*daoai.TranslateBeatsSheetRepository
+		*dao.InsertBeatsSheetRepository
+	}{
+		SelectBeatsSheetRepository:    selectBeatsSheetDAO,
+		SelectLoglineRepository:       selectLoglineDAO,
+		SelectStoryPlanService:        selectStoryPlan,
+		TranslateBeatsSheetRepository: translateBeatsSheetDAO,
+		InsertBeatsSheetRepository:    insertBeatsSheetDAO,
+	}
+}
+
+// TranslateBeatsSheetRequest translates an existing beats sheet to another language. The translation follows the
+// same story plan, in the target language, and is saved as a new beats sheet linked to the source one.
+type TranslateBeatsSheetRequest struct {
+	BeatsSheetID uuid.UUID
+	UserID       uuid.UUID
+	// The language to translate the sheet to.
+	Lang models.Lang
+	// Optional logline to attach the translation to, usually a translation of the source logline. It must be
+	// written in the target language. Defaults to the logline of the source sheet.
+	LoglineID *uuid.UUID
+}
+
+type TranslateBeatsSheetService struct {
+	source TranslateBeatsSheetSource
+}
+
+func NewTranslateBeatsSheetService(source TranslateBeatsSheetSource) *TranslateBeatsSheetService {
+	return &TranslateBeatsSheetService{source: source}
+}
+
+func (service *TranslateBeatsSheetService) TranslateBeatsSheet(
+	ctx context.Context, request TranslateBeatsSheetRequest,
+) (*models.BeatsSheet, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.TranslateBeatsSheet")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.beatsSheetID", request.BeatsSheetID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.lang", request.Lang.String()),
+		attribute.String("request.loglineID", lo.FromPtr(request.LoglineID).String()),
+	)
+
+	err := storyplanmodel.CheckLang(request.Lang)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check lang: %w", err))
+	}
+
+	beatsSheet, err := service.source.SelectBeatsSheet(ctx, request.BeatsSheetID)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select beats sheet: %w", err))
+	}
+
+	if beatsSheet.Lang == request.Lang {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: %s", ErrTranslationSameLang, request.Lang))
+	}
+
+	// Make sure the selected beats sheet is linked to a logline that belongs to the user.
+	logline, err := service.source.SelectLogline(ctx, dao.SelectLoglineData{
+		ID:     beatsSheet.LoglineID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check logline: %w", err))
+	}
+
+	targetLoglineID := beatsSheet.LoglineID
+
+	if request.LoglineID != nil {
+		targetLogline, err := service.source.SelectLogline(ctx, dao.SelectLoglineData{
+			ID:     *request.LoglineID,
+			UserID: request.UserID,
+		})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("check target logline: %w", err))
+		}
+
+		if targetLogline.Lang != request.Lang {
+			return nil, otel.ReportError(span, fmt.Errorf(
+				"%w: logline is %s, translation is %s", ErrTranslationLangMismatch, targetLogline.Lang, request.Lang,
+			))
+		}
+
+		targetLoglineID = targetLogline.ID
+	}
+
+	// Older sheets have no plan attached, and use the default one.
+	sourcePlan, err := service.source.SelectStoryPlan(ctx, SelectStoryPlanRequest{
+		ID:     lo.Ternary(beatsSheet.StoryPlanID != uuid.Nil, &beatsSheet.StoryPlanID, nil),
+		Lang:   beatsSheet.Lang,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get source story plan: %w", err))
+	}
+
+	// Translations of a plan share its slug.
+	targetPlan, err := service.source.SelectStoryPlan(ctx, SelectStoryPlanRequest{
+		Slug:   &sourcePlan.Metadata.Slug,
+		Lang:   request.Lang,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get target story plan: %w", err))
+	}
+
+	translated, err := service.source.TranslateBeatsSheet(ctx, daoai.TranslateBeatsSheetRequest{
+		Logline:    logline.Name + "\n\n" + logline.Content,
+		Beats:      beatsSheet.Content,
+		SourceLang: beatsSheet.Lang,
+		Plan:       targetPlan,
+		UserID:     request.UserID.String(),
+		Lang:       request.Lang,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("translate beats sheet: %w", err))
+	}
+
+	// The translation is generated, so make sure it actually follows the target plan before saving it.
+	err = targetPlan.Validate(translated)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check story plan: %w", err))
+	}
+
+	resp, err := service.source.InsertBeatsSheet(ctx, dao.InsertBeatsSheetData{
+		Sheet: models.BeatsSheet{
+			ID:          uuid.New(),
+			LoglineID:   targetLoglineID,
+			StoryPlanID: targetPlan.Metadata.ID,
+			SourceID:    beatsSheet.ID,
+			Content:     translated,
+			Lang:        request.Lang,
+			CreatedAt:   time.Now(),
+		},
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("insert beats sheet: %w", err))
+	}
+
+	span.SetAttributes(attribute.String("dao.insertBeatsSheet.id", resp.ID.String()))
+
+	return otel.ReportSuccess(span, &models.BeatsSheet{
+		ID:          resp.ID,
+		LoglineID:   resp.LoglineID,
+		StoryPlanID: resp.StoryPlanID,
+		SourceID:    resp.SourceID,
+		Content:     resp.Content,
+		Lang:        resp.Lang,
+		Acts:        targetPlan.GroupBeats(resp.Content),
+		CreatedAt:   resp.CreatedAt,
+	}), nil
+}
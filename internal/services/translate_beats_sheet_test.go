@@ -0,0 +1,450 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestTranslateBeatsSheet(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectBeatsSheetData struct {
+		resp *dao.BeatsSheetEntity
+		err  error
+	}
+
+	type selectLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	type selectStoryPlanData struct {
+		resp *storyplanmodel.Plan
+		err  error
+	}
+
+	type translateBeatsSheetData struct {
+		resp []models.Beat
+		err  error
+	}
+
+	type insertBeatsSheetData struct {
+		resp *dao.BeatsSheetEntity
+		err  error
+	}
+
+	sourceSheet := &dao.BeatsSheetEntity{
+		ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+		Content: []models.Beat{
+			{Key: "beginning", Title: "Beginning", Content: "Beginning Content"},
+			{Key: "end", Title: "End", Content: "End Content"},
+		},
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	logline := &dao.LoglineEntity{
+		ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Slug:      "logline-1",
+		Name:      "Logline 1",
+		Content:   "Content 1",
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	targetLogline := &dao.LoglineEntity{
+		ID:        uuid.MustParse("00000000-0000-1000-0000-000000000002"),
+		UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Slug:      "logline-1-fr",
+		SourceID:  uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		Name:      "Logline 1",
+		Content:   "Contenu 1",
+		Lang:      models.LangFR,
+		CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	sourcePlan := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			ID:   uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+			Slug: "plan",
+			Name: "Plan",
+			Lang: models.LangEN,
+		},
+		Acts: []storyplanmodel.Act{
+			{Name: "Act 1", Key: "act-1"},
+		},
+		Beats: []storyplanmodel.Beat{
+			{Name: "Beginning", Key: "beginning", Act: "act-1"},
+			{Name: "End", Key: "end", Act: "act-1"},
+		},
+	}
+
+	targetPlan := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			ID:   uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+			Slug: "plan",
+			Name: "Plan",
+			Lang: models.LangFR,
+		},
+		Acts: []storyplanmodel.Act{
+			{Name: "Acte 1", Key: "act-1"},
+		},
+		Beats: []storyplanmodel.Beat{
+			{Name: "Début", Key: "beginning", Act: "act-1"},
+			{Name: "Fin", Key: "end", Act: "act-1"},
+		},
+	}
+
+	translated := []models.Beat{
+		{Key: "beginning", Title: "Début", Content: "Contenu du début"},
+		{Key: "end", Title: "Fin", Content: "Contenu de fin"},
+	}
+
+	request := services.TranslateBeatsSheetRequest{
+		BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Lang:         models.LangFR,
+	}
+
+	requestWithLogline := request
+	requestWithLogline.LoglineID = lo.ToPtr(uuid.MustParse("00000000-0000-1000-0000-000000000002"))
+
+	testCases := []struct {
+		name string
+
+		request services.TranslateBeatsSheetRequest
+
+		selectBeatsSheetData    *selectBeatsSheetData
+		selectLoglineData       *selectLoglineData
+		selectTargetLoglineData *selectLoglineData
+		selectSourcePlanData    *selectStoryPlanData
+		selectTargetPlanData    *selectStoryPlanData
+		translateBeatsSheetData *translateBeatsSheetData
+		insertBeatsSheetData    *insertBeatsSheetData
+
+		expectLoglineID uuid.UUID
+
+		expect    *models.BeatsSheet
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			selectBeatsSheetData:    &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:       &selectLoglineData{resp: logline},
+			selectSourcePlanData:    &selectStoryPlanData{resp: sourcePlan},
+			selectTargetPlanData:    &selectStoryPlanData{resp: targetPlan},
+			translateBeatsSheetData: &translateBeatsSheetData{resp: translated},
+			insertBeatsSheetData: &insertBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+					SourceID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content:     translated,
+					Lang:        models.LangFR,
+					CreatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectLoglineID: logline.ID,
+
+			expect: &models.BeatsSheet{
+				ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+				LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+				StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+				SourceID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Content:     translated,
+				Lang:        models.LangFR,
+				Acts: []models.BeatsSheetAct{
+					{Key: "act-1", Name: "Acte 1", Beats: []string{"beginning", "end"}},
+				},
+				CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/TargetLogline",
+
+			request: requestWithLogline,
+
+			selectBeatsSheetData:    &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:       &selectLoglineData{resp: logline},
+			selectTargetLoglineData: &selectLoglineData{resp: targetLogline},
+			selectSourcePlanData:    &selectStoryPlanData{resp: sourcePlan},
+			selectTargetPlanData:    &selectStoryPlanData{resp: targetPlan},
+			translateBeatsSheetData: &translateBeatsSheetData{resp: translated},
+			insertBeatsSheetData: &insertBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000002"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+					SourceID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content:     translated,
+					Lang:        models.LangFR,
+					CreatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectLoglineID: targetLogline.ID,
+
+			expect: &models.BeatsSheet{
+				ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+				LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000002"),
+				StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+				SourceID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Content:     translated,
+				Lang:        models.LangFR,
+				Acts: []models.BeatsSheetAct{
+					{Key: "act-1", Name: "Acte 1", Beats: []string{"beginning", "end"}},
+				},
+				CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "UnsupportedLang",
+
+			request: services.TranslateBeatsSheetRequest{
+				BeatsSheetID: request.BeatsSheetID,
+				UserID:       request.UserID,
+				Lang:         "xx",
+			},
+
+			expectErr: storyplanmodel.ErrUnsupportedLang,
+		},
+		{
+			name: "SameLang",
+
+			request: services.TranslateBeatsSheetRequest{
+				BeatsSheetID: request.BeatsSheetID,
+				UserID:       request.UserID,
+				Lang:         models.LangEN,
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+
+			expectErr: services.ErrTranslationSameLang,
+		},
+		{
+			name: "TargetLoglineLangMismatch",
+
+			request: requestWithLogline,
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{resp: logline},
+			selectTargetLoglineData: &selectLoglineData{
+				resp: &dao.LoglineEntity{
+					ID:     targetLogline.ID,
+					UserID: targetLogline.UserID,
+					Lang:   models.LangES,
+				},
+			},
+
+			expectErr: services.ErrTranslationLangMismatch,
+		},
+		{
+			name: "InvalidTranslation",
+
+			request: request,
+
+			selectBeatsSheetData:    &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:       &selectLoglineData{resp: logline},
+			selectSourcePlanData:    &selectStoryPlanData{resp: sourcePlan},
+			selectTargetPlanData:    &selectStoryPlanData{resp: targetPlan},
+			translateBeatsSheetData: &translateBeatsSheetData{resp: translated[:1]},
+
+			expectErr: storyplanmodel.ErrMissingBeat,
+		},
+		{
+			name: "TranslateBeatsSheet/Error",
+
+			request: request,
+
+			selectBeatsSheetData:    &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:       &selectLoglineData{resp: logline},
+			selectSourcePlanData:    &selectStoryPlanData{resp: sourcePlan},
+			selectTargetPlanData:    &selectStoryPlanData{resp: targetPlan},
+			translateBeatsSheetData: &translateBeatsSheetData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "InsertBeatsSheet/Error",
+
+			request: request,
+
+			selectBeatsSheetData:    &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:       &selectLoglineData{resp: logline},
+			selectSourcePlanData:    &selectStoryPlanData{resp: sourcePlan},
+			selectTargetPlanData:    &selectStoryPlanData{resp: targetPlan},
+			translateBeatsSheetData: &translateBeatsSheetData{resp: translated},
+			insertBeatsSheetData:    &insertBeatsSheetData{err: errFoo},
+
+			expectLoglineID: logline.ID,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "SelectTargetPlan/Error",
+
+			request: request,
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{resp: logline},
+			selectSourcePlanData: &selectStoryPlanData{resp: sourcePlan},
+			selectTargetPlanData: &selectStoryPlanData{err: dao.ErrStoryPlanNotFound},
+
+			expectErr: dao.ErrStoryPlanNotFound,
+		},
+		{
+			name: "SelectSourcePlan/Error",
+
+			request: request,
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{resp: logline},
+			selectSourcePlanData: &selectStoryPlanData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "SelectTargetLogline/Error",
+
+			request: requestWithLogline,
+
+			selectBeatsSheetData:    &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:       &selectLoglineData{resp: logline},
+			selectTargetLoglineData: &selectLoglineData{err: dao.ErrLoglineNotFound},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+		{
+			name: "SelectLogline/Error",
+
+			request: request,
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{err: dao.ErrLoglineNotFound},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+		{
+			name: "SelectBeatsSheet/Error",
+
+			request: request,
+
+			selectBeatsSheetData: &selectBeatsSheetData{err: dao.ErrBeatsSheetNotFound},
+
+			expectErr: dao.ErrBeatsSheetNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockTranslateBeatsSheetSource(t)
+
+			if testCase.selectBeatsSheetData != nil {
+				source.EXPECT().
+					SelectBeatsSheet(mock.Anything, testCase.request.BeatsSheetID).
+					Return(testCase.selectBeatsSheetData.resp, testCase.selectBeatsSheetData.err)
+			}
+
+			if testCase.selectLoglineData != nil {
+				source.EXPECT().
+					SelectLogline(mock.Anything, dao.SelectLoglineData{
+						ID:     sourceSheet.LoglineID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectLoglineData.resp, testCase.selectLoglineData.err)
+			}
+
+			if testCase.selectTargetLoglineData != nil {
+				source.EXPECT().
+					SelectLogline(mock.Anything, dao.SelectLoglineData{
+						ID:     *testCase.request.LoglineID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectTargetLoglineData.resp, testCase.selectTargetLoglineData.err)
+			}
+
+			if testCase.selectSourcePlanData != nil {
+				source.EXPECT().
+					SelectStoryPlan(mock.Anything, services.SelectStoryPlanRequest{
+						ID:     &sourceSheet.StoryPlanID,
+						Lang:   sourceSheet.Lang,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectSourcePlanData.resp, testCase.selectSourcePlanData.err)
+			}
+
+			if testCase.selectTargetPlanData != nil {
+				source.EXPECT().
+					SelectStoryPlan(mock.Anything, services.SelectStoryPlanRequest{
+						Slug:   &sourcePlan.Metadata.Slug,
+						Lang:   testCase.request.Lang,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectTargetPlanData.resp, testCase.selectTargetPlanData.err)
+			}
+
+			if testCase.translateBeatsSheetData != nil {
+				source.EXPECT().
+					TranslateBeatsSheet(mock.Anything, daoai.TranslateBeatsSheetRequest{
+						Logline:    logline.Name + "\n\n" + logline.Content,
+						Beats:      sourceSheet.Content,
+						SourceLang: sourceSheet.Lang,
+						Plan:       testCase.selectTargetPlanData.resp,
+						UserID:     testCase.request.UserID.String(),
+						Lang:       testCase.request.Lang,
+					}).
+					Return(testCase.translateBeatsSheetData.resp, testCase.translateBeatsSheetData.err)
+			}
+
+			if testCase.insertBeatsSheetData != nil {
+				source.EXPECT().
+					InsertBeatsSheet(mock.Anything, mock.MatchedBy(func(data dao.InsertBeatsSheetData) bool {
+						return assert.NotEqual(t, uuid.Nil, data.Sheet.ID) &&
+							assert.Equal(t, testCase.expectLoglineID, data.Sheet.LoglineID) &&
+							assert.Equal(t, targetPlan.Metadata.ID, data.Sheet.StoryPlanID) &&
+							assert.Equal(t, sourceSheet.ID, data.Sheet.SourceID) &&
+							assert.Equal(t, testCase.translateBeatsSheetData.resp, data.Sheet.Content) &&
+							assert.Equal(t, testCase.request.Lang, data.Sheet.Lang) &&
+							assert.WithinDuration(t, time.Now(), data.Sheet.CreatedAt, time.Second)
+					})).
+					Return(testCase.insertBeatsSheetData.resp, testCase.insertBeatsSheetData.err)
+			}
+
+			service := services.NewTranslateBeatsSheetService(source)
+
+			resp, err := service.TranslateBeatsSheet(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+// ErrTranslationSameLang is returned when the translation target language is the language of the source.
+var ErrTranslationSameLang = errors.New("cannot translate to the source language")
+
+type TranslateLoglineSource interface {
+	SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)
+	TranslateLogline(ctx context.Context, request daoai.TranslateLoglineRequest) (*models.LoglineIdea, error)
+	InsertLogline(ctx context.Context, data dao.InsertLoglineData) (*dao.LoglineEntity, error)
+	SelectSlugIteration(ctx context.Context, data dao.SelectSlugIterationData) (models.Slug, int, error)
+}
+
+func NewTranslateLoglineServiceSource(
+	selectLoglineDAO *dao.SelectLoglineRepository,
+	translateLoglineDAO *daoai.TranslateLoglineRepository,
+	insertLoglineDAO *dao.InsertLoglineRepository,
+	selectSlugIterationDAO *dao.SelectSlugIterationRepository,
+) TranslateLoglineSource {
+	return &struct {
+		*dao.SelectLoglineRepository
+		*daoai.TranslateLoglineRepository
+		*dao.InsertLoglineRepository
+		*dao.SelectSlugIterationRepository
+	}{
+		SelectLoglineRepository:       selectLoglineDAO,
+		TranslateLoglineRepository:    translateLoglineDAO,
+		InsertLoglineRepository:       insertLoglineDAO,
+		SelectSlugIterationRepository: selectSlugIterationDAO,
+	}
+}
+
+// TranslateLoglineRequest translates an existing logline to another language. The translation is saved as a new
+// logline, linked to the source one.
+type TranslateLoglineRequest struct {
+	LoglineID uuid.UUID
+	UserID    uuid.UUID
+	// The language to translate the logline to.
+	Lang models.Lang
+}
+
+type TranslateLoglineService struct {
+	source TranslateLoglineSource
+}
+
+func NewTranslateLoglineService(source TranslateLoglineSource) *TranslateLoglineService {
+	return &TranslateLoglineService{source: source}
+}
+
+func (service *TranslateLoglineService) TranslateLogline(
+	ctx context.Context, request TranslateLoglineRequest,
+) (*models.Logline, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.TranslateLogline")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.loglineID", request.LoglineID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.lang", request.Lang.String()),
+		attribute.Bool("slug.taken", false),
+	)
+
+	err := storyplanmodel.CheckLang(request.Lang)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check lang: %w", err))
+	}
+
+	logline, err := service.source.SelectLogline(ctx, dao.SelectLoglineData{
+		ID:     request.LoglineID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select logline: %w", err))
+	}
+
+	if logline.Lang == request.Lang {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: %s", ErrTranslationSameLang, request.Lang))
+	}
+
+	translated, err := service.source.TranslateLogline(ctx, daoai.TranslateLoglineRequest{
+		Logline: models.LoglineIdea{
+			Name:    logline.Name,
+			Content: logline.Content,
+			Lang:    logline.Lang,
+		},
+		UserID: request.UserID.String(),
+		Lang:   request.Lang,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("translate logline: %w", err))
+	}
+
+	data := dao.InsertLoglineData{
+		ID:       uuid.New(),
+		UserID:   request.UserID,
+		Slug:     models.Slug(logline.Slug.String() + "-" + request.Lang.String()),
+		SourceID: logline.ID,
+		Name:     translated.Name,
+		Content:  translated.Content,
+		Lang:     request.Lang,
+		// Genres, tags and projects are not tied to a language, so the translation shares them with its source.
+		Genre:     logline.Genre,
+		Tags:      logline.Tags,
+		ProjectID: logline.ProjectID,
+		Now:       time.Now(),
+	}
+
+	resp, err := service.source.InsertLogline(ctx, data)
+
+	// If slug is taken, try to modify it by appending a version number.
+	if errors.Is(err, dao.ErrLoglineAlreadyExists) {
+		span.SetAttributes(attribute.Bool("slug.taken", true))
+
+		data.Slug, _, err = service.source.SelectSlugIteration(ctx, dao.SelectSlugIterationData{
+			Slug:   data.Slug,
+			Target: dao.SlugIterationTargetLogline,
+			Args:   []any{data.UserID},
+		})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("check slug uniqueness: %w", err))
+		}
+
+		resp, err = service.source.InsertLogline(ctx, data)
+	}
+
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("insert logline: %w", err))
+	}
+
+	span.SetAttributes(attribute.String("dao.insertLogline.id", resp.ID.String()))
+
+	return otel.ReportSuccess(span, &models.Logline{
+		ID:        resp.ID,
+		UserID:    resp.UserID,
+		Slug:      resp.Slug,
+		SourceID:  resp.SourceID,
+		ProjectID: resp.ProjectID,
+		Name:      resp.Name,
+		Content:   resp.Content,
+		Lang:      resp.Lang,
+		Genre:     resp.Genre,
+		Tags:      resp.Tags,
+		CreatedAt: resp.CreatedAt,
+	}), nil
+}
@@ -0,0 +1,285 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/daoai"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestTranslateLogline(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	type translateLoglineData struct {
+		resp *models.LoglineIdea
+		err  error
+	}
+
+	type insertLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	type selectSlugIterationData struct {
+		slug      models.Slug
+		iteration int
+		err       error
+	}
+
+	sourceLogline := &dao.LoglineEntity{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Slug:      "test-slug",
+		Name:      "Test Logline",
+		Content:   "Once upon a time",
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	translated := &models.LoglineIdea{
+		Name:    "Logline de test",
+		Content: "Il était une fois",
+		Lang:    models.LangFR,
+	}
+
+	request := services.TranslateLoglineRequest{
+		LoglineID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Lang:      models.LangFR,
+	}
+
+	translatedEntity := func(slug models.Slug) *dao.LoglineEntity {
+		return &dao.LoglineEntity{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Slug:      slug,
+			SourceID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Name:      "Logline de test",
+			Content:   "Il était une fois",
+			Lang:      models.LangFR,
+			CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	translatedModel := func(slug models.Slug) *models.Logline {
+		return &models.Logline{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			Slug:      slug,
+			SourceID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Name:      "Logline de test",
+			Content:   "Il était une fois",
+			Lang:      models.LangFR,
+			CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.TranslateLoglineRequest
+
+		selectLoglineData       *selectLoglineData
+		translateLoglineData    *translateLoglineData
+		insertLoglineData       *insertLoglineData
+		selectSlugIterationData *selectSlugIterationData
+		reinsertLoglineData     *insertLoglineData
+
+		expect    *models.Logline
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			selectLoglineData:    &selectLoglineData{resp: sourceLogline},
+			translateLoglineData: &translateLoglineData{resp: translated},
+			insertLoglineData:    &insertLoglineData{resp: translatedEntity("test-slug-fr")},
+
+			expect: translatedModel("test-slug-fr"),
+		},
+		{
+			name: "RetrySlug",
+
+			request: request,
+
+			selectLoglineData:    &selectLoglineData{resp: sourceLogline},
+			translateLoglineData: &translateLoglineData{resp: translated},
+			insertLoglineData:    &insertLoglineData{err: dao.ErrLoglineAlreadyExists},
+			selectSlugIterationData: &selectSlugIterationData{
+				slug:      "test-slug-fr-2",
+				iteration: 2,
+			},
+			reinsertLoglineData: &insertLoglineData{resp: translatedEntity("test-slug-fr-2")},
+
+			expect: translatedModel("test-slug-fr-2"),
+		},
+		{
+			name: "UnsupportedLang",
+
+			request: services.TranslateLoglineRequest{
+				LoglineID: request.LoglineID,
+				UserID:    request.UserID,
+				Lang:      "xx",
+			},
+
+			expectErr: storyplanmodel.ErrUnsupportedLang,
+		},
+		{
+			name: "SameLang",
+
+			request: services.TranslateLoglineRequest{
+				LoglineID: request.LoglineID,
+				UserID:    request.UserID,
+				Lang:      models.LangEN,
+			},
+
+			selectLoglineData: &selectLoglineData{resp: sourceLogline},
+
+			expectErr: services.ErrTranslationSameLang,
+		},
+		{
+			name: "SelectLoglineError",
+
+			request: request,
+
+			selectLoglineData: &selectLoglineData{err: dao.ErrLoglineNotFound},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+		{
+			name: "TranslateError",
+
+			request: request,
+
+			selectLoglineData:    &selectLoglineData{resp: sourceLogline},
+			translateLoglineData: &translateLoglineData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "InsertError",
+
+			request: request,
+
+			selectLoglineData:    &selectLoglineData{resp: sourceLogline},
+			translateLoglineData: &translateLoglineData{resp: translated},
+			insertLoglineData:    &insertLoglineData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "SlugIterationError",
+
+			request: request,
+
+			selectLoglineData:       &selectLoglineData{resp: sourceLogline},
+			translateLoglineData:    &translateLoglineData{resp: translated},
+			insertLoglineData:       &insertLoglineData{err: dao.ErrLoglineAlreadyExists},
+			selectSlugIterationData: &selectSlugIterationData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockTranslateLoglineSource(t)
+
+			if testCase.selectLoglineData != nil {
+				source.EXPECT().
+					SelectLogline(mock.Anything, dao.SelectLoglineData{
+						ID:     testCase.request.LoglineID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectLoglineData.resp, testCase.selectLoglineData.err)
+			}
+
+			if testCase.translateLoglineData != nil {
+				source.EXPECT().
+					TranslateLogline(mock.Anything, daoai.TranslateLoglineRequest{
+						Logline: models.LoglineIdea{
+							Name:    sourceLogline.Name,
+							Content: sourceLogline.Content,
+							Lang:    sourceLogline.Lang,
+						},
+						UserID: testCase.request.UserID.String(),
+						Lang:   testCase.request.Lang,
+					}).
+					Return(testCase.translateLoglineData.resp, testCase.translateLoglineData.err)
+			}
+
+			matchInsert := func(slug models.Slug) func(data dao.InsertLoglineData) bool {
+				return func(data dao.InsertLoglineData) bool {
+					return assert.NotEqual(t, uuid.Nil, data.ID) &&
+						assert.Equal(t, testCase.request.UserID, data.UserID) &&
+						slug == data.Slug &&
+						assert.Equal(t, sourceLogline.ID, data.SourceID) &&
+						assert.Equal(t, translated.Name, data.Name) &&
+						assert.Equal(t, translated.Content, data.Content) &&
+						assert.Equal(t, testCase.request.Lang, data.Lang) &&
+						assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+				}
+			}
+
+			if testCase.insertLoglineData != nil {
+				initialCall := source.EXPECT().
+					InsertLogline(mock.Anything, mock.MatchedBy(matchInsert("test-slug-fr"))).
+					Return(testCase.insertLoglineData.resp, testCase.insertLoglineData.err).
+					Once()
+
+				if testCase.reinsertLoglineData != nil {
+					source.EXPECT().
+						InsertLogline(mock.Anything, mock.MatchedBy(matchInsert(testCase.selectSlugIterationData.slug))).
+						Return(testCase.reinsertLoglineData.resp, testCase.reinsertLoglineData.err).
+						NotBefore(initialCall)
+				}
+			}
+
+			if testCase.selectSlugIterationData != nil {
+				source.EXPECT().
+					SelectSlugIteration(mock.Anything, dao.SelectSlugIterationData{
+						Slug:   "test-slug-fr",
+						Target: dao.SlugIterationTargetLogline,
+						Args:   []any{testCase.request.UserID},
+					}).
+					Return(
+						testCase.selectSlugIterationData.slug,
+						testCase.selectSlugIterationData.iteration,
+						testCase.selectSlugIterationData.err,
+					)
+			}
+
+			service := services.NewTranslateLoglineService(source)
+
+			resp, err := service.TranslateLogline(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+var ErrBeatNotFound = errors.New("beat not found in beats sheet")
+
+type UpdateBeatSource interface {
+	InsertBeatsSheet(ctx context.Context, data dao.InsertBeatsSheetData) (*dao.BeatsSheetEntity, error)
+	SelectBeatsSheet(ctx context.Context, data uuid.UUID) (*dao.BeatsSheetEntity, error)
+	SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)
+	SelectStoryPlan(ctx context.Context, request SelectStoryPlanRequest) (*storyplanmodel.Plan, error)
+}
+
+func NewUpdateBeatServiceSource(
+	insertBeatsSheetDAO *dao.InsertBeatsSheetRepository,
+	selectBeatsSheetDAO *dao.SelectBeatsSheetRepository,
+	selectLoglineDAO *dao.SelectLoglineRepository,
+	selectStoryPlan *SelectStoryPlanService,
+) UpdateBeatSource {
+	return &struct {
+		*dao.InsertBeatsSheetRepository
+		*dao.SelectBeatsSheetRepository
+		*dao.SelectLoglineRepository
+		*SelectStoryPlanService
+	}{
+		InsertBeatsSheetRepository: insertBeatsSheetDAO,
+		SelectBeatsSheetRepository: selectBeatsSheetDAO,
+		SelectLoglineRepository:    selectLoglineDAO,
+		SelectStoryPlanService:     selectStoryPlan,
+	}
+}
+
+// UpdateBeatRequest edits a single beat of a beats sheet. The edit is saved as a new revision of the sheet, and the
+// original sheet is left untouched. Omitted fields keep their current value.
+type UpdateBeatRequest struct {
+	BeatsSheetID uuid.UUID
+	UserID       uuid.UUID
+	Key          string
+	// When the key is repeated in the sheet, the (zero-based) occurrence of the beat to edit.
+	Occurrence int
+	Title      *string
+	Content    *string
+}
+
+type UpdateBeatService struct {
+	source UpdateBeatSource
+}
+
+func NewUpdateBeatService(source UpdateBeatSource) *UpdateBeatService {
+	return &UpdateBeatService{source: source}
+}
+
+func (service *UpdateBeatService) UpdateBeat(
+	ctx context.Context, request UpdateBeatRequest,
+) (*models.BeatsSheet, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.UpdateBeat")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.beatsSheetID", request.BeatsSheetID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.key", request.Key),
+		attribute.Int("request.occurrence", request.Occurrence),
+	)
+
+	beatsSheet, err := service.source.SelectBeatsSheet(ctx, request.BeatsSheetID)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select beats sheet: %w", err))
+	}
+
+	// Make sure the selected beats sheet is linked to a logline that belongs to the user.
+	_, err = service.source.SelectLogline(ctx, dao.SelectLoglineData{
+		ID:     beatsSheet.LoglineID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check logline: %w", err))
+	}
+
+	// Older sheets have no plan attached, and use the default one.
+	storyPlan, err := service.source.SelectStoryPlan(ctx, SelectStoryPlanRequest{
+		ID:     lo.Ternary(beatsSheet.StoryPlanID != uuid.Nil, &beatsSheet.StoryPlanID, nil),
+		Lang:   beatsSheet.Lang,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get story plan: %w", err))
+	}
+
+	// Work on a copy, so the content of the original sheet is never altered.
+	content := make([]models.Beat, len(beatsSheet.Content))
+	copy(content, beatsSheet.Content)
+
+	index, err := beatIndex(content, request.Key, request.Occurrence)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	target := &content[index]
+	target.Title = lo.FromPtrOr(request.Title, target.Title)
+	target.Content = lo.FromPtrOr(request.Content, target.Content)
+
+	beatsSheetRevision, err := insertBeatsSheetRevision(
+		ctx, service.source.InsertBeatsSheet, beatsSheet, storyPlan, content,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(attribute.String("dao.insertBeatsSheet.id", beatsSheetRevision.ID.String()))
+
+	return otel.ReportSuccess(span, beatsSheetRevision), nil
+}
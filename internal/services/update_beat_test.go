@@ -0,0 +1,379 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestUpdateBeat(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectBeatsSheetData struct {
+		resp *dao.BeatsSheetEntity
+		err  error
+	}
+
+	type selectLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	type selectStoryPlanData struct {
+		resp *storyplanmodel.Plan
+		err  error
+	}
+
+	type insertBeatsSheetData struct {
+		resp *dao.BeatsSheetEntity
+		err  error
+	}
+
+	sourceSheet := &dao.BeatsSheetEntity{
+		ID:          uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+		Content: []models.Beat{
+			{Key: "beginning", Title: "Beginning", Content: "Beginning Content"},
+			{Key: "trial", Title: "Trial 1", Content: "Trial 1 Content"},
+			{Key: "trial", Title: "Trial 2", Content: "Trial 2 Content"},
+			{Key: "end", Title: "End", Content: "End Content"},
+		},
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	logline := &dao.LoglineEntity{
+		ID:        uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+		UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Slug:      "logline-1",
+		Name:      "Logline 1",
+		Content:   "Content 1",
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	storyPlan := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			ID:   uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+			Name: "Test Plan",
+			Lang: models.LangEN,
+		},
+		Beats: []storyplanmodel.Beat{
+			{Name: "Beginning", Key: "beginning"},
+			{Name: "Trial", Key: "trial", Repeat: &storyplanmodel.Repeat{}},
+			{Name: "End", Key: "end"},
+		},
+	}
+
+	// The plan was changed since the sheet was written, so the sheet no longer follows it.
+	outdatedPlan := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			ID:   uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+			Name: "Test Plan",
+			Lang: models.LangEN,
+		},
+		Beats: []storyplanmodel.Beat{
+			{Name: "Beginning", Key: "beginning"},
+			{Name: "End", Key: "end"},
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.UpdateBeatRequest
+
+		selectBeatsSheetData *selectBeatsSheetData
+		selectLoglineData    *selectLoglineData
+		selectStoryPlanData  *selectStoryPlanData
+		insertBeatsSheetData *insertBeatsSheetData
+
+		expect    *models.BeatsSheet
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.UpdateBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Key:          "end",
+				Content:      lo.ToPtr("New End Content"),
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{resp: logline},
+			selectStoryPlanData:  &selectStoryPlanData{resp: storyPlan},
+			insertBeatsSheetData: &insertBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					ParentID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{Key: "beginning", Title: "Beginning", Content: "Beginning Content"},
+						{Key: "trial", Title: "Trial 1", Content: "Trial 1 Content"},
+						{Key: "trial", Title: "Trial 2", Content: "Trial 2 Content"},
+						{Key: "end", Title: "End", Content: "New End Content"},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.BeatsSheet{
+				ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+				LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+				StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+				ParentID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Content: []models.Beat{
+					{Key: "beginning", Title: "Beginning", Content: "Beginning Content"},
+					{Key: "trial", Title: "Trial 1", Content: "Trial 1 Content"},
+					{Key: "trial", Title: "Trial 2", Content: "Trial 2 Content"},
+					{Key: "end", Title: "End", Content: "New End Content"},
+				},
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/Occurrence",
+
+			request: services.UpdateBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Key:          "trial",
+				Occurrence:   1,
+				Title:        lo.ToPtr("Final Trial"),
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{resp: logline},
+			selectStoryPlanData:  &selectStoryPlanData{resp: storyPlan},
+			insertBeatsSheetData: &insertBeatsSheetData{
+				resp: &dao.BeatsSheetEntity{
+					ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+					LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+					StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+					ParentID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Content: []models.Beat{
+						{Key: "beginning", Title: "Beginning", Content: "Beginning Content"},
+						{Key: "trial", Title: "Trial 1", Content: "Trial 1 Content"},
+						{Key: "trial", Title: "Final Trial", Content: "Trial 2 Content"},
+						{Key: "end", Title: "End", Content: "End Content"},
+					},
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &models.BeatsSheet{
+				ID:          uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+				LoglineID:   uuid.MustParse("00000000-0000-1000-0000-000000000001"),
+				StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+				ParentID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Content: []models.Beat{
+					{Key: "beginning", Title: "Beginning", Content: "Beginning Content"},
+					{Key: "trial", Title: "Trial 1", Content: "Trial 1 Content"},
+					{Key: "trial", Title: "Final Trial", Content: "Trial 2 Content"},
+					{Key: "end", Title: "End", Content: "End Content"},
+				},
+				Lang:      models.LangEN,
+				CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "BeatNotFound",
+
+			request: services.UpdateBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Key:          "middle",
+				Content:      lo.ToPtr("New Content"),
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{resp: logline},
+			selectStoryPlanData:  &selectStoryPlanData{resp: storyPlan},
+
+			expectErr: services.ErrBeatNotFound,
+		},
+		{
+			name: "BeatNotFound/Occurrence",
+
+			request: services.UpdateBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Key:          "trial",
+				Occurrence:   2,
+				Content:      lo.ToPtr("New Content"),
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{resp: logline},
+			selectStoryPlanData:  &selectStoryPlanData{resp: storyPlan},
+
+			expectErr: services.ErrBeatNotFound,
+		},
+		{
+			name: "InvalidPlan",
+
+			request: services.UpdateBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Key:          "end",
+				Content:      lo.ToPtr("New End Content"),
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{resp: logline},
+			selectStoryPlanData:  &selectStoryPlanData{resp: outdatedPlan},
+
+			expectErr: storyplanmodel.ErrInvalidPlan,
+		},
+		{
+			name: "InsertBeatsSheet/Error",
+
+			request: services.UpdateBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Key:          "end",
+				Content:      lo.ToPtr("New End Content"),
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{resp: logline},
+			selectStoryPlanData:  &selectStoryPlanData{resp: storyPlan},
+			insertBeatsSheetData: &insertBeatsSheetData{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "SelectStoryPlan/Error",
+
+			request: services.UpdateBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Key:          "end",
+				Content:      lo.ToPtr("New End Content"),
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{resp: logline},
+			selectStoryPlanData:  &selectStoryPlanData{err: dao.ErrStoryPlanNotFound},
+
+			expectErr: dao.ErrStoryPlanNotFound,
+		},
+		{
+			name: "SelectLogline/Error",
+
+			request: services.UpdateBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Key:          "end",
+				Content:      lo.ToPtr("New End Content"),
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{resp: sourceSheet},
+			selectLoglineData:    &selectLoglineData{err: dao.ErrLoglineNotFound},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+		{
+			name: "SelectBeatsSheet/Error",
+
+			request: services.UpdateBeatRequest{
+				BeatsSheetID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Key:          "end",
+				Content:      lo.ToPtr("New End Content"),
+			},
+
+			selectBeatsSheetData: &selectBeatsSheetData{err: dao.ErrBeatsSheetNotFound},
+
+			expectErr: dao.ErrBeatsSheetNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockUpdateBeatSource(t)
+
+			if testCase.selectBeatsSheetData != nil {
+				source.EXPECT().
+					SelectBeatsSheet(mock.Anything, testCase.request.BeatsSheetID).
+					Return(testCase.selectBeatsSheetData.resp, testCase.selectBeatsSheetData.err)
+			}
+
+			if testCase.selectLoglineData != nil {
+				source.EXPECT().
+					SelectLogline(mock.Anything, dao.SelectLoglineData{
+						ID:     sourceSheet.LoglineID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectLoglineData.resp, testCase.selectLoglineData.err)
+			}
+
+			if testCase.selectStoryPlanData != nil {
+				source.EXPECT().
+					SelectStoryPlan(mock.Anything, services.SelectStoryPlanRequest{
+						ID:     &sourceSheet.StoryPlanID,
+						Lang:   sourceSheet.Lang,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectStoryPlanData.resp, testCase.selectStoryPlanData.err)
+			}
+
+			if testCase.insertBeatsSheetData != nil {
+				source.EXPECT().
+					InsertBeatsSheet(mock.Anything, mock.MatchedBy(func(data dao.InsertBeatsSheetData) bool {
+						expectContent := lo.Ternary(
+							testCase.insertBeatsSheetData.resp != nil,
+							lo.FromPtr(testCase.insertBeatsSheetData.resp).Content,
+							data.Sheet.Content,
+						)
+
+						return assert.NotEqual(t, uuid.Nil, data.Sheet.ID) &&
+							assert.Equal(t, sourceSheet.LoglineID, data.Sheet.LoglineID) &&
+							assert.Equal(t, sourceSheet.StoryPlanID, data.Sheet.StoryPlanID) &&
+							assert.Equal(t, sourceSheet.ID, data.Sheet.ParentID) &&
+							assert.Equal(t, expectContent, data.Sheet.Content) &&
+							assert.Equal(t, sourceSheet.Lang, data.Sheet.Lang) &&
+							assert.WithinDuration(t, time.Now(), data.Sheet.CreatedAt, time.Second)
+					})).
+					Return(testCase.insertBeatsSheetData.resp, testCase.insertBeatsSheetData.err)
+			}
+
+			service := services.NewUpdateBeatService(source)
+
+			resp, err := service.UpdateBeat(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			// The original sheet is never altered.
+			require.Equal(t, "End Content", sourceSheet.Content[3].Content)
+			require.Equal(t, "Trial 2", sourceSheet.Content[2].Title)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type UpdateLoglineSource interface {
+	SelectLogline(ctx context.Context, data dao.SelectLoglineData) (*dao.LoglineEntity, error)
+	UpdateLogline(ctx context.Context, data dao.UpdateLoglineData) (*dao.LoglineEntity, error)
+	SelectSlugIteration(ctx context.Context, data dao.SelectSlugIterationData) (models.Slug, int, error)
+	SelectProject(ctx context.Context, data dao.SelectProjectData) (*dao.ProjectEntity, error)
+}
+
+func NewUpdateLoglineServiceSource(
+	selectLoglineDAO *dao.SelectLoglineRepository,
+	updateLoglineDAO *dao.UpdateLoglineRepository,
+	selectSlugIterationDAO *dao.SelectSlugIterationRepository,
+	selectProjectDAO *dao.SelectProjectRepository,
+) UpdateLoglineSource {
+	return &struct {
+		*dao.SelectLoglineRepository
+		*dao.UpdateLoglineRepository
+		*dao.SelectSlugIterationRepository
+		*dao.SelectProjectRepository
+	}{
+		SelectLoglineRepository:       selectLoglineDAO,
+		UpdateLoglineRepository:       updateLoglineDAO,
+		SelectSlugIterationRepository: selectSlugIterationDAO,
+		SelectProjectRepository:       selectProjectDAO,
+	}
+}
+
+// UpdateLoglineRequest edits an existing logline in place. Omitted fields keep their current value.
+type UpdateLoglineRequest struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// Optional. If the new slug is already used by another logline of the user, a version number is appended to it.
+	Slug    *models.Slug
+	Name    *string
+	Content *string
+	Lang    *models.Lang
+	// Optional. Set to an empty genre to remove the current one.
+	Genre *models.Genre
+	// Optional. Replaces every tag of the logline, so an empty list removes them all.
+	Tags []string
+	// Optional. Moves the logline to another project of the user. Set to uuid.Nil to take the logline out of its
+	// current project.
+	ProjectID *uuid.UUID
+}
+
+type UpdateLoglineService struct {
+	source UpdateLoglineSource
+}
+
+func NewUpdateLoglineService(source UpdateLoglineSource) *UpdateLoglineService {
+	return &UpdateLoglineService{source: source}
+}
+
+func (service *UpdateLoglineService) UpdateLogline(
+	ctx context.Context, request UpdateLoglineRequest,
+) (*models.Logline, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.UpdateLogline")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.id", request.ID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.slug", lo.FromPtr(request.Slug).String()),
+		attribute.String("request.name", lo.FromPtr(request.Name)),
+		attribute.String("request.lang", lo.FromPtr(request.Lang).String()),
+		attribute.String("request.genre", lo.FromPtr(request.Genre).String()),
+		attribute.StringSlice("request.tags", request.Tags),
+		attribute.String("request.projectID", lo.FromPtr(request.ProjectID).String()),
+		attribute.Bool("slug.taken", false),
+	)
+
+	logline, err := service.source.SelectLogline(ctx, dao.SelectLoglineData{
+		ID:     request.ID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select logline: %w", err))
+	}
+
+	data := dao.UpdateLoglineData{
+		ID:        logline.ID,
+		UserID:    logline.UserID,
+		Slug:      lo.FromPtrOr(request.Slug, logline.Slug),
+		Name:      lo.FromPtrOr(request.Name, logline.Name),
+		Content:   lo.FromPtrOr(request.Content, logline.Content),
+		Lang:      lo.FromPtrOr(request.Lang, logline.Lang),
+		Genre:     lo.FromPtrOr(request.Genre, logline.Genre),
+		Tags:      logline.Tags,
+		ProjectID: lo.FromPtrOr(request.ProjectID, logline.ProjectID),
+		Now:       time.Now(),
+	}
+
+	if request.Tags != nil {
+		data.Tags, err = models.NormalizeTags(request.Tags)
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("normalize tags: %w", err))
+		}
+	}
+
+	// Only check the ownership of a project the logline is moved to.
+	if data.ProjectID != uuid.Nil && data.ProjectID != logline.ProjectID {
+		_, err = service.source.SelectProject(ctx, dao.SelectProjectData{
+			ID:     data.ProjectID,
+			UserID: request.UserID,
+		})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("select project: %w", err))
+		}
+	}
+
+	err = storyplanmodel.CheckLang(data.Lang)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check lang: %w", err))
+	}
+
+	err = models.CheckGenre(data.Genre)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check genre: %w", err))
+	}
+
+	detection, err := checkContentLang(data.Lang, data.Name+"\n\n"+data.Content)
+
+	span.SetAttributes(
+		attribute.String("detection.lang", detection.Lang.String()),
+		attribute.Float64("detection.confidence", detection.Confidence),
+	)
+
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check content lang: %w", err))
+	}
+
+	resp, err := service.source.UpdateLogline(ctx, data)
+
+	// If the new slug is taken, try to modify it by appending a version number.
+	if errors.Is(err, dao.ErrLoglineAlreadyExists) && data.Slug != logline.Slug {
+		span.SetAttributes(attribute.Bool("slug.taken", true))
+
+		data.Slug, _, err = service.source.SelectSlugIteration(ctx, dao.SelectSlugIterationData{
+			Slug:   data.Slug,
+			Target: dao.SlugIterationTargetLogline,
+			Args:   []any{data.UserID},
+		})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("check slug uniqueness: %w", err))
+		}
+
+		resp, err = service.source.UpdateLogline(ctx, data)
+	}
+
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("update logline: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &models.Logline{
+		ID:        resp.ID,
+		UserID:    resp.UserID,
+		Slug:      resp.Slug,
+		SourceID:  resp.SourceID,
+		ProjectID: resp.ProjectID,
+		Name:      resp.Name,
+		Content:   resp.Content,
+		Lang:      resp.Lang,
+		Genre:     resp.Genre,
+		Tags:      resp.Tags,
+		CreatedAt: resp.CreatedAt,
+		UpdatedAt: resp.UpdatedAt,
+	}), nil
+}
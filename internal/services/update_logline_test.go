@@ -0,0 +1,486 @@
+package services_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestUpdateLogline(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	type updateLoglineData struct {
+		resp *dao.LoglineEntity
+		err  error
+	}
+
+	type selectSlugIterationData struct {
+		slug      models.Slug
+		iteration int
+		err       error
+	}
+
+	type selectProjectData struct {
+		resp *dao.ProjectEntity
+		err  error
+	}
+
+	currentLogline := &dao.LoglineEntity{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Slug:      "test-slug",
+		Name:      "Test Logline",
+		Content:   "Once upon a tme",
+		Lang:      models.LangEN,
+		Genre:     models.GenreDrama,
+		Tags:      []string{"family"},
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	updatedEntity := func(data dao.UpdateLoglineData) *dao.LoglineEntity {
+		return &dao.LoglineEntity{
+			ID:        data.ID,
+			UserID:    data.UserID,
+			ProjectID: data.ProjectID,
+			Slug:      data.Slug,
+			Name:      data.Name,
+			Content:   data.Content,
+			Lang:      data.Lang,
+			Genre:     data.Genre,
+			Tags:      data.Tags,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	updatedModel := func(data dao.UpdateLoglineData) *models.Logline {
+		return &models.Logline{
+			ID:        data.ID,
+			UserID:    data.UserID,
+			ProjectID: data.ProjectID,
+			Slug:      data.Slug,
+			Name:      data.Name,
+			Content:   data.Content,
+			Lang:      data.Lang,
+			Genre:     data.Genre,
+			Tags:      data.Tags,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	fixedContent := dao.UpdateLoglineData{
+		ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Slug:    "test-slug",
+		Name:    "Test Logline",
+		Content: "Once upon a time",
+		Lang:    models.LangEN,
+		Genre:   models.GenreDrama,
+		Tags:    []string{"family"},
+	}
+
+	renamed := dao.UpdateLoglineData{
+		ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Slug:    "new-slug",
+		Name:    "New Logline",
+		Content: "Once upon a tme",
+		Lang:    models.LangEN,
+		Genre:   models.GenreDrama,
+		Tags:    []string{"family"},
+	}
+
+	retagged := dao.UpdateLoglineData{
+		ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Slug:    "test-slug",
+		Name:    "Test Logline",
+		Content: "Once upon a tme",
+		Lang:    models.LangEN,
+		Tags:    []string{"revenge", "sea"},
+	}
+
+	loglineInProject := *currentLogline
+	loglineInProject.ProjectID = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+
+	moved := dao.UpdateLoglineData{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Slug:      "test-slug",
+		Name:      "Test Logline",
+		Content:   "Once upon a tme",
+		Lang:      models.LangEN,
+		Genre:     models.GenreDrama,
+		Tags:      []string{"family"},
+	}
+
+	unassigned := moved
+	unassigned.ProjectID = uuid.Nil
+
+	renamedIteration := renamed
+	renamedIteration.Slug = "new-slug-2"
+
+	testCases := []struct {
+		name string
+
+		request services.UpdateLoglineRequest
+
+		selectLoglineData       *selectLoglineData
+		selectProjectData       *selectProjectData
+		updateLoglineData       *updateLoglineData
+		selectSlugIterationData *selectSlugIterationData
+		reupdateLoglineData     *updateLoglineData
+
+		expectUpdate   dao.UpdateLoglineData
+		expectReupdate dao.UpdateLoglineData
+
+		expect    *models.Logline
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.UpdateLoglineRequest{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Content: lo.ToPtr("Once upon a time"),
+			},
+
+			selectLoglineData: &selectLoglineData{resp: currentLogline},
+			updateLoglineData: &updateLoglineData{resp: updatedEntity(fixedContent)},
+
+			expectUpdate: fixedContent,
+
+			expect: updatedModel(fixedContent),
+		},
+		{
+			name: "Rename",
+
+			request: services.UpdateLoglineRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:   lo.ToPtr(models.Slug("new-slug")),
+				Name:   lo.ToPtr("New Logline"),
+			},
+
+			selectLoglineData: &selectLoglineData{resp: currentLogline},
+			updateLoglineData: &updateLoglineData{resp: updatedEntity(renamed)},
+
+			expectUpdate: renamed,
+
+			expect: updatedModel(renamed),
+		},
+		{
+			name: "Retag",
+
+			request: services.UpdateLoglineRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Genre:  lo.ToPtr(models.Genre("")),
+				Tags:   []string{"Sea", "revenge", "sea"},
+			},
+
+			selectLoglineData: &selectLoglineData{resp: currentLogline},
+			updateLoglineData: &updateLoglineData{resp: updatedEntity(retagged)},
+
+			expectUpdate: retagged,
+
+			expect: updatedModel(retagged),
+		},
+		{
+			name: "MoveToProject",
+
+			request: services.UpdateLoglineRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				ProjectID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+			},
+
+			selectLoglineData: &selectLoglineData{resp: &loglineInProject},
+			selectProjectData: &selectProjectData{
+				resp: &dao.ProjectEntity{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+					Name:   "Test Project",
+				},
+			},
+			updateLoglineData: &updateLoglineData{resp: updatedEntity(moved)},
+
+			expectUpdate: moved,
+
+			expect: updatedModel(moved),
+		},
+		{
+			name: "RemoveFromProject",
+
+			request: services.UpdateLoglineRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				ProjectID: lo.ToPtr(uuid.Nil),
+			},
+
+			selectLoglineData: &selectLoglineData{resp: &loglineInProject},
+			updateLoglineData: &updateLoglineData{resp: updatedEntity(unassigned)},
+
+			expectUpdate: unassigned,
+
+			expect: updatedModel(unassigned),
+		},
+		{
+			name: "ProjectNotFound",
+
+			request: services.UpdateLoglineRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				ProjectID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+			},
+
+			selectLoglineData: &selectLoglineData{resp: currentLogline},
+			selectProjectData: &selectProjectData{err: dao.ErrProjectNotFound},
+
+			expectErr: dao.ErrProjectNotFound,
+		},
+		{
+			name: "RenameRetrySlug",
+
+			request: services.UpdateLoglineRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:   lo.ToPtr(models.Slug("new-slug")),
+				Name:   lo.ToPtr("New Logline"),
+			},
+
+			selectLoglineData: &selectLoglineData{resp: currentLogline},
+			updateLoglineData: &updateLoglineData{err: dao.ErrLoglineAlreadyExists},
+			selectSlugIterationData: &selectSlugIterationData{
+				slug:      "new-slug-2",
+				iteration: 2,
+			},
+			reupdateLoglineData: &updateLoglineData{resp: updatedEntity(renamedIteration)},
+
+			expectUpdate:   renamed,
+			expectReupdate: renamedIteration,
+
+			expect: updatedModel(renamedIteration),
+		},
+		{
+			name: "RenameSlugIterationError",
+
+			request: services.UpdateLoglineRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Slug:   lo.ToPtr(models.Slug("new-slug")),
+				Name:   lo.ToPtr("New Logline"),
+			},
+
+			selectLoglineData:       &selectLoglineData{resp: currentLogline},
+			updateLoglineData:       &updateLoglineData{err: dao.ErrLoglineAlreadyExists},
+			selectSlugIterationData: &selectSlugIterationData{err: errFoo},
+
+			expectUpdate: renamed,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "SameSlugConflict",
+
+			request: services.UpdateLoglineRequest{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Content: lo.ToPtr("Once upon a time"),
+			},
+
+			selectLoglineData: &selectLoglineData{resp: currentLogline},
+			updateLoglineData: &updateLoglineData{err: dao.ErrLoglineAlreadyExists},
+
+			expectUpdate: fixedContent,
+
+			expectErr: dao.ErrLoglineAlreadyExists,
+		},
+		{
+			name: "UnsupportedLang",
+
+			request: services.UpdateLoglineRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Lang:   lo.ToPtr(models.Lang("xx")),
+			},
+
+			selectLoglineData: &selectLoglineData{resp: currentLogline},
+
+			expectErr: storyplanmodel.ErrUnsupportedLang,
+		},
+		{
+			name: "UnsupportedGenre",
+
+			request: services.UpdateLoglineRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Genre:  lo.ToPtr(models.Genre("space-western")),
+			},
+
+			selectLoglineData: &selectLoglineData{resp: currentLogline},
+
+			expectErr: models.ErrUnsupportedGenre,
+		},
+		{
+			name: "InvalidTags",
+
+			request: services.UpdateLoglineRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Tags:   []string{strings.Repeat("a", models.MaxTagLength+1)},
+			},
+
+			selectLoglineData: &selectLoglineData{resp: currentLogline},
+
+			expectErr: models.ErrInvalidTags,
+		},
+		{
+			name: "ContentLangMismatch",
+
+			request: services.UpdateLoglineRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Name:   lo.ToPtr("La vengeance du marin"),
+				Content: lo.ToPtr(
+					"Un vieux marin, trahi par son équipage, doit traverser la mer avec sa fille pour retrouver le " +
+						"trésor qui lui a été volé, avant que les pirates ne le trouvent dans la nuit.",
+				),
+			},
+
+			selectLoglineData: &selectLoglineData{resp: currentLogline},
+
+			expectErr: services.ErrContentLangMismatch,
+		},
+		{
+			name: "SelectLoglineError",
+
+			request: services.UpdateLoglineRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+			},
+
+			selectLoglineData: &selectLoglineData{err: dao.ErrLoglineNotFound},
+
+			expectErr: dao.ErrLoglineNotFound,
+		},
+		{
+			name: "UpdateError",
+
+			request: services.UpdateLoglineRequest{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:  uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Content: lo.ToPtr("Once upon a time"),
+			},
+
+			selectLoglineData: &selectLoglineData{resp: currentLogline},
+			updateLoglineData: &updateLoglineData{err: errFoo},
+
+			expectUpdate: fixedContent,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockUpdateLoglineSource(t)
+
+			if testCase.selectLoglineData != nil {
+				source.EXPECT().
+					SelectLogline(mock.Anything, dao.SelectLoglineData{
+						ID:     testCase.request.ID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectLoglineData.resp, testCase.selectLoglineData.err)
+			}
+
+			if testCase.selectProjectData != nil {
+				source.EXPECT().
+					SelectProject(mock.Anything, dao.SelectProjectData{
+						ID:     lo.FromPtr(testCase.request.ProjectID),
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectProjectData.resp, testCase.selectProjectData.err)
+			}
+
+			matchUpdate := func(expect dao.UpdateLoglineData) func(data dao.UpdateLoglineData) bool {
+				return func(data dao.UpdateLoglineData) bool {
+					return assert.Equal(t, expect.ID, data.ID) &&
+						assert.Equal(t, expect.UserID, data.UserID) &&
+						expect.Slug == data.Slug &&
+						assert.Equal(t, expect.Name, data.Name) &&
+						assert.Equal(t, expect.Content, data.Content) &&
+						assert.Equal(t, expect.Lang, data.Lang) &&
+						assert.Equal(t, expect.Genre, data.Genre) &&
+						assert.Equal(t, expect.Tags, data.Tags) &&
+						assert.Equal(t, expect.ProjectID, data.ProjectID) &&
+						assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+				}
+			}
+
+			if testCase.updateLoglineData != nil {
+				initialCall := source.EXPECT().
+					UpdateLogline(mock.Anything, mock.MatchedBy(matchUpdate(testCase.expectUpdate))).
+					Return(testCase.updateLoglineData.resp, testCase.updateLoglineData.err).
+					Once()
+
+				if testCase.reupdateLoglineData != nil {
+					source.EXPECT().
+						UpdateLogline(mock.Anything, mock.MatchedBy(matchUpdate(testCase.expectReupdate))).
+						Return(testCase.reupdateLoglineData.resp, testCase.reupdateLoglineData.err).
+						NotBefore(initialCall)
+				}
+			}
+
+			if testCase.selectSlugIterationData != nil {
+				source.EXPECT().
+					SelectSlugIteration(mock.Anything, dao.SelectSlugIterationData{
+						Slug:   testCase.expectUpdate.Slug,
+						Target: dao.SlugIterationTargetLogline,
+						Args:   []any{testCase.request.UserID},
+					}).
+					Return(
+						testCase.selectSlugIterationData.slug,
+						testCase.selectSlugIterationData.iteration,
+						testCase.selectSlugIterationData.err,
+					)
+			}
+
+			service := services.NewUpdateLoglineService(source)
+
+			resp, err := service.UpdateLogline(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
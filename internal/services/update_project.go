@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+type UpdateProjectSource interface {
+	SelectProject(ctx context.Context, data dao.SelectProjectData) (*dao.ProjectEntity, error)
+	UpdateProject(ctx context.Context, data dao.UpdateProjectData) (*dao.ProjectEntity, error)
+}
+
+func NewUpdateProjectServiceSource(
+	selectProjectDAO *dao.SelectProjectRepository,
+	updateProjectDAO *dao.UpdateProjectRepository,
+) UpdateProjectSource {
+	return &struct {
+		*dao.SelectProjectRepository
+		*dao.UpdateProjectRepository
+	}{
+		SelectProjectRepository: selectProjectDAO,
+		UpdateProjectRepository: updateProjectDAO,
+	}
+}
+
+// UpdateProjectRequest edits an existing project. Omitted fields keep their current value.
+type UpdateProjectRequest struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Name        *string
+	Description *string
+}
+
+type UpdateProjectService struct {
+	source UpdateProjectSource
+}
+
+func NewUpdateProjectService(source UpdateProjectSource) *UpdateProjectService {
+	return &UpdateProjectService{source: source}
+}
+
+func (service *UpdateProjectService) UpdateProject(
+	ctx context.Context, request UpdateProjectRequest,
+) (*models.Project, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.UpdateProject")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.id", request.ID.String()),
+		attribute.String("request.userID", request.UserID.String()),
+		attribute.String("request.name", lo.FromPtr(request.Name)),
+	)
+
+	project, err := service.source.SelectProject(ctx, dao.SelectProjectData{
+		ID:     request.ID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select project: %w", err))
+	}
+
+	resp, err := service.source.UpdateProject(ctx, dao.UpdateProjectData{
+		ID:          project.ID,
+		UserID:      project.UserID,
+		Name:        lo.FromPtrOr(request.Name, project.Name),
+		Description: lo.FromPtrOr(request.Description, project.Description),
+		Now:         time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("update project: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &models.Project{
+		ID:          resp.ID,
+		UserID:      resp.UserID,
+		Name:        resp.Name,
+		Description: resp.Description,
+		CreatedAt:   resp.CreatedAt,
+		UpdatedAt:   resp.UpdatedAt,
+	}), nil
+}
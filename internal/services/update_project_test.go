@@ -0,0 +1,161 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestUpdateProject(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectProjectData struct {
+		resp *dao.ProjectEntity
+		err  error
+	}
+
+	type updateProjectData struct {
+		resp *dao.ProjectEntity
+		err  error
+	}
+
+	currentProject := &dao.ProjectEntity{
+		ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Name:        "The Sea Trilogy",
+		Description: "Three novels about the sea.",
+		CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	renamed := &dao.ProjectEntity{
+		ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Name:        "The Ocean Trilogy",
+		Description: "Three novels about the sea.",
+		CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	request := services.UpdateProjectRequest{
+		ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID: uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		Name:   lo.ToPtr("The Ocean Trilogy"),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.UpdateProjectRequest
+
+		selectProjectData *selectProjectData
+		updateProjectData *updateProjectData
+
+		expectUpdate dao.UpdateProjectData
+
+		expect    *models.Project
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			selectProjectData: &selectProjectData{resp: currentProject},
+			updateProjectData: &updateProjectData{resp: renamed},
+
+			expectUpdate: dao.UpdateProjectData{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Name:        "The Ocean Trilogy",
+				Description: "Three novels about the sea.",
+			},
+
+			expect: &models.Project{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Name:        "The Ocean Trilogy",
+				Description: "Three novels about the sea.",
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "NotFound",
+
+			request: request,
+
+			selectProjectData: &selectProjectData{err: dao.ErrProjectNotFound},
+
+			expectErr: dao.ErrProjectNotFound,
+		},
+		{
+			name: "UpdateError",
+
+			request: request,
+
+			selectProjectData: &selectProjectData{resp: currentProject},
+			updateProjectData: &updateProjectData{err: errFoo},
+
+			expectUpdate: dao.UpdateProjectData{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:      uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+				Name:        "The Ocean Trilogy",
+				Description: "Three novels about the sea.",
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockUpdateProjectSource(t)
+
+			if testCase.selectProjectData != nil {
+				source.EXPECT().
+					SelectProject(mock.Anything, dao.SelectProjectData{
+						ID:     testCase.request.ID,
+						UserID: testCase.request.UserID,
+					}).
+					Return(testCase.selectProjectData.resp, testCase.selectProjectData.err)
+			}
+
+			if testCase.updateProjectData != nil {
+				source.EXPECT().
+					UpdateProject(mock.Anything, mock.MatchedBy(func(data dao.UpdateProjectData) bool {
+						return assert.Equal(t, testCase.expectUpdate.ID, data.ID) &&
+							assert.Equal(t, testCase.expectUpdate.UserID, data.UserID) &&
+							assert.Equal(t, testCase.expectUpdate.Name, data.Name) &&
+							assert.Equal(t, testCase.expectUpdate.Description, data.Description) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(testCase.updateProjectData.resp, testCase.updateProjectData.err)
+			}
+
+			service := services.NewUpdateProjectService(source)
+
+			resp, err := service.UpdateProject(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type UpdateStoryPlanSource interface {
+	SelectStoryPlan(ctx context.Context, data dao.SelectStoryPlanData) (*dao.StoryPlanEntity, error)
+	ListStoryPlanTranslations(ctx context.Context, data dao.ListStoryPlanTranslationsData) ([]*dao.StoryPlanEntity, error)
+	UpdateStoryPlan(ctx context.Context, data dao.UpdateStoryPlanData) (*dao.StoryPlanEntity, error)
+}
+
+func NewUpdateStoryPlanServiceSource(
+	selectStoryPlanDAO *dao.SelectStoryPlanRepository,
+	listStoryPlanTranslationsDAO *dao.ListStoryPlanTranslationsRepository,
+	updateStoryPlanDAO *dao.UpdateStoryPlanRepository,
+) UpdateStoryPlanSource {
+	return &struct {
+		*dao.SelectStoryPlanRepository
+		*dao.ListStoryPlanTranslationsRepository
+		*dao.UpdateStoryPlanRepository
+	}{
+		SelectStoryPlanRepository:           selectStoryPlanDAO,
+		ListStoryPlanTranslationsRepository: listStoryPlanTranslationsDAO,
+		UpdateStoryPlanRepository:           updateStoryPlanDAO,
+	}
+}
+
+// UpdateStoryPlanRequest creates a new version of an existing story plan. ID may point to any version of the plan;
+// previous versions are kept, so beats sheets built against them remain valid.
+type UpdateStoryPlanRequest struct {
+	ID uuid.UUID
+	// UserID must match the owner of a custom plan. Leave it nil to update a built-in plan.
+	UserID *uuid.UUID
+	Name   string
+	Acts   []storyplanmodel.Act
+	Beats  []storyplanmodel.Beat
+}
+
+type UpdateStoryPlanService struct {
+	source UpdateStoryPlanSource
+}
+
+func NewUpdateStoryPlanService(source UpdateStoryPlanSource) *UpdateStoryPlanService {
+	return &UpdateStoryPlanService{source: source}
+}
+
+func (service *UpdateStoryPlanService) UpdateStoryPlan(
+	ctx context.Context, request UpdateStoryPlanRequest,
+) (*storyplanmodel.Plan, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.UpdateStoryPlan")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.id", request.ID.String()),
+		attribute.String("request.userID", lo.FromPtr(request.UserID).String()),
+		attribute.String("request.name", request.Name),
+		attribute.Int("request.acts.count", len(request.Acts)),
+		attribute.Int("request.beats.count", len(request.Beats)),
+	)
+
+	current, err := service.source.SelectStoryPlan(ctx, dao.SelectStoryPlanData{
+		ID:     request.ID,
+		UserID: lo.FromPtr(request.UserID),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select story plan: %w", err))
+	}
+
+	// Built-in plans are visible to every user, but can only be updated as such.
+	if lo.FromPtr(current.UserID) != lo.FromPtr(request.UserID) {
+		return nil, otel.ReportError(span, fmt.Errorf("select story plan: %w", dao.ErrStoryPlanNotFound))
+	}
+
+	plan := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{Slug: current.Slug, Lang: current.Lang},
+		Acts:     request.Acts,
+		Beats:    request.Beats,
+	}
+
+	err = plan.Lint()
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("lint plan: %w", err))
+	}
+
+	translations, err := service.source.ListStoryPlanTranslations(ctx, dao.ListStoryPlanTranslationsData{
+		UserID: request.UserID,
+		Slug:   current.Slug,
+		Lang:   current.Lang,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list story plan translations: %w", err))
+	}
+
+	err = lintStoryPlanTranslations(plan, translations)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("lint plan translations: %w", err))
+	}
+
+	resp, err := service.source.UpdateStoryPlan(ctx, dao.UpdateStoryPlanData{
+		ID:     request.ID,
+		NewID:  uuid.New(),
+		UserID: request.UserID,
+		Name:   request.Name,
+		Acts:   request.Acts,
+		Beats:  request.Beats,
+		Now:    time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("update story plan: %w", err))
+	}
+
+	return otel.ReportSuccess(span, storyPlanEntityToModel(resp)), nil
+}
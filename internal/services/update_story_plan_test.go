@@ -0,0 +1,382 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestUpdateStoryPlan(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectStoryPlanData struct {
+		resp *dao.StoryPlanEntity
+		err  error
+	}
+
+	type listStoryPlanTranslationsData struct {
+		resp []*dao.StoryPlanEntity
+		err  error
+	}
+
+	type updateStoryPlanData struct {
+		resp *dao.StoryPlanEntity
+		err  error
+	}
+
+	current := &dao.StoryPlanEntity{
+		ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Slug:    "test-slug",
+		Version: 1,
+		Name:    "Test Name",
+		Lang:    models.LangEN,
+		Beats: []storyplanmodel.Beat{
+			{
+				Name:      "Test Beat",
+				Key:       "test-beat",
+				KeyPoints: []string{"Test Key Point"},
+				Purpose:   "Test Purpose",
+			},
+		},
+		CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	translation := &dao.StoryPlanEntity{
+		ID:      uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		Slug:    "test-slug",
+		Version: 1,
+		Name:    "Nom de Test",
+		Lang:    models.LangFR,
+		Beats: []storyplanmodel.Beat{
+			{
+				Name:      "Battement de Test",
+				Key:       "test-beat",
+				KeyPoints: []string{"Point Clé de Test"},
+				Purpose:   "Objectif de Test",
+			},
+		},
+		CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.UpdateStoryPlanRequest
+
+		selectStoryPlanData           *selectStoryPlanData
+		listStoryPlanTranslationsData *listStoryPlanTranslationsData
+		updateStoryPlanData           *updateStoryPlanData
+
+		expect    *storyplanmodel.Plan
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.UpdateStoryPlanRequest{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name: "Test Name Updated",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{resp: current},
+			listStoryPlanTranslationsData: &listStoryPlanTranslationsData{
+				resp: []*dao.StoryPlanEntity{translation},
+			},
+			updateStoryPlanData: &updateStoryPlanData{
+				resp: &dao.StoryPlanEntity{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Slug:    "test-slug",
+					Version: 2,
+					Name:    "Test Name Updated",
+					Lang:    models.LangEN,
+					Beats: []storyplanmodel.Beat{
+						{
+							Name:      "Test Beat",
+							Key:       "test-beat",
+							KeyPoints: []string{"Test Key Point"},
+							Purpose:   "Test Purpose",
+						},
+					},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &storyplanmodel.Plan{
+				Metadata: storyplanmodel.Metadata{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Slug:      "test-slug",
+					Version:   2,
+					Name:      "Test Name Updated",
+					Lang:      models.LangEN,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+		},
+		{
+			name: "TranslationMismatch",
+
+			request: services.UpdateStoryPlanRequest{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name: "Test Name Updated",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "other-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{resp: current},
+			listStoryPlanTranslationsData: &listStoryPlanTranslationsData{
+				resp: []*dao.StoryPlanEntity{translation},
+			},
+
+			expectErr: storyplanmodel.ErrTranslationMismatch,
+		},
+		{
+			name: "NotFound",
+
+			request: services.UpdateStoryPlanRequest{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name: "Test Name Updated",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{err: dao.ErrStoryPlanNotFound},
+
+			expectErr: dao.ErrStoryPlanNotFound,
+		},
+		{
+			name: "BuiltInPlan",
+
+			request: services.UpdateStoryPlanRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-1000-000000000001")),
+				Name:   "Test Name Updated",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{resp: current},
+
+			expectErr: dao.ErrStoryPlanNotFound,
+		},
+		{
+			name: "ListTranslationsError",
+
+			request: services.UpdateStoryPlanRequest{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name: "Test Name Updated",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{resp: current},
+			listStoryPlanTranslationsData: &listStoryPlanTranslationsData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "InvalidActs",
+
+			request: services.UpdateStoryPlanRequest{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name: "Test Name Updated",
+				Acts: []storyplanmodel.Act{
+					{Name: "Act 1", Key: "act-1", Purpose: "Test Purpose"},
+				},
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Act:       "act-2",
+					},
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{resp: current},
+
+			expectErr: storyplanmodel.ErrUnknownAct,
+		},
+		{
+			name: "InvalidScenes",
+
+			request: services.UpdateStoryPlanRequest{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name: "Test Name Updated",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+						Scenes:    storyplanmodel.Scenes{Min: lo.ToPtr(3), Max: lo.ToPtr(2)},
+					},
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{resp: current},
+
+			expectErr: storyplanmodel.ErrInvalidScenes,
+		},
+		{
+			name: "DuplicateBeat",
+
+			request: services.UpdateStoryPlanRequest{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name: "Test Name Updated",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{resp: current},
+
+			expectErr: storyplanmodel.ErrDuplicateBeat,
+		},
+		{
+			name: "Error",
+
+			request: services.UpdateStoryPlanRequest{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name: "Test Name Updated",
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Test Beat",
+						Key:       "test-beat",
+						KeyPoints: []string{"Test Key Point"},
+						Purpose:   "Test Purpose",
+					},
+				},
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{resp: current},
+			listStoryPlanTranslationsData: &listStoryPlanTranslationsData{
+				resp: []*dao.StoryPlanEntity{},
+			},
+			updateStoryPlanData: &updateStoryPlanData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockUpdateStoryPlanSource(t)
+
+			if testCase.selectStoryPlanData != nil {
+				source.EXPECT().
+					SelectStoryPlan(mock.Anything, dao.SelectStoryPlanData{
+						ID:     testCase.request.ID,
+						UserID: lo.FromPtr(testCase.request.UserID),
+					}).
+					Return(testCase.selectStoryPlanData.resp, testCase.selectStoryPlanData.err)
+			}
+
+			if testCase.listStoryPlanTranslationsData != nil {
+				source.EXPECT().
+					ListStoryPlanTranslations(mock.Anything, dao.ListStoryPlanTranslationsData{
+						UserID: testCase.request.UserID,
+						Slug:   current.Slug,
+						Lang:   current.Lang,
+					}).
+					Return(testCase.listStoryPlanTranslationsData.resp, testCase.listStoryPlanTranslationsData.err)
+			}
+
+			if testCase.updateStoryPlanData != nil {
+				source.EXPECT().
+					UpdateStoryPlan(mock.Anything, mock.MatchedBy(func(data dao.UpdateStoryPlanData) bool {
+						return assert.Equal(t, testCase.request.ID, data.ID) &&
+							assert.NotEqual(t, uuid.Nil, data.NewID) &&
+							assert.NotEqual(t, testCase.request.ID, data.NewID) &&
+							assert.Equal(t, testCase.request.Name, data.Name) &&
+							assert.Equal(t, testCase.request.UserID, data.UserID) &&
+							assert.Equal(t, testCase.request.Acts, data.Acts) &&
+							assert.Equal(t, testCase.request.Beats, data.Beats) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(testCase.updateStoryPlanData.resp, testCase.updateStoryPlanData.err)
+			}
+
+			service := services.NewUpdateStoryPlanService(source)
+
+			resp, err := service.UpdateStoryPlan(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
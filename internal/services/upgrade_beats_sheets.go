@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/golib/otel"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+type UpgradeBeatsSheetsSource interface {
+	ListOutdatedBeatsSheets(ctx context.Context, data dao.ListOutdatedBeatsSheetsData) ([]*dao.BeatsSheetEntity, error)
+	SelectStoryPlan(ctx context.Context, request SelectStoryPlanRequest) (*storyplanmodel.Plan, error)
+	UpdateBeatsSheetStoryPlan(
+		ctx context.Context, data dao.UpdateBeatsSheetStoryPlanData,
+	) (*dao.BeatsSheetEntity, error)
+}
+
+func NewUpgradeBeatsSheetsServiceSource(
+	listOutdatedBeatsSheetsDAO *dao.ListOutdatedBeatsSheetsRepository,
+	selectStoryPlan *SelectStoryPlanService,
+	updateBeatsSheetStoryPlanDAO *dao.UpdateBeatsSheetStoryPlanRepository,
+) UpgradeBeatsSheetsSource {
+	return &struct {
+		*dao.ListOutdatedBeatsSheetsRepository
+		*SelectStoryPlanService
+		*dao.UpdateBeatsSheetStoryPlanRepository
+	}{
+		ListOutdatedBeatsSheetsRepository:   listOutdatedBeatsSheetsDAO,
+		SelectStoryPlanService:              selectStoryPlan,
+		UpdateBeatsSheetStoryPlanRepository: updateBeatsSheetStoryPlanDAO,
+	}
+}
+
+// UpgradeBeatsSheetsRequest targets a version of a story plan. Every beats sheet pinned to an older version of the
+// same plan is checked against it.
+type UpgradeBeatsSheetsRequest struct {
+	StoryPlanID uuid.UUID
+	// UserID restricts the upgrade to the beats sheets of the user, who may then target their own custom plans.
+	// Leave it nil to upgrade the sheets of every user; this is reserved to admins.
+	UserID *uuid.UUID
+	// When DryRun is set, sheets are only reported, and never upgraded.
+	DryRun bool
+}
+
+type UpgradeBeatsSheetsService struct {
+	source UpgradeBeatsSheetsSource
+}
+
+func NewUpgradeBeatsSheetsService(source UpgradeBeatsSheetsSource) *UpgradeBeatsSheetsService {
+	return &UpgradeBeatsSheetsService{source: source}
+}
+
+// UpgradeBeatsSheets reports the beats sheets that lag behind the target story plan version. Sheets whose content
+// validates against the target version are pinned to it, unless the request is a dry run. Incompatible sheets are
+// left untouched, and must be regenerated manually.
+func (service *UpgradeBeatsSheetsService) UpgradeBeatsSheets(
+	ctx context.Context, request UpgradeBeatsSheetsRequest,
+) ([]*models.BeatsSheetUpgrade, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.UpgradeBeatsSheets")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.storyPlanID", request.StoryPlanID.String()),
+		attribute.String("request.userID", lo.FromPtr(request.UserID).String()),
+		attribute.Bool("request.dryRun", request.DryRun),
+	)
+
+	storyPlan, err := service.source.SelectStoryPlan(ctx, SelectStoryPlanRequest{
+		ID:     &request.StoryPlanID,
+		UserID: lo.FromPtr(request.UserID),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select story plan: %w", err))
+	}
+
+	beatsSheets, err := service.source.ListOutdatedBeatsSheets(ctx, dao.ListOutdatedBeatsSheetsData{
+		StoryPlanID: request.StoryPlanID,
+		UserID:      lo.FromPtr(request.UserID),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list outdated beats sheets: %w", err))
+	}
+
+	output := make([]*models.BeatsSheetUpgrade, 0, len(beatsSheets))
+
+	var upgraded int
+
+	for _, beatsSheet := range beatsSheets {
+		report := &models.BeatsSheetUpgrade{
+			BeatsSheetID:    beatsSheet.ID,
+			LoglineID:       beatsSheet.LoglineID,
+			FromStoryPlanID: beatsSheet.StoryPlanID,
+			ToStoryPlanID:   storyPlan.Metadata.ID,
+		}
+
+		output = append(output, report)
+
+		err = storyPlan.Validate(beatsSheet.Content)
+		if err != nil {
+			report.Reason = err.Error()
+
+			continue
+		}
+
+		report.Compatible = true
+
+		if request.DryRun {
+			continue
+		}
+
+		_, err = service.source.UpdateBeatsSheetStoryPlan(ctx, dao.UpdateBeatsSheetStoryPlanData{
+			ID:          beatsSheet.ID,
+			StoryPlanID: storyPlan.Metadata.ID,
+		})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("upgrade beats sheet %s: %w", beatsSheet.ID, err))
+		}
+
+		report.Upgraded = true
+		upgraded++
+	}
+
+	span.SetAttributes(
+		attribute.Int("outdated.count", len(output)),
+		attribute.Int("upgraded.count", upgraded),
+	)
+
+	return otel.ReportSuccess(span, output), nil
+}
@@ -0,0 +1,310 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/dao"
+	"github.com/a-novel/service-story-schematics/internal/services"
+	servicesmocks "github.com/a-novel/service-story-schematics/internal/services/mocks"
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestUpgradeBeatsSheets(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type selectStoryPlanData struct {
+		resp *storyplanmodel.Plan
+		err  error
+	}
+
+	type listOutdatedBeatsSheetsData struct {
+		resp []*dao.BeatsSheetEntity
+		err  error
+	}
+
+	type updateBeatsSheetStoryPlanData struct {
+		resp *dao.BeatsSheetEntity
+		err  error
+	}
+
+	storyPlan := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-100000000002"),
+			Slug:    "test-plan",
+			Version: 2,
+			Name:    "Test Plan",
+			Lang:    models.LangEN,
+		},
+		Beats: []storyplanmodel.Beat{
+			{Name: "Beat 1", Key: "beat-1", KeyPoints: []string{"Key point 1"}, Purpose: "Purpose 1"},
+			{Name: "Beat 2", Key: "beat-2", KeyPoints: []string{"Key point 2"}, Purpose: "Purpose 2"},
+		},
+	}
+
+	compatibleSheet := &dao.BeatsSheetEntity{
+		ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000001"),
+		StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+		Content: []models.Beat{
+			{Key: "beat-1", Title: "Beat 1", Content: "Content 1"},
+			{Key: "beat-2", Title: "Beat 2", Content: "Content 2"},
+		},
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	incompatibleSheet := &dao.BeatsSheetEntity{
+		ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		LoglineID:   uuid.MustParse("00000000-0000-0000-1000-000000000002"),
+		StoryPlanID: uuid.MustParse("00000000-0000-0000-0000-100000000001"),
+		Content: []models.Beat{
+			{Key: "beat-1", Title: "Beat 1", Content: "Content 1"},
+		},
+		Lang:      models.LangEN,
+		CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		request services.UpgradeBeatsSheetsRequest
+
+		selectStoryPlanData           *selectStoryPlanData
+		listOutdatedBeatsSheetsData   *listOutdatedBeatsSheetsData
+		updateBeatsSheetStoryPlanData map[uuid.UUID]*updateBeatsSheetStoryPlanData
+
+		expect    []*models.BeatsSheetUpgrade
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: services.UpgradeBeatsSheetsRequest{
+				StoryPlanID: storyPlan.Metadata.ID,
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: storyPlan,
+			},
+
+			listOutdatedBeatsSheetsData: &listOutdatedBeatsSheetsData{
+				resp: []*dao.BeatsSheetEntity{compatibleSheet, incompatibleSheet},
+			},
+
+			updateBeatsSheetStoryPlanData: map[uuid.UUID]*updateBeatsSheetStoryPlanData{
+				compatibleSheet.ID: {
+					resp: compatibleSheet,
+				},
+			},
+
+			expect: []*models.BeatsSheetUpgrade{
+				{
+					BeatsSheetID:    compatibleSheet.ID,
+					LoglineID:       compatibleSheet.LoglineID,
+					FromStoryPlanID: compatibleSheet.StoryPlanID,
+					ToStoryPlanID:   storyPlan.Metadata.ID,
+					Compatible:      true,
+					Upgraded:        true,
+				},
+				{
+					BeatsSheetID:    incompatibleSheet.ID,
+					LoglineID:       incompatibleSheet.LoglineID,
+					FromStoryPlanID: incompatibleSheet.StoryPlanID,
+					ToStoryPlanID:   storyPlan.Metadata.ID,
+					Reason:          storyPlan.Validate(incompatibleSheet.Content).Error(),
+				},
+			},
+		},
+		{
+			name: "User",
+
+			request: services.UpgradeBeatsSheetsRequest{
+				StoryPlanID: storyPlan.Metadata.ID,
+				UserID:      lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000010")),
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: storyPlan,
+			},
+
+			listOutdatedBeatsSheetsData: &listOutdatedBeatsSheetsData{
+				resp: []*dao.BeatsSheetEntity{compatibleSheet},
+			},
+
+			updateBeatsSheetStoryPlanData: map[uuid.UUID]*updateBeatsSheetStoryPlanData{
+				compatibleSheet.ID: {
+					resp: compatibleSheet,
+				},
+			},
+
+			expect: []*models.BeatsSheetUpgrade{
+				{
+					BeatsSheetID:    compatibleSheet.ID,
+					LoglineID:       compatibleSheet.LoglineID,
+					FromStoryPlanID: compatibleSheet.StoryPlanID,
+					ToStoryPlanID:   storyPlan.Metadata.ID,
+					Compatible:      true,
+					Upgraded:        true,
+				},
+			},
+		},
+		{
+			name: "DryRun",
+
+			request: services.UpgradeBeatsSheetsRequest{
+				StoryPlanID: storyPlan.Metadata.ID,
+				DryRun:      true,
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: storyPlan,
+			},
+
+			listOutdatedBeatsSheetsData: &listOutdatedBeatsSheetsData{
+				resp: []*dao.BeatsSheetEntity{compatibleSheet, incompatibleSheet},
+			},
+
+			expect: []*models.BeatsSheetUpgrade{
+				{
+					BeatsSheetID:    compatibleSheet.ID,
+					LoglineID:       compatibleSheet.LoglineID,
+					FromStoryPlanID: compatibleSheet.StoryPlanID,
+					ToStoryPlanID:   storyPlan.Metadata.ID,
+					Compatible:      true,
+				},
+				{
+					BeatsSheetID:    incompatibleSheet.ID,
+					LoglineID:       incompatibleSheet.LoglineID,
+					FromStoryPlanID: incompatibleSheet.StoryPlanID,
+					ToStoryPlanID:   storyPlan.Metadata.ID,
+					Reason:          storyPlan.Validate(incompatibleSheet.Content).Error(),
+				},
+			},
+		},
+		{
+			name: "NoOutdatedSheets",
+
+			request: services.UpgradeBeatsSheetsRequest{
+				StoryPlanID: storyPlan.Metadata.ID,
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: storyPlan,
+			},
+
+			listOutdatedBeatsSheetsData: &listOutdatedBeatsSheetsData{
+				resp: []*dao.BeatsSheetEntity{},
+			},
+
+			expect: []*models.BeatsSheetUpgrade{},
+		},
+		{
+			name: "SelectStoryPlanError",
+
+			request: services.UpgradeBeatsSheetsRequest{
+				StoryPlanID: storyPlan.Metadata.ID,
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "ListOutdatedBeatsSheetsError",
+
+			request: services.UpgradeBeatsSheetsRequest{
+				StoryPlanID: storyPlan.Metadata.ID,
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: storyPlan,
+			},
+
+			listOutdatedBeatsSheetsData: &listOutdatedBeatsSheetsData{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "UpdateBeatsSheetStoryPlanError",
+
+			request: services.UpgradeBeatsSheetsRequest{
+				StoryPlanID: storyPlan.Metadata.ID,
+			},
+
+			selectStoryPlanData: &selectStoryPlanData{
+				resp: storyPlan,
+			},
+
+			listOutdatedBeatsSheetsData: &listOutdatedBeatsSheetsData{
+				resp: []*dao.BeatsSheetEntity{compatibleSheet, incompatibleSheet},
+			},
+
+			updateBeatsSheetStoryPlanData: map[uuid.UUID]*updateBeatsSheetStoryPlanData{
+				compatibleSheet.ID: {
+					err: errFoo,
+				},
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			source := servicesmocks.NewMockUpgradeBeatsSheetsSource(t)
+
+			if testCase.selectStoryPlanData != nil {
+				source.EXPECT().
+					SelectStoryPlan(mock.Anything, services.SelectStoryPlanRequest{
+						ID:     &testCase.request.StoryPlanID,
+						UserID: lo.FromPtr(testCase.request.UserID),
+					}).
+					Return(testCase.selectStoryPlanData.resp, testCase.selectStoryPlanData.err)
+			}
+
+			if testCase.listOutdatedBeatsSheetsData != nil {
+				source.EXPECT().
+					ListOutdatedBeatsSheets(mock.Anything, dao.ListOutdatedBeatsSheetsData{
+						StoryPlanID: testCase.request.StoryPlanID,
+						UserID:      lo.FromPtr(testCase.request.UserID),
+					}).
+					Return(testCase.listOutdatedBeatsSheetsData.resp, testCase.listOutdatedBeatsSheetsData.err)
+			}
+
+			for sheetID, data := range testCase.updateBeatsSheetStoryPlanData {
+				source.EXPECT().
+					UpdateBeatsSheetStoryPlan(mock.Anything, dao.UpdateBeatsSheetStoryPlanData{
+						ID:          sheetID,
+						StoryPlanID: testCase.request.StoryPlanID,
+					}).
+					Return(data.resp, data.err)
+			}
+
+			service := services.NewUpgradeBeatsSheetsService(source)
+
+			resp, err := service.UpgradeBeatsSheets(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Package textdiff computes word-level differences between two versions of a text.
+package textdiff
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+// maxCells caps the size of the table used to find the common words of both texts. Beyond this, the differing part
+// of the texts is reported as a single replacement, rather than spending unbounded memory on it.
+const maxCells = 1 << 22
+
+// Words are split on whitespace, and whitespace is kept as its own token so the chunks rebuild the original texts.
+var tokenRegexp = regexp.MustCompile(`\s+|\S+`)
+
+type diffBuilder struct {
+	chunks  []models.DiffChunk
+	deleted strings.Builder
+	added   strings.Builder
+}
+
+func (builder *diffBuilder) push(op models.DiffOp, text string) {
+	if text == "" {
+		return
+	}
+
+	if last := len(builder.chunks) - 1; last >= 0 && builder.chunks[last].Op == op {
+		builder.chunks[last].Text += text
+
+		return
+	}
+
+	builder.chunks = append(builder.chunks, models.DiffChunk{Op: op, Text: text})
+}
+
+// flush writes the pending changes, deletions first, so a replaced word reads as "old" then "new".
+func (builder *diffBuilder) flush() {
+	builder.push(models.DiffOpDelete, builder.deleted.String())
+	builder.push(models.DiffOpInsert, builder.added.String())
+	builder.deleted.Reset()
+	builder.added.Reset()
+}
+
+func (builder *diffBuilder) equal(text string) {
+	builder.flush()
+	builder.push(models.DiffOpEqual, text)
+}
+
+// Words returns the word-level difference between two texts. Unchanged texts result in a single equal chunk, and
+// empty texts in no chunk at all.
+func Words(from, to string) []models.DiffChunk {
+	fromTokens := tokenRegexp.FindAllString(from, -1)
+	toTokens := tokenRegexp.FindAllString(to, -1)
+
+	builder := new(diffBuilder)
+
+	// Common prefix and suffix do not need to go through the table.
+	prefix := 0
+	for prefix < len(fromTokens) && prefix < len(toTokens) && fromTokens[prefix] == toTokens[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(fromTokens)-prefix && suffix < len(toTokens)-prefix &&
+		fromTokens[len(fromTokens)-1-suffix] == toTokens[len(toTokens)-1-suffix] {
+		suffix++
+	}
+
+	builder.equal(strings.Join(fromTokens[:prefix], ""))
+
+	fromMiddle := fromTokens[prefix : len(fromTokens)-suffix]
+	toMiddle := toTokens[prefix : len(toTokens)-suffix]
+
+	if (len(fromMiddle)+1)*(len(toMiddle)+1) > maxCells {
+		builder.deleted.WriteString(strings.Join(fromMiddle, ""))
+		builder.added.WriteString(strings.Join(toMiddle, ""))
+	} else {
+		diffTokens(builder, fromMiddle, toMiddle)
+	}
+
+	builder.equal(strings.Join(fromTokens[len(fromTokens)-suffix:], ""))
+	builder.flush()
+
+	return builder.chunks
+}
+
+// diffTokens walks the longest common subsequence of both token lists.
+func diffTokens(builder *diffBuilder, from, to []string) {
+	// common[i][j] is the length of the longest common subsequence of from[i:] and to[j:].
+	common := make([][]int, len(from)+1)
+	for i := range common {
+		common[i] = make([]int, len(to)+1)
+	}
+
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(from) && j < len(to) {
+		switch {
+		case from[i] == to[j]:
+			builder.equal(from[i])
+
+			i++
+			j++
+		case common[i+1][j] >= common[i][j+1]:
+			builder.deleted.WriteString(from[i])
+
+			i++
+		default:
+			builder.added.WriteString(to[j])
+
+			j++
+		}
+	}
+
+	for ; i < len(from); i++ {
+		builder.deleted.WriteString(from[i])
+	}
+
+	for ; j < len(to); j++ {
+		builder.added.WriteString(to[j])
+	}
+}
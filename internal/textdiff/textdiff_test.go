@@ -0,0 +1,120 @@
+package textdiff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/internal/textdiff"
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestWords(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		from string
+		to   string
+
+		expect []models.DiffChunk
+	}{
+		{
+			name: "Equal",
+
+			from: "A hero leaves home.",
+			to:   "A hero leaves home.",
+
+			expect: []models.DiffChunk{
+				{Op: models.DiffOpEqual, Text: "A hero leaves home."},
+			},
+		},
+		{
+			name: "Empty",
+
+			expect: nil,
+		},
+		{
+			name: "FromEmpty",
+
+			to: "A hero leaves home.",
+
+			expect: []models.DiffChunk{
+				{Op: models.DiffOpInsert, Text: "A hero leaves home."},
+			},
+		},
+		{
+			name: "ToEmpty",
+
+			from: "A hero leaves home.",
+
+			expect: []models.DiffChunk{
+				{Op: models.DiffOpDelete, Text: "A hero leaves home."},
+			},
+		},
+		{
+			name: "Replace",
+
+			from: "A young hero leaves home.",
+			to:   "A reluctant hero leaves home.",
+
+			expect: []models.DiffChunk{
+				{Op: models.DiffOpEqual, Text: "A "},
+				{Op: models.DiffOpDelete, Text: "young"},
+				{Op: models.DiffOpInsert, Text: "reluctant"},
+				{Op: models.DiffOpEqual, Text: " hero leaves home."},
+			},
+		},
+		{
+			name: "Insert",
+
+			from: "A hero leaves home.",
+			to:   "A hero leaves home, never to return.",
+
+			expect: []models.DiffChunk{
+				{Op: models.DiffOpEqual, Text: "A hero leaves "},
+				{Op: models.DiffOpDelete, Text: "home."},
+				{Op: models.DiffOpInsert, Text: "home, never to return."},
+			},
+		},
+		{
+			name: "Delete",
+
+			from: "A hero reluctantly leaves home.",
+			to:   "A hero leaves home.",
+
+			expect: []models.DiffChunk{
+				{Op: models.DiffOpEqual, Text: "A hero "},
+				{Op: models.DiffOpDelete, Text: "reluctantly "},
+				{Op: models.DiffOpEqual, Text: "leaves home."},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := textdiff.Words(testCase.from, testCase.to)
+			require.Equal(t, testCase.expect, res)
+
+			// The chunks must always rebuild both texts.
+			var from, to strings.Builder
+
+			for _, chunk := range res {
+				if chunk.Op != models.DiffOpInsert {
+					from.WriteString(chunk.Text)
+				}
+
+				if chunk.Op != models.DiffOpDelete {
+					to.WriteString(chunk.Text)
+				}
+			}
+
+			require.Equal(t, testCase.from, from.String())
+			require.Equal(t, testCase.to, to.String())
+		})
+	}
+}
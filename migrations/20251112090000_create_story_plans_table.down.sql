@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS story_plans_slug_idx;
+
+DROP TABLE IF EXISTS story_plans;
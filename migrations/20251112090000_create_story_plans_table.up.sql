@@ -0,0 +1,11 @@
+CREATE TABLE story_plans (
+  id uuid PRIMARY KEY NOT NULL,
+  slug text NOT NULL,
+  name text NOT NULL,
+  lang text NOT NULL,
+  beats jsonb NOT NULL,
+  CONSTRAINT unique_story_plan_slug_per_lang UNIQUE (slug, lang),
+  created_at timestamp(6) with time zone NOT NULL
+);
+
+CREATE INDEX story_plans_slug_idx ON story_plans (slug);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS beats_sheets_story_plan_id_idx;
+
+ALTER TABLE beats_sheets
+DROP COLUMN IF EXISTS story_plan_id;
@@ -0,0 +1,4 @@
+ALTER TABLE beats_sheets
+ADD COLUMN story_plan_id uuid REFERENCES story_plans (id);
+
+CREATE INDEX beats_sheets_story_plan_id_idx ON beats_sheets (story_plan_id);
@@ -0,0 +1,41 @@
+-- Only the latest version of each plan can be kept. Beats sheets pinned to an older version are moved to it.
+UPDATE beats_sheets
+SET
+  story_plan_id = latest_plans.id
+FROM
+  story_plans AS pinned_plans
+  JOIN story_plans AS latest_plans ON latest_plans.slug = pinned_plans.slug
+  AND latest_plans.lang = pinned_plans.lang
+WHERE
+  pinned_plans.id = beats_sheets.story_plan_id
+  AND latest_plans.version = (
+    SELECT
+      MAX(versions.version)
+    FROM
+      story_plans AS versions
+    WHERE
+      versions.slug = pinned_plans.slug
+      AND versions.lang = pinned_plans.lang
+  )
+  AND latest_plans.id <> pinned_plans.id;
+
+DELETE FROM story_plans
+WHERE
+  version < (
+    SELECT
+      MAX(versions.version)
+    FROM
+      story_plans AS versions
+    WHERE
+      versions.slug = story_plans.slug
+      AND versions.lang = story_plans.lang
+  );
+
+ALTER TABLE story_plans
+DROP CONSTRAINT IF EXISTS unique_story_plan_version_per_lang;
+
+ALTER TABLE story_plans
+ADD CONSTRAINT unique_story_plan_slug_per_lang UNIQUE (slug, lang);
+
+ALTER TABLE story_plans
+DROP COLUMN IF EXISTS version;
@@ -0,0 +1,8 @@
+ALTER TABLE story_plans
+ADD COLUMN version integer NOT NULL DEFAULT 1;
+
+ALTER TABLE story_plans
+DROP CONSTRAINT IF EXISTS unique_story_plan_slug_per_lang;
+
+ALTER TABLE story_plans
+ADD CONSTRAINT unique_story_plan_version_per_lang UNIQUE (slug, lang, version);
@@ -0,0 +1,2 @@
+ALTER TABLE story_plans
+ADD COLUMN acts jsonb;
@@ -0,0 +1,27 @@
+DROP INDEX IF EXISTS story_plans_user_id_idx;
+
+UPDATE beats_sheets
+SET
+  story_plan_id = NULL
+WHERE
+  story_plan_id IN (
+    SELECT
+      id
+    FROM
+      story_plans
+    WHERE
+      user_id IS NOT NULL
+  );
+
+DELETE FROM story_plans
+WHERE
+  user_id IS NOT NULL;
+
+ALTER TABLE story_plans
+DROP CONSTRAINT IF EXISTS unique_story_plan_version_per_owner;
+
+ALTER TABLE story_plans
+ADD CONSTRAINT unique_story_plan_version_per_lang UNIQUE (slug, lang, version);
+
+ALTER TABLE story_plans
+DROP COLUMN IF EXISTS user_id;
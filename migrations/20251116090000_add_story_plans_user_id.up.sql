@@ -0,0 +1,12 @@
+ALTER TABLE story_plans
+ADD COLUMN user_id uuid;
+
+ALTER TABLE story_plans
+DROP CONSTRAINT IF EXISTS unique_story_plan_version_per_lang;
+
+-- Built-in plans have no owner. NULLS NOT DISTINCT keeps their versions unique, while users may reuse the slug of
+-- a built-in plan for their own.
+ALTER TABLE story_plans
+ADD CONSTRAINT unique_story_plan_version_per_owner UNIQUE NULLS NOT DISTINCT (user_id, slug, lang, version);
+
+CREATE INDEX story_plans_user_id_idx ON story_plans (user_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS beats_sheets_source_id_idx;
+
+ALTER TABLE beats_sheets
+DROP COLUMN IF EXISTS source_id;
@@ -0,0 +1,4 @@
+ALTER TABLE beats_sheets
+ADD COLUMN source_id uuid REFERENCES beats_sheets (id) ON DELETE SET NULL;
+
+CREATE INDEX beats_sheets_source_id_idx ON beats_sheets (source_id);
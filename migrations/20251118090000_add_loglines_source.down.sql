@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS loglines_source_id_idx;
+
+ALTER TABLE loglines
+DROP COLUMN IF EXISTS source_id;
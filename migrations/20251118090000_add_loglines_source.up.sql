@@ -0,0 +1,4 @@
+ALTER TABLE loglines
+ADD COLUMN source_id uuid REFERENCES loglines (id) ON DELETE SET NULL;
+
+CREATE INDEX loglines_source_id_idx ON loglines (source_id);
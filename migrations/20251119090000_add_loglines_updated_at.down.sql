@@ -0,0 +1,2 @@
+ALTER TABLE loglines
+DROP COLUMN IF EXISTS updated_at;
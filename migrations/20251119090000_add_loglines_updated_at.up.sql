@@ -0,0 +1,2 @@
+ALTER TABLE loglines
+ADD COLUMN updated_at timestamp(6) with time zone;
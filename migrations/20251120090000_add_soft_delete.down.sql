@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS beats_sheets_deleted_at_idx;
+
+DROP INDEX IF EXISTS loglines_deleted_at_idx;
+
+ALTER TABLE beats_sheets
+DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE loglines
+DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,13 @@
+ALTER TABLE loglines
+ADD COLUMN deleted_at timestamp(6) with time zone;
+
+ALTER TABLE beats_sheets
+ADD COLUMN deleted_at timestamp(6) with time zone;
+
+CREATE INDEX loglines_deleted_at_idx ON loglines (deleted_at)
+WHERE
+  deleted_at IS NOT NULL;
+
+CREATE INDEX beats_sheets_deleted_at_idx ON beats_sheets (deleted_at)
+WHERE
+  deleted_at IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS logline_revisions_logline_id_idx;
+
+DROP TABLE IF EXISTS logline_revisions;
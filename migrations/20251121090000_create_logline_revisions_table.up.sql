@@ -0,0 +1,23 @@
+CREATE TABLE logline_revisions (
+  id uuid PRIMARY KEY NOT NULL,
+  logline_id uuid NOT NULL,
+  name text NOT NULL,
+  content text NOT NULL,
+  lang text NOT NULL,
+  created_at timestamp(6) with time zone NOT NULL
+);
+
+CREATE INDEX logline_revisions_logline_id_idx ON logline_revisions (logline_id, created_at DESC);
+
+-- Existing loglines start their history with their current content.
+INSERT INTO
+  logline_revisions (id, logline_id, name, content, lang, created_at)
+SELECT
+  gen_random_uuid(),
+  id,
+  name,
+  content,
+  lang,
+  COALESCE(updated_at, created_at)
+FROM
+  loglines;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS beats_sheets_parent_id_idx;
+
+ALTER TABLE beats_sheets
+DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,4 @@
+ALTER TABLE beats_sheets
+ADD COLUMN parent_id uuid REFERENCES beats_sheets (id) ON DELETE SET NULL;
+
+CREATE INDEX beats_sheets_parent_id_idx ON beats_sheets (parent_id);
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS beats_sheets_search_vector_idx;
+
+DROP INDEX IF EXISTS loglines_search_vector_idx;
+
+ALTER TABLE beats_sheets
+DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE loglines
+DROP COLUMN IF EXISTS search_vector;
+
+DROP FUNCTION IF EXISTS beats_sheet_text (jsonb);
+
+DROP FUNCTION IF EXISTS lang_regconfig (text);
@@ -0,0 +1,35 @@
+-- Text search configuration matching the language of a row. Unknown languages are indexed without stemming.
+CREATE FUNCTION lang_regconfig (lang text) RETURNS regconfig LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+  SELECT CASE lang
+    WHEN 'en' THEN 'english'::regconfig
+    WHEN 'fr' THEN 'french'::regconfig
+    WHEN 'es' THEN 'spanish'::regconfig
+    WHEN 'de' THEN 'german'::regconfig
+    WHEN 'it' THEN 'italian'::regconfig
+    WHEN 'pt' THEN 'portuguese'::regconfig
+    ELSE 'simple'::regconfig
+  END
+$$;
+
+-- Flattens the beats of a sheet into a single searchable text, in the order they appear in the sheet.
+CREATE FUNCTION beats_sheet_text (content jsonb) RETURNS text LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+  SELECT COALESCE(
+    string_agg(concat_ws(E'\n', beat ->> 'title', beat ->> 'content'), E'\n\n' ORDER BY position),
+    ''
+  )
+  FROM jsonb_array_elements(content) WITH ORDINALITY AS beats (beat, position)
+$$;
+
+ALTER TABLE loglines
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector(lang_regconfig (lang), name), 'A') || setweight(to_tsvector(lang_regconfig (lang), content), 'B')
+) STORED;
+
+ALTER TABLE beats_sheets
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+  to_tsvector(lang_regconfig (lang), beats_sheet_text (content))
+) STORED;
+
+CREATE INDEX loglines_search_vector_idx ON loglines USING GIN (search_vector);
+
+CREATE INDEX beats_sheets_search_vector_idx ON beats_sheets USING GIN (search_vector);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS logline_tags;
+
+DROP INDEX IF EXISTS loglines_genre_idx;
+
+ALTER TABLE loglines
+DROP COLUMN IF EXISTS genre;
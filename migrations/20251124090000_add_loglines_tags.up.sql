@@ -0,0 +1,12 @@
+ALTER TABLE loglines
+ADD COLUMN genre text;
+
+CREATE INDEX loglines_genre_idx ON loglines (user_id, genre);
+
+CREATE TABLE logline_tags (
+  logline_id uuid NOT NULL REFERENCES loglines (id) ON DELETE CASCADE,
+  tag text NOT NULL,
+  PRIMARY KEY (logline_id, tag)
+);
+
+CREATE INDEX logline_tags_tag_idx ON logline_tags (tag);
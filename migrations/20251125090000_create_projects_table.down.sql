@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS loglines_project_id_idx;
+
+ALTER TABLE loglines
+DROP COLUMN IF EXISTS project_id;
+
+DROP TABLE IF EXISTS projects;
@@ -0,0 +1,16 @@
+CREATE TABLE projects (
+  id uuid PRIMARY KEY NOT NULL,
+  user_id uuid NOT NULL,
+  name text NOT NULL,
+  description text NOT NULL DEFAULT '',
+  created_at timestamp(6) with time zone NOT NULL,
+  updated_at timestamp(6) with time zone
+);
+
+CREATE INDEX projects_user_id_idx ON projects (user_id);
+
+-- Deleting a project keeps its loglines, they are simply no longer part of a project.
+ALTER TABLE loglines
+ADD COLUMN project_id uuid REFERENCES projects (id) ON DELETE SET NULL;
+
+CREATE INDEX loglines_project_id_idx ON loglines (user_id, project_id);
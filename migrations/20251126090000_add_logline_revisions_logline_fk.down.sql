@@ -0,0 +1,2 @@
+ALTER TABLE logline_revisions
+DROP CONSTRAINT IF EXISTS logline_revisions_logline_id_fkey;
@@ -0,0 +1,13 @@
+-- Revisions of loglines that were already purged from the trash are left without a logline.
+DELETE FROM logline_revisions
+WHERE
+  logline_id NOT IN (
+    SELECT
+      id
+    FROM
+      loglines
+  );
+
+-- Purging a logline from the trash also purges its history.
+ALTER TABLE logline_revisions
+ADD CONSTRAINT logline_revisions_logline_id_fkey FOREIGN KEY (logline_id) REFERENCES loglines (id) ON DELETE CASCADE;
@@ -0,0 +1,8 @@
+-- Escapes the HTML special characters of a text, so it can be embedded in markup.
+CREATE FUNCTION html_escape (content text) RETURNS text LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+  SELECT replace(
+    replace(replace(replace(replace(content, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'),
+    '''',
+    '&#39;'
+  )
+$$;
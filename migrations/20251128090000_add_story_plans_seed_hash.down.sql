@@ -0,0 +1,2 @@
+ALTER TABLE story_plans
+DROP COLUMN IF EXISTS seed_hash;
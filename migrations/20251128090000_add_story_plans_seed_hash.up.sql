@@ -0,0 +1,4 @@
+-- Hash of the embedded plan a built-in plan was last seeded from. Versions created through the API carry the hash
+-- of the previous version over, so the seeder can tell a changed embedded plan from an edit made by an admin.
+ALTER TABLE story_plans
+ADD COLUMN seed_hash text;
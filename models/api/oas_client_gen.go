@@ -29,21 +29,95 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// ConvertBeatsSheet invokes convertBeatsSheet operation.
+	//
+	// Rewrite an existing beats sheet so it follows a different story plan, preserving the story it
+	// tells. The
+	// result is saved as a new beats sheet, linked to the original one. The target plan must be
+	// available in the
+	// language of the original beats sheet.
+	//
+	// POST /beats-sheet/convert
+	ConvertBeatsSheet(ctx context.Context, request *ConvertBeatsSheetForm) (ConvertBeatsSheetRes, error)
 	// CreateBeatsSheet invokes createBeatsSheet operation.
 	//
 	// Create a new beats sheet for a logline, following a story plan.
 	//
 	// PUT /beats-sheet
 	CreateBeatsSheet(ctx context.Context, request *CreateBeatsSheetForm) (CreateBeatsSheetRes, error)
+	// CreateCustomStoryPlan invokes createCustomStoryPlan operation.
+	//
+	// Create a new story plan, owned by the current user. Custom plans are only visible to their owner.
+	// They may
+	// reuse the slug of a built-in plan, in which case they take precedence over it when selected by
+	// slug.
+	//
+	// PUT /story-plan/custom
+	CreateCustomStoryPlan(ctx context.Context, request *CreateStoryPlanForm) (CreateCustomStoryPlanRes, error)
 	// CreateLogline invokes createLogline operation.
 	//
 	// Create a new logline for a user.
 	//
 	// PUT /logline
 	CreateLogline(ctx context.Context, request *CreateLoglineForm) (CreateLoglineRes, error)
+	// CreateProject invokes createProject operation.
+	//
+	// Create a new project for the current user.
+	//
+	// PUT /project
+	CreateProject(ctx context.Context, request *CreateProjectForm) (CreateProjectRes, error)
+	// CreateStoryPlan invokes createStoryPlan operation.
+	//
+	// Create a new story plan. The slug must be unique for a given language.
+	//
+	// PUT /story-plan
+	CreateStoryPlan(ctx context.Context, request *CreateStoryPlanForm) (CreateStoryPlanRes, error)
+	// CritiqueLogline invokes critiqueLogline operation.
+	//
+	// Score the protagonist, goal, stakes, hook and genre clarity of a logline, with a justification and
+	// a concrete
+	// suggestion for each. Either critique a logline of the current user, or a logline idea that was not
+	// saved.
+	//
+	// POST /logline/critique
+	CritiqueLogline(ctx context.Context, request *CritiqueLoglineForm) (CritiqueLoglineRes, error)
+	// DeleteBeatsSheet invokes deleteBeatsSheet operation.
+	//
+	// Move a beats sheet to the trash. It can be restored until the trash is purged.
+	//
+	// DELETE /beats-sheet
+	DeleteBeatsSheet(ctx context.Context, params DeleteBeatsSheetParams) (DeleteBeatsSheetRes, error)
+	// DeleteLogline invokes deleteLogline operation.
+	//
+	// Move a logline to the trash, along with its beats sheets. They can be restored until the trash is
+	// purged.
+	//
+	// DELETE /logline
+	DeleteLogline(ctx context.Context, params DeleteLoglineParams) (DeleteLoglineRes, error)
+	// DeleteProject invokes deleteProject operation.
+	//
+	// Permanently delete a project. The loglines of the project are kept, and no longer belong to any
+	// project.
+	//
+	// DELETE /project
+	DeleteProject(ctx context.Context, params DeleteProjectParams) (DeleteProjectRes, error)
+	// DetectLang invokes detectLang operation.
+	//
+	// Guess the language a text is written in, among the languages supported by the service. Loglines
+	// and beats
+	// sheets are rejected on creation when they are confidently detected to be written in another
+	// language than
+	// the one they are declared in. Short texts usually come with a low confidence, and may not have a
+	// detected
+	// language at all.
+	//
+	// POST /lang/detect
+	DetectLang(ctx context.Context, request *DetectLangForm) (DetectLangRes, error)
 	// ExpandBeat invokes expandBeat operation.
 	//
 	// Add more details to a specific beat in a beats sheet.
+	// When apply is set, the expanded beat is saved as a new beats sheet, whose parent is the original
+	// one.
 	//
 	// POST /beats-sheet/expand
 	ExpandBeat(ctx context.Context, request *ExpandBeatForm) (ExpandBeatRes, error)
@@ -53,12 +127,30 @@ type Invoker interface {
 	//
 	// POST /logline/expand
 	ExpandLogline(ctx context.Context, request *LoglineIdea) (ExpandLoglineRes, error)
+	// ForkStoryPlan invokes forkStoryPlan operation.
+	//
+	// Copy a built-in story plan, or a custom plan owned by the current user, into a new custom plan
+	// owned by the
+	// current user. The slug and name of the source plan are kept, unless overridden.
+	//
+	// POST /story-plan/fork
+	ForkStoryPlan(ctx context.Context, request *ForkStoryPlanForm) (ForkStoryPlanRes, error)
 	// GenerateBeatsSheet invokes generateBeatsSheet operation.
 	//
 	// Generate a new beats sheet for a logline, following a story plan.
 	//
 	// POST /beats-sheet/generate
 	GenerateBeatsSheet(ctx context.Context, request *GenerateBeatsSheetForm) (GenerateBeatsSheetRes, error)
+	// GenerateLoglineVariations invokes generateLoglineVariations operation.
+	//
+	// Generate variations of an existing logline. Each variation changes a single dimension of the
+	// original logline,
+	// among the requested ones, and keeps every other dimension as is. Either derive the variations from
+	// a logline
+	// of the current user, or from a logline idea that was not saved.
+	//
+	// POST /loglines/variations
+	GenerateLoglineVariations(ctx context.Context, request *GenerateLoglineVariationsForm) (GenerateLoglineVariationsRes, error)
 	// GenerateLoglines invokes generateLoglines operation.
 	//
 	// Generate new loglines for a user.
@@ -71,9 +163,19 @@ type Invoker interface {
 	//
 	// GET /beats-sheet
 	GetBeatsSheet(ctx context.Context, params GetBeatsSheetParams) (GetBeatsSheetRes, error)
+	// GetBeatsSheetDiff invokes getBeatsSheetDiff operation.
+	//
+	// Match the beats of two beats sheets by key, and compute the word-level changes of each beat. Beats
+	// added,
+	// removed or moved between both sheets are reported as such.
+	//
+	// GET /beats-sheet/diff
+	GetBeatsSheetDiff(ctx context.Context, params GetBeatsSheetDiffParams) (GetBeatsSheetDiffRes, error)
 	// GetBeatsSheets invokes getBeatsSheets operation.
 	//
-	// Get all beats sheets for the current user.
+	// Get all beats sheets for the current user. Results are paginated with cursors: pass the nextCursor
+	// of a page
+	// to get the following one.
 	//
 	// GET /beats-sheets
 	GetBeatsSheets(ctx context.Context, params GetBeatsSheetsParams) (GetBeatsSheetsRes, error)
@@ -83,12 +185,86 @@ type Invoker interface {
 	//
 	// GET /logline
 	GetLogline(ctx context.Context, params GetLoglineParams) (GetLoglineRes, error)
+	// GetLoglineRevision invokes getLoglineRevision operation.
+	//
+	// Get a single revision of a logline owned by the current user.
+	//
+	// GET /logline/revision
+	GetLoglineRevision(ctx context.Context, params GetLoglineRevisionParams) (GetLoglineRevisionRes, error)
+	// GetLoglineRevisionDiff invokes getLoglineRevisionDiff operation.
+	//
+	// Compute the word-level changes that turn a revision into another one.
+	//
+	// GET /logline/revision/diff
+	GetLoglineRevisionDiff(ctx context.Context, params GetLoglineRevisionDiffParams) (GetLoglineRevisionDiffRes, error)
+	// GetLoglineRevisions invokes getLoglineRevisions operation.
+	//
+	// List the revisions of a logline owned by the current user, most recent first. A revision is saved
+	// every time
+	// the name, content or language of the logline is written.
+	//
+	// GET /logline/revisions
+	GetLoglineRevisions(ctx context.Context, params GetLoglineRevisionsParams) (GetLoglineRevisionsRes, error)
+	// GetLoglineTags invokes getLoglineTags operation.
+	//
+	// List the tags used on the loglines of the current user, with the number of loglines using each of
+	// them. Most
+	// used tags come first. Loglines in the trash are not counted.
+	//
+	// GET /loglines/tags
+	GetLoglineTags(ctx context.Context) (GetLoglineTagsRes, error)
 	// GetLoglines invokes getLoglines operation.
 	//
-	// Get all loglines for the current user.
+	// Get all loglines for the current user. Results are paginated with cursors: pass the nextCursor of
+	// a page to
+	// get the following one.
 	//
 	// GET /loglines
 	GetLoglines(ctx context.Context, params GetLoglinesParams) (GetLoglinesRes, error)
+	// GetProject invokes getProject operation.
+	//
+	// Get a project owned by the current user.
+	//
+	// GET /project
+	GetProject(ctx context.Context, params GetProjectParams) (GetProjectRes, error)
+	// GetProjects invokes getProjects operation.
+	//
+	// Get all the projects of the current user, sorted by name. Results are paginated with cursors: pass
+	// the
+	// nextCursor of a page to get the following one.
+	//
+	// GET /projects
+	GetProjects(ctx context.Context, params GetProjectsParams) (GetProjectsRes, error)
+	// GetStoryPlan invokes getStoryPlan operation.
+	//
+	// Get a story plan, either by its unique identifier, or by its slug and language. If neither the id
+	// nor the slug
+	// is provided, the default story plan for the language is returned. When selecting by slug, the
+	// latest version
+	// of the plan is returned.
+	//
+	// GET /story-plan
+	GetStoryPlan(ctx context.Context, params GetStoryPlanParams) (GetStoryPlanRes, error)
+	// GetStoryPlans invokes getStoryPlans operation.
+	//
+	// Get all the available story plans.
+	//
+	// GET /story-plans
+	GetStoryPlans(ctx context.Context, params GetStoryPlansParams) (GetStoryPlansRes, error)
+	// GetTrashedBeatsSheets invokes getTrashedBeatsSheets operation.
+	//
+	// List the beats sheets of the current user that were deleted on their own, most recently deleted
+	// first. Sheets
+	// deleted along with their logline are restored with it, and are not listed here.
+	//
+	// GET /beats-sheets/trash
+	GetTrashedBeatsSheets(ctx context.Context, params GetTrashedBeatsSheetsParams) (GetTrashedBeatsSheetsRes, error)
+	// GetTrashedLoglines invokes getTrashedLoglines operation.
+	//
+	// List the loglines of the current user that are in the trash, most recently deleted first.
+	//
+	// GET /loglines/trash
+	GetTrashedLoglines(ctx context.Context, params GetTrashedLoglinesParams) (GetTrashedLoglinesRes, error)
 	// Healthcheck invokes healthcheck operation.
 	//
 	// Returns a detailed report of the health of the service, including every dependency.
@@ -104,9 +280,121 @@ type Invoker interface {
 	// RegenerateBeats invokes regenerateBeats operation.
 	//
 	// Regenerate the content of specific beats in a beats sheet.
+	// When apply is set, the regenerated beats are saved as a new beats sheet, whose parent is the
+	// original one.
 	//
 	// POST /beats-sheet/regenerate
 	RegenerateBeats(ctx context.Context, request *RegenerateBeatsForm) (RegenerateBeatsRes, error)
+	// RestoreBeatsSheet invokes restoreBeatsSheet operation.
+	//
+	// Take a beats sheet out of the trash. Sheets deleted along with their logline must be restored
+	// through the
+	// logline.
+	//
+	// POST /beats-sheet/restore
+	RestoreBeatsSheet(ctx context.Context, request *RestoreBeatsSheetForm) (RestoreBeatsSheetRes, error)
+	// RestoreLogline invokes restoreLogline operation.
+	//
+	// Take a logline out of the trash, along with the beats sheets that were deleted with it.
+	//
+	// POST /logline/restore
+	RestoreLogline(ctx context.Context, request *RestoreLoglineForm) (RestoreLoglineRes, error)
+	// RestoreLoglineRevision invokes restoreLoglineRevision operation.
+	//
+	// Write the name, content and language of an older revision back to its logline. The slug of the
+	// logline is
+	// kept, and the restored content is saved as a new revision.
+	//
+	// POST /logline/revision/restore
+	RestoreLoglineRevision(ctx context.Context, request *RestoreLoglineRevisionForm) (RestoreLoglineRevisionRes, error)
+	// Search invokes search operation.
+	//
+	// Run a full-text search over the loglines and beats sheets of the current user, outside the trash.
+	// Each item is
+	// matched using the dictionary of its own language, so words match their inflected forms. The query
+	// supports
+	// the web search syntax: quoted phrases, "or", and "-" to exclude a word. Hits are returned most
+	// relevant first,
+	// with snippets of the matching text.
+	//
+	// GET /search
+	Search(ctx context.Context, params SearchParams) (SearchRes, error)
+	// TranslateBeatsSheet invokes translateBeatsSheet operation.
+	//
+	// Translate an existing beats sheet to another language. The translation follows the same story plan,
+	//  in the
+	// target language, and keeps every beat of the original sheet. It is saved as a new beats sheet,
+	// linked to the
+	// original one.
+	//
+	// POST /beats-sheet/translate
+	TranslateBeatsSheet(ctx context.Context, request *TranslateBeatsSheetForm) (TranslateBeatsSheetRes, error)
+	// TranslateLogline invokes translateLogline operation.
+	//
+	// Translate an existing logline to another language. The translation is saved as a new logline,
+	// linked to the
+	// original one.
+	//
+	// POST /logline/translate
+	TranslateLogline(ctx context.Context, request *TranslateLoglineForm) (TranslateLoglineRes, error)
+	// UpdateBeat invokes updateBeat operation.
+	//
+	// Edit the title or content of a single beat. Omitted fields keep their current value. The edit is
+	// saved as a
+	// new beats sheet, whose parent is the original one, and the original sheet is left untouched.
+	//
+	// PATCH /beats-sheet/beat
+	UpdateBeat(ctx context.Context, request *UpdateBeatForm) (UpdateBeatRes, error)
+	// UpdateCustomStoryPlan invokes updateCustomStoryPlan operation.
+	//
+	// Create a new version of a story plan owned by the current user. Built-in plans, and plans owned by
+	// other
+	// users, cannot be updated through this endpoint.
+	//
+	// PATCH /story-plan/custom
+	UpdateCustomStoryPlan(ctx context.Context, request *UpdateStoryPlanForm) (UpdateCustomStoryPlanRes, error)
+	// UpdateLogline invokes updateLogline operation.
+	//
+	// Edit a logline owned by the current user, in place. Omitted fields keep their current value. If
+	// the new slug
+	// is already used by another logline of the user, a version number is appended to it.
+	//
+	// PATCH /logline
+	UpdateLogline(ctx context.Context, request *UpdateLoglineForm) (UpdateLoglineRes, error)
+	// UpdateProject invokes updateProject operation.
+	//
+	// Edit a project owned by the current user. Omitted fields keep their current value.
+	//
+	// PATCH /project
+	UpdateProject(ctx context.Context, request *UpdateProjectForm) (UpdateProjectRes, error)
+	// UpdateStoryPlan invokes updateStoryPlan operation.
+	//
+	// Update the name and beats of an existing story plan. Story plans are immutable: this creates a new
+	// version
+	// of the plan, with the same slug and language. Previous versions are kept, so existing beats sheets
+	// remain
+	// pinned to the version they were built against.
+	//
+	// PATCH /story-plan
+	UpdateStoryPlan(ctx context.Context, request *UpdateStoryPlanForm) (UpdateStoryPlanRes, error)
+	// UpgradeBeatsSheets invokes upgradeBeatsSheets operation.
+	//
+	// Check every beats sheet pinned to an older version of the target story plan. Sheets whose content
+	// is valid
+	// under the target version are pinned to it, unless dryRun is set. Incompatible sheets are reported,
+	// and left
+	// untouched.
+	//
+	// POST /story-plan/upgrade-beats-sheets
+	UpgradeBeatsSheets(ctx context.Context, request *UpgradeBeatsSheetsForm) (UpgradeBeatsSheetsRes, error)
+	// UpgradeCustomBeatsSheets invokes upgradeCustomBeatsSheets operation.
+	//
+	// Same as upgradeBeatsSheets, restricted to the beats sheets of the current user. The target may be
+	// a built-in
+	// story plan, or one of the user's custom plans.
+	//
+	// POST /story-plan/custom/upgrade-beats-sheets
+	UpgradeCustomBeatsSheets(ctx context.Context, request *UpgradeBeatsSheetsForm) (UpgradeCustomBeatsSheetsRes, error)
 }
 
 // Client implements OAS client.
@@ -158,21 +446,25 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
-// CreateBeatsSheet invokes createBeatsSheet operation.
+// ConvertBeatsSheet invokes convertBeatsSheet operation.
 //
-// Create a new beats sheet for a logline, following a story plan.
+// Rewrite an existing beats sheet so it follows a different story plan, preserving the story it
+// tells. The
+// result is saved as a new beats sheet, linked to the original one. The target plan must be
+// available in the
+// language of the original beats sheet.
 //
-// PUT /beats-sheet
-func (c *Client) CreateBeatsSheet(ctx context.Context, request *CreateBeatsSheetForm) (CreateBeatsSheetRes, error) {
-	res, err := c.sendCreateBeatsSheet(ctx, request)
+// POST /beats-sheet/convert
+func (c *Client) ConvertBeatsSheet(ctx context.Context, request *ConvertBeatsSheetForm) (ConvertBeatsSheetRes, error) {
+	res, err := c.sendConvertBeatsSheet(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendCreateBeatsSheet(ctx context.Context, request *CreateBeatsSheetForm) (res CreateBeatsSheetRes, err error) {
+func (c *Client) sendConvertBeatsSheet(ctx context.Context, request *ConvertBeatsSheetForm) (res ConvertBeatsSheetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createBeatsSheet"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/beats-sheet"),
+		otelogen.OperationID("convertBeatsSheet"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/beats-sheet/convert"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -188,7 +480,7 @@ func (c *Client) sendCreateBeatsSheet(ctx context.Context, request *CreateBeatsS
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateBeatsSheetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ConvertBeatsSheetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -206,15 +498,15 @@ func (c *Client) sendCreateBeatsSheet(ctx context.Context, request *CreateBeatsS
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/beats-sheet"
+	pathParts[0] = "/beats-sheet/convert"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateBeatsSheetRequest(request, r); err != nil {
+	if err := encodeConvertBeatsSheetRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -223,7 +515,7 @@ func (c *Client) sendCreateBeatsSheet(ctx context.Context, request *CreateBeatsS
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateBeatsSheetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ConvertBeatsSheetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -259,7 +551,7 @@ func (c *Client) sendCreateBeatsSheet(ctx context.Context, request *CreateBeatsS
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateBeatsSheetResponse(resp)
+	result, err := decodeConvertBeatsSheetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -267,21 +559,21 @@ func (c *Client) sendCreateBeatsSheet(ctx context.Context, request *CreateBeatsS
 	return result, nil
 }
 
-// CreateLogline invokes createLogline operation.
+// CreateBeatsSheet invokes createBeatsSheet operation.
 //
-// Create a new logline for a user.
+// Create a new beats sheet for a logline, following a story plan.
 //
-// PUT /logline
-func (c *Client) CreateLogline(ctx context.Context, request *CreateLoglineForm) (CreateLoglineRes, error) {
-	res, err := c.sendCreateLogline(ctx, request)
+// PUT /beats-sheet
+func (c *Client) CreateBeatsSheet(ctx context.Context, request *CreateBeatsSheetForm) (CreateBeatsSheetRes, error) {
+	res, err := c.sendCreateBeatsSheet(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendCreateLogline(ctx context.Context, request *CreateLoglineForm) (res CreateLoglineRes, err error) {
+func (c *Client) sendCreateBeatsSheet(ctx context.Context, request *CreateBeatsSheetForm) (res CreateBeatsSheetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createLogline"),
+		otelogen.OperationID("createBeatsSheet"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/logline"),
+		semconv.URLTemplateKey.String("/beats-sheet"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -297,7 +589,7 @@ func (c *Client) sendCreateLogline(ctx context.Context, request *CreateLoglineFo
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateLoglineOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateBeatsSheetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -315,7 +607,7 @@ func (c *Client) sendCreateLogline(ctx context.Context, request *CreateLoglineFo
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/logline"
+	pathParts[0] = "/beats-sheet"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -323,7 +615,7 @@ func (c *Client) sendCreateLogline(ctx context.Context, request *CreateLoglineFo
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateLoglineRequest(request, r); err != nil {
+	if err := encodeCreateBeatsSheetRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -332,7 +624,7 @@ func (c *Client) sendCreateLogline(ctx context.Context, request *CreateLoglineFo
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateLoglineOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateBeatsSheetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -368,7 +660,7 @@ func (c *Client) sendCreateLogline(ctx context.Context, request *CreateLoglineFo
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateLoglineResponse(resp)
+	result, err := decodeCreateBeatsSheetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -376,21 +668,24 @@ func (c *Client) sendCreateLogline(ctx context.Context, request *CreateLoglineFo
 	return result, nil
 }
 
-// ExpandBeat invokes expandBeat operation.
+// CreateCustomStoryPlan invokes createCustomStoryPlan operation.
 //
-// Add more details to a specific beat in a beats sheet.
+// Create a new story plan, owned by the current user. Custom plans are only visible to their owner.
+// They may
+// reuse the slug of a built-in plan, in which case they take precedence over it when selected by
+// slug.
 //
-// POST /beats-sheet/expand
-func (c *Client) ExpandBeat(ctx context.Context, request *ExpandBeatForm) (ExpandBeatRes, error) {
-	res, err := c.sendExpandBeat(ctx, request)
+// PUT /story-plan/custom
+func (c *Client) CreateCustomStoryPlan(ctx context.Context, request *CreateStoryPlanForm) (CreateCustomStoryPlanRes, error) {
+	res, err := c.sendCreateCustomStoryPlan(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendExpandBeat(ctx context.Context, request *ExpandBeatForm) (res ExpandBeatRes, err error) {
+func (c *Client) sendCreateCustomStoryPlan(ctx context.Context, request *CreateStoryPlanForm) (res CreateCustomStoryPlanRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("expandBeat"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/beats-sheet/expand"),
+		otelogen.OperationID("createCustomStoryPlan"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/story-plan/custom"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -406,7 +701,7 @@ func (c *Client) sendExpandBeat(ctx context.Context, request *ExpandBeatForm) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ExpandBeatOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateCustomStoryPlanOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -424,15 +719,15 @@ func (c *Client) sendExpandBeat(ctx context.Context, request *ExpandBeatForm) (r
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/beats-sheet/expand"
+	pathParts[0] = "/story-plan/custom"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeExpandBeatRequest(request, r); err != nil {
+	if err := encodeCreateCustomStoryPlanRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -441,7 +736,7 @@ func (c *Client) sendExpandBeat(ctx context.Context, request *ExpandBeatForm) (r
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ExpandBeatOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateCustomStoryPlanOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -477,7 +772,7 @@ func (c *Client) sendExpandBeat(ctx context.Context, request *ExpandBeatForm) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeExpandBeatResponse(resp)
+	result, err := decodeCreateCustomStoryPlanResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -485,21 +780,21 @@ func (c *Client) sendExpandBeat(ctx context.Context, request *ExpandBeatForm) (r
 	return result, nil
 }
 
-// ExpandLogline invokes expandLogline operation.
+// CreateLogline invokes createLogline operation.
 //
-// Add more details to a logline idea.
+// Create a new logline for a user.
 //
-// POST /logline/expand
-func (c *Client) ExpandLogline(ctx context.Context, request *LoglineIdea) (ExpandLoglineRes, error) {
-	res, err := c.sendExpandLogline(ctx, request)
+// PUT /logline
+func (c *Client) CreateLogline(ctx context.Context, request *CreateLoglineForm) (CreateLoglineRes, error) {
+	res, err := c.sendCreateLogline(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendExpandLogline(ctx context.Context, request *LoglineIdea) (res ExpandLoglineRes, err error) {
+func (c *Client) sendCreateLogline(ctx context.Context, request *CreateLoglineForm) (res CreateLoglineRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("expandLogline"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/logline/expand"),
+		otelogen.OperationID("createLogline"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/logline"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -515,7 +810,7 @@ func (c *Client) sendExpandLogline(ctx context.Context, request *LoglineIdea) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ExpandLoglineOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateLoglineOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -533,15 +828,15 @@ func (c *Client) sendExpandLogline(ctx context.Context, request *LoglineIdea) (r
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/logline/expand"
+	pathParts[0] = "/logline"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeExpandLoglineRequest(request, r); err != nil {
+	if err := encodeCreateLoglineRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -550,7 +845,7 @@ func (c *Client) sendExpandLogline(ctx context.Context, request *LoglineIdea) (r
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ExpandLoglineOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateLoglineOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -586,7 +881,7 @@ func (c *Client) sendExpandLogline(ctx context.Context, request *LoglineIdea) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeExpandLoglineResponse(resp)
+	result, err := decodeCreateLoglineResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -594,21 +889,21 @@ func (c *Client) sendExpandLogline(ctx context.Context, request *LoglineIdea) (r
 	return result, nil
 }
 
-// GenerateBeatsSheet invokes generateBeatsSheet operation.
+// CreateProject invokes createProject operation.
 //
-// Generate a new beats sheet for a logline, following a story plan.
+// Create a new project for the current user.
 //
-// POST /beats-sheet/generate
-func (c *Client) GenerateBeatsSheet(ctx context.Context, request *GenerateBeatsSheetForm) (GenerateBeatsSheetRes, error) {
-	res, err := c.sendGenerateBeatsSheet(ctx, request)
+// PUT /project
+func (c *Client) CreateProject(ctx context.Context, request *CreateProjectForm) (CreateProjectRes, error) {
+	res, err := c.sendCreateProject(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGenerateBeatsSheet(ctx context.Context, request *GenerateBeatsSheetForm) (res GenerateBeatsSheetRes, err error) {
+func (c *Client) sendCreateProject(ctx context.Context, request *CreateProjectForm) (res CreateProjectRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("generateBeatsSheet"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/beats-sheet/generate"),
+		otelogen.OperationID("createProject"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/project"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -624,7 +919,7 @@ func (c *Client) sendGenerateBeatsSheet(ctx context.Context, request *GenerateBe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GenerateBeatsSheetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateProjectOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -642,15 +937,15 @@ func (c *Client) sendGenerateBeatsSheet(ctx context.Context, request *GenerateBe
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/beats-sheet/generate"
+	pathParts[0] = "/project"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGenerateBeatsSheetRequest(request, r); err != nil {
+	if err := encodeCreateProjectRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -659,7 +954,7 @@ func (c *Client) sendGenerateBeatsSheet(ctx context.Context, request *GenerateBe
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GenerateBeatsSheetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateProjectOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -695,7 +990,7 @@ func (c *Client) sendGenerateBeatsSheet(ctx context.Context, request *GenerateBe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGenerateBeatsSheetResponse(resp)
+	result, err := decodeCreateProjectResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -703,21 +998,21 @@ func (c *Client) sendGenerateBeatsSheet(ctx context.Context, request *GenerateBe
 	return result, nil
 }
 
-// GenerateLoglines invokes generateLoglines operation.
+// CreateStoryPlan invokes createStoryPlan operation.
 //
-// Generate new loglines for a user.
+// Create a new story plan. The slug must be unique for a given language.
 //
-// POST /loglines/generate
-func (c *Client) GenerateLoglines(ctx context.Context, request *GenerateLoglinesForm) (GenerateLoglinesRes, error) {
-	res, err := c.sendGenerateLoglines(ctx, request)
+// PUT /story-plan
+func (c *Client) CreateStoryPlan(ctx context.Context, request *CreateStoryPlanForm) (CreateStoryPlanRes, error) {
+	res, err := c.sendCreateStoryPlan(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGenerateLoglines(ctx context.Context, request *GenerateLoglinesForm) (res GenerateLoglinesRes, err error) {
+func (c *Client) sendCreateStoryPlan(ctx context.Context, request *CreateStoryPlanForm) (res CreateStoryPlanRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("generateLoglines"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/loglines/generate"),
+		otelogen.OperationID("createStoryPlan"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/story-plan"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -733,7 +1028,7 @@ func (c *Client) sendGenerateLoglines(ctx context.Context, request *GenerateLogl
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GenerateLoglinesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateStoryPlanOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -751,15 +1046,15 @@ func (c *Client) sendGenerateLoglines(ctx context.Context, request *GenerateLogl
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/loglines/generate"
+	pathParts[0] = "/story-plan"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGenerateLoglinesRequest(request, r); err != nil {
+	if err := encodeCreateStoryPlanRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -768,7 +1063,7 @@ func (c *Client) sendGenerateLoglines(ctx context.Context, request *GenerateLogl
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GenerateLoglinesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateStoryPlanOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -804,7 +1099,7 @@ func (c *Client) sendGenerateLoglines(ctx context.Context, request *GenerateLogl
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGenerateLoglinesResponse(resp)
+	result, err := decodeCreateStoryPlanResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -812,21 +1107,24 @@ func (c *Client) sendGenerateLoglines(ctx context.Context, request *GenerateLogl
 	return result, nil
 }
 
-// GetBeatsSheet invokes getBeatsSheet operation.
+// CritiqueLogline invokes critiqueLogline operation.
 //
-// Get a beats sheet.
+// Score the protagonist, goal, stakes, hook and genre clarity of a logline, with a justification and
+// a concrete
+// suggestion for each. Either critique a logline of the current user, or a logline idea that was not
+// saved.
 //
-// GET /beats-sheet
-func (c *Client) GetBeatsSheet(ctx context.Context, params GetBeatsSheetParams) (GetBeatsSheetRes, error) {
-	res, err := c.sendGetBeatsSheet(ctx, params)
+// POST /logline/critique
+func (c *Client) CritiqueLogline(ctx context.Context, request *CritiqueLoglineForm) (CritiqueLoglineRes, error) {
+	res, err := c.sendCritiqueLogline(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetBeatsSheet(ctx context.Context, params GetBeatsSheetParams) (res GetBeatsSheetRes, err error) {
+func (c *Client) sendCritiqueLogline(ctx context.Context, request *CritiqueLoglineForm) (res CritiqueLoglineRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBeatsSheet"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/beats-sheet"),
+		otelogen.OperationID("critiqueLogline"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/logline/critique"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -842,7 +1140,7 @@ func (c *Client) sendGetBeatsSheet(ctx context.Context, params GetBeatsSheetPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetBeatsSheetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CritiqueLoglineOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -860,42 +1158,24 @@ func (c *Client) sendGetBeatsSheet(ctx context.Context, params GetBeatsSheetPara
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/beats-sheet"
+	pathParts[0] = "/logline/critique"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "beatsSheetID" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "beatsSheetID",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if unwrapped := uuid.UUID(params.BeatsSheetID); true {
-				return e.EncodeValue(conv.UUIDToString(unwrapped))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCritiqueLoglineRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetBeatsSheetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CritiqueLoglineOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -931,7 +1211,7 @@ func (c *Client) sendGetBeatsSheet(ctx context.Context, params GetBeatsSheetPara
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetBeatsSheetResponse(resp)
+	result, err := decodeCritiqueLoglineResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -939,21 +1219,21 @@ func (c *Client) sendGetBeatsSheet(ctx context.Context, params GetBeatsSheetPara
 	return result, nil
 }
 
-// GetBeatsSheets invokes getBeatsSheets operation.
+// DeleteBeatsSheet invokes deleteBeatsSheet operation.
 //
-// Get all beats sheets for the current user.
+// Move a beats sheet to the trash. It can be restored until the trash is purged.
 //
-// GET /beats-sheets
-func (c *Client) GetBeatsSheets(ctx context.Context, params GetBeatsSheetsParams) (GetBeatsSheetsRes, error) {
-	res, err := c.sendGetBeatsSheets(ctx, params)
+// DELETE /beats-sheet
+func (c *Client) DeleteBeatsSheet(ctx context.Context, params DeleteBeatsSheetParams) (DeleteBeatsSheetRes, error) {
+	res, err := c.sendDeleteBeatsSheet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetBeatsSheets(ctx context.Context, params GetBeatsSheetsParams) (res GetBeatsSheetsRes, err error) {
+func (c *Client) sendDeleteBeatsSheet(ctx context.Context, params DeleteBeatsSheetParams) (res DeleteBeatsSheetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBeatsSheets"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/beats-sheets"),
+		otelogen.OperationID("deleteBeatsSheet"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/beats-sheet"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -969,7 +1249,7 @@ func (c *Client) sendGetBeatsSheets(ctx context.Context, params GetBeatsSheetsPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetBeatsSheetsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteBeatsSheetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -987,21 +1267,21 @@ func (c *Client) sendGetBeatsSheets(ctx context.Context, params GetBeatsSheetsPa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/beats-sheets"
+	pathParts[0] = "/beats-sheet"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "loglineID" parameter.
+		// Encode "beatsSheetID" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "loglineID",
+			Name:    "beatsSheetID",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if unwrapped := uuid.UUID(params.LoglineID); true {
+			if unwrapped := uuid.UUID(params.BeatsSheetID); true {
 				return e.EncodeValue(conv.UUIDToString(unwrapped))
 			}
 			return nil
@@ -1009,44 +1289,10 @@ func (c *Client) sendGetBeatsSheets(ctx context.Context, params GetBeatsSheetsPa
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
 	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1056,7 +1302,7 @@ func (c *Client) sendGetBeatsSheets(ctx context.Context, params GetBeatsSheetsPa
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetBeatsSheetsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteBeatsSheetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1092,7 +1338,7 @@ func (c *Client) sendGetBeatsSheets(ctx context.Context, params GetBeatsSheetsPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetBeatsSheetsResponse(resp)
+	result, err := decodeDeleteBeatsSheetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1100,20 +1346,21 @@ func (c *Client) sendGetBeatsSheets(ctx context.Context, params GetBeatsSheetsPa
 	return result, nil
 }
 
-// GetLogline invokes getLogline operation.
+// DeleteLogline invokes deleteLogline operation.
 //
-// Get a logline.
+// Move a logline to the trash, along with its beats sheets. They can be restored until the trash is
+// purged.
 //
-// GET /logline
-func (c *Client) GetLogline(ctx context.Context, params GetLoglineParams) (GetLoglineRes, error) {
-	res, err := c.sendGetLogline(ctx, params)
+// DELETE /logline
+func (c *Client) DeleteLogline(ctx context.Context, params DeleteLoglineParams) (DeleteLoglineRes, error) {
+	res, err := c.sendDeleteLogline(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetLogline(ctx context.Context, params GetLoglineParams) (res GetLoglineRes, err error) {
+func (c *Client) sendDeleteLogline(ctx context.Context, params DeleteLoglineParams) (res DeleteLoglineRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getLogline"),
-		semconv.HTTPRequestMethodKey.String("GET"),
+		otelogen.OperationID("deleteLogline"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
 		semconv.URLTemplateKey.String("/logline"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
@@ -1130,7 +1377,7 @@ func (c *Client) sendGetLogline(ctx context.Context, params GetLoglineParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetLoglineOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteLoglineOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1154,39 +1401,16 @@ func (c *Client) sendGetLogline(ctx context.Context, params GetLoglineParams) (r
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ID.Get(); ok {
-				if unwrapped := uuid.UUID(val); true {
-					return e.EncodeValue(conv.UUIDToString(unwrapped))
-				}
-				return nil
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "slug" parameter.
+		// Encode "loglineID" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "slug",
+			Name:    "loglineID",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Slug.Get(); ok {
-				if unwrapped := string(val); true {
-					return e.EncodeValue(conv.StringToString(unwrapped))
-				}
-				return nil
+			if unwrapped := uuid.UUID(params.LoglineID); true {
+				return e.EncodeValue(conv.UUIDToString(unwrapped))
 			}
 			return nil
 		}); err != nil {
@@ -1196,7 +1420,7 @@ func (c *Client) sendGetLogline(ctx context.Context, params GetLoglineParams) (r
 	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1206,7 +1430,7 @@ func (c *Client) sendGetLogline(ctx context.Context, params GetLoglineParams) (r
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetLoglineOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteLoglineOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1242,7 +1466,7 @@ func (c *Client) sendGetLogline(ctx context.Context, params GetLoglineParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetLoglineResponse(resp)
+	result, err := decodeDeleteLoglineResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1250,21 +1474,22 @@ func (c *Client) sendGetLogline(ctx context.Context, params GetLoglineParams) (r
 	return result, nil
 }
 
-// GetLoglines invokes getLoglines operation.
+// DeleteProject invokes deleteProject operation.
 //
-// Get all loglines for the current user.
+// Permanently delete a project. The loglines of the project are kept, and no longer belong to any
+// project.
 //
-// GET /loglines
-func (c *Client) GetLoglines(ctx context.Context, params GetLoglinesParams) (GetLoglinesRes, error) {
-	res, err := c.sendGetLoglines(ctx, params)
+// DELETE /project
+func (c *Client) DeleteProject(ctx context.Context, params DeleteProjectParams) (DeleteProjectRes, error) {
+	res, err := c.sendDeleteProject(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetLoglines(ctx context.Context, params GetLoglinesParams) (res GetLoglinesRes, err error) {
+func (c *Client) sendDeleteProject(ctx context.Context, params DeleteProjectParams) (res DeleteProjectRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getLoglines"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/loglines"),
+		otelogen.OperationID("deleteProject"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/project"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1280,7 +1505,7 @@ func (c *Client) sendGetLoglines(ctx context.Context, params GetLoglinesParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetLoglinesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteProjectOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1298,39 +1523,22 @@ func (c *Client) sendGetLoglines(ctx context.Context, params GetLoglinesParams)
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/loglines"
+	pathParts[0] = "/project"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "offset" parameter.
+		// Encode "projectID" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
+			Name:    "projectID",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
+			if unwrapped := uuid.UUID(params.ProjectID); true {
+				return e.EncodeValue(conv.UUIDToString(unwrapped))
 			}
 			return nil
 		}); err != nil {
@@ -1340,7 +1548,7 @@ func (c *Client) sendGetLoglines(ctx context.Context, params GetLoglinesParams)
 	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1350,7 +1558,7 @@ func (c *Client) sendGetLoglines(ctx context.Context, params GetLoglinesParams)
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetLoglinesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteProjectOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1386,7 +1594,7 @@ func (c *Client) sendGetLoglines(ctx context.Context, params GetLoglinesParams)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetLoglinesResponse(resp)
+	result, err := decodeDeleteProjectResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1394,21 +1602,27 @@ func (c *Client) sendGetLoglines(ctx context.Context, params GetLoglinesParams)
 	return result, nil
 }
 
-// Healthcheck invokes healthcheck operation.
+// DetectLang invokes detectLang operation.
 //
-// Returns a detailed report of the health of the service, including every dependency.
+// Guess the language a text is written in, among the languages supported by the service. Loglines
+// and beats
+// sheets are rejected on creation when they are confidently detected to be written in another
+// language than
+// the one they are declared in. Short texts usually come with a low confidence, and may not have a
+// detected
+// language at all.
 //
-// GET /healthcheck
-func (c *Client) Healthcheck(ctx context.Context) (HealthcheckRes, error) {
-	res, err := c.sendHealthcheck(ctx)
+// POST /lang/detect
+func (c *Client) DetectLang(ctx context.Context, request *DetectLangForm) (DetectLangRes, error) {
+	res, err := c.sendDetectLang(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendHealthcheck(ctx context.Context) (res HealthcheckRes, err error) {
+func (c *Client) sendDetectLang(ctx context.Context, request *DetectLangForm) (res DetectLangRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("healthcheck"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/healthcheck"),
+		otelogen.OperationID("detectLang"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/lang/detect"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1424,7 +1638,7 @@ func (c *Client) sendHealthcheck(ctx context.Context) (res HealthcheckRes, err e
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, HealthcheckOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DetectLangOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1442,14 +1656,50 @@ func (c *Client) sendHealthcheck(ctx context.Context) (res HealthcheckRes, err e
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/healthcheck"
+	pathParts[0] = "/lang/detect"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeDetectLangRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DetectLangOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -1459,7 +1709,7 @@ func (c *Client) sendHealthcheck(ctx context.Context) (res HealthcheckRes, err e
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeHealthcheckResponse(resp)
+	result, err := decodeDetectLangResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1467,21 +1717,23 @@ func (c *Client) sendHealthcheck(ctx context.Context) (res HealthcheckRes, err e
 	return result, nil
 }
 
-// Ping invokes ping operation.
+// ExpandBeat invokes expandBeat operation.
 //
-// Check the status of the service. If the service is running, a successful response is returned.
+// Add more details to a specific beat in a beats sheet.
+// When apply is set, the expanded beat is saved as a new beats sheet, whose parent is the original
+// one.
 //
-// GET /ping
-func (c *Client) Ping(ctx context.Context) (PingRes, error) {
-	res, err := c.sendPing(ctx)
+// POST /beats-sheet/expand
+func (c *Client) ExpandBeat(ctx context.Context, request *ExpandBeatForm) (ExpandBeatRes, error) {
+	res, err := c.sendExpandBeat(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendPing(ctx context.Context) (res PingRes, err error) {
+func (c *Client) sendExpandBeat(ctx context.Context, request *ExpandBeatForm) (res ExpandBeatRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ping"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/ping"),
+		otelogen.OperationID("expandBeat"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/beats-sheet/expand"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1497,7 +1749,7 @@ func (c *Client) sendPing(ctx context.Context) (res PingRes, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ExpandBeatOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1515,14 +1767,50 @@ func (c *Client) sendPing(ctx context.Context) (res PingRes, err error) {
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/ping"
+	pathParts[0] = "/beats-sheet/expand"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeExpandBeatRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ExpandBeatOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -1532,7 +1820,7 @@ func (c *Client) sendPing(ctx context.Context) (res PingRes, err error) {
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePingResponse(resp)
+	result, err := decodeExpandBeatResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1540,21 +1828,21 @@ func (c *Client) sendPing(ctx context.Context) (res PingRes, err error) {
 	return result, nil
 }
 
-// RegenerateBeats invokes regenerateBeats operation.
+// ExpandLogline invokes expandLogline operation.
 //
-// Regenerate the content of specific beats in a beats sheet.
+// Add more details to a logline idea.
 //
-// POST /beats-sheet/regenerate
-func (c *Client) RegenerateBeats(ctx context.Context, request *RegenerateBeatsForm) (RegenerateBeatsRes, error) {
-	res, err := c.sendRegenerateBeats(ctx, request)
+// POST /logline/expand
+func (c *Client) ExpandLogline(ctx context.Context, request *LoglineIdea) (ExpandLoglineRes, error) {
+	res, err := c.sendExpandLogline(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendRegenerateBeats(ctx context.Context, request *RegenerateBeatsForm) (res RegenerateBeatsRes, err error) {
+func (c *Client) sendExpandLogline(ctx context.Context, request *LoglineIdea) (res ExpandLoglineRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("regenerateBeats"),
+		otelogen.OperationID("expandLogline"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/beats-sheet/regenerate"),
+		semconv.URLTemplateKey.String("/logline/expand"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1570,7 +1858,7 @@ func (c *Client) sendRegenerateBeats(ctx context.Context, request *RegenerateBea
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RegenerateBeatsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ExpandLoglineOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1588,7 +1876,7 @@ func (c *Client) sendRegenerateBeats(ctx context.Context, request *RegenerateBea
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/beats-sheet/regenerate"
+	pathParts[0] = "/logline/expand"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1596,7 +1884,7 @@ func (c *Client) sendRegenerateBeats(ctx context.Context, request *RegenerateBea
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRegenerateBeatsRequest(request, r); err != nil {
+	if err := encodeExpandLoglineRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1605,7 +1893,7 @@ func (c *Client) sendRegenerateBeats(ctx context.Context, request *RegenerateBea
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RegenerateBeatsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ExpandLoglineOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1641,7 +1929,4489 @@ func (c *Client) sendRegenerateBeats(ctx context.Context, request *RegenerateBea
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRegenerateBeatsResponse(resp)
+	result, err := decodeExpandLoglineResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ForkStoryPlan invokes forkStoryPlan operation.
+//
+// Copy a built-in story plan, or a custom plan owned by the current user, into a new custom plan
+// owned by the
+// current user. The slug and name of the source plan are kept, unless overridden.
+//
+// POST /story-plan/fork
+func (c *Client) ForkStoryPlan(ctx context.Context, request *ForkStoryPlanForm) (ForkStoryPlanRes, error) {
+	res, err := c.sendForkStoryPlan(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendForkStoryPlan(ctx context.Context, request *ForkStoryPlanForm) (res ForkStoryPlanRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("forkStoryPlan"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/story-plan/fork"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ForkStoryPlanOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/story-plan/fork"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeForkStoryPlanRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ForkStoryPlanOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeForkStoryPlanResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GenerateBeatsSheet invokes generateBeatsSheet operation.
+//
+// Generate a new beats sheet for a logline, following a story plan.
+//
+// POST /beats-sheet/generate
+func (c *Client) GenerateBeatsSheet(ctx context.Context, request *GenerateBeatsSheetForm) (GenerateBeatsSheetRes, error) {
+	res, err := c.sendGenerateBeatsSheet(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGenerateBeatsSheet(ctx context.Context, request *GenerateBeatsSheetForm) (res GenerateBeatsSheetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("generateBeatsSheet"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/beats-sheet/generate"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GenerateBeatsSheetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/beats-sheet/generate"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGenerateBeatsSheetRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GenerateBeatsSheetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGenerateBeatsSheetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GenerateLoglineVariations invokes generateLoglineVariations operation.
+//
+// Generate variations of an existing logline. Each variation changes a single dimension of the
+// original logline,
+// among the requested ones, and keeps every other dimension as is. Either derive the variations from
+// a logline
+// of the current user, or from a logline idea that was not saved.
+//
+// POST /loglines/variations
+func (c *Client) GenerateLoglineVariations(ctx context.Context, request *GenerateLoglineVariationsForm) (GenerateLoglineVariationsRes, error) {
+	res, err := c.sendGenerateLoglineVariations(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGenerateLoglineVariations(ctx context.Context, request *GenerateLoglineVariationsForm) (res GenerateLoglineVariationsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("generateLoglineVariations"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/loglines/variations"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GenerateLoglineVariationsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/loglines/variations"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGenerateLoglineVariationsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GenerateLoglineVariationsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGenerateLoglineVariationsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GenerateLoglines invokes generateLoglines operation.
+//
+// Generate new loglines for a user.
+//
+// POST /loglines/generate
+func (c *Client) GenerateLoglines(ctx context.Context, request *GenerateLoglinesForm) (GenerateLoglinesRes, error) {
+	res, err := c.sendGenerateLoglines(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGenerateLoglines(ctx context.Context, request *GenerateLoglinesForm) (res GenerateLoglinesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("generateLoglines"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/loglines/generate"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GenerateLoglinesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/loglines/generate"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGenerateLoglinesRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GenerateLoglinesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGenerateLoglinesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetBeatsSheet invokes getBeatsSheet operation.
+//
+// Get a beats sheet.
+//
+// GET /beats-sheet
+func (c *Client) GetBeatsSheet(ctx context.Context, params GetBeatsSheetParams) (GetBeatsSheetRes, error) {
+	res, err := c.sendGetBeatsSheet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetBeatsSheet(ctx context.Context, params GetBeatsSheetParams) (res GetBeatsSheetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBeatsSheet"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/beats-sheet"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetBeatsSheetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/beats-sheet"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "beatsSheetID" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "beatsSheetID",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if unwrapped := uuid.UUID(params.BeatsSheetID); true {
+				return e.EncodeValue(conv.UUIDToString(unwrapped))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetBeatsSheetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetBeatsSheetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetBeatsSheetDiff invokes getBeatsSheetDiff operation.
+//
+// Match the beats of two beats sheets by key, and compute the word-level changes of each beat. Beats
+// added,
+// removed or moved between both sheets are reported as such.
+//
+// GET /beats-sheet/diff
+func (c *Client) GetBeatsSheetDiff(ctx context.Context, params GetBeatsSheetDiffParams) (GetBeatsSheetDiffRes, error) {
+	res, err := c.sendGetBeatsSheetDiff(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetBeatsSheetDiff(ctx context.Context, params GetBeatsSheetDiffParams) (res GetBeatsSheetDiffRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBeatsSheetDiff"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/beats-sheet/diff"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetBeatsSheetDiffOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/beats-sheet/diff"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if unwrapped := uuid.UUID(params.From); true {
+				return e.EncodeValue(conv.UUIDToString(unwrapped))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if unwrapped := uuid.UUID(params.To); true {
+				return e.EncodeValue(conv.UUIDToString(unwrapped))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetBeatsSheetDiffOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetBeatsSheetDiffResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetBeatsSheets invokes getBeatsSheets operation.
+//
+// Get all beats sheets for the current user. Results are paginated with cursors: pass the nextCursor
+// of a page
+// to get the following one.
+//
+// GET /beats-sheets
+func (c *Client) GetBeatsSheets(ctx context.Context, params GetBeatsSheetsParams) (GetBeatsSheetsRes, error) {
+	res, err := c.sendGetBeatsSheets(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetBeatsSheets(ctx context.Context, params GetBeatsSheetsParams) (res GetBeatsSheetsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBeatsSheets"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/beats-sheets"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetBeatsSheetsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/beats-sheets"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "loglineID" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "loglineID",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if unwrapped := uuid.UUID(params.LoglineID); true {
+				return e.EncodeValue(conv.UUIDToString(unwrapped))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetBeatsSheetsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetBeatsSheetsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetLogline invokes getLogline operation.
+//
+// Get a logline.
+//
+// GET /logline
+func (c *Client) GetLogline(ctx context.Context, params GetLoglineParams) (GetLoglineRes, error) {
+	res, err := c.sendGetLogline(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetLogline(ctx context.Context, params GetLoglineParams) (res GetLoglineRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLogline"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/logline"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetLoglineOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/logline"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ID.Get(); ok {
+				if unwrapped := uuid.UUID(val); true {
+					return e.EncodeValue(conv.UUIDToString(unwrapped))
+				}
+				return nil
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "slug" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "slug",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Slug.Get(); ok {
+				if unwrapped := string(val); true {
+					return e.EncodeValue(conv.StringToString(unwrapped))
+				}
+				return nil
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetLoglineOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetLoglineResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetLoglineRevision invokes getLoglineRevision operation.
+//
+// Get a single revision of a logline owned by the current user.
+//
+// GET /logline/revision
+func (c *Client) GetLoglineRevision(ctx context.Context, params GetLoglineRevisionParams) (GetLoglineRevisionRes, error) {
+	res, err := c.sendGetLoglineRevision(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetLoglineRevision(ctx context.Context, params GetLoglineRevisionParams) (res GetLoglineRevisionRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLoglineRevision"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/logline/revision"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetLoglineRevisionOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/logline/revision"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "revisionID" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "revisionID",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if unwrapped := uuid.UUID(params.RevisionID); true {
+				return e.EncodeValue(conv.UUIDToString(unwrapped))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetLoglineRevisionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetLoglineRevisionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetLoglineRevisionDiff invokes getLoglineRevisionDiff operation.
+//
+// Compute the word-level changes that turn a revision into another one.
+//
+// GET /logline/revision/diff
+func (c *Client) GetLoglineRevisionDiff(ctx context.Context, params GetLoglineRevisionDiffParams) (GetLoglineRevisionDiffRes, error) {
+	res, err := c.sendGetLoglineRevisionDiff(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetLoglineRevisionDiff(ctx context.Context, params GetLoglineRevisionDiffParams) (res GetLoglineRevisionDiffRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLoglineRevisionDiff"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/logline/revision/diff"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetLoglineRevisionDiffOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/logline/revision/diff"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if unwrapped := uuid.UUID(params.From); true {
+				return e.EncodeValue(conv.UUIDToString(unwrapped))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if unwrapped := uuid.UUID(params.To); true {
+				return e.EncodeValue(conv.UUIDToString(unwrapped))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetLoglineRevisionDiffOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetLoglineRevisionDiffResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetLoglineRevisions invokes getLoglineRevisions operation.
+//
+// List the revisions of a logline owned by the current user, most recent first. A revision is saved
+// every time
+// the name, content or language of the logline is written.
+//
+// GET /logline/revisions
+func (c *Client) GetLoglineRevisions(ctx context.Context, params GetLoglineRevisionsParams) (GetLoglineRevisionsRes, error) {
+	res, err := c.sendGetLoglineRevisions(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetLoglineRevisions(ctx context.Context, params GetLoglineRevisionsParams) (res GetLoglineRevisionsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLoglineRevisions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/logline/revisions"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetLoglineRevisionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/logline/revisions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "loglineID" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "loglineID",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if unwrapped := uuid.UUID(params.LoglineID); true {
+				return e.EncodeValue(conv.UUIDToString(unwrapped))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetLoglineRevisionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetLoglineRevisionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetLoglineTags invokes getLoglineTags operation.
+//
+// List the tags used on the loglines of the current user, with the number of loglines using each of
+// them. Most
+// used tags come first. Loglines in the trash are not counted.
+//
+// GET /loglines/tags
+func (c *Client) GetLoglineTags(ctx context.Context) (GetLoglineTagsRes, error) {
+	res, err := c.sendGetLoglineTags(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetLoglineTags(ctx context.Context) (res GetLoglineTagsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLoglineTags"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/loglines/tags"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetLoglineTagsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/loglines/tags"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetLoglineTagsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetLoglineTagsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetLoglines invokes getLoglines operation.
+//
+// Get all loglines for the current user. Results are paginated with cursors: pass the nextCursor of
+// a page to
+// get the following one.
+//
+// GET /loglines
+func (c *Client) GetLoglines(ctx context.Context, params GetLoglinesParams) (GetLoglinesRes, error) {
+	res, err := c.sendGetLoglines(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetLoglines(ctx context.Context, params GetLoglinesParams) (res GetLoglinesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLoglines"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/loglines"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetLoglinesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/loglines"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "genre" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "genre",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Genre.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "lang" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "lang",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Lang.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tag" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tag",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Tag != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Tag {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "projectID" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "projectID",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ProjectID.Get(); ok {
+				if unwrapped := uuid.UUID(val); true {
+					return e.EncodeValue(conv.UUIDToString(unwrapped))
+				}
+				return nil
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetLoglinesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetLoglinesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetProject invokes getProject operation.
+//
+// Get a project owned by the current user.
+//
+// GET /project
+func (c *Client) GetProject(ctx context.Context, params GetProjectParams) (GetProjectRes, error) {
+	res, err := c.sendGetProject(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (res GetProjectRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getProject"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/project"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/project"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "projectID" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "projectID",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if unwrapped := uuid.UUID(params.ProjectID); true {
+				return e.EncodeValue(conv.UUIDToString(unwrapped))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetProjectOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetProjectResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetProjects invokes getProjects operation.
+//
+// Get all the projects of the current user, sorted by name. Results are paginated with cursors: pass
+// the
+// nextCursor of a page to get the following one.
+//
+// GET /projects
+func (c *Client) GetProjects(ctx context.Context, params GetProjectsParams) (GetProjectsRes, error) {
+	res, err := c.sendGetProjects(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetProjects(ctx context.Context, params GetProjectsParams) (res GetProjectsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getProjects"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/projects"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/projects"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetProjectsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetProjectsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetStoryPlan invokes getStoryPlan operation.
+//
+// Get a story plan, either by its unique identifier, or by its slug and language. If neither the id
+// nor the slug
+// is provided, the default story plan for the language is returned. When selecting by slug, the
+// latest version
+// of the plan is returned.
+//
+// GET /story-plan
+func (c *Client) GetStoryPlan(ctx context.Context, params GetStoryPlanParams) (GetStoryPlanRes, error) {
+	res, err := c.sendGetStoryPlan(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetStoryPlan(ctx context.Context, params GetStoryPlanParams) (res GetStoryPlanRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getStoryPlan"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/story-plan"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetStoryPlanOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/story-plan"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ID.Get(); ok {
+				if unwrapped := uuid.UUID(val); true {
+					return e.EncodeValue(conv.UUIDToString(unwrapped))
+				}
+				return nil
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "slug" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "slug",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Slug.Get(); ok {
+				if unwrapped := string(val); true {
+					return e.EncodeValue(conv.StringToString(unwrapped))
+				}
+				return nil
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "lang" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "lang",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Lang.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetStoryPlanOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetStoryPlanResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetStoryPlans invokes getStoryPlans operation.
+//
+// Get all the available story plans.
+//
+// GET /story-plans
+func (c *Client) GetStoryPlans(ctx context.Context, params GetStoryPlansParams) (GetStoryPlansRes, error) {
+	res, err := c.sendGetStoryPlans(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetStoryPlans(ctx context.Context, params GetStoryPlansParams) (res GetStoryPlansRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getStoryPlans"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/story-plans"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetStoryPlansOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/story-plans"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetStoryPlansOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetStoryPlansResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTrashedBeatsSheets invokes getTrashedBeatsSheets operation.
+//
+// List the beats sheets of the current user that were deleted on their own, most recently deleted
+// first. Sheets
+// deleted along with their logline are restored with it, and are not listed here.
+//
+// GET /beats-sheets/trash
+func (c *Client) GetTrashedBeatsSheets(ctx context.Context, params GetTrashedBeatsSheetsParams) (GetTrashedBeatsSheetsRes, error) {
+	res, err := c.sendGetTrashedBeatsSheets(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetTrashedBeatsSheets(ctx context.Context, params GetTrashedBeatsSheetsParams) (res GetTrashedBeatsSheetsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTrashedBeatsSheets"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/beats-sheets/trash"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetTrashedBeatsSheetsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/beats-sheets/trash"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetTrashedBeatsSheetsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetTrashedBeatsSheetsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTrashedLoglines invokes getTrashedLoglines operation.
+//
+// List the loglines of the current user that are in the trash, most recently deleted first.
+//
+// GET /loglines/trash
+func (c *Client) GetTrashedLoglines(ctx context.Context, params GetTrashedLoglinesParams) (GetTrashedLoglinesRes, error) {
+	res, err := c.sendGetTrashedLoglines(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetTrashedLoglines(ctx context.Context, params GetTrashedLoglinesParams) (res GetTrashedLoglinesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTrashedLoglines"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/loglines/trash"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetTrashedLoglinesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/loglines/trash"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetTrashedLoglinesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetTrashedLoglinesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Healthcheck invokes healthcheck operation.
+//
+// Returns a detailed report of the health of the service, including every dependency.
+//
+// GET /healthcheck
+func (c *Client) Healthcheck(ctx context.Context) (HealthcheckRes, error) {
+	res, err := c.sendHealthcheck(ctx)
+	return res, err
+}
+
+func (c *Client) sendHealthcheck(ctx context.Context) (res HealthcheckRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("healthcheck"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/healthcheck"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, HealthcheckOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/healthcheck"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeHealthcheckResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Ping invokes ping operation.
+//
+// Check the status of the service. If the service is running, a successful response is returned.
+//
+// GET /ping
+func (c *Client) Ping(ctx context.Context) (PingRes, error) {
+	res, err := c.sendPing(ctx)
+	return res, err
+}
+
+func (c *Client) sendPing(ctx context.Context) (res PingRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ping"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/ping"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PingOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/ping"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePingResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RegenerateBeats invokes regenerateBeats operation.
+//
+// Regenerate the content of specific beats in a beats sheet.
+// When apply is set, the regenerated beats are saved as a new beats sheet, whose parent is the
+// original one.
+//
+// POST /beats-sheet/regenerate
+func (c *Client) RegenerateBeats(ctx context.Context, request *RegenerateBeatsForm) (RegenerateBeatsRes, error) {
+	res, err := c.sendRegenerateBeats(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRegenerateBeats(ctx context.Context, request *RegenerateBeatsForm) (res RegenerateBeatsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("regenerateBeats"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/beats-sheet/regenerate"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RegenerateBeatsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/beats-sheet/regenerate"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRegenerateBeatsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RegenerateBeatsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRegenerateBeatsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RestoreBeatsSheet invokes restoreBeatsSheet operation.
+//
+// Take a beats sheet out of the trash. Sheets deleted along with their logline must be restored
+// through the
+// logline.
+//
+// POST /beats-sheet/restore
+func (c *Client) RestoreBeatsSheet(ctx context.Context, request *RestoreBeatsSheetForm) (RestoreBeatsSheetRes, error) {
+	res, err := c.sendRestoreBeatsSheet(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRestoreBeatsSheet(ctx context.Context, request *RestoreBeatsSheetForm) (res RestoreBeatsSheetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("restoreBeatsSheet"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/beats-sheet/restore"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RestoreBeatsSheetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/beats-sheet/restore"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRestoreBeatsSheetRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RestoreBeatsSheetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRestoreBeatsSheetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RestoreLogline invokes restoreLogline operation.
+//
+// Take a logline out of the trash, along with the beats sheets that were deleted with it.
+//
+// POST /logline/restore
+func (c *Client) RestoreLogline(ctx context.Context, request *RestoreLoglineForm) (RestoreLoglineRes, error) {
+	res, err := c.sendRestoreLogline(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRestoreLogline(ctx context.Context, request *RestoreLoglineForm) (res RestoreLoglineRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("restoreLogline"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/logline/restore"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RestoreLoglineOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/logline/restore"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRestoreLoglineRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RestoreLoglineOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRestoreLoglineResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RestoreLoglineRevision invokes restoreLoglineRevision operation.
+//
+// Write the name, content and language of an older revision back to its logline. The slug of the
+// logline is
+// kept, and the restored content is saved as a new revision.
+//
+// POST /logline/revision/restore
+func (c *Client) RestoreLoglineRevision(ctx context.Context, request *RestoreLoglineRevisionForm) (RestoreLoglineRevisionRes, error) {
+	res, err := c.sendRestoreLoglineRevision(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRestoreLoglineRevision(ctx context.Context, request *RestoreLoglineRevisionForm) (res RestoreLoglineRevisionRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("restoreLoglineRevision"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/logline/revision/restore"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RestoreLoglineRevisionOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/logline/revision/restore"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRestoreLoglineRevisionRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RestoreLoglineRevisionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRestoreLoglineRevisionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Search invokes search operation.
+//
+// Run a full-text search over the loglines and beats sheets of the current user, outside the trash.
+// Each item is
+// matched using the dictionary of its own language, so words match their inflected forms. The query
+// supports
+// the web search syntax: quoted phrases, "or", and "-" to exclude a word. Hits are returned most
+// relevant first,
+// with snippets of the matching text.
+//
+// GET /search
+func (c *Client) Search(ctx context.Context, params SearchParams) (SearchRes, error) {
+	res, err := c.sendSearch(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendSearch(ctx context.Context, params SearchParams) (res SearchRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("search"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/search"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/search"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "query" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "query",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Query))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "lang" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "lang",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Lang.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SearchOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSearchResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// TranslateBeatsSheet invokes translateBeatsSheet operation.
+//
+// Translate an existing beats sheet to another language. The translation follows the same story plan,
+//
+//	in the
+//
+// target language, and keeps every beat of the original sheet. It is saved as a new beats sheet,
+// linked to the
+// original one.
+//
+// POST /beats-sheet/translate
+func (c *Client) TranslateBeatsSheet(ctx context.Context, request *TranslateBeatsSheetForm) (TranslateBeatsSheetRes, error) {
+	res, err := c.sendTranslateBeatsSheet(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendTranslateBeatsSheet(ctx context.Context, request *TranslateBeatsSheetForm) (res TranslateBeatsSheetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("translateBeatsSheet"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/beats-sheet/translate"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, TranslateBeatsSheetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/beats-sheet/translate"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeTranslateBeatsSheetRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, TranslateBeatsSheetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeTranslateBeatsSheetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// TranslateLogline invokes translateLogline operation.
+//
+// Translate an existing logline to another language. The translation is saved as a new logline,
+// linked to the
+// original one.
+//
+// POST /logline/translate
+func (c *Client) TranslateLogline(ctx context.Context, request *TranslateLoglineForm) (TranslateLoglineRes, error) {
+	res, err := c.sendTranslateLogline(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendTranslateLogline(ctx context.Context, request *TranslateLoglineForm) (res TranslateLoglineRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("translateLogline"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/logline/translate"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, TranslateLoglineOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/logline/translate"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeTranslateLoglineRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, TranslateLoglineOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeTranslateLoglineResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdateBeat invokes updateBeat operation.
+//
+// Edit the title or content of a single beat. Omitted fields keep their current value. The edit is
+// saved as a
+// new beats sheet, whose parent is the original one, and the original sheet is left untouched.
+//
+// PATCH /beats-sheet/beat
+func (c *Client) UpdateBeat(ctx context.Context, request *UpdateBeatForm) (UpdateBeatRes, error) {
+	res, err := c.sendUpdateBeat(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendUpdateBeat(ctx context.Context, request *UpdateBeatForm) (res UpdateBeatRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateBeat"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/beats-sheet/beat"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateBeatOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/beats-sheet/beat"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateBeatRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpdateBeatOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateBeatResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdateCustomStoryPlan invokes updateCustomStoryPlan operation.
+//
+// Create a new version of a story plan owned by the current user. Built-in plans, and plans owned by
+// other
+// users, cannot be updated through this endpoint.
+//
+// PATCH /story-plan/custom
+func (c *Client) UpdateCustomStoryPlan(ctx context.Context, request *UpdateStoryPlanForm) (UpdateCustomStoryPlanRes, error) {
+	res, err := c.sendUpdateCustomStoryPlan(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendUpdateCustomStoryPlan(ctx context.Context, request *UpdateStoryPlanForm) (res UpdateCustomStoryPlanRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateCustomStoryPlan"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/story-plan/custom"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateCustomStoryPlanOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/story-plan/custom"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateCustomStoryPlanRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpdateCustomStoryPlanOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateCustomStoryPlanResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdateLogline invokes updateLogline operation.
+//
+// Edit a logline owned by the current user, in place. Omitted fields keep their current value. If
+// the new slug
+// is already used by another logline of the user, a version number is appended to it.
+//
+// PATCH /logline
+func (c *Client) UpdateLogline(ctx context.Context, request *UpdateLoglineForm) (UpdateLoglineRes, error) {
+	res, err := c.sendUpdateLogline(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendUpdateLogline(ctx context.Context, request *UpdateLoglineForm) (res UpdateLoglineRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateLogline"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/logline"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateLoglineOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/logline"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateLoglineRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpdateLoglineOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateLoglineResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdateProject invokes updateProject operation.
+//
+// Edit a project owned by the current user. Omitted fields keep their current value.
+//
+// PATCH /project
+func (c *Client) UpdateProject(ctx context.Context, request *UpdateProjectForm) (UpdateProjectRes, error) {
+	res, err := c.sendUpdateProject(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendUpdateProject(ctx context.Context, request *UpdateProjectForm) (res UpdateProjectRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateProject"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/project"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateProjectOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/project"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateProjectRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpdateProjectOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateProjectResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdateStoryPlan invokes updateStoryPlan operation.
+//
+// Update the name and beats of an existing story plan. Story plans are immutable: this creates a new
+// version
+// of the plan, with the same slug and language. Previous versions are kept, so existing beats sheets
+// remain
+// pinned to the version they were built against.
+//
+// PATCH /story-plan
+func (c *Client) UpdateStoryPlan(ctx context.Context, request *UpdateStoryPlanForm) (UpdateStoryPlanRes, error) {
+	res, err := c.sendUpdateStoryPlan(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendUpdateStoryPlan(ctx context.Context, request *UpdateStoryPlanForm) (res UpdateStoryPlanRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateStoryPlan"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/story-plan"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateStoryPlanOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/story-plan"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateStoryPlanRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpdateStoryPlanOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateStoryPlanResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpgradeBeatsSheets invokes upgradeBeatsSheets operation.
+//
+// Check every beats sheet pinned to an older version of the target story plan. Sheets whose content
+// is valid
+// under the target version are pinned to it, unless dryRun is set. Incompatible sheets are reported,
+// and left
+// untouched.
+//
+// POST /story-plan/upgrade-beats-sheets
+func (c *Client) UpgradeBeatsSheets(ctx context.Context, request *UpgradeBeatsSheetsForm) (UpgradeBeatsSheetsRes, error) {
+	res, err := c.sendUpgradeBeatsSheets(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendUpgradeBeatsSheets(ctx context.Context, request *UpgradeBeatsSheetsForm) (res UpgradeBeatsSheetsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("upgradeBeatsSheets"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/story-plan/upgrade-beats-sheets"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpgradeBeatsSheetsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/story-plan/upgrade-beats-sheets"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpgradeBeatsSheetsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpgradeBeatsSheetsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpgradeBeatsSheetsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpgradeCustomBeatsSheets invokes upgradeCustomBeatsSheets operation.
+//
+// Same as upgradeBeatsSheets, restricted to the beats sheets of the current user. The target may be
+// a built-in
+// story plan, or one of the user's custom plans.
+//
+// POST /story-plan/custom/upgrade-beats-sheets
+func (c *Client) UpgradeCustomBeatsSheets(ctx context.Context, request *UpgradeBeatsSheetsForm) (UpgradeCustomBeatsSheetsRes, error) {
+	res, err := c.sendUpgradeCustomBeatsSheets(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendUpgradeCustomBeatsSheets(ctx context.Context, request *UpgradeBeatsSheetsForm) (res UpgradeCustomBeatsSheetsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("upgradeCustomBeatsSheets"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/story-plan/custom/upgrade-beats-sheets"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpgradeCustomBeatsSheetsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/story-plan/custom/upgrade-beats-sheets"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpgradeCustomBeatsSheetsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpgradeCustomBeatsSheetsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpgradeCustomBeatsSheetsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
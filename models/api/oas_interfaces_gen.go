@@ -1,14 +1,50 @@
 // Code generated by ogen, DO NOT EDIT.
 package apimodels
 
+type ConvertBeatsSheetRes interface {
+	convertBeatsSheetRes()
+}
+
 type CreateBeatsSheetRes interface {
 	createBeatsSheetRes()
 }
 
+type CreateCustomStoryPlanRes interface {
+	createCustomStoryPlanRes()
+}
+
 type CreateLoglineRes interface {
 	createLoglineRes()
 }
 
+type CreateProjectRes interface {
+	createProjectRes()
+}
+
+type CreateStoryPlanRes interface {
+	createStoryPlanRes()
+}
+
+type CritiqueLoglineRes interface {
+	critiqueLoglineRes()
+}
+
+type DeleteBeatsSheetRes interface {
+	deleteBeatsSheetRes()
+}
+
+type DeleteLoglineRes interface {
+	deleteLoglineRes()
+}
+
+type DeleteProjectRes interface {
+	deleteProjectRes()
+}
+
+type DetectLangRes interface {
+	detectLangRes()
+}
+
 type ExpandBeatRes interface {
 	expandBeatRes()
 }
@@ -17,14 +53,26 @@ type ExpandLoglineRes interface {
 	expandLoglineRes()
 }
 
+type ForkStoryPlanRes interface {
+	forkStoryPlanRes()
+}
+
 type GenerateBeatsSheetRes interface {
 	generateBeatsSheetRes()
 }
 
+type GenerateLoglineVariationsRes interface {
+	generateLoglineVariationsRes()
+}
+
 type GenerateLoglinesRes interface {
 	generateLoglinesRes()
 }
 
+type GetBeatsSheetDiffRes interface {
+	getBeatsSheetDiffRes()
+}
+
 type GetBeatsSheetRes interface {
 	getBeatsSheetRes()
 }
@@ -37,10 +85,50 @@ type GetLoglineRes interface {
 	getLoglineRes()
 }
 
+type GetLoglineRevisionDiffRes interface {
+	getLoglineRevisionDiffRes()
+}
+
+type GetLoglineRevisionRes interface {
+	getLoglineRevisionRes()
+}
+
+type GetLoglineRevisionsRes interface {
+	getLoglineRevisionsRes()
+}
+
+type GetLoglineTagsRes interface {
+	getLoglineTagsRes()
+}
+
 type GetLoglinesRes interface {
 	getLoglinesRes()
 }
 
+type GetProjectRes interface {
+	getProjectRes()
+}
+
+type GetProjectsRes interface {
+	getProjectsRes()
+}
+
+type GetStoryPlanRes interface {
+	getStoryPlanRes()
+}
+
+type GetStoryPlansRes interface {
+	getStoryPlansRes()
+}
+
+type GetTrashedBeatsSheetsRes interface {
+	getTrashedBeatsSheetsRes()
+}
+
+type GetTrashedLoglinesRes interface {
+	getTrashedLoglinesRes()
+}
+
 type HealthcheckRes interface {
 	healthcheckRes()
 }
@@ -52,3 +140,55 @@ type PingRes interface {
 type RegenerateBeatsRes interface {
 	regenerateBeatsRes()
 }
+
+type RestoreBeatsSheetRes interface {
+	restoreBeatsSheetRes()
+}
+
+type RestoreLoglineRes interface {
+	restoreLoglineRes()
+}
+
+type RestoreLoglineRevisionRes interface {
+	restoreLoglineRevisionRes()
+}
+
+type SearchRes interface {
+	searchRes()
+}
+
+type TranslateBeatsSheetRes interface {
+	translateBeatsSheetRes()
+}
+
+type TranslateLoglineRes interface {
+	translateLoglineRes()
+}
+
+type UpdateBeatRes interface {
+	updateBeatRes()
+}
+
+type UpdateCustomStoryPlanRes interface {
+	updateCustomStoryPlanRes()
+}
+
+type UpdateLoglineRes interface {
+	updateLoglineRes()
+}
+
+type UpdateProjectRes interface {
+	updateProjectRes()
+}
+
+type UpdateStoryPlanRes interface {
+	updateStoryPlanRes()
+}
+
+type UpgradeBeatsSheetsRes interface {
+	upgradeBeatsSheetsRes()
+}
+
+type UpgradeCustomBeatsSheetsRes interface {
+	upgradeCustomBeatsSheetsRes()
+}
@@ -6,17 +6,52 @@ package apimodels
 type OperationName = string
 
 const (
-	CreateBeatsSheetOperation   OperationName = "CreateBeatsSheet"
-	CreateLoglineOperation      OperationName = "CreateLogline"
-	ExpandBeatOperation         OperationName = "ExpandBeat"
-	ExpandLoglineOperation      OperationName = "ExpandLogline"
-	GenerateBeatsSheetOperation OperationName = "GenerateBeatsSheet"
-	GenerateLoglinesOperation   OperationName = "GenerateLoglines"
-	GetBeatsSheetOperation      OperationName = "GetBeatsSheet"
-	GetBeatsSheetsOperation     OperationName = "GetBeatsSheets"
-	GetLoglineOperation         OperationName = "GetLogline"
-	GetLoglinesOperation        OperationName = "GetLoglines"
-	HealthcheckOperation        OperationName = "Healthcheck"
-	PingOperation               OperationName = "Ping"
-	RegenerateBeatsOperation    OperationName = "RegenerateBeats"
+	ConvertBeatsSheetOperation         OperationName = "ConvertBeatsSheet"
+	CreateBeatsSheetOperation          OperationName = "CreateBeatsSheet"
+	CreateCustomStoryPlanOperation     OperationName = "CreateCustomStoryPlan"
+	CreateLoglineOperation             OperationName = "CreateLogline"
+	CreateProjectOperation             OperationName = "CreateProject"
+	CreateStoryPlanOperation           OperationName = "CreateStoryPlan"
+	CritiqueLoglineOperation           OperationName = "CritiqueLogline"
+	DeleteBeatsSheetOperation          OperationName = "DeleteBeatsSheet"
+	DeleteLoglineOperation             OperationName = "DeleteLogline"
+	DeleteProjectOperation             OperationName = "DeleteProject"
+	DetectLangOperation                OperationName = "DetectLang"
+	ExpandBeatOperation                OperationName = "ExpandBeat"
+	ExpandLoglineOperation             OperationName = "ExpandLogline"
+	ForkStoryPlanOperation             OperationName = "ForkStoryPlan"
+	GenerateBeatsSheetOperation        OperationName = "GenerateBeatsSheet"
+	GenerateLoglineVariationsOperation OperationName = "GenerateLoglineVariations"
+	GenerateLoglinesOperation          OperationName = "GenerateLoglines"
+	GetBeatsSheetOperation             OperationName = "GetBeatsSheet"
+	GetBeatsSheetDiffOperation         OperationName = "GetBeatsSheetDiff"
+	GetBeatsSheetsOperation            OperationName = "GetBeatsSheets"
+	GetLoglineOperation                OperationName = "GetLogline"
+	GetLoglineRevisionOperation        OperationName = "GetLoglineRevision"
+	GetLoglineRevisionDiffOperation    OperationName = "GetLoglineRevisionDiff"
+	GetLoglineRevisionsOperation       OperationName = "GetLoglineRevisions"
+	GetLoglineTagsOperation            OperationName = "GetLoglineTags"
+	GetLoglinesOperation               OperationName = "GetLoglines"
+	GetProjectOperation                OperationName = "GetProject"
+	GetProjectsOperation               OperationName = "GetProjects"
+	GetStoryPlanOperation              OperationName = "GetStoryPlan"
+	GetStoryPlansOperation             OperationName = "GetStoryPlans"
+	GetTrashedBeatsSheetsOperation     OperationName = "GetTrashedBeatsSheets"
+	GetTrashedLoglinesOperation        OperationName = "GetTrashedLoglines"
+	HealthcheckOperation               OperationName = "Healthcheck"
+	PingOperation                      OperationName = "Ping"
+	RegenerateBeatsOperation           OperationName = "RegenerateBeats"
+	RestoreBeatsSheetOperation         OperationName = "RestoreBeatsSheet"
+	RestoreLoglineOperation            OperationName = "RestoreLogline"
+	RestoreLoglineRevisionOperation    OperationName = "RestoreLoglineRevision"
+	SearchOperation                    OperationName = "Search"
+	TranslateBeatsSheetOperation       OperationName = "TranslateBeatsSheet"
+	TranslateLoglineOperation          OperationName = "TranslateLogline"
+	UpdateBeatOperation                OperationName = "UpdateBeat"
+	UpdateCustomStoryPlanOperation     OperationName = "UpdateCustomStoryPlan"
+	UpdateLoglineOperation             OperationName = "UpdateLogline"
+	UpdateProjectOperation             OperationName = "UpdateProject"
+	UpdateStoryPlanOperation           OperationName = "UpdateStoryPlan"
+	UpgradeBeatsSheetsOperation        OperationName = "UpgradeBeatsSheets"
+	UpgradeCustomBeatsSheetsOperation  OperationName = "UpgradeCustomBeatsSheets"
 )
@@ -70,12 +70,14 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 				if len(elem) == 0 {
 					switch r.Method {
+					case "DELETE":
+						s.handleDeleteBeatsSheetRequest([0]string{}, elemIsEscaped, w, r)
 					case "GET":
 						s.handleGetBeatsSheetRequest([0]string{}, elemIsEscaped, w, r)
 					case "PUT":
 						s.handleCreateBeatsSheetRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET,PUT")
+						s.notAllowed(w, r, "DELETE,GET,PUT")
 					}
 
 					return
@@ -93,6 +95,66 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
+					case 'b': // Prefix: "beat"
+
+						if l := len("beat"); len(elem) >= l && elem[0:l] == "beat" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "PATCH":
+								s.handleUpdateBeatRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "PATCH")
+							}
+
+							return
+						}
+
+					case 'c': // Prefix: "convert"
+
+						if l := len("convert"); len(elem) >= l && elem[0:l] == "convert" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleConvertBeatsSheetRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 'd': // Prefix: "diff"
+
+						if l := len("diff"); len(elem) >= l && elem[0:l] == "diff" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetBeatsSheetDiffRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
 					case 'e': // Prefix: "expand"
 
 						if l := len("expand"); len(elem) >= l && elem[0:l] == "expand" {
@@ -133,9 +195,63 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							return
 						}
 
-					case 'r': // Prefix: "regenerate"
+					case 'r': // Prefix: "re"
+
+						if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'g': // Prefix: "generate"
+
+							if l := len("generate"); len(elem) >= l && elem[0:l] == "generate" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleRegenerateBeatsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 's': // Prefix: "store"
+
+							if l := len("store"); len(elem) >= l && elem[0:l] == "store" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleRestoreBeatsSheetRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					case 't': // Prefix: "translate"
 
-						if l := len("regenerate"); len(elem) >= l && elem[0:l] == "regenerate" {
+						if l := len("translate"); len(elem) >= l && elem[0:l] == "translate" {
 							elem = elem[l:]
 						} else {
 							break
@@ -145,7 +261,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "POST":
-								s.handleRegenerateBeatsRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleTranslateBeatsSheetRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "POST")
 							}
@@ -164,7 +280,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
 						case "GET":
 							s.handleGetBeatsSheetsRequest([0]string{}, elemIsEscaped, w, r)
@@ -174,6 +289,28 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/trash"
+
+						if l := len("/trash"); len(elem) >= l && elem[0:l] == "/trash" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetTrashedBeatsSheetsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
 
 				}
 
@@ -197,30 +334,21 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					return
 				}
 
-			case 'l': // Prefix: "logline"
+			case 'l': // Prefix: "l"
 
-				if l := len("logline"); len(elem) >= l && elem[0:l] == "logline" {
+				if l := len("l"); len(elem) >= l && elem[0:l] == "l" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch r.Method {
-					case "GET":
-						s.handleGetLoglineRequest([0]string{}, elemIsEscaped, w, r)
-					case "PUT":
-						s.handleCreateLoglineRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET,PUT")
-					}
-
-					return
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/expand"
+				case 'a': // Prefix: "ang/detect"
 
-					if l := len("/expand"); len(elem) >= l && elem[0:l] == "/expand" {
+					if l := len("ang/detect"); len(elem) >= l && elem[0:l] == "ang/detect" {
 						elem = elem[l:]
 					} else {
 						break
@@ -230,7 +358,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						// Leaf node.
 						switch r.Method {
 						case "POST":
-							s.handleExpandLoglineRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleDetectLangRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "POST")
 						}
@@ -238,9 +366,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						return
 					}
 
-				case 's': // Prefix: "s"
+				case 'o': // Prefix: "ogline"
 
-					if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+					if l := len("ogline"); len(elem) >= l && elem[0:l] == "ogline" {
 						elem = elem[l:]
 					} else {
 						break
@@ -248,127 +376,682 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					if len(elem) == 0 {
 						switch r.Method {
+						case "DELETE":
+							s.handleDeleteLoglineRequest([0]string{}, elemIsEscaped, w, r)
 						case "GET":
-							s.handleGetLoglinesRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleGetLoglineRequest([0]string{}, elemIsEscaped, w, r)
+						case "PATCH":
+							s.handleUpdateLoglineRequest([0]string{}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleCreateLoglineRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET")
+							s.notAllowed(w, r, "DELETE,GET,PATCH,PUT")
 						}
 
 						return
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/generate"
+					case '/': // Prefix: "/"
 
-						if l := len("/generate"); len(elem) >= l && elem[0:l] == "/generate" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "critique"
+
+							if l := len("critique"); len(elem) >= l && elem[0:l] == "critique" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleCritiqueLoglineRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'e': // Prefix: "expand"
+
+							if l := len("expand"); len(elem) >= l && elem[0:l] == "expand" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleExpandLoglineRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'r': // Prefix: "re"
+
+							if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 's': // Prefix: "store"
+
+								if l := len("store"); len(elem) >= l && elem[0:l] == "store" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleRestoreLoglineRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'v': // Prefix: "vision"
+
+								if l := len("vision"); len(elem) >= l && elem[0:l] == "vision" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleGetLoglineRevisionRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'd': // Prefix: "diff"
+
+										if l := len("diff"); len(elem) >= l && elem[0:l] == "diff" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetLoglineRevisionDiffRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									case 'r': // Prefix: "restore"
+
+										if l := len("restore"); len(elem) >= l && elem[0:l] == "restore" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleRestoreLoglineRevisionRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								case 's': // Prefix: "s"
+
+									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetLoglineRevisionsRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						case 't': // Prefix: "translate"
+
+							if l := len("translate"); len(elem) >= l && elem[0:l] == "translate" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleTranslateLoglineRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
-							case "POST":
-								s.handleGenerateLoglinesRequest([0]string{}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetLoglinesRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "POST")
+								s.notAllowed(w, r, "GET")
 							}
 
 							return
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'g': // Prefix: "generate"
+
+								if l := len("generate"); len(elem) >= l && elem[0:l] == "generate" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleGenerateLoglinesRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 't': // Prefix: "t"
+
+								if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "ags"
+
+									if l := len("ags"); len(elem) >= l && elem[0:l] == "ags" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetLoglineTagsRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'r': // Prefix: "rash"
+
+									if l := len("rash"); len(elem) >= l && elem[0:l] == "rash" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetTrashedLoglinesRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							case 'v': // Prefix: "variations"
+
+								if l := len("variations"); len(elem) >= l && elem[0:l] == "variations" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleGenerateLoglineVariationsRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
 
 					}
 
 				}
 
-			case 'p': // Prefix: "ping"
+			case 'p': // Prefix: "p"
 
-				if l := len("ping"); len(elem) >= l && elem[0:l] == "ping" {
+				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handlePingRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
+					break
+				}
+				switch elem[0] {
+				case 'i': // Prefix: "ing"
+
+					if l := len("ing"); len(elem) >= l && elem[0:l] == "ing" {
+						elem = elem[l:]
+					} else {
+						break
 					}
 
-					return
-				}
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handlePingRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
 
-			}
+						return
+					}
 
-		}
-	}
-	s.notFound(w, r)
-}
+				case 'r': // Prefix: "roject"
 
-// Route is route object.
-type Route struct {
-	name        string
-	summary     string
-	operationID string
-	pathPattern string
-	count       int
-	args        [0]string
-}
+					if l := len("roject"); len(elem) >= l && elem[0:l] == "roject" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
+					if len(elem) == 0 {
+						switch r.Method {
+						case "DELETE":
+							s.handleDeleteProjectRequest([0]string{}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleGetProjectRequest([0]string{}, elemIsEscaped, w, r)
+						case "PATCH":
+							s.handleUpdateProjectRequest([0]string{}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleCreateProjectRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,GET,PATCH,PUT")
+						}
 
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
+						return
+					}
+					switch elem[0] {
+					case 's': // Prefix: "s"
 
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetProjectsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
 
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
+							return
+						}
 
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
+					}
 
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
 				}
-			}
-		}()
+
+			case 's': // Prefix: "s"
+
+				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "earch"
+
+					if l := len("earch"); len(elem) >= l && elem[0:l] == "earch" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleSearchRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case 't': // Prefix: "tory-plan"
+
+					if l := len("tory-plan"); len(elem) >= l && elem[0:l] == "tory-plan" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetStoryPlanRequest([0]string{}, elemIsEscaped, w, r)
+						case "PATCH":
+							s.handleUpdateStoryPlanRequest([0]string{}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleCreateStoryPlanRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,PATCH,PUT")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "custom"
+
+							if l := len("custom"); len(elem) >= l && elem[0:l] == "custom" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "PATCH":
+									s.handleUpdateCustomStoryPlanRequest([0]string{}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleCreateCustomStoryPlanRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "PATCH,PUT")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/upgrade-beats-sheets"
+
+								if l := len("/upgrade-beats-sheets"); len(elem) >= l && elem[0:l] == "/upgrade-beats-sheets" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUpgradeCustomBeatsSheetsRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						case 'f': // Prefix: "fork"
+
+							if l := len("fork"); len(elem) >= l && elem[0:l] == "fork" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleForkStoryPlanRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'u': // Prefix: "upgrade-beats-sheets"
+
+							if l := len("upgrade-beats-sheets"); len(elem) >= l && elem[0:l] == "upgrade-beats-sheets" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleUpgradeBeatsSheetsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetStoryPlansRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name        string
+	summary     string
+	operationID string
+	pathPattern string
+	count       int
+	args        [0]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
 	}
 
 	elem, ok := s.cutPrefix(elem)
@@ -405,6 +1088,14 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				if len(elem) == 0 {
 					switch method {
+					case "DELETE":
+						r.name = DeleteBeatsSheetOperation
+						r.summary = "Delete a beats sheet."
+						r.operationID = "deleteBeatsSheet"
+						r.pathPattern = "/beats-sheet"
+						r.args = args
+						r.count = 0
+						return r, true
 					case "GET":
 						r.name = GetBeatsSheetOperation
 						r.summary = "Get a beats sheet."
@@ -438,6 +1129,78 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
+					case 'b': // Prefix: "beat"
+
+						if l := len("beat"); len(elem) >= l && elem[0:l] == "beat" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "PATCH":
+								r.name = UpdateBeatOperation
+								r.summary = "Update a beat in a beats sheet."
+								r.operationID = "updateBeat"
+								r.pathPattern = "/beats-sheet/beat"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'c': // Prefix: "convert"
+
+						if l := len("convert"); len(elem) >= l && elem[0:l] == "convert" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = ConvertBeatsSheetOperation
+								r.summary = "Convert a beats sheet to another story plan."
+								r.operationID = "convertBeatsSheet"
+								r.pathPattern = "/beats-sheet/convert"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'd': // Prefix: "diff"
+
+						if l := len("diff"); len(elem) >= l && elem[0:l] == "diff" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetBeatsSheetDiffOperation
+								r.summary = "Compare two beats sheets."
+								r.operationID = "getBeatsSheetDiff"
+								r.pathPattern = "/beats-sheet/diff"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
 					case 'e': // Prefix: "expand"
 
 						if l := len("expand"); len(elem) >= l && elem[0:l] == "expand" {
@@ -486,9 +1249,71 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 
-					case 'r': // Prefix: "regenerate"
+					case 'r': // Prefix: "re"
 
-						if l := len("regenerate"); len(elem) >= l && elem[0:l] == "regenerate" {
+						if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'g': // Prefix: "generate"
+
+							if l := len("generate"); len(elem) >= l && elem[0:l] == "generate" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = RegenerateBeatsOperation
+									r.summary = "Regenerate beats in a beats sheet."
+									r.operationID = "regenerateBeats"
+									r.pathPattern = "/beats-sheet/regenerate"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 's': // Prefix: "store"
+
+							if l := len("store"); len(elem) >= l && elem[0:l] == "store" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = RestoreBeatsSheetOperation
+									r.summary = "Restore a beats sheet."
+									r.operationID = "restoreBeatsSheet"
+									r.pathPattern = "/beats-sheet/restore"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 't': // Prefix: "translate"
+
+						if l := len("translate"); len(elem) >= l && elem[0:l] == "translate" {
 							elem = elem[l:]
 						} else {
 							break
@@ -498,10 +1323,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							// Leaf node.
 							switch method {
 							case "POST":
-								r.name = RegenerateBeatsOperation
-								r.summary = "Regenerate beats in a beats sheet."
-								r.operationID = "regenerateBeats"
-								r.pathPattern = "/beats-sheet/regenerate"
+								r.name = TranslateBeatsSheetOperation
+								r.summary = "Translate a beats sheet to another language."
+								r.operationID = "translateBeatsSheet"
+								r.pathPattern = "/beats-sheet/translate"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -521,7 +1346,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch method {
 						case "GET":
 							r.name = GetBeatsSheetsOperation
@@ -535,6 +1359,32 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/trash"
+
+						if l := len("/trash"); len(elem) >= l && elem[0:l] == "/trash" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetTrashedBeatsSheetsOperation
+								r.summary = "List the beats sheets in the trash."
+								r.operationID = "getTrashedBeatsSheets"
+								r.pathPattern = "/beats-sheets/trash"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
 
 				}
 
@@ -562,40 +1412,21 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 				}
 
-			case 'l': // Prefix: "logline"
+			case 'l': // Prefix: "l"
 
-				if l := len("logline"); len(elem) >= l && elem[0:l] == "logline" {
+				if l := len("l"); len(elem) >= l && elem[0:l] == "l" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = GetLoglineOperation
-						r.summary = "Get a logline."
-						r.operationID = "getLogline"
-						r.pathPattern = "/logline"
-						r.args = args
-						r.count = 0
-						return r, true
-					case "PUT":
-						r.name = CreateLoglineOperation
-						r.summary = "Create a new logline."
-						r.operationID = "createLogline"
-						r.pathPattern = "/logline"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/expand"
+				case 'a': // Prefix: "ang/detect"
 
-					if l := len("/expand"); len(elem) >= l && elem[0:l] == "/expand" {
+					if l := len("ang/detect"); len(elem) >= l && elem[0:l] == "ang/detect" {
 						elem = elem[l:]
 					} else {
 						break
@@ -605,10 +1436,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						// Leaf node.
 						switch method {
 						case "POST":
-							r.name = ExpandLoglineOperation
-							r.summary = "Expand a logline idea."
-							r.operationID = "expandLogline"
-							r.pathPattern = "/logline/expand"
+							r.name = DetectLangOperation
+							r.summary = "Detect the language of a text."
+							r.operationID = "detectLang"
+							r.pathPattern = "/lang/detect"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -617,9 +1448,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 
-				case 's': // Prefix: "s"
+				case 'o': // Prefix: "ogline"
 
-					if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+					if l := len("ogline"); len(elem) >= l && elem[0:l] == "ogline" {
 						elem = elem[l:]
 					} else {
 						break
@@ -627,11 +1458,35 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					if len(elem) == 0 {
 						switch method {
+						case "DELETE":
+							r.name = DeleteLoglineOperation
+							r.summary = "Delete a logline."
+							r.operationID = "deleteLogline"
+							r.pathPattern = "/logline"
+							r.args = args
+							r.count = 0
+							return r, true
 						case "GET":
-							r.name = GetLoglinesOperation
-							r.summary = "Get all loglines."
-							r.operationID = "getLoglines"
-							r.pathPattern = "/loglines"
+							r.name = GetLoglineOperation
+							r.summary = "Get a logline."
+							r.operationID = "getLogline"
+							r.pathPattern = "/logline"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "PATCH":
+							r.name = UpdateLoglineOperation
+							r.summary = "Update a logline."
+							r.operationID = "updateLogline"
+							r.pathPattern = "/logline"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "PUT":
+							r.name = CreateLoglineOperation
+							r.summary = "Create a new logline."
+							r.operationID = "createLogline"
+							r.pathPattern = "/logline"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -640,9 +1495,480 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/generate"
+					case '/': // Prefix: "/"
 
-						if l := len("/generate"); len(elem) >= l && elem[0:l] == "/generate" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "critique"
+
+							if l := len("critique"); len(elem) >= l && elem[0:l] == "critique" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = CritiqueLoglineOperation
+									r.summary = "Critique a logline."
+									r.operationID = "critiqueLogline"
+									r.pathPattern = "/logline/critique"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'e': // Prefix: "expand"
+
+							if l := len("expand"); len(elem) >= l && elem[0:l] == "expand" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ExpandLoglineOperation
+									r.summary = "Expand a logline idea."
+									r.operationID = "expandLogline"
+									r.pathPattern = "/logline/expand"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'r': // Prefix: "re"
+
+							if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 's': // Prefix: "store"
+
+								if l := len("store"); len(elem) >= l && elem[0:l] == "store" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = RestoreLoglineOperation
+										r.summary = "Restore a logline."
+										r.operationID = "restoreLogline"
+										r.pathPattern = "/logline/restore"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'v': // Prefix: "vision"
+
+								if l := len("vision"); len(elem) >= l && elem[0:l] == "vision" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = GetLoglineRevisionOperation
+										r.summary = "Get a revision of a logline."
+										r.operationID = "getLoglineRevision"
+										r.pathPattern = "/logline/revision"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'd': // Prefix: "diff"
+
+										if l := len("diff"); len(elem) >= l && elem[0:l] == "diff" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetLoglineRevisionDiffOperation
+												r.summary = "Compare two revisions of a logline."
+												r.operationID = "getLoglineRevisionDiff"
+												r.pathPattern = "/logline/revision/diff"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'r': // Prefix: "restore"
+
+										if l := len("restore"); len(elem) >= l && elem[0:l] == "restore" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = RestoreLoglineRevisionOperation
+												r.summary = "Restore a revision of a logline."
+												r.operationID = "restoreLoglineRevision"
+												r.pathPattern = "/logline/revision/restore"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 's': // Prefix: "s"
+
+									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetLoglineRevisionsOperation
+											r.summary = "List the revisions of a logline."
+											r.operationID = "getLoglineRevisions"
+											r.pathPattern = "/logline/revisions"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						case 't': // Prefix: "translate"
+
+							if l := len("translate"); len(elem) >= l && elem[0:l] == "translate" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = TranslateLoglineOperation
+									r.summary = "Translate a logline to another language."
+									r.operationID = "translateLogline"
+									r.pathPattern = "/logline/translate"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = GetLoglinesOperation
+								r.summary = "Get all loglines."
+								r.operationID = "getLoglines"
+								r.pathPattern = "/loglines"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'g': // Prefix: "generate"
+
+								if l := len("generate"); len(elem) >= l && elem[0:l] == "generate" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = GenerateLoglinesOperation
+										r.summary = "Generate new loglines."
+										r.operationID = "generateLoglines"
+										r.pathPattern = "/loglines/generate"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 't': // Prefix: "t"
+
+								if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "ags"
+
+									if l := len("ags"); len(elem) >= l && elem[0:l] == "ags" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetLoglineTagsOperation
+											r.summary = "List the tags of the loglines."
+											r.operationID = "getLoglineTags"
+											r.pathPattern = "/loglines/tags"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "rash"
+
+									if l := len("rash"); len(elem) >= l && elem[0:l] == "rash" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetTrashedLoglinesOperation
+											r.summary = "List the loglines in the trash."
+											r.operationID = "getTrashedLoglines"
+											r.pathPattern = "/loglines/trash"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'v': // Prefix: "variations"
+
+								if l := len("variations"); len(elem) >= l && elem[0:l] == "variations" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = GenerateLoglineVariationsOperation
+										r.summary = "Generate variations of a logline."
+										r.operationID = "generateLoglineVariations"
+										r.pathPattern = "/loglines/variations"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'p': // Prefix: "p"
+
+				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'i': // Prefix: "ing"
+
+					if l := len("ing"); len(elem) >= l && elem[0:l] == "ing" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = PingOperation
+							r.summary = "Check the status of the service."
+							r.operationID = "ping"
+							r.pathPattern = "/ping"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 'r': // Prefix: "roject"
+
+					if l := len("roject"); len(elem) >= l && elem[0:l] == "roject" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "DELETE":
+							r.name = DeleteProjectOperation
+							r.summary = "Delete a project."
+							r.operationID = "deleteProject"
+							r.pathPattern = "/project"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "GET":
+							r.name = GetProjectOperation
+							r.summary = "Get a project."
+							r.operationID = "getProject"
+							r.pathPattern = "/project"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "PATCH":
+							r.name = UpdateProjectOperation
+							r.summary = "Update a project."
+							r.operationID = "updateProject"
+							r.pathPattern = "/project"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "PUT":
+							r.name = CreateProjectOperation
+							r.summary = "Create a new project."
+							r.operationID = "createProject"
+							r.pathPattern = "/project"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 							elem = elem[l:]
 						} else {
 							break
@@ -651,11 +1977,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
-							case "POST":
-								r.name = GenerateLoglinesOperation
-								r.summary = "Generate new loglines."
-								r.operationID = "generateLoglines"
-								r.pathPattern = "/loglines/generate"
+							case "GET":
+								r.name = GetProjectsOperation
+								r.summary = "Get all projects."
+								r.operationID = "getProjects"
+								r.pathPattern = "/projects"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -668,28 +1994,226 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				}
 
-			case 'p': // Prefix: "ping"
+			case 's': // Prefix: "s"
 
-				if l := len("ping"); len(elem) >= l && elem[0:l] == "ping" {
+				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "GET":
-						r.name = PingOperation
-						r.summary = "Check the status of the service."
-						r.operationID = "ping"
-						r.pathPattern = "/ping"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "earch"
+
+					if l := len("earch"); len(elem) >= l && elem[0:l] == "earch" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = SearchOperation
+							r.summary = "Search loglines and beats sheets."
+							r.operationID = "search"
+							r.pathPattern = "/search"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 't': // Prefix: "tory-plan"
+
+					if l := len("tory-plan"); len(elem) >= l && elem[0:l] == "tory-plan" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetStoryPlanOperation
+							r.summary = "Get a story plan."
+							r.operationID = "getStoryPlan"
+							r.pathPattern = "/story-plan"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "PATCH":
+							r.name = UpdateStoryPlanOperation
+							r.summary = "Update a story plan."
+							r.operationID = "updateStoryPlan"
+							r.pathPattern = "/story-plan"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "PUT":
+							r.name = CreateStoryPlanOperation
+							r.summary = "Create a new story plan."
+							r.operationID = "createStoryPlan"
+							r.pathPattern = "/story-plan"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "custom"
+
+							if l := len("custom"); len(elem) >= l && elem[0:l] == "custom" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "PATCH":
+									r.name = UpdateCustomStoryPlanOperation
+									r.summary = "Update a custom story plan."
+									r.operationID = "updateCustomStoryPlan"
+									r.pathPattern = "/story-plan/custom"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "PUT":
+									r.name = CreateCustomStoryPlanOperation
+									r.summary = "Create a custom story plan."
+									r.operationID = "createCustomStoryPlan"
+									r.pathPattern = "/story-plan/custom"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/upgrade-beats-sheets"
+
+								if l := len("/upgrade-beats-sheets"); len(elem) >= l && elem[0:l] == "/upgrade-beats-sheets" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = UpgradeCustomBeatsSheetsOperation
+										r.summary = "Upgrade the beats sheets of the current user to a story plan version."
+										r.operationID = "upgradeCustomBeatsSheets"
+										r.pathPattern = "/story-plan/custom/upgrade-beats-sheets"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 'f': // Prefix: "fork"
+
+							if l := len("fork"); len(elem) >= l && elem[0:l] == "fork" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ForkStoryPlanOperation
+									r.summary = "Fork a story plan."
+									r.operationID = "forkStoryPlan"
+									r.pathPattern = "/story-plan/fork"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'u': // Prefix: "upgrade-beats-sheets"
+
+							if l := len("upgrade-beats-sheets"); len(elem) >= l && elem[0:l] == "upgrade-beats-sheets" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = UpgradeBeatsSheetsOperation
+									r.summary = "Upgrade beats sheets to a story plan version."
+									r.operationID = "upgradeBeatsSheets"
+									r.pathPattern = "/story-plan/upgrade-beats-sheets"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetStoryPlansOperation
+								r.summary = "Get all story plans."
+								r.operationID = "getStoryPlans"
+								r.pathPattern = "/story-plans"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
 					}
+
 				}
 
 			}
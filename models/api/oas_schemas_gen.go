@@ -84,6 +84,159 @@ func (s *Beat) SetContent(val string) {
 
 func (*Beat) expandBeatRes() {}
 
+// The changes made to a single beat. Beats are matched by key, and repeated keys are matched in
+// order.
+// Ref: #/components/schemas/BeatDiff
+type BeatDiff struct {
+	// The key of the beat in the story plan.
+	Key    string         `json:"key"`
+	Status BeatDiffStatus `json:"status"`
+	// The position of the beat in the older sheet. Missing for added beats.
+	FromIndex OptInt `json:"fromIndex"`
+	// The position of the beat in the newer sheet. Missing for removed beats.
+	ToIndex OptInt `json:"toIndex"`
+	// Whether a kept beat changed position relative to the other kept beats. Beats shifted by the
+	// addition or
+	// removal of other beats are not moved.
+	Moved bool `json:"moved"`
+	// Whether the title or content of a kept beat changed.
+	Modified bool        `json:"modified"`
+	Title    []DiffChunk `json:"title"`
+	Content  []DiffChunk `json:"content"`
+}
+
+// GetKey returns the value of Key.
+func (s *BeatDiff) GetKey() string {
+	return s.Key
+}
+
+// GetStatus returns the value of Status.
+func (s *BeatDiff) GetStatus() BeatDiffStatus {
+	return s.Status
+}
+
+// GetFromIndex returns the value of FromIndex.
+func (s *BeatDiff) GetFromIndex() OptInt {
+	return s.FromIndex
+}
+
+// GetToIndex returns the value of ToIndex.
+func (s *BeatDiff) GetToIndex() OptInt {
+	return s.ToIndex
+}
+
+// GetMoved returns the value of Moved.
+func (s *BeatDiff) GetMoved() bool {
+	return s.Moved
+}
+
+// GetModified returns the value of Modified.
+func (s *BeatDiff) GetModified() bool {
+	return s.Modified
+}
+
+// GetTitle returns the value of Title.
+func (s *BeatDiff) GetTitle() []DiffChunk {
+	return s.Title
+}
+
+// GetContent returns the value of Content.
+func (s *BeatDiff) GetContent() []DiffChunk {
+	return s.Content
+}
+
+// SetKey sets the value of Key.
+func (s *BeatDiff) SetKey(val string) {
+	s.Key = val
+}
+
+// SetStatus sets the value of Status.
+func (s *BeatDiff) SetStatus(val BeatDiffStatus) {
+	s.Status = val
+}
+
+// SetFromIndex sets the value of FromIndex.
+func (s *BeatDiff) SetFromIndex(val OptInt) {
+	s.FromIndex = val
+}
+
+// SetToIndex sets the value of ToIndex.
+func (s *BeatDiff) SetToIndex(val OptInt) {
+	s.ToIndex = val
+}
+
+// SetMoved sets the value of Moved.
+func (s *BeatDiff) SetMoved(val bool) {
+	s.Moved = val
+}
+
+// SetModified sets the value of Modified.
+func (s *BeatDiff) SetModified(val bool) {
+	s.Modified = val
+}
+
+// SetTitle sets the value of Title.
+func (s *BeatDiff) SetTitle(val []DiffChunk) {
+	s.Title = val
+}
+
+// SetContent sets the value of Content.
+func (s *BeatDiff) SetContent(val []DiffChunk) {
+	s.Content = val
+}
+
+// Whether a beat is present in both sheets (kept), only in the newer one (added), or only in the
+// older one
+// (removed).
+// Ref: #/components/schemas/BeatDiffStatus
+type BeatDiffStatus string
+
+const (
+	BeatDiffStatusAdded   BeatDiffStatus = "added"
+	BeatDiffStatusRemoved BeatDiffStatus = "removed"
+	BeatDiffStatusKept    BeatDiffStatus = "kept"
+)
+
+// AllValues returns all BeatDiffStatus values.
+func (BeatDiffStatus) AllValues() []BeatDiffStatus {
+	return []BeatDiffStatus{
+		BeatDiffStatusAdded,
+		BeatDiffStatusRemoved,
+		BeatDiffStatusKept,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s BeatDiffStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case BeatDiffStatusAdded:
+		return []byte(s), nil
+	case BeatDiffStatusRemoved:
+		return []byte(s), nil
+	case BeatDiffStatusKept:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BeatDiffStatus) UnmarshalText(data []byte) error {
+	switch BeatDiffStatus(data) {
+	case BeatDiffStatusAdded:
+		*s = BeatDiffStatusAdded
+		return nil
+	case BeatDiffStatusRemoved:
+		*s = BeatDiffStatusRemoved
+		return nil
+	case BeatDiffStatusKept:
+		*s = BeatDiffStatusKept
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type Beats []Beat
 
 func (*Beats) regenerateBeatsRes() {}
@@ -93,9 +246,22 @@ func (*Beats) regenerateBeatsRes() {}
 type BeatsSheet struct {
 	ID        BeatsSheetID `json:"id"`
 	LoglineID LoglineID    `json:"loglineID"`
-	Content   []Beat       `json:"content"`
+	// The story plan the beats sheet follows. Missing for beats sheets created before story plans could
+	// be
+	// selected, in which case the default story plan for the language applies.
+	StoryPlanID OptStoryPlanID `json:"storyPlanID"`
+	// The beats sheet this one was converted or translated from, if any.
+	SourceID OptBeatsSheetID `json:"sourceID"`
+	// The previous revision of the beats sheet, if it was created by applying regenerated or expanded
+	// beats.
+	ParentID OptBeatsSheetID `json:"parentID"`
+	Content  []Beat          `json:"content"`
 	// The language of the beats sheet.
 	Lang Lang `json:"lang"`
+	// The content of the beats sheet, grouped following the acts of its story plan. Missing if the story
+	// plan
+	// does not define any act.
+	Acts []BeatsSheetAct `json:"acts"`
 	// The date and time at which the beats sheet was created.
 	CreatedAt time.Time `json:"createdAt"`
 }
@@ -110,6 +276,21 @@ func (s *BeatsSheet) GetLoglineID() LoglineID {
 	return s.LoglineID
 }
 
+// GetStoryPlanID returns the value of StoryPlanID.
+func (s *BeatsSheet) GetStoryPlanID() OptStoryPlanID {
+	return s.StoryPlanID
+}
+
+// GetSourceID returns the value of SourceID.
+func (s *BeatsSheet) GetSourceID() OptBeatsSheetID {
+	return s.SourceID
+}
+
+// GetParentID returns the value of ParentID.
+func (s *BeatsSheet) GetParentID() OptBeatsSheetID {
+	return s.ParentID
+}
+
 // GetContent returns the value of Content.
 func (s *BeatsSheet) GetContent() []Beat {
 	return s.Content
@@ -120,6 +301,11 @@ func (s *BeatsSheet) GetLang() Lang {
 	return s.Lang
 }
 
+// GetActs returns the value of Acts.
+func (s *BeatsSheet) GetActs() []BeatsSheetAct {
+	return s.Acts
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *BeatsSheet) GetCreatedAt() time.Time {
 	return s.CreatedAt
@@ -135,6 +321,21 @@ func (s *BeatsSheet) SetLoglineID(val LoglineID) {
 	s.LoglineID = val
 }
 
+// SetStoryPlanID sets the value of StoryPlanID.
+func (s *BeatsSheet) SetStoryPlanID(val OptStoryPlanID) {
+	s.StoryPlanID = val
+}
+
+// SetSourceID sets the value of SourceID.
+func (s *BeatsSheet) SetSourceID(val OptBeatsSheetID) {
+	s.SourceID = val
+}
+
+// SetParentID sets the value of ParentID.
+func (s *BeatsSheet) SetParentID(val OptBeatsSheetID) {
+	s.ParentID = val
+}
+
 // SetContent sets the value of Content.
 func (s *BeatsSheet) SetContent(val []Beat) {
 	s.Content = val
@@ -145,13 +346,106 @@ func (s *BeatsSheet) SetLang(val Lang) {
 	s.Lang = val
 }
 
+// SetActs sets the value of Acts.
+func (s *BeatsSheet) SetActs(val []BeatsSheetAct) {
+	s.Acts = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *BeatsSheet) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
-func (*BeatsSheet) createBeatsSheetRes() {}
-func (*BeatsSheet) getBeatsSheetRes()    {}
+func (*BeatsSheet) convertBeatsSheetRes()   {}
+func (*BeatsSheet) createBeatsSheetRes()    {}
+func (*BeatsSheet) expandBeatRes()          {}
+func (*BeatsSheet) getBeatsSheetRes()       {}
+func (*BeatsSheet) regenerateBeatsRes()     {}
+func (*BeatsSheet) restoreBeatsSheetRes()   {}
+func (*BeatsSheet) translateBeatsSheetRes() {}
+func (*BeatsSheet) updateBeatRes()          {}
+
+// The beats of a beats sheet that belong to a given act of its story plan.
+// Ref: #/components/schemas/BeatsSheetAct
+type BeatsSheetAct struct {
+	// The key of the act in the story plan.
+	Key string `json:"key"`
+	// The name of the act.
+	Name string `json:"name"`
+	// The keys of the beats in the act, in order.
+	Beats []string `json:"beats"`
+}
+
+// GetKey returns the value of Key.
+func (s *BeatsSheetAct) GetKey() string {
+	return s.Key
+}
+
+// GetName returns the value of Name.
+func (s *BeatsSheetAct) GetName() string {
+	return s.Name
+}
+
+// GetBeats returns the value of Beats.
+func (s *BeatsSheetAct) GetBeats() []string {
+	return s.Beats
+}
+
+// SetKey sets the value of Key.
+func (s *BeatsSheetAct) SetKey(val string) {
+	s.Key = val
+}
+
+// SetName sets the value of Name.
+func (s *BeatsSheetAct) SetName(val string) {
+	s.Name = val
+}
+
+// SetBeats sets the value of Beats.
+func (s *BeatsSheetAct) SetBeats(val []string) {
+	s.Beats = val
+}
+
+// The beat-by-beat changes between two beats sheets.
+// Ref: #/components/schemas/BeatsSheetDiff
+type BeatsSheetDiff struct {
+	From BeatsSheet `json:"from"`
+	To   BeatsSheet `json:"to"`
+	// The beats of the newer sheet, in order, followed by the beats removed from the older one.
+	Beats []BeatDiff `json:"beats"`
+}
+
+// GetFrom returns the value of From.
+func (s *BeatsSheetDiff) GetFrom() BeatsSheet {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *BeatsSheetDiff) GetTo() BeatsSheet {
+	return s.To
+}
+
+// GetBeats returns the value of Beats.
+func (s *BeatsSheetDiff) GetBeats() []BeatDiff {
+	return s.Beats
+}
+
+// SetFrom sets the value of From.
+func (s *BeatsSheetDiff) SetFrom(val BeatsSheet) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *BeatsSheetDiff) SetTo(val BeatsSheet) {
+	s.To = val
+}
+
+// SetBeats sets the value of Beats.
+func (s *BeatsSheetDiff) SetBeats(val []BeatDiff) {
+	s.Beats = val
+}
+
+func (*BeatsSheetDiff) getBeatsSheetDiffRes() {}
 
 type BeatsSheetID uuid.UUID
 
@@ -224,9 +518,221 @@ func (s *BeatsSheetPreview) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
+// Reports whether a beats sheet can be moved to a newer version of its story plan.
+// Ref: #/components/schemas/BeatsSheetUpgrade
+type BeatsSheetUpgrade struct {
+	BeatsSheetID    BeatsSheetID `json:"beatsSheetID"`
+	LoglineID       LoglineID    `json:"loglineID"`
+	FromStoryPlanID StoryPlanID  `json:"fromStoryPlanID"`
+	ToStoryPlanID   StoryPlanID  `json:"toStoryPlanID"`
+	// Whether the content of the beats sheet is valid under the target version.
+	Compatible bool `json:"compatible"`
+	// Whether the beats sheet was pinned to the target version.
+	Upgraded bool `json:"upgraded"`
+	// Why the beats sheet cannot be upgraded automatically.
+	Reason OptString `json:"reason"`
+}
+
+// GetBeatsSheetID returns the value of BeatsSheetID.
+func (s *BeatsSheetUpgrade) GetBeatsSheetID() BeatsSheetID {
+	return s.BeatsSheetID
+}
+
+// GetLoglineID returns the value of LoglineID.
+func (s *BeatsSheetUpgrade) GetLoglineID() LoglineID {
+	return s.LoglineID
+}
+
+// GetFromStoryPlanID returns the value of FromStoryPlanID.
+func (s *BeatsSheetUpgrade) GetFromStoryPlanID() StoryPlanID {
+	return s.FromStoryPlanID
+}
+
+// GetToStoryPlanID returns the value of ToStoryPlanID.
+func (s *BeatsSheetUpgrade) GetToStoryPlanID() StoryPlanID {
+	return s.ToStoryPlanID
+}
+
+// GetCompatible returns the value of Compatible.
+func (s *BeatsSheetUpgrade) GetCompatible() bool {
+	return s.Compatible
+}
+
+// GetUpgraded returns the value of Upgraded.
+func (s *BeatsSheetUpgrade) GetUpgraded() bool {
+	return s.Upgraded
+}
+
+// GetReason returns the value of Reason.
+func (s *BeatsSheetUpgrade) GetReason() OptString {
+	return s.Reason
+}
+
+// SetBeatsSheetID sets the value of BeatsSheetID.
+func (s *BeatsSheetUpgrade) SetBeatsSheetID(val BeatsSheetID) {
+	s.BeatsSheetID = val
+}
+
+// SetLoglineID sets the value of LoglineID.
+func (s *BeatsSheetUpgrade) SetLoglineID(val LoglineID) {
+	s.LoglineID = val
+}
+
+// SetFromStoryPlanID sets the value of FromStoryPlanID.
+func (s *BeatsSheetUpgrade) SetFromStoryPlanID(val StoryPlanID) {
+	s.FromStoryPlanID = val
+}
+
+// SetToStoryPlanID sets the value of ToStoryPlanID.
+func (s *BeatsSheetUpgrade) SetToStoryPlanID(val StoryPlanID) {
+	s.ToStoryPlanID = val
+}
+
+// SetCompatible sets the value of Compatible.
+func (s *BeatsSheetUpgrade) SetCompatible(val bool) {
+	s.Compatible = val
+}
+
+// SetUpgraded sets the value of Upgraded.
+func (s *BeatsSheetUpgrade) SetUpgraded(val bool) {
+	s.Upgraded = val
+}
+
+// SetReason sets the value of Reason.
+func (s *BeatsSheetUpgrade) SetReason(val OptString) {
+	s.Reason = val
+}
+
+// Ref: #/components/schemas/BeatsSheetsPage
+type BeatsSheetsPage struct {
+	Items []BeatsSheetPreview `json:"items"`
+	// The cursor to the next page. Missing on the last page.
+	NextCursor OptString `json:"nextCursor"`
+}
+
+// GetItems returns the value of Items.
+func (s *BeatsSheetsPage) GetItems() []BeatsSheetPreview {
+	return s.Items
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *BeatsSheetsPage) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetItems sets the value of Items.
+func (s *BeatsSheetsPage) SetItems(val []BeatsSheetPreview) {
+	s.Items = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *BeatsSheetsPage) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*BeatsSheetsPage) getBeatsSheetsRes() {}
+
+// The order of a list of beats sheets. "newest" returns the most recently created ones first,
+// "oldest" the
+// least recently created ones first.
+// Ref: #/components/schemas/BeatsSheetsSort
+type BeatsSheetsSort string
+
+const (
+	BeatsSheetsSortNewest BeatsSheetsSort = "newest"
+	BeatsSheetsSortOldest BeatsSheetsSort = "oldest"
+)
+
+// AllValues returns all BeatsSheetsSort values.
+func (BeatsSheetsSort) AllValues() []BeatsSheetsSort {
+	return []BeatsSheetsSort{
+		BeatsSheetsSortNewest,
+		BeatsSheetsSortOldest,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s BeatsSheetsSort) MarshalText() ([]byte, error) {
+	switch s {
+	case BeatsSheetsSortNewest:
+		return []byte(s), nil
+	case BeatsSheetsSortOldest:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BeatsSheetsSort) UnmarshalText(data []byte) error {
+	switch BeatsSheetsSort(data) {
+	case BeatsSheetsSortNewest:
+		*s = BeatsSheetsSortNewest
+		return nil
+	case BeatsSheetsSortOldest:
+		*s = BeatsSheetsSortOldest
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ConflictError
+type ConflictError struct {
+	// The error message.
+	Error string `json:"error"`
+}
+
+// GetError returns the value of Error.
+func (s *ConflictError) GetError() string {
+	return s.Error
+}
+
+// SetError sets the value of Error.
+func (s *ConflictError) SetError(val string) {
+	s.Error = val
+}
+
+func (*ConflictError) createCustomStoryPlanRes() {}
+func (*ConflictError) createStoryPlanRes()       {}
+func (*ConflictError) forkStoryPlanRes()         {}
+func (*ConflictError) updateCustomStoryPlanRes() {}
+func (*ConflictError) updateLoglineRes()         {}
+func (*ConflictError) updateStoryPlanRes()       {}
+
+// Ref: #/components/schemas/ConvertBeatsSheetForm
+type ConvertBeatsSheetForm struct {
+	BeatsSheetID BeatsSheetID `json:"beatsSheetID"`
+	// The story plan to convert the beats sheet to.
+	StoryPlanID StoryPlanID `json:"storyPlanID"`
+}
+
+// GetBeatsSheetID returns the value of BeatsSheetID.
+func (s *ConvertBeatsSheetForm) GetBeatsSheetID() BeatsSheetID {
+	return s.BeatsSheetID
+}
+
+// GetStoryPlanID returns the value of StoryPlanID.
+func (s *ConvertBeatsSheetForm) GetStoryPlanID() StoryPlanID {
+	return s.StoryPlanID
+}
+
+// SetBeatsSheetID sets the value of BeatsSheetID.
+func (s *ConvertBeatsSheetForm) SetBeatsSheetID(val BeatsSheetID) {
+	s.BeatsSheetID = val
+}
+
+// SetStoryPlanID sets the value of StoryPlanID.
+func (s *ConvertBeatsSheetForm) SetStoryPlanID(val StoryPlanID) {
+	s.StoryPlanID = val
+}
+
 // Ref: #/components/schemas/CreateBeatsSheetForm
 type CreateBeatsSheetForm struct {
 	LoglineID LoglineID `json:"loglineID"`
+	// The story plan the beats sheet follows. If omitted, the default story plan for the language is
+	// used.
+	StoryPlanID OptStoryPlanID `json:"storyPlanID"`
 	// The beats of the story, in order.
 	Content []Beat `json:"content"`
 	// The language of the beats sheet.
@@ -238,6 +744,11 @@ func (s *CreateBeatsSheetForm) GetLoglineID() LoglineID {
 	return s.LoglineID
 }
 
+// GetStoryPlanID returns the value of StoryPlanID.
+func (s *CreateBeatsSheetForm) GetStoryPlanID() OptStoryPlanID {
+	return s.StoryPlanID
+}
+
 // GetContent returns the value of Content.
 func (s *CreateBeatsSheetForm) GetContent() []Beat {
 	return s.Content
@@ -253,6 +764,11 @@ func (s *CreateBeatsSheetForm) SetLoglineID(val LoglineID) {
 	s.LoglineID = val
 }
 
+// SetStoryPlanID sets the value of StoryPlanID.
+func (s *CreateBeatsSheetForm) SetStoryPlanID(val OptStoryPlanID) {
+	s.StoryPlanID = val
+}
+
 // SetContent sets the value of Content.
 func (s *CreateBeatsSheetForm) SetContent(val []Beat) {
 	s.Content = val
@@ -271,7 +787,11 @@ type CreateLoglineForm struct {
 	// The content of the logline.
 	Content string `json:"content"`
 	// The language of the logline.
-	Lang Lang `json:"lang"`
+	Lang  Lang     `json:"lang"`
+	Genre OptGenre `json:"genre"`
+	Tags  Tags     `json:"tags"`
+	// The project to add the logline to.
+	ProjectID OptProjectID `json:"projectID"`
 }
 
 // GetSlug returns the value of Slug.
@@ -294,6 +814,21 @@ func (s *CreateLoglineForm) GetLang() Lang {
 	return s.Lang
 }
 
+// GetGenre returns the value of Genre.
+func (s *CreateLoglineForm) GetGenre() OptGenre {
+	return s.Genre
+}
+
+// GetTags returns the value of Tags.
+func (s *CreateLoglineForm) GetTags() Tags {
+	return s.Tags
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *CreateLoglineForm) GetProjectID() OptProjectID {
+	return s.ProjectID
+}
+
 // SetSlug sets the value of Slug.
 func (s *CreateLoglineForm) SetSlug(val Slug) {
 	s.Slug = val
@@ -314,25 +849,156 @@ func (s *CreateLoglineForm) SetLang(val Lang) {
 	s.Lang = val
 }
 
-// Ref: #/components/schemas/Dependency
-type Dependency struct {
-	// The name of the dependency.
-	Name            string           `json:"name"`
-	Status          DependencyStatus `json:"status"`
-	AdditionalProps DependencyAdditional
+// SetGenre sets the value of Genre.
+func (s *CreateLoglineForm) SetGenre(val OptGenre) {
+	s.Genre = val
 }
 
-// GetName returns the value of Name.
-func (s *Dependency) GetName() string {
-	return s.Name
+// SetTags sets the value of Tags.
+func (s *CreateLoglineForm) SetTags(val Tags) {
+	s.Tags = val
 }
 
-// GetStatus returns the value of Status.
-func (s *Dependency) GetStatus() DependencyStatus {
-	return s.Status
+// SetProjectID sets the value of ProjectID.
+func (s *CreateLoglineForm) SetProjectID(val OptProjectID) {
+	s.ProjectID = val
 }
 
-// GetAdditionalProps returns the value of AdditionalProps.
+// Ref: #/components/schemas/CreateProjectForm
+type CreateProjectForm struct {
+	// The name of the project.
+	Name string `json:"name"`
+	// A description of the project.
+	Description OptString `json:"description"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateProjectForm) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *CreateProjectForm) GetDescription() OptString {
+	return s.Description
+}
+
+// SetName sets the value of Name.
+func (s *CreateProjectForm) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CreateProjectForm) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// Ref: #/components/schemas/CreateStoryPlanForm
+type CreateStoryPlanForm struct {
+	Slug Slug `json:"slug"`
+	// The name of the story plan.
+	Name string `json:"name"`
+	// The language of the story plan.
+	Lang  Lang           `json:"lang"`
+	Acts  StoryPlanActs  `json:"acts"`
+	Beats StoryPlanBeats `json:"beats"`
+}
+
+// GetSlug returns the value of Slug.
+func (s *CreateStoryPlanForm) GetSlug() Slug {
+	return s.Slug
+}
+
+// GetName returns the value of Name.
+func (s *CreateStoryPlanForm) GetName() string {
+	return s.Name
+}
+
+// GetLang returns the value of Lang.
+func (s *CreateStoryPlanForm) GetLang() Lang {
+	return s.Lang
+}
+
+// GetActs returns the value of Acts.
+func (s *CreateStoryPlanForm) GetActs() StoryPlanActs {
+	return s.Acts
+}
+
+// GetBeats returns the value of Beats.
+func (s *CreateStoryPlanForm) GetBeats() StoryPlanBeats {
+	return s.Beats
+}
+
+// SetSlug sets the value of Slug.
+func (s *CreateStoryPlanForm) SetSlug(val Slug) {
+	s.Slug = val
+}
+
+// SetName sets the value of Name.
+func (s *CreateStoryPlanForm) SetName(val string) {
+	s.Name = val
+}
+
+// SetLang sets the value of Lang.
+func (s *CreateStoryPlanForm) SetLang(val Lang) {
+	s.Lang = val
+}
+
+// SetActs sets the value of Acts.
+func (s *CreateStoryPlanForm) SetActs(val StoryPlanActs) {
+	s.Acts = val
+}
+
+// SetBeats sets the value of Beats.
+func (s *CreateStoryPlanForm) SetBeats(val StoryPlanBeats) {
+	s.Beats = val
+}
+
+// Provide either the ID of a stored logline, or a logline idea.
+// Ref: #/components/schemas/CritiqueLoglineForm
+type CritiqueLoglineForm struct {
+	LoglineID OptLoglineID   `json:"loglineID"`
+	Logline   OptLoglineIdea `json:"logline"`
+}
+
+// GetLoglineID returns the value of LoglineID.
+func (s *CritiqueLoglineForm) GetLoglineID() OptLoglineID {
+	return s.LoglineID
+}
+
+// GetLogline returns the value of Logline.
+func (s *CritiqueLoglineForm) GetLogline() OptLoglineIdea {
+	return s.Logline
+}
+
+// SetLoglineID sets the value of LoglineID.
+func (s *CritiqueLoglineForm) SetLoglineID(val OptLoglineID) {
+	s.LoglineID = val
+}
+
+// SetLogline sets the value of Logline.
+func (s *CritiqueLoglineForm) SetLogline(val OptLoglineIdea) {
+	s.Logline = val
+}
+
+// Ref: #/components/schemas/Dependency
+type Dependency struct {
+	// The name of the dependency.
+	Name            string           `json:"name"`
+	Status          DependencyStatus `json:"status"`
+	AdditionalProps DependencyAdditional
+}
+
+// GetName returns the value of Name.
+func (s *Dependency) GetName() string {
+	return s.Name
+}
+
+// GetStatus returns the value of Status.
+func (s *Dependency) GetStatus() DependencyStatus {
+	return s.Status
+}
+
+// GetAdditionalProps returns the value of AdditionalProps.
 func (s *Dependency) GetAdditionalProps() DependencyAdditional {
 	return s.AdditionalProps
 }
@@ -413,11 +1079,112 @@ func (s *DependencyStatus) UnmarshalText(data []byte) error {
 	}
 }
 
+// Ref: #/components/schemas/DetectLangForm
+type DetectLangForm struct {
+	// The text to detect the language of.
+	Text string `json:"text"`
+}
+
+// GetText returns the value of Text.
+func (s *DetectLangForm) GetText() string {
+	return s.Text
+}
+
+// SetText sets the value of Text.
+func (s *DetectLangForm) SetText(val string) {
+	s.Text = val
+}
+
+// Ref: #/components/schemas/DiffChunk
+type DiffChunk struct {
+	Op DiffOp `json:"op"`
+	// The piece of text, whitespace included.
+	Text string `json:"text"`
+}
+
+// GetOp returns the value of Op.
+func (s *DiffChunk) GetOp() DiffOp {
+	return s.Op
+}
+
+// GetText returns the value of Text.
+func (s *DiffChunk) GetText() string {
+	return s.Text
+}
+
+// SetOp sets the value of Op.
+func (s *DiffChunk) SetOp(val DiffOp) {
+	s.Op = val
+}
+
+// SetText sets the value of Text.
+func (s *DiffChunk) SetText(val string) {
+	s.Text = val
+}
+
+// Whether a piece of text is present in both versions (equal), only in the newer one (insert), or
+// only in the
+// older one (delete).
+// Ref: #/components/schemas/DiffOp
+type DiffOp string
+
+const (
+	DiffOpEqual  DiffOp = "equal"
+	DiffOpInsert DiffOp = "insert"
+	DiffOpDelete DiffOp = "delete"
+)
+
+// AllValues returns all DiffOp values.
+func (DiffOp) AllValues() []DiffOp {
+	return []DiffOp{
+		DiffOpEqual,
+		DiffOpInsert,
+		DiffOpDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s DiffOp) MarshalText() ([]byte, error) {
+	switch s {
+	case DiffOpEqual:
+		return []byte(s), nil
+	case DiffOpInsert:
+		return []byte(s), nil
+	case DiffOpDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *DiffOp) UnmarshalText(data []byte) error {
+	switch DiffOp(data) {
+	case DiffOpEqual:
+		*s = DiffOpEqual
+		return nil
+	case DiffOpInsert:
+		*s = DiffOpInsert
+		return nil
+	case DiffOpDelete:
+		*s = DiffOpDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/ExpandBeatForm
 type ExpandBeatForm struct {
 	BeatsSheetID BeatsSheetID `json:"beatsSheetID"`
 	// The key of the beat to expand.
 	TargetKey string `json:"targetKey"`
+	// When the key is repeated in the beats sheet, the zero-based occurrence of the beat to expand.
+	// Defaults to
+	// the first one.
+	Occurrence OptInt `json:"occurrence"`
+	// Save the expanded beat as a new revision of the beats sheet.
+	Apply OptBool `json:"apply"`
 }
 
 // GetBeatsSheetID returns the value of BeatsSheetID.
@@ -430,6 +1197,16 @@ func (s *ExpandBeatForm) GetTargetKey() string {
 	return s.TargetKey
 }
 
+// GetOccurrence returns the value of Occurrence.
+func (s *ExpandBeatForm) GetOccurrence() OptInt {
+	return s.Occurrence
+}
+
+// GetApply returns the value of Apply.
+func (s *ExpandBeatForm) GetApply() OptBool {
+	return s.Apply
+}
+
 // SetBeatsSheetID sets the value of BeatsSheetID.
 func (s *ExpandBeatForm) SetBeatsSheetID(val BeatsSheetID) {
 	s.BeatsSheetID = val
@@ -440,6 +1217,16 @@ func (s *ExpandBeatForm) SetTargetKey(val string) {
 	s.TargetKey = val
 }
 
+// SetOccurrence sets the value of Occurrence.
+func (s *ExpandBeatForm) SetOccurrence(val OptInt) {
+	s.Occurrence = val
+}
+
+// SetApply sets the value of Apply.
+func (s *ExpandBeatForm) SetApply(val OptBool) {
+	s.Apply = val
+}
+
 // Ref: #/components/schemas/ForbiddenError
 type ForbiddenError struct {
 	// The error message.
@@ -456,21 +1243,97 @@ func (s *ForbiddenError) SetError(val string) {
 	s.Error = val
 }
 
-func (*ForbiddenError) createBeatsSheetRes()   {}
-func (*ForbiddenError) createLoglineRes()      {}
-func (*ForbiddenError) expandBeatRes()         {}
-func (*ForbiddenError) expandLoglineRes()      {}
-func (*ForbiddenError) generateBeatsSheetRes() {}
-func (*ForbiddenError) generateLoglinesRes()   {}
-func (*ForbiddenError) getBeatsSheetRes()      {}
-func (*ForbiddenError) getBeatsSheetsRes()     {}
-func (*ForbiddenError) getLoglineRes()         {}
-func (*ForbiddenError) getLoglinesRes()        {}
-func (*ForbiddenError) regenerateBeatsRes()    {}
+func (*ForbiddenError) convertBeatsSheetRes()         {}
+func (*ForbiddenError) createBeatsSheetRes()          {}
+func (*ForbiddenError) createCustomStoryPlanRes()     {}
+func (*ForbiddenError) createLoglineRes()             {}
+func (*ForbiddenError) createProjectRes()             {}
+func (*ForbiddenError) createStoryPlanRes()           {}
+func (*ForbiddenError) critiqueLoglineRes()           {}
+func (*ForbiddenError) deleteBeatsSheetRes()          {}
+func (*ForbiddenError) deleteLoglineRes()             {}
+func (*ForbiddenError) deleteProjectRes()             {}
+func (*ForbiddenError) detectLangRes()                {}
+func (*ForbiddenError) expandBeatRes()                {}
+func (*ForbiddenError) expandLoglineRes()             {}
+func (*ForbiddenError) forkStoryPlanRes()             {}
+func (*ForbiddenError) generateBeatsSheetRes()        {}
+func (*ForbiddenError) generateLoglineVariationsRes() {}
+func (*ForbiddenError) generateLoglinesRes()          {}
+func (*ForbiddenError) getBeatsSheetDiffRes()         {}
+func (*ForbiddenError) getBeatsSheetRes()             {}
+func (*ForbiddenError) getBeatsSheetsRes()            {}
+func (*ForbiddenError) getLoglineRes()                {}
+func (*ForbiddenError) getLoglineRevisionDiffRes()    {}
+func (*ForbiddenError) getLoglineRevisionRes()        {}
+func (*ForbiddenError) getLoglineRevisionsRes()       {}
+func (*ForbiddenError) getLoglineTagsRes()            {}
+func (*ForbiddenError) getLoglinesRes()               {}
+func (*ForbiddenError) getProjectRes()                {}
+func (*ForbiddenError) getProjectsRes()               {}
+func (*ForbiddenError) getStoryPlanRes()              {}
+func (*ForbiddenError) getStoryPlansRes()             {}
+func (*ForbiddenError) getTrashedBeatsSheetsRes()     {}
+func (*ForbiddenError) getTrashedLoglinesRes()        {}
+func (*ForbiddenError) regenerateBeatsRes()           {}
+func (*ForbiddenError) restoreBeatsSheetRes()         {}
+func (*ForbiddenError) restoreLoglineRes()            {}
+func (*ForbiddenError) restoreLoglineRevisionRes()    {}
+func (*ForbiddenError) searchRes()                    {}
+func (*ForbiddenError) translateBeatsSheetRes()       {}
+func (*ForbiddenError) translateLoglineRes()          {}
+func (*ForbiddenError) updateBeatRes()                {}
+func (*ForbiddenError) updateCustomStoryPlanRes()     {}
+func (*ForbiddenError) updateLoglineRes()             {}
+func (*ForbiddenError) updateProjectRes()             {}
+func (*ForbiddenError) updateStoryPlanRes()           {}
+func (*ForbiddenError) upgradeBeatsSheetsRes()        {}
+func (*ForbiddenError) upgradeCustomBeatsSheetsRes()  {}
+
+// Ref: #/components/schemas/ForkStoryPlanForm
+type ForkStoryPlanForm struct {
+	ID StoryPlanID `json:"id"`
+	// The slug of the new plan. Defaults to the slug of the forked plan.
+	Slug OptSlug `json:"slug"`
+	// The name of the new plan. Defaults to the name of the forked plan.
+	Name OptString `json:"name"`
+}
+
+// GetID returns the value of ID.
+func (s *ForkStoryPlanForm) GetID() StoryPlanID {
+	return s.ID
+}
+
+// GetSlug returns the value of Slug.
+func (s *ForkStoryPlanForm) GetSlug() OptSlug {
+	return s.Slug
+}
+
+// GetName returns the value of Name.
+func (s *ForkStoryPlanForm) GetName() OptString {
+	return s.Name
+}
+
+// SetID sets the value of ID.
+func (s *ForkStoryPlanForm) SetID(val StoryPlanID) {
+	s.ID = val
+}
+
+// SetSlug sets the value of Slug.
+func (s *ForkStoryPlanForm) SetSlug(val OptSlug) {
+	s.Slug = val
+}
+
+// SetName sets the value of Name.
+func (s *ForkStoryPlanForm) SetName(val OptString) {
+	s.Name = val
+}
 
 // Ref: #/components/schemas/GenerateBeatsSheetForm
 type GenerateBeatsSheetForm struct {
 	LoglineID LoglineID `json:"loglineID"`
+	// The story plan to follow. If omitted, the default story plan for the language is used.
+	StoryPlanID OptStoryPlanID `json:"storyPlanID"`
 	// The language of the beats sheet to generate.
 	Lang Lang `json:"lang"`
 }
@@ -480,6 +1343,11 @@ func (s *GenerateBeatsSheetForm) GetLoglineID() LoglineID {
 	return s.LoglineID
 }
 
+// GetStoryPlanID returns the value of StoryPlanID.
+func (s *GenerateBeatsSheetForm) GetStoryPlanID() OptStoryPlanID {
+	return s.StoryPlanID
+}
+
 // GetLang returns the value of Lang.
 func (s *GenerateBeatsSheetForm) GetLang() Lang {
 	return s.Lang
@@ -490,11 +1358,73 @@ func (s *GenerateBeatsSheetForm) SetLoglineID(val LoglineID) {
 	s.LoglineID = val
 }
 
+// SetStoryPlanID sets the value of StoryPlanID.
+func (s *GenerateBeatsSheetForm) SetStoryPlanID(val OptStoryPlanID) {
+	s.StoryPlanID = val
+}
+
 // SetLang sets the value of Lang.
 func (s *GenerateBeatsSheetForm) SetLang(val Lang) {
 	s.Lang = val
 }
 
+// Provide either the ID of a stored logline, or a logline idea.
+// Ref: #/components/schemas/GenerateLoglineVariationsForm
+type GenerateLoglineVariationsForm struct {
+	LoglineID OptLoglineID   `json:"loglineID"`
+	Logline   OptLoglineIdea `json:"logline"`
+	// The number of variations to generate.
+	Count int `json:"count"`
+	// The dimensions the variations may change. Every other dimension is kept from the original logline.
+	// If
+	// omitted or empty, any dimension may change.
+	Dimensions []LoglineDimension `json:"dimensions"`
+}
+
+// GetLoglineID returns the value of LoglineID.
+func (s *GenerateLoglineVariationsForm) GetLoglineID() OptLoglineID {
+	return s.LoglineID
+}
+
+// GetLogline returns the value of Logline.
+func (s *GenerateLoglineVariationsForm) GetLogline() OptLoglineIdea {
+	return s.Logline
+}
+
+// GetCount returns the value of Count.
+func (s *GenerateLoglineVariationsForm) GetCount() int {
+	return s.Count
+}
+
+// GetDimensions returns the value of Dimensions.
+func (s *GenerateLoglineVariationsForm) GetDimensions() []LoglineDimension {
+	return s.Dimensions
+}
+
+// SetLoglineID sets the value of LoglineID.
+func (s *GenerateLoglineVariationsForm) SetLoglineID(val OptLoglineID) {
+	s.LoglineID = val
+}
+
+// SetLogline sets the value of Logline.
+func (s *GenerateLoglineVariationsForm) SetLogline(val OptLoglineIdea) {
+	s.Logline = val
+}
+
+// SetCount sets the value of Count.
+func (s *GenerateLoglineVariationsForm) SetCount(val int) {
+	s.Count = val
+}
+
+// SetDimensions sets the value of Dimensions.
+func (s *GenerateLoglineVariationsForm) SetDimensions(val []LoglineDimension) {
+	s.Dimensions = val
+}
+
+type GenerateLoglineVariationsOKApplicationJSON []LoglineVariation
+
+func (*GenerateLoglineVariationsOKApplicationJSON) generateLoglineVariationsRes() {}
+
 // Ref: #/components/schemas/GenerateLoglinesForm
 type GenerateLoglinesForm struct {
 	// The number of loglines to generate.
@@ -539,13 +1469,152 @@ type GenerateLoglinesOKApplicationJSON []LoglineIdea
 
 func (*GenerateLoglinesOKApplicationJSON) generateLoglinesRes() {}
 
-type GetBeatsSheetsOKApplicationJSON []BeatsSheetPreview
+// The literary genre of a story.
+// Ref: #/components/schemas/Genre
+type Genre string
 
-func (*GetBeatsSheetsOKApplicationJSON) getBeatsSheetsRes() {}
+const (
+	GenreAction         Genre = "action"
+	GenreAdventure      Genre = "adventure"
+	GenreComedy         Genre = "comedy"
+	GenreCrime          Genre = "crime"
+	GenreDrama          Genre = "drama"
+	GenreFantasy        Genre = "fantasy"
+	GenreHistorical     Genre = "historical"
+	GenreHorror         Genre = "horror"
+	GenreMystery        Genre = "mystery"
+	GenreRomance        Genre = "romance"
+	GenreScienceFiction Genre = "science-fiction"
+	GenreThriller       Genre = "thriller"
+	GenreWestern        Genre = "western"
+	GenreOther          Genre = "other"
+)
+
+// AllValues returns all Genre values.
+func (Genre) AllValues() []Genre {
+	return []Genre{
+		GenreAction,
+		GenreAdventure,
+		GenreComedy,
+		GenreCrime,
+		GenreDrama,
+		GenreFantasy,
+		GenreHistorical,
+		GenreHorror,
+		GenreMystery,
+		GenreRomance,
+		GenreScienceFiction,
+		GenreThriller,
+		GenreWestern,
+		GenreOther,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Genre) MarshalText() ([]byte, error) {
+	switch s {
+	case GenreAction:
+		return []byte(s), nil
+	case GenreAdventure:
+		return []byte(s), nil
+	case GenreComedy:
+		return []byte(s), nil
+	case GenreCrime:
+		return []byte(s), nil
+	case GenreDrama:
+		return []byte(s), nil
+	case GenreFantasy:
+		return []byte(s), nil
+	case GenreHistorical:
+		return []byte(s), nil
+	case GenreHorror:
+		return []byte(s), nil
+	case GenreMystery:
+		return []byte(s), nil
+	case GenreRomance:
+		return []byte(s), nil
+	case GenreScienceFiction:
+		return []byte(s), nil
+	case GenreThriller:
+		return []byte(s), nil
+	case GenreWestern:
+		return []byte(s), nil
+	case GenreOther:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Genre) UnmarshalText(data []byte) error {
+	switch Genre(data) {
+	case GenreAction:
+		*s = GenreAction
+		return nil
+	case GenreAdventure:
+		*s = GenreAdventure
+		return nil
+	case GenreComedy:
+		*s = GenreComedy
+		return nil
+	case GenreCrime:
+		*s = GenreCrime
+		return nil
+	case GenreDrama:
+		*s = GenreDrama
+		return nil
+	case GenreFantasy:
+		*s = GenreFantasy
+		return nil
+	case GenreHistorical:
+		*s = GenreHistorical
+		return nil
+	case GenreHorror:
+		*s = GenreHorror
+		return nil
+	case GenreMystery:
+		*s = GenreMystery
+		return nil
+	case GenreRomance:
+		*s = GenreRomance
+		return nil
+	case GenreScienceFiction:
+		*s = GenreScienceFiction
+		return nil
+	case GenreThriller:
+		*s = GenreThriller
+		return nil
+	case GenreWestern:
+		*s = GenreWestern
+		return nil
+	case GenreOther:
+		*s = GenreOther
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetLoglineRevisionsOKApplicationJSON []LoglineRevision
+
+func (*GetLoglineRevisionsOKApplicationJSON) getLoglineRevisionsRes() {}
 
-type GetLoglinesOKApplicationJSON []LoglinePreview
+type GetLoglineTagsOKApplicationJSON []LoglineTag
 
-func (*GetLoglinesOKApplicationJSON) getLoglinesRes() {}
+func (*GetLoglineTagsOKApplicationJSON) getLoglineTagsRes() {}
+
+type GetStoryPlansOKApplicationJSON []StoryPlanPreview
+
+func (*GetStoryPlansOKApplicationJSON) getStoryPlansRes() {}
+
+type GetTrashedBeatsSheetsOKApplicationJSON []TrashedBeatsSheet
+
+func (*GetTrashedBeatsSheetsOKApplicationJSON) getTrashedBeatsSheetsRes() {}
+
+type GetTrashedLoglinesOKApplicationJSON []Logline
+
+func (*GetTrashedLoglinesOKApplicationJSON) getTrashedLoglinesRes() {}
 
 // Ref: #/components/schemas/Health
 type Health struct {
@@ -598,6 +1667,10 @@ type Lang string
 const (
 	LangEn Lang = "en"
 	LangFr Lang = "fr"
+	LangEs Lang = "es"
+	LangDe Lang = "de"
+	LangIt Lang = "it"
+	LangPt Lang = "pt"
 )
 
 // AllValues returns all Lang values.
@@ -605,6 +1678,10 @@ func (Lang) AllValues() []Lang {
 	return []Lang{
 		LangEn,
 		LangFr,
+		LangEs,
+		LangDe,
+		LangIt,
+		LangPt,
 	}
 }
 
@@ -615,6 +1692,14 @@ func (s Lang) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case LangFr:
 		return []byte(s), nil
+	case LangEs:
+		return []byte(s), nil
+	case LangDe:
+		return []byte(s), nil
+	case LangIt:
+		return []byte(s), nil
+	case LangPt:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -629,25 +1714,79 @@ func (s *Lang) UnmarshalText(data []byte) error {
 	case LangFr:
 		*s = LangFr
 		return nil
+	case LangEs:
+		*s = LangEs
+		return nil
+	case LangDe:
+		*s = LangDe
+		return nil
+	case LangIt:
+		*s = LangIt
+		return nil
+	case LangPt:
+		*s = LangPt
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// A logline is a brief summary of a story, used to quickly convey its essence.
-// Ref: #/components/schemas/Logline
+// Ref: #/components/schemas/LangDetection
+type LangDetection struct {
+	// The detected language. Missing if the text does not carry enough evidence to tell.
+	Lang OptLang `json:"lang"`
+	// The confidence of the detection, between 0 and 1. Detections with a confidence below 0.5 should
+	// not be
+	// trusted.
+	Confidence float64 `json:"confidence"`
+}
+
+// GetLang returns the value of Lang.
+func (s *LangDetection) GetLang() OptLang {
+	return s.Lang
+}
+
+// GetConfidence returns the value of Confidence.
+func (s *LangDetection) GetConfidence() float64 {
+	return s.Confidence
+}
+
+// SetLang sets the value of Lang.
+func (s *LangDetection) SetLang(val OptLang) {
+	s.Lang = val
+}
+
+// SetConfidence sets the value of Confidence.
+func (s *LangDetection) SetConfidence(val float64) {
+	s.Confidence = val
+}
+
+func (*LangDetection) detectLangRes() {}
+
+// A logline is a brief summary of a story, used to quickly convey its essence.
+// Ref: #/components/schemas/Logline
 type Logline struct {
 	ID     LoglineID `json:"id"`
 	UserID UserID    `json:"userID"`
 	Slug   Slug      `json:"slug"`
+	// The logline this one was translated from, if any.
+	SourceID OptLoglineID `json:"sourceID"`
+	// The project the logline belongs to, if any.
+	ProjectID OptProjectID `json:"projectID"`
 	// The name of the logline.
 	Name string `json:"name"`
 	// The content of the logline.
 	Content string `json:"content"`
 	// The language of the logline.
-	Lang Lang `json:"lang"`
+	Lang  Lang     `json:"lang"`
+	Genre OptGenre `json:"genre"`
+	Tags  Tags     `json:"tags"`
 	// The date and time at which the logline was created.
 	CreatedAt time.Time `json:"createdAt"`
+	// The date and time at which the logline was last edited, if it was.
+	UpdatedAt OptDateTime `json:"updatedAt"`
+	// The date and time at which the logline was moved to the trash, if it is in the trash.
+	DeletedAt OptDateTime `json:"deletedAt"`
 }
 
 // GetID returns the value of ID.
@@ -665,6 +1804,16 @@ func (s *Logline) GetSlug() Slug {
 	return s.Slug
 }
 
+// GetSourceID returns the value of SourceID.
+func (s *Logline) GetSourceID() OptLoglineID {
+	return s.SourceID
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *Logline) GetProjectID() OptProjectID {
+	return s.ProjectID
+}
+
 // GetName returns the value of Name.
 func (s *Logline) GetName() string {
 	return s.Name
@@ -680,11 +1829,31 @@ func (s *Logline) GetLang() Lang {
 	return s.Lang
 }
 
+// GetGenre returns the value of Genre.
+func (s *Logline) GetGenre() OptGenre {
+	return s.Genre
+}
+
+// GetTags returns the value of Tags.
+func (s *Logline) GetTags() Tags {
+	return s.Tags
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *Logline) GetCreatedAt() time.Time {
 	return s.CreatedAt
 }
 
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Logline) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// GetDeletedAt returns the value of DeletedAt.
+func (s *Logline) GetDeletedAt() OptDateTime {
+	return s.DeletedAt
+}
+
 // SetID sets the value of ID.
 func (s *Logline) SetID(val LoglineID) {
 	s.ID = val
@@ -700,6 +1869,16 @@ func (s *Logline) SetSlug(val Slug) {
 	s.Slug = val
 }
 
+// SetSourceID sets the value of SourceID.
+func (s *Logline) SetSourceID(val OptLoglineID) {
+	s.SourceID = val
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *Logline) SetProjectID(val OptProjectID) {
+	s.ProjectID = val
+}
+
 // SetName sets the value of Name.
 func (s *Logline) SetName(val string) {
 	s.Name = val
@@ -715,13 +1894,217 @@ func (s *Logline) SetLang(val Lang) {
 	s.Lang = val
 }
 
+// SetGenre sets the value of Genre.
+func (s *Logline) SetGenre(val OptGenre) {
+	s.Genre = val
+}
+
+// SetTags sets the value of Tags.
+func (s *Logline) SetTags(val Tags) {
+	s.Tags = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *Logline) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
-func (*Logline) createLoglineRes() {}
-func (*Logline) getLoglineRes()    {}
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Logline) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// SetDeletedAt sets the value of DeletedAt.
+func (s *Logline) SetDeletedAt(val OptDateTime) {
+	s.DeletedAt = val
+}
+
+func (*Logline) createLoglineRes()          {}
+func (*Logline) deleteLoglineRes()          {}
+func (*Logline) getLoglineRes()             {}
+func (*Logline) restoreLoglineRes()         {}
+func (*Logline) restoreLoglineRevisionRes() {}
+func (*Logline) translateLoglineRes()       {}
+func (*Logline) updateLoglineRes()          {}
+
+// Ref: #/components/schemas/LoglineCritique
+type LoglineCritique struct {
+	Protagonist LoglineCritiqueScore `json:"protagonist"`
+	Goal        LoglineCritiqueScore `json:"goal"`
+	// The antagonist, or the obstacle, and what the protagonist stands to lose.
+	Stakes LoglineCritiqueScore `json:"stakes"`
+	// The irony or the hook that makes the premise stand out.
+	Hook         LoglineCritiqueScore `json:"hook"`
+	GenreClarity LoglineCritiqueScore `json:"genreClarity"`
+	// The language of the critique.
+	Lang Lang `json:"lang"`
+}
+
+// GetProtagonist returns the value of Protagonist.
+func (s *LoglineCritique) GetProtagonist() LoglineCritiqueScore {
+	return s.Protagonist
+}
+
+// GetGoal returns the value of Goal.
+func (s *LoglineCritique) GetGoal() LoglineCritiqueScore {
+	return s.Goal
+}
+
+// GetStakes returns the value of Stakes.
+func (s *LoglineCritique) GetStakes() LoglineCritiqueScore {
+	return s.Stakes
+}
+
+// GetHook returns the value of Hook.
+func (s *LoglineCritique) GetHook() LoglineCritiqueScore {
+	return s.Hook
+}
+
+// GetGenreClarity returns the value of GenreClarity.
+func (s *LoglineCritique) GetGenreClarity() LoglineCritiqueScore {
+	return s.GenreClarity
+}
+
+// GetLang returns the value of Lang.
+func (s *LoglineCritique) GetLang() Lang {
+	return s.Lang
+}
+
+// SetProtagonist sets the value of Protagonist.
+func (s *LoglineCritique) SetProtagonist(val LoglineCritiqueScore) {
+	s.Protagonist = val
+}
+
+// SetGoal sets the value of Goal.
+func (s *LoglineCritique) SetGoal(val LoglineCritiqueScore) {
+	s.Goal = val
+}
+
+// SetStakes sets the value of Stakes.
+func (s *LoglineCritique) SetStakes(val LoglineCritiqueScore) {
+	s.Stakes = val
+}
+
+// SetHook sets the value of Hook.
+func (s *LoglineCritique) SetHook(val LoglineCritiqueScore) {
+	s.Hook = val
+}
+
+// SetGenreClarity sets the value of GenreClarity.
+func (s *LoglineCritique) SetGenreClarity(val LoglineCritiqueScore) {
+	s.GenreClarity = val
+}
+
+// SetLang sets the value of Lang.
+func (s *LoglineCritique) SetLang(val Lang) {
+	s.Lang = val
+}
+
+func (*LoglineCritique) critiqueLoglineRes() {}
+
+// Ref: #/components/schemas/LoglineCritiqueScore
+type LoglineCritiqueScore struct {
+	// From 1 (missing or unclear) to 10 (strong and specific).
+	Score int `json:"score"`
+	// Why the aspect got this score.
+	Justification string `json:"justification"`
+	// A concrete change that would improve the aspect.
+	Suggestion string `json:"suggestion"`
+}
+
+// GetScore returns the value of Score.
+func (s *LoglineCritiqueScore) GetScore() int {
+	return s.Score
+}
+
+// GetJustification returns the value of Justification.
+func (s *LoglineCritiqueScore) GetJustification() string {
+	return s.Justification
+}
+
+// GetSuggestion returns the value of Suggestion.
+func (s *LoglineCritiqueScore) GetSuggestion() string {
+	return s.Suggestion
+}
+
+// SetScore sets the value of Score.
+func (s *LoglineCritiqueScore) SetScore(val int) {
+	s.Score = val
+}
+
+// SetJustification sets the value of Justification.
+func (s *LoglineCritiqueScore) SetJustification(val string) {
+	s.Justification = val
+}
+
+// SetSuggestion sets the value of Suggestion.
+func (s *LoglineCritiqueScore) SetSuggestion(val string) {
+	s.Suggestion = val
+}
+
+// An aspect of a logline that can be changed independently of the others.
+// Ref: #/components/schemas/LoglineDimension
+type LoglineDimension string
+
+const (
+	LoglineDimensionTone        LoglineDimension = "tone"
+	LoglineDimensionGenre       LoglineDimension = "genre"
+	LoglineDimensionProtagonist LoglineDimension = "protagonist"
+	LoglineDimensionSetting     LoglineDimension = "setting"
+	LoglineDimensionEnding      LoglineDimension = "ending"
+)
+
+// AllValues returns all LoglineDimension values.
+func (LoglineDimension) AllValues() []LoglineDimension {
+	return []LoglineDimension{
+		LoglineDimensionTone,
+		LoglineDimensionGenre,
+		LoglineDimensionProtagonist,
+		LoglineDimensionSetting,
+		LoglineDimensionEnding,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LoglineDimension) MarshalText() ([]byte, error) {
+	switch s {
+	case LoglineDimensionTone:
+		return []byte(s), nil
+	case LoglineDimensionGenre:
+		return []byte(s), nil
+	case LoglineDimensionProtagonist:
+		return []byte(s), nil
+	case LoglineDimensionSetting:
+		return []byte(s), nil
+	case LoglineDimensionEnding:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LoglineDimension) UnmarshalText(data []byte) error {
+	switch LoglineDimension(data) {
+	case LoglineDimensionTone:
+		*s = LoglineDimensionTone
+		return nil
+	case LoglineDimensionGenre:
+		*s = LoglineDimensionGenre
+		return nil
+	case LoglineDimensionProtagonist:
+		*s = LoglineDimensionProtagonist
+		return nil
+	case LoglineDimensionSetting:
+		*s = LoglineDimensionSetting
+		return nil
+	case LoglineDimensionEnding:
+		*s = LoglineDimensionEnding
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
 type LoglineID uuid.UUID
 
@@ -770,12 +2153,16 @@ func (*LoglineIdea) expandLoglineRes() {}
 // Ref: #/components/schemas/LoglinePreview
 type LoglinePreview struct {
 	Slug Slug `json:"slug"`
+	// The project the logline belongs to, if any.
+	ProjectID OptProjectID `json:"projectID"`
 	// The name of the logline.
 	Name string `json:"name"`
 	// The content of the logline.
 	Content string `json:"content"`
 	// The language of the logline.
-	Lang Lang `json:"lang"`
+	Lang  Lang     `json:"lang"`
+	Genre OptGenre `json:"genre"`
+	Tags  Tags     `json:"tags"`
 	// The date and time at which the logline was created.
 	CreatedAt time.Time `json:"createdAt"`
 }
@@ -785,6 +2172,11 @@ func (s *LoglinePreview) GetSlug() Slug {
 	return s.Slug
 }
 
+// GetProjectID returns the value of ProjectID.
+func (s *LoglinePreview) GetProjectID() OptProjectID {
+	return s.ProjectID
+}
+
 // GetName returns the value of Name.
 func (s *LoglinePreview) GetName() string {
 	return s.Name
@@ -800,6 +2192,16 @@ func (s *LoglinePreview) GetLang() Lang {
 	return s.Lang
 }
 
+// GetGenre returns the value of Genre.
+func (s *LoglinePreview) GetGenre() OptGenre {
+	return s.Genre
+}
+
+// GetTags returns the value of Tags.
+func (s *LoglinePreview) GetTags() Tags {
+	return s.Tags
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *LoglinePreview) GetCreatedAt() time.Time {
 	return s.CreatedAt
@@ -810,6 +2212,11 @@ func (s *LoglinePreview) SetSlug(val Slug) {
 	s.Slug = val
 }
 
+// SetProjectID sets the value of ProjectID.
+func (s *LoglinePreview) SetProjectID(val OptProjectID) {
+	s.ProjectID = val
+}
+
 // SetName sets the value of Name.
 func (s *LoglinePreview) SetName(val string) {
 	s.Name = val
@@ -825,221 +2232,2155 @@ func (s *LoglinePreview) SetLang(val Lang) {
 	s.Lang = val
 }
 
+// SetGenre sets the value of Genre.
+func (s *LoglinePreview) SetGenre(val OptGenre) {
+	s.Genre = val
+}
+
+// SetTags sets the value of Tags.
+func (s *LoglinePreview) SetTags(val Tags) {
+	s.Tags = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *LoglinePreview) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
-// Ref: #/components/schemas/NotFoundError
-type NotFoundError struct {
-	// The error message.
-	Error string `json:"error"`
+// A snapshot of the content of a logline, saved every time the logline is written.
+// Ref: #/components/schemas/LoglineRevision
+type LoglineRevision struct {
+	ID        LoglineRevisionID `json:"id"`
+	LoglineID LoglineID         `json:"loglineID"`
+	// The name of the logline at the time of the revision.
+	Name string `json:"name"`
+	// The content of the logline at the time of the revision.
+	Content string `json:"content"`
+	Lang    Lang   `json:"lang"`
+	// The date and time at which the revision was saved.
+	CreatedAt time.Time `json:"createdAt"`
 }
 
-// GetError returns the value of Error.
-func (s *NotFoundError) GetError() string {
-	return s.Error
+// GetID returns the value of ID.
+func (s *LoglineRevision) GetID() LoglineRevisionID {
+	return s.ID
 }
 
-// SetError sets the value of Error.
-func (s *NotFoundError) SetError(val string) {
-	s.Error = val
+// GetLoglineID returns the value of LoglineID.
+func (s *LoglineRevision) GetLoglineID() LoglineID {
+	return s.LoglineID
 }
 
-func (*NotFoundError) createBeatsSheetRes()   {}
-func (*NotFoundError) expandBeatRes()         {}
-func (*NotFoundError) generateBeatsSheetRes() {}
-func (*NotFoundError) getBeatsSheetRes()      {}
-func (*NotFoundError) getLoglineRes()         {}
-func (*NotFoundError) regenerateBeatsRes()    {}
+// GetName returns the value of Name.
+func (s *LoglineRevision) GetName() string {
+	return s.Name
+}
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
-	}
+// GetContent returns the value of Content.
+func (s *LoglineRevision) GetContent() string {
+	return s.Content
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// GetLang returns the value of Lang.
+func (s *LoglineRevision) GetLang() Lang {
+	return s.Lang
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// GetCreatedAt returns the value of CreatedAt.
+func (s *LoglineRevision) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+// SetID sets the value of ID.
+func (s *LoglineRevision) SetID(val LoglineRevisionID) {
+	s.ID = val
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// SetLoglineID sets the value of LoglineID.
+func (s *LoglineRevision) SetLoglineID(val LoglineID) {
+	s.LoglineID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetName sets the value of Name.
+func (s *LoglineRevision) SetName(val string) {
+	s.Name = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetContent sets the value of Content.
+func (s *LoglineRevision) SetContent(val string) {
+	s.Content = val
 }
 
-// NewOptLoglineID returns new OptLoglineID with value set to v.
-func NewOptLoglineID(v LoglineID) OptLoglineID {
-	return OptLoglineID{
-		Value: v,
-		Set:   true,
-	}
+// SetLang sets the value of Lang.
+func (s *LoglineRevision) SetLang(val Lang) {
+	s.Lang = val
 }
 
-// OptLoglineID is optional LoglineID.
-type OptLoglineID struct {
-	Value LoglineID
-	Set   bool
+// SetCreatedAt sets the value of CreatedAt.
+func (s *LoglineRevision) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// IsSet returns true if OptLoglineID was set.
-func (o OptLoglineID) IsSet() bool { return o.Set }
+func (*LoglineRevision) getLoglineRevisionRes() {}
 
-// Reset unsets value.
-func (o *OptLoglineID) Reset() {
-	var v LoglineID
-	o.Value = v
-	o.Set = false
+// The word-level changes between two revisions. Concatenating the equal and deleted chunks gives
+// back the older
+// text, and concatenating the equal and inserted chunks gives the newer one.
+// Ref: #/components/schemas/LoglineRevisionDiff
+type LoglineRevisionDiff struct {
+	From    LoglineRevision `json:"from"`
+	To      LoglineRevision `json:"to"`
+	Name    []DiffChunk     `json:"name"`
+	Content []DiffChunk     `json:"content"`
 }
 
-// SetTo sets value to v.
-func (o *OptLoglineID) SetTo(v LoglineID) {
-	o.Set = true
-	o.Value = v
+// GetFrom returns the value of From.
+func (s *LoglineRevisionDiff) GetFrom() LoglineRevision {
+	return s.From
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptLoglineID) Get() (v LoglineID, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetTo returns the value of To.
+func (s *LoglineRevisionDiff) GetTo() LoglineRevision {
+	return s.To
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptLoglineID) Or(d LoglineID) LoglineID {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetName returns the value of Name.
+func (s *LoglineRevisionDiff) GetName() []DiffChunk {
+	return s.Name
 }
 
-// NewOptSlug returns new OptSlug with value set to v.
-func NewOptSlug(v Slug) OptSlug {
-	return OptSlug{
-		Value: v,
-		Set:   true,
-	}
+// GetContent returns the value of Content.
+func (s *LoglineRevisionDiff) GetContent() []DiffChunk {
+	return s.Content
 }
 
-// OptSlug is optional Slug.
-type OptSlug struct {
-	Value Slug
-	Set   bool
+// SetFrom sets the value of From.
+func (s *LoglineRevisionDiff) SetFrom(val LoglineRevision) {
+	s.From = val
 }
 
-// IsSet returns true if OptSlug was set.
-func (o OptSlug) IsSet() bool { return o.Set }
+// SetTo sets the value of To.
+func (s *LoglineRevisionDiff) SetTo(val LoglineRevision) {
+	s.To = val
+}
 
-// Reset unsets value.
-func (o *OptSlug) Reset() {
-	var v Slug
+// SetName sets the value of Name.
+func (s *LoglineRevisionDiff) SetName(val []DiffChunk) {
+	s.Name = val
+}
+
+// SetContent sets the value of Content.
+func (s *LoglineRevisionDiff) SetContent(val []DiffChunk) {
+	s.Content = val
+}
+
+func (*LoglineRevisionDiff) getLoglineRevisionDiffRes() {}
+
+type LoglineRevisionID uuid.UUID
+
+// Ref: #/components/schemas/LoglineTag
+type LoglineTag struct {
+	// The tag.
+	Tag string `json:"tag"`
+	// The number of loglines using the tag.
+	Count int `json:"count"`
+}
+
+// GetTag returns the value of Tag.
+func (s *LoglineTag) GetTag() string {
+	return s.Tag
+}
+
+// GetCount returns the value of Count.
+func (s *LoglineTag) GetCount() int {
+	return s.Count
+}
+
+// SetTag sets the value of Tag.
+func (s *LoglineTag) SetTag(val string) {
+	s.Tag = val
+}
+
+// SetCount sets the value of Count.
+func (s *LoglineTag) SetCount(val int) {
+	s.Count = val
+}
+
+// Ref: #/components/schemas/LoglineVariation
+type LoglineVariation struct {
+	// The title of the variation.
+	Name string `json:"name"`
+	// The content of the variation.
+	Content string `json:"content"`
+	// The language of the variation.
+	Lang Lang `json:"lang"`
+	// The dimension of the original logline that was changed.
+	Dimension LoglineDimension `json:"dimension"`
+}
+
+// GetName returns the value of Name.
+func (s *LoglineVariation) GetName() string {
+	return s.Name
+}
+
+// GetContent returns the value of Content.
+func (s *LoglineVariation) GetContent() string {
+	return s.Content
+}
+
+// GetLang returns the value of Lang.
+func (s *LoglineVariation) GetLang() Lang {
+	return s.Lang
+}
+
+// GetDimension returns the value of Dimension.
+func (s *LoglineVariation) GetDimension() LoglineDimension {
+	return s.Dimension
+}
+
+// SetName sets the value of Name.
+func (s *LoglineVariation) SetName(val string) {
+	s.Name = val
+}
+
+// SetContent sets the value of Content.
+func (s *LoglineVariation) SetContent(val string) {
+	s.Content = val
+}
+
+// SetLang sets the value of Lang.
+func (s *LoglineVariation) SetLang(val Lang) {
+	s.Lang = val
+}
+
+// SetDimension sets the value of Dimension.
+func (s *LoglineVariation) SetDimension(val LoglineDimension) {
+	s.Dimension = val
+}
+
+// Ref: #/components/schemas/LoglinesPage
+type LoglinesPage struct {
+	Items []LoglinePreview `json:"items"`
+	// The cursor to the next page. Missing on the last page.
+	NextCursor OptString `json:"nextCursor"`
+}
+
+// GetItems returns the value of Items.
+func (s *LoglinesPage) GetItems() []LoglinePreview {
+	return s.Items
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *LoglinesPage) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetItems sets the value of Items.
+func (s *LoglinesPage) SetItems(val []LoglinePreview) {
+	s.Items = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *LoglinesPage) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*LoglinesPage) getLoglinesRes() {}
+
+// The order of a list of loglines. "newest" returns the most recently created ones first, "oldest"
+// the least
+// recently created ones first, and "name" sorts them alphabetically.
+// Ref: #/components/schemas/LoglinesSort
+type LoglinesSort string
+
+const (
+	LoglinesSortNewest LoglinesSort = "newest"
+	LoglinesSortOldest LoglinesSort = "oldest"
+	LoglinesSortName   LoglinesSort = "name"
+)
+
+// AllValues returns all LoglinesSort values.
+func (LoglinesSort) AllValues() []LoglinesSort {
+	return []LoglinesSort{
+		LoglinesSortNewest,
+		LoglinesSortOldest,
+		LoglinesSortName,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LoglinesSort) MarshalText() ([]byte, error) {
+	switch s {
+	case LoglinesSortNewest:
+		return []byte(s), nil
+	case LoglinesSortOldest:
+		return []byte(s), nil
+	case LoglinesSortName:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LoglinesSort) UnmarshalText(data []byte) error {
+	switch LoglinesSort(data) {
+	case LoglinesSortNewest:
+		*s = LoglinesSortNewest
+		return nil
+	case LoglinesSortOldest:
+		*s = LoglinesSortOldest
+		return nil
+	case LoglinesSortName:
+		*s = LoglinesSortName
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/NotFoundError
+type NotFoundError struct {
+	// The error message.
+	Error string `json:"error"`
+}
+
+// GetError returns the value of Error.
+func (s *NotFoundError) GetError() string {
+	return s.Error
+}
+
+// SetError sets the value of Error.
+func (s *NotFoundError) SetError(val string) {
+	s.Error = val
+}
+
+func (*NotFoundError) convertBeatsSheetRes()         {}
+func (*NotFoundError) createBeatsSheetRes()          {}
+func (*NotFoundError) createLoglineRes()             {}
+func (*NotFoundError) critiqueLoglineRes()           {}
+func (*NotFoundError) deleteBeatsSheetRes()          {}
+func (*NotFoundError) deleteLoglineRes()             {}
+func (*NotFoundError) deleteProjectRes()             {}
+func (*NotFoundError) expandBeatRes()                {}
+func (*NotFoundError) forkStoryPlanRes()             {}
+func (*NotFoundError) generateBeatsSheetRes()        {}
+func (*NotFoundError) generateLoglineVariationsRes() {}
+func (*NotFoundError) getBeatsSheetDiffRes()         {}
+func (*NotFoundError) getBeatsSheetRes()             {}
+func (*NotFoundError) getLoglineRes()                {}
+func (*NotFoundError) getLoglineRevisionDiffRes()    {}
+func (*NotFoundError) getLoglineRevisionRes()        {}
+func (*NotFoundError) getLoglineRevisionsRes()       {}
+func (*NotFoundError) getProjectRes()                {}
+func (*NotFoundError) getStoryPlanRes()              {}
+func (*NotFoundError) regenerateBeatsRes()           {}
+func (*NotFoundError) restoreBeatsSheetRes()         {}
+func (*NotFoundError) restoreLoglineRes()            {}
+func (*NotFoundError) restoreLoglineRevisionRes()    {}
+func (*NotFoundError) translateBeatsSheetRes()       {}
+func (*NotFoundError) translateLoglineRes()          {}
+func (*NotFoundError) updateBeatRes()                {}
+func (*NotFoundError) updateCustomStoryPlanRes()     {}
+func (*NotFoundError) updateLoglineRes()             {}
+func (*NotFoundError) updateProjectRes()             {}
+func (*NotFoundError) updateStoryPlanRes()           {}
+func (*NotFoundError) upgradeBeatsSheetsRes()        {}
+func (*NotFoundError) upgradeCustomBeatsSheetsRes()  {}
+
+// NewOptBeatsSheetID returns new OptBeatsSheetID with value set to v.
+func NewOptBeatsSheetID(v BeatsSheetID) OptBeatsSheetID {
+	return OptBeatsSheetID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBeatsSheetID is optional BeatsSheetID.
+type OptBeatsSheetID struct {
+	Value BeatsSheetID
+	Set   bool
+}
+
+// IsSet returns true if OptBeatsSheetID was set.
+func (o OptBeatsSheetID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBeatsSheetID) Reset() {
+	var v BeatsSheetID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBeatsSheetID) SetTo(v BeatsSheetID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBeatsSheetID) Get() (v BeatsSheetID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBeatsSheetID) Or(d BeatsSheetID) BeatsSheetID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBeatsSheetsSort returns new OptBeatsSheetsSort with value set to v.
+func NewOptBeatsSheetsSort(v BeatsSheetsSort) OptBeatsSheetsSort {
+	return OptBeatsSheetsSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBeatsSheetsSort is optional BeatsSheetsSort.
+type OptBeatsSheetsSort struct {
+	Value BeatsSheetsSort
+	Set   bool
+}
+
+// IsSet returns true if OptBeatsSheetsSort was set.
+func (o OptBeatsSheetsSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBeatsSheetsSort) Reset() {
+	var v BeatsSheetsSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBeatsSheetsSort) SetTo(v BeatsSheetsSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBeatsSheetsSort) Get() (v BeatsSheetsSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBeatsSheetsSort) Or(d BeatsSheetsSort) BeatsSheetsSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGenre returns new OptGenre with value set to v.
+func NewOptGenre(v Genre) OptGenre {
+	return OptGenre{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGenre is optional Genre.
+type OptGenre struct {
+	Value Genre
+	Set   bool
+}
+
+// IsSet returns true if OptGenre was set.
+func (o OptGenre) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGenre) Reset() {
+	var v Genre
 	o.Value = v
 	o.Set = false
 }
 
-// SetTo sets value to v.
-func (o *OptSlug) SetTo(v Slug) {
-	o.Set = true
-	o.Value = v
+// SetTo sets value to v.
+func (o *OptGenre) SetTo(v Genre) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGenre) Get() (v Genre, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGenre) Or(d Genre) Genre {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLang returns new OptLang with value set to v.
+func NewOptLang(v Lang) OptLang {
+	return OptLang{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLang is optional Lang.
+type OptLang struct {
+	Value Lang
+	Set   bool
+}
+
+// IsSet returns true if OptLang was set.
+func (o OptLang) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLang) Reset() {
+	var v Lang
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLang) SetTo(v Lang) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLang) Get() (v Lang, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLang) Or(d Lang) Lang {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLoglineID returns new OptLoglineID with value set to v.
+func NewOptLoglineID(v LoglineID) OptLoglineID {
+	return OptLoglineID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLoglineID is optional LoglineID.
+type OptLoglineID struct {
+	Value LoglineID
+	Set   bool
+}
+
+// IsSet returns true if OptLoglineID was set.
+func (o OptLoglineID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLoglineID) Reset() {
+	var v LoglineID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLoglineID) SetTo(v LoglineID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLoglineID) Get() (v LoglineID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLoglineID) Or(d LoglineID) LoglineID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLoglineIdea returns new OptLoglineIdea with value set to v.
+func NewOptLoglineIdea(v LoglineIdea) OptLoglineIdea {
+	return OptLoglineIdea{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLoglineIdea is optional LoglineIdea.
+type OptLoglineIdea struct {
+	Value LoglineIdea
+	Set   bool
+}
+
+// IsSet returns true if OptLoglineIdea was set.
+func (o OptLoglineIdea) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLoglineIdea) Reset() {
+	var v LoglineIdea
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLoglineIdea) SetTo(v LoglineIdea) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLoglineIdea) Get() (v LoglineIdea, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLoglineIdea) Or(d LoglineIdea) LoglineIdea {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLoglinesSort returns new OptLoglinesSort with value set to v.
+func NewOptLoglinesSort(v LoglinesSort) OptLoglinesSort {
+	return OptLoglinesSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLoglinesSort is optional LoglinesSort.
+type OptLoglinesSort struct {
+	Value LoglinesSort
+	Set   bool
+}
+
+// IsSet returns true if OptLoglinesSort was set.
+func (o OptLoglinesSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLoglinesSort) Reset() {
+	var v LoglinesSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLoglinesSort) SetTo(v LoglinesSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLoglinesSort) Get() (v LoglinesSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLoglinesSort) Or(d LoglinesSort) LoglinesSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilGenre returns new OptNilGenre with value set to v.
+func NewOptNilGenre(v Genre) OptNilGenre {
+	return OptNilGenre{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilGenre is optional nullable Genre.
+type OptNilGenre struct {
+	Value Genre
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilGenre was set.
+func (o OptNilGenre) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilGenre) Reset() {
+	var v Genre
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilGenre) SetTo(v Genre) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilGenre) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilGenre) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v Genre
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilGenre) Get() (v Genre, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilGenre) Or(d Genre) Genre {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilProjectID returns new OptNilProjectID with value set to v.
+func NewOptNilProjectID(v ProjectID) OptNilProjectID {
+	return OptNilProjectID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilProjectID is optional nullable ProjectID.
+type OptNilProjectID struct {
+	Value ProjectID
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilProjectID was set.
+func (o OptNilProjectID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilProjectID) Reset() {
+	var v ProjectID
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilProjectID) SetTo(v ProjectID) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilProjectID) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilProjectID) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v ProjectID
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilProjectID) Get() (v ProjectID, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilProjectID) Or(d ProjectID) ProjectID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptProjectID returns new OptProjectID with value set to v.
+func NewOptProjectID(v ProjectID) OptProjectID {
+	return OptProjectID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptProjectID is optional ProjectID.
+type OptProjectID struct {
+	Value ProjectID
+	Set   bool
+}
+
+// IsSet returns true if OptProjectID was set.
+func (o OptProjectID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptProjectID) Reset() {
+	var v ProjectID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptProjectID) SetTo(v ProjectID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptProjectID) Get() (v ProjectID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptProjectID) Or(d ProjectID) ProjectID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSlug returns new OptSlug with value set to v.
+func NewOptSlug(v Slug) OptSlug {
+	return OptSlug{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSlug is optional Slug.
+type OptSlug struct {
+	Value Slug
+	Set   bool
+}
+
+// IsSet returns true if OptSlug was set.
+func (o OptSlug) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSlug) Reset() {
+	var v Slug
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSlug) SetTo(v Slug) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSlug) Get() (v Slug, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSlug) Or(d Slug) Slug {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptStoryPlanID returns new OptStoryPlanID with value set to v.
+func NewOptStoryPlanID(v StoryPlanID) OptStoryPlanID {
+	return OptStoryPlanID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptStoryPlanID is optional StoryPlanID.
+type OptStoryPlanID struct {
+	Value StoryPlanID
+	Set   bool
+}
+
+// IsSet returns true if OptStoryPlanID was set.
+func (o OptStoryPlanID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptStoryPlanID) Reset() {
+	var v StoryPlanID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptStoryPlanID) SetTo(v StoryPlanID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptStoryPlanID) Get() (v StoryPlanID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptStoryPlanID) Or(d StoryPlanID) StoryPlanID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptStoryPlanRepeat returns new OptStoryPlanRepeat with value set to v.
+func NewOptStoryPlanRepeat(v StoryPlanRepeat) OptStoryPlanRepeat {
+	return OptStoryPlanRepeat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptStoryPlanRepeat is optional StoryPlanRepeat.
+type OptStoryPlanRepeat struct {
+	Value StoryPlanRepeat
+	Set   bool
+}
+
+// IsSet returns true if OptStoryPlanRepeat was set.
+func (o OptStoryPlanRepeat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptStoryPlanRepeat) Reset() {
+	var v StoryPlanRepeat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptStoryPlanRepeat) SetTo(v StoryPlanRepeat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptStoryPlanRepeat) Get() (v StoryPlanRepeat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptStoryPlanRepeat) Or(d StoryPlanRepeat) StoryPlanRepeat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUserID returns new OptUserID with value set to v.
+func NewOptUserID(v UserID) OptUserID {
+	return OptUserID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUserID is optional UserID.
+type OptUserID struct {
+	Value UserID
+	Set   bool
+}
+
+// IsSet returns true if OptUserID was set.
+func (o OptUserID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUserID) Reset() {
+	var v UserID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUserID) SetTo(v UserID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUserID) Get() (v UserID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUserID) Or(d UserID) UserID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// PingIMATeapot is response for Ping operation.
+type PingIMATeapot struct{}
+
+func (*PingIMATeapot) pingRes() {}
+
+type PingOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s PingOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*PingOK) pingRes() {}
+
+// A project groups loglines that belong together.
+// Ref: #/components/schemas/Project
+type Project struct {
+	ID     ProjectID `json:"id"`
+	UserID UserID    `json:"userID"`
+	// The name of the project.
+	Name string `json:"name"`
+	// A description of the project.
+	Description string `json:"description"`
+	// The date and time at which the project was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// The date and time at which the project was last edited, if it was.
+	UpdatedAt OptDateTime `json:"updatedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *Project) GetID() ProjectID {
+	return s.ID
+}
+
+// GetUserID returns the value of UserID.
+func (s *Project) GetUserID() UserID {
+	return s.UserID
+}
+
+// GetName returns the value of Name.
+func (s *Project) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *Project) GetDescription() string {
+	return s.Description
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Project) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Project) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Project) SetID(val ProjectID) {
+	s.ID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *Project) SetUserID(val UserID) {
+	s.UserID = val
+}
+
+// SetName sets the value of Name.
+func (s *Project) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *Project) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Project) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Project) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+func (*Project) createProjectRes() {}
+func (*Project) deleteProjectRes() {}
+func (*Project) getProjectRes()    {}
+func (*Project) updateProjectRes() {}
+
+type ProjectID uuid.UUID
+
+// Ref: #/components/schemas/ProjectsPage
+type ProjectsPage struct {
+	Items []Project `json:"items"`
+	// The cursor to the next page. Missing on the last page.
+	NextCursor OptString `json:"nextCursor"`
+}
+
+// GetItems returns the value of Items.
+func (s *ProjectsPage) GetItems() []Project {
+	return s.Items
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ProjectsPage) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetItems sets the value of Items.
+func (s *ProjectsPage) SetItems(val []Project) {
+	s.Items = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ProjectsPage) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*ProjectsPage) getProjectsRes() {}
+
+// Ref: #/components/schemas/RegenerateBeatsForm
+type RegenerateBeatsForm struct {
+	BeatsSheetID BeatsSheetID `json:"beatsSheetID"`
+	// The keys of the beats to regenerate.
+	RegenerateKeys []string `json:"regenerateKeys"`
+	// Save the regenerated beats as a new revision of the beats sheet.
+	Apply OptBool `json:"apply"`
+}
+
+// GetBeatsSheetID returns the value of BeatsSheetID.
+func (s *RegenerateBeatsForm) GetBeatsSheetID() BeatsSheetID {
+	return s.BeatsSheetID
+}
+
+// GetRegenerateKeys returns the value of RegenerateKeys.
+func (s *RegenerateBeatsForm) GetRegenerateKeys() []string {
+	return s.RegenerateKeys
+}
+
+// GetApply returns the value of Apply.
+func (s *RegenerateBeatsForm) GetApply() OptBool {
+	return s.Apply
+}
+
+// SetBeatsSheetID sets the value of BeatsSheetID.
+func (s *RegenerateBeatsForm) SetBeatsSheetID(val BeatsSheetID) {
+	s.BeatsSheetID = val
+}
+
+// SetRegenerateKeys sets the value of RegenerateKeys.
+func (s *RegenerateBeatsForm) SetRegenerateKeys(val []string) {
+	s.RegenerateKeys = val
+}
+
+// SetApply sets the value of Apply.
+func (s *RegenerateBeatsForm) SetApply(val OptBool) {
+	s.Apply = val
+}
+
+// Ref: #/components/schemas/RestoreBeatsSheetForm
+type RestoreBeatsSheetForm struct {
+	ID BeatsSheetID `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *RestoreBeatsSheetForm) GetID() BeatsSheetID {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *RestoreBeatsSheetForm) SetID(val BeatsSheetID) {
+	s.ID = val
+}
+
+// Ref: #/components/schemas/RestoreLoglineForm
+type RestoreLoglineForm struct {
+	ID LoglineID `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *RestoreLoglineForm) GetID() LoglineID {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *RestoreLoglineForm) SetID(val LoglineID) {
+	s.ID = val
+}
+
+// Ref: #/components/schemas/RestoreLoglineRevisionForm
+type RestoreLoglineRevisionForm struct {
+	ID LoglineRevisionID `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *RestoreLoglineRevisionForm) GetID() LoglineRevisionID {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *RestoreLoglineRevisionForm) SetID(val LoglineRevisionID) {
+	s.ID = val
+}
+
+// Ref: #/components/schemas/SearchHit
+type SearchHit struct {
+	Kind SearchHitKind `json:"kind"`
+	// The unique identifier of the matching logline or beats sheet.
+	ID        uuid.UUID `json:"id"`
+	LoglineID LoglineID `json:"loglineID"`
+	// The slug of the logline the hit belongs to.
+	Slug Slug `json:"slug"`
+	// The name of the logline the hit belongs to.
+	Name string `json:"name"`
+	// The language of the matching item.
+	Lang Lang `json:"lang"`
+	// The relevance of the hit. Higher is better; ranks are only meaningful when compared within the same
+	// search.
+	Rank float64 `json:"rank"`
+	// HTML excerpts of the matching text, with the matched words wrapped in <mark></mark> tags. The text
+	// itself is HTML-escaped, so the <mark> tags are the only markup in the snippet.
+	Snippet string `json:"snippet"`
+	// The date and time at which the matching item was created.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetKind returns the value of Kind.
+func (s *SearchHit) GetKind() SearchHitKind {
+	return s.Kind
+}
+
+// GetID returns the value of ID.
+func (s *SearchHit) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetLoglineID returns the value of LoglineID.
+func (s *SearchHit) GetLoglineID() LoglineID {
+	return s.LoglineID
+}
+
+// GetSlug returns the value of Slug.
+func (s *SearchHit) GetSlug() Slug {
+	return s.Slug
+}
+
+// GetName returns the value of Name.
+func (s *SearchHit) GetName() string {
+	return s.Name
+}
+
+// GetLang returns the value of Lang.
+func (s *SearchHit) GetLang() Lang {
+	return s.Lang
+}
+
+// GetRank returns the value of Rank.
+func (s *SearchHit) GetRank() float64 {
+	return s.Rank
+}
+
+// GetSnippet returns the value of Snippet.
+func (s *SearchHit) GetSnippet() string {
+	return s.Snippet
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *SearchHit) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetKind sets the value of Kind.
+func (s *SearchHit) SetKind(val SearchHitKind) {
+	s.Kind = val
+}
+
+// SetID sets the value of ID.
+func (s *SearchHit) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetLoglineID sets the value of LoglineID.
+func (s *SearchHit) SetLoglineID(val LoglineID) {
+	s.LoglineID = val
+}
+
+// SetSlug sets the value of Slug.
+func (s *SearchHit) SetSlug(val Slug) {
+	s.Slug = val
+}
+
+// SetName sets the value of Name.
+func (s *SearchHit) SetName(val string) {
+	s.Name = val
+}
+
+// SetLang sets the value of Lang.
+func (s *SearchHit) SetLang(val Lang) {
+	s.Lang = val
+}
+
+// SetRank sets the value of Rank.
+func (s *SearchHit) SetRank(val float64) {
+	s.Rank = val
+}
+
+// SetSnippet sets the value of Snippet.
+func (s *SearchHit) SetSnippet(val string) {
+	s.Snippet = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *SearchHit) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// The type of item a search hit points to.
+// Ref: #/components/schemas/SearchHitKind
+type SearchHitKind string
+
+const (
+	SearchHitKindLogline    SearchHitKind = "logline"
+	SearchHitKindBeatsSheet SearchHitKind = "beatsSheet"
+)
+
+// AllValues returns all SearchHitKind values.
+func (SearchHitKind) AllValues() []SearchHitKind {
+	return []SearchHitKind{
+		SearchHitKindLogline,
+		SearchHitKindBeatsSheet,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchHitKind) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchHitKindLogline:
+		return []byte(s), nil
+	case SearchHitKindBeatsSheet:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchHitKind) UnmarshalText(data []byte) error {
+	switch SearchHitKind(data) {
+	case SearchHitKindLogline:
+		*s = SearchHitKindLogline
+		return nil
+	case SearchHitKindBeatsSheet:
+		*s = SearchHitKindBeatsSheet
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SearchOKApplicationJSON []SearchHit
+
+func (*SearchOKApplicationJSON) searchRes() {}
+
+type Slug string
+
+// A story plan is a structure used to outline a story.
+// Ref: #/components/schemas/StoryPlan
+type StoryPlan struct {
+	ID   StoryPlanID `json:"id"`
+	Slug Slug        `json:"slug"`
+	// The version of the story plan. Each update creates a new version.
+	Version int `json:"version"`
+	// The owner of a custom story plan. Omitted for built-in plans.
+	UserID OptUserID `json:"userID"`
+	// The name of the story plan.
+	Name string `json:"name"`
+	// The language of the story plan.
+	Lang  Lang           `json:"lang"`
+	Acts  StoryPlanActs  `json:"acts"`
+	Beats StoryPlanBeats `json:"beats"`
+	// The date and time at which the story plan was created.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetID returns the value of ID.
+func (s *StoryPlan) GetID() StoryPlanID {
+	return s.ID
+}
+
+// GetSlug returns the value of Slug.
+func (s *StoryPlan) GetSlug() Slug {
+	return s.Slug
+}
+
+// GetVersion returns the value of Version.
+func (s *StoryPlan) GetVersion() int {
+	return s.Version
+}
+
+// GetUserID returns the value of UserID.
+func (s *StoryPlan) GetUserID() OptUserID {
+	return s.UserID
+}
+
+// GetName returns the value of Name.
+func (s *StoryPlan) GetName() string {
+	return s.Name
+}
+
+// GetLang returns the value of Lang.
+func (s *StoryPlan) GetLang() Lang {
+	return s.Lang
+}
+
+// GetActs returns the value of Acts.
+func (s *StoryPlan) GetActs() StoryPlanActs {
+	return s.Acts
+}
+
+// GetBeats returns the value of Beats.
+func (s *StoryPlan) GetBeats() StoryPlanBeats {
+	return s.Beats
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *StoryPlan) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *StoryPlan) SetID(val StoryPlanID) {
+	s.ID = val
+}
+
+// SetSlug sets the value of Slug.
+func (s *StoryPlan) SetSlug(val Slug) {
+	s.Slug = val
+}
+
+// SetVersion sets the value of Version.
+func (s *StoryPlan) SetVersion(val int) {
+	s.Version = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *StoryPlan) SetUserID(val OptUserID) {
+	s.UserID = val
+}
+
+// SetName sets the value of Name.
+func (s *StoryPlan) SetName(val string) {
+	s.Name = val
+}
+
+// SetLang sets the value of Lang.
+func (s *StoryPlan) SetLang(val Lang) {
+	s.Lang = val
+}
+
+// SetActs sets the value of Acts.
+func (s *StoryPlan) SetActs(val StoryPlanActs) {
+	s.Acts = val
+}
+
+// SetBeats sets the value of Beats.
+func (s *StoryPlan) SetBeats(val StoryPlanBeats) {
+	s.Beats = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *StoryPlan) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*StoryPlan) createCustomStoryPlanRes() {}
+func (*StoryPlan) createStoryPlanRes()       {}
+func (*StoryPlan) forkStoryPlanRes()         {}
+func (*StoryPlan) getStoryPlanRes()          {}
+func (*StoryPlan) updateCustomStoryPlanRes() {}
+func (*StoryPlan) updateStoryPlanRes()       {}
+
+// An act, or sequence, groups consecutive beats of a story plan.
+// Ref: #/components/schemas/StoryPlanAct
+type StoryPlanAct struct {
+	// The name of the act.
+	Name string `json:"name"`
+	// The key of the act, unique within the story plan.
+	Key string `json:"key"`
+	// The purpose of the act within the story.
+	Purpose string `json:"purpose"`
+}
+
+// GetName returns the value of Name.
+func (s *StoryPlanAct) GetName() string {
+	return s.Name
+}
+
+// GetKey returns the value of Key.
+func (s *StoryPlanAct) GetKey() string {
+	return s.Key
+}
+
+// GetPurpose returns the value of Purpose.
+func (s *StoryPlanAct) GetPurpose() string {
+	return s.Purpose
+}
+
+// SetName sets the value of Name.
+func (s *StoryPlanAct) SetName(val string) {
+	s.Name = val
+}
+
+// SetKey sets the value of Key.
+func (s *StoryPlanAct) SetKey(val string) {
+	s.Key = val
+}
+
+// SetPurpose sets the value of Purpose.
+func (s *StoryPlanAct) SetPurpose(val string) {
+	s.Purpose = val
+}
+
+type StoryPlanActs []StoryPlanAct
+
+// A beat of a story plan, describing what the matching beat of a beats sheet should cover.
+// Ref: #/components/schemas/StoryPlanBeat
+type StoryPlanBeat struct {
+	// The name of the beat.
+	Name string `json:"name"`
+	// The key of the beat, unique within the story plan.
+	Key string `json:"key"`
+	// The key points the beat must cover.
+	KeyPoints []string `json:"keyPoints"`
+	// The purpose of the beat within the story.
+	Purpose string          `json:"purpose"`
+	Scenes  StoryPlanScenes `json:"scenes"`
+	// The key of the act the beat belongs to. Required when the story plan defines acts.
+	Act OptString `json:"act"`
+	// Optional beats may be omitted from a beats sheet.
+	Optional OptBool            `json:"optional"`
+	Repeat   OptStoryPlanRepeat `json:"repeat"`
+}
+
+// GetName returns the value of Name.
+func (s *StoryPlanBeat) GetName() string {
+	return s.Name
+}
+
+// GetKey returns the value of Key.
+func (s *StoryPlanBeat) GetKey() string {
+	return s.Key
+}
+
+// GetKeyPoints returns the value of KeyPoints.
+func (s *StoryPlanBeat) GetKeyPoints() []string {
+	return s.KeyPoints
+}
+
+// GetPurpose returns the value of Purpose.
+func (s *StoryPlanBeat) GetPurpose() string {
+	return s.Purpose
+}
+
+// GetScenes returns the value of Scenes.
+func (s *StoryPlanBeat) GetScenes() StoryPlanScenes {
+	return s.Scenes
+}
+
+// GetAct returns the value of Act.
+func (s *StoryPlanBeat) GetAct() OptString {
+	return s.Act
+}
+
+// GetOptional returns the value of Optional.
+func (s *StoryPlanBeat) GetOptional() OptBool {
+	return s.Optional
+}
+
+// GetRepeat returns the value of Repeat.
+func (s *StoryPlanBeat) GetRepeat() OptStoryPlanRepeat {
+	return s.Repeat
+}
+
+// SetName sets the value of Name.
+func (s *StoryPlanBeat) SetName(val string) {
+	s.Name = val
+}
+
+// SetKey sets the value of Key.
+func (s *StoryPlanBeat) SetKey(val string) {
+	s.Key = val
+}
+
+// SetKeyPoints sets the value of KeyPoints.
+func (s *StoryPlanBeat) SetKeyPoints(val []string) {
+	s.KeyPoints = val
+}
+
+// SetPurpose sets the value of Purpose.
+func (s *StoryPlanBeat) SetPurpose(val string) {
+	s.Purpose = val
+}
+
+// SetScenes sets the value of Scenes.
+func (s *StoryPlanBeat) SetScenes(val StoryPlanScenes) {
+	s.Scenes = val
+}
+
+// SetAct sets the value of Act.
+func (s *StoryPlanBeat) SetAct(val OptString) {
+	s.Act = val
+}
+
+// SetOptional sets the value of Optional.
+func (s *StoryPlanBeat) SetOptional(val OptBool) {
+	s.Optional = val
+}
+
+// SetRepeat sets the value of Repeat.
+func (s *StoryPlanBeat) SetRepeat(val OptStoryPlanRepeat) {
+	s.Repeat = val
+}
+
+type StoryPlanBeats []StoryPlanBeat
+
+type StoryPlanID uuid.UUID
+
+// Ref: #/components/schemas/StoryPlanPreview
+type StoryPlanPreview struct {
+	ID   StoryPlanID `json:"id"`
+	Slug Slug        `json:"slug"`
+	// The version of the story plan. Each update creates a new version.
+	Version int `json:"version"`
+	// The owner of a custom story plan. Omitted for built-in plans.
+	UserID OptUserID `json:"userID"`
+	// The name of the story plan.
+	Name string `json:"name"`
+	// The language of the story plan.
+	Lang Lang `json:"lang"`
+	// The date and time at which the story plan was created.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetID returns the value of ID.
+func (s *StoryPlanPreview) GetID() StoryPlanID {
+	return s.ID
+}
+
+// GetSlug returns the value of Slug.
+func (s *StoryPlanPreview) GetSlug() Slug {
+	return s.Slug
+}
+
+// GetVersion returns the value of Version.
+func (s *StoryPlanPreview) GetVersion() int {
+	return s.Version
+}
+
+// GetUserID returns the value of UserID.
+func (s *StoryPlanPreview) GetUserID() OptUserID {
+	return s.UserID
+}
+
+// GetName returns the value of Name.
+func (s *StoryPlanPreview) GetName() string {
+	return s.Name
+}
+
+// GetLang returns the value of Lang.
+func (s *StoryPlanPreview) GetLang() Lang {
+	return s.Lang
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *StoryPlanPreview) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *StoryPlanPreview) SetID(val StoryPlanID) {
+	s.ID = val
+}
+
+// SetSlug sets the value of Slug.
+func (s *StoryPlanPreview) SetSlug(val Slug) {
+	s.Slug = val
+}
+
+// SetVersion sets the value of Version.
+func (s *StoryPlanPreview) SetVersion(val int) {
+	s.Version = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *StoryPlanPreview) SetUserID(val OptUserID) {
+	s.UserID = val
+}
+
+// SetName sets the value of Name.
+func (s *StoryPlanPreview) SetName(val string) {
+	s.Name = val
+}
+
+// SetLang sets the value of Lang.
+func (s *StoryPlanPreview) SetLang(val Lang) {
+	s.Lang = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *StoryPlanPreview) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Allows a beat to occur more than once in a row. A missing minimum defaults to 1, and a missing
+// maximum allows
+// the beat to be repeated indefinitely.
+// Ref: #/components/schemas/StoryPlanRepeat
+type StoryPlanRepeat struct {
+	// The minimum number of consecutive occurrences of the beat.
+	Min OptInt `json:"min"`
+	// The maximum number of consecutive occurrences of the beat.
+	Max OptInt `json:"max"`
+}
+
+// GetMin returns the value of Min.
+func (s *StoryPlanRepeat) GetMin() OptInt {
+	return s.Min
+}
+
+// GetMax returns the value of Max.
+func (s *StoryPlanRepeat) GetMax() OptInt {
+	return s.Max
+}
+
+// SetMin sets the value of Min.
+func (s *StoryPlanRepeat) SetMin(val OptInt) {
+	s.Min = val
+}
+
+// SetMax sets the value of Max.
+func (s *StoryPlanRepeat) SetMax(val OptInt) {
+	s.Max = val
+}
+
+// The number of scenes expected for a beat. Either an exact number is provided, or a range delimited
+// by a
+// minimum and a maximum.
+// Ref: #/components/schemas/StoryPlanScenes
+type StoryPlanScenes struct {
+	// The exact number of scenes in the beat.
+	Exact OptInt `json:"exact"`
+	// The minimum number of scenes in the beat.
+	Min OptInt `json:"min"`
+	// The maximum number of scenes in the beat.
+	Max OptInt `json:"max"`
+}
+
+// GetExact returns the value of Exact.
+func (s *StoryPlanScenes) GetExact() OptInt {
+	return s.Exact
+}
+
+// GetMin returns the value of Min.
+func (s *StoryPlanScenes) GetMin() OptInt {
+	return s.Min
+}
+
+// GetMax returns the value of Max.
+func (s *StoryPlanScenes) GetMax() OptInt {
+	return s.Max
+}
+
+// SetExact sets the value of Exact.
+func (s *StoryPlanScenes) SetExact(val OptInt) {
+	s.Exact = val
+}
+
+// SetMin sets the value of Min.
+func (s *StoryPlanScenes) SetMin(val OptInt) {
+	s.Min = val
+}
+
+// SetMax sets the value of Max.
+func (s *StoryPlanScenes) SetMax(val OptInt) {
+	s.Max = val
+}
+
+type Tags []string
+
+// Ref: #/components/schemas/TranslateBeatsSheetForm
+type TranslateBeatsSheetForm struct {
+	BeatsSheetID BeatsSheetID `json:"beatsSheetID"`
+	// The language to translate the beats sheet to.
+	Lang Lang `json:"lang"`
+	// The logline to attach the translation to, usually a translation of the original logline. It must be
+	// written in the target language. Defaults to the logline of the original beats sheet.
+	LoglineID OptLoglineID `json:"loglineID"`
+}
+
+// GetBeatsSheetID returns the value of BeatsSheetID.
+func (s *TranslateBeatsSheetForm) GetBeatsSheetID() BeatsSheetID {
+	return s.BeatsSheetID
+}
+
+// GetLang returns the value of Lang.
+func (s *TranslateBeatsSheetForm) GetLang() Lang {
+	return s.Lang
+}
+
+// GetLoglineID returns the value of LoglineID.
+func (s *TranslateBeatsSheetForm) GetLoglineID() OptLoglineID {
+	return s.LoglineID
+}
+
+// SetBeatsSheetID sets the value of BeatsSheetID.
+func (s *TranslateBeatsSheetForm) SetBeatsSheetID(val BeatsSheetID) {
+	s.BeatsSheetID = val
+}
+
+// SetLang sets the value of Lang.
+func (s *TranslateBeatsSheetForm) SetLang(val Lang) {
+	s.Lang = val
+}
+
+// SetLoglineID sets the value of LoglineID.
+func (s *TranslateBeatsSheetForm) SetLoglineID(val OptLoglineID) {
+	s.LoglineID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptSlug) Get() (v Slug, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// Ref: #/components/schemas/TranslateLoglineForm
+type TranslateLoglineForm struct {
+	LoglineID LoglineID `json:"loglineID"`
+	// The language to translate the logline to.
+	Lang Lang `json:"lang"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptSlug) Or(d Slug) Slug {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetLoglineID returns the value of LoglineID.
+func (s *TranslateLoglineForm) GetLoglineID() LoglineID {
+	return s.LoglineID
 }
 
-// PingIMATeapot is response for Ping operation.
-type PingIMATeapot struct{}
+// GetLang returns the value of Lang.
+func (s *TranslateLoglineForm) GetLang() Lang {
+	return s.Lang
+}
 
-func (*PingIMATeapot) pingRes() {}
+// SetLoglineID sets the value of LoglineID.
+func (s *TranslateLoglineForm) SetLoglineID(val LoglineID) {
+	s.LoglineID = val
+}
 
-type PingOK struct {
-	Data io.Reader
+// SetLang sets the value of Lang.
+func (s *TranslateLoglineForm) SetLang(val Lang) {
+	s.Lang = val
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s PingOK) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// Ref: #/components/schemas/TrashedBeatsSheet
+type TrashedBeatsSheet struct {
+	ID        BeatsSheetID `json:"id"`
+	LoglineID LoglineID    `json:"loglineID"`
+	// The language of the beats sheet.
+	Lang Lang `json:"lang"`
+	// The date and time at which the beats sheet was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// The date and time at which the beats sheet was moved to the trash.
+	DeletedAt time.Time `json:"deletedAt"`
 }
 
-func (*PingOK) pingRes() {}
+// GetID returns the value of ID.
+func (s *TrashedBeatsSheet) GetID() BeatsSheetID {
+	return s.ID
+}
 
-// Ref: #/components/schemas/RegenerateBeatsForm
-type RegenerateBeatsForm struct {
-	BeatsSheetID BeatsSheetID `json:"beatsSheetID"`
-	// The keys of the beats to regenerate.
-	RegenerateKeys []string `json:"regenerateKeys"`
+// GetLoglineID returns the value of LoglineID.
+func (s *TrashedBeatsSheet) GetLoglineID() LoglineID {
+	return s.LoglineID
 }
 
-// GetBeatsSheetID returns the value of BeatsSheetID.
-func (s *RegenerateBeatsForm) GetBeatsSheetID() BeatsSheetID {
-	return s.BeatsSheetID
+// GetLang returns the value of Lang.
+func (s *TrashedBeatsSheet) GetLang() Lang {
+	return s.Lang
 }
 
-// GetRegenerateKeys returns the value of RegenerateKeys.
-func (s *RegenerateBeatsForm) GetRegenerateKeys() []string {
-	return s.RegenerateKeys
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TrashedBeatsSheet) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// SetBeatsSheetID sets the value of BeatsSheetID.
-func (s *RegenerateBeatsForm) SetBeatsSheetID(val BeatsSheetID) {
-	s.BeatsSheetID = val
+// GetDeletedAt returns the value of DeletedAt.
+func (s *TrashedBeatsSheet) GetDeletedAt() time.Time {
+	return s.DeletedAt
 }
 
-// SetRegenerateKeys sets the value of RegenerateKeys.
-func (s *RegenerateBeatsForm) SetRegenerateKeys(val []string) {
-	s.RegenerateKeys = val
+// SetID sets the value of ID.
+func (s *TrashedBeatsSheet) SetID(val BeatsSheetID) {
+	s.ID = val
 }
 
-type Slug string
+// SetLoglineID sets the value of LoglineID.
+func (s *TrashedBeatsSheet) SetLoglineID(val LoglineID) {
+	s.LoglineID = val
+}
+
+// SetLang sets the value of Lang.
+func (s *TrashedBeatsSheet) SetLang(val Lang) {
+	s.Lang = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TrashedBeatsSheet) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetDeletedAt sets the value of DeletedAt.
+func (s *TrashedBeatsSheet) SetDeletedAt(val time.Time) {
+	s.DeletedAt = val
+}
+
+func (*TrashedBeatsSheet) deleteBeatsSheetRes() {}
 
 // Ref: #/components/schemas/UnauthorizedError
 type UnauthorizedError struct {
@@ -1057,17 +4398,52 @@ func (s *UnauthorizedError) SetError(val string) {
 	s.Error = val
 }
 
-func (*UnauthorizedError) createBeatsSheetRes()   {}
-func (*UnauthorizedError) createLoglineRes()      {}
-func (*UnauthorizedError) expandBeatRes()         {}
-func (*UnauthorizedError) expandLoglineRes()      {}
-func (*UnauthorizedError) generateBeatsSheetRes() {}
-func (*UnauthorizedError) generateLoglinesRes()   {}
-func (*UnauthorizedError) getBeatsSheetRes()      {}
-func (*UnauthorizedError) getBeatsSheetsRes()     {}
-func (*UnauthorizedError) getLoglineRes()         {}
-func (*UnauthorizedError) getLoglinesRes()        {}
-func (*UnauthorizedError) regenerateBeatsRes()    {}
+func (*UnauthorizedError) convertBeatsSheetRes()         {}
+func (*UnauthorizedError) createBeatsSheetRes()          {}
+func (*UnauthorizedError) createCustomStoryPlanRes()     {}
+func (*UnauthorizedError) createLoglineRes()             {}
+func (*UnauthorizedError) createProjectRes()             {}
+func (*UnauthorizedError) createStoryPlanRes()           {}
+func (*UnauthorizedError) critiqueLoglineRes()           {}
+func (*UnauthorizedError) deleteBeatsSheetRes()          {}
+func (*UnauthorizedError) deleteLoglineRes()             {}
+func (*UnauthorizedError) deleteProjectRes()             {}
+func (*UnauthorizedError) detectLangRes()                {}
+func (*UnauthorizedError) expandBeatRes()                {}
+func (*UnauthorizedError) expandLoglineRes()             {}
+func (*UnauthorizedError) forkStoryPlanRes()             {}
+func (*UnauthorizedError) generateBeatsSheetRes()        {}
+func (*UnauthorizedError) generateLoglineVariationsRes() {}
+func (*UnauthorizedError) generateLoglinesRes()          {}
+func (*UnauthorizedError) getBeatsSheetDiffRes()         {}
+func (*UnauthorizedError) getBeatsSheetRes()             {}
+func (*UnauthorizedError) getBeatsSheetsRes()            {}
+func (*UnauthorizedError) getLoglineRes()                {}
+func (*UnauthorizedError) getLoglineRevisionDiffRes()    {}
+func (*UnauthorizedError) getLoglineRevisionRes()        {}
+func (*UnauthorizedError) getLoglineRevisionsRes()       {}
+func (*UnauthorizedError) getLoglineTagsRes()            {}
+func (*UnauthorizedError) getLoglinesRes()               {}
+func (*UnauthorizedError) getProjectRes()                {}
+func (*UnauthorizedError) getProjectsRes()               {}
+func (*UnauthorizedError) getStoryPlanRes()              {}
+func (*UnauthorizedError) getStoryPlansRes()             {}
+func (*UnauthorizedError) getTrashedBeatsSheetsRes()     {}
+func (*UnauthorizedError) getTrashedLoglinesRes()        {}
+func (*UnauthorizedError) regenerateBeatsRes()           {}
+func (*UnauthorizedError) restoreBeatsSheetRes()         {}
+func (*UnauthorizedError) restoreLoglineRes()            {}
+func (*UnauthorizedError) restoreLoglineRevisionRes()    {}
+func (*UnauthorizedError) searchRes()                    {}
+func (*UnauthorizedError) translateBeatsSheetRes()       {}
+func (*UnauthorizedError) translateLoglineRes()          {}
+func (*UnauthorizedError) updateBeatRes()                {}
+func (*UnauthorizedError) updateCustomStoryPlanRes()     {}
+func (*UnauthorizedError) updateLoglineRes()             {}
+func (*UnauthorizedError) updateProjectRes()             {}
+func (*UnauthorizedError) updateStoryPlanRes()           {}
+func (*UnauthorizedError) upgradeBeatsSheetsRes()        {}
+func (*UnauthorizedError) upgradeCustomBeatsSheetsRes()  {}
 
 // Ref: #/components/schemas/UnexpectedError
 type UnexpectedError struct {
@@ -1127,7 +4503,313 @@ func (s *UnprocessableEntityError) SetError(val string) {
 	s.Error = val
 }
 
-func (*UnprocessableEntityError) createBeatsSheetRes() {}
-func (*UnprocessableEntityError) expandBeatRes()       {}
+func (*UnprocessableEntityError) convertBeatsSheetRes()         {}
+func (*UnprocessableEntityError) createBeatsSheetRes()          {}
+func (*UnprocessableEntityError) createCustomStoryPlanRes()     {}
+func (*UnprocessableEntityError) createLoglineRes()             {}
+func (*UnprocessableEntityError) createStoryPlanRes()           {}
+func (*UnprocessableEntityError) critiqueLoglineRes()           {}
+func (*UnprocessableEntityError) expandBeatRes()                {}
+func (*UnprocessableEntityError) expandLoglineRes()             {}
+func (*UnprocessableEntityError) generateBeatsSheetRes()        {}
+func (*UnprocessableEntityError) generateLoglineVariationsRes() {}
+func (*UnprocessableEntityError) generateLoglinesRes()          {}
+func (*UnprocessableEntityError) getBeatsSheetsRes()            {}
+func (*UnprocessableEntityError) getLoglinesRes()               {}
+func (*UnprocessableEntityError) getProjectsRes()               {}
+func (*UnprocessableEntityError) getStoryPlanRes()              {}
+func (*UnprocessableEntityError) regenerateBeatsRes()           {}
+func (*UnprocessableEntityError) translateBeatsSheetRes()       {}
+func (*UnprocessableEntityError) translateLoglineRes()          {}
+func (*UnprocessableEntityError) updateBeatRes()                {}
+func (*UnprocessableEntityError) updateCustomStoryPlanRes()     {}
+func (*UnprocessableEntityError) updateLoglineRes()             {}
+func (*UnprocessableEntityError) updateStoryPlanRes()           {}
+
+// Ref: #/components/schemas/UpdateBeatForm
+type UpdateBeatForm struct {
+	BeatsSheetID BeatsSheetID `json:"beatsSheetID"`
+	// The key of the beat to update.
+	Key string `json:"key"`
+	// When the key is repeated in the beats sheet, the zero-based occurrence of the beat to update.
+	// Defaults to
+	// the first one.
+	Occurrence OptInt `json:"occurrence"`
+	// The new title of the beat.
+	Title OptString `json:"title"`
+	// The new content of the beat.
+	Content OptString `json:"content"`
+}
+
+// GetBeatsSheetID returns the value of BeatsSheetID.
+func (s *UpdateBeatForm) GetBeatsSheetID() BeatsSheetID {
+	return s.BeatsSheetID
+}
+
+// GetKey returns the value of Key.
+func (s *UpdateBeatForm) GetKey() string {
+	return s.Key
+}
+
+// GetOccurrence returns the value of Occurrence.
+func (s *UpdateBeatForm) GetOccurrence() OptInt {
+	return s.Occurrence
+}
+
+// GetTitle returns the value of Title.
+func (s *UpdateBeatForm) GetTitle() OptString {
+	return s.Title
+}
+
+// GetContent returns the value of Content.
+func (s *UpdateBeatForm) GetContent() OptString {
+	return s.Content
+}
+
+// SetBeatsSheetID sets the value of BeatsSheetID.
+func (s *UpdateBeatForm) SetBeatsSheetID(val BeatsSheetID) {
+	s.BeatsSheetID = val
+}
+
+// SetKey sets the value of Key.
+func (s *UpdateBeatForm) SetKey(val string) {
+	s.Key = val
+}
+
+// SetOccurrence sets the value of Occurrence.
+func (s *UpdateBeatForm) SetOccurrence(val OptInt) {
+	s.Occurrence = val
+}
+
+// SetTitle sets the value of Title.
+func (s *UpdateBeatForm) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// SetContent sets the value of Content.
+func (s *UpdateBeatForm) SetContent(val OptString) {
+	s.Content = val
+}
+
+// Ref: #/components/schemas/UpdateLoglineForm
+type UpdateLoglineForm struct {
+	ID   LoglineID `json:"id"`
+	Slug OptSlug   `json:"slug"`
+	// The name of the logline.
+	Name OptString `json:"name"`
+	// The content of the logline.
+	Content OptString `json:"content"`
+	// The language of the logline.
+	Lang OptLang `json:"lang"`
+	// The new genre of the logline. Set to null to remove it.
+	Genre OptNilGenre `json:"genre"`
+	// Replaces every tag of the logline. Pass an empty list to remove them all.
+	Tags Tags `json:"tags"`
+	// The project to move the logline to. Set to null to take the logline out of its project.
+	ProjectID OptNilProjectID `json:"projectID"`
+}
+
+// GetID returns the value of ID.
+func (s *UpdateLoglineForm) GetID() LoglineID {
+	return s.ID
+}
+
+// GetSlug returns the value of Slug.
+func (s *UpdateLoglineForm) GetSlug() OptSlug {
+	return s.Slug
+}
+
+// GetName returns the value of Name.
+func (s *UpdateLoglineForm) GetName() OptString {
+	return s.Name
+}
+
+// GetContent returns the value of Content.
+func (s *UpdateLoglineForm) GetContent() OptString {
+	return s.Content
+}
+
+// GetLang returns the value of Lang.
+func (s *UpdateLoglineForm) GetLang() OptLang {
+	return s.Lang
+}
+
+// GetGenre returns the value of Genre.
+func (s *UpdateLoglineForm) GetGenre() OptNilGenre {
+	return s.Genre
+}
+
+// GetTags returns the value of Tags.
+func (s *UpdateLoglineForm) GetTags() Tags {
+	return s.Tags
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *UpdateLoglineForm) GetProjectID() OptNilProjectID {
+	return s.ProjectID
+}
+
+// SetID sets the value of ID.
+func (s *UpdateLoglineForm) SetID(val LoglineID) {
+	s.ID = val
+}
+
+// SetSlug sets the value of Slug.
+func (s *UpdateLoglineForm) SetSlug(val OptSlug) {
+	s.Slug = val
+}
+
+// SetName sets the value of Name.
+func (s *UpdateLoglineForm) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetContent sets the value of Content.
+func (s *UpdateLoglineForm) SetContent(val OptString) {
+	s.Content = val
+}
+
+// SetLang sets the value of Lang.
+func (s *UpdateLoglineForm) SetLang(val OptLang) {
+	s.Lang = val
+}
+
+// SetGenre sets the value of Genre.
+func (s *UpdateLoglineForm) SetGenre(val OptNilGenre) {
+	s.Genre = val
+}
+
+// SetTags sets the value of Tags.
+func (s *UpdateLoglineForm) SetTags(val Tags) {
+	s.Tags = val
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *UpdateLoglineForm) SetProjectID(val OptNilProjectID) {
+	s.ProjectID = val
+}
+
+// Ref: #/components/schemas/UpdateProjectForm
+type UpdateProjectForm struct {
+	ID ProjectID `json:"id"`
+	// The name of the project.
+	Name OptString `json:"name"`
+	// A description of the project.
+	Description OptString `json:"description"`
+}
+
+// GetID returns the value of ID.
+func (s *UpdateProjectForm) GetID() ProjectID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *UpdateProjectForm) GetName() OptString {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *UpdateProjectForm) GetDescription() OptString {
+	return s.Description
+}
+
+// SetID sets the value of ID.
+func (s *UpdateProjectForm) SetID(val ProjectID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *UpdateProjectForm) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *UpdateProjectForm) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// Ref: #/components/schemas/UpdateStoryPlanForm
+type UpdateStoryPlanForm struct {
+	ID StoryPlanID `json:"id"`
+	// The name of the story plan.
+	Name  string         `json:"name"`
+	Acts  StoryPlanActs  `json:"acts"`
+	Beats StoryPlanBeats `json:"beats"`
+}
+
+// GetID returns the value of ID.
+func (s *UpdateStoryPlanForm) GetID() StoryPlanID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *UpdateStoryPlanForm) GetName() string {
+	return s.Name
+}
+
+// GetActs returns the value of Acts.
+func (s *UpdateStoryPlanForm) GetActs() StoryPlanActs {
+	return s.Acts
+}
+
+// GetBeats returns the value of Beats.
+func (s *UpdateStoryPlanForm) GetBeats() StoryPlanBeats {
+	return s.Beats
+}
+
+// SetID sets the value of ID.
+func (s *UpdateStoryPlanForm) SetID(val StoryPlanID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *UpdateStoryPlanForm) SetName(val string) {
+	s.Name = val
+}
+
+// SetActs sets the value of Acts.
+func (s *UpdateStoryPlanForm) SetActs(val StoryPlanActs) {
+	s.Acts = val
+}
+
+// SetBeats sets the value of Beats.
+func (s *UpdateStoryPlanForm) SetBeats(val StoryPlanBeats) {
+	s.Beats = val
+}
+
+// Ref: #/components/schemas/UpgradeBeatsSheetsForm
+type UpgradeBeatsSheetsForm struct {
+	ID StoryPlanID `json:"id"`
+	// Only report the outdated beats sheets, without upgrading them.
+	DryRun OptBool `json:"dryRun"`
+}
+
+// GetID returns the value of ID.
+func (s *UpgradeBeatsSheetsForm) GetID() StoryPlanID {
+	return s.ID
+}
+
+// GetDryRun returns the value of DryRun.
+func (s *UpgradeBeatsSheetsForm) GetDryRun() OptBool {
+	return s.DryRun
+}
+
+// SetID sets the value of ID.
+func (s *UpgradeBeatsSheetsForm) SetID(val StoryPlanID) {
+	s.ID = val
+}
+
+// SetDryRun sets the value of DryRun.
+func (s *UpgradeBeatsSheetsForm) SetDryRun(val OptBool) {
+	s.DryRun = val
+}
+
+type UpgradeBeatsSheetsOKApplicationJSON []BeatsSheetUpgrade
+
+func (*UpgradeBeatsSheetsOKApplicationJSON) upgradeBeatsSheetsRes() {}
+
+type UpgradeCustomBeatsSheetsOKApplicationJSON []BeatsSheetUpgrade
+
+func (*UpgradeCustomBeatsSheetsOKApplicationJSON) upgradeCustomBeatsSheetsRes() {}
 
 type UserID uuid.UUID
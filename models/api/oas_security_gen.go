@@ -33,39 +33,144 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 }
 
 var operationRolesBearerAuth = map[string][]string{
+	ConvertBeatsSheetOperation: []string{
+		"beats-sheet:convert",
+	},
 	CreateBeatsSheetOperation: []string{
 		"beats-sheet:create",
 	},
+	CreateCustomStoryPlanOperation: []string{
+		"custom-story-plan:create",
+	},
 	CreateLoglineOperation: []string{
 		"logline:create",
 	},
+	CreateProjectOperation: []string{
+		"project:create",
+	},
+	CreateStoryPlanOperation: []string{
+		"story-plan:create",
+	},
+	CritiqueLoglineOperation: []string{
+		"logline:critique",
+	},
+	DeleteBeatsSheetOperation: []string{
+		"beats-sheet:delete",
+	},
+	DeleteLoglineOperation: []string{
+		"logline:delete",
+	},
+	DeleteProjectOperation: []string{
+		"project:delete",
+	},
+	DetectLangOperation: []string{
+		"lang:detect",
+	},
 	ExpandBeatOperation: []string{
 		"beat:expand",
 	},
 	ExpandLoglineOperation: []string{
 		"logline:expand",
 	},
+	ForkStoryPlanOperation: []string{
+		"custom-story-plan:create",
+	},
 	GenerateBeatsSheetOperation: []string{
 		"beats-sheet:generate",
 	},
+	GenerateLoglineVariationsOperation: []string{
+		"loglines:generate",
+	},
 	GenerateLoglinesOperation: []string{
 		"loglines:generate",
 	},
 	GetBeatsSheetOperation: []string{
 		"beats-sheet:read",
 	},
+	GetBeatsSheetDiffOperation: []string{
+		"beats-sheet:read",
+	},
 	GetBeatsSheetsOperation: []string{
 		"beats-sheets:read",
 	},
 	GetLoglineOperation: []string{
 		"logline:read",
 	},
+	GetLoglineRevisionOperation: []string{
+		"logline-revision:read",
+	},
+	GetLoglineRevisionDiffOperation: []string{
+		"logline-revision:read",
+	},
+	GetLoglineRevisionsOperation: []string{
+		"logline-revisions:read",
+	},
+	GetLoglineTagsOperation: []string{
+		"loglines:read",
+	},
 	GetLoglinesOperation: []string{
 		"loglines:read",
 	},
+	GetProjectOperation: []string{
+		"project:read",
+	},
+	GetProjectsOperation: []string{
+		"projects:read",
+	},
+	GetStoryPlanOperation: []string{
+		"story-plan:read",
+	},
+	GetStoryPlansOperation: []string{
+		"story-plans:read",
+	},
+	GetTrashedBeatsSheetsOperation: []string{
+		"trash:read",
+	},
+	GetTrashedLoglinesOperation: []string{
+		"trash:read",
+	},
 	RegenerateBeatsOperation: []string{
 		"beats-sheet:regenerate",
 	},
+	RestoreBeatsSheetOperation: []string{
+		"beats-sheet:restore",
+	},
+	RestoreLoglineOperation: []string{
+		"logline:restore",
+	},
+	RestoreLoglineRevisionOperation: []string{
+		"logline:update",
+	},
+	SearchOperation: []string{
+		"search:read",
+	},
+	TranslateBeatsSheetOperation: []string{
+		"beats-sheet:translate",
+	},
+	TranslateLoglineOperation: []string{
+		"logline:translate",
+	},
+	UpdateBeatOperation: []string{
+		"beat:update",
+	},
+	UpdateCustomStoryPlanOperation: []string{
+		"custom-story-plan:update",
+	},
+	UpdateLoglineOperation: []string{
+		"logline:update",
+	},
+	UpdateProjectOperation: []string{
+		"project:update",
+	},
+	UpdateStoryPlanOperation: []string{
+		"story-plan:update",
+	},
+	UpgradeBeatsSheetsOperation: []string{
+		"story-plan:update",
+	},
+	UpgradeCustomBeatsSheetsOperation: []string{
+		"custom-story-plan:upgrade",
+	},
 }
 
 func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
@@ -8,21 +8,95 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// ConvertBeatsSheet implements convertBeatsSheet operation.
+	//
+	// Rewrite an existing beats sheet so it follows a different story plan, preserving the story it
+	// tells. The
+	// result is saved as a new beats sheet, linked to the original one. The target plan must be
+	// available in the
+	// language of the original beats sheet.
+	//
+	// POST /beats-sheet/convert
+	ConvertBeatsSheet(ctx context.Context, req *ConvertBeatsSheetForm) (ConvertBeatsSheetRes, error)
 	// CreateBeatsSheet implements createBeatsSheet operation.
 	//
 	// Create a new beats sheet for a logline, following a story plan.
 	//
 	// PUT /beats-sheet
 	CreateBeatsSheet(ctx context.Context, req *CreateBeatsSheetForm) (CreateBeatsSheetRes, error)
+	// CreateCustomStoryPlan implements createCustomStoryPlan operation.
+	//
+	// Create a new story plan, owned by the current user. Custom plans are only visible to their owner.
+	// They may
+	// reuse the slug of a built-in plan, in which case they take precedence over it when selected by
+	// slug.
+	//
+	// PUT /story-plan/custom
+	CreateCustomStoryPlan(ctx context.Context, req *CreateStoryPlanForm) (CreateCustomStoryPlanRes, error)
 	// CreateLogline implements createLogline operation.
 	//
 	// Create a new logline for a user.
 	//
 	// PUT /logline
 	CreateLogline(ctx context.Context, req *CreateLoglineForm) (CreateLoglineRes, error)
+	// CreateProject implements createProject operation.
+	//
+	// Create a new project for the current user.
+	//
+	// PUT /project
+	CreateProject(ctx context.Context, req *CreateProjectForm) (CreateProjectRes, error)
+	// CreateStoryPlan implements createStoryPlan operation.
+	//
+	// Create a new story plan. The slug must be unique for a given language.
+	//
+	// PUT /story-plan
+	CreateStoryPlan(ctx context.Context, req *CreateStoryPlanForm) (CreateStoryPlanRes, error)
+	// CritiqueLogline implements critiqueLogline operation.
+	//
+	// Score the protagonist, goal, stakes, hook and genre clarity of a logline, with a justification and
+	// a concrete
+	// suggestion for each. Either critique a logline of the current user, or a logline idea that was not
+	// saved.
+	//
+	// POST /logline/critique
+	CritiqueLogline(ctx context.Context, req *CritiqueLoglineForm) (CritiqueLoglineRes, error)
+	// DeleteBeatsSheet implements deleteBeatsSheet operation.
+	//
+	// Move a beats sheet to the trash. It can be restored until the trash is purged.
+	//
+	// DELETE /beats-sheet
+	DeleteBeatsSheet(ctx context.Context, params DeleteBeatsSheetParams) (DeleteBeatsSheetRes, error)
+	// DeleteLogline implements deleteLogline operation.
+	//
+	// Move a logline to the trash, along with its beats sheets. They can be restored until the trash is
+	// purged.
+	//
+	// DELETE /logline
+	DeleteLogline(ctx context.Context, params DeleteLoglineParams) (DeleteLoglineRes, error)
+	// DeleteProject implements deleteProject operation.
+	//
+	// Permanently delete a project. The loglines of the project are kept, and no longer belong to any
+	// project.
+	//
+	// DELETE /project
+	DeleteProject(ctx context.Context, params DeleteProjectParams) (DeleteProjectRes, error)
+	// DetectLang implements detectLang operation.
+	//
+	// Guess the language a text is written in, among the languages supported by the service. Loglines
+	// and beats
+	// sheets are rejected on creation when they are confidently detected to be written in another
+	// language than
+	// the one they are declared in. Short texts usually come with a low confidence, and may not have a
+	// detected
+	// language at all.
+	//
+	// POST /lang/detect
+	DetectLang(ctx context.Context, req *DetectLangForm) (DetectLangRes, error)
 	// ExpandBeat implements expandBeat operation.
 	//
 	// Add more details to a specific beat in a beats sheet.
+	// When apply is set, the expanded beat is saved as a new beats sheet, whose parent is the original
+	// one.
 	//
 	// POST /beats-sheet/expand
 	ExpandBeat(ctx context.Context, req *ExpandBeatForm) (ExpandBeatRes, error)
@@ -32,12 +106,30 @@ type Handler interface {
 	//
 	// POST /logline/expand
 	ExpandLogline(ctx context.Context, req *LoglineIdea) (ExpandLoglineRes, error)
+	// ForkStoryPlan implements forkStoryPlan operation.
+	//
+	// Copy a built-in story plan, or a custom plan owned by the current user, into a new custom plan
+	// owned by the
+	// current user. The slug and name of the source plan are kept, unless overridden.
+	//
+	// POST /story-plan/fork
+	ForkStoryPlan(ctx context.Context, req *ForkStoryPlanForm) (ForkStoryPlanRes, error)
 	// GenerateBeatsSheet implements generateBeatsSheet operation.
 	//
 	// Generate a new beats sheet for a logline, following a story plan.
 	//
 	// POST /beats-sheet/generate
 	GenerateBeatsSheet(ctx context.Context, req *GenerateBeatsSheetForm) (GenerateBeatsSheetRes, error)
+	// GenerateLoglineVariations implements generateLoglineVariations operation.
+	//
+	// Generate variations of an existing logline. Each variation changes a single dimension of the
+	// original logline,
+	// among the requested ones, and keeps every other dimension as is. Either derive the variations from
+	// a logline
+	// of the current user, or from a logline idea that was not saved.
+	//
+	// POST /loglines/variations
+	GenerateLoglineVariations(ctx context.Context, req *GenerateLoglineVariationsForm) (GenerateLoglineVariationsRes, error)
 	// GenerateLoglines implements generateLoglines operation.
 	//
 	// Generate new loglines for a user.
@@ -50,9 +142,19 @@ type Handler interface {
 	//
 	// GET /beats-sheet
 	GetBeatsSheet(ctx context.Context, params GetBeatsSheetParams) (GetBeatsSheetRes, error)
+	// GetBeatsSheetDiff implements getBeatsSheetDiff operation.
+	//
+	// Match the beats of two beats sheets by key, and compute the word-level changes of each beat. Beats
+	// added,
+	// removed or moved between both sheets are reported as such.
+	//
+	// GET /beats-sheet/diff
+	GetBeatsSheetDiff(ctx context.Context, params GetBeatsSheetDiffParams) (GetBeatsSheetDiffRes, error)
 	// GetBeatsSheets implements getBeatsSheets operation.
 	//
-	// Get all beats sheets for the current user.
+	// Get all beats sheets for the current user. Results are paginated with cursors: pass the nextCursor
+	// of a page
+	// to get the following one.
 	//
 	// GET /beats-sheets
 	GetBeatsSheets(ctx context.Context, params GetBeatsSheetsParams) (GetBeatsSheetsRes, error)
@@ -62,12 +164,86 @@ type Handler interface {
 	//
 	// GET /logline
 	GetLogline(ctx context.Context, params GetLoglineParams) (GetLoglineRes, error)
+	// GetLoglineRevision implements getLoglineRevision operation.
+	//
+	// Get a single revision of a logline owned by the current user.
+	//
+	// GET /logline/revision
+	GetLoglineRevision(ctx context.Context, params GetLoglineRevisionParams) (GetLoglineRevisionRes, error)
+	// GetLoglineRevisionDiff implements getLoglineRevisionDiff operation.
+	//
+	// Compute the word-level changes that turn a revision into another one.
+	//
+	// GET /logline/revision/diff
+	GetLoglineRevisionDiff(ctx context.Context, params GetLoglineRevisionDiffParams) (GetLoglineRevisionDiffRes, error)
+	// GetLoglineRevisions implements getLoglineRevisions operation.
+	//
+	// List the revisions of a logline owned by the current user, most recent first. A revision is saved
+	// every time
+	// the name, content or language of the logline is written.
+	//
+	// GET /logline/revisions
+	GetLoglineRevisions(ctx context.Context, params GetLoglineRevisionsParams) (GetLoglineRevisionsRes, error)
+	// GetLoglineTags implements getLoglineTags operation.
+	//
+	// List the tags used on the loglines of the current user, with the number of loglines using each of
+	// them. Most
+	// used tags come first. Loglines in the trash are not counted.
+	//
+	// GET /loglines/tags
+	GetLoglineTags(ctx context.Context) (GetLoglineTagsRes, error)
 	// GetLoglines implements getLoglines operation.
 	//
-	// Get all loglines for the current user.
+	// Get all loglines for the current user. Results are paginated with cursors: pass the nextCursor of
+	// a page to
+	// get the following one.
 	//
 	// GET /loglines
 	GetLoglines(ctx context.Context, params GetLoglinesParams) (GetLoglinesRes, error)
+	// GetProject implements getProject operation.
+	//
+	// Get a project owned by the current user.
+	//
+	// GET /project
+	GetProject(ctx context.Context, params GetProjectParams) (GetProjectRes, error)
+	// GetProjects implements getProjects operation.
+	//
+	// Get all the projects of the current user, sorted by name. Results are paginated with cursors: pass
+	// the
+	// nextCursor of a page to get the following one.
+	//
+	// GET /projects
+	GetProjects(ctx context.Context, params GetProjectsParams) (GetProjectsRes, error)
+	// GetStoryPlan implements getStoryPlan operation.
+	//
+	// Get a story plan, either by its unique identifier, or by its slug and language. If neither the id
+	// nor the slug
+	// is provided, the default story plan for the language is returned. When selecting by slug, the
+	// latest version
+	// of the plan is returned.
+	//
+	// GET /story-plan
+	GetStoryPlan(ctx context.Context, params GetStoryPlanParams) (GetStoryPlanRes, error)
+	// GetStoryPlans implements getStoryPlans operation.
+	//
+	// Get all the available story plans.
+	//
+	// GET /story-plans
+	GetStoryPlans(ctx context.Context, params GetStoryPlansParams) (GetStoryPlansRes, error)
+	// GetTrashedBeatsSheets implements getTrashedBeatsSheets operation.
+	//
+	// List the beats sheets of the current user that were deleted on their own, most recently deleted
+	// first. Sheets
+	// deleted along with their logline are restored with it, and are not listed here.
+	//
+	// GET /beats-sheets/trash
+	GetTrashedBeatsSheets(ctx context.Context, params GetTrashedBeatsSheetsParams) (GetTrashedBeatsSheetsRes, error)
+	// GetTrashedLoglines implements getTrashedLoglines operation.
+	//
+	// List the loglines of the current user that are in the trash, most recently deleted first.
+	//
+	// GET /loglines/trash
+	GetTrashedLoglines(ctx context.Context, params GetTrashedLoglinesParams) (GetTrashedLoglinesRes, error)
 	// Healthcheck implements healthcheck operation.
 	//
 	// Returns a detailed report of the health of the service, including every dependency.
@@ -83,9 +259,121 @@ type Handler interface {
 	// RegenerateBeats implements regenerateBeats operation.
 	//
 	// Regenerate the content of specific beats in a beats sheet.
+	// When apply is set, the regenerated beats are saved as a new beats sheet, whose parent is the
+	// original one.
 	//
 	// POST /beats-sheet/regenerate
 	RegenerateBeats(ctx context.Context, req *RegenerateBeatsForm) (RegenerateBeatsRes, error)
+	// RestoreBeatsSheet implements restoreBeatsSheet operation.
+	//
+	// Take a beats sheet out of the trash. Sheets deleted along with their logline must be restored
+	// through the
+	// logline.
+	//
+	// POST /beats-sheet/restore
+	RestoreBeatsSheet(ctx context.Context, req *RestoreBeatsSheetForm) (RestoreBeatsSheetRes, error)
+	// RestoreLogline implements restoreLogline operation.
+	//
+	// Take a logline out of the trash, along with the beats sheets that were deleted with it.
+	//
+	// POST /logline/restore
+	RestoreLogline(ctx context.Context, req *RestoreLoglineForm) (RestoreLoglineRes, error)
+	// RestoreLoglineRevision implements restoreLoglineRevision operation.
+	//
+	// Write the name, content and language of an older revision back to its logline. The slug of the
+	// logline is
+	// kept, and the restored content is saved as a new revision.
+	//
+	// POST /logline/revision/restore
+	RestoreLoglineRevision(ctx context.Context, req *RestoreLoglineRevisionForm) (RestoreLoglineRevisionRes, error)
+	// Search implements search operation.
+	//
+	// Run a full-text search over the loglines and beats sheets of the current user, outside the trash.
+	// Each item is
+	// matched using the dictionary of its own language, so words match their inflected forms. The query
+	// supports
+	// the web search syntax: quoted phrases, "or", and "-" to exclude a word. Hits are returned most
+	// relevant first,
+	// with snippets of the matching text.
+	//
+	// GET /search
+	Search(ctx context.Context, params SearchParams) (SearchRes, error)
+	// TranslateBeatsSheet implements translateBeatsSheet operation.
+	//
+	// Translate an existing beats sheet to another language. The translation follows the same story plan,
+	//  in the
+	// target language, and keeps every beat of the original sheet. It is saved as a new beats sheet,
+	// linked to the
+	// original one.
+	//
+	// POST /beats-sheet/translate
+	TranslateBeatsSheet(ctx context.Context, req *TranslateBeatsSheetForm) (TranslateBeatsSheetRes, error)
+	// TranslateLogline implements translateLogline operation.
+	//
+	// Translate an existing logline to another language. The translation is saved as a new logline,
+	// linked to the
+	// original one.
+	//
+	// POST /logline/translate
+	TranslateLogline(ctx context.Context, req *TranslateLoglineForm) (TranslateLoglineRes, error)
+	// UpdateBeat implements updateBeat operation.
+	//
+	// Edit the title or content of a single beat. Omitted fields keep their current value. The edit is
+	// saved as a
+	// new beats sheet, whose parent is the original one, and the original sheet is left untouched.
+	//
+	// PATCH /beats-sheet/beat
+	UpdateBeat(ctx context.Context, req *UpdateBeatForm) (UpdateBeatRes, error)
+	// UpdateCustomStoryPlan implements updateCustomStoryPlan operation.
+	//
+	// Create a new version of a story plan owned by the current user. Built-in plans, and plans owned by
+	// other
+	// users, cannot be updated through this endpoint.
+	//
+	// PATCH /story-plan/custom
+	UpdateCustomStoryPlan(ctx context.Context, req *UpdateStoryPlanForm) (UpdateCustomStoryPlanRes, error)
+	// UpdateLogline implements updateLogline operation.
+	//
+	// Edit a logline owned by the current user, in place. Omitted fields keep their current value. If
+	// the new slug
+	// is already used by another logline of the user, a version number is appended to it.
+	//
+	// PATCH /logline
+	UpdateLogline(ctx context.Context, req *UpdateLoglineForm) (UpdateLoglineRes, error)
+	// UpdateProject implements updateProject operation.
+	//
+	// Edit a project owned by the current user. Omitted fields keep their current value.
+	//
+	// PATCH /project
+	UpdateProject(ctx context.Context, req *UpdateProjectForm) (UpdateProjectRes, error)
+	// UpdateStoryPlan implements updateStoryPlan operation.
+	//
+	// Update the name and beats of an existing story plan. Story plans are immutable: this creates a new
+	// version
+	// of the plan, with the same slug and language. Previous versions are kept, so existing beats sheets
+	// remain
+	// pinned to the version they were built against.
+	//
+	// PATCH /story-plan
+	UpdateStoryPlan(ctx context.Context, req *UpdateStoryPlanForm) (UpdateStoryPlanRes, error)
+	// UpgradeBeatsSheets implements upgradeBeatsSheets operation.
+	//
+	// Check every beats sheet pinned to an older version of the target story plan. Sheets whose content
+	// is valid
+	// under the target version are pinned to it, unless dryRun is set. Incompatible sheets are reported,
+	// and left
+	// untouched.
+	//
+	// POST /story-plan/upgrade-beats-sheets
+	UpgradeBeatsSheets(ctx context.Context, req *UpgradeBeatsSheetsForm) (UpgradeBeatsSheetsRes, error)
+	// UpgradeCustomBeatsSheets implements upgradeCustomBeatsSheets operation.
+	//
+	// Same as upgradeBeatsSheets, restricted to the beats sheets of the current user. The target may be
+	// a built-in
+	// story plan, or one of the user's custom plans.
+	//
+	// POST /story-plan/custom/upgrade-beats-sheets
+	UpgradeCustomBeatsSheets(ctx context.Context, req *UpgradeBeatsSheetsForm) (UpgradeCustomBeatsSheetsRes, error)
 	// NewError creates *UnexpectedErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
@@ -13,6 +13,19 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// ConvertBeatsSheet implements convertBeatsSheet operation.
+//
+// Rewrite an existing beats sheet so it follows a different story plan, preserving the story it
+// tells. The
+// result is saved as a new beats sheet, linked to the original one. The target plan must be
+// available in the
+// language of the original beats sheet.
+//
+// POST /beats-sheet/convert
+func (UnimplementedHandler) ConvertBeatsSheet(ctx context.Context, req *ConvertBeatsSheetForm) (r ConvertBeatsSheetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateBeatsSheet implements createBeatsSheet operation.
 //
 // Create a new beats sheet for a logline, following a story plan.
@@ -22,6 +35,18 @@ func (UnimplementedHandler) CreateBeatsSheet(ctx context.Context, req *CreateBea
 	return r, ht.ErrNotImplemented
 }
 
+// CreateCustomStoryPlan implements createCustomStoryPlan operation.
+//
+// Create a new story plan, owned by the current user. Custom plans are only visible to their owner.
+// They may
+// reuse the slug of a built-in plan, in which case they take precedence over it when selected by
+// slug.
+//
+// PUT /story-plan/custom
+func (UnimplementedHandler) CreateCustomStoryPlan(ctx context.Context, req *CreateStoryPlanForm) (r CreateCustomStoryPlanRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateLogline implements createLogline operation.
 //
 // Create a new logline for a user.
@@ -31,9 +56,85 @@ func (UnimplementedHandler) CreateLogline(ctx context.Context, req *CreateLoglin
 	return r, ht.ErrNotImplemented
 }
 
+// CreateProject implements createProject operation.
+//
+// Create a new project for the current user.
+//
+// PUT /project
+func (UnimplementedHandler) CreateProject(ctx context.Context, req *CreateProjectForm) (r CreateProjectRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateStoryPlan implements createStoryPlan operation.
+//
+// Create a new story plan. The slug must be unique for a given language.
+//
+// PUT /story-plan
+func (UnimplementedHandler) CreateStoryPlan(ctx context.Context, req *CreateStoryPlanForm) (r CreateStoryPlanRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CritiqueLogline implements critiqueLogline operation.
+//
+// Score the protagonist, goal, stakes, hook and genre clarity of a logline, with a justification and
+// a concrete
+// suggestion for each. Either critique a logline of the current user, or a logline idea that was not
+// saved.
+//
+// POST /logline/critique
+func (UnimplementedHandler) CritiqueLogline(ctx context.Context, req *CritiqueLoglineForm) (r CritiqueLoglineRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteBeatsSheet implements deleteBeatsSheet operation.
+//
+// Move a beats sheet to the trash. It can be restored until the trash is purged.
+//
+// DELETE /beats-sheet
+func (UnimplementedHandler) DeleteBeatsSheet(ctx context.Context, params DeleteBeatsSheetParams) (r DeleteBeatsSheetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteLogline implements deleteLogline operation.
+//
+// Move a logline to the trash, along with its beats sheets. They can be restored until the trash is
+// purged.
+//
+// DELETE /logline
+func (UnimplementedHandler) DeleteLogline(ctx context.Context, params DeleteLoglineParams) (r DeleteLoglineRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteProject implements deleteProject operation.
+//
+// Permanently delete a project. The loglines of the project are kept, and no longer belong to any
+// project.
+//
+// DELETE /project
+func (UnimplementedHandler) DeleteProject(ctx context.Context, params DeleteProjectParams) (r DeleteProjectRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DetectLang implements detectLang operation.
+//
+// Guess the language a text is written in, among the languages supported by the service. Loglines
+// and beats
+// sheets are rejected on creation when they are confidently detected to be written in another
+// language than
+// the one they are declared in. Short texts usually come with a low confidence, and may not have a
+// detected
+// language at all.
+//
+// POST /lang/detect
+func (UnimplementedHandler) DetectLang(ctx context.Context, req *DetectLangForm) (r DetectLangRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ExpandBeat implements expandBeat operation.
 //
 // Add more details to a specific beat in a beats sheet.
+// When apply is set, the expanded beat is saved as a new beats sheet, whose parent is the original
+// one.
 //
 // POST /beats-sheet/expand
 func (UnimplementedHandler) ExpandBeat(ctx context.Context, req *ExpandBeatForm) (r ExpandBeatRes, _ error) {
@@ -49,6 +150,17 @@ func (UnimplementedHandler) ExpandLogline(ctx context.Context, req *LoglineIdea)
 	return r, ht.ErrNotImplemented
 }
 
+// ForkStoryPlan implements forkStoryPlan operation.
+//
+// Copy a built-in story plan, or a custom plan owned by the current user, into a new custom plan
+// owned by the
+// current user. The slug and name of the source plan are kept, unless overridden.
+//
+// POST /story-plan/fork
+func (UnimplementedHandler) ForkStoryPlan(ctx context.Context, req *ForkStoryPlanForm) (r ForkStoryPlanRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GenerateBeatsSheet implements generateBeatsSheet operation.
 //
 // Generate a new beats sheet for a logline, following a story plan.
@@ -58,6 +170,19 @@ func (UnimplementedHandler) GenerateBeatsSheet(ctx context.Context, req *Generat
 	return r, ht.ErrNotImplemented
 }
 
+// GenerateLoglineVariations implements generateLoglineVariations operation.
+//
+// Generate variations of an existing logline. Each variation changes a single dimension of the
+// original logline,
+// among the requested ones, and keeps every other dimension as is. Either derive the variations from
+// a logline
+// of the current user, or from a logline idea that was not saved.
+//
+// POST /loglines/variations
+func (UnimplementedHandler) GenerateLoglineVariations(ctx context.Context, req *GenerateLoglineVariationsForm) (r GenerateLoglineVariationsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GenerateLoglines implements generateLoglines operation.
 //
 // Generate new loglines for a user.
@@ -76,9 +201,22 @@ func (UnimplementedHandler) GetBeatsSheet(ctx context.Context, params GetBeatsSh
 	return r, ht.ErrNotImplemented
 }
 
+// GetBeatsSheetDiff implements getBeatsSheetDiff operation.
+//
+// Match the beats of two beats sheets by key, and compute the word-level changes of each beat. Beats
+// added,
+// removed or moved between both sheets are reported as such.
+//
+// GET /beats-sheet/diff
+func (UnimplementedHandler) GetBeatsSheetDiff(ctx context.Context, params GetBeatsSheetDiffParams) (r GetBeatsSheetDiffRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetBeatsSheets implements getBeatsSheets operation.
 //
-// Get all beats sheets for the current user.
+// Get all beats sheets for the current user. Results are paginated with cursors: pass the nextCursor
+// of a page
+// to get the following one.
 //
 // GET /beats-sheets
 func (UnimplementedHandler) GetBeatsSheets(ctx context.Context, params GetBeatsSheetsParams) (r GetBeatsSheetsRes, _ error) {
@@ -94,15 +232,119 @@ func (UnimplementedHandler) GetLogline(ctx context.Context, params GetLoglinePar
 	return r, ht.ErrNotImplemented
 }
 
+// GetLoglineRevision implements getLoglineRevision operation.
+//
+// Get a single revision of a logline owned by the current user.
+//
+// GET /logline/revision
+func (UnimplementedHandler) GetLoglineRevision(ctx context.Context, params GetLoglineRevisionParams) (r GetLoglineRevisionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetLoglineRevisionDiff implements getLoglineRevisionDiff operation.
+//
+// Compute the word-level changes that turn a revision into another one.
+//
+// GET /logline/revision/diff
+func (UnimplementedHandler) GetLoglineRevisionDiff(ctx context.Context, params GetLoglineRevisionDiffParams) (r GetLoglineRevisionDiffRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetLoglineRevisions implements getLoglineRevisions operation.
+//
+// List the revisions of a logline owned by the current user, most recent first. A revision is saved
+// every time
+// the name, content or language of the logline is written.
+//
+// GET /logline/revisions
+func (UnimplementedHandler) GetLoglineRevisions(ctx context.Context, params GetLoglineRevisionsParams) (r GetLoglineRevisionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetLoglineTags implements getLoglineTags operation.
+//
+// List the tags used on the loglines of the current user, with the number of loglines using each of
+// them. Most
+// used tags come first. Loglines in the trash are not counted.
+//
+// GET /loglines/tags
+func (UnimplementedHandler) GetLoglineTags(ctx context.Context) (r GetLoglineTagsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetLoglines implements getLoglines operation.
 //
-// Get all loglines for the current user.
+// Get all loglines for the current user. Results are paginated with cursors: pass the nextCursor of
+// a page to
+// get the following one.
 //
 // GET /loglines
 func (UnimplementedHandler) GetLoglines(ctx context.Context, params GetLoglinesParams) (r GetLoglinesRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// GetProject implements getProject operation.
+//
+// Get a project owned by the current user.
+//
+// GET /project
+func (UnimplementedHandler) GetProject(ctx context.Context, params GetProjectParams) (r GetProjectRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetProjects implements getProjects operation.
+//
+// Get all the projects of the current user, sorted by name. Results are paginated with cursors: pass
+// the
+// nextCursor of a page to get the following one.
+//
+// GET /projects
+func (UnimplementedHandler) GetProjects(ctx context.Context, params GetProjectsParams) (r GetProjectsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetStoryPlan implements getStoryPlan operation.
+//
+// Get a story plan, either by its unique identifier, or by its slug and language. If neither the id
+// nor the slug
+// is provided, the default story plan for the language is returned. When selecting by slug, the
+// latest version
+// of the plan is returned.
+//
+// GET /story-plan
+func (UnimplementedHandler) GetStoryPlan(ctx context.Context, params GetStoryPlanParams) (r GetStoryPlanRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetStoryPlans implements getStoryPlans operation.
+//
+// Get all the available story plans.
+//
+// GET /story-plans
+func (UnimplementedHandler) GetStoryPlans(ctx context.Context, params GetStoryPlansParams) (r GetStoryPlansRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetTrashedBeatsSheets implements getTrashedBeatsSheets operation.
+//
+// List the beats sheets of the current user that were deleted on their own, most recently deleted
+// first. Sheets
+// deleted along with their logline are restored with it, and are not listed here.
+//
+// GET /beats-sheets/trash
+func (UnimplementedHandler) GetTrashedBeatsSheets(ctx context.Context, params GetTrashedBeatsSheetsParams) (r GetTrashedBeatsSheetsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetTrashedLoglines implements getTrashedLoglines operation.
+//
+// List the loglines of the current user that are in the trash, most recently deleted first.
+//
+// GET /loglines/trash
+func (UnimplementedHandler) GetTrashedLoglines(ctx context.Context, params GetTrashedLoglinesParams) (r GetTrashedLoglinesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // Healthcheck implements healthcheck operation.
 //
 // Returns a detailed report of the health of the service, including every dependency.
@@ -124,12 +366,165 @@ func (UnimplementedHandler) Ping(ctx context.Context) (r PingRes, _ error) {
 // RegenerateBeats implements regenerateBeats operation.
 //
 // Regenerate the content of specific beats in a beats sheet.
+// When apply is set, the regenerated beats are saved as a new beats sheet, whose parent is the
+// original one.
 //
 // POST /beats-sheet/regenerate
 func (UnimplementedHandler) RegenerateBeats(ctx context.Context, req *RegenerateBeatsForm) (r RegenerateBeatsRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// RestoreBeatsSheet implements restoreBeatsSheet operation.
+//
+// Take a beats sheet out of the trash. Sheets deleted along with their logline must be restored
+// through the
+// logline.
+//
+// POST /beats-sheet/restore
+func (UnimplementedHandler) RestoreBeatsSheet(ctx context.Context, req *RestoreBeatsSheetForm) (r RestoreBeatsSheetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RestoreLogline implements restoreLogline operation.
+//
+// Take a logline out of the trash, along with the beats sheets that were deleted with it.
+//
+// POST /logline/restore
+func (UnimplementedHandler) RestoreLogline(ctx context.Context, req *RestoreLoglineForm) (r RestoreLoglineRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RestoreLoglineRevision implements restoreLoglineRevision operation.
+//
+// Write the name, content and language of an older revision back to its logline. The slug of the
+// logline is
+// kept, and the restored content is saved as a new revision.
+//
+// POST /logline/revision/restore
+func (UnimplementedHandler) RestoreLoglineRevision(ctx context.Context, req *RestoreLoglineRevisionForm) (r RestoreLoglineRevisionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// Search implements search operation.
+//
+// Run a full-text search over the loglines and beats sheets of the current user, outside the trash.
+// Each item is
+// matched using the dictionary of its own language, so words match their inflected forms. The query
+// supports
+// the web search syntax: quoted phrases, "or", and "-" to exclude a word. Hits are returned most
+// relevant first,
+// with snippets of the matching text.
+//
+// GET /search
+func (UnimplementedHandler) Search(ctx context.Context, params SearchParams) (r SearchRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// TranslateBeatsSheet implements translateBeatsSheet operation.
+//
+// Translate an existing beats sheet to another language. The translation follows the same story plan,
+//
+//	in the
+//
+// target language, and keeps every beat of the original sheet. It is saved as a new beats sheet,
+// linked to the
+// original one.
+//
+// POST /beats-sheet/translate
+func (UnimplementedHandler) TranslateBeatsSheet(ctx context.Context, req *TranslateBeatsSheetForm) (r TranslateBeatsSheetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// TranslateLogline implements translateLogline operation.
+//
+// Translate an existing logline to another language. The translation is saved as a new logline,
+// linked to the
+// original one.
+//
+// POST /logline/translate
+func (UnimplementedHandler) TranslateLogline(ctx context.Context, req *TranslateLoglineForm) (r TranslateLoglineRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateBeat implements updateBeat operation.
+//
+// Edit the title or content of a single beat. Omitted fields keep their current value. The edit is
+// saved as a
+// new beats sheet, whose parent is the original one, and the original sheet is left untouched.
+//
+// PATCH /beats-sheet/beat
+func (UnimplementedHandler) UpdateBeat(ctx context.Context, req *UpdateBeatForm) (r UpdateBeatRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateCustomStoryPlan implements updateCustomStoryPlan operation.
+//
+// Create a new version of a story plan owned by the current user. Built-in plans, and plans owned by
+// other
+// users, cannot be updated through this endpoint.
+//
+// PATCH /story-plan/custom
+func (UnimplementedHandler) UpdateCustomStoryPlan(ctx context.Context, req *UpdateStoryPlanForm) (r UpdateCustomStoryPlanRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateLogline implements updateLogline operation.
+//
+// Edit a logline owned by the current user, in place. Omitted fields keep their current value. If
+// the new slug
+// is already used by another logline of the user, a version number is appended to it.
+//
+// PATCH /logline
+func (UnimplementedHandler) UpdateLogline(ctx context.Context, req *UpdateLoglineForm) (r UpdateLoglineRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateProject implements updateProject operation.
+//
+// Edit a project owned by the current user. Omitted fields keep their current value.
+//
+// PATCH /project
+func (UnimplementedHandler) UpdateProject(ctx context.Context, req *UpdateProjectForm) (r UpdateProjectRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateStoryPlan implements updateStoryPlan operation.
+//
+// Update the name and beats of an existing story plan. Story plans are immutable: this creates a new
+// version
+// of the plan, with the same slug and language. Previous versions are kept, so existing beats sheets
+// remain
+// pinned to the version they were built against.
+//
+// PATCH /story-plan
+func (UnimplementedHandler) UpdateStoryPlan(ctx context.Context, req *UpdateStoryPlanForm) (r UpdateStoryPlanRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpgradeBeatsSheets implements upgradeBeatsSheets operation.
+//
+// Check every beats sheet pinned to an older version of the target story plan. Sheets whose content
+// is valid
+// under the target version are pinned to it, unless dryRun is set. Incompatible sheets are reported,
+// and left
+// untouched.
+//
+// POST /story-plan/upgrade-beats-sheets
+func (UnimplementedHandler) UpgradeBeatsSheets(ctx context.Context, req *UpgradeBeatsSheetsForm) (r UpgradeBeatsSheetsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpgradeCustomBeatsSheets implements upgradeCustomBeatsSheets operation.
+//
+// Same as upgradeBeatsSheets, restricted to the beats sheets of the current user. The target may be
+// a built-in
+// story plan, or one of the user's custom plans.
+//
+// POST /story-plan/custom/upgrade-beats-sheets
+func (UnimplementedHandler) UpgradeCustomBeatsSheets(ctx context.Context, req *UpgradeBeatsSheetsForm) (r UpgradeCustomBeatsSheetsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *UnexpectedErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
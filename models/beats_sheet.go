@@ -12,10 +12,21 @@ import (
 type BeatsSheet struct {
 	ID        uuid.UUID `json:"id"`
 	LoglineID uuid.UUID `json:"loglineID"`
+	// The story plan the beats sheet follows. Beats sheets created before story plans could be selected have no
+	// plan attached, and default to the plan for their language.
+	StoryPlanID uuid.UUID `json:"storyPlanID"`
+	// The beats sheet this one was derived from, if any. A sheet converted to another story plan, or translated to
+	// another language, links to the original sheet.
+	SourceID uuid.UUID `json:"sourceID"`
+	// The previous revision of the beats sheet, if any. Applying regenerated or expanded beats to a sheet saves the
+	// result as a new sheet, linked to the one it was edited from.
+	ParentID uuid.UUID `json:"parentID"`
 
 	// The beats (in order) that make up the story.
 	Content []Beat `bun:"content,type:jsonb" json:"content"`
 	Lang    Lang   `bun:"lang"               json:"lang"`
+	// Acts group the content following the acts of the story plan. Empty if the plan does not define any.
+	Acts []BeatsSheetAct `bun:"-" json:"acts,omitempty"`
 
 	CreatedAt time.Time `bun:"created_at" json:"createdAt"`
 }
@@ -42,3 +53,44 @@ type Beat struct {
 func (beat Beat) String() string {
 	return fmt.Sprintf("%s (%s)\n%s", beat.Title, beat.Key, beat.Content)
 }
+
+// RegeneratedBeats holds the new version of the beats picked for regeneration.
+type RegeneratedBeats struct {
+	Beats []Beat `json:"beats"`
+	// The new revision of the sheet, with the regenerated beats applied. Only set when the regeneration was applied.
+	BeatsSheet *BeatsSheet `json:"beatsSheet,omitempty"`
+}
+
+// ExpandedBeat holds the expanded version of a beat.
+type ExpandedBeat struct {
+	Beat *Beat `json:"beat"`
+	// The new revision of the sheet, with the expanded beat applied. Only set when the expansion was applied.
+	BeatsSheet *BeatsSheet `json:"beatsSheet,omitempty"`
+}
+
+// BeatsSheetUpgrade reports whether a beats sheet, pinned to an older version of a story plan, can be moved to a
+// newer version of the same plan.
+type BeatsSheetUpgrade struct {
+	BeatsSheetID uuid.UUID `json:"beatsSheetID"`
+	LoglineID    uuid.UUID `json:"loglineID"`
+
+	// The version of the story plan the sheet is currently pinned to.
+	FromStoryPlanID uuid.UUID `json:"fromStoryPlanID"`
+	// The version of the story plan the sheet is upgraded to.
+	ToStoryPlanID uuid.UUID `json:"toStoryPlanID"`
+
+	// Compatible is true when the content of the sheet is valid under the target version.
+	Compatible bool `json:"compatible"`
+	// Upgraded is true when the sheet has been pinned to the target version.
+	Upgraded bool `json:"upgraded"`
+	// Reason explains why an incompatible sheet cannot be upgraded automatically.
+	Reason string `json:"reason,omitempty"`
+}
+
+// BeatsSheetAct lists the beats of a sheet that belong to a given act of its story plan.
+type BeatsSheetAct struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	// The keys of the beats in the act, in order.
+	Beats []string `json:"beats"`
+}
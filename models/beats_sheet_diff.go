@@ -0,0 +1,44 @@
+package models
+
+type BeatDiffStatus string
+
+func (status BeatDiffStatus) String() string {
+	return string(status)
+}
+
+const (
+	// BeatDiffStatusAdded marks a beat only present in the newer sheet.
+	BeatDiffStatusAdded BeatDiffStatus = "added"
+	// BeatDiffStatusRemoved marks a beat only present in the older sheet.
+	BeatDiffStatusRemoved BeatDiffStatus = "removed"
+	// BeatDiffStatusKept marks a beat present in both sheets. Its title and content may still have changed.
+	BeatDiffStatusKept BeatDiffStatus = "kept"
+)
+
+// BeatDiff lists the changes made to a single beat between two beats sheets. Beats are matched by key. When a key
+// is repeated, its occurrences are matched in order.
+type BeatDiff struct {
+	Key    string         `json:"key"`
+	Status BeatDiffStatus `json:"status"`
+
+	// The position of the beat in each sheet. Nil when the beat is missing from the sheet.
+	FromIndex *int `json:"fromIndex,omitempty"`
+	ToIndex   *int `json:"toIndex,omitempty"`
+	// Moved is true when a kept beat changed position relative to the other kept beats. Beats shifted by the
+	// addition or removal of other beats are not moved.
+	Moved bool `json:"moved"`
+	// Modified is true when the title or content of a kept beat changed.
+	Modified bool `json:"modified"`
+
+	Title   []DiffChunk `json:"title"`
+	Content []DiffChunk `json:"content"`
+}
+
+// BeatsSheetDiff lists the changes between two beats sheets.
+type BeatsSheetDiff struct {
+	From *BeatsSheet `json:"from"`
+	To   *BeatsSheet `json:"to"`
+
+	// The beats of the newer sheet, in order, followed by the beats removed from the older one.
+	Beats []BeatDiff `json:"beats"`
+}
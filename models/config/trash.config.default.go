@@ -0,0 +1,13 @@
+package config
+
+import (
+	"time"
+
+	"github.com/a-novel/golib/config"
+)
+
+const TrashRetention = 30 * 24 * time.Hour
+
+var TrashPresetDefault = Trash{
+	Retention: config.LoadEnv(getEnv("TRASH_RETENTION"), TrashRetention, config.DurationParser),
+}
@@ -0,0 +1,10 @@
+package config
+
+import (
+	"time"
+)
+
+type Trash struct {
+	// Items that stayed in the trash longer than this are purged for good.
+	Retention time.Duration `json:"retention" yaml:"retention"`
+}
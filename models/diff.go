@@ -0,0 +1,23 @@
+package models
+
+type DiffOp string
+
+func (op DiffOp) String() string {
+	return string(op)
+}
+
+const (
+	// DiffOpEqual marks text present in both versions.
+	DiffOpEqual DiffOp = "equal"
+	// DiffOpInsert marks text only present in the newer version.
+	DiffOpInsert DiffOp = "insert"
+	// DiffOpDelete marks text only present in the older version.
+	DiffOpDelete DiffOp = "delete"
+)
+
+// DiffChunk is a piece of text in the difference between two versions of a text. Concatenating the equal and
+// deleted chunks gives back the older version, and concatenating the equal and inserted chunks gives the newer one.
+type DiffChunk struct {
+	Op   DiffOp `json:"op"`
+	Text string `json:"text"`
+}
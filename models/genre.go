@@ -0,0 +1,61 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrUnsupportedGenre = errors.New("unsupported genre")
+
+// Genre is the literary genre of a story. Unlike tags, genres come from a fixed list, so loglines can be grouped
+// consistently across users.
+type Genre string
+
+func (genre Genre) String() string {
+	return string(genre)
+}
+
+const (
+	GenreAction         Genre = "action"
+	GenreAdventure      Genre = "adventure"
+	GenreComedy         Genre = "comedy"
+	GenreCrime          Genre = "crime"
+	GenreDrama          Genre = "drama"
+	GenreFantasy        Genre = "fantasy"
+	GenreHistorical     Genre = "historical"
+	GenreHorror         Genre = "horror"
+	GenreMystery        Genre = "mystery"
+	GenreRomance        Genre = "romance"
+	GenreScienceFiction Genre = "science-fiction"
+	GenreThriller       Genre = "thriller"
+	GenreWestern        Genre = "western"
+	GenreOther          Genre = "other"
+)
+
+var Genres = []Genre{
+	GenreAction,
+	GenreAdventure,
+	GenreComedy,
+	GenreCrime,
+	GenreDrama,
+	GenreFantasy,
+	GenreHistorical,
+	GenreHorror,
+	GenreMystery,
+	GenreRomance,
+	GenreScienceFiction,
+	GenreThriller,
+	GenreWestern,
+	GenreOther,
+}
+
+// CheckGenre returns an error if the genre is not part of Genres. An empty genre is valid, and means the logline
+// has none.
+func CheckGenre(genre Genre) error {
+	if genre != "" && !slices.Contains(Genres, genre) {
+		return fmt.Errorf("%w: %s", ErrUnsupportedGenre, genre)
+	}
+
+	return nil
+}
@@ -9,4 +9,20 @@ func (lang Lang) String() string {
 const (
 	LangEN Lang = "en"
 	LangFR Lang = "fr"
+	LangES Lang = "es"
+	LangDE Lang = "de"
+	LangIT Lang = "it"
+	LangPT Lang = "pt"
 )
+
+// Langs lists every language supported by the service. Each of them must come with a translation of the built-in
+// story plans.
+var Langs = []Lang{LangEN, LangFR, LangES, LangDE, LangIT, LangPT}
+
+// LangDetection is the language guessed for a text.
+type LangDetection struct {
+	// The detected language. Empty if the text does not carry enough evidence to tell.
+	Lang Lang `json:"lang"`
+	// Confidence of the detection, between 0 and 1.
+	Confidence float64 `json:"confidence"`
+}
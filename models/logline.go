@@ -10,20 +10,36 @@ type Logline struct {
 	ID     uuid.UUID `json:"id"`
 	UserID uuid.UUID `json:"userID"`
 	Slug   Slug      `json:"slug"`
+	// The logline this one was derived from, if any. A logline translated to another language links to the
+	// original logline.
+	SourceID uuid.UUID `json:"sourceID"`
+	// The project the logline belongs to. Nil if the logline is not part of any project.
+	ProjectID uuid.UUID `json:"projectID"`
 
 	Name    string `json:"name"`
 	Content string `json:"content"`
 	Lang    Lang   `json:"lang"`
+	// Empty if no genre was picked.
+	Genre Genre `json:"genre"`
+	// Normalized with NormalizeTags, and sorted alphabetically.
+	Tags []string `json:"tags"`
 
 	CreatedAt time.Time `json:"createdAt"`
+	// Zero if the logline was never edited.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Zero unless the logline is in the trash.
+	DeletedAt time.Time `json:"deletedAt"`
 }
 
 type LoglinePreview struct {
-	Slug Slug `json:"slug"`
-
-	Name    string `json:"name"`
-	Content string `json:"content"`
-	Lang    Lang   `json:"lang"`
+	Slug      Slug      `json:"slug"`
+	ProjectID uuid.UUID `json:"projectID"`
+
+	Name    string   `json:"name"`
+	Content string   `json:"content"`
+	Lang    Lang     `json:"lang"`
+	Genre   Genre    `json:"genre"`
+	Tags    []string `json:"tags"`
 
 	CreatedAt time.Time `json:"createdAt"`
 }
@@ -0,0 +1,24 @@
+package models
+
+// LoglineCritiqueScore rates one aspect of a logline.
+type LoglineCritiqueScore struct {
+	// From 1 (missing or unclear) to 10 (strong and specific).
+	Score int `json:"score"`
+	// Why the aspect got this score.
+	Justification string `json:"justification"`
+	// A concrete change that would improve the aspect.
+	Suggestion string `json:"suggestion"`
+}
+
+// LoglineCritique points out the weaknesses of a logline, aspect by aspect.
+type LoglineCritique struct {
+	Protagonist LoglineCritiqueScore `json:"protagonist"`
+	Goal        LoglineCritiqueScore `json:"goal"`
+	// The antagonist, or the obstacle, and what the protagonist stands to lose.
+	Stakes LoglineCritiqueScore `json:"stakes"`
+	// The irony or the hook that makes the premise stand out.
+	Hook         LoglineCritiqueScore `json:"hook"`
+	GenreClarity LoglineCritiqueScore `json:"genreClarity"`
+
+	Lang Lang `json:"lang"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoglineRevision is a snapshot of the content of a logline, saved every time the logline is written.
+type LoglineRevision struct {
+	ID        uuid.UUID `json:"id"`
+	LoglineID uuid.UUID `json:"loglineID"`
+
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	Lang    Lang   `json:"lang"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// LoglineRevisionDiff lists the changes made to a logline between two of its revisions.
+type LoglineRevisionDiff struct {
+	From *LoglineRevision `json:"from"`
+	To   *LoglineRevision `json:"to"`
+
+	Name    []DiffChunk `json:"name"`
+	Content []DiffChunk `json:"content"`
+}
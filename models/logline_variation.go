@@ -0,0 +1,50 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrUnsupportedLoglineDimension = errors.New("unsupported logline dimension")
+
+// LoglineDimension is an aspect of a logline that can be changed independently of the others when generating
+// variations.
+type LoglineDimension string
+
+func (dimension LoglineDimension) String() string {
+	return string(dimension)
+}
+
+const (
+	LoglineDimensionTone        LoglineDimension = "tone"
+	LoglineDimensionGenre       LoglineDimension = "genre"
+	LoglineDimensionProtagonist LoglineDimension = "protagonist"
+	LoglineDimensionSetting     LoglineDimension = "setting"
+	LoglineDimensionEnding      LoglineDimension = "ending"
+)
+
+var LoglineDimensions = []LoglineDimension{
+	LoglineDimensionTone,
+	LoglineDimensionGenre,
+	LoglineDimensionProtagonist,
+	LoglineDimensionSetting,
+	LoglineDimensionEnding,
+}
+
+// CheckLoglineDimension returns an error if the dimension is not part of LoglineDimensions.
+func CheckLoglineDimension(dimension LoglineDimension) error {
+	if !slices.Contains(LoglineDimensions, dimension) {
+		return fmt.Errorf("%w: %s", ErrUnsupportedLoglineDimension, dimension)
+	}
+
+	return nil
+}
+
+// LoglineVariation is a logline idea derived from another logline, by changing a single dimension of it.
+type LoglineVariation struct {
+	LoglineIdea
+
+	// The dimension that was changed from the original logline. Every other dimension is kept as is.
+	Dimension LoglineDimension `json:"dimension"`
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrInvalidCursor   = errors.New("invalid cursor")
+	ErrUnsupportedSort = errors.New("unsupported sort")
+)
+
+// ListSort is the order in which the items of a list are returned.
+type ListSort string
+
+func (sort ListSort) String() string {
+	return string(sort)
+}
+
+const (
+	// ListSortNewest returns the most recently created items first. This is the default order.
+	ListSortNewest ListSort = "newest"
+	ListSortOldest ListSort = "oldest"
+	// ListSortName returns items alphabetically. Only available for items that have a name.
+	ListSortName ListSort = "name"
+)
+
+var ListSorts = []ListSort{ListSortNewest, ListSortOldest, ListSortName}
+
+// Cursor points to the last item of a page, so the next page resumes right after it. Unlike an offset, a cursor
+// is not shifted by items inserted or deleted while paging.
+type Cursor struct {
+	Sort      ListSort  `json:"sort"`
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Only set when sorting by name.
+	Name string `json:"name,omitempty"`
+}
+
+// String encodes the cursor into an opaque token, meant to be passed back as is to get the next page.
+func (cursor *Cursor) String() string {
+	// Marshalling a struct of plain values cannot fail.
+	return base64.RawURLEncoding.EncodeToString(lo.Must(json.Marshal(cursor)))
+}
+
+// ParseCursor decodes a token created with Cursor.String.
+func ParseCursor(token string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	var cursor Cursor
+
+	err = json.Unmarshal(raw, &cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	if !slices.Contains(ListSorts, cursor.Sort) || cursor.ID == uuid.Nil {
+		return nil, fmt.Errorf("%w: missing position", ErrInvalidCursor)
+	}
+
+	return &cursor, nil
+}
+
+// Page is a portion of a list. NextCursor is empty on the last page.
+type Page[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
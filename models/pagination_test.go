@@ -0,0 +1,83 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestCursor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		token string
+
+		expect    *models.Cursor
+		expectErr error
+	}{
+		{
+			name: "RoundTrip",
+
+			token: (&models.Cursor{
+				Sort:      models.ListSortName,
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 123456000, time.UTC),
+				Name:      "Test Name",
+			}).String(),
+
+			expect: &models.Cursor{
+				Sort:      models.ListSortName,
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 123456000, time.UTC),
+				Name:      "Test Name",
+			},
+		},
+		{
+			name: "NotBase64",
+
+			token: "not a cursor!",
+
+			expectErr: models.ErrInvalidCursor,
+		},
+		{
+			name: "NotJSON",
+
+			token: "bm90IGpzb24",
+
+			expectErr: models.ErrInvalidCursor,
+		},
+		{
+			name: "UnknownSort",
+
+			token: (&models.Cursor{
+				Sort: "random",
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			}).String(),
+
+			expectErr: models.ErrInvalidCursor,
+		},
+		{
+			name: "MissingID",
+
+			token: (&models.Cursor{Sort: models.ListSortNewest}).String(),
+
+			expectErr: models.ErrInvalidCursor,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			cursor, err := models.ParseCursor(testCase.token)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, cursor)
+		})
+	}
+}
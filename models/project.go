@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Project groups loglines owned by the same user.
+type Project struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"userID"`
+
+	Name        string `json:"name"`
+	Description string `json:"description"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	// Zero if the project was never edited.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SearchHitKind string
+
+func (kind SearchHitKind) String() string {
+	return string(kind)
+}
+
+const (
+	SearchHitKindLogline    SearchHitKind = "logline"
+	SearchHitKindBeatsSheet SearchHitKind = "beatsSheet"
+)
+
+// SearchHit is a logline or beats sheet matching a full-text search.
+type SearchHit struct {
+	Kind SearchHitKind `json:"kind"`
+	// ID of the matching logline or beats sheet.
+	ID uuid.UUID `json:"id"`
+	// The logline the hit belongs to. For logline hits, this is the same as ID.
+	LoglineID uuid.UUID `json:"loglineID"`
+	Slug      Slug      `json:"slug"`
+	Name      string    `json:"name"`
+	Lang      Lang      `json:"lang"`
+
+	// Relevance of the hit. Higher is better; only meaningful to compare hits of the same search.
+	Rank float64 `json:"rank"`
+	// HTML excerpts of the matching text, with the matched words wrapped in <mark></mark> tags. The text itself is
+	// escaped, so the <mark> tags are the only markup in the snippet.
+	Snippet string `json:"snippet"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
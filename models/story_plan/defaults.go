@@ -0,0 +1,75 @@
+package storyplanmodel
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+// ErrUnsupportedLang is returned when content is requested in a language the built-in plans are not translated to.
+var ErrUnsupportedLang = errors.New("unsupported language: no story plan translation available")
+
+// DefaultSlug is the slug of the plan used when a request does not target a specific story plan.
+const DefaultSlug models.Slug = "save-the-cat"
+
+// DefaultPlans are the built-in plans, used to seed the database when the service starts.
+var DefaultPlans = []*Plan{
+	SaveTheCat[models.LangEN],
+	SaveTheCat[models.LangFR],
+	SaveTheCat[models.LangES],
+	SaveTheCat[models.LangDE],
+	SaveTheCat[models.LangIT],
+	SaveTheCat[models.LangPT],
+	HerosJourney[models.LangEN],
+	HerosJourney[models.LangFR],
+	HerosJourney[models.LangES],
+	HerosJourney[models.LangDE],
+	HerosJourney[models.LangIT],
+	HerosJourney[models.LangPT],
+	ThreeAct[models.LangEN],
+	ThreeAct[models.LangFR],
+	ThreeAct[models.LangES],
+	ThreeAct[models.LangDE],
+	ThreeAct[models.LangIT],
+	ThreeAct[models.LangPT],
+	StoryCircle[models.LangEN],
+	StoryCircle[models.LangFR],
+	StoryCircle[models.LangES],
+	StoryCircle[models.LangDE],
+	StoryCircle[models.LangIT],
+	StoryCircle[models.LangPT],
+	SevenPoint[models.LangEN],
+	SevenPoint[models.LangFR],
+	SevenPoint[models.LangES],
+	SevenPoint[models.LangDE],
+	SevenPoint[models.LangIT],
+	SevenPoint[models.LangPT],
+	FreytagPyramid[models.LangEN],
+	FreytagPyramid[models.LangFR],
+	FreytagPyramid[models.LangES],
+	FreytagPyramid[models.LangDE],
+	FreytagPyramid[models.LangIT],
+	FreytagPyramid[models.LangPT],
+	Kishotenketsu[models.LangEN],
+	Kishotenketsu[models.LangFR],
+	Kishotenketsu[models.LangES],
+	Kishotenketsu[models.LangDE],
+	Kishotenketsu[models.LangIT],
+	Kishotenketsu[models.LangPT],
+}
+
+// CheckLang makes sure the default plan is translated to the given language, so beats sheets can be created for
+// content written in it.
+func CheckLang(lang models.Lang) error {
+	ok := lo.ContainsBy(DefaultPlans, func(plan *Plan) bool {
+		return plan.Metadata.Slug == DefaultSlug && plan.Metadata.Lang == lang
+	})
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedLang, lang)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package storyplanmodel_test
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestDefaultPlans(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, storyplanmodel.LintPlans(storyplanmodel.DefaultPlans))
+
+	plans := map[string]map[models.Lang]*storyplanmodel.Plan{
+		"SaveTheCat":     storyplanmodel.SaveTheCat,
+		"HerosJourney":   storyplanmodel.HerosJourney,
+		"ThreeAct":       storyplanmodel.ThreeAct,
+		"StoryCircle":    storyplanmodel.StoryCircle,
+		"SevenPoint":     storyplanmodel.SevenPoint,
+		"FreytagPyramid": storyplanmodel.FreytagPyramid,
+		"Kishotenketsu":  storyplanmodel.Kishotenketsu,
+	}
+
+	for name, translations := range plans {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plans := make([]*storyplanmodel.Plan, 0, len(models.Langs))
+
+			for _, lang := range models.Langs {
+				plan := translations[lang]
+
+				require.NotNil(t, plan, "missing %s translation", lang)
+				require.Equal(t, lang, plan.Metadata.Lang)
+
+				plans = append(plans, plan)
+			}
+
+			require.Len(t, translations, len(models.Langs))
+			require.NoError(t, storyplanmodel.LintTranslations(plans...))
+
+			for _, plan := range plans {
+				require.Contains(t, storyplanmodel.DefaultPlans, plan)
+				require.NoError(t, plan.Lint())
+
+				beats := lo.Map(plan.Beats, func(item storyplanmodel.Beat, _ int) models.Beat {
+					return models.Beat{Key: item.Key, Title: item.Name, Content: item.Purpose}
+				})
+
+				require.NoError(t, plan.Validate(beats))
+
+				for _, beat := range plan.Beats {
+					require.NotEmpty(t, beat.Name)
+					require.NotEmpty(t, beat.KeyPoints)
+					require.NotEmpty(t, beat.Purpose)
+					require.True(
+						t,
+						beat.Scenes.Exact != nil || beat.Scenes.Min != nil || beat.Scenes.Max != nil,
+						"beat %s has no scenes constraint", beat.Key,
+					)
+				}
+
+				schema, ok := plan.OutputSchema().(map[string]any)
+				require.True(t, ok)
+
+				properties, ok := schema["properties"].(map[string]any)
+				require.True(t, ok)
+
+				beatsSchema, ok := properties["beats"].(map[string]any)
+				require.True(t, ok)
+
+				if len(plan.FlexibleBeats()) > 0 {
+					itemsSchema, ok := beatsSchema["items"].(map[string]any)
+					require.True(t, ok)
+					require.Len(t, itemsSchema["anyOf"], len(plan.Beats))
+				} else {
+					require.Len(t, beatsSchema["prefixItems"], len(plan.Beats))
+				}
+			}
+		})
+	}
+}
+
+func TestCheckLang(t *testing.T) {
+	t.Parallel()
+
+	for _, lang := range models.Langs {
+		require.NoError(t, storyplanmodel.CheckLang(lang))
+	}
+
+	require.ErrorIs(t, storyplanmodel.CheckLang("xx"), storyplanmodel.ErrUnsupportedLang)
+}
@@ -0,0 +1,39 @@
+package storyplanmodel
+
+import (
+	_ "embed"
+
+	"github.com/goccy/go-yaml"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/config"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+//go:embed freytag.en.yaml
+var freytagPyramidEN []byte
+
+//go:embed freytag.fr.yaml
+var freytagPyramidFR []byte
+
+//go:embed freytag.es.yaml
+var freytagPyramidES []byte
+
+//go:embed freytag.de.yaml
+var freytagPyramidDE []byte
+
+//go:embed freytag.it.yaml
+var freytagPyramidIT []byte
+
+//go:embed freytag.pt.yaml
+var freytagPyramidPT []byte
+
+var FreytagPyramid = map[models.Lang]*Plan{
+	models.LangEN: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, freytagPyramidEN)),
+	models.LangFR: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, freytagPyramidFR)),
+	models.LangES: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, freytagPyramidES)),
+	models.LangDE: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, freytagPyramidDE)),
+	models.LangIT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, freytagPyramidIT)),
+	models.LangPT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, freytagPyramidPT)),
+}
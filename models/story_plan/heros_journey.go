@@ -0,0 +1,39 @@
+package storyplanmodel
+
+import (
+	_ "embed"
+
+	"github.com/goccy/go-yaml"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/config"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+//go:embed heros_journey.en.yaml
+var herosJourneyEN []byte
+
+//go:embed heros_journey.fr.yaml
+var herosJourneyFR []byte
+
+//go:embed heros_journey.es.yaml
+var herosJourneyES []byte
+
+//go:embed heros_journey.de.yaml
+var herosJourneyDE []byte
+
+//go:embed heros_journey.it.yaml
+var herosJourneyIT []byte
+
+//go:embed heros_journey.pt.yaml
+var herosJourneyPT []byte
+
+var HerosJourney = map[models.Lang]*Plan{
+	models.LangEN: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, herosJourneyEN)),
+	models.LangFR: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, herosJourneyFR)),
+	models.LangES: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, herosJourneyES)),
+	models.LangDE: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, herosJourneyDE)),
+	models.LangIT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, herosJourneyIT)),
+	models.LangPT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, herosJourneyPT)),
+}
@@ -0,0 +1,39 @@
+package storyplanmodel
+
+import (
+	_ "embed"
+
+	"github.com/goccy/go-yaml"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/config"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+//go:embed kishotenketsu.en.yaml
+var kishotenketsuEN []byte
+
+//go:embed kishotenketsu.fr.yaml
+var kishotenketsuFR []byte
+
+//go:embed kishotenketsu.es.yaml
+var kishotenketsuES []byte
+
+//go:embed kishotenketsu.de.yaml
+var kishotenketsuDE []byte
+
+//go:embed kishotenketsu.it.yaml
+var kishotenketsuIT []byte
+
+//go:embed kishotenketsu.pt.yaml
+var kishotenketsuPT []byte
+
+var Kishotenketsu = map[models.Lang]*Plan{
+	models.LangEN: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, kishotenketsuEN)),
+	models.LangFR: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, kishotenketsuFR)),
+	models.LangES: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, kishotenketsuES)),
+	models.LangDE: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, kishotenketsuDE)),
+	models.LangIT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, kishotenketsuIT)),
+	models.LangPT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, kishotenketsuPT)),
+}
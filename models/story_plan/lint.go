@@ -0,0 +1,212 @@
+package storyplanmodel
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+// Lint checks the plan is consistent on its own, regardless of any beats sheet: keys must be set and unique, scenes
+// and repeat bounds must be satisfiable, and beats must follow the order of their acts.
+func (plan Plan) Lint() error {
+	errs := make([]error, 0, len(plan.Beats)+1)
+
+	if len(plan.Beats) == 0 {
+		errs = append(errs, fmt.Errorf("%w: plan has no beats", ErrMissingBeat))
+	}
+
+	for index, act := range plan.Acts {
+		if act.Key == "" {
+			errs = append(errs, fmt.Errorf("%w: act at index %d", ErrEmptyKey, index))
+		}
+	}
+
+	for _, key := range lo.FindDuplicates(lo.Map(plan.Acts, func(item Act, _ int) string { return item.Key })) {
+		errs = append(errs, fmt.Errorf("%w: %s", ErrDuplicateAct, key))
+	}
+
+	for index, beat := range plan.Beats {
+		if beat.Key == "" {
+			errs = append(errs, fmt.Errorf("%w: beat at index %d", ErrEmptyKey, index))
+
+			continue
+		}
+
+		err := beat.Lint()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("beat %s: %w", beat.Key, err))
+		}
+	}
+
+	for _, key := range lo.FindDuplicates(lo.Map(plan.Beats, func(item Beat, _ int) string { return item.Key })) {
+		errs = append(errs, fmt.Errorf("%w: %s", ErrDuplicateBeat, key))
+	}
+
+	errs = append(errs, plan.ValidateActs())
+
+	return errors.Join(errs...)
+}
+
+// Lint checks the scenes and repeat bounds of the beat can be satisfied.
+func (beat Beat) Lint() error {
+	errs := []error{beat.Scenes.Lint()}
+
+	if beat.Repeat != nil {
+		errs = append(errs, beat.Repeat.Lint())
+	}
+
+	return errors.Join(errs...)
+}
+
+// Lint checks the bounds of the scenes are consistent. An exact count cannot be combined with a range.
+func (scenes Scenes) Lint() error {
+	var errs []error
+
+	if scenes.Exact != nil && (scenes.Min != nil || scenes.Max != nil) {
+		errs = append(errs, fmt.Errorf("%w: exact cannot be combined with min or max", ErrInvalidScenes))
+	}
+
+	if scenes.Exact != nil && *scenes.Exact < 1 {
+		errs = append(errs, fmt.Errorf("%w: exact must be at least 1, got %d", ErrInvalidScenes, *scenes.Exact))
+	}
+
+	if scenes.Min != nil && *scenes.Min < 0 {
+		errs = append(errs, fmt.Errorf("%w: min cannot be negative, got %d", ErrInvalidScenes, *scenes.Min))
+	}
+
+	if scenes.Max != nil && *scenes.Max < 1 {
+		errs = append(errs, fmt.Errorf("%w: max must be at least 1, got %d", ErrInvalidScenes, *scenes.Max))
+	}
+
+	if scenes.Min != nil && scenes.Max != nil && *scenes.Min > *scenes.Max {
+		errs = append(errs, fmt.Errorf(
+			"%w: min (%d) is greater than max (%d)", ErrInvalidScenes, *scenes.Min, *scenes.Max,
+		))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Lint checks the repeat bounds are consistent.
+func (repeat Repeat) Lint() error {
+	var errs []error
+
+	if repeat.Min != nil && *repeat.Min < 1 {
+		errs = append(errs, fmt.Errorf("%w: min must be at least 1, got %d", ErrInvalidRepeat, *repeat.Min))
+	}
+
+	if repeat.Max != nil && *repeat.Max < 1 {
+		errs = append(errs, fmt.Errorf("%w: max must be at least 1, got %d", ErrInvalidRepeat, *repeat.Max))
+	}
+
+	if repeat.Min != nil && repeat.Max != nil && *repeat.Min > *repeat.Max {
+		errs = append(errs, fmt.Errorf(
+			"%w: min (%d) is greater than max (%d)", ErrInvalidRepeat, *repeat.Min, *repeat.Max,
+		))
+	}
+
+	return errors.Join(errs...)
+}
+
+// LintTranslations checks the given plans are translations of one another: they must share the same slug, and
+// define the same acts and beats, in the same order, with the same constraints. Only names, purposes and key
+// points may differ.
+func LintTranslations(plans ...*Plan) error {
+	if len(plans) < 2 {
+		return nil
+	}
+
+	errs := make([]error, 0, len(plans)-1)
+
+	reference := plans[0]
+
+	for _, plan := range plans[1:] {
+		errs = append(errs, lintTranslation(reference, plan))
+	}
+
+	return errors.Join(errs...)
+}
+
+func lintTranslation(reference, plan *Plan) error {
+	var errs []error
+
+	mismatch := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(
+			"%w: %s and %s: %s",
+			ErrTranslationMismatch, reference.Metadata.Lang, plan.Metadata.Lang, fmt.Sprintf(format, args...),
+		))
+	}
+
+	if reference.Metadata.Slug != plan.Metadata.Slug {
+		mismatch("slugs %s and %s differ", reference.Metadata.Slug, plan.Metadata.Slug)
+	}
+
+	actKeys := func(acts []Act) []string {
+		return lo.Map(acts, func(item Act, _ int) string { return item.Key })
+	}
+
+	if referenceActs, acts := actKeys(reference.Acts), actKeys(plan.Acts); !slices.Equal(referenceActs, acts) {
+		mismatch("act keys %v and %v differ", referenceActs, acts)
+	}
+
+	beatKeys := func(beats []Beat) []string {
+		return lo.Map(beats, func(item Beat, _ int) string { return item.Key })
+	}
+
+	if referenceBeats, beats := beatKeys(reference.Beats), beatKeys(plan.Beats); !slices.Equal(referenceBeats, beats) {
+		mismatch("beat keys %v and %v differ", referenceBeats, beats)
+
+		// Beats cannot be compared one by one.
+		return errors.Join(errs...)
+	}
+
+	for index, referenceBeat := range reference.Beats {
+		beat := plan.Beats[index]
+
+		if referenceBeat.Act != beat.Act {
+			mismatch("beat %s belongs to acts %q and %q", beat.Key, referenceBeat.Act, beat.Act)
+		}
+
+		if referenceBeat.Occurrences() != beat.Occurrences() {
+			mismatch(
+				"beat %s occurs %s and %s", beat.Key, referenceBeat.Occurrences(), beat.Occurrences(),
+			)
+		}
+
+		if referenceBeat.Scenes.String() != beat.Scenes.String() {
+			mismatch(
+				"beat %s expects %s and %s", beat.Key, referenceBeat.Scenes.String(), beat.Scenes.String(),
+			)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// LintPlans lints every plan of the list. Plans sharing the same slug are also checked to be translations of one
+// another.
+func LintPlans(plans []*Plan) error {
+	var errs []error
+
+	for _, plan := range plans {
+		err := plan.Lint()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("plan %s (%s): %w", plan.Metadata.Slug, plan.Metadata.Lang, err))
+		}
+	}
+
+	translations := lo.GroupBy(plans, func(item *Plan) models.Slug { return item.Metadata.Slug })
+
+	for _, slug := range lo.Uniq(lo.Map(plans, func(item *Plan, _ int) models.Slug { return item.Metadata.Slug })) {
+		err := LintTranslations(translations[slug]...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("plan %s: %w", slug, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,296 @@
+package storyplanmodel_test
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/models"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+)
+
+func TestPlanLint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		plan storyplanmodel.Plan
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			plan: storyplanmodel.Plan{
+				Acts: []storyplanmodel.Act{
+					{Name: "Act 1", Key: "act-1"},
+					{Name: "Act 2", Key: "act-2"},
+				},
+				Beats: []storyplanmodel.Beat{
+					{Name: "Beat 1", Key: "beat-1", Act: "act-1", Scenes: storyplanmodel.Scenes{Exact: lo.ToPtr(1)}},
+					{
+						Name:   "Beat 2",
+						Key:    "beat-2",
+						Act:    "act-2",
+						Scenes: storyplanmodel.Scenes{Min: lo.ToPtr(1), Max: lo.ToPtr(3)},
+						Repeat: &storyplanmodel.Repeat{Min: lo.ToPtr(1), Max: lo.ToPtr(2)},
+					},
+				},
+			},
+		},
+		{
+			name: "NoBeats",
+
+			plan: storyplanmodel.Plan{},
+
+			expectErr: storyplanmodel.ErrMissingBeat,
+		},
+		{
+			name: "EmptyBeatKey",
+
+			plan: storyplanmodel.Plan{
+				Beats: []storyplanmodel.Beat{{Name: "Beat 1"}},
+			},
+
+			expectErr: storyplanmodel.ErrEmptyKey,
+		},
+		{
+			name: "EmptyActKey",
+
+			plan: storyplanmodel.Plan{
+				Acts:  []storyplanmodel.Act{{Name: "Act 1"}},
+				Beats: []storyplanmodel.Beat{{Name: "Beat 1", Key: "beat-1"}},
+			},
+
+			expectErr: storyplanmodel.ErrEmptyKey,
+		},
+		{
+			name: "DuplicateBeat",
+
+			plan: storyplanmodel.Plan{
+				Beats: []storyplanmodel.Beat{
+					{Name: "Beat 1", Key: "beat-1"},
+					{Name: "Beat 2", Key: "beat-1"},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrDuplicateBeat,
+		},
+		{
+			name: "DuplicateAct",
+
+			plan: storyplanmodel.Plan{
+				Acts: []storyplanmodel.Act{
+					{Name: "Act 1", Key: "act-1"},
+					{Name: "Act 2", Key: "act-1"},
+				},
+				Beats: []storyplanmodel.Beat{{Name: "Beat 1", Key: "beat-1", Act: "act-1"}},
+			},
+
+			expectErr: storyplanmodel.ErrDuplicateAct,
+		},
+		{
+			name: "Scenes/ExactWithMin",
+
+			plan: storyplanmodel.Plan{
+				Beats: []storyplanmodel.Beat{
+					{Name: "Beat 1", Key: "beat-1", Scenes: storyplanmodel.Scenes{Exact: lo.ToPtr(1), Min: lo.ToPtr(1)}},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrInvalidScenes,
+		},
+		{
+			name: "Scenes/MinGreaterThanMax",
+
+			plan: storyplanmodel.Plan{
+				Beats: []storyplanmodel.Beat{
+					{Name: "Beat 1", Key: "beat-1", Scenes: storyplanmodel.Scenes{Min: lo.ToPtr(3), Max: lo.ToPtr(2)}},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrInvalidScenes,
+		},
+		{
+			name: "Scenes/ZeroExact",
+
+			plan: storyplanmodel.Plan{
+				Beats: []storyplanmodel.Beat{
+					{Name: "Beat 1", Key: "beat-1", Scenes: storyplanmodel.Scenes{Exact: lo.ToPtr(0)}},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrInvalidScenes,
+		},
+		{
+			name: "Repeat/MinGreaterThanMax",
+
+			plan: storyplanmodel.Plan{
+				Beats: []storyplanmodel.Beat{
+					{Name: "Beat 1", Key: "beat-1", Repeat: &storyplanmodel.Repeat{Min: lo.ToPtr(3), Max: lo.ToPtr(2)}},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrInvalidRepeat,
+		},
+		{
+			name: "Repeat/ZeroMax",
+
+			plan: storyplanmodel.Plan{
+				Beats: []storyplanmodel.Beat{
+					{Name: "Beat 1", Key: "beat-1", Repeat: &storyplanmodel.Repeat{Max: lo.ToPtr(0)}},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrInvalidRepeat,
+		},
+		{
+			name: "UnknownAct",
+
+			plan: storyplanmodel.Plan{
+				Acts:  []storyplanmodel.Act{{Name: "Act 1", Key: "act-1"}},
+				Beats: []storyplanmodel.Beat{{Name: "Beat 1", Key: "beat-1", Act: "act-2"}},
+			},
+
+			expectErr: storyplanmodel.ErrUnknownAct,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.plan.Lint()
+			if testCase.expectErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.ErrorIs(t, err, storyplanmodel.ErrInvalidPlan)
+			}
+		})
+	}
+}
+
+func TestLintTranslations(t *testing.T) {
+	t.Parallel()
+
+	planEN := &storyplanmodel.Plan{
+		Metadata: storyplanmodel.Metadata{Slug: "test-slug", Lang: models.LangEN},
+		Acts:     []storyplanmodel.Act{{Name: "Act 1", Key: "act-1"}},
+		Beats: []storyplanmodel.Beat{
+			{Name: "Beat 1", Key: "beat-1", Act: "act-1", Scenes: storyplanmodel.Scenes{Exact: lo.ToPtr(1)}},
+			{Name: "Beat 2", Key: "beat-2", Act: "act-1", Optional: true},
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		plan *storyplanmodel.Plan
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			plan: &storyplanmodel.Plan{
+				Metadata: storyplanmodel.Metadata{Slug: "test-slug", Lang: models.LangFR},
+				Acts:     []storyplanmodel.Act{{Name: "Acte 1", Key: "act-1"}},
+				Beats: []storyplanmodel.Beat{
+					{Name: "Battement 1", Key: "beat-1", Act: "act-1", Scenes: storyplanmodel.Scenes{Exact: lo.ToPtr(1)}},
+					{Name: "Battement 2", Key: "beat-2", Act: "act-1", Optional: true},
+				},
+			},
+		},
+		{
+			name: "DifferentSlug",
+
+			plan: &storyplanmodel.Plan{
+				Metadata: storyplanmodel.Metadata{Slug: "other-slug", Lang: models.LangFR},
+				Acts:     planEN.Acts,
+				Beats:    planEN.Beats,
+			},
+
+			expectErr: storyplanmodel.ErrTranslationMismatch,
+		},
+		{
+			name: "DifferentBeatKeys",
+
+			plan: &storyplanmodel.Plan{
+				Metadata: storyplanmodel.Metadata{Slug: "test-slug", Lang: models.LangFR},
+				Acts:     planEN.Acts,
+				Beats: []storyplanmodel.Beat{
+					{Name: "Battement 1", Key: "beat-1", Act: "act-1", Scenes: storyplanmodel.Scenes{Exact: lo.ToPtr(1)}},
+					{Name: "Battement 2", Key: "beat-3", Act: "act-1", Optional: true},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrTranslationMismatch,
+		},
+		{
+			name: "DifferentActKeys",
+
+			plan: &storyplanmodel.Plan{
+				Metadata: storyplanmodel.Metadata{Slug: "test-slug", Lang: models.LangFR},
+				Acts:     []storyplanmodel.Act{{Name: "Acte 1", Key: "acte-1"}},
+				Beats: []storyplanmodel.Beat{
+					{Name: "Battement 1", Key: "beat-1", Act: "acte-1", Scenes: storyplanmodel.Scenes{Exact: lo.ToPtr(1)}},
+					{Name: "Battement 2", Key: "beat-2", Act: "acte-1", Optional: true},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrTranslationMismatch,
+		},
+		{
+			name: "DifferentOccurrences",
+
+			plan: &storyplanmodel.Plan{
+				Metadata: storyplanmodel.Metadata{Slug: "test-slug", Lang: models.LangFR},
+				Acts:     planEN.Acts,
+				Beats: []storyplanmodel.Beat{
+					{Name: "Battement 1", Key: "beat-1", Act: "act-1", Scenes: storyplanmodel.Scenes{Exact: lo.ToPtr(1)}},
+					{Name: "Battement 2", Key: "beat-2", Act: "act-1"},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrTranslationMismatch,
+		},
+		{
+			name: "DifferentScenes",
+
+			plan: &storyplanmodel.Plan{
+				Metadata: storyplanmodel.Metadata{Slug: "test-slug", Lang: models.LangFR},
+				Acts:     planEN.Acts,
+				Beats: []storyplanmodel.Beat{
+					{Name: "Battement 1", Key: "beat-1", Act: "act-1", Scenes: storyplanmodel.Scenes{Exact: lo.ToPtr(2)}},
+					{Name: "Battement 2", Key: "beat-2", Act: "act-1", Optional: true},
+				},
+			},
+
+			expectErr: storyplanmodel.ErrTranslationMismatch,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := storyplanmodel.LintTranslations(planEN, testCase.plan)
+			if testCase.expectErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, testCase.expectErr)
+			}
+
+			// LintPlans reports the same mismatches, along with the lint errors of each plan.
+			err = storyplanmodel.LintPlans([]*storyplanmodel.Plan{planEN, testCase.plan})
+			if testCase.expectErr == nil || testCase.plan.Metadata.Slug != planEN.Metadata.Slug {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, testCase.expectErr)
+			}
+		})
+	}
+}
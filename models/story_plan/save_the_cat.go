@@ -17,7 +17,23 @@ var saveTheCatEN []byte
 //go:embed save_the_cat.fr.yaml
 var saveTheCatFR []byte
 
+//go:embed save_the_cat.es.yaml
+var saveTheCatES []byte
+
+//go:embed save_the_cat.de.yaml
+var saveTheCatDE []byte
+
+//go:embed save_the_cat.it.yaml
+var saveTheCatIT []byte
+
+//go:embed save_the_cat.pt.yaml
+var saveTheCatPT []byte
+
 var SaveTheCat = map[models.Lang]*Plan{
 	models.LangEN: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, saveTheCatEN)),
 	models.LangFR: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, saveTheCatFR)),
+	models.LangES: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, saveTheCatES)),
+	models.LangDE: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, saveTheCatDE)),
+	models.LangIT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, saveTheCatIT)),
+	models.LangPT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, saveTheCatPT)),
 }
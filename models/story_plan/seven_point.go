@@ -0,0 +1,39 @@
+package storyplanmodel
+
+import (
+	_ "embed"
+
+	"github.com/goccy/go-yaml"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/config"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+//go:embed seven_point.en.yaml
+var sevenPointEN []byte
+
+//go:embed seven_point.fr.yaml
+var sevenPointFR []byte
+
+//go:embed seven_point.es.yaml
+var sevenPointES []byte
+
+//go:embed seven_point.de.yaml
+var sevenPointDE []byte
+
+//go:embed seven_point.it.yaml
+var sevenPointIT []byte
+
+//go:embed seven_point.pt.yaml
+var sevenPointPT []byte
+
+var SevenPoint = map[models.Lang]*Plan{
+	models.LangEN: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, sevenPointEN)),
+	models.LangFR: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, sevenPointFR)),
+	models.LangES: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, sevenPointES)),
+	models.LangDE: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, sevenPointDE)),
+	models.LangIT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, sevenPointIT)),
+	models.LangPT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, sevenPointPT)),
+}
@@ -0,0 +1,39 @@
+package storyplanmodel
+
+import (
+	_ "embed"
+
+	"github.com/goccy/go-yaml"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/config"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+//go:embed story_circle.en.yaml
+var storyCircleEN []byte
+
+//go:embed story_circle.fr.yaml
+var storyCircleFR []byte
+
+//go:embed story_circle.es.yaml
+var storyCircleES []byte
+
+//go:embed story_circle.de.yaml
+var storyCircleDE []byte
+
+//go:embed story_circle.it.yaml
+var storyCircleIT []byte
+
+//go:embed story_circle.pt.yaml
+var storyCirclePT []byte
+
+var StoryCircle = map[models.Lang]*Plan{
+	models.LangEN: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, storyCircleEN)),
+	models.LangFR: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, storyCircleFR)),
+	models.LangES: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, storyCircleES)),
+	models.LangDE: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, storyCircleDE)),
+	models.LangIT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, storyCircleIT)),
+	models.LangPT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, storyCirclePT)),
+}
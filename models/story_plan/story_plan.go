@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/samber/lo"
 
 	"github.com/a-novel/service-story-schematics/models"
@@ -15,11 +17,23 @@ var (
 	ErrMissingBeat   = fmt.Errorf("%w: missing beat", ErrInvalidPlan)
 	ErrMisplacedBeat = fmt.Errorf("%w: misplaced beat", ErrInvalidPlan)
 	ErrExtraBeat     = fmt.Errorf("%w: extra beat", ErrInvalidPlan)
+	ErrUnknownAct    = fmt.Errorf("%w: unknown act", ErrInvalidPlan)
+	ErrMisplacedAct  = fmt.Errorf("%w: misplaced act", ErrInvalidPlan)
+
+	ErrEmptyKey            = fmt.Errorf("%w: empty key", ErrInvalidPlan)
+	ErrDuplicateBeat       = fmt.Errorf("%w: duplicate beat", ErrInvalidPlan)
+	ErrDuplicateAct        = fmt.Errorf("%w: duplicate act", ErrInvalidPlan)
+	ErrInvalidScenes       = fmt.Errorf("%w: invalid scenes", ErrInvalidPlan)
+	ErrInvalidRepeat       = fmt.Errorf("%w: invalid repeat", ErrInvalidPlan)
+	ErrTranslationMismatch = fmt.Errorf("%w: translation mismatch", ErrInvalidPlan)
 )
 
 type Plan struct {
 	Metadata Metadata `json:"metadata" yaml:"metadata"`
-	Beats    []Beat   `json:"beats"    yaml:"beats"`
+	// Acts optionally group the beats into larger movements, such as acts or sequences. When set, every beat must
+	// reference one of the acts, and beats must follow the order of the acts.
+	Acts  []Act  `json:"acts,omitempty" yaml:"acts,omitempty"`
+	Beats []Beat `json:"beats"          yaml:"beats"`
 }
 
 func (plan Plan) Pick(beats ...string) *Plan {
@@ -27,12 +41,47 @@ func (plan Plan) Pick(beats ...string) *Plan {
 		return lo.Contains(beats, beat.Key)
 	})
 
+	// Drop the acts that no longer contain any beat.
+	pickedActs := lo.Filter(plan.Acts, func(act Act, _ int) bool {
+		return lo.ContainsBy(pickedBeats, func(beat Beat) bool { return beat.Act == act.Key })
+	})
+
 	return &Plan{
 		Metadata: plan.Metadata,
+		Acts:     lo.Ternary(len(pickedActs) > 0, pickedActs, nil),
 		Beats:    pickedBeats,
 	}
 }
 
+func (plan Plan) GetAct(key string) (*Act, bool) {
+	act, ok := lo.Find(plan.Acts, func(a Act) bool { return a.Key == key })
+	if !ok {
+		return nil, false
+	}
+
+	return &act, true
+}
+
+// GroupBeats splits the beats of a sheet following the acts of the plan. Beats that do not belong to the plan are
+// ignored. It returns nil if the plan has no acts.
+func (plan Plan) GroupBeats(beats []models.Beat) []models.BeatsSheetAct {
+	if len(plan.Acts) == 0 {
+		return nil
+	}
+
+	return lo.Map(plan.Acts, func(act Act, _ int) models.BeatsSheetAct {
+		return models.BeatsSheetAct{
+			Key:  act.Key,
+			Name: act.Name,
+			Beats: lo.FilterMap(beats, func(beat models.Beat, _ int) (string, bool) {
+				return beat.Key, lo.ContainsBy(plan.Beats, func(planBeat Beat) bool {
+					return planBeat.Key == beat.Key && planBeat.Act == act.Key
+				})
+			}),
+		}
+	})
+}
+
 func (plan Plan) GetBeat(key string) (*Beat, error) {
 	beat, ok := lo.Find(plan.Beats, func(b Beat) bool { return b.Key == key })
 	if !ok {
@@ -42,64 +91,172 @@ func (plan Plan) GetBeat(key string) (*Beat, error) {
 	return &beat, nil
 }
 
+// Validate ensures the beats of a sheet follow the plan. Beats must appear in the order of the plan, each beat
+// occurring as many times as its optional and repeat bounds allow. Repeated beats must be consecutive.
 func (plan Plan) Validate(beats []models.Beat) error {
-	var errs []error
+	var (
+		errs     []error
+		cursor   int
+		reported = make(map[string]bool)
+	)
 
-	for planIndex, planBeat := range plan.Beats {
-		_, beatIndex, ok := lo.FindIndexOf(beats, func(b models.Beat) bool {
-			return b.Key == planBeat.Key
-		})
+	for _, planBeat := range plan.Beats {
+		var occurrences int
+		for cursor+occurrences < len(beats) && beats[cursor+occurrences].Key == planBeat.Key {
+			occurrences++
+		}
 
-		if !ok {
-			errs = append(
-				errs,
-				fmt.Errorf("%w: %s at index %d", ErrMissingBeat, planBeat.Key, planIndex),
-			)
+		minOccurrences, maxOccurrences := planBeat.MinOccurrences(), planBeat.MaxOccurrences()
 
-			continue
-		}
+		switch {
+		case occurrences == 0 && minOccurrences > 0:
+			reported[planBeat.Key] = true
 
-		if planIndex != beatIndex {
-			errs = append(
-				errs,
-				fmt.Errorf(
-					"%w: expected beat %s at index %d, found at index %d",
-					ErrMisplacedBeat, planBeat.Key, planIndex, beatIndex,
-				),
-			)
+			if lo.ContainsBy(beats, func(b models.Beat) bool { return b.Key == planBeat.Key }) {
+				errs = append(errs, fmt.Errorf(
+					"%w: expected beat %s at index %d", ErrMisplacedBeat, planBeat.Key, cursor,
+				))
+			} else {
+				errs = append(errs, fmt.Errorf("%w: %s at index %d", ErrMissingBeat, planBeat.Key, cursor))
+			}
+		case occurrences < minOccurrences:
+			errs = append(errs, fmt.Errorf(
+				"%w: expected at least %d occurrences of beat %s, found %d",
+				ErrMissingBeat, minOccurrences, planBeat.Key, occurrences,
+			))
+		case maxOccurrences != nil && occurrences > *maxOccurrences:
+			errs = append(errs, fmt.Errorf(
+				"%w: expected at most %d occurrences of beat %s, found %d",
+				ErrExtraBeat, *maxOccurrences, planBeat.Key, occurrences,
+			))
 		}
+
+		cursor += occurrences
 	}
 
-	for _, beat := range beats {
-		if !lo.ContainsBy(plan.Beats, func(b Beat) bool { return b.Key == beat.Key }) {
+	// Remaining beats either do not belong to the plan, or are out of order.
+	for index, beat := range beats[cursor:] {
+		switch {
+		case !lo.ContainsBy(plan.Beats, func(b Beat) bool { return b.Key == beat.Key }):
 			errs = append(errs, fmt.Errorf("%w: %s", ErrExtraBeat, beat.Key))
+		case !reported[beat.Key]:
+			reported[beat.Key] = true
+
+			errs = append(errs, fmt.Errorf(
+				"%w: beat %s found at index %d, out of order", ErrMisplacedBeat, beat.Key, cursor+index,
+			))
 		}
 	}
 
+	errs = append(errs, plan.ValidateActs())
+
+	return errors.Join(errs...)
+}
+
+// FlexibleBeats returns the beats of the plan that are optional, or can be repeated.
+func (plan Plan) FlexibleBeats() []Beat {
+	return lo.Filter(plan.Beats, func(item Beat, _ int) bool { return item.Flexible() })
+}
+
+// ValidateActs ensures the beats of the plan are correctly grouped into its acts, if any.
+func (plan Plan) ValidateActs() error {
+	if len(plan.Acts) == 0 {
+		return nil
+	}
+
+	var (
+		errs            []error
+		currentActIndex int
+	)
+
+	for _, beat := range plan.Beats {
+		_, actIndex, ok := lo.FindIndexOf(plan.Acts, func(act Act) bool { return act.Key == beat.Act })
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w: beat %s references act %q", ErrUnknownAct, beat.Key, beat.Act))
+
+			continue
+		}
+
+		if actIndex < currentActIndex {
+			errs = append(errs, fmt.Errorf(
+				"%w: beat %s belongs to act %s, but follows a beat from act %s",
+				ErrMisplacedAct, beat.Key, beat.Act, plan.Acts[currentActIndex].Key,
+			))
+
+			continue
+		}
+
+		currentActIndex = actIndex
+	}
+
 	return errors.Join(errs...)
 }
 
 func (plan Plan) OutputSchema() any {
+	description := "The beats that compose the story. " +
+		"A beat is a unit of story structure that represents a specific moment or event in the narrative."
+
+	if len(plan.Acts) > 0 {
+		description += "\nThe beats are grouped into the following acts, in order:" + strings.Join(
+			lo.Map(plan.Acts, func(item Act, _ int) string { return "\n\t- " + item.String() }),
+			"",
+		)
+	}
+
+	beatsSchemas := lo.Map(plan.Beats, func(item Beat, _ int) any {
+		act, _ := plan.GetAct(item.Act)
+
+		return item.outputSchema(act)
+	})
+
+	beatsSchema := map[string]any{"type": "array"}
+
+	// A fixed list of beats can be described item by item. Otherwise, the number of items varies, so the order
+	// is given in the description.
+	if len(plan.FlexibleBeats()) == 0 {
+		beatsSchema["prefixItems"] = beatsSchemas
+	} else {
+		description += "\nThe beats must appear in the following order, repeated beats being consecutive:" +
+			strings.Join(lo.Map(plan.Beats, func(item Beat, _ int) string {
+				return "\n\t- " + item.Key + " (" + item.Occurrences() + ")"
+			}), "")
+		beatsSchema["items"] = map[string]any{"anyOf": beatsSchemas}
+	}
+
+	beatsSchema["description"] = description
+
 	return map[string]any{
 		"type":                 "object",
 		"additionalProperties": false,
 		"required":             []string{"beats"},
 		"properties": map[string]any{
-			"beats": map[string]any{
-				"type": "array",
-				"description": "The beats that compose the story. " +
-					"A beat is a unit of story structure that represents a specific moment or event in the narrative.",
-				"prefixItems": lo.Map(plan.Beats, func(item Beat, index int) any {
-					return item.OutputSchema()
-				}),
-			},
+			"beats": beatsSchema,
 		},
 	}
 }
 
 type Metadata struct {
-	Name string      `json:"name" yaml:"name"`
-	Lang models.Lang `json:"lang" yaml:"lang"`
+	ID uuid.UUID `json:"id" yaml:"-"`
+	// UserID is the owner of a custom plan. It is nil for built-in plans.
+	UserID *uuid.UUID  `json:"userID,omitempty" yaml:"-"`
+	Slug   models.Slug `json:"slug"             yaml:"slug"`
+	// Version of the plan. Plans are immutable: every update creates a new version under the same slug and language.
+	Version int         `json:"version" yaml:"-"`
+	Name    string      `json:"name"    yaml:"name"`
+	Lang    models.Lang `json:"lang"    yaml:"lang"`
+
+	CreatedAt time.Time `json:"createdAt" yaml:"-"`
+}
+
+// Act groups consecutive beats of a plan.
+type Act struct {
+	Name    string `json:"name"    yaml:"name"`
+	Key     string `json:"key"     yaml:"key"`
+	Purpose string `json:"purpose" yaml:"purpose"`
+}
+
+func (act Act) String() string {
+	return fmt.Sprintf("%s: %s", act.Name, act.Purpose)
 }
 
 type Beat struct {
@@ -108,6 +265,64 @@ type Beat struct {
 	KeyPoints []string `json:"keyPoints" yaml:"keyPoints"`
 	Purpose   string   `json:"purpose"   yaml:"purpose"`
 	Scenes    Scenes   `json:"scenes"    yaml:"scenes"`
+	// Act is the key of the act the beat belongs to, if the plan defines any.
+	Act string `json:"act,omitempty" yaml:"act,omitempty"`
+	// Optional beats may be omitted from a beats sheet.
+	Optional bool `json:"optional,omitempty" yaml:"optional,omitempty"`
+	// Repeat allows the beat to occur more than once in a row, within the given bounds.
+	Repeat *Repeat `json:"repeat,omitempty" yaml:"repeat,omitempty"`
+}
+
+// Flexible returns true if the beat does not have to occur exactly once.
+func (beat Beat) Flexible() bool {
+	return beat.Optional || beat.Repeat != nil
+}
+
+// MinOccurrences returns the minimum number of times the beat must occur in a sheet. Optional beats have no
+// minimum, regardless of their repeat bounds.
+func (beat Beat) MinOccurrences() int {
+	switch {
+	case beat.Optional:
+		return 0
+	case beat.Repeat != nil && beat.Repeat.Min != nil:
+		return *beat.Repeat.Min
+	default:
+		return 1
+	}
+}
+
+// MaxOccurrences returns the maximum number of times the beat may occur in a sheet, or nil if it can be repeated
+// indefinitely.
+func (beat Beat) MaxOccurrences() *int {
+	if beat.Repeat == nil {
+		return lo.ToPtr(1)
+	}
+
+	return beat.Repeat.Max
+}
+
+// Occurrences describes how many times the beat occurs in a sheet.
+func (beat Beat) Occurrences() string {
+	minOccurrences, maxOccurrences := beat.MinOccurrences(), beat.MaxOccurrences()
+
+	switch {
+	case maxOccurrences == nil && minOccurrences == 0:
+		return "optional, any number of times"
+	case maxOccurrences == nil && minOccurrences == 1:
+		return "at least once"
+	case maxOccurrences == nil:
+		return fmt.Sprintf("at least %d times", minOccurrences)
+	case minOccurrences == 0 && *maxOccurrences == 1:
+		return "optional"
+	case minOccurrences == 0:
+		return fmt.Sprintf("optional, up to %d times", *maxOccurrences)
+	case minOccurrences == 1 && *maxOccurrences == 1:
+		return "exactly once"
+	case minOccurrences == *maxOccurrences:
+		return fmt.Sprintf("exactly %d times", minOccurrences)
+	default:
+		return fmt.Sprintf("between %d and %d times", minOccurrences, *maxOccurrences)
+	}
 }
 
 func (beat Beat) String() string {
@@ -121,6 +336,26 @@ func (beat Beat) String() string {
 }
 
 func (beat Beat) OutputSchema() any {
+	return beat.outputSchema(nil)
+}
+
+func (beat Beat) outputSchema(act *Act) any {
+	description := fmt.Sprintf(
+		"A summary of the %s that make up the '%s' beat.\nKey Points: %s\nPurpose: %s",
+		beat.Scenes.String(),
+		beat.Name,
+		"\n\t- "+strings.Join(beat.KeyPoints, "\n\t- "),
+		beat.Purpose,
+	)
+
+	if act != nil {
+		description += "\nAct: " + act.String()
+	}
+
+	if beat.Flexible() {
+		description += "\nOccurrences: " + beat.Occurrences()
+	}
+
 	return map[string]any{
 		"type":                 "object",
 		"additionalProperties": false,
@@ -134,19 +369,20 @@ func (beat Beat) OutputSchema() any {
 				"description": "A short title representing the beat.",
 			},
 			"content": map[string]any{
-				"type": "string",
-				"description": fmt.Sprintf(
-					"A summary of the %s that make up the '%s' beat.\nKey Points: %s\nPurpose: %s",
-					beat.Scenes.String(),
-					beat.Name,
-					"\n\t- "+strings.Join(beat.KeyPoints, "\n\t- "),
-					beat.Purpose,
-				),
+				"type":        "string",
+				"description": description,
 			},
 		},
 	}
 }
 
+// Repeat bounds the number of consecutive occurrences of a beat. A missing minimum defaults to 1, and a missing
+// maximum allows the beat to be repeated indefinitely.
+type Repeat struct {
+	Min *int `json:"min,omitempty" yaml:"min,omitempty"`
+	Max *int `json:"max,omitempty" yaml:"max,omitempty"`
+}
+
 type Scenes struct {
 	Exact *int `json:"exact,omitempty" yaml:"exact,omitempty"`
 	Min   *int `json:"min,omitempty"   yaml:"min,omitempty"`
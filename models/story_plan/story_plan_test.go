@@ -6,6 +6,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
+	"github.com/a-novel/service-story-schematics/models"
 	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
@@ -312,6 +313,187 @@ func TestPlanOutputSchema(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WithActs",
+
+			plan: &storyplanmodel.Plan{
+				Metadata: storyplanmodel.Metadata{},
+				Acts: []storyplanmodel.Act{
+					{Name: "Act 1", Key: "act-1", Purpose: "Purpose of Act 1"},
+				},
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Beat 1",
+						Key:       "beat-1",
+						KeyPoints: []string{"Key point 1"},
+						Purpose:   "Purpose of Beat 1",
+						Scenes: storyplanmodel.Scenes{
+							Exact: lo.ToPtr(1),
+						},
+						Act: "act-1",
+					},
+				},
+			},
+
+			expect: map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"required":             []string{"beats"},
+				"properties": map[string]any{
+					"beats": map[string]any{
+						"type": "array",
+						"description": "The beats that compose the story. " +
+							"A beat is a unit of story structure that represents a specific moment or event in the " +
+							"narrative." +
+							"\nThe beats are grouped into the following acts, in order:" +
+							"\n\t- Act 1: Purpose of Act 1",
+						"prefixItems": []any{
+							map[string]any{
+								"type":                 "object",
+								"additionalProperties": false,
+								"required":             []string{"key", "content", "title"},
+								"properties": map[string]any{
+									"key": map[string]any{
+										"const": "beat-1",
+									},
+									"title": map[string]any{
+										"type":        "string",
+										"description": "A short title representing the beat.",
+									},
+									"content": map[string]any{
+										"type": "string",
+										"description": "A summary of the exactly 1 scene that make up the 'Beat 1' beat." +
+											"\nKey Points: " +
+											"\n\t- Key point 1" +
+											"\nPurpose: Purpose of Beat 1" +
+											"\nAct: Act 1: Purpose of Act 1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "WithFlexibleBeats",
+
+			plan: &storyplanmodel.Plan{
+				Metadata: storyplanmodel.Metadata{},
+				Beats: []storyplanmodel.Beat{
+					{
+						Name:      "Beat 1",
+						Key:       "beat-1",
+						KeyPoints: []string{"Key point 1"},
+						Purpose:   "Purpose of Beat 1",
+						Scenes:    storyplanmodel.Scenes{Exact: lo.ToPtr(1)},
+					},
+					{
+						Name:      "Beat 2",
+						Key:       "beat-2",
+						KeyPoints: []string{"Key point 1"},
+						Purpose:   "Purpose of Beat 2",
+						Scenes:    storyplanmodel.Scenes{Exact: lo.ToPtr(1)},
+						Repeat:    &storyplanmodel.Repeat{Min: lo.ToPtr(2), Max: lo.ToPtr(4)},
+					},
+					{
+						Name:      "Beat 3",
+						Key:       "beat-3",
+						KeyPoints: []string{"Key point 1"},
+						Purpose:   "Purpose of Beat 3",
+						Scenes:    storyplanmodel.Scenes{Exact: lo.ToPtr(1)},
+						Optional:  true,
+					},
+				},
+			},
+
+			expect: map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"required":             []string{"beats"},
+				"properties": map[string]any{
+					"beats": map[string]any{
+						"type": "array",
+						"description": "The beats that compose the story. " +
+							"A beat is a unit of story structure that represents a specific moment or event in the " +
+							"narrative." +
+							"\nThe beats must appear in the following order, repeated beats being consecutive:" +
+							"\n\t- beat-1 (exactly once)" +
+							"\n\t- beat-2 (between 2 and 4 times)" +
+							"\n\t- beat-3 (optional)",
+						"items": map[string]any{
+							"anyOf": []any{
+								map[string]any{
+									"type":                 "object",
+									"additionalProperties": false,
+									"required":             []string{"key", "content", "title"},
+									"properties": map[string]any{
+										"key": map[string]any{
+											"const": "beat-1",
+										},
+										"title": map[string]any{
+											"type":        "string",
+											"description": "A short title representing the beat.",
+										},
+										"content": map[string]any{
+											"type": "string",
+											"description": "A summary of the exactly 1 scene that make up the 'Beat 1' beat." +
+												"\nKey Points: " +
+												"\n\t- Key point 1" +
+												"\nPurpose: Purpose of Beat 1",
+										},
+									},
+								},
+								map[string]any{
+									"type":                 "object",
+									"additionalProperties": false,
+									"required":             []string{"key", "content", "title"},
+									"properties": map[string]any{
+										"key": map[string]any{
+											"const": "beat-2",
+										},
+										"title": map[string]any{
+											"type":        "string",
+											"description": "A short title representing the beat.",
+										},
+										"content": map[string]any{
+											"type": "string",
+											"description": "A summary of the exactly 1 scene that make up the 'Beat 2' beat." +
+												"\nKey Points: " +
+												"\n\t- Key point 1" +
+												"\nPurpose: Purpose of Beat 2" +
+												"\nOccurrences: between 2 and 4 times",
+										},
+									},
+								},
+								map[string]any{
+									"type":                 "object",
+									"additionalProperties": false,
+									"required":             []string{"key", "content", "title"},
+									"properties": map[string]any{
+										"key": map[string]any{
+											"const": "beat-3",
+										},
+										"title": map[string]any{
+											"type":        "string",
+											"description": "A short title representing the beat.",
+										},
+										"content": map[string]any{
+											"type": "string",
+											"description": "A summary of the exactly 1 scene that make up the 'Beat 3' beat." +
+												"\nKey Points: " +
+												"\n\t- Key point 1" +
+												"\nPurpose: Purpose of Beat 3" +
+												"\nOccurrences: optional",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -323,3 +505,276 @@ func TestPlanOutputSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanValidate(t *testing.T) {
+	t.Parallel()
+
+	plan := storyplanmodel.Plan{
+		Acts: []storyplanmodel.Act{
+			{Name: "Act 1", Key: "act-1", Purpose: "Purpose of Act 1"},
+			{Name: "Act 2", Key: "act-2", Purpose: "Purpose of Act 2"},
+		},
+		Beats: []storyplanmodel.Beat{
+			{Name: "Beat 1", Key: "beat-1", Act: "act-1"},
+			{Name: "Beat 2", Key: "beat-2", Act: "act-1"},
+			{Name: "Beat 3", Key: "beat-3", Act: "act-2"},
+		},
+	}
+
+	flexiblePlan := storyplanmodel.Plan{
+		Beats: []storyplanmodel.Beat{
+			{Name: "Beat 1", Key: "beat-1"},
+			{Name: "Beat 2", Key: "beat-2", Repeat: &storyplanmodel.Repeat{Min: lo.ToPtr(2), Max: lo.ToPtr(3)}},
+			{Name: "Beat 3", Key: "beat-3", Optional: true},
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		plan  storyplanmodel.Plan
+		beats []models.Beat
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			plan:  plan,
+			beats: []models.Beat{{Key: "beat-1"}, {Key: "beat-2"}, {Key: "beat-3"}},
+		},
+		{
+			name: "Success/NoActs",
+
+			plan: storyplanmodel.Plan{
+				Beats: []storyplanmodel.Beat{{Name: "Beat 1", Key: "beat-1"}},
+			},
+			beats: []models.Beat{{Key: "beat-1"}},
+		},
+		{
+			name: "MissingBeat",
+
+			plan:  plan,
+			beats: []models.Beat{{Key: "beat-1"}, {Key: "beat-2"}},
+
+			expectErr: storyplanmodel.ErrMissingBeat,
+		},
+		{
+			name: "MisplacedBeat",
+
+			plan:  plan,
+			beats: []models.Beat{{Key: "beat-2"}, {Key: "beat-1"}, {Key: "beat-3"}},
+
+			expectErr: storyplanmodel.ErrMisplacedBeat,
+		},
+		{
+			name: "ExtraBeat",
+
+			plan:  plan,
+			beats: []models.Beat{{Key: "beat-1"}, {Key: "beat-2"}, {Key: "beat-3"}, {Key: "beat-4"}},
+
+			expectErr: storyplanmodel.ErrExtraBeat,
+		},
+		{
+			name: "Flexible/OptionalOmitted",
+
+			plan:  flexiblePlan,
+			beats: []models.Beat{{Key: "beat-1"}, {Key: "beat-2"}, {Key: "beat-2"}},
+		},
+		{
+			name: "Flexible/OptionalAndRepeated",
+
+			plan: flexiblePlan,
+			beats: []models.Beat{
+				{Key: "beat-1"}, {Key: "beat-2"}, {Key: "beat-2"}, {Key: "beat-2"}, {Key: "beat-3"},
+			},
+		},
+		{
+			name: "Flexible/NotEnoughRepeats",
+
+			plan:  flexiblePlan,
+			beats: []models.Beat{{Key: "beat-1"}, {Key: "beat-2"}, {Key: "beat-3"}},
+
+			expectErr: storyplanmodel.ErrMissingBeat,
+		},
+		{
+			name: "Flexible/TooManyRepeats",
+
+			plan: flexiblePlan,
+			beats: []models.Beat{
+				{Key: "beat-1"}, {Key: "beat-2"}, {Key: "beat-2"}, {Key: "beat-2"}, {Key: "beat-2"},
+			},
+
+			expectErr: storyplanmodel.ErrExtraBeat,
+		},
+		{
+			name: "Flexible/RepeatsNotConsecutive",
+
+			plan: flexiblePlan,
+			beats: []models.Beat{
+				{Key: "beat-1"}, {Key: "beat-2"}, {Key: "beat-2"}, {Key: "beat-3"}, {Key: "beat-2"},
+			},
+
+			expectErr: storyplanmodel.ErrMisplacedBeat,
+		},
+		{
+			name: "Flexible/OptionalMisplaced",
+
+			plan:  flexiblePlan,
+			beats: []models.Beat{{Key: "beat-3"}, {Key: "beat-1"}, {Key: "beat-2"}, {Key: "beat-2"}},
+
+			expectErr: storyplanmodel.ErrMisplacedBeat,
+		},
+		{
+			name: "UnknownAct",
+
+			plan: storyplanmodel.Plan{
+				Acts: plan.Acts,
+				Beats: []storyplanmodel.Beat{
+					{Name: "Beat 1", Key: "beat-1", Act: "act-1"},
+					{Name: "Beat 2", Key: "beat-2", Act: "act-3"},
+				},
+			},
+			beats: []models.Beat{{Key: "beat-1"}, {Key: "beat-2"}},
+
+			expectErr: storyplanmodel.ErrUnknownAct,
+		},
+		{
+			name: "MisplacedAct",
+
+			plan: storyplanmodel.Plan{
+				Acts: plan.Acts,
+				Beats: []storyplanmodel.Beat{
+					{Name: "Beat 1", Key: "beat-1", Act: "act-1"},
+					{Name: "Beat 2", Key: "beat-2", Act: "act-2"},
+					{Name: "Beat 3", Key: "beat-3", Act: "act-1"},
+				},
+			},
+			beats: []models.Beat{{Key: "beat-1"}, {Key: "beat-2"}, {Key: "beat-3"}},
+
+			expectErr: storyplanmodel.ErrMisplacedAct,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.plan.Validate(testCase.beats)
+			if testCase.expectErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, testCase.expectErr)
+			}
+		})
+	}
+}
+
+func TestPlanGroupBeats(t *testing.T) {
+	t.Parallel()
+
+	plan := storyplanmodel.Plan{
+		Acts: []storyplanmodel.Act{
+			{Name: "Act 1", Key: "act-1", Purpose: "Purpose of Act 1"},
+			{Name: "Act 2", Key: "act-2", Purpose: "Purpose of Act 2"},
+		},
+		Beats: []storyplanmodel.Beat{
+			{Name: "Beat 1", Key: "beat-1", Act: "act-1"},
+			{Name: "Beat 2", Key: "beat-2", Act: "act-1"},
+			{Name: "Beat 3", Key: "beat-3", Act: "act-2"},
+		},
+	}
+
+	require.Equal(
+		t,
+		[]models.BeatsSheetAct{
+			{Key: "act-1", Name: "Act 1", Beats: []string{"beat-1", "beat-2"}},
+			{Key: "act-2", Name: "Act 2", Beats: []string{"beat-3"}},
+		},
+		plan.GroupBeats([]models.Beat{{Key: "beat-1"}, {Key: "beat-2"}, {Key: "beat-3"}, {Key: "unknown"}}),
+	)
+
+	require.Nil(t, storyplanmodel.Plan{Beats: plan.Beats}.GroupBeats([]models.Beat{{Key: "beat-1"}}))
+
+	picked := plan.Pick("beat-3")
+	require.Equal(t, []storyplanmodel.Act{plan.Acts[1]}, picked.Acts)
+	require.Equal(t, []storyplanmodel.Beat{plan.Beats[2]}, picked.Beats)
+}
+
+func TestBeatOccurrences(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		beat storyplanmodel.Beat
+
+		expectMin int
+		expectMax *int
+		expect    string
+	}{
+		{
+			name: "Default",
+
+			beat: storyplanmodel.Beat{},
+
+			expectMin: 1,
+			expectMax: lo.ToPtr(1),
+			expect:    "exactly once",
+		},
+		{
+			name: "Optional",
+
+			beat: storyplanmodel.Beat{Optional: true},
+
+			expectMin: 0,
+			expectMax: lo.ToPtr(1),
+			expect:    "optional",
+		},
+		{
+			name: "OptionalRepeated",
+
+			beat: storyplanmodel.Beat{Optional: true, Repeat: &storyplanmodel.Repeat{Min: lo.ToPtr(2), Max: lo.ToPtr(3)}},
+
+			expectMin: 0,
+			expectMax: lo.ToPtr(3),
+			expect:    "optional, up to 3 times",
+		},
+		{
+			name: "RepeatedUnbounded",
+
+			beat: storyplanmodel.Beat{Repeat: &storyplanmodel.Repeat{}},
+
+			expectMin: 1,
+			expect:    "at least once",
+		},
+		{
+			name: "RepeatedExact",
+
+			beat: storyplanmodel.Beat{Repeat: &storyplanmodel.Repeat{Min: lo.ToPtr(2), Max: lo.ToPtr(2)}},
+
+			expectMin: 2,
+			expectMax: lo.ToPtr(2),
+			expect:    "exactly 2 times",
+		},
+		{
+			name: "RepeatedRange",
+
+			beat: storyplanmodel.Beat{Repeat: &storyplanmodel.Repeat{Min: lo.ToPtr(2), Max: lo.ToPtr(4)}},
+
+			expectMin: 2,
+			expectMax: lo.ToPtr(4),
+			expect:    "between 2 and 4 times",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testCase.expectMin, testCase.beat.MinOccurrences())
+			require.Equal(t, testCase.expectMax, testCase.beat.MaxOccurrences())
+			require.Equal(t, testCase.expect, testCase.beat.Occurrences())
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package storyplanmodel
+
+import (
+	_ "embed"
+
+	"github.com/goccy/go-yaml"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/golib/config"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+//go:embed three_act.en.yaml
+var threeActEN []byte
+
+//go:embed three_act.fr.yaml
+var threeActFR []byte
+
+//go:embed three_act.es.yaml
+var threeActES []byte
+
+//go:embed three_act.de.yaml
+var threeActDE []byte
+
+//go:embed three_act.it.yaml
+var threeActIT []byte
+
+//go:embed three_act.pt.yaml
+var threeActPT []byte
+
+var ThreeAct = map[models.Lang]*Plan{
+	models.LangEN: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, threeActEN)),
+	models.LangFR: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, threeActFR)),
+	models.LangES: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, threeActES)),
+	models.LangDE: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, threeActDE)),
+	models.LangIT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, threeActIT)),
+	models.LangPT: lo.ToPtr(config.MustUnmarshal[Plan](yaml.Unmarshal, threeActPT)),
+}
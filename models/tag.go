@@ -0,0 +1,51 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MaxTagLength      = 32
+	MaxTagsPerLogline = 20
+)
+
+var ErrInvalidTags = errors.New("invalid tags")
+
+// LoglineTag is a tag used by a user, along with the number of loglines it is set on.
+type LoglineTag struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// NormalizeTags gives user-provided tags a canonical form, so that "Space Opera" and "space  opera " are the same
+// tag. Tags are lowercased, their whitespace collapsed, and the result is deduplicated and sorted. Blank tags are
+// dropped.
+func NormalizeTags(tags []string) ([]string, error) {
+	output := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.Join(strings.Fields(tag), " "))
+		if tag == "" {
+			continue
+		}
+
+		if utf8.RuneCountInString(tag) > MaxTagLength {
+			return nil, fmt.Errorf("%w: tag %q is longer than %d characters", ErrInvalidTags, tag, MaxTagLength)
+		}
+
+		output = append(output, tag)
+	}
+
+	slices.Sort(output)
+	output = slices.Compact(output)
+
+	if len(output) > MaxTagsPerLogline {
+		return nil, fmt.Errorf("%w: a logline cannot have more than %d tags", ErrInvalidTags, MaxTagsPerLogline)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,62 @@
+package models_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-story-schematics/models"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		tags []string
+
+		expect    []string
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			tags: []string{"Space  Opera ", "noir", "space opera", "  ", "Été"},
+
+			expect: []string{"noir", "space opera", "été"},
+		},
+		{
+			name: "Empty",
+
+			tags: nil,
+
+			expect: []string{},
+		},
+		{
+			name: "TooLong",
+
+			tags: []string{strings.Repeat("a", models.MaxTagLength+1)},
+
+			expectErr: models.ErrInvalidTags,
+		},
+		{
+			name: "TooMany",
+
+			tags: strings.Split("a b c d e f g h i j k l m n o p q r s t u", " "),
+
+			expectErr: models.ErrInvalidTags,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			tags, err := models.NormalizeTags(testCase.tags)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, tags)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashedBeatsSheet is a beats sheet that was moved to the trash on its own. It can be restored as long as its
+// logline is not in the trash.
+type TrashedBeatsSheet struct {
+	ID        uuid.UUID `json:"id"`
+	LoglineID uuid.UUID `json:"loglineID"`
+	Lang      Lang      `json:"lang"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+// TrashPurge reports the number of items permanently deleted from the trash.
+type TrashPurge struct {
+	Loglines    int `json:"loglines"`
+	BeatsSheets int `json:"beatsSheets"`
+}
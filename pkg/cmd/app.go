@@ -15,6 +15,7 @@ import (
 	"github.com/a-novel/golib/otel"
 	"github.com/a-novel/golib/postgres"
 	authmodels "github.com/a-novel/service-authentication/models"
+	jkApiModels "github.com/a-novel/service-json-keys/models/api"
 	jkconfig "github.com/a-novel/service-json-keys/models/config"
 	jkpkg "github.com/a-novel/service-json-keys/pkg"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/a-novel/service-story-schematics/internal/services"
 	apimodels "github.com/a-novel/service-story-schematics/models/api"
 	"github.com/a-novel/service-story-schematics/models/config"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
 )
 
 func App[Otel otel.Config, Pg postgres.Config](
@@ -62,32 +64,140 @@ func App[Otel otel.Config, Pg postgres.Config](
 		return fmt.Errorf("create access token verifier: %w", err)
 	}
 
+	handler, err := newAPIHandler(ctx, config, jkClient)
+	if err != nil {
+		return fmt.Errorf("create api handler: %w", err)
+	}
+
 	// =================================================================================================================
-	// DAO
+	// SETUP ROUTER
 	// =================================================================================================================
 
+	router := chi.NewRouter()
+
+	router.Use(middleware.Recoverer)
+	router.Use(middleware.RealIP)
+	router.Use(middleware.Timeout(config.API.Timeouts.Request))
+	router.Use(middleware.RequestSize(config.API.MaxRequestSize))
+	router.Use(cors.Handler(cors.Options{
+		AllowedOrigins:   config.API.Cors.AllowedOrigins,
+		AllowedHeaders:   config.API.Cors.AllowedHeaders,
+		AllowCredentials: config.API.Cors.AllowCredentials,
+		AllowedMethods: []string{
+			http.MethodHead,
+			http.MethodGet,
+			http.MethodPost,
+			http.MethodPut,
+			http.MethodPatch,
+			http.MethodDelete,
+		},
+		MaxAge: config.API.Cors.MaxAge,
+	}))
+	router.Use(config.Otel.HTTPHandler())
+
+	securityHandler, err := api.NewSecurity(accessTokenVerifier, config.PermissionsConfig)
+	if err != nil {
+		return fmt.Errorf("create security handler: %w", err)
+	}
+
+	apiServer, err := apimodels.NewServer(handler, securityHandler)
+	if err != nil {
+		return fmt.Errorf("new api server: %w", err)
+	}
+
+	router.Mount("/v1/", http.StripPrefix("/v1", apiServer))
+
+	httpServer := &http.Server{
+		Addr:              ":" + strconv.Itoa(config.API.Port),
+		Handler:           router,
+		ReadTimeout:       config.API.Timeouts.Read,
+		ReadHeaderTimeout: config.API.Timeouts.ReadHeader,
+		WriteTimeout:      config.API.Timeouts.Write,
+		IdleTimeout:       config.API.Timeouts.Idle,
+		BaseContext:       func(_ net.Listener) context.Context { return ctx },
+	}
+
+	err = httpServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("listen and serve: %w", err)
+	}
+
+	return nil
+}
+
+// newAPIHandler wires the repositories and services of the application into the API handler.
+func newAPIHandler[Otel otel.Config, Pg postgres.Config](
+	ctx context.Context, config config.App[Otel, Pg], jkClient *jkApiModels.Client,
+) (*api.API, error) {
+	// =================================================================================================================
+	// DAO
+	// =================================================================================================================
 	selectSlugIterationDAO := dao.NewSelectSlugIterationRepository()
 
+	deleteBeatsSheetDAO := dao.NewDeleteBeatsSheetRepository()
+	deleteLoglineDAO := dao.NewDeleteLoglineRepository()
+	deleteProjectDAO := dao.NewDeleteProjectRepository()
 	insertBeatsSheetDAO := dao.NewInsertBeatsSheetRepository()
 	insertLoglineDAO := dao.NewInsertLoglineRepository()
+	insertProjectDAO := dao.NewInsertProjectRepository()
+	insertStoryPlanDAO := dao.NewInsertStoryPlanRepository()
 	listBeatsSheetsDAO := dao.NewListBeatsSheetsRepository()
+	listLoglineRevisionsDAO := dao.NewListLoglineRevisionsRepository()
+	listLoglineTagsDAO := dao.NewListLoglineTagsRepository()
 	listLoglinesDAO := dao.NewListLoglinesRepository()
+	listOutdatedBeatsSheetsDAO := dao.NewListOutdatedBeatsSheetsRepository()
+	listProjectsDAO := dao.NewListProjectsRepository()
+	listStoryPlanTranslationsDAO := dao.NewListStoryPlanTranslationsRepository()
+	listStoryPlansDAO := dao.NewListStoryPlansRepository()
+	listTrashedBeatsSheetsDAO := dao.NewListTrashedBeatsSheetsRepository()
+	listTrashedLoglinesDAO := dao.NewListTrashedLoglinesRepository()
+	restoreBeatsSheetDAO := dao.NewRestoreBeatsSheetRepository()
+	restoreLoglineDAO := dao.NewRestoreLoglineRepository()
+	searchDAO := dao.NewSearchRepository()
 	selectBeatsSheetDAO := dao.NewSelectBeatsSheetRepository()
 	selectLoglineDAO := dao.NewSelectLoglineRepository()
 	selectLoglineBySlugDAO := dao.NewSelectLoglineBySlugRepository()
-
+	selectLoglineRevisionDAO := dao.NewSelectLoglineRevisionRepository()
+	selectProjectDAO := dao.NewSelectProjectRepository()
+	selectStoryPlanDAO := dao.NewSelectStoryPlanRepository()
+	selectStoryPlanBySlugDAO := dao.NewSelectStoryPlanBySlugRepository()
+	updateBeatsSheetStoryPlanDAO := dao.NewUpdateBeatsSheetStoryPlanRepository()
+	updateLoglineDAO := dao.NewUpdateLoglineRepository()
+	updateProjectDAO := dao.NewUpdateProjectRepository()
+	updateStoryPlanDAO := dao.NewUpdateStoryPlanRepository()
+	updateStoryPlanSeedHashDAO := dao.NewUpdateStoryPlanSeedHashRepository()
+
+	convertBeatsSheetDAO := daoai.NewConvertBeatsSheetRepository(&config.OpenAI)
+	critiqueLoglineDAO := daoai.NewCritiqueLoglineRepository(&config.OpenAI)
 	expandBeatDAO := daoai.NewExpandBeatRepository(&config.OpenAI)
 	expandLoglineDAO := daoai.NewExpandLoglineRepository(&config.OpenAI)
 	generateBeatsSheetDAO := daoai.NewGenerateBeatsSheetRepository(&config.OpenAI)
+	generateLoglineVariationsDAO := daoai.NewGenerateLoglineVariationsRepository(&config.OpenAI)
 	generateLoglinesDAO := daoai.NewGenerateLoglinesRepository(&config.OpenAI)
 	regenerateBeatsDAO := daoai.NewRegenerateBeatsRepository(&config.OpenAI)
+	translateBeatsSheetDAO := daoai.NewTranslateBeatsSheetRepository(&config.OpenAI)
+	translateLoglineDAO := daoai.NewTranslateLoglineRepository(&config.OpenAI)
 
 	// =================================================================================================================
 	// SERVICES
 	// =================================================================================================================
 
-	selectStoryPlanService := services.NewSelectStoryPlanService()
+	selectStoryPlanService := services.NewSelectStoryPlanService(
+		services.NewSelectStoryPlanServiceSource(
+			selectStoryPlanDAO,
+			selectStoryPlanBySlugDAO,
+		),
+	)
 
+	convertBeatsSheetService := services.NewConvertBeatsSheetService(
+		services.NewConvertBeatsSheetServiceSource(
+			convertBeatsSheetDAO,
+			insertBeatsSheetDAO,
+			selectBeatsSheetDAO,
+			selectLoglineDAO,
+			selectStoryPlanService,
+		),
+	)
 	createBeatsSheetService := services.NewCreateBeatsSheetService(
 		services.NewCreateBeatsSheetServiceSource(
 			insertBeatsSheetDAO,
@@ -99,17 +209,42 @@ func App[Otel otel.Config, Pg postgres.Config](
 		services.NewCreateLoglineServiceSource(
 			insertLoglineDAO,
 			selectSlugIterationDAO,
+			selectProjectDAO,
+		),
+	)
+	createProjectService := services.NewCreateProjectService(insertProjectDAO)
+	createStoryPlanService := services.NewCreateStoryPlanService(
+		services.NewCreateStoryPlanServiceSource(
+			insertStoryPlanDAO,
+			listStoryPlanTranslationsDAO,
+		),
+	)
+	critiqueLoglineService := services.NewCritiqueLoglineService(
+		services.NewCritiqueLoglineServiceSource(
+			critiqueLoglineDAO,
+			selectLoglineDAO,
 		),
 	)
+	deleteBeatsSheetService := services.NewDeleteBeatsSheetService(deleteBeatsSheetDAO)
+	deleteLoglineService := services.NewDeleteLoglineService(deleteLoglineDAO)
+	deleteProjectService := services.NewDeleteProjectService(deleteProjectDAO)
+	detectLangService := services.NewDetectLangService()
 	expandBeatService := services.NewExpandBeatService(
 		services.NewExpandBeatServiceSource(
 			expandBeatDAO,
+			insertBeatsSheetDAO,
 			selectBeatsSheetDAO,
 			selectLoglineDAO,
 			selectStoryPlanService,
 		),
 	)
 	expandLoglineService := services.NewExpandLoglineService(expandLoglineDAO)
+	forkStoryPlanService := services.NewForkStoryPlanService(
+		services.NewForkStoryPlanServiceSource(
+			selectStoryPlanService,
+			insertStoryPlanDAO,
+		),
+	)
 	generateBeatsSheetService := services.NewGenerateBeatsSheetService(
 		services.NewGenerateBeatsSheetServiceSource(
 			generateBeatsSheetDAO,
@@ -117,6 +252,12 @@ func App[Otel otel.Config, Pg postgres.Config](
 			selectStoryPlanService,
 		),
 	)
+	generateLoglineVariationsService := services.NewGenerateLoglineVariationsService(
+		services.NewGenerateLoglineVariationsServiceSource(
+			generateLoglineVariationsDAO,
+			selectLoglineDAO,
+		),
+	)
 	generateLoglinesService := services.NewGenerateLoglinesService(generateLoglinesDAO)
 	listBeatsSheetsService := services.NewListBeatsSheetsService(
 		services.NewListBeatsSheetsServiceSource(
@@ -124,9 +265,21 @@ func App[Otel otel.Config, Pg postgres.Config](
 			selectLoglineDAO,
 		),
 	)
+	listLoglineRevisionsService := services.NewListLoglineRevisionsService(
+		services.NewListLoglineRevisionsServiceSource(
+			listLoglineRevisionsDAO,
+			selectLoglineDAO,
+		),
+	)
+	listLoglineTagsService := services.NewListLoglineTagsService(listLoglineTagsDAO)
 	listLoglinesService := services.NewListLoglinesService(listLoglinesDAO)
+	listProjectsService := services.NewListProjectsService(listProjectsDAO)
+	listStoryPlansService := services.NewListStoryPlansService(listStoryPlansDAO)
+	listTrashedBeatsSheetsService := services.NewListTrashedBeatsSheetsService(listTrashedBeatsSheetsDAO)
+	listTrashedLoglinesService := services.NewListTrashedLoglinesService(listTrashedLoglinesDAO)
 	regenerateBeatsService := services.NewRegenerateBeatsService(
 		services.NewRegenerateBeatsServiceSource(
+			insertBeatsSheetDAO,
 			regenerateBeatsDAO,
 			selectBeatsSheetDAO,
 			selectLoglineDAO,
@@ -137,6 +290,31 @@ func App[Otel otel.Config, Pg postgres.Config](
 		services.NewSelectBeatsSheetServiceSource(
 			selectBeatsSheetDAO,
 			selectLoglineDAO,
+			selectStoryPlanService,
+		),
+	)
+	diffBeatsSheetsService := services.NewDiffBeatsSheetsService(selectBeatsSheetService)
+	restoreBeatsSheetService := services.NewRestoreBeatsSheetService(
+		services.NewRestoreBeatsSheetServiceSource(
+			restoreBeatsSheetDAO,
+			selectBeatsSheetService,
+		),
+	)
+	restoreLoglineService := services.NewRestoreLoglineService(restoreLoglineDAO)
+	restoreLoglineRevisionService := services.NewRestoreLoglineRevisionService(
+		services.NewRestoreLoglineRevisionServiceSource(
+			selectLoglineRevisionDAO,
+			selectLoglineDAO,
+			updateLoglineDAO,
+		),
+	)
+	searchService := services.NewSearchService(searchDAO)
+	seedStoryPlansService := services.NewSeedStoryPlansService(
+		services.NewSeedStoryPlansServiceSource(
+			insertStoryPlanDAO,
+			selectStoryPlanBySlugDAO,
+			updateStoryPlanDAO,
+			updateStoryPlanSeedHashDAO,
 		),
 	)
 	selectLoglineService := services.NewSelectLoglineService(
@@ -145,81 +323,147 @@ func App[Otel otel.Config, Pg postgres.Config](
 			selectLoglineBySlugDAO,
 		),
 	)
+	selectLoglineRevisionService := services.NewSelectLoglineRevisionService(
+		services.NewSelectLoglineRevisionServiceSource(
+			selectLoglineRevisionDAO,
+			selectLoglineDAO,
+		),
+	)
+	selectProjectService := services.NewSelectProjectService(selectProjectDAO)
+	diffLoglineRevisionsService := services.NewDiffLoglineRevisionsService(selectLoglineRevisionService)
+	translateBeatsSheetService := services.NewTranslateBeatsSheetService(
+		services.NewTranslateBeatsSheetServiceSource(
+			selectBeatsSheetDAO,
+			selectLoglineDAO,
+			selectStoryPlanService,
+			translateBeatsSheetDAO,
+			insertBeatsSheetDAO,
+		),
+	)
+	translateLoglineService := services.NewTranslateLoglineService(
+		services.NewTranslateLoglineServiceSource(
+			selectLoglineDAO,
+			translateLoglineDAO,
+			insertLoglineDAO,
+			selectSlugIterationDAO,
+		),
+	)
+	updateBeatService := services.NewUpdateBeatService(
+		services.NewUpdateBeatServiceSource(
+			insertBeatsSheetDAO,
+			selectBeatsSheetDAO,
+			selectLoglineDAO,
+			selectStoryPlanService,
+		),
+	)
+	updateLoglineService := services.NewUpdateLoglineService(
+		services.NewUpdateLoglineServiceSource(
+			selectLoglineDAO,
+			updateLoglineDAO,
+			selectSlugIterationDAO,
+			selectProjectDAO,
+		),
+	)
+	updateProjectService := services.NewUpdateProjectService(
+		services.NewUpdateProjectServiceSource(
+			selectProjectDAO,
+			updateProjectDAO,
+		),
+	)
+	updateStoryPlanService := services.NewUpdateStoryPlanService(
+		services.NewUpdateStoryPlanServiceSource(
+			selectStoryPlanDAO,
+			listStoryPlanTranslationsDAO,
+			updateStoryPlanDAO,
+		),
+	)
+	upgradeBeatsSheetsService := services.NewUpgradeBeatsSheetsService(
+		services.NewUpgradeBeatsSheetsServiceSource(
+			listOutdatedBeatsSheetsDAO,
+			selectStoryPlanService,
+			updateBeatsSheetStoryPlanDAO,
+		),
+	)
 
 	// =================================================================================================================
-	// SETUP ROUTER
+	// SEED
 	// =================================================================================================================
 
-	router := chi.NewRouter()
+	// Make sure the built-in story plans are available, so beats sheets can be generated on a fresh database.
+	_, err := seedStoryPlansService.SeedStoryPlans(ctx, services.SeedStoryPlansRequest{
+		Plans: storyplanmodel.DefaultPlans,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("seed story plans: %w", err)
+	}
 
-	router.Use(middleware.Recoverer)
-	router.Use(middleware.RealIP)
-	router.Use(middleware.Timeout(config.API.Timeouts.Request))
-	router.Use(middleware.RequestSize(config.API.MaxRequestSize))
-	router.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   config.API.Cors.AllowedOrigins,
-		AllowedHeaders:   config.API.Cors.AllowedHeaders,
-		AllowCredentials: config.API.Cors.AllowCredentials,
-		AllowedMethods: []string{
-			http.MethodHead,
-			http.MethodGet,
-			http.MethodPost,
-			http.MethodPut,
-			http.MethodPatch,
-			http.MethodDelete,
-		},
-		MaxAge: config.API.Cors.MaxAge,
-	}))
-	router.Use(config.Otel.HTTPHandler())
+	// =================================================================================================================
+	// HANDLER
+	// =================================================================================================================
+
+	return &api.API{
+		ConvertBeatsSheetService: convertBeatsSheetService,
 
-	handler := &api.API{
 		CreateBeatsSheetService: createBeatsSheetService,
 		CreateLoglineService:    createLoglineService,
+		CreateProjectService:    createProjectService,
+		CreateStoryPlanService:  createStoryPlanService,
+
+		CritiqueLoglineService: critiqueLoglineService,
+
+		DeleteBeatsSheetService: deleteBeatsSheetService,
+		DeleteLoglineService:    deleteLoglineService,
+		DeleteProjectService:    deleteProjectService,
+
+		DetectLangService: detectLangService,
+
+		DiffBeatsSheetsService:      diffBeatsSheetsService,
+		DiffLoglineRevisionsService: diffLoglineRevisionsService,
 
 		ExpandBeatService:    expandBeatService,
 		ExpandLoglineService: expandLoglineService,
 
-		GenerateBeatsSheetService: generateBeatsSheetService,
-		GenerateLoglinesService:   generateLoglinesService,
+		ForkStoryPlanService: forkStoryPlanService,
 
-		ListBeatsSheetsService: listBeatsSheetsService,
-		ListLoglinesService:    listLoglinesService,
+		GenerateBeatsSheetService:        generateBeatsSheetService,
+		GenerateLoglineVariationsService: generateLoglineVariationsService,
+		GenerateLoglinesService:          generateLoglinesService,
 
-		RegenerateBeatsService: regenerateBeatsService,
+		ListBeatsSheetsService:      listBeatsSheetsService,
+		ListLoglineRevisionsService: listLoglineRevisionsService,
+		ListLoglineTagsService:      listLoglineTagsService,
+		ListLoglinesService:         listLoglinesService,
+		ListProjectsService:         listProjectsService,
+		ListStoryPlansService:       listStoryPlansService,
 
-		SelectBeatsSheetService: selectBeatsSheetService,
-		SelectLoglineService:    selectLoglineService,
+		ListTrashedBeatsSheetsService: listTrashedBeatsSheetsService,
+		ListTrashedLoglinesService:    listTrashedLoglinesService,
 
-		JKClient:     jkClient,
-		OpenAIClient: &config.OpenAI,
-	}
+		RegenerateBeatsService: regenerateBeatsService,
 
-	securityHandler, err := api.NewSecurity(accessTokenVerifier, config.PermissionsConfig)
-	if err != nil {
-		return fmt.Errorf("create security handler: %w", err)
-	}
+		RestoreBeatsSheetService:      restoreBeatsSheetService,
+		RestoreLoglineService:         restoreLoglineService,
+		RestoreLoglineRevisionService: restoreLoglineRevisionService,
 
-	apiServer, err := apimodels.NewServer(handler, securityHandler)
-	if err != nil {
-		return fmt.Errorf("new api server: %w", err)
-	}
+		SearchService: searchService,
 
-	router.Mount("/v1/", http.StripPrefix("/v1", apiServer))
+		SelectBeatsSheetService:      selectBeatsSheetService,
+		SelectLoglineService:         selectLoglineService,
+		SelectLoglineRevisionService: selectLoglineRevisionService,
+		SelectProjectService:         selectProjectService,
+		SelectStoryPlanService:       selectStoryPlanService,
 
-	httpServer := &http.Server{
-		Addr:              ":" + strconv.Itoa(config.API.Port),
-		Handler:           router,
-		ReadTimeout:       config.API.Timeouts.Read,
-		ReadHeaderTimeout: config.API.Timeouts.ReadHeader,
-		WriteTimeout:      config.API.Timeouts.Write,
-		IdleTimeout:       config.API.Timeouts.Idle,
-		BaseContext:       func(_ net.Listener) context.Context { return ctx },
-	}
+		TranslateBeatsSheetService: translateBeatsSheetService,
+		TranslateLoglineService:    translateLoglineService,
 
-	err = httpServer.ListenAndServe()
-	if err != nil && !errors.Is(err, http.ErrServerClosed) {
-		return fmt.Errorf("listen and serve: %w", err)
-	}
+		UpdateBeatService:      updateBeatService,
+		UpdateLoglineService:   updateLoglineService,
+		UpdateProjectService:   updateProjectService,
+		UpdateStoryPlanService: updateStoryPlanService,
 
-	return nil
+		UpgradeBeatsSheetsService: upgradeBeatsSheetsService,
+
+		JKClient:     jkClient,
+		OpenAIClient: &config.OpenAI,
+	}, nil
 }
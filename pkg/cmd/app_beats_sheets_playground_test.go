@@ -94,6 +94,27 @@ func testAppBeatsSheetsPlayground(ctx context.Context, t *testing.T, appConfig T
 		beatsSheet.Content = generatedBeatsSheet.Content
 	}
 
+	t.Log("GenerateBeatsSheet/StoryPlanLangMismatch")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		storyPlanFR, err := ogen.MustGetResponse[apimodels.GetStoryPlanRes, *apimodels.StoryPlan](
+			client.GetStoryPlan(t.Context(), apimodels.GetStoryPlanParams{
+				Lang: apimodels.NewOptLang(apimodels.LangFr),
+			}),
+		)
+		require.NoError(t, err)
+
+		_, err = ogen.MustGetResponse[apimodels.GenerateBeatsSheetRes, *apimodels.UnprocessableEntityError](
+			client.GenerateBeatsSheet(t.Context(), &apimodels.GenerateBeatsSheetForm{
+				LoglineID:   logline.ID,
+				StoryPlanID: apimodels.NewOptStoryPlanID(storyPlanFR.ID),
+				Lang:        apimodels.LangEn,
+			}),
+		)
+		require.NoError(t, err)
+	}
+
 	t.Log("CreateBeatsSheet")
 	{
 		security.SetToken(userLambdaAccessToken)
@@ -110,6 +131,12 @@ func testAppBeatsSheetsPlayground(ctx context.Context, t *testing.T, appConfig T
 		require.NotEmpty(t, newBeatsSheet.GetID())
 		require.Equal(t, logline.ID, newBeatsSheet.GetLoglineID())
 		require.Equal(t, beatsSheet.Content, newBeatsSheet.GetContent())
+		require.True(t, newBeatsSheet.GetStoryPlanID().IsSet())
+
+		// The default plan groups its beats into acts.
+		require.Len(t, newBeatsSheet.GetActs(), 4)
+		require.Equal(t, "act1", newBeatsSheet.GetActs()[0].GetKey())
+		require.Equal(t, "openingImage", newBeatsSheet.GetActs()[0].GetBeats()[0])
 
 		*beatsSheet = *newBeatsSheet
 	}
@@ -166,6 +193,77 @@ func testAppBeatsSheetsPlayground(ctx context.Context, t *testing.T, appConfig T
 		*beatsSheet = *newBeatsSheet
 	}
 
+	t.Log("ExpandBeat/Apply")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		appliedBeatsSheet, err := ogen.MustGetResponse[apimodels.ExpandBeatRes, *apimodels.BeatsSheet](
+			client.ExpandBeat(t.Context(), &apimodels.ExpandBeatForm{
+				BeatsSheetID: beatsSheet.ID,
+				TargetKey:    "catalyst",
+				Apply:        apimodels.NewOptBool(true),
+			}),
+		)
+		require.NoError(t, err)
+
+		require.NotEqual(t, beatsSheet.ID, appliedBeatsSheet.GetID())
+		require.Equal(t, apimodels.NewOptBeatsSheetID(beatsSheet.ID), appliedBeatsSheet.GetParentID())
+		require.Equal(t, beatsSheet.StoryPlanID, appliedBeatsSheet.GetStoryPlanID())
+		require.Len(t, appliedBeatsSheet.GetContent(), len(beatsSheet.Content))
+
+		*beatsSheet = *appliedBeatsSheet
+	}
+
+	t.Log("GetBeatsSheetDiff")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		diff, err := ogen.MustGetResponse[apimodels.GetBeatsSheetDiffRes, *apimodels.BeatsSheetDiff](
+			client.GetBeatsSheetDiff(t.Context(), apimodels.GetBeatsSheetDiffParams{
+				From: beatsSheet.ParentID.Value,
+				To:   beatsSheet.ID,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Len(t, diff.GetBeats(), len(beatsSheet.Content))
+
+		// Only the expanded beat changed.
+		for _, beat := range diff.GetBeats() {
+			require.Equal(t, apimodels.BeatDiffStatusKept, beat.GetStatus())
+			require.False(t, beat.GetMoved())
+			require.Equal(t, beat.GetKey() == "catalyst", beat.GetModified())
+		}
+	}
+
+	t.Log("UpdateBeat")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		updatedBeatsSheet, err := ogen.MustGetResponse[apimodels.UpdateBeatRes, *apimodels.BeatsSheet](
+			client.UpdateBeat(t.Context(), &apimodels.UpdateBeatForm{
+				BeatsSheetID: beatsSheet.ID,
+				Key:          "catalyst",
+				Title:        apimodels.NewOptString("A call to adventure"),
+			}),
+		)
+		require.NoError(t, err)
+
+		require.NotEqual(t, beatsSheet.ID, updatedBeatsSheet.GetID())
+		require.Equal(t, apimodels.NewOptBeatsSheetID(beatsSheet.ID), updatedBeatsSheet.GetParentID())
+
+		for i, beat := range updatedBeatsSheet.GetContent() {
+			if beat.GetKey() == "catalyst" {
+				require.Equal(t, "A call to adventure", beat.GetTitle())
+				require.Equal(t, beatsSheet.Content[i].GetContent(), beat.GetContent())
+			} else {
+				require.Equal(t, beatsSheet.Content[i], beat)
+			}
+		}
+
+		*beatsSheet = *updatedBeatsSheet
+	}
+
 	t.Log("GetBeatsSheet")
 	{
 		security.SetToken(userLambdaAccessToken)
@@ -178,22 +276,113 @@ func testAppBeatsSheetsPlayground(ctx context.Context, t *testing.T, appConfig T
 		require.Equal(t, beatsSheet, newBeatsSheet)
 	}
 
+	t.Log("ConvertBeatsSheet")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		storyPlan, err := ogen.MustGetResponse[apimodels.GetStoryPlanRes, *apimodels.StoryPlan](
+			client.GetStoryPlan(t.Context(), apimodels.GetStoryPlanParams{
+				Slug: apimodels.NewOptSlug("kishotenketsu"),
+				Lang: apimodels.NewOptLang(apimodels.LangEn),
+			}),
+		)
+		require.NoError(t, err)
+
+		convertedBeatsSheet, err := ogen.MustGetResponse[apimodels.ConvertBeatsSheetRes, *apimodels.BeatsSheet](
+			client.ConvertBeatsSheet(t.Context(), &apimodels.ConvertBeatsSheetForm{
+				BeatsSheetID: beatsSheet.ID,
+				StoryPlanID:  storyPlan.ID,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.NotEqual(t, beatsSheet.ID, convertedBeatsSheet.GetID())
+		require.Equal(t, logline.ID, convertedBeatsSheet.GetLoglineID())
+		require.Equal(t, apimodels.NewOptStoryPlanID(storyPlan.ID), convertedBeatsSheet.GetStoryPlanID())
+		require.Equal(t, apimodels.NewOptBeatsSheetID(beatsSheet.ID), convertedBeatsSheet.GetSourceID())
+		require.Len(t, convertedBeatsSheet.GetContent(), len(storyPlan.GetBeats()))
+
+		*beatsSheet = *convertedBeatsSheet
+	}
+
 	t.Log("ListBeatsSheets")
 	{
 		security.SetToken(userLambdaAccessToken)
 
 		beatsSheets, err := ogen.MustGetResponse[
-			apimodels.GetBeatsSheetsRes, *apimodels.GetBeatsSheetsOKApplicationJSON,
+			apimodels.GetBeatsSheetsRes, *apimodels.BeatsSheetsPage,
 		](
 			client.GetBeatsSheets(t.Context(), apimodels.GetBeatsSheetsParams{LoglineID: logline.ID}),
 		)
 		require.NoError(t, err)
 
-		require.Len(t, *beatsSheets, 3)
+		require.Len(t, beatsSheets.Items, 6)
+		require.False(t, beatsSheets.NextCursor.IsSet())
 		require.Equal(t, apimodels.BeatsSheetPreview{
 			ID:        beatsSheet.ID,
 			Lang:      beatsSheet.Lang,
 			CreatedAt: beatsSheet.CreatedAt,
-		}, (*beatsSheets)[0])
+		}, beatsSheets.Items[0])
+
+		ids := make([]apimodels.BeatsSheetID, 0, len(beatsSheets.Items))
+
+		for item, err := range pkg.IterBeatsSheets(t.Context(), client, apimodels.GetBeatsSheetsParams{
+			LoglineID: logline.ID,
+			Limit:     apimodels.NewOptInt(4),
+		}) {
+			require.NoError(t, err)
+
+			ids = append(ids, item.ID)
+		}
+
+		require.Equal(t, lo.Map(beatsSheets.Items, func(item apimodels.BeatsSheetPreview, _ int) apimodels.BeatsSheetID {
+			return item.ID
+		}), ids)
+	}
+
+	t.Log("TranslateLogline")
+
+	translatedLogline := new(apimodels.Logline)
+
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		res, err := ogen.MustGetResponse[apimodels.TranslateLoglineRes, *apimodels.Logline](
+			client.TranslateLogline(t.Context(), &apimodels.TranslateLoglineForm{
+				LoglineID: logline.ID,
+				Lang:      apimodels.LangFr,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.NotEqual(t, logline.ID, res.GetID())
+		require.Equal(t, apimodels.NewOptLoglineID(logline.ID), res.GetSourceID())
+		require.Equal(t, apimodels.LangFr, res.GetLang())
+
+		*translatedLogline = *res
+	}
+
+	t.Log("TranslateBeatsSheet")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		translatedBeatsSheet, err := ogen.MustGetResponse[apimodels.TranslateBeatsSheetRes, *apimodels.BeatsSheet](
+			client.TranslateBeatsSheet(t.Context(), &apimodels.TranslateBeatsSheetForm{
+				BeatsSheetID: beatsSheet.ID,
+				Lang:         apimodels.LangFr,
+				LoglineID:    apimodels.NewOptLoglineID(translatedLogline.ID),
+			}),
+		)
+		require.NoError(t, err)
+
+		require.NotEqual(t, beatsSheet.ID, translatedBeatsSheet.GetID())
+		require.Equal(t, translatedLogline.ID, translatedBeatsSheet.GetLoglineID())
+		require.Equal(t, apimodels.NewOptBeatsSheetID(beatsSheet.ID), translatedBeatsSheet.GetSourceID())
+		require.Equal(t, apimodels.LangFr, translatedBeatsSheet.GetLang())
+		require.Equal(
+			t,
+			lo.Map(beatsSheet.GetContent(), func(item apimodels.Beat, _ int) string { return item.Key }),
+			lo.Map(translatedBeatsSheet.GetContent(), func(item apimodels.Beat, _ int) string { return item.Key }),
+		)
 	}
 }
@@ -76,6 +76,71 @@ func testAppLoglinesPlayground(ctx context.Context, t *testing.T, appConfig Test
 		*loglineIdea = *expandedIdea
 	}
 
+	t.Log("CritiqueLogline")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		critique, err := ogen.MustGetResponse[apimodels.CritiqueLoglineRes, *apimodels.LoglineCritique](
+			client.CritiqueLogline(t.Context(), &apimodels.CritiqueLoglineForm{
+				Logline: apimodels.NewOptLoglineIdea(*loglineIdea),
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, apimodels.LangEn, critique.GetLang())
+	}
+
+	t.Log("GenerateLoglineVariations")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		variations, err := ogen.MustGetResponse[
+			apimodels.GenerateLoglineVariationsRes, *apimodels.GenerateLoglineVariationsOKApplicationJSON,
+		](
+			client.GenerateLoglineVariations(t.Context(), &apimodels.GenerateLoglineVariationsForm{
+				Logline:    apimodels.NewOptLoglineIdea(*loglineIdea),
+				Count:      2,
+				Dimensions: []apimodels.LoglineDimension{apimodels.LoglineDimensionSetting},
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Len(t, *variations, 2)
+
+		for _, variation := range *variations {
+			require.Equal(t, apimodels.LoglineDimensionSetting, variation.GetDimension())
+		}
+	}
+
+	t.Log("DetectLang")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		detection, err := ogen.MustGetResponse[apimodels.DetectLangRes, *apimodels.LangDetection](
+			client.DetectLang(t.Context(), &apimodels.DetectLangForm{
+				Text: loglineIdea.Name + "\n\n" + loglineIdea.Content,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, apimodels.NewOptLang(apimodels.LangEn), detection.GetLang())
+	}
+
+	t.Log("CreateLogline/LangMismatch")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		_, err = ogen.MustGetResponse[apimodels.CreateLoglineRes, *apimodels.UnprocessableEntityError](
+			client.CreateLogline(t.Context(), &apimodels.CreateLoglineForm{
+				Slug:    apimodels.Slug(loglineSlug),
+				Name:    loglineIdea.Name,
+				Content: loglineIdea.Content,
+				Lang:    apimodels.LangFr,
+			}),
+		)
+		require.NoError(t, err)
+	}
+
 	t.Log("CreateLoglineNotAllowed")
 	{
 		security.SetToken(userAnonAccessToken)
@@ -167,29 +232,51 @@ func testAppLoglinesPlayground(ctx context.Context, t *testing.T, appConfig Test
 	{
 		security.SetToken(userLambdaAccessToken)
 
-		userLoglines, err := ogen.MustGetResponse[apimodels.GetLoglinesRes, *apimodels.GetLoglinesOKApplicationJSON](
+		userLoglines, err := ogen.MustGetResponse[apimodels.GetLoglinesRes, *apimodels.LoglinesPage](
 			client.GetLoglines(t.Context(), apimodels.GetLoglinesParams{}),
 		)
 		require.NoError(t, err)
 
-		require.Equal(t, &apimodels.GetLoglinesOKApplicationJSON{
-			{
-				Slug:      loglines[1].Slug,
-				Name:      loglines[1].Name,
-				Content:   loglines[1].Content,
-				Lang:      apimodels.LangEn,
-				CreatedAt: loglines[1].CreatedAt,
-			},
-			{
-				Slug:      loglines[0].Slug,
-				Name:      loglines[0].Name,
-				Content:   loglines[0].Content,
-				Lang:      apimodels.LangEn,
-				CreatedAt: loglines[0].CreatedAt,
+		require.Equal(t, &apimodels.LoglinesPage{
+			Items: []apimodels.LoglinePreview{
+				{
+					Slug:      loglines[1].Slug,
+					Name:      loglines[1].Name,
+					Content:   loglines[1].Content,
+					Lang:      apimodels.LangEn,
+					Tags:      apimodels.Tags{},
+					CreatedAt: loglines[1].CreatedAt,
+				},
+				{
+					Slug:      loglines[0].Slug,
+					Name:      loglines[0].Name,
+					Content:   loglines[0].Content,
+					Lang:      apimodels.LangEn,
+					Tags:      apimodels.Tags{},
+					CreatedAt: loglines[0].CreatedAt,
+				},
 			},
 		}, userLoglines)
 	}
 
+	t.Log("ListLoglines/Paginate")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		slugs := make([]apimodels.Slug, 0, 2)
+
+		for item, err := range pkg.IterLoglines(t.Context(), client, apimodels.GetLoglinesParams{
+			Sort:  apimodels.NewOptLoglinesSort(apimodels.LoglinesSortOldest),
+			Limit: apimodels.NewOptInt(1),
+		}) {
+			require.NoError(t, err)
+
+			slugs = append(slugs, item.Slug)
+		}
+
+		require.Equal(t, []apimodels.Slug{loglines[0].Slug, loglines[1].Slug}, slugs)
+	}
+
 	t.Log("NewUserLogline")
 	{
 		security.SetToken(userLambda2AccessToken)
@@ -216,19 +303,337 @@ func testAppLoglinesPlayground(ctx context.Context, t *testing.T, appConfig Test
 	{
 		security.SetToken(userLambda2AccessToken)
 
-		userLoglines, err := ogen.MustGetResponse[apimodels.GetLoglinesRes, *apimodels.GetLoglinesOKApplicationJSON](
+		userLoglines, err := ogen.MustGetResponse[apimodels.GetLoglinesRes, *apimodels.LoglinesPage](
 			client.GetLoglines(t.Context(), apimodels.GetLoglinesParams{}),
 		)
 		require.NoError(t, err)
 
-		require.Equal(t, &apimodels.GetLoglinesOKApplicationJSON{
-			{
-				Slug:      loglines[2].Slug,
-				Name:      loglines[2].Name,
-				Content:   loglines[2].Content,
-				CreatedAt: loglines[2].CreatedAt,
-				Lang:      apimodels.LangEn,
+		require.Equal(t, &apimodels.LoglinesPage{
+			Items: []apimodels.LoglinePreview{
+				{
+					Slug:      loglines[2].Slug,
+					Name:      loglines[2].Name,
+					Content:   loglines[2].Content,
+					CreatedAt: loglines[2].CreatedAt,
+					Lang:      apimodels.LangEn,
+					Tags:      apimodels.Tags{},
+				},
 			},
 		}, userLoglines)
 	}
+
+	t.Log("UpdateLogline/SlugResolution")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		// Renaming the alternate logline to the slug of the original one must resolve to a new iteration.
+		updatedLogline, err := ogen.MustGetResponse[apimodels.UpdateLoglineRes, *apimodels.Logline](
+			client.UpdateLogline(t.Context(), &apimodels.UpdateLoglineForm{
+				ID:   loglines[1].ID,
+				Slug: apimodels.NewOptSlug(apimodels.Slug(loglineSlug)),
+				Name: apimodels.NewOptString(loglineIdea.Name + " Fixed"),
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, loglines[1].ID, updatedLogline.ID)
+		require.Equal(t, loglineIdea.Name+" Fixed", updatedLogline.Name)
+		require.Equal(t, loglines[1].Content, updatedLogline.Content)
+		require.Equal(t, apimodels.Slug(loglineSlug+"-2"), updatedLogline.Slug)
+		require.Equal(t, loglines[1].CreatedAt, updatedLogline.CreatedAt)
+		require.True(t, updatedLogline.UpdatedAt.IsSet())
+	}
+
+	t.Log("UpdateLogline/OtherUser")
+	{
+		security.SetToken(userLambda2AccessToken)
+
+		_, err := ogen.MustGetResponse[apimodels.UpdateLoglineRes, *apimodels.NotFoundError](
+			client.UpdateLogline(t.Context(), &apimodels.UpdateLoglineForm{
+				ID:   loglines[0].ID,
+				Name: apimodels.NewOptString("Stolen"),
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	t.Log("LoglineTags")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		taggedLogline, err := ogen.MustGetResponse[apimodels.UpdateLoglineRes, *apimodels.Logline](
+			client.UpdateLogline(t.Context(), &apimodels.UpdateLoglineForm{
+				ID:    loglines[0].ID,
+				Genre: apimodels.NewOptNilGenre(apimodels.GenreAdventure),
+				Tags:  apimodels.Tags{"Sea", "revenge", "sea"},
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, apimodels.NewOptGenre(apimodels.GenreAdventure), taggedLogline.Genre)
+		require.Equal(t, apimodels.Tags{"revenge", "sea"}, taggedLogline.Tags)
+
+		_, err = ogen.MustGetResponse[apimodels.UpdateLoglineRes, *apimodels.Logline](
+			client.UpdateLogline(t.Context(), &apimodels.UpdateLoglineForm{
+				ID:   loglines[1].ID,
+				Tags: apimodels.Tags{"sea"},
+			}),
+		)
+		require.NoError(t, err)
+
+		filtered, err := ogen.MustGetResponse[apimodels.GetLoglinesRes, *apimodels.LoglinesPage](
+			client.GetLoglines(t.Context(), apimodels.GetLoglinesParams{
+				Tag: []string{"revenge", "sea"},
+			}),
+		)
+		require.NoError(t, err)
+		require.Len(t, filtered.Items, 1)
+		require.Equal(t, loglines[0].Slug, filtered.Items[0].Slug)
+
+		filtered, err = ogen.MustGetResponse[apimodels.GetLoglinesRes, *apimodels.LoglinesPage](
+			client.GetLoglines(t.Context(), apimodels.GetLoglinesParams{
+				Genre: apimodels.NewOptGenre(apimodels.GenreWestern),
+			}),
+		)
+		require.NoError(t, err)
+		require.Empty(t, filtered.Items)
+
+		tags, err := ogen.MustGetResponse[apimodels.GetLoglineTagsRes, *apimodels.GetLoglineTagsOKApplicationJSON](
+			client.GetLoglineTags(t.Context()),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, &apimodels.GetLoglineTagsOKApplicationJSON{
+			{Tag: "sea", Count: 2},
+			{Tag: "revenge", Count: 1},
+		}, tags)
+
+		// Other users do not see the tags.
+		security.SetToken(userLambda2AccessToken)
+
+		tags, err = ogen.MustGetResponse[apimodels.GetLoglineTagsRes, *apimodels.GetLoglineTagsOKApplicationJSON](
+			client.GetLoglineTags(t.Context()),
+		)
+		require.NoError(t, err)
+		require.Empty(t, *tags)
+	}
+
+	t.Log("Projects")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		project, err := ogen.MustGetResponse[apimodels.CreateProjectRes, *apimodels.Project](
+			client.CreateProject(t.Context(), &apimodels.CreateProjectForm{
+				Name:        "Playground Project",
+				Description: apimodels.NewOptString("Loglines of the playground."),
+			}),
+		)
+		require.NoError(t, err)
+
+		renamedProject, err := ogen.MustGetResponse[apimodels.UpdateProjectRes, *apimodels.Project](
+			client.UpdateProject(t.Context(), &apimodels.UpdateProjectForm{
+				ID:   project.ID,
+				Name: apimodels.NewOptString("Playground Project Renamed"),
+			}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, "Playground Project Renamed", renamedProject.Name)
+		require.Equal(t, project.Description, renamedProject.Description)
+
+		projects, err := ogen.MustGetResponse[apimodels.GetProjectsRes, *apimodels.ProjectsPage](
+			client.GetProjects(t.Context(), apimodels.GetProjectsParams{}),
+		)
+		require.NoError(t, err)
+		require.Len(t, projects.Items, 1)
+		require.Equal(t, project.ID, projects.Items[0].ID)
+		require.False(t, projects.NextCursor.IsSet())
+
+		movedLogline, err := ogen.MustGetResponse[apimodels.UpdateLoglineRes, *apimodels.Logline](
+			client.UpdateLogline(t.Context(), &apimodels.UpdateLoglineForm{
+				ID:        loglines[0].ID,
+				ProjectID: apimodels.NewOptNilProjectID(project.ID),
+			}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, apimodels.NewOptProjectID(project.ID), movedLogline.ProjectID)
+
+		filtered, err := ogen.MustGetResponse[apimodels.GetLoglinesRes, *apimodels.LoglinesPage](
+			client.GetLoglines(t.Context(), apimodels.GetLoglinesParams{
+				ProjectID: apimodels.NewOptProjectID(project.ID),
+			}),
+		)
+		require.NoError(t, err)
+		require.Len(t, filtered.Items, 1)
+		require.Equal(t, loglines[0].Slug, filtered.Items[0].Slug)
+
+		// Projects of other users cannot be seen, nor used.
+		security.SetToken(userLambda2AccessToken)
+
+		_, err = ogen.MustGetResponse[apimodels.GetProjectRes, *apimodels.NotFoundError](
+			client.GetProject(t.Context(), apimodels.GetProjectParams{ProjectID: project.ID}),
+		)
+		require.NoError(t, err)
+
+		_, err = ogen.MustGetResponse[apimodels.CreateLoglineRes, *apimodels.NotFoundError](
+			client.CreateLogline(t.Context(), &apimodels.CreateLoglineForm{
+				Slug:      apimodels.Slug(loglineSlug),
+				Name:      loglineIdea.Name,
+				Content:   loglineIdea.Content,
+				Lang:      apimodels.LangEn,
+				ProjectID: apimodels.NewOptProjectID(project.ID),
+			}),
+		)
+		require.NoError(t, err)
+
+		// Deleting the project keeps its loglines.
+		security.SetToken(userLambdaAccessToken)
+
+		_, err = ogen.MustGetResponse[apimodels.DeleteProjectRes, *apimodels.Project](
+			client.DeleteProject(t.Context(), apimodels.DeleteProjectParams{ProjectID: project.ID}),
+		)
+		require.NoError(t, err)
+
+		logline, err := ogen.MustGetResponse[apimodels.GetLoglineRes, *apimodels.Logline](
+			client.GetLogline(t.Context(), apimodels.GetLoglineParams{
+				ID: apimodels.NewOptLoglineID(loglines[0].ID),
+			}),
+		)
+		require.NoError(t, err)
+		require.False(t, logline.ProjectID.IsSet())
+	}
+
+	t.Log("LoglineRevisions")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		revisions, err := ogen.MustGetResponse[
+			apimodels.GetLoglineRevisionsRes, *apimodels.GetLoglineRevisionsOKApplicationJSON,
+		](
+			client.GetLoglineRevisions(t.Context(), apimodels.GetLoglineRevisionsParams{LoglineID: loglines[1].ID}),
+		)
+		require.NoError(t, err)
+
+		// One revision for the creation, and one for the edit.
+		require.Len(t, *revisions, 2)
+		require.Equal(t, loglineIdea.Name+" Fixed", (*revisions)[0].Name)
+		require.Equal(t, loglines[1].Name, (*revisions)[1].Name)
+
+		diff, err := ogen.MustGetResponse[apimodels.GetLoglineRevisionDiffRes, *apimodels.LoglineRevisionDiff](
+			client.GetLoglineRevisionDiff(t.Context(), apimodels.GetLoglineRevisionDiffParams{
+				From: (*revisions)[1].ID,
+				To:   (*revisions)[0].ID,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Contains(t, diff.Name, apimodels.DiffChunk{Op: apimodels.DiffOpInsert, Text: " Fixed"})
+		require.Equal(t, []apimodels.DiffChunk{
+			{Op: apimodels.DiffOpEqual, Text: loglines[1].Content},
+		}, diff.Content)
+
+		restoredLogline, err := ogen.MustGetResponse[apimodels.RestoreLoglineRevisionRes, *apimodels.Logline](
+			client.RestoreLoglineRevision(t.Context(), &apimodels.RestoreLoglineRevisionForm{
+				ID: (*revisions)[1].ID,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, loglines[1].ID, restoredLogline.ID)
+		require.Equal(t, loglines[1].Name, restoredLogline.Name)
+		require.Equal(t, apimodels.Slug(loglineSlug+"-2"), restoredLogline.Slug)
+
+		security.SetToken(userLambda2AccessToken)
+
+		_, err = ogen.MustGetResponse[apimodels.GetLoglineRevisionRes, *apimodels.NotFoundError](
+			client.GetLoglineRevision(t.Context(), apimodels.GetLoglineRevisionParams{
+				RevisionID: (*revisions)[0].ID,
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	t.Log("DeleteLogline")
+	{
+		security.SetToken(userLambda2AccessToken)
+
+		deletedLogline, err := ogen.MustGetResponse[apimodels.DeleteLoglineRes, *apimodels.Logline](
+			client.DeleteLogline(t.Context(), apimodels.DeleteLoglineParams{LoglineID: loglines[2].ID}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, loglines[2].ID, deletedLogline.ID)
+		require.True(t, deletedLogline.DeletedAt.IsSet())
+
+		_, err = ogen.MustGetResponse[apimodels.GetLoglineRes, *apimodels.NotFoundError](
+			client.GetLogline(t.Context(), apimodels.GetLoglineParams{
+				ID: apimodels.NewOptLoglineID(loglines[2].ID),
+			}),
+		)
+		require.NoError(t, err)
+
+		userLoglines, err := ogen.MustGetResponse[apimodels.GetLoglinesRes, *apimodels.LoglinesPage](
+			client.GetLoglines(t.Context(), apimodels.GetLoglinesParams{}),
+		)
+		require.NoError(t, err)
+		require.Empty(t, userLoglines.Items)
+	}
+
+	t.Log("ListTrashedLoglines")
+	{
+		security.SetToken(userLambda2AccessToken)
+
+		trashedLoglines, err := ogen.MustGetResponse[
+			apimodels.GetTrashedLoglinesRes, *apimodels.GetTrashedLoglinesOKApplicationJSON,
+		](
+			client.GetTrashedLoglines(t.Context(), apimodels.GetTrashedLoglinesParams{}),
+		)
+		require.NoError(t, err)
+
+		require.Len(t, *trashedLoglines, 1)
+		require.Equal(t, loglines[2].ID, (*trashedLoglines)[0].ID)
+	}
+
+	t.Log("RestoreLogline/OtherUser")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		_, err := ogen.MustGetResponse[apimodels.RestoreLoglineRes, *apimodels.NotFoundError](
+			client.RestoreLogline(t.Context(), &apimodels.RestoreLoglineForm{ID: loglines[2].ID}),
+		)
+		require.NoError(t, err)
+	}
+
+	t.Log("RestoreLogline")
+	{
+		security.SetToken(userLambda2AccessToken)
+
+		restoredLogline, err := ogen.MustGetResponse[apimodels.RestoreLoglineRes, *apimodels.Logline](
+			client.RestoreLogline(t.Context(), &apimodels.RestoreLoglineForm{ID: loglines[2].ID}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, loglines[2].ID, restoredLogline.ID)
+		require.False(t, restoredLogline.DeletedAt.IsSet())
+
+		_, err = ogen.MustGetResponse[apimodels.GetLoglineRes, *apimodels.Logline](
+			client.GetLogline(t.Context(), apimodels.GetLoglineParams{
+				ID: apimodels.NewOptLoglineID(loglines[2].ID),
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	t.Log("Search")
+	{
+		security.SetToken(userLambda2AccessToken)
+
+		hits, err := ogen.MustGetResponse[apimodels.SearchRes, *apimodels.SearchOKApplicationJSON](
+			client.Search(t.Context(), apimodels.SearchParams{Query: loglines[2].Name}),
+		)
+		require.NoError(t, err)
+
+		require.True(t, lo.ContainsBy(*hits, func(item apimodels.SearchHit) bool {
+			return item.Kind == apimodels.SearchHitKindLogline && item.ID == uuid.UUID(loglines[2].ID)
+		}))
+	}
 }
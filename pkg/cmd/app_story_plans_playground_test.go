@@ -0,0 +1,662 @@
+package cmdpkg_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/golib/ogen"
+	authmodels "github.com/a-novel/service-authentication/models"
+
+	"github.com/a-novel/service-story-schematics/models"
+	apimodels "github.com/a-novel/service-story-schematics/models/api"
+	storyplanmodel "github.com/a-novel/service-story-schematics/models/story_plan"
+	"github.com/a-novel/service-story-schematics/pkg"
+)
+
+func testAppStoryPlansPlayground(ctx context.Context, t *testing.T, appConfig TestConfig) {
+	t.Helper()
+
+	security := pkg.NewBearerSource()
+
+	client, err := pkg.NewAPIClient(ctx, fmt.Sprintf("http://localhost:%v/v1", appConfig.API.Port), security)
+	require.NoError(t, err)
+
+	storyPlanSlug := "story-plan-playground-integration-test"
+
+	storyPlans := make([]*apimodels.StoryPlan, 0)
+
+	userLambdaClaims := authmodels.AccessTokenClaims{
+		UserID: lo.ToPtr(uuid.New()),
+		Roles:  []authmodels.Role{authmodels.RoleUser},
+	}
+	userAdminClaims := authmodels.AccessTokenClaims{
+		UserID: lo.ToPtr(uuid.New()),
+		Roles:  []authmodels.Role{authmodels.RoleAdmin},
+	}
+
+	userLambdaAccessToken := getAccessToken(t, appConfig, userLambdaClaims)
+	userAdminAccessToken := getAccessToken(t, appConfig, userAdminClaims)
+
+	beats := apimodels.StoryPlanBeats{
+		{
+			Name:      "Beginning",
+			Key:       "beginning",
+			KeyPoints: []string{"Introduce the protagonist."},
+			Purpose:   "Set the stage.",
+			Scenes:    apimodels.StoryPlanScenes{Exact: apimodels.NewOptInt(1)},
+		},
+		{
+			Name:      "End",
+			Key:       "end",
+			KeyPoints: []string{"Resolve the conflict."},
+			Purpose:   "Close the story.",
+			Scenes:    apimodels.StoryPlanScenes{Min: apimodels.NewOptInt(1), Max: apimodels.NewOptInt(3)},
+		},
+	}
+
+	t.Log("GetDefaultStoryPlan")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		storyPlan, err := ogen.MustGetResponse[apimodels.GetStoryPlanRes, *apimodels.StoryPlan](
+			client.GetStoryPlan(t.Context(), apimodels.GetStoryPlanParams{
+				Lang: apimodels.NewOptLang(apimodels.LangFr),
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, apimodels.Slug(storyplanmodel.DefaultSlug), storyPlan.Slug)
+		require.Equal(t, apimodels.LangFr, storyPlan.Lang)
+		require.Len(t, storyPlan.Beats, len(storyplanmodel.SaveTheCat[models.LangFR].Beats))
+	}
+
+	t.Log("GetDefaultStoryPlan/AllLangs")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		for _, lang := range models.Langs {
+			storyPlan, err := ogen.MustGetResponse[apimodels.GetStoryPlanRes, *apimodels.StoryPlan](
+				client.GetStoryPlan(t.Context(), apimodels.GetStoryPlanParams{
+					Lang: apimodels.NewOptLang(apimodels.Lang(lang)),
+				}),
+			)
+			require.NoError(t, err)
+
+			require.Equal(t, apimodels.Slug(storyplanmodel.DefaultSlug), storyPlan.Slug)
+			require.Equal(t, apimodels.Lang(lang), storyPlan.Lang)
+		}
+	}
+
+	t.Log("CreateStoryPlanNotAllowed")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		_, err = ogen.MustGetResponse[apimodels.CreateStoryPlanRes, *apimodels.ForbiddenError](
+			client.CreateStoryPlan(t.Context(), &apimodels.CreateStoryPlanForm{
+				Slug:  apimodels.Slug(storyPlanSlug),
+				Name:  "Playground Plan",
+				Lang:  apimodels.LangEn,
+				Beats: beats,
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	t.Log("CreateStoryPlan")
+	{
+		security.SetToken(userAdminAccessToken)
+
+		storyPlan, err := ogen.MustGetResponse[apimodels.CreateStoryPlanRes, *apimodels.StoryPlan](
+			client.CreateStoryPlan(t.Context(), &apimodels.CreateStoryPlanForm{
+				Slug:  apimodels.Slug(storyPlanSlug),
+				Name:  "Playground Plan",
+				Lang:  apimodels.LangEn,
+				Beats: beats,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, apimodels.Slug(storyPlanSlug), storyPlan.Slug)
+		require.Equal(t, "Playground Plan", storyPlan.Name)
+		require.Equal(t, beats, storyPlan.Beats)
+
+		storyPlans = append(storyPlans, storyPlan)
+	}
+
+	t.Log("CreateStoryPlan/Conflict")
+	{
+		security.SetToken(userAdminAccessToken)
+
+		_, err = ogen.MustGetResponse[apimodels.CreateStoryPlanRes, *apimodels.ConflictError](
+			client.CreateStoryPlan(t.Context(), &apimodels.CreateStoryPlanForm{
+				Slug:  apimodels.Slug(storyPlanSlug),
+				Name:  "Playground Plan Duplicate",
+				Lang:  apimodels.LangEn,
+				Beats: beats,
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	t.Log("UpdateStoryPlanNotAllowed")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		_, err = ogen.MustGetResponse[apimodels.UpdateStoryPlanRes, *apimodels.ForbiddenError](
+			client.UpdateStoryPlan(t.Context(), &apimodels.UpdateStoryPlanForm{
+				ID:    storyPlans[0].ID,
+				Name:  "Playground Plan Updated",
+				Beats: beats[:1],
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	logline := new(apimodels.Logline)
+
+	t.Log("CreateLogline")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		newLogline, err := ogen.MustGetResponse[apimodels.CreateLoglineRes, *apimodels.Logline](
+			client.CreateLogline(t.Context(), &apimodels.CreateLoglineForm{
+				Slug:    apimodels.Slug(storyPlanSlug),
+				Name:    "Playground Logline",
+				Content: "A protagonist faces a conflict, and resolves it.",
+				Lang:    apimodels.LangEn,
+			}),
+		)
+		require.NoError(t, err)
+
+		*logline = *newLogline
+	}
+
+	beatsSheet := new(apimodels.BeatsSheet)
+
+	t.Log("CreateBeatsSheet")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		newBeatsSheet, err := ogen.MustGetResponse[apimodels.CreateBeatsSheetRes, *apimodels.BeatsSheet](
+			client.CreateBeatsSheet(t.Context(), &apimodels.CreateBeatsSheetForm{
+				LoglineID:   logline.ID,
+				StoryPlanID: apimodels.NewOptStoryPlanID(storyPlans[0].ID),
+				Content: []apimodels.Beat{
+					{Key: "beginning", Title: "Beginning", Content: "The protagonist is introduced."},
+					{Key: "end", Title: "End", Content: "The conflict is resolved."},
+				},
+				Lang: apimodels.LangEn,
+			}),
+		)
+		require.NoError(t, err)
+
+		*beatsSheet = *newBeatsSheet
+	}
+
+	t.Log("UpdateStoryPlan")
+	{
+		security.SetToken(userAdminAccessToken)
+
+		storyPlan, err := ogen.MustGetResponse[apimodels.UpdateStoryPlanRes, *apimodels.StoryPlan](
+			client.UpdateStoryPlan(t.Context(), &apimodels.UpdateStoryPlanForm{
+				ID:    storyPlans[0].ID,
+				Name:  "Playground Plan Updated",
+				Beats: beats[:1],
+			}),
+		)
+		require.NoError(t, err)
+
+		// Plans are immutable: a new version is created.
+		require.NotEqual(t, storyPlans[0].ID, storyPlan.ID)
+		require.Equal(t, storyPlans[0].Slug, storyPlan.Slug)
+		require.Equal(t, 2, storyPlan.Version)
+		require.Equal(t, "Playground Plan Updated", storyPlan.Name)
+		require.Equal(t, beats[:1], storyPlan.Beats)
+
+		storyPlans = append(storyPlans, storyPlan)
+	}
+
+	t.Log("GetStoryPlanByID")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		storyPlan, err := ogen.MustGetResponse[apimodels.GetStoryPlanRes, *apimodels.StoryPlan](
+			client.GetStoryPlan(t.Context(), apimodels.GetStoryPlanParams{
+				ID: apimodels.NewOptStoryPlanID(storyPlans[1].ID),
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, storyPlans[1], storyPlan)
+	}
+
+	t.Log("GetStoryPlanByID/PreviousVersion")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		storyPlan, err := ogen.MustGetResponse[apimodels.GetStoryPlanRes, *apimodels.StoryPlan](
+			client.GetStoryPlan(t.Context(), apimodels.GetStoryPlanParams{
+				ID: apimodels.NewOptStoryPlanID(storyPlans[0].ID),
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, storyPlans[0], storyPlan)
+	}
+
+	t.Log("UpgradeBeatsSheetsNotAllowed")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		_, err = ogen.MustGetResponse[apimodels.UpgradeBeatsSheetsRes, *apimodels.ForbiddenError](
+			client.UpgradeBeatsSheets(t.Context(), &apimodels.UpgradeBeatsSheetsForm{
+				ID: storyPlans[1].ID,
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	t.Log("UpgradeBeatsSheets/Incompatible")
+	{
+		security.SetToken(userAdminAccessToken)
+
+		res, err := ogen.MustGetResponse[
+			apimodels.UpgradeBeatsSheetsRes, *apimodels.UpgradeBeatsSheetsOKApplicationJSON,
+		](
+			client.UpgradeBeatsSheets(t.Context(), &apimodels.UpgradeBeatsSheetsForm{
+				ID: storyPlans[1].ID,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Len(t, *res, 1)
+		require.Equal(t, beatsSheet.ID, (*res)[0].BeatsSheetID)
+		require.Equal(t, storyPlans[0].ID, (*res)[0].FromStoryPlanID)
+		require.False(t, (*res)[0].Compatible)
+		require.False(t, (*res)[0].Upgraded)
+		require.True(t, (*res)[0].Reason.IsSet())
+	}
+
+	t.Log("UpdateStoryPlan/RestoreBeats")
+	{
+		security.SetToken(userAdminAccessToken)
+
+		storyPlan, err := ogen.MustGetResponse[apimodels.UpdateStoryPlanRes, *apimodels.StoryPlan](
+			client.UpdateStoryPlan(t.Context(), &apimodels.UpdateStoryPlanForm{
+				ID:    storyPlans[1].ID,
+				Name:  "Playground Plan Updated",
+				Beats: beats,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, 3, storyPlan.Version)
+
+		storyPlans = append(storyPlans, storyPlan)
+	}
+
+	t.Log("UpgradeBeatsSheets/DryRun")
+	{
+		security.SetToken(userAdminAccessToken)
+
+		res, err := ogen.MustGetResponse[
+			apimodels.UpgradeBeatsSheetsRes, *apimodels.UpgradeBeatsSheetsOKApplicationJSON,
+		](
+			client.UpgradeBeatsSheets(t.Context(), &apimodels.UpgradeBeatsSheetsForm{
+				ID:     storyPlans[2].ID,
+				DryRun: apimodels.NewOptBool(true),
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Len(t, *res, 1)
+		require.True(t, (*res)[0].Compatible)
+		require.False(t, (*res)[0].Upgraded)
+	}
+
+	t.Log("UpgradeCustomBeatsSheets/DryRun")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		res, err := ogen.MustGetResponse[
+			apimodels.UpgradeCustomBeatsSheetsRes, *apimodels.UpgradeCustomBeatsSheetsOKApplicationJSON,
+		](
+			client.UpgradeCustomBeatsSheets(t.Context(), &apimodels.UpgradeBeatsSheetsForm{
+				ID:     storyPlans[2].ID,
+				DryRun: apimodels.NewOptBool(true),
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Len(t, *res, 1)
+		require.Equal(t, beatsSheet.ID, (*res)[0].BeatsSheetID)
+		require.True(t, (*res)[0].Compatible)
+		require.False(t, (*res)[0].Upgraded)
+	}
+
+	t.Log("UpgradeCustomBeatsSheets/OtherUser")
+	{
+		// The admin does not own any beats sheet: the user-scoped route ignores the sheets of other users.
+		security.SetToken(userAdminAccessToken)
+
+		res, err := ogen.MustGetResponse[
+			apimodels.UpgradeCustomBeatsSheetsRes, *apimodels.UpgradeCustomBeatsSheetsOKApplicationJSON,
+		](
+			client.UpgradeCustomBeatsSheets(t.Context(), &apimodels.UpgradeBeatsSheetsForm{
+				ID: storyPlans[2].ID,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Empty(t, *res)
+	}
+
+	t.Log("UpgradeBeatsSheets")
+	{
+		security.SetToken(userAdminAccessToken)
+
+		res, err := ogen.MustGetResponse[
+			apimodels.UpgradeBeatsSheetsRes, *apimodels.UpgradeBeatsSheetsOKApplicationJSON,
+		](
+			client.UpgradeBeatsSheets(t.Context(), &apimodels.UpgradeBeatsSheetsForm{
+				ID: storyPlans[2].ID,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Len(t, *res, 1)
+		require.True(t, (*res)[0].Compatible)
+		require.True(t, (*res)[0].Upgraded)
+	}
+
+	t.Log("GetBeatsSheet/Upgraded")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		res, err := ogen.MustGetResponse[apimodels.GetBeatsSheetRes, *apimodels.BeatsSheet](
+			client.GetBeatsSheet(t.Context(), apimodels.GetBeatsSheetParams{
+				BeatsSheetID: beatsSheet.ID,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, apimodels.NewOptStoryPlanID(storyPlans[2].ID), res.StoryPlanID)
+	}
+
+	t.Log("UpgradeBeatsSheets/UpToDate")
+	{
+		security.SetToken(userAdminAccessToken)
+
+		res, err := ogen.MustGetResponse[
+			apimodels.UpgradeBeatsSheetsRes, *apimodels.UpgradeBeatsSheetsOKApplicationJSON,
+		](
+			client.UpgradeBeatsSheets(t.Context(), &apimodels.UpgradeBeatsSheetsForm{
+				ID: storyPlans[2].ID,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Empty(t, *res)
+	}
+
+	t.Log("GetStoryPlanBySlug")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		storyPlan, err := ogen.MustGetResponse[apimodels.GetStoryPlanRes, *apimodels.StoryPlan](
+			client.GetStoryPlan(t.Context(), apimodels.GetStoryPlanParams{
+				Slug: apimodels.NewOptSlug(apimodels.Slug(storyPlanSlug)),
+				Lang: apimodels.NewOptLang(apimodels.LangEn),
+			}),
+		)
+		require.NoError(t, err)
+
+		// The latest version is returned.
+		require.Equal(t, storyPlans[2], storyPlan)
+	}
+
+	t.Log("GetStoryPlanBySlug/OtherLang")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		_, err = ogen.MustGetResponse[apimodels.GetStoryPlanRes, *apimodels.NotFoundError](
+			client.GetStoryPlan(t.Context(), apimodels.GetStoryPlanParams{
+				Slug: apimodels.NewOptSlug(apimodels.Slug(storyPlanSlug)),
+				Lang: apimodels.NewOptLang(apimodels.LangFr),
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	t.Log("ListStoryPlans")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		res, err := ogen.MustGetResponse[apimodels.GetStoryPlansRes, *apimodels.GetStoryPlansOKApplicationJSON](
+			client.GetStoryPlans(t.Context(), apimodels.GetStoryPlansParams{
+				Limit: apimodels.NewOptInt(100),
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Contains(t, *res, apimodels.StoryPlanPreview{
+			ID:        storyPlans[2].ID,
+			Slug:      storyPlans[2].Slug,
+			Version:   storyPlans[2].Version,
+			Name:      storyPlans[2].Name,
+			Lang:      storyPlans[2].Lang,
+			CreatedAt: storyPlans[2].CreatedAt,
+		})
+		// Previous versions are not listed.
+		require.NotContains(t, lo.Map(*res, func(item apimodels.StoryPlanPreview, _ int) apimodels.StoryPlanID {
+			return item.ID
+		}), storyPlans[0].ID)
+	}
+
+	userOtherClaims := authmodels.AccessTokenClaims{
+		UserID: lo.ToPtr(uuid.New()),
+		Roles:  []authmodels.Role{authmodels.RoleUser},
+	}
+	userOtherAccessToken := getAccessToken(t, appConfig, userOtherClaims)
+
+	customStoryPlans := make([]*apimodels.StoryPlan, 0)
+
+	t.Log("ForkStoryPlan")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		storyPlan, err := ogen.MustGetResponse[apimodels.ForkStoryPlanRes, *apimodels.StoryPlan](
+			client.ForkStoryPlan(t.Context(), &apimodels.ForkStoryPlanForm{
+				ID: storyPlans[2].ID,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.NotEqual(t, storyPlans[2].ID, storyPlan.ID)
+		require.Equal(t, storyPlans[2].Slug, storyPlan.Slug)
+		require.Equal(t, 1, storyPlan.Version)
+		require.Equal(t, apimodels.NewOptUserID(apimodels.UserID(*userLambdaClaims.UserID)), storyPlan.UserID)
+		require.Equal(t, storyPlans[2].Beats, storyPlan.Beats)
+
+		customStoryPlans = append(customStoryPlans, storyPlan)
+	}
+
+	t.Log("GetStoryPlan/CustomShadowsBuiltIn")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		storyPlan, err := ogen.MustGetResponse[apimodels.GetStoryPlanRes, *apimodels.StoryPlan](
+			client.GetStoryPlan(t.Context(), apimodels.GetStoryPlanParams{
+				Slug: apimodels.NewOptSlug(apimodels.Slug(storyPlanSlug)),
+				Lang: apimodels.NewOptLang(apimodels.LangEn),
+			}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, customStoryPlans[0], storyPlan)
+	}
+
+	t.Log("GetStoryPlan/CustomOtherUser")
+	{
+		security.SetToken(userOtherAccessToken)
+
+		_, err = ogen.MustGetResponse[apimodels.GetStoryPlanRes, *apimodels.NotFoundError](
+			client.GetStoryPlan(t.Context(), apimodels.GetStoryPlanParams{
+				ID: apimodels.NewOptStoryPlanID(customStoryPlans[0].ID),
+			}),
+		)
+		require.NoError(t, err)
+
+		// Other users still get the built-in plan.
+		storyPlan, err := ogen.MustGetResponse[apimodels.GetStoryPlanRes, *apimodels.StoryPlan](
+			client.GetStoryPlan(t.Context(), apimodels.GetStoryPlanParams{
+				Slug: apimodels.NewOptSlug(apimodels.Slug(storyPlanSlug)),
+				Lang: apimodels.NewOptLang(apimodels.LangEn),
+			}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, storyPlans[2], storyPlan)
+	}
+
+	t.Log("CreateCustomStoryPlan/Conflict")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		_, err = ogen.MustGetResponse[apimodels.CreateCustomStoryPlanRes, *apimodels.ConflictError](
+			client.CreateCustomStoryPlan(t.Context(), &apimodels.CreateStoryPlanForm{
+				Slug:  apimodels.Slug(storyPlanSlug),
+				Name:  "Playground Custom Plan",
+				Lang:  apimodels.LangEn,
+				Beats: beats,
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	t.Log("CreateCustomStoryPlan")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		storyPlan, err := ogen.MustGetResponse[apimodels.CreateCustomStoryPlanRes, *apimodels.StoryPlan](
+			client.CreateCustomStoryPlan(t.Context(), &apimodels.CreateStoryPlanForm{
+				Slug:  apimodels.Slug(storyPlanSlug),
+				Name:  "Playground Custom Plan",
+				Lang:  apimodels.LangFr,
+				Beats: beats,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, apimodels.NewOptUserID(apimodels.UserID(*userLambdaClaims.UserID)), storyPlan.UserID)
+		require.Equal(t, beats, storyPlan.Beats)
+
+		customStoryPlans = append(customStoryPlans, storyPlan)
+	}
+
+	t.Log("UpdateCustomStoryPlan/OtherUser")
+	{
+		security.SetToken(userOtherAccessToken)
+
+		_, err = ogen.MustGetResponse[apimodels.UpdateCustomStoryPlanRes, *apimodels.NotFoundError](
+			client.UpdateCustomStoryPlan(t.Context(), &apimodels.UpdateStoryPlanForm{
+				ID:    customStoryPlans[1].ID,
+				Name:  "Playground Custom Plan Updated",
+				Beats: beats[:1],
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	t.Log("UpdateCustomStoryPlan/BuiltIn")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		_, err = ogen.MustGetResponse[apimodels.UpdateCustomStoryPlanRes, *apimodels.NotFoundError](
+			client.UpdateCustomStoryPlan(t.Context(), &apimodels.UpdateStoryPlanForm{
+				ID:    storyPlans[2].ID,
+				Name:  "Playground Plan Updated",
+				Beats: beats[:1],
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	t.Log("UpdateCustomStoryPlan/TranslationMismatch")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		// The English version of the custom plan still has every beat.
+		_, err = ogen.MustGetResponse[apimodels.UpdateCustomStoryPlanRes, *apimodels.UnprocessableEntityError](
+			client.UpdateCustomStoryPlan(t.Context(), &apimodels.UpdateStoryPlanForm{
+				ID:    customStoryPlans[1].ID,
+				Name:  "Playground Custom Plan Updated",
+				Beats: beats[:1],
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	t.Log("UpdateCustomStoryPlan")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		storyPlan, err := ogen.MustGetResponse[apimodels.UpdateCustomStoryPlanRes, *apimodels.StoryPlan](
+			client.UpdateCustomStoryPlan(t.Context(), &apimodels.UpdateStoryPlanForm{
+				ID:    customStoryPlans[1].ID,
+				Name:  "Playground Custom Plan Updated",
+				Beats: beats,
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, customStoryPlans[1].Slug, storyPlan.Slug)
+		require.Equal(t, 2, storyPlan.Version)
+		require.Equal(t, customStoryPlans[1].UserID, storyPlan.UserID)
+
+		customStoryPlans = append(customStoryPlans, storyPlan)
+	}
+
+	t.Log("ListStoryPlans/Custom")
+	{
+		security.SetToken(userLambdaAccessToken)
+
+		res, err := ogen.MustGetResponse[apimodels.GetStoryPlansRes, *apimodels.GetStoryPlansOKApplicationJSON](
+			client.GetStoryPlans(t.Context(), apimodels.GetStoryPlansParams{
+				Limit: apimodels.NewOptInt(100),
+			}),
+		)
+		require.NoError(t, err)
+
+		ids := lo.Map(*res, func(item apimodels.StoryPlanPreview, _ int) apimodels.StoryPlanID {
+			return item.ID
+		})
+
+		require.Contains(t, ids, customStoryPlans[0].ID)
+		require.Contains(t, ids, customStoryPlans[2].ID)
+		require.NotContains(t, ids, customStoryPlans[1].ID)
+	}
+
+	t.Log("ListStoryPlans/CustomOtherUser")
+	{
+		security.SetToken(userOtherAccessToken)
+
+		res, err := ogen.MustGetResponse[apimodels.GetStoryPlansRes, *apimodels.GetStoryPlansOKApplicationJSON](
+			client.GetStoryPlans(t.Context(), apimodels.GetStoryPlansParams{
+				Limit: apimodels.NewOptInt(100),
+			}),
+		)
+		require.NoError(t, err)
+
+		ids := lo.Map(*res, func(item apimodels.StoryPlanPreview, _ int) apimodels.StoryPlanID {
+			return item.ID
+		})
+
+		require.Contains(t, ids, storyPlans[2].ID)
+		require.NotContains(t, ids, customStoryPlans[0].ID)
+		require.NotContains(t, ids, customStoryPlans[2].ID)
+	}
+}
@@ -25,6 +25,7 @@ func TestApp(t *testing.T) {
 		"Ping":                  testAppPing,
 		"BeatsSheetsPlayground": testAppBeatsSheetsPlayground,
 		"LoglinesPlayground":    testAppLoglinesPlayground,
+		"StoryPlansPlayground":  testAppStoryPlansPlayground,
 	}
 
 	for testName, testSuite := range testSuites {
@@ -0,0 +1,72 @@
+package pkg
+
+import (
+	"context"
+	"iter"
+
+	"github.com/a-novel/golib/ogen"
+
+	"github.com/a-novel/service-story-schematics/models/api"
+)
+
+// IterLoglines goes through every logline of the current user, requesting the following pages as the loop goes.
+// The limit of params sets the size of each page. Iteration stops on the first error, which is yielded last.
+func IterLoglines(
+	ctx context.Context, client *APIClient, params apimodels.GetLoglinesParams,
+) iter.Seq2[apimodels.LoglinePreview, error] {
+	return func(yield func(apimodels.LoglinePreview, error) bool) {
+		for {
+			page, err := ogen.MustGetResponse[apimodels.GetLoglinesRes, *apimodels.LoglinesPage](
+				client.GetLoglines(ctx, params),
+			)
+			if err != nil {
+				yield(apimodels.LoglinePreview{}, err)
+
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if !page.NextCursor.IsSet() {
+				return
+			}
+
+			params.Cursor = page.NextCursor
+		}
+	}
+}
+
+// IterBeatsSheets goes through every beats sheet of a logline, requesting the following pages as the loop goes.
+// The limit of params sets the size of each page. Iteration stops on the first error, which is yielded last.
+func IterBeatsSheets(
+	ctx context.Context, client *APIClient, params apimodels.GetBeatsSheetsParams,
+) iter.Seq2[apimodels.BeatsSheetPreview, error] {
+	return func(yield func(apimodels.BeatsSheetPreview, error) bool) {
+		for {
+			page, err := ogen.MustGetResponse[apimodels.GetBeatsSheetsRes, *apimodels.BeatsSheetsPage](
+				client.GetBeatsSheets(ctx, params),
+			)
+			if err != nil {
+				yield(apimodels.BeatsSheetPreview{}, err)
+
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if !page.NextCursor.IsSet() {
+				return
+			}
+
+			params.Cursor = page.NextCursor
+		}
+	}
+}